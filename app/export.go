@@ -11,6 +11,8 @@ import (
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	defitypes "github.com/gauss/gauss/v4/x/defi/types"
 )
 
 // ExportAppStateAndValidators exports the state of the application for a genesis
@@ -190,4 +192,71 @@ func (app *GaussApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs
 			return false
 		},
 	)
+
+	/* Handle defi state. */
+
+	// withdraw all defi commission
+	app.DefiKeeper.IterateDefis(ctx, func(_ int64, defi defitypes.DefiI) (stop bool) {
+		_, _ = app.DefiKeeper.WithdrawDefiCommission(ctx, defi.GetOperator())
+		return false
+	})
+
+	// withdraw all defi delegator rewards
+	defiDels := app.DefiKeeper.GetAllDelegations(ctx)
+	for _, delegation := range defiDels {
+		defiAddr, err := sdk.ValAddressFromBech32(delegation.DefiAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		delAddr, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		_, _ = app.DefiKeeper.WithdrawDelegationRewards(ctx, delAddr, defiAddr)
+	}
+
+	// clear defi historical rewards
+	app.DefiKeeper.DeleteAllDefiHistoricalRewards(ctx)
+
+	// set context height to zero
+	ctx = ctx.WithBlockHeight(0)
+
+	// reinitialize all defis
+	app.DefiKeeper.IterateDefis(ctx, func(_ int64, defi defitypes.DefiI) (stop bool) {
+		// donate any unwithdrawn outstanding reward fraction tokens to the community pool
+		scraps := app.DefiKeeper.GetDefiOutstandingRewardsCoins(ctx, defi.GetOperator())
+		feePool := app.DefiKeeper.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(scraps...)
+		app.DefiKeeper.SetFeePool(ctx, feePool)
+
+		app.DefiKeeper.Hooks().AfterDefiCreated(ctx, defi.GetOperator())
+		return false
+	})
+
+	// reinitialize all defi delegations
+	for _, del := range defiDels {
+		defiAddr, err := sdk.ValAddressFromBech32(del.DefiAddress)
+		if err != nil {
+			panic(err)
+		}
+		delAddr, err := sdk.AccAddressFromBech32(del.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		app.DefiKeeper.Hooks().BeforeDelegationCreated(ctx, delAddr, defiAddr)
+		app.DefiKeeper.Hooks().AfterDelegationModified(ctx, delAddr, defiAddr)
+	}
+
+	// reset context height
+	ctx = ctx.WithBlockHeight(height)
+
+	// iterate through defi unbonding delegations, reset creation height
+	app.DefiKeeper.IterateUnbondingDelegations(ctx, func(_ int64, ubd defitypes.UnbondingDelegation) (stop bool) {
+		for i := range ubd.Entries {
+			ubd.Entries[i].CreationHeight = 0
+		}
+		app.DefiKeeper.SetUnbondingDelegation(ctx, ubd)
+		return false
+	})
 }
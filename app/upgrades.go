@@ -0,0 +1,58 @@
+package gauss
+
+import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	gaussammswaptypes "github.com/gauss/gauss/v4/x/ammswap/types"
+	gaussbridgetypes "github.com/gauss/gauss/v4/x/bridge/types"
+	gaussdefitypes "github.com/gauss/gauss/v4/x/defi/types"
+	gaussidentitytypes "github.com/gauss/gauss/v4/x/identity/types"
+	gaussoracletypes "github.com/gauss/gauss/v4/x/oracle/types"
+	gaussorderbooktypes "github.com/gauss/gauss/v4/x/orderbook/types"
+)
+
+// ModulesUpgradeName is the name of the software upgrade adding the defi,
+// orderbook, ammswap, oracle, identity and bridge modules to a running chain
+// and migrating the token module
+const ModulesUpgradeName = "modules-upgrade"
+
+// addedModules are the modules whose stores are added by the upgrade, in the
+// order of their genesis initialization
+var addedModules = []string{
+	gaussdefitypes.ModuleName, gaussorderbooktypes.ModuleName, gaussammswaptypes.ModuleName,
+	gaussoracletypes.ModuleName, gaussidentitytypes.ModuleName, gaussbridgetypes.ModuleName,
+}
+
+// registerUpgradeHandlers registers the handlers of the software upgrades
+// applied to the app, the added modules starting from their default genesis
+func (app *GaussApp) registerUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(ModulesUpgradeName, func(ctx sdk.Context, plan upgradetypes.Plan) {
+		for _, name := range addedModules {
+			m := app.mm.Modules[name]
+			m.InitGenesis(ctx, app.appCodec, m.DefaultGenesis(app.appCodec))
+		}
+
+		app.TokenKeeper.MigrateStore(ctx)
+	})
+}
+
+// setUpgradeStoreLoader mounts the stores of the added modules at the height of
+// the upgrade, it must be set before the latest version is loaded
+func (app *GaussApp) setUpgradeStoreLoader() {
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk: %s", err))
+	}
+
+	if upgradeInfo.Name != ModulesUpgradeName || app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	// the store keys of the added modules are their names
+	storeUpgrades := storetypes.StoreUpgrades{Added: addedModules}
+	app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+}
@@ -4,6 +4,7 @@ go 1.15
 
 require (
 	github.com/armon/go-metrics v0.3.8
+	github.com/btcsuite/btcd v0.21.0-beta
 	github.com/cosmos/cosmos-sdk v0.42.9
 	github.com/gogo/protobuf v1.3.3
 	github.com/golang/protobuf v1.5.2
@@ -21,6 +22,7 @@ require (
 	github.com/tendermint/tm-db v0.6.4
 	github.com/tendermint/tmlibs v0.9.0
 	github.com/tidwall/gjson v1.8.1
+	golang.org/x/crypto v0.0.0-20210513164829-c07d793c2f9a
 	google.golang.org/genproto v0.0.0-20210524171403-669157292da3
 	google.golang.org/grpc v1.37.0
 	gopkg.in/yaml.v2 v2.4.0
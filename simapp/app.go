@@ -89,14 +89,31 @@ import (
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	gaussammswap "github.com/gauss/gauss/v4/x/ammswap"
+	gaussammswapkeeper "github.com/gauss/gauss/v4/x/ammswap/keeper"
+	gaussammswaptypes "github.com/gauss/gauss/v4/x/ammswap/types"
+	gaussbridge "github.com/gauss/gauss/v4/x/bridge"
+	gaussbridgekeeper "github.com/gauss/gauss/v4/x/bridge/keeper"
+	gaussbridgetypes "github.com/gauss/gauss/v4/x/bridge/types"
+	gaussdefi "github.com/gauss/gauss/v4/x/defi"
+	gaussdeficlient "github.com/gauss/gauss/v4/x/defi/client"
+	gaussdefikeeper "github.com/gauss/gauss/v4/x/defi/keeper"
+	gaussdefitypes "github.com/gauss/gauss/v4/x/defi/types"
+	gaussidentity "github.com/gauss/gauss/v4/x/identity"
+	gaussidentitykeeper "github.com/gauss/gauss/v4/x/identity/keeper"
+	gaussidentitytypes "github.com/gauss/gauss/v4/x/identity/types"
+	gaussoracle "github.com/gauss/gauss/v4/x/oracle"
+	gaussoraclekeeper "github.com/gauss/gauss/v4/x/oracle/keeper"
+	gaussoracletypes "github.com/gauss/gauss/v4/x/oracle/types"
 	gaussorderbook "github.com/gauss/gauss/v4/x/orderbook"
-	gaussorderbookeeper "github.com/gauss/gauss/v4/x/orderbook/keeper"
+	gaussorderbookkeeper "github.com/gauss/gauss/v4/x/orderbook/keeper"
 	gaussorderbooktypes "github.com/gauss/gauss/v4/x/orderbook/types"
 
 	// unnamed import of statik for swagger UI support
 	_ "github.com/cosmos/cosmos-sdk/client/docs/statik"
 
-	gausstoken"github.com/gauss/gauss/v4/x/token"
+	gausstoken "github.com/gauss/gauss/v4/x/token"
+	gausstokenclient "github.com/gauss/gauss/v4/x/token/client"
 	gausstokenkeeper "github.com/gauss/gauss/v4/x/token/keeper"
 	gausstokentypes "github.com/gauss/gauss/v4/x/token/types"
 )
@@ -119,10 +136,13 @@ var (
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
-			paramsclient.ProposalHandler, 
-			distrclient.ProposalHandler, 
-			upgradeclient.ProposalHandler, 
+			paramsclient.ProposalHandler,
+			distrclient.ProposalHandler,
+			upgradeclient.ProposalHandler,
 			upgradeclient.CancelProposalHandler,
+			gausstokenclient.ProposalHandler,
+			gausstokenclient.SymbolReservationProposalHandler,
+			gaussdeficlient.ProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -133,21 +153,31 @@ var (
 		transfer.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 
+		gaussdefi.AppModuleBasic{},
 		gaussorderbook.AppModuleBasic{},
+		gaussammswap.AppModuleBasic{},
+		gaussoracle.AppModuleBasic{},
+		gaussidentity.AppModuleBasic{},
+		gaussbridge.AppModuleBasic{},
 		gausstoken.AppModuleBasic{},
 	)
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		gaussorderbooktypes.ModuleName:      {authtypes.Staking}
-		gausstokentypes.ModuleName:          {authtypes.Minter, authtypes.Burner},
+		authtypes.FeeCollectorName:       nil,
+		distrtypes.ModuleName:            nil,
+		minttypes.ModuleName:             {authtypes.Minter},
+		stakingtypes.BondedPoolName:      {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:   {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:              {authtypes.Burner},
+		ibctransfertypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
+		gaussdefitypes.ModuleName:        nil,
+		gaussdefitypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		gaussdefitypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		gaussorderbooktypes.ModuleName:   nil,
+		gaussammswaptypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
+		gaussbridgetypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
+		gausstokentypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -157,7 +187,7 @@ var (
 )
 
 var (
-	_ simapp.App                     = (*SimApp)(nil)
+	_ simapp.App              = (*SimApp)(nil)
 	_ servertypes.Application = (*SimApp)(nil)
 )
 
@@ -198,8 +228,13 @@ type SimApp struct {
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
 	ScopedIBCMockKeeper  capabilitykeeper.ScopedKeeper
 
-	OrderbooKeeper gaussorderbookeeper.Keeper
-	TokenKeeper    gausstokenkeeper.Keeper
+	DefiKeeper      gaussdefikeeper.Keeper
+	OrderbookKeeper gaussorderbookkeeper.Keeper
+	AmmswapKeeper   gaussammswapkeeper.Keeper
+	OracleKeeper    gaussoraclekeeper.Keeper
+	IdentityKeeper  gaussidentitykeeper.Keeper
+	BridgeKeeper    gaussbridgekeeper.Keeper
+	TokenKeeper     gausstokenkeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -239,8 +274,8 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
-		gaussorderbooktypes.StoreKey,
-		gausstokentypes.StoreKey, 
+		gaussdefitypes.StoreKey, gaussorderbooktypes.StoreKey, gaussammswaptypes.StoreKey, gaussoracletypes.StoreKey, gausstokentypes.StoreKey,
+		gaussidentitytypes.StoreKey, gaussbridgetypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -273,9 +308,19 @@ func NewSimApp(
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
 		appCodec, keys[authtypes.StoreKey], app.GetSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms,
 	)
-	app.BankKeeper = bankkeeper.NewBaseKeeper(
+	bankKeeper := bankkeeper.NewBaseKeeper(
 		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs(),
 	)
+	app.TokenKeeper = gausstokenkeeper.NewKeeper(
+		appCodec,
+		keys[gausstokentypes.StoreKey],
+		app.GetSubspace(gausstokentypes.ModuleName),
+		bankKeeper,
+		app.ModuleAccountAddrs(),
+		authtypes.FeeCollectorName,
+	)
+	// the other modules send the coins through the bank keeper restricting the token transfers
+	app.BankKeeper = gausstokenkeeper.NewRestrictedBankKeeper(bankKeeper, app.TokenKeeper)
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
@@ -306,13 +351,31 @@ func NewSimApp(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, scopedIBCKeeper,
 	)
 
+	defiKeeper := gaussdefikeeper.NewKeeper(
+		appCodec,
+		keys[gaussdefitypes.StoreKey],
+		app.GetSubspace(gaussdefitypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.TokenKeeper,
+		app.ModuleAccountAddrs(),
+	)
+
+	// register the defi hooks
+	// NOTE: defiKeeper above is passed by reference, so that it will contain these hooks
+	app.DefiKeeper = *defiKeeper.SetHooks(
+		gaussdefitypes.NewMultiDefiHooks(defiKeeper.Hooks()),
+	)
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(gausstokentypes.RouterKey, gausstoken.NewTokenProposalHandler(app.TokenKeeper)).
+		AddRoute(gaussdefitypes.RouterKey, gaussdefi.NewDefiProposalHandler(app.DefiKeeper))
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
@@ -343,22 +406,46 @@ func NewSimApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
-	app.OrderbooKeeper = gaussorderbookeeper.NewKeeper{
-		appCodec, 
-		keys[orderbooktypes.StoreKey], 
-		app.GetSubspace(orderbooktypes.ModuleName),
-		app.AccountKeeper, 
-		app.BankKeeper, 
-		app.StakingKeeper, 
+	app.OrderbookKeeper = gaussorderbookkeeper.NewKeeper(
+		appCodec,
+		keys[gaussorderbooktypes.StoreKey],
+		app.GetSubspace(gaussorderbooktypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.StakingKeeper,
+		app.DefiKeeper,
+		app.TokenKeeper,
 		app.ModuleAccountAddrs(),
-	}
-	app.TokenKeeper = gausstokenkeeper.NewKeeper(
-		appCodec, 
-		keys[tokentypes.StoreKey], 
-		app.GetSubspace(tokentypes.ModuleName),
-		app.BankKeeper, 
-		app.ModuleAccountAddrs(),
-		authtypes.FeeCollectorName,
+	)
+	app.AmmswapKeeper = gaussammswapkeeper.NewKeeper(
+		appCodec,
+		keys[gaussammswaptypes.StoreKey],
+		app.GetSubspace(gaussammswaptypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.TokenKeeper,
+	)
+	app.OracleKeeper = gaussoraclekeeper.NewKeeper(
+		appCodec,
+		keys[gaussoracletypes.StoreKey],
+		app.GetSubspace(gaussoracletypes.ModuleName),
+		app.StakingKeeper,
+	)
+
+	app.IdentityKeeper = gaussidentitykeeper.NewKeeper(
+		appCodec,
+		keys[gaussidentitytypes.StoreKey],
+		app.GetSubspace(gaussidentitytypes.ModuleName),
+	)
+
+	app.BridgeKeeper = gaussbridgekeeper.NewKeeper(
+		appCodec,
+		keys[gaussbridgetypes.StoreKey],
+		app.GetSubspace(gaussbridgetypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.StakingKeeper,
+		app.TokenKeeper,
 	)
 
 	/****  Module Options ****/
@@ -389,8 +476,13 @@ func NewSimApp(
 		ibc.NewAppModule(app.IBCKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
-		
-		gaussorderbook.NewAppModule(appCodec, app.Orderbook, app.AccountKeeper, app.Bankeeper),
+
+		gaussdefi.NewAppModule(appCodec, app.DefiKeeper, app.AccountKeeper, app.BankKeeper),
+		gaussorderbook.NewAppModule(appCodec, app.OrderbookKeeper, app.AccountKeeper, app.BankKeeper),
+		gaussammswap.NewAppModule(appCodec, app.AmmswapKeeper, app.AccountKeeper, app.BankKeeper),
+		gaussoracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper),
+		gaussidentity.NewAppModule(appCodec, app.IdentityKeeper, app.AccountKeeper, app.BankKeeper),
+		gaussbridge.NewAppModule(appCodec, app.BridgeKeeper, app.AccountKeeper, app.BankKeeper),
 		gausstoken.NewAppModule(appCodec, app.TokenKeeper, app.AccountKeeper, app.BankKeeper),
 	)
 
@@ -400,9 +492,13 @@ func NewSimApp(
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	app.mm.SetOrderBeginBlockers(
 		upgradetypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
-		evidencetypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName,
+		evidencetypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName, gaussdefitypes.ModuleName,
+		gausstokentypes.ModuleName,
+	)
+	app.mm.SetOrderEndBlockers(
+		crisistypes.ModuleName, govtypes.ModuleName, gaussoracletypes.ModuleName,
+		stakingtypes.ModuleName, gaussdefitypes.ModuleName, gaussorderbooktypes.ModuleName, gaussbridgetypes.ModuleName,
 	)
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -411,10 +507,14 @@ func NewSimApp(
 	// can do so safely.
 	app.mm.SetOrderInitGenesis(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
-		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
+		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName,
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
-		gaussorderbooktypes.ModuleName,
-		gausstokentypes.ModuleName, 
+		gausstokentypes.ModuleName, gaussdefitypes.ModuleName, gaussorderbooktypes.ModuleName,
+		gaussammswaptypes.ModuleName, gaussoracletypes.ModuleName, gaussidentitytypes.ModuleName,
+		gaussbridgetypes.ModuleName,
+		// crisis needs to be last so that the invariants of the modules above
+		// are asserted against their initialized state
+		crisistypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -441,7 +541,12 @@ func NewSimApp(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		transferModule,
-		gaussorderbook.NewAppModule(appCodec, app.OrderbooKeeper, app.AccountKeeper, app.BankKeeper),
+		gaussdefi.NewAppModule(appCodec, app.DefiKeeper, app.AccountKeeper, app.BankKeeper),
+		gaussorderbook.NewAppModule(appCodec, app.OrderbookKeeper, app.AccountKeeper, app.BankKeeper),
+		gaussammswap.NewAppModule(appCodec, app.AmmswapKeeper, app.AccountKeeper, app.BankKeeper),
+		gaussoracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper),
+		gaussidentity.NewAppModule(appCodec, app.IdentityKeeper, app.AccountKeeper, app.BankKeeper),
+		gaussbridge.NewAppModule(appCodec, app.BridgeKeeper, app.AccountKeeper, app.BankKeeper),
 		gausstoken.NewAppModule(appCodec, app.TokenKeeper, app.AccountKeeper, app.BankKeeper),
 	)
 
@@ -655,7 +760,12 @@ func initParamsKeeper(appCodec codec.BinaryMarshaler, legacyAmino *codec.LegacyA
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(gaussdefitypes.ModuleName)
 	paramsKeeper.Subspace(gaussorderbooktypes.ModuleName)
+	paramsKeeper.Subspace(gaussammswaptypes.ModuleName)
+	paramsKeeper.Subspace(gaussoracletypes.ModuleName)
+	paramsKeeper.Subspace(gaussidentitytypes.ModuleName)
+	paramsKeeper.Subspace(gaussbridgetypes.ModuleName)
 	paramsKeeper.Subspace(gausstokentypes.ModuleName)
 
 	return paramsKeeper
@@ -2,10 +2,34 @@ package simulation
 
 // Default simulation operation weights for messages and gov proposals
 const (
-	DefaultWeightMsgCreatePool                  int = 100
-	DefaultWeightMsgAddPledge                   int = 20
-	DefaultWeightMsgRedeemPledge                int = 10
-	DefaultWeightMsgPlaceOrder                  int = 100 
-	DefaultWeightMsgRevokeOrder                 int = 50
-	DefaultWeightMsgAgreeOrderPair              int = 100
+	DefaultWeightMsgCreatePool     int = 100
+	DefaultWeightMsgAddPledge      int = 20
+	DefaultWeightMsgRedeemPledge   int = 10
+	DefaultWeightMsgPlaceOrder     int = 100
+	DefaultWeightMsgRevokeOrder    int = 50
+	DefaultWeightMsgAgreeOrderPair int = 100
+
+	DefaultWeightMsgCreateSwapPool  int = 20
+	DefaultWeightMsgAddLiquidity    int = 50
+	DefaultWeightMsgRemoveLiquidity int = 30
+	DefaultWeightMsgSwapExactIn     int = 100
+	DefaultWeightMsgSwapExactOut    int = 100
+
+	DefaultWeightMsgAggregateExchangeRatePrevote int = 100
+	DefaultWeightMsgAggregateExchangeRateVote    int = 100
+	DefaultWeightMsgDelegateFeedConsent          int = 10
+
+	DefaultWeightMsgCreateDid                int = 50
+	DefaultWeightMsgAddVerificationMethod    int = 30
+	DefaultWeightMsgRotateVerificationMethod int = 20
+	DefaultWeightMsgRevokeVerificationMethod int = 10
+	DefaultWeightMsgAddService               int = 30
+	DefaultWeightMsgRemoveService            int = 10
+	DefaultWeightMsgAnchorCredential         int = 50
+	DefaultWeightMsgRevokeCredential         int = 10
+
+	DefaultWeightMsgSetOrchestratorAddress int = 50
+	DefaultWeightMsgBridgeClaim            int = 200
+	DefaultWeightMsgSendToExternal         int = 50
+	DefaultWeightMsgConfirmBatch           int = 100
 )
@@ -0,0 +1,23 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagDeadline  = "deadline"
+	FlagMinShares = "min-shares"
+	FlagMinTokens = "min-tokens"
+)
+
+var (
+	fsDeadline        = flag.NewFlagSet("", flag.ContinueOnError)
+	fsAddLiquidity    = flag.NewFlagSet("", flag.ContinueOnError)
+	fsRemoveLiquidity = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	fsDeadline.String(FlagDeadline, "", "time (RFC3339) after which the tx fails, no deadline if empty")
+	fsAddLiquidity.String(FlagMinShares, "0", "minimum pool shares minted for the deposit")
+	fsRemoveLiquidity.String(FlagMinTokens, "", "minimum tokens withdrawn for the shares, e.g. 10ugauss,20uusdg")
+}
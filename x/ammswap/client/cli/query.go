@@ -0,0 +1,308 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	ammswapQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the ammswap module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	ammswapQueryCmd.AddCommand(
+		GetCmdQueryPools(),
+		GetCmdQueryReserves(),
+		GetCmdQuerySpotPrice(),
+		GetCmdQuerySimulateSwapExactIn(),
+		GetCmdQuerySimulateSwapExactOut(),
+		GetCmdQueryParams(),
+	)
+
+	return ammswapQueryCmd
+}
+
+// GetCmdQueryPools implements the pools query command.
+func GetCmdQueryPools() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pools [pool-id]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query all pools",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all pools. optionally restrict to a single pool
+
+Example:
+$ %s query %s pools
+$ %s query %s pools 1
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 1 {
+				poolID, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+
+				res, err := queryClient.Pool(context.Background(), &types.QueryPoolRequest{PoolId: poolID})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Pools(context.Background(), &types.QueryPoolsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pools")
+
+	return cmd
+}
+
+// GetCmdQueryReserves implements the reserves query command.
+func GetCmdQueryReserves() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserves [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the reserves and the total shares of a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the reserves and the total shares of a pool.
+
+Example:
+$ %s query %s reserves 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Reserves(context.Background(), &types.QueryReservesRequest{PoolId: poolID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySpotPrice implements the spot price query command.
+func GetCmdQuerySpotPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spot-price [pool-id] [base-denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the spot price of a denom of a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the spot price of a denom of a pool in the other denom of the pool.
+
+Example:
+$ %s query %s spot-price 1 ugauss
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SpotPrice(context.Background(),
+				&types.QuerySpotPriceRequest{PoolId: poolID, BaseDenom: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySimulateSwapExactIn implements the simulate swap exact in query command.
+func GetCmdQuerySimulateSwapExactIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-swap-exact-in [pool-id] [token-in]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the output of swapping an exact input",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the output and the fee of swapping an exact input in a pool.
+
+Example:
+$ %s query %s simulate-swap-exact-in 1 1000ugauss
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			tokenIn, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SimulateSwapExactIn(context.Background(),
+				&types.QuerySimulateSwapExactInRequest{PoolId: poolID, TokenIn: tokenIn})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySimulateSwapExactOut implements the simulate swap exact out query command.
+func GetCmdQuerySimulateSwapExactOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-swap-exact-out [pool-id] [token-out]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the input of swapping for an exact output",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the input and the fee of swapping for an exact output in a pool.
+
+Example:
+$ %s query %s simulate-swap-exact-out 1 2000uusdg
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			tokenOut, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SimulateSwapExactOut(context.Background(),
+				&types.QuerySimulateSwapExactOutRequest{PoolId: poolID, TokenOut: tokenOut})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryParams implements the params query command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the ammswap module parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the ammswap module parameters information
+
+Example:
+$ %s query %s params
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(),
+				&types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,310 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/ammswap transaction commands.
+func NewTxCmd() *cobra.Command {
+	ammswapTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Ammswap transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	ammswapTxCmd.AddCommand(
+		NewCreatePoolCmd(),
+		NewAddLiquidityCmd(),
+		NewRemoveLiquidityCmd(),
+		NewSwapExactInCmd(),
+		NewSwapExactOutCmd(),
+	)
+
+	return ammswapTxCmd
+}
+
+func NewCreatePoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-pool [token-a] [token-b]",
+		Args:  cobra.ExactArgs(2),
+		Short: "create the pool of two denoms with its initial liquidity.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`create the pool of two denoms, depositing its initial liquidity.
+
+Example:
+$ %s tx %s create-pool 1000000ugauss 2000000uusdg --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenA, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			tokenB, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePool(clientCtx.GetFromAddress(), tokenA, tokenB)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewAddLiquidityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-liquidity [pool-id] [max-tokens]",
+		Args:  cobra.ExactArgs(2),
+		Short: "deposit liquidity into a pool for pool shares.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`deposit at most max-tokens into a pool at the ratio of its reserves, for pool shares.
+
+Example:
+$ %s tx %s add-liquidity 1 1000ugauss,2000uusdg --min-shares 1000 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			maxTokens, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			minSharesStr, _ := cmd.Flags().GetString(FlagMinShares)
+			minShares, ok := sdk.NewIntFromString(minSharesStr)
+			if !ok {
+				return fmt.Errorf("invalid min shares %s", minSharesStr)
+			}
+
+			deadline, err := getDeadline(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddLiquidity(clientCtx.GetFromAddress(), poolID, maxTokens, minShares, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsAddLiquidity)
+	cmd.Flags().AddFlagSet(fsDeadline)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRemoveLiquidityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-liquidity [pool-id] [shares]",
+		Args:  cobra.ExactArgs(2),
+		Short: "redeem pool shares for liquidity of a pool.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`burn pool shares and withdraw their part of the reserves of a pool.
+
+Example:
+$ %s tx %s remove-liquidity 1 1000 --min-tokens 500ugauss,1000uusdg --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			shares, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid shares %s", args[1])
+			}
+
+			minTokensStr, _ := cmd.Flags().GetString(FlagMinTokens)
+			minTokens, err := sdk.ParseCoinsNormalized(minTokensStr)
+			if err != nil {
+				return err
+			}
+
+			deadline, err := getDeadline(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveLiquidity(clientCtx.GetFromAddress(), poolID, shares, minTokens, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsRemoveLiquidity)
+	cmd.Flags().AddFlagSet(fsDeadline)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSwapExactInCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-in [pool-id] [token-in] [min-token-out]",
+		Args:  cobra.ExactArgs(3),
+		Short: "swap an exact input for at least a minimum output.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`swap an exact input in a pool for at least a minimum output.
+
+Example:
+$ %s tx %s swap-exact-in 1 1000ugauss 1900uusdg --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			tokenIn, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			minTokenOut, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			deadline, err := getDeadline(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSwapExactIn(clientCtx.GetFromAddress(), poolID, tokenIn, minTokenOut, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsDeadline)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSwapExactOutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-out [pool-id] [max-token-in] [token-out]",
+		Args:  cobra.ExactArgs(3),
+		Short: "swap at most a maximum input for an exact output.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`swap at most a maximum input in a pool for an exact output.
+
+Example:
+$ %s tx %s swap-exact-out 1 1100ugauss 2000uusdg --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			maxTokenIn, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			tokenOut, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			deadline, err := getDeadline(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSwapExactOut(clientCtx.GetFromAddress(), poolID, maxTokenIn, tokenOut, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsDeadline)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getDeadline parses the deadline flag, a zero time if it is not set
+func getDeadline(fs *flag.FlagSet) (time.Time, error) {
+	deadlineStr, _ := fs.GetString(FlagDeadline)
+	if deadlineStr == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, deadlineStr)
+}
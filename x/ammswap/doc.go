@@ -1,6 +1,8 @@
 /*
-Package ammswap implements a gauss module, that provides an implementation
-of the ammswap algorithm. 
+Package ammswap implements a gauss module, that provides constant-product
+automated market maker pools of any two bank or x/token denoms. Liquidity
+providers deposit both denoms for pool shares, and traders swap one denom for
+the other against the reserves of a pool for a swap fee kept by the pool.
 Please refer to the specification under /spec for further information.
 */
 package ammswap
@@ -0,0 +1,47 @@
+package ammswap
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/ammswap/keeper"
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+)
+
+// InitGenesis sets the pools and parameters for the provided keeper.
+func InitGenesis(
+	ctx sdk.Context, keeper keeper.Keeper, accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper, data *types.GenesisState,
+) (res []abci.ValidatorUpdate) {
+	if err := ValidateGenesis(data); err != nil {
+		panic(err.Error())
+	}
+
+	keeper.SetParams(ctx, data.Params)
+
+	for _, pool := range data.Pools {
+		keeper.SetPool(ctx, pool)
+		keeper.SetPoolByDenoms(ctx, pool)
+	}
+
+	keeper.SetNextPoolID(ctx, data.NextPoolId)
+
+	// check if the module account exists, it holds the reserves of the pools
+	if moduleAcc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	return res
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(keeper.GetParams(ctx), keeper.GetAllPools(ctx), keeper.GetNextPoolID(ctx))
+}
+
+// ValidateGenesis validates the provided ammswap genesis state
+func ValidateGenesis(data *types.GenesisState) error {
+	return data.Validate()
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+// Params queries the ammswap parameters
+func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Pools queries all the pools
+func (k Querier) Pools(c context.Context, req *types.QueryPoolsRequest) (*types.QueryPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var pools []types.Pool
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	poolStore := prefix.NewStore(store, types.PoolKey)
+
+	pageRes, err := query.Paginate(poolStore, req.Pagination, func(key []byte, value []byte) error {
+		var pool types.Pool
+		if err := k.cdc.UnmarshalBinaryBare(value, &pool); err != nil {
+			return err
+		}
+
+		pools = append(pools, pool)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolsResponse{Pools: pools, Pagination: pageRes}, nil
+}
+
+// Pool queries a pool by id
+func (k Querier) Pool(c context.Context, req *types.QueryPoolRequest) (*types.QueryPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pool, err := k.getPool(sdk.UnwrapSDKContext(c), req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoolResponse{Pool: pool}, nil
+}
+
+// Reserves queries the reserves and the total shares of a pool
+func (k Querier) Reserves(c context.Context, req *types.QueryReservesRequest) (*types.QueryReservesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pool, err := k.getPool(sdk.UnwrapSDKContext(c), req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReservesResponse{Reserves: pool.GetReserves(), TotalShares: pool.TotalShares}, nil
+}
+
+// SpotPrice queries the price of a denom of a pool in its other denom
+func (k Querier) SpotPrice(c context.Context, req *types.QuerySpotPriceRequest) (*types.QuerySpotPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pool, err := k.getPool(sdk.UnwrapSDKContext(c), req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := pool.GetSpotPrice(req.BaseDenom)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QuerySpotPriceResponse{SpotPrice: price}, nil
+}
+
+// SimulateSwapExactIn queries the output and the fee of swapping an exact input
+func (k Querier) SimulateSwapExactIn(
+	c context.Context, req *types.QuerySimulateSwapExactInRequest,
+) (*types.QuerySimulateSwapExactInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pool, err := k.getPool(ctx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOut, fee, err := k.Keeper.SimulateSwapExactIn(ctx, pool, req.TokenIn)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QuerySimulateSwapExactInResponse{TokenOut: tokenOut, Fee: fee}, nil
+}
+
+// SimulateSwapExactOut queries the input and the fee of swapping for an exact output
+func (k Querier) SimulateSwapExactOut(
+	c context.Context, req *types.QuerySimulateSwapExactOutRequest,
+) (*types.QuerySimulateSwapExactOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pool, err := k.getPool(ctx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIn, fee, err := k.Keeper.SimulateSwapExactOut(ctx, pool, req.TokenOut)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QuerySimulateSwapExactOutResponse{TokenIn: tokenIn, Fee: fee}, nil
+}
+
+func (k Querier) getPool(ctx sdk.Context, poolID uint64) (types.Pool, error) {
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		return pool, status.Errorf(codes.NotFound, "pool %d not found", poolID)
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+)
+
+// RegisterInvariants registers all ammswap invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account",
+		ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-shares",
+		TotalSharesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the ammswap module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleAccountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return TotalSharesInvariant(k)(ctx)
+	}
+}
+
+// ModuleAccountInvariant checks that the module account holds the reserves of
+// all the pools and their locked minimum liquidity
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		k.IteratePools(ctx, func(_ int64, pool types.Pool) bool {
+			expected = expected.Add(pool.GetReserves()...)
+			expected = expected.Add(sdk.NewCoin(pool.TotalShares.Denom, types.MinimumLiquidity))
+			return false
+		})
+
+		balances := k.bankKeeper.GetAllBalances(ctx, k.GetModuleAccountAddress())
+		broken := !balances.IsEqual(expected)
+
+		return sdk.FormatInvariant(types.ModuleName, "module-account",
+			fmt.Sprintf("\tmodule account balances: %s\n\texpected balances: %s\n", balances, expected)), broken
+	}
+}
+
+// TotalSharesInvariant checks that the supply of the shares of every pool
+// equals its total shares
+func TotalSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		supply := k.bankKeeper.GetSupply(ctx).GetTotal()
+		k.IteratePools(ctx, func(_ int64, pool types.Pool) bool {
+			if amount := supply.AmountOf(pool.TotalShares.Denom); !amount.Equal(pool.TotalShares.Amount) {
+				count++
+				msg += fmt.Sprintf("\tpool %d: share supply %s, total shares %s\n", pool.Id, amount, pool.TotalShares.Amount)
+			}
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "total-shares",
+			fmt.Sprintf("%d pools with mismatched shares found\n%s", count, msg)), broken
+	}
+}
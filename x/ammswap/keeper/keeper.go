@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+)
+
+// keeper of the ammswap store
+type Keeper struct {
+	storeKey    sdk.StoreKey
+	cdc         codec.BinaryMarshaler
+	authKeeper  types.AccountKeeper
+	bankKeeper  types.BankKeeper
+	tokenKeeper types.TokenKeeper
+	paramstore  paramtypes.Subspace
+}
+
+// NewKeeper creates a new ammswap Keeper instance
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey, ps paramtypes.Subspace, ak types.AccountKeeper, bk types.BankKeeper,
+	tk types.TokenKeeper,
+) Keeper {
+	// ensure ammswap module account is set, it holds the reserves of the pools
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:    key,
+		cdc:         cdc,
+		authKeeper:  ak,
+		bankKeeper:  bk,
+		tokenKeeper: tk,
+		paramstore:  ps,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetModuleAccountAddress returns the address of the ammswap module account
+func (k Keeper) GetModuleAccountAddress() sdk.AccAddress {
+	return k.authKeeper.GetModuleAddress(types.ModuleName)
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+)
+
+// CreatePool creates the pool of the denoms of two tokens deposited by the
+// creator as its initial liquidity. The creator receives the initial shares
+// except the minimum liquidity, which is locked in the module account.
+func (k Keeper) CreatePool(ctx sdk.Context, creator sdk.AccAddress, tokenA, tokenB sdk.Coin) (types.Pool, sdk.Coin, error) {
+	if err := types.ValidateReserves(tokenA, tokenB); err != nil {
+		return types.Pool{}, sdk.Coin{}, err
+	}
+
+	if _, found := k.GetPoolIDByDenoms(ctx, tokenA.Denom, tokenB.Denom); found {
+		return types.Pool{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrPoolExists, "pool of %s",
+			types.GetDenomPair(tokenA.Denom, tokenB.Denom))
+	}
+
+	initialShares := types.GetInitialShares(tokenA.Amount, tokenB.Amount)
+	if initialShares.LTE(types.MinimumLiquidity) {
+		return types.Pool{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrInsufficientLiquidity,
+			"initial shares %s must be greater than the minimum liquidity %s", initialShares, types.MinimumLiquidity)
+	}
+
+	poolID := k.GetNextPoolID(ctx)
+	pool := types.NewPool(poolID, creator, tokenA, tokenB)
+	pool.TotalShares.Amount = initialShares
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, pool.GetReserves()); err != nil {
+		return types.Pool{}, sdk.Coin{}, err
+	}
+
+	shares := pool.TotalShares.Sub(sdk.NewCoin(pool.TotalShares.Denom, types.MinimumLiquidity))
+	if err := k.mintShares(ctx, creator, pool.TotalShares, shares); err != nil {
+		return types.Pool{}, sdk.Coin{}, err
+	}
+
+	k.SetPool(ctx, pool)
+	k.SetPoolByDenoms(ctx, pool)
+	k.SetNextPoolID(ctx, poolID+1)
+
+	return pool, shares, nil
+}
+
+// AddLiquidity deposits at most maxTokens into a pool at the ratio of its
+// reserves, and mints the shares of the deposit to the sender
+func (k Keeper) AddLiquidity(
+	ctx sdk.Context, sender sdk.AccAddress, poolID uint64, maxTokens sdk.Coins, minShares sdk.Int,
+) (sdk.Coin, sdk.Coins, error) {
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		return sdk.Coin{}, nil, sdkerrors.Wrapf(types.ErrNoPoolFound, "pool %d", poolID)
+	}
+
+	if len(maxTokens) != 2 || !pool.HasDenom(maxTokens[0].Denom) || !pool.HasDenom(maxTokens[1].Denom) {
+		return sdk.Coin{}, nil, sdkerrors.Wrapf(types.ErrInvalidDenom, "max tokens %s for pool of %s",
+			maxTokens, pool.GetDenomPair())
+	}
+
+	amount, tokens := pool.GetDepositShares(maxTokens)
+	if !amount.IsPositive() {
+		return sdk.Coin{}, nil, sdkerrors.Wrapf(types.ErrInsufficientShares, "deposit of %s mints no shares", maxTokens)
+	}
+	if amount.LT(minShares) {
+		return sdk.Coin{}, nil, sdkerrors.Wrapf(types.ErrSlippageExceeded, "shares %s < min shares %s", amount, minShares)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, tokens); err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	shares := sdk.NewCoin(pool.TotalShares.Denom, amount)
+	if err := k.mintShares(ctx, sender, shares, shares); err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	pool.AddReserves(tokens)
+	pool.TotalShares = pool.TotalShares.Add(shares)
+	k.SetPool(ctx, pool)
+
+	return shares, tokens, nil
+}
+
+// RemoveLiquidity burns shares of a pool of the sender, and withdraws their
+// part of the reserves to the sender. The withdrawal is checked as a transfer
+// of the reserves by the sender, so that the shares cannot move the tokens
+// the sender is frozen for, nor paused tokens.
+func (k Keeper) RemoveLiquidity(
+	ctx sdk.Context, sender sdk.AccAddress, poolID uint64, amount sdk.Int, minTokens sdk.Coins,
+) (sdk.Coins, error) {
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNoPoolFound, "pool %d", poolID)
+	}
+
+	// the minimum liquidity is never held by an account
+	if amount.GT(pool.TotalShares.Amount.Sub(types.MinimumLiquidity)) {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientShares, "shares %s of pool %d", amount, poolID)
+	}
+
+	tokens := pool.GetWithdrawnTokens(amount)
+	if tokens.Empty() {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "shares %s withdraw no tokens", amount)
+	}
+	if !tokens.IsAllGTE(minTokens) {
+		return nil, sdkerrors.Wrapf(types.ErrSlippageExceeded, "tokens %s < min tokens %s", tokens, minTokens)
+	}
+	if err := k.tokenKeeper.ValidateTransfer(ctx, sender, tokens); err != nil {
+		return nil, err
+	}
+
+	shares := sdk.NewCoins(sdk.NewCoin(pool.TotalShares.Denom, amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, shares); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, shares); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, tokens); err != nil {
+		return nil, err
+	}
+
+	pool.SubReserves(tokens)
+	pool.TotalShares = pool.TotalShares.Sub(shares[0])
+	k.SetPool(ctx, pool)
+
+	return tokens, nil
+}
+
+// mintShares mints shares of a pool to the module account and sends a part
+// of them to an account
+func (k Keeper) mintShares(ctx sdk.Context, to sdk.AccAddress, minted, sent sdk.Coin) error {
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(minted)); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, sdk.NewCoins(sent))
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/ammswap/keeper"
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+	tokentypes "github.com/gauss/gauss/v4/x/token/types"
+)
+
+const (
+	denomA = "atoken"
+	denomB = "btoken"
+)
+
+var shareDenom = types.GetShareDenom(1)
+
+func TestCreatePool(t *testing.T) {
+	app, ctx, addrs := setupSwapTest(t, 2)
+	msgServer := keeper.NewMsgServerImpl(app.AmmswapKeeper)
+
+	// the initial shares must exceed the locked minimum liquidity
+	_, err := msgServer.CreatePool(sdk.WrapSDKContext(ctx),
+		types.NewMsgCreatePool(addrs[0], sdk.NewInt64Coin(denomA, 10), sdk.NewInt64Coin(denomB, 100000)))
+	require.ErrorIs(t, err, types.ErrInsufficientLiquidity)
+
+	// the denoms are sorted whatever their order in the msg
+	res, err := msgServer.CreatePool(sdk.WrapSDKContext(ctx),
+		types.NewMsgCreatePool(addrs[0], sdk.NewInt64Coin(denomB, 40000), sdk.NewInt64Coin(denomA, 10000)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.PoolId)
+	require.Equal(t, sdk.NewInt64Coin(shareDenom, 20000-1000), res.Shares)
+
+	pool, found := app.AmmswapKeeper.GetPool(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin(denomA, 10000), pool.ReserveA)
+	require.Equal(t, sdk.NewInt64Coin(denomB, 40000), pool.ReserveB)
+	require.Equal(t, sdk.NewInt64Coin(shareDenom, 20000), pool.TotalShares)
+	require.Equal(t, res.Shares, app.BankKeeper.GetBalance(ctx, addrs[0], shareDenom))
+
+	// a pool of the same denoms cannot be created twice
+	_, err = msgServer.CreatePool(sdk.WrapSDKContext(ctx),
+		types.NewMsgCreatePool(addrs[1], sdk.NewInt64Coin(denomA, 10000), sdk.NewInt64Coin(denomB, 10000)))
+	require.ErrorIs(t, err, types.ErrPoolExists)
+
+	checkInvariants(t, app, ctx)
+}
+
+func TestAddRemoveLiquidity(t *testing.T) {
+	app, ctx, addrs := setupSwapTest(t, 2)
+	msgServer := keeper.NewMsgServerImpl(app.AmmswapKeeper)
+	createPool(t, msgServer, ctx, addrs[0], 10000, 40000)
+
+	provider := addrs[1]
+	maxTokens := sdk.NewCoins(sdk.NewInt64Coin(denomA, 1000), sdk.NewInt64Coin(denomB, 2000))
+
+	addLiquidity := func(ctx sdk.Context, minShares int64, deadline time.Time) (*types.MsgAddLiquidityResponse, error) {
+		return msgServer.AddLiquidity(sdk.WrapSDKContext(ctx),
+			types.NewMsgAddLiquidity(provider, 1, maxTokens, sdk.NewInt(minShares), deadline))
+	}
+
+	_, err := addLiquidity(ctx, 1001, time.Time{})
+	require.ErrorIs(t, err, types.ErrSlippageExceeded)
+	_, err = addLiquidity(ctx.WithBlockTime(time.Unix(100, 0)), 0, time.Unix(99, 0))
+	require.ErrorIs(t, err, types.ErrDeadlineExceeded)
+
+	// the deposit is limited by the scarcer denom at the ratio of the reserves
+	res, err := addLiquidity(ctx.WithBlockTime(time.Unix(100, 0)), 1000, time.Unix(100, 0))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(shareDenom, 1000), res.Shares)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denomA, 500), sdk.NewInt64Coin(denomB, 2000)), res.Tokens)
+	require.Equal(t, sdk.NewInt(100000-500), app.BankKeeper.GetBalance(ctx, provider, denomA).Amount)
+
+	_, err = msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), types.NewMsgRemoveLiquidity(provider, 1,
+		sdk.NewInt(1000), sdk.NewCoins(sdk.NewInt64Coin(denomA, 501)), time.Time{}))
+	require.ErrorIs(t, err, types.ErrSlippageExceeded)
+	_, err = msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), types.NewMsgRemoveLiquidity(provider, 1,
+		sdk.NewInt(1001), nil, time.Time{}))
+	require.Error(t, err)
+
+	removed, err := msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), types.NewMsgRemoveLiquidity(provider, 1,
+		sdk.NewInt(1000), sdk.NewCoins(sdk.NewInt64Coin(denomA, 500)), time.Time{}))
+	require.NoError(t, err)
+	require.Equal(t, res.Tokens, removed.Tokens)
+	require.True(t, app.BankKeeper.GetBalance(ctx, provider, shareDenom).IsZero())
+
+	pool, _ := app.AmmswapKeeper.GetPool(ctx, 1)
+	require.Equal(t, sdk.NewInt64Coin(shareDenom, 20000), pool.TotalShares)
+	require.Equal(t, sdk.NewInt64Coin(denomA, 10000), pool.ReserveA)
+
+	// the minimum liquidity is never withdrawn
+	_, err = msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), types.NewMsgRemoveLiquidity(addrs[0], 1,
+		sdk.NewInt(20000), nil, time.Time{}))
+	require.ErrorIs(t, err, types.ErrInsufficientShares)
+
+	checkInvariants(t, app, ctx)
+}
+
+func TestRemoveLiquidityFrozen(t *testing.T) {
+	app, ctx, addrs := setupSwapTest(t, 2)
+	msgServer := keeper.NewMsgServerImpl(app.AmmswapKeeper)
+
+	issuer, provider := addrs[0], addrs[1]
+	err := app.TokenKeeper.IssueToken(ctx, "USD Coin", "usdc", "uusdc", 6, 1000, 2000, true, true, false, true, issuer)
+	require.NoError(t, err)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, issuer, provider, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000))))
+
+	res, err := msgServer.CreatePool(sdk.WrapSDKContext(ctx),
+		types.NewMsgCreatePool(provider, sdk.NewInt64Coin("uusdc", 1000), sdk.NewInt64Coin(denomB, 4000)))
+	require.NoError(t, err)
+
+	// the shares of a frozen account cannot withdraw the token it is frozen for
+	require.NoError(t, app.TokenKeeper.FreezeAccount(ctx, "usdc", provider, issuer))
+	_, err = msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), types.NewMsgRemoveLiquidity(provider, res.PoolId,
+		res.Shares.Amount, nil, time.Time{}))
+	require.ErrorIs(t, err, tokentypes.ErrAccountFrozen)
+
+	// nor can any account the shares are sent to
+	shares := sdk.NewCoins(sdk.NewCoin(res.Shares.Denom, res.Shares.Amount.QuoRaw(2)))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, provider, issuer, shares))
+	require.NoError(t, app.TokenKeeper.PauseToken(ctx, "usdc", issuer))
+	_, err = msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), types.NewMsgRemoveLiquidity(issuer, res.PoolId,
+		shares[0].Amount, nil, time.Time{}))
+	require.ErrorIs(t, err, tokentypes.ErrTokenPaused)
+
+	require.NoError(t, app.TokenKeeper.UnpauseToken(ctx, "usdc", issuer))
+	require.NoError(t, app.TokenKeeper.UnfreezeAccount(ctx, "usdc", provider, issuer))
+	_, err = msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), types.NewMsgRemoveLiquidity(provider, res.PoolId,
+		shares[0].Amount, nil, time.Time{}))
+	require.NoError(t, err)
+
+	checkInvariants(t, app, ctx)
+}
+
+func setupSwapTest(t *testing.T, n int) (*simapp.SimApp, sdk.Context, []sdk.AccAddress) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.AmmswapKeeper.SetParams(ctx, types.DefaultParams())
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denomA, 100000), sdk.NewInt64Coin(denomB, 100000))
+	addrs := simapp.AddTestAddrs(app, ctx, n, sdk.ZeroInt())
+	for _, addr := range addrs {
+		require.NoError(t, app.BankKeeper.SetBalances(ctx, addr, coins))
+	}
+
+	return app, ctx, addrs
+}
+
+func createPool(t *testing.T, msgServer types.MsgServer, ctx sdk.Context, creator sdk.AccAddress, amountA, amountB int64) {
+	_, err := msgServer.CreatePool(sdk.WrapSDKContext(ctx),
+		types.NewMsgCreatePool(creator, sdk.NewInt64Coin(denomA, amountA), sdk.NewInt64Coin(denomB, amountB)))
+	require.NoError(t, err)
+}
+
+func checkInvariants(t *testing.T, app *simapp.SimApp, ctx sdk.Context) {
+	msg, broken := keeper.AllInvariants(app.AmmswapKeeper)(ctx)
+	require.False(t, broken, msg)
+}
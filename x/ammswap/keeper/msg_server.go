@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the ammswap MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (*types.MsgCreatePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, shares, err := k.Keeper.CreatePool(ctx, creator, msg.TokenA, msg.TokenB)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreatePool,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokens, pool.GetReserves().String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Creator),
+		),
+	})
+
+	return &types.MsgCreatePoolResponse{PoolId: pool.Id, Shares: shares}, nil
+}
+
+func (k msgServer) AddLiquidity(goCtx context.Context, msg *types.MsgAddLiquidity) (*types.MsgAddLiquidityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkDeadline(ctx, msg.Deadline); err != nil {
+		return nil, err
+	}
+
+	shares, tokens, err := k.Keeper.AddLiquidity(ctx, sender, msg.PoolId, msg.MaxTokens, msg.MinShares)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddLiquidity,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokens, tokens.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgAddLiquidityResponse{Shares: shares, Tokens: tokens}, nil
+}
+
+func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLiquidity) (*types.MsgRemoveLiquidityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkDeadline(ctx, msg.Deadline); err != nil {
+		return nil, err
+	}
+
+	tokens, err := k.Keeper.RemoveLiquidity(ctx, sender, msg.PoolId, msg.Shares, msg.MinTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveLiquidity,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokens, tokens.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, sdk.NewCoin(types.GetShareDenom(msg.PoolId), msg.Shares).String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgRemoveLiquidityResponse{Tokens: tokens}, nil
+}
+
+func (k msgServer) SwapExactIn(goCtx context.Context, msg *types.MsgSwapExactIn) (*types.MsgSwapExactInResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkDeadline(ctx, msg.Deadline); err != nil {
+		return nil, err
+	}
+
+	tokenOut, fee, err := k.Keeper.SwapExactIn(ctx, sender, msg.PoolId, msg.TokenIn, msg.MinTokenOut)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newSwapEvent(msg.PoolId, msg.TokenIn, tokenOut, fee),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSwapExactInResponse{TokenOut: tokenOut}, nil
+}
+
+func (k msgServer) SwapExactOut(goCtx context.Context, msg *types.MsgSwapExactOut) (*types.MsgSwapExactOutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkDeadline(ctx, msg.Deadline); err != nil {
+		return nil, err
+	}
+
+	tokenIn, fee, err := k.Keeper.SwapExactOut(ctx, sender, msg.PoolId, msg.MaxTokenIn, msg.TokenOut)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newSwapEvent(msg.PoolId, tokenIn, msg.TokenOut, fee),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSwapExactOutResponse{TokenIn: tokenIn}, nil
+}
+
+// checkDeadline fails a message executed after its deadline, a zero deadline
+// never passes
+func checkDeadline(ctx sdk.Context, deadline time.Time) error {
+	if !deadline.IsZero() && ctx.BlockHeader().Time.After(deadline) {
+		return sdkerrors.Wrapf(types.ErrDeadlineExceeded, "deadline %s, block time %s",
+			deadline.Format(time.RFC3339), ctx.BlockHeader().Time.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func newSwapEvent(poolID uint64, tokenIn, tokenOut, fee sdk.Coin) sdk.Event {
+	return sdk.NewEvent(
+		types.EventTypeSwap,
+		sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(poolID, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokenIn, tokenIn.String()),
+		sdk.NewAttribute(types.AttributeKeyTokenOut, tokenOut.String()),
+		sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+	)
+}
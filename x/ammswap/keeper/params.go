@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+)
+
+// SwapFeeRate - Rate of the input of a swap kept by the pool
+func (k Keeper) SwapFeeRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeySwapFeeRate, &res)
+	return
+}
+
+// Get all parameteras as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
+}
+
+// set the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
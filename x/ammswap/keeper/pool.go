@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+)
+
+// GetPool returns the pool of the given id
+func (k Keeper) GetPool(ctx sdk.Context, poolID uint64) (pool types.Pool, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetPoolKey(poolID))
+	if value == nil {
+		return pool, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &pool)
+	return pool, true
+}
+
+// SetPool sets the main record holding pool details
+func (k Keeper) SetPool(ctx sdk.Context, pool types.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&pool)
+	store.Set(types.GetPoolKey(pool.Id), bz)
+}
+
+// GetPoolIDByDenoms returns the id of the pool of two denoms
+func (k Keeper) GetPoolIDByDenoms(ctx sdk.Context, denomA, denomB string) (poolID uint64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetPoolByDenomKey(denomA, denomB))
+	if value == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(value), true
+}
+
+// SetPoolByDenoms indexes a pool by its denoms
+func (k Keeper) SetPoolByDenoms(ctx sdk.Context, pool types.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPoolByDenomKey(pool.ReserveA.Denom, pool.ReserveB.Denom), sdk.Uint64ToBigEndian(pool.Id))
+}
+
+// GetNextPoolID returns the id of the next created pool
+func (k Keeper) GetNextPoolID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.NextPoolIDKey)
+	if value == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(value)
+}
+
+// SetNextPoolID sets the id of the next created pool
+func (k Keeper) SetNextPoolID(ctx sdk.Context, poolID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextPoolIDKey, sdk.Uint64ToBigEndian(poolID))
+}
+
+// IteratePools iterates through all of the pools by id
+func (k Keeper) IteratePools(ctx sdk.Context, fn func(index int64, pool types.Pool) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PoolKey)
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		var pool types.Pool
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &pool)
+
+		if stop := fn(i, pool); stop {
+			break
+		}
+		i++
+	}
+}
+
+// GetAllPools returns all the pools, used during genesis dump
+func (k Keeper) GetAllPools(ctx sdk.Context) (pools []types.Pool) {
+	k.IteratePools(ctx, func(_ int64, pool types.Pool) bool {
+		pools = append(pools, pool)
+		return false
+	})
+
+	return pools
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+)
+
+// SimulateSwapExactIn returns the output and the fee of swapping an exact
+// input in a pool
+func (k Keeper) SimulateSwapExactIn(ctx sdk.Context, pool types.Pool, tokenIn sdk.Coin) (tokenOut, fee sdk.Coin, err error) {
+	reserveIn, reserveOut, err := pool.GetSwapReserves(tokenIn.Denom)
+	if err != nil {
+		return tokenOut, fee, err
+	}
+
+	amountOut, feeAmount := types.GetSwapExactInOutput(reserveIn.Amount, reserveOut.Amount, tokenIn.Amount,
+		k.SwapFeeRate(ctx))
+	if !amountOut.IsPositive() {
+		return tokenOut, fee, sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "swap of %s has no output", tokenIn)
+	}
+
+	return sdk.NewCoin(reserveOut.Denom, amountOut), sdk.NewCoin(reserveIn.Denom, feeAmount), nil
+}
+
+// SimulateSwapExactOut returns the input and the fee of swapping for an exact
+// output in a pool
+func (k Keeper) SimulateSwapExactOut(ctx sdk.Context, pool types.Pool, tokenOut sdk.Coin) (tokenIn, fee sdk.Coin, err error) {
+	reserveOut, reserveIn, err := pool.GetSwapReserves(tokenOut.Denom)
+	if err != nil {
+		return tokenIn, fee, err
+	}
+	if !tokenOut.IsPositive() {
+		return tokenIn, fee, sdkerrors.Wrapf(types.ErrInvalidAmount, "invalid output %s", tokenOut)
+	}
+
+	amountIn, feeAmount, err := types.GetSwapExactOutInput(reserveIn.Amount, reserveOut.Amount, tokenOut.Amount,
+		k.SwapFeeRate(ctx))
+	if err != nil {
+		return tokenIn, fee, err
+	}
+
+	return sdk.NewCoin(reserveIn.Denom, amountIn), sdk.NewCoin(reserveIn.Denom, feeAmount), nil
+}
+
+// SwapExactIn swaps an exact input of the sender for at least minTokenOut.
+// The fee is kept in the reserves of the pool.
+func (k Keeper) SwapExactIn(
+	ctx sdk.Context, sender sdk.AccAddress, poolID uint64, tokenIn, minTokenOut sdk.Coin,
+) (tokenOut, fee sdk.Coin, err error) {
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		return tokenOut, fee, sdkerrors.Wrapf(types.ErrNoPoolFound, "pool %d", poolID)
+	}
+
+	tokenOut, fee, err = k.SimulateSwapExactIn(ctx, pool, tokenIn)
+	if err != nil {
+		return tokenOut, fee, err
+	}
+	if tokenOut.Denom != minTokenOut.Denom {
+		return tokenOut, fee, sdkerrors.Wrapf(types.ErrInvalidDenom, "swap of %s outputs %s, not %s",
+			tokenIn, tokenOut.Denom, minTokenOut.Denom)
+	}
+	if tokenOut.IsLT(minTokenOut) {
+		return tokenOut, fee, sdkerrors.Wrapf(types.ErrSlippageExceeded, "output %s < min output %s", tokenOut, minTokenOut)
+	}
+
+	return tokenOut, fee, k.swap(ctx, sender, pool, tokenIn, tokenOut)
+}
+
+// SwapExactOut swaps at most maxTokenIn of the sender for an exact output.
+// The fee is kept in the reserves of the pool.
+func (k Keeper) SwapExactOut(
+	ctx sdk.Context, sender sdk.AccAddress, poolID uint64, maxTokenIn, tokenOut sdk.Coin,
+) (tokenIn, fee sdk.Coin, err error) {
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		return tokenIn, fee, sdkerrors.Wrapf(types.ErrNoPoolFound, "pool %d", poolID)
+	}
+
+	tokenIn, fee, err = k.SimulateSwapExactOut(ctx, pool, tokenOut)
+	if err != nil {
+		return tokenIn, fee, err
+	}
+	if tokenIn.Denom != maxTokenIn.Denom {
+		return tokenIn, fee, sdkerrors.Wrapf(types.ErrInvalidDenom, "swap for %s inputs %s, not %s",
+			tokenOut, tokenIn.Denom, maxTokenIn.Denom)
+	}
+	if maxTokenIn.IsLT(tokenIn) {
+		return tokenIn, fee, sdkerrors.Wrapf(types.ErrSlippageExceeded, "input %s > max input %s", tokenIn, maxTokenIn)
+	}
+
+	return tokenIn, fee, k.swap(ctx, sender, pool, tokenIn, tokenOut)
+}
+
+// swap moves the input of a swap into the pool and its output to the sender
+func (k Keeper) swap(ctx sdk.Context, sender sdk.AccAddress, pool types.Pool, tokenIn, tokenOut sdk.Coin) error {
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(tokenIn)); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(tokenOut)); err != nil {
+		return err
+	}
+
+	pool.AddReserves(sdk.NewCoins(tokenIn))
+	pool.SubReserves(sdk.NewCoins(tokenOut))
+	k.SetPool(ctx, pool)
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/x/ammswap/keeper"
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+)
+
+func TestSwapExactIn(t *testing.T) {
+	app, ctx, addrs := setupSwapTest(t, 2)
+	msgServer := keeper.NewMsgServerImpl(app.AmmswapKeeper)
+	createPool(t, msgServer, ctx, addrs[0], 10000, 40000)
+
+	trader := addrs[1]
+	tokenIn := sdk.NewInt64Coin(denomA, 1000)
+	swap := func(minOut int64) (*types.MsgSwapExactInResponse, error) {
+		return msgServer.SwapExactIn(sdk.WrapSDKContext(ctx),
+			types.NewMsgSwapExactIn(trader, 1, tokenIn, sdk.NewInt64Coin(denomB, minOut), time.Time{}))
+	}
+
+	// fee = ceil(1000 * 0.003) = 3, out = 40000 * 997 / (10000 + 997) = 3626
+	_, err := swap(3627)
+	require.ErrorIs(t, err, types.ErrSlippageExceeded)
+
+	res, err := swap(3626)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denomB, 3626), res.TokenOut)
+	require.Equal(t, sdk.NewInt(100000+3626), app.BankKeeper.GetBalance(ctx, trader, denomB).Amount)
+
+	// the fee stays in the reserves
+	pool, _ := app.AmmswapKeeper.GetPool(ctx, 1)
+	require.Equal(t, sdk.NewInt64Coin(denomA, 11000), pool.ReserveA)
+	require.Equal(t, sdk.NewInt64Coin(denomB, 40000-3626), pool.ReserveB)
+
+	checkInvariants(t, app, ctx)
+}
+
+func TestSwapExactOut(t *testing.T) {
+	app, ctx, addrs := setupSwapTest(t, 2)
+	msgServer := keeper.NewMsgServerImpl(app.AmmswapKeeper)
+	createPool(t, msgServer, ctx, addrs[0], 10000, 40000)
+
+	trader := addrs[1]
+	tokenOut := sdk.NewInt64Coin(denomA, 2000)
+	swap := func(ctx sdk.Context, maxIn int64, deadline time.Time) (*types.MsgSwapExactOutResponse, error) {
+		return msgServer.SwapExactOut(sdk.WrapSDKContext(ctx),
+			types.NewMsgSwapExactOut(trader, 1, sdk.NewInt64Coin(denomB, maxIn), tokenOut, deadline))
+	}
+
+	// in after fee = ceil(40000 * 2000 / 8000) = 10000, in = ceil(10000 / 0.997) = 10031
+	_, err := swap(ctx, 10030, time.Time{})
+	require.ErrorIs(t, err, types.ErrSlippageExceeded)
+	_, err = swap(ctx.WithBlockTime(time.Unix(100, 0)), 10031, time.Unix(50, 0))
+	require.ErrorIs(t, err, types.ErrDeadlineExceeded)
+
+	res, err := swap(ctx, 20000, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denomB, 10031), res.TokenIn)
+	require.Equal(t, sdk.NewInt(100000-10031), app.BankKeeper.GetBalance(ctx, trader, denomB).Amount)
+
+	// the output must be lower than the reserve
+	_, err = msgServer.SwapExactOut(sdk.WrapSDKContext(ctx),
+		types.NewMsgSwapExactOut(trader, 1, sdk.NewInt64Coin(denomB, 100000), sdk.NewInt64Coin(denomA, 8000), time.Time{}))
+	require.ErrorIs(t, err, types.ErrInsufficientLiquidity)
+
+	checkInvariants(t, app, ctx)
+}
+
+func TestGRPCQueries(t *testing.T) {
+	app, ctx, addrs := setupSwapTest(t, 1)
+	msgServer := keeper.NewMsgServerImpl(app.AmmswapKeeper)
+	createPool(t, msgServer, ctx, addrs[0], 10000, 40000)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.Querier{Keeper: app.AmmswapKeeper})
+	queryClient := types.NewQueryClient(queryHelper)
+
+	pools, err := queryClient.Pools(sdk.WrapSDKContext(ctx), &types.QueryPoolsRequest{})
+	require.NoError(t, err)
+	require.Len(t, pools.Pools, 1)
+
+	reserves, err := queryClient.Reserves(sdk.WrapSDKContext(ctx), &types.QueryReservesRequest{PoolId: 1})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denomA, 10000), sdk.NewInt64Coin(denomB, 40000)), reserves.Reserves)
+	require.Equal(t, sdk.NewInt64Coin(shareDenom, 20000), reserves.TotalShares)
+
+	_, err = queryClient.Reserves(sdk.WrapSDKContext(ctx), &types.QueryReservesRequest{PoolId: 2})
+	require.Error(t, err)
+
+	price, err := queryClient.SpotPrice(sdk.WrapSDKContext(ctx), &types.QuerySpotPriceRequest{PoolId: 1, BaseDenom: denomA})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64DecCoin(denomB, 4), price.SpotPrice)
+
+	simIn, err := queryClient.SimulateSwapExactIn(sdk.WrapSDKContext(ctx),
+		&types.QuerySimulateSwapExactInRequest{PoolId: 1, TokenIn: sdk.NewInt64Coin(denomA, 1000)})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denomB, 3626), simIn.TokenOut)
+	require.Equal(t, sdk.NewInt64Coin(denomA, 3), simIn.Fee)
+
+	simOut, err := queryClient.SimulateSwapExactOut(sdk.WrapSDKContext(ctx),
+		&types.QuerySimulateSwapExactOutRequest{PoolId: 1, TokenOut: sdk.NewInt64Coin(denomA, 2000)})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denomB, 10031), simOut.TokenIn)
+	require.Equal(t, sdk.NewInt64Coin(denomB, 31), simOut.Fee)
+}
@@ -0,0 +1,32 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding ammswap type.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.PoolKey):
+			var poolA, poolB types.Pool
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &poolA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &poolB)
+
+			return fmt.Sprintf("%v\n%v", poolA, poolB)
+		case bytes.Equal(kvA.Key[:1], types.PoolByDenomKey),
+			bytes.Equal(kvA.Key[:1], types.NextPoolIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		default:
+			panic(fmt.Sprintf("invalid ammswap key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
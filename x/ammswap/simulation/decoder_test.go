@@ -0,0 +1,57 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/ammswap/simulation"
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+)
+
+var (
+	creatorPk1   = ed25519.GenPrivKey().PubKey()
+	creatorAddr1 = sdk.AccAddress(creatorPk1.Address())
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc, _ := simapp.MakeCodecs()
+	dec := simulation.NewDecodeStore(cdc)
+
+	pool := types.NewPool(1, creatorAddr1, sdk.NewInt64Coin("atoken", 100), sdk.NewInt64Coin("btoken", 200))
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetPoolKey(1), Value: cdc.MustMarshalBinaryBare(&pool)},
+			{Key: types.GetPoolByDenomKey("atoken", "btoken"), Value: sdk.Uint64ToBigEndian(1)},
+			{Key: types.NextPoolIDKey, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"pool", fmt.Sprintf("%v\n%v", pool, pool)},
+		{"poolByDenom", "1\n1"},
+		{"nextPoolID", "2\n2"},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+)
+
+// Simulation parameter constants
+const (
+	swapFeeRate = "swap_fee_rate"
+)
+
+// GenSwapFeeRate randomized swapFeeRate, up to 1%
+func GenSwapFeeRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 4)
+}
+
+// RandomizedGenState generates a random GenesisState for ammswap
+func RandomizedGenState(simState *module.SimulationState) {
+	// params
+	var swapFeeRateL sdk.Dec
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, swapFeeRate, &swapFeeRateL, simState.Rand,
+		func(r *rand.Rand) { swapFeeRateL = GenSwapFeeRate(r) },
+	)
+
+	params := types.NewParams(swapFeeRateL)
+
+	ammswapGenesis := types.NewGenesisState(params, []types.Pool{}, 1)
+
+	bz, err := json.MarshalIndent(&ammswapGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated ammswap parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(ammswapGenesis)
+}
@@ -0,0 +1,353 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	gaussimappparams "github.com/gauss/gauss/v4/simapp/params"
+	"github.com/gauss/gauss/v4/x/ammswap/keeper"
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreatePool      = "op_weight_msg_create_swap_pool"
+	OpWeightMsgAddLiquidity    = "op_weight_msg_add_liquidity"
+	OpWeightMsgRemoveLiquidity = "op_weight_msg_remove_liquidity"
+	OpWeightMsgSwapExactIn     = "op_weight_msg_swap_exact_in"
+	OpWeightMsgSwapExactOut    = "op_weight_msg_swap_exact_out"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler, ak types.AccountKeeper,
+	bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreatePool      int
+		weightMsgAddLiquidity    int
+		weightMsgRemoveLiquidity int
+		weightMsgSwapExactIn     int
+		weightMsgSwapExactOut    int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreatePool, &weightMsgCreatePool, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreatePool = gaussimappparams.DefaultWeightMsgCreateSwapPool
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAddLiquidity, &weightMsgAddLiquidity, nil,
+		func(_ *rand.Rand) {
+			weightMsgAddLiquidity = gaussimappparams.DefaultWeightMsgAddLiquidity
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRemoveLiquidity, &weightMsgRemoveLiquidity, nil,
+		func(_ *rand.Rand) {
+			weightMsgRemoveLiquidity = gaussimappparams.DefaultWeightMsgRemoveLiquidity
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSwapExactIn, &weightMsgSwapExactIn, nil,
+		func(_ *rand.Rand) {
+			weightMsgSwapExactIn = gaussimappparams.DefaultWeightMsgSwapExactIn
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSwapExactOut, &weightMsgSwapExactOut, nil,
+		func(_ *rand.Rand) {
+			weightMsgSwapExactOut = gaussimappparams.DefaultWeightMsgSwapExactOut
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreatePool,
+			SimulateMsgCreatePool(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAddLiquidity,
+			SimulateMsgAddLiquidity(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRemoveLiquidity,
+			SimulateMsgRemoveLiquidity(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSwapExactIn,
+			SimulateMsgSwapExactIn(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSwapExactOut,
+			SimulateMsgSwapExactOut(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreatePool generates a MsgCreatePool with random values
+func SimulateMsgCreatePool(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		var spendable sdk.Coins
+		for _, coin := range bk.SpendableCoins(ctx, simAccount.Address) {
+			if !types.IsShareDenom(coin.Denom) {
+				spendable = append(spendable, coin)
+			}
+		}
+		if len(spendable) < 2 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePool, "no two denoms to pool"), nil, nil
+		}
+
+		perm := r.Perm(len(spendable))
+		coinA, coinB := spendable[perm[0]], spendable[perm[1]]
+		if _, found := k.GetPoolIDByDenoms(ctx, coinA.Denom, coinB.Denom); found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePool, "pool already exists"), nil, nil
+		}
+
+		amountA, err := simtypes.RandPositiveInt(r, coinA.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePool, "unable to generate positive amount"), nil, err
+		}
+		amountB, err := simtypes.RandPositiveInt(r, coinB.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePool, "unable to generate positive amount"), nil, err
+		}
+
+		if types.GetInitialShares(amountA, amountB).LTE(types.MinimumLiquidity) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePool, "insufficient initial liquidity"), nil, nil
+		}
+
+		msg := types.NewMsgCreatePool(simAccount.Address, sdk.NewCoin(coinA.Denom, amountA), sdk.NewCoin(coinB.Denom, amountB))
+
+		return deliverMsg(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(msg.TokenA, msg.TokenB), chainID)
+	}
+}
+
+// SimulateMsgAddLiquidity generates a MsgAddLiquidity with random values
+func SimulateMsgAddLiquidity(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		pool, ok := randomPool(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddLiquidity, "number of pools equal zero"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+
+		maxTokens := sdk.NewCoins()
+		for _, denom := range []string{pool.ReserveA.Denom, pool.ReserveB.Denom} {
+			balance := spendable.AmountOf(denom)
+			if !balance.IsPositive() {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddLiquidity, "insufficient balance"), nil, nil
+			}
+
+			amount, err := simtypes.RandPositiveInt(r, balance)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddLiquidity, "unable to generate positive amount"), nil, err
+			}
+			maxTokens = maxTokens.Add(sdk.NewCoin(denom, amount))
+		}
+
+		shares, tokens := pool.GetDepositShares(maxTokens)
+		if !shares.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddLiquidity, "deposit mints no shares"), nil, nil
+		}
+
+		msg := types.NewMsgAddLiquidity(simAccount.Address, pool.Id, maxTokens, shares, randDeadline(r, ctx))
+
+		return deliverMsg(r, app, ctx, ak, bk, simAccount, msg, tokens, chainID)
+	}
+}
+
+// SimulateMsgRemoveLiquidity generates a MsgRemoveLiquidity with random values
+func SimulateMsgRemoveLiquidity(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		pool, ok := randomPool(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveLiquidity, "number of pools equal zero"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		balance := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(pool.TotalShares.Denom)
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveLiquidity, "no shares of the pool"), nil, nil
+		}
+
+		shares, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveLiquidity, "unable to generate positive amount"), nil, err
+		}
+
+		tokens := pool.GetWithdrawnTokens(shares)
+		if tokens.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveLiquidity, "shares withdraw no tokens"), nil, nil
+		}
+
+		msg := types.NewMsgRemoveLiquidity(simAccount.Address, pool.Id, shares, tokens, randDeadline(r, ctx))
+
+		return deliverMsg(r, app, ctx, ak, bk, simAccount, msg,
+			sdk.NewCoins(sdk.NewCoin(pool.TotalShares.Denom, shares)), chainID)
+	}
+}
+
+// SimulateMsgSwapExactIn generates a MsgSwapExactIn with random values
+func SimulateMsgSwapExactIn(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		pool, ok := randomPool(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSwapExactIn, "number of pools equal zero"), nil, nil
+		}
+
+		denomIn, _ := randomSwapDenoms(r, pool)
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		balance := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(denomIn)
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSwapExactIn, "insufficient balance"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSwapExactIn, "unable to generate positive amount"), nil, err
+		}
+
+		tokenIn := sdk.NewCoin(denomIn, amount)
+		tokenOut, _, err := k.SimulateSwapExactIn(ctx, pool, tokenIn)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSwapExactIn, "swap has no output"), nil, nil
+		}
+
+		msg := types.NewMsgSwapExactIn(simAccount.Address, pool.Id, tokenIn, tokenOut, randDeadline(r, ctx))
+
+		return deliverMsg(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(tokenIn), chainID)
+	}
+}
+
+// SimulateMsgSwapExactOut generates a MsgSwapExactOut with random values
+func SimulateMsgSwapExactOut(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		pool, ok := randomPool(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSwapExactOut, "number of pools equal zero"), nil, nil
+		}
+
+		denomIn, denomOut := randomSwapDenoms(r, pool)
+		_, reserveOut, _ := pool.GetSwapReserves(denomIn)
+
+		// the output is always lower than the reserve
+		amount, err := simtypes.RandPositiveInt(r, reserveOut.Amount.QuoRaw(2).AddRaw(1))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSwapExactOut, "unable to generate positive amount"), nil, err
+		}
+
+		tokenOut := sdk.NewCoin(denomOut, amount)
+		tokenIn, _, err := k.SimulateSwapExactOut(ctx, pool, tokenOut)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSwapExactOut, "swap has no input"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		if bk.SpendableCoins(ctx, simAccount.Address).AmountOf(denomIn).LT(tokenIn.Amount) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSwapExactOut, "insufficient balance"), nil, nil
+		}
+
+		msg := types.NewMsgSwapExactOut(simAccount.Address, pool.Id, tokenIn, tokenOut, randDeadline(r, ctx))
+
+		return deliverMsg(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(tokenIn), chainID)
+	}
+}
+
+// randomPool returns a random pool, false if there is none
+func randomPool(r *rand.Rand, k keeper.Keeper, ctx sdk.Context) (types.Pool, bool) {
+	pools := k.GetAllPools(ctx)
+	if len(pools) == 0 {
+		return types.Pool{}, false
+	}
+
+	return pools[r.Intn(len(pools))], true
+}
+
+// randomSwapDenoms returns the input and the output denoms of a swap in either
+// direction of a pool
+func randomSwapDenoms(r *rand.Rand, pool types.Pool) (denomIn, denomOut string) {
+	if r.Intn(2) == 0 {
+		return pool.ReserveA.Denom, pool.ReserveB.Denom
+	}
+
+	return pool.ReserveB.Denom, pool.ReserveA.Denom
+}
+
+// randDeadline returns either no deadline or a deadline the tx cannot miss
+func randDeadline(r *rand.Rand, ctx sdk.Context) time.Time {
+	if r.Intn(2) == 0 {
+		return time.Time{}
+	}
+
+	return ctx.BlockHeader().Time.Add(time.Duration(r.Intn(3600)+1) * time.Second)
+}
+
+func deliverMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	simAccount simtypes.Account, msg sdk.Msg, spent sdk.Coins, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := ak.GetAccount(ctx, simAccount.Address)
+	spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+	var (
+		fees sdk.Coins
+		err  error
+	)
+
+	coins, hasNeg := spendable.SafeSub(spent)
+	if hasNeg {
+		coins = nil
+	}
+
+	if !coins.Empty() {
+		fees, err = simtypes.RandomFees(r, ctx, coins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
@@ -0,0 +1,25 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/gauss/gauss/v4/x/ammswap/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySwapFeeRate),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenSwapFeeRate(r))
+			},
+		),
+	}
+}
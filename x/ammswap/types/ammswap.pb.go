@@ -0,0 +1,756 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gauss/ammswap/ammswap.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Pool defines a constant product pool of two denoms, whose liquidity is
+// represented by the pool share coins of its total_shares denom.
+type Pool struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// reserve of the denom sorted first
+	ReserveA types.Coin `protobuf:"bytes,3,opt,name=reserve_a,json=reserveA,proto3" json:"reserve_a" yaml:"reserve_a"`
+	// reserve of the denom sorted second
+	ReserveB    types.Coin `protobuf:"bytes,4,opt,name=reserve_b,json=reserveB,proto3" json:"reserve_b" yaml:"reserve_b"`
+	TotalShares types.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+}
+
+func (m *Pool) Reset()      { *m = Pool{} }
+func (*Pool) ProtoMessage() {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_250d1fd00c8840a2, []int{0}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func (m *Pool) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Pool) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Pool) GetReserveA() types.Coin {
+	if m != nil {
+		return m.ReserveA
+	}
+	return types.Coin{}
+}
+
+func (m *Pool) GetReserveB() types.Coin {
+	if m != nil {
+		return m.ReserveB
+	}
+	return types.Coin{}
+}
+
+func (m *Pool) GetTotalShares() types.Coin {
+	if m != nil {
+		return m.TotalShares
+	}
+	return types.Coin{}
+}
+
+// Params defines the parameters for the ammswap module.
+type Params struct {
+	// rate of the swapped input kept by the pool as a fee for its liquidity providers
+	SwapFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee_rate,json=swapFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee_rate" yaml:"swap_fee_rate"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_250d1fd00c8840a2, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Pool)(nil), "gauss.ammswap.Pool")
+	proto.RegisterType((*Params)(nil), "gauss.ammswap.Params")
+}
+
+func init() { proto.RegisterFile("gauss/ammswap/ammswap.proto", fileDescriptor_250d1fd00c8840a2) }
+
+var fileDescriptor_250d1fd00c8840a2 = []byte{
+	// 391 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xbf, 0x8e, 0xda, 0x40,
+	0x10, 0xc6, 0xbd, 0x0e, 0x21, 0xb0, 0x84, 0x28, 0x72, 0x28, 0x1c, 0x90, 0x6c, 0xe4, 0x22, 0x22,
+	0x45, 0x76, 0x45, 0x92, 0x8a, 0x2e, 0x26, 0xa2, 0x46, 0x4e, 0x95, 0x34, 0xd6, 0xda, 0x6c, 0x8c,
+	0x13, 0xcc, 0xa2, 0xdd, 0x85, 0x84, 0xe6, 0x9e, 0xe1, 0xca, 0x2b, 0x79, 0x8d, 0x7b, 0x03, 0x4a,
+	0xca, 0xd3, 0x15, 0xd6, 0x09, 0x9a, 0xab, 0x79, 0x82, 0x93, 0xbd, 0xe6, 0xcf, 0x55, 0x27, 0x5d,
+	0xb3, 0x33, 0xdf, 0xce, 0xce, 0xef, 0x93, 0x76, 0x06, 0xb6, 0x22, 0x32, 0x17, 0x02, 0x93, 0x24,
+	0x11, 0xff, 0xc8, 0xec, 0x10, 0xd1, 0x8c, 0x33, 0xc9, 0x8c, 0x7a, 0x5e, 0x44, 0xc5, 0x65, 0xb3,
+	0x11, 0xb1, 0x88, 0xe5, 0x15, 0x9c, 0x65, 0xea, 0x51, 0xd3, 0x0a, 0x99, 0x48, 0x98, 0xc0, 0x01,
+	0x11, 0x14, 0x2f, 0xba, 0x01, 0x95, 0xa4, 0x8b, 0x43, 0x16, 0x4f, 0x55, 0xdd, 0xb9, 0xd6, 0x61,
+	0x69, 0xc8, 0xd8, 0xc4, 0x78, 0x03, 0xf5, 0x78, 0x64, 0x82, 0x36, 0xe8, 0x94, 0x3c, 0x3d, 0x1e,
+	0x19, 0x26, 0x7c, 0x15, 0x72, 0x4a, 0x24, 0xe3, 0xa6, 0xde, 0x06, 0x9d, 0xaa, 0x77, 0x90, 0xc6,
+	0x10, 0x56, 0x39, 0x15, 0x94, 0x2f, 0xa8, 0x4f, 0xcc, 0x17, 0x6d, 0xd0, 0xa9, 0x7d, 0x7e, 0x8f,
+	0x94, 0x0d, 0xca, 0x6c, 0x50, 0x61, 0x83, 0xfa, 0x2c, 0x9e, 0xba, 0xe6, 0x3a, 0xb5, 0xb5, 0x7d,
+	0x6a, 0xbf, 0x5d, 0x92, 0x64, 0xd2, 0x73, 0x8e, 0x9d, 0x8e, 0x57, 0x29, 0xf2, 0x6f, 0xe7, 0xc4,
+	0xc0, 0x2c, 0x3d, 0x93, 0x18, 0x9c, 0x88, 0xae, 0xf1, 0x13, 0xbe, 0x96, 0x4c, 0x92, 0x89, 0x2f,
+	0xc6, 0x84, 0x53, 0x61, 0xbe, 0x7c, 0x0a, 0xda, 0x2a, 0xa0, 0xef, 0x14, 0xf4, 0xbc, 0xd9, 0xf1,
+	0x6a, 0xb9, 0xfc, 0x91, 0xab, 0x5e, 0xe5, 0x6a, 0x65, 0x6b, 0xf7, 0x2b, 0x1b, 0x38, 0x17, 0xb0,
+	0x3c, 0x24, 0x9c, 0x24, 0xc2, 0xf8, 0x03, 0xeb, 0xd9, 0x0c, 0xfc, 0xdf, 0x94, 0xfa, 0x9c, 0x48,
+	0x9a, 0xff, 0x63, 0xd5, 0x1d, 0x64, 0xd0, 0xdb, 0xd4, 0xfe, 0x10, 0xc5, 0x72, 0x3c, 0x0f, 0x50,
+	0xc8, 0x12, 0x5c, 0xcc, 0x43, 0x85, 0x4f, 0x62, 0xf4, 0x17, 0xcb, 0xe5, 0x8c, 0x0a, 0xf4, 0x9d,
+	0x86, 0xfb, 0xd4, 0x6e, 0x28, 0xfb, 0x47, 0x30, 0xc7, 0xab, 0x65, 0x7a, 0x40, 0xa9, 0x47, 0x24,
+	0x3d, 0xf9, 0xbb, 0xfd, 0xf5, 0xd6, 0x02, 0x9b, 0xad, 0x05, 0xee, 0xb6, 0x16, 0xb8, 0xdc, 0x59,
+	0xda, 0x66, 0x67, 0x69, 0x37, 0x3b, 0x4b, 0xfb, 0xf5, 0xf1, 0xcc, 0x50, 0xad, 0x90, 0x3a, 0x17,
+	0x5f, 0xf1, 0xff, 0xe3, 0x36, 0xe5, 0xbe, 0x41, 0x39, 0xdf, 0x83, 0x2f, 0x0f, 0x03, 0x00, 0x32,
+	0x2b, 0xc3, 0xf8, 0x6b, 0x02, 0x00, 0x00,
+}
+
+func (this *Pool) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Pool)
+	if !ok {
+		that2, ok := that.(Pool)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if !this.ReserveA.Equal(&that1.ReserveA) {
+		return false
+	}
+	if !this.ReserveB.Equal(&that1.ReserveB) {
+		return false
+	}
+	if !this.TotalShares.Equal(&that1.TotalShares) {
+		return false
+	}
+	return true
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SwapFeeRate.Equal(that1.SwapFeeRate) {
+		return false
+	}
+	return true
+}
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAmmswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.ReserveB.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAmmswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ReserveA.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAmmswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintAmmswap(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAmmswap(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SwapFeeRate.Size()
+		i -= size
+		if _, err := m.SwapFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAmmswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAmmswap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAmmswap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAmmswap(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovAmmswap(uint64(l))
+	}
+	l = m.ReserveA.Size()
+	n += 1 + l + sovAmmswap(uint64(l))
+	l = m.ReserveB.Size()
+	n += 1 + l + sovAmmswap(uint64(l))
+	l = m.TotalShares.Size()
+	n += 1 + l + sovAmmswap(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapFeeRate.Size()
+	n += 1 + l + sovAmmswap(uint64(l))
+	return n
+}
+
+func sovAmmswap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAmmswap(x uint64) (n int) {
+	return sovAmmswap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmmswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmmswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmmswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmmswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmmswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmmswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmmswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmmswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmmswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmmswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmmswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmmswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmmswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmmswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmmswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmmswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmmswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmmswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmmswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmmswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmmswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmmswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAmmswap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAmmswap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAmmswap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAmmswap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAmmswap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAmmswap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAmmswap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAmmswap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAmmswap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAmmswap = fmt.Errorf("proto: unexpected end of group")
+)
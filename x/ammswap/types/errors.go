@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/ammswap module sentinel errors
+var (
+	ErrInvalidDenom          = sdkerrors.Register(ModuleName, 2, "invalid denom")
+	ErrInvalidAmount         = sdkerrors.Register(ModuleName, 3, "invalid amount")
+	ErrPoolExists            = sdkerrors.Register(ModuleName, 4, "pool already exists")
+	ErrNoPoolFound           = sdkerrors.Register(ModuleName, 5, "pool does not exist")
+	ErrInsufficientLiquidity = sdkerrors.Register(ModuleName, 6, "insufficient liquidity")
+	ErrInsufficientShares    = sdkerrors.Register(ModuleName, 7, "insufficient pool shares")
+	ErrSlippageExceeded      = sdkerrors.Register(ModuleName, 8, "slippage limit exceeded")
+	ErrDeadlineExceeded      = sdkerrors.Register(ModuleName, 9, "deadline exceeded")
+)
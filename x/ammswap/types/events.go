@@ -0,0 +1,17 @@
+package types
+
+const (
+	AttributeValueCategory = ModuleName
+
+	EventTypeCreatePool      = "create_pool"
+	EventTypeAddLiquidity    = "add_liquidity"
+	EventTypeRemoveLiquidity = "remove_liquidity"
+	EventTypeSwap            = "swap"
+
+	AttributeKeyPoolID   = "pool_id"
+	AttributeKeyShares   = "shares"
+	AttributeKeyTokens   = "tokens"
+	AttributeKeyTokenIn  = "token_in"
+	AttributeKeyTokenOut = "token_out"
+	AttributeKeyFee      = "fee"
+)
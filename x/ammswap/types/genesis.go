@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, pools []Pool, nextPoolID uint64) *GenesisState {
+	return &GenesisState{
+		Params:     params,
+		Pools:      pools,
+		NextPoolId: nextPoolID,
+	}
+}
+
+// DefaultGenesisState returns a default ammswap module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []Pool{}, 1)
+}
+
+// Validate performs basic validation of the ammswap genesis state
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	ids := make(map[uint64]bool)
+	denomPairs := make(map[string]bool)
+	for _, pool := range gs.Pools {
+		if err := pool.Validate(); err != nil {
+			return err
+		}
+
+		if pool.Id >= gs.NextPoolId {
+			return fmt.Errorf("pool id %d is not lower than the next pool id %d", pool.Id, gs.NextPoolId)
+		}
+		if ids[pool.Id] {
+			return fmt.Errorf("duplicate pool id %d", pool.Id)
+		}
+		if denomPair := pool.GetDenomPair(); denomPairs[denomPair] {
+			return fmt.Errorf("duplicate pool of %s", denomPair)
+		}
+
+		ids[pool.Id] = true
+		denomPairs[pool.GetDenomPair()] = true
+	}
+
+	return nil
+}
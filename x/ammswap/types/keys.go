@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "ammswap"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace default name for parameter store
+	DefaultParamspace = ModuleName
+
+	// ShareDenomPrefix prefixes the denoms of the pool shares, it is a keyword
+	// no token symbol may start with
+	ShareDenomPrefix = "swap/pool"
+
+	// DenomPairSeparator separates the denoms of a pool in its denom pair
+	DenomPairSeparator = "/"
+)
+
+var (
+	PoolKey        = []byte{0x11} // prefix for each key to a pool
+	PoolByDenomKey = []byte{0x12} // prefix for each key to the id of the pool of a denom pair
+	NextPoolIDKey  = []byte{0x13} // key to the id of the next created pool
+)
+
+// GetPoolKey returns the key of a pool
+// VALUE: ammswap/Pool
+func GetPoolKey(poolID uint64) []byte {
+	return append(PoolKey, sdk.Uint64ToBigEndian(poolID)...)
+}
+
+// GetPoolByDenomKey returns the key of the id of the pool of two denoms
+// VALUE: uint64 pool id
+func GetPoolByDenomKey(denomA, denomB string) []byte {
+	return append(PoolByDenomKey, []byte(GetDenomPair(denomA, denomB))...)
+}
+
+// GetDenomPair returns the denom pair of two denoms, sorted, e.g. ugauss/uusdg
+func GetDenomPair(denomA, denomB string) string {
+	if denomA > denomB {
+		denomA, denomB = denomB, denomA
+	}
+	return denomA + DenomPairSeparator + denomB
+}
+
+// GetShareDenom returns the denom of the shares of a pool
+func GetShareDenom(poolID uint64) string {
+	return fmt.Sprintf("%s%d", ShareDenomPrefix, poolID)
+}
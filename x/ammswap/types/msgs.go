@@ -0,0 +1,260 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgCreatePool      = "create_pool"
+	TypeMsgAddLiquidity    = "add_liquidity"
+	TypeMsgRemoveLiquidity = "remove_liquidity"
+	TypeMsgSwapExactIn     = "swap_exact_in"
+	TypeMsgSwapExactOut    = "swap_exact_out"
+)
+
+var (
+	_ sdk.Msg = &MsgCreatePool{}
+	_ sdk.Msg = &MsgAddLiquidity{}
+	_ sdk.Msg = &MsgRemoveLiquidity{}
+	_ sdk.Msg = &MsgSwapExactIn{}
+	_ sdk.Msg = &MsgSwapExactOut{}
+)
+
+// NewMsgCreatePool creates a new MsgCreatePool instance.
+func NewMsgCreatePool(creator sdk.AccAddress, tokenA, tokenB sdk.Coin) *MsgCreatePool {
+	return &MsgCreatePool{
+		Creator: creator.String(),
+		TokenA:  tokenA,
+		TokenB:  tokenB,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreatePool) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreatePool) Type() string { return TypeMsgCreatePool }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreatePool) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Creator)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCreatePool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreatePool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return ValidateReserves(msg.TokenA, msg.TokenB)
+}
+
+// NewMsgAddLiquidity creates a new MsgAddLiquidity instance.
+func NewMsgAddLiquidity(
+	sender sdk.AccAddress, poolID uint64, maxTokens sdk.Coins, minShares sdk.Int, deadline time.Time,
+) *MsgAddLiquidity {
+	return &MsgAddLiquidity{
+		Sender:    sender.String(),
+		PoolId:    poolID,
+		MaxTokens: maxTokens,
+		MinShares: minShares,
+		Deadline:  deadline,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgAddLiquidity) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgAddLiquidity) Type() string { return TypeMsgAddLiquidity }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAddLiquidity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Sender)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgAddLiquidity) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAddLiquidity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(ErrNoPoolFound, "pool id cannot be zero")
+	}
+	if !msg.MaxTokens.IsValid() || len(msg.MaxTokens) != 2 {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "max tokens %s must be two positive coins", msg.MaxTokens)
+	}
+	if msg.MinShares.IsNil() || msg.MinShares.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid min shares %s", msg.MinShares)
+	}
+
+	return nil
+}
+
+// NewMsgRemoveLiquidity creates a new MsgRemoveLiquidity instance.
+func NewMsgRemoveLiquidity(
+	sender sdk.AccAddress, poolID uint64, shares sdk.Int, minTokens sdk.Coins, deadline time.Time,
+) *MsgRemoveLiquidity {
+	return &MsgRemoveLiquidity{
+		Sender:    sender.String(),
+		PoolId:    poolID,
+		Shares:    shares,
+		MinTokens: minTokens,
+		Deadline:  deadline,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRemoveLiquidity) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRemoveLiquidity) Type() string { return TypeMsgRemoveLiquidity }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRemoveLiquidity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Sender)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRemoveLiquidity) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRemoveLiquidity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(ErrNoPoolFound, "pool id cannot be zero")
+	}
+	if msg.Shares.IsNil() || !msg.Shares.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid shares %s", msg.Shares)
+	}
+	if !msg.MinTokens.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid min tokens %s", msg.MinTokens)
+	}
+
+	return nil
+}
+
+// NewMsgSwapExactIn creates a new MsgSwapExactIn instance.
+func NewMsgSwapExactIn(
+	sender sdk.AccAddress, poolID uint64, tokenIn, minTokenOut sdk.Coin, deadline time.Time,
+) *MsgSwapExactIn {
+	return &MsgSwapExactIn{
+		Sender:      sender.String(),
+		PoolId:      poolID,
+		TokenIn:     tokenIn,
+		MinTokenOut: minTokenOut,
+		Deadline:    deadline,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSwapExactIn) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSwapExactIn) Type() string { return TypeMsgSwapExactIn }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSwapExactIn) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Sender)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgSwapExactIn) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSwapExactIn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(ErrNoPoolFound, "pool id cannot be zero")
+	}
+
+	return validateSwapTokens(msg.TokenIn, msg.MinTokenOut, msg.TokenIn)
+}
+
+// NewMsgSwapExactOut creates a new MsgSwapExactOut instance.
+func NewMsgSwapExactOut(
+	sender sdk.AccAddress, poolID uint64, maxTokenIn, tokenOut sdk.Coin, deadline time.Time,
+) *MsgSwapExactOut {
+	return &MsgSwapExactOut{
+		Sender:     sender.String(),
+		PoolId:     poolID,
+		MaxTokenIn: maxTokenIn,
+		TokenOut:   tokenOut,
+		Deadline:   deadline,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSwapExactOut) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSwapExactOut) Type() string { return TypeMsgSwapExactOut }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSwapExactOut) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Sender)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgSwapExactOut) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSwapExactOut) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(ErrNoPoolFound, "pool id cannot be zero")
+	}
+
+	return validateSwapTokens(msg.MaxTokenIn, msg.TokenOut, msg.TokenOut)
+}
+
+// validateSwapTokens checks the input and output of a swap, the exact one of
+// which must be positive while the limit may be zero
+func validateSwapTokens(tokenIn, tokenOut, exact sdk.Coin) error {
+	if !tokenIn.IsValid() || !tokenOut.IsValid() || exact.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid swap of %s for %s", tokenIn, tokenOut)
+	}
+	if tokenIn.Denom == tokenOut.Denom {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "cannot swap %s for itself", tokenIn.Denom)
+	}
+
+	return nil
+}
+
+func mustAccAddress(address string) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
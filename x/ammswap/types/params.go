@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	// DefaultSwapFeeRate is the default rate of the swap fee, 0.3%
+	DefaultSwapFeeRate = sdk.NewDecWithPrec(3, 3)
+)
+
+var (
+	KeySwapFeeRate = []byte("SwapFeeRate")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable for ammswap module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the ammswap module
+func NewParams(swapFeeRate sdk.Dec) Params {
+	return Params{
+		SwapFeeRate: swapFeeRate,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the ammswap module
+func DefaultParams() Params {
+	return NewParams(DefaultSwapFeeRate)
+}
+
+// Validate all ammswap module parameters
+func (p Params) Validate() error {
+	return validateSwapFeeRate(p.SwapFeeRate)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeySwapFeeRate, &p.SwapFeeRate, validateSwapFeeRate),
+	}
+}
+
+func validateSwapFeeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// the whole input cannot be kept as a fee
+	if v.IsNil() || v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("swap fee rate should be between [0, 1): %s", v)
+	}
+
+	return nil
+}
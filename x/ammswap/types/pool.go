@@ -0,0 +1,111 @@
+package types
+
+import (
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewPool creates a new pool object of two denoms without any shares
+func NewPool(id uint64, creator sdk.AccAddress, reserveA, reserveB sdk.Coin) Pool {
+	if reserveA.Denom > reserveB.Denom {
+		reserveA, reserveB = reserveB, reserveA
+	}
+
+	return Pool{
+		Id:          id,
+		Creator:     creator.String(),
+		ReserveA:    reserveA,
+		ReserveB:    reserveB,
+		TotalShares: sdk.NewCoin(GetShareDenom(id), sdk.ZeroInt()),
+	}
+}
+
+// GetDenomPair returns the sorted denom pair of the pool, e.g. ugauss/uusdg
+func (p Pool) GetDenomPair() string {
+	return GetDenomPair(p.ReserveA.Denom, p.ReserveB.Denom)
+}
+
+// GetReserves returns the reserves of the pool
+func (p Pool) GetReserves() sdk.Coins {
+	return sdk.NewCoins(p.ReserveA, p.ReserveB)
+}
+
+// HasDenom returns true if the denom is one of the two denoms of the pool
+func (p Pool) HasDenom(denom string) bool {
+	return denom == p.ReserveA.Denom || denom == p.ReserveB.Denom
+}
+
+// GetSwapReserves returns the reserve of the given input denom and the reserve
+// of the other denom of the pool
+func (p Pool) GetSwapReserves(denomIn string) (reserveIn, reserveOut sdk.Coin, err error) {
+	switch denomIn {
+	case p.ReserveA.Denom:
+		return p.ReserveA, p.ReserveB, nil
+	case p.ReserveB.Denom:
+		return p.ReserveB, p.ReserveA, nil
+	default:
+		return reserveIn, reserveOut, sdkerrors.Wrapf(ErrInvalidDenom, "%s is not a denom of pool %d", denomIn, p.Id)
+	}
+}
+
+// AddReserves adds coins of the denoms of the pool to its reserves
+func (p *Pool) AddReserves(coins sdk.Coins) {
+	p.ReserveA.Amount = p.ReserveA.Amount.Add(coins.AmountOf(p.ReserveA.Denom))
+	p.ReserveB.Amount = p.ReserveB.Amount.Add(coins.AmountOf(p.ReserveB.Denom))
+}
+
+// SubReserves subtracts coins of the denoms of the pool from its reserves
+func (p *Pool) SubReserves(coins sdk.Coins) {
+	p.ReserveA.Amount = p.ReserveA.Amount.Sub(coins.AmountOf(p.ReserveA.Denom))
+	p.ReserveB.Amount = p.ReserveB.Amount.Sub(coins.AmountOf(p.ReserveB.Denom))
+}
+
+// Validate performs a stateless validation of the pool
+func (p Pool) Validate() error {
+	if p.Id == 0 {
+		return sdkerrors.Wrap(ErrNoPoolFound, "pool id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidateReserves(p.ReserveA, p.ReserveB); err != nil {
+		return err
+	}
+	if p.ReserveA.Denom > p.ReserveB.Denom {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "reserves of pool %d are not sorted", p.Id)
+	}
+	if p.TotalShares.Denom != GetShareDenom(p.Id) {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "shares %s of pool %d", p.TotalShares.Denom, p.Id)
+	}
+	if !p.TotalShares.IsValid() || p.TotalShares.Amount.LT(MinimumLiquidity) {
+		return sdkerrors.Wrapf(ErrInsufficientShares, "pool %d has %s shares", p.Id, p.TotalShares)
+	}
+
+	return nil
+}
+
+// ValidateReserves checks that two coins can be the reserves of a pool
+func ValidateReserves(tokenA, tokenB sdk.Coin) error {
+	for _, token := range []sdk.Coin{tokenA, tokenB} {
+		if !token.IsValid() || token.IsZero() {
+			return sdkerrors.Wrapf(ErrInvalidAmount, "invalid reserve %s", token)
+		}
+		if IsShareDenom(token.Denom) {
+			return sdkerrors.Wrapf(ErrInvalidDenom, "pool shares %s cannot be a reserve", token.Denom)
+		}
+	}
+
+	if tokenA.Denom == tokenB.Denom {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "reserves have the same denom %s", tokenA.Denom)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Pool) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MinimumLiquidity is the amount of shares locked in the module account when a
+// pool is created, so that the reserves of the pool can never be drained
+var MinimumLiquidity = sdk.NewInt(1000)
+
+// IsShareDenom returns true if the denom is the denom of the shares of a pool
+func IsShareDenom(denom string) bool {
+	return strings.HasPrefix(denom, ShareDenomPrefix)
+}
+
+// GetInitialShares returns the shares of the initial liquidity of a pool, the
+// geometric mean of its reserves
+func GetInitialShares(amountA, amountB sdk.Int) sdk.Int {
+	product := amountA.Mul(amountB).BigInt()
+	return sdk.NewIntFromBigInt(product.Sqrt(product))
+}
+
+// GetDepositShares returns the shares minted for depositing at most maxTokens
+// into the pool, and the tokens actually deposited at the ratio of the reserves.
+// The deposited tokens are rounded up in favor of the pool.
+func (p Pool) GetDepositShares(maxTokens sdk.Coins) (shares sdk.Int, tokens sdk.Coins) {
+	totalShares := p.TotalShares.Amount
+
+	sharesA := maxTokens.AmountOf(p.ReserveA.Denom).Mul(totalShares).Quo(p.ReserveA.Amount)
+	sharesB := maxTokens.AmountOf(p.ReserveB.Denom).Mul(totalShares).Quo(p.ReserveB.Amount)
+	shares = sdk.MinInt(sharesA, sharesB)
+
+	tokens = sdk.NewCoins(
+		sdk.NewCoin(p.ReserveA.Denom, quoCeil(shares.Mul(p.ReserveA.Amount), totalShares)),
+		sdk.NewCoin(p.ReserveB.Denom, quoCeil(shares.Mul(p.ReserveB.Amount), totalShares)),
+	)
+
+	return shares, tokens
+}
+
+// GetWithdrawnTokens returns the tokens withdrawn for redeeming shares of the
+// pool, rounded down in favor of the pool
+func (p Pool) GetWithdrawnTokens(shares sdk.Int) sdk.Coins {
+	totalShares := p.TotalShares.Amount
+
+	return sdk.NewCoins(
+		sdk.NewCoin(p.ReserveA.Denom, shares.Mul(p.ReserveA.Amount).Quo(totalShares)),
+		sdk.NewCoin(p.ReserveB.Denom, shares.Mul(p.ReserveB.Amount).Quo(totalShares)),
+	)
+}
+
+// GetSpotPrice returns the price of the base denom in the other denom of the
+// pool, before the swap fee
+func (p Pool) GetSpotPrice(baseDenom string) (sdk.DecCoin, error) {
+	reserveBase, reserveQuote, err := p.GetSwapReserves(baseDenom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	price := reserveQuote.Amount.ToDec().Quo(reserveBase.Amount.ToDec())
+	return sdk.NewDecCoinFromDec(reserveQuote.Denom, price), nil
+}
+
+// GetSwapExactInOutput returns the output of swapping an exact input and the
+// fee kept by the pool. The output keeps the product of the reserves constant
+// for the input net of the fee, rounded down in favor of the pool.
+func GetSwapExactInOutput(reserveIn, reserveOut, amountIn sdk.Int, feeRate sdk.Dec) (amountOut, fee sdk.Int) {
+	fee = feeRate.MulInt(amountIn).Ceil().TruncateInt()
+	amountInAfterFee := amountIn.Sub(fee)
+
+	amountOut = reserveOut.Mul(amountInAfterFee).Quo(reserveIn.Add(amountInAfterFee))
+	return amountOut, fee
+}
+
+// GetSwapExactOutInput returns the input needed to swap for an exact output
+// and the fee kept by the pool, rounded up in favor of the pool.
+func GetSwapExactOutInput(reserveIn, reserveOut, amountOut sdk.Int, feeRate sdk.Dec) (amountIn, fee sdk.Int, err error) {
+	if amountOut.GTE(reserveOut) {
+		return amountIn, fee, sdkerrors.Wrapf(ErrInsufficientLiquidity, "output %s, reserve %s", amountOut, reserveOut)
+	}
+
+	amountInAfterFee := quoCeil(reserveIn.Mul(amountOut), reserveOut.Sub(amountOut))
+	amountIn = amountInAfterFee.ToDec().Quo(sdk.OneDec().Sub(feeRate)).Ceil().TruncateInt()
+
+	return amountIn, amountIn.Sub(amountInAfterFee), nil
+}
+
+// quoCeil returns a divided by b rounded up, for a non-negative a and a positive b
+func quoCeil(a, b sdk.Int) sdk.Int {
+	return a.Add(b).SubRaw(1).Quo(b)
+}
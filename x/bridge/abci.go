@@ -0,0 +1,20 @@
+package bridge
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/bridge/keeper"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+// EndBlocker observes the attested claims, returns the transfers of the timed
+// out batches to the pool and batches the transfers waiting in the pool
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.TallyAttestations(ctx)
+	k.CancelTimedOutBatches(ctx)
+	k.BuildBatches(ctx)
+}
@@ -0,0 +1,356 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	bridgeQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the bridge module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	bridgeQueryCmd.AddCommand(
+		GetCmdQueryOrchestratorKeys(),
+		GetCmdQueryEventNonce(),
+		GetCmdQueryAttestations(),
+		GetCmdQueryOutgoingTxPool(),
+		GetCmdQueryOutgoingTxBatches(),
+		GetCmdQueryBatchConfirms(),
+		GetCmdQueryCurrentValset(),
+		GetCmdQueryParams(),
+	)
+
+	return bridgeQueryCmd
+}
+
+// GetCmdQueryOrchestratorKeys implements the orchestrator keys query command.
+func GetCmdQueryOrchestratorKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orchestrator-keys [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the orchestrator account and the EVM address of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the orchestrator account and the EVM address of a validator.
+
+Example:
+$ %s query %s orchestrator-keys gaussvaloper1...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OrchestratorKeys(context.Background(), &types.QueryOrchestratorKeysRequest{ValidatorAddr: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEventNonce implements the event nonce query command.
+func GetCmdQueryEventNonce() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "event-nonce [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the nonce of the last event attested by a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the nonce of the last event attested by a validator, the next claim of
+the validator must attest the following one, and the nonce and the EVM block
+height of the last observed event.
+
+Example:
+$ %s query %s event-nonce gaussvaloper1...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LastEventNonce(context.Background(), &types.QueryLastEventNonceRequest{ValidatorAddr: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAttestations implements the attestations query command.
+func GetCmdQueryAttestations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attestations",
+		Args:  cobra.NoArgs,
+		Short: "Query the attestations of the claims not observed yet",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the attestations of the claims not observed yet, by event nonce.
+
+Example:
+$ %s query %s attestations
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Attestations(context.Background(), &types.QueryAttestationsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "attestations")
+
+	return cmd
+}
+
+// GetCmdQueryOutgoingTxPool implements the outgoing transfer pool query command.
+func GetCmdQueryOutgoingTxPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool",
+		Args:  cobra.NoArgs,
+		Short: "Query the transfers waiting to be batched",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the transfers of burnt vouchers waiting to be batched, by token contract.
+
+Example:
+$ %s query %s pool
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OutgoingTxPool(context.Background(), &types.QueryOutgoingTxPoolRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pool")
+
+	return cmd
+}
+
+// GetCmdQueryOutgoingTxBatches implements the batches query command.
+func GetCmdQueryOutgoingTxBatches() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batches",
+		Args:  cobra.NoArgs,
+		Short: "Query the batches waiting to be executed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the batches waiting to be executed by the bridge contract, by token contract.
+
+Example:
+$ %s query %s batches
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OutgoingTxBatches(context.Background(), &types.QueryOutgoingTxBatchesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "batches")
+
+	return cmd
+}
+
+// GetCmdQueryBatchConfirms implements the batch confirmations query command.
+func GetCmdQueryBatchConfirms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-confirms [token-contract] [batch-nonce]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the confirmations of a batch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the signatures of the checkpoint of a batch by the validators.
+
+Example:
+$ %s query %s batch-confirms 0xdAC17F958D2ee523a2206206994597C13D831ec7 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			batchNonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BatchConfirms(context.Background(), &types.QueryBatchConfirmsRequest{
+				TokenContract: args[0],
+				BatchNonce:    batchNonce,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCurrentValset implements the validator set query command.
+func GetCmdQueryCurrentValset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "valset",
+		Args:  cobra.NoArgs,
+		Short: "Query the EVM addresses and the powers of the bonded validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the EVM addresses of the bonded validators and their power, normalized
+to 2^32, as checked by the bridge contract.
+
+Example:
+$ %s query %s valset
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CurrentValset(context.Background(), &types.QueryCurrentValsetRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryParams implements the params query command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current bridge parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current bridge parameters.
+
+Example:
+$ %s query %s params
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,259 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/bridge transaction commands.
+func NewTxCmd() *cobra.Command {
+	bridgeTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Bridge transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	bridgeTxCmd.AddCommand(
+		NewSetOrchestratorAddressCmd(),
+		NewDepositClaimCmd(),
+		NewBatchExecutedClaimCmd(),
+		NewSendToExternalCmd(),
+		NewConfirmBatchCmd(),
+	)
+
+	return bridgeTxCmd
+}
+
+func NewSetOrchestratorAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-orchestrator-address [orchestrator] [eth-address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "register the orchestrator account and the EVM address of a validator.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`register the account submitting the claims and the confirmations of the
+validator of the --from account, and the EVM address signing its confirmations.
+
+Example:
+$ %s tx %s set-orchestrator-address gauss1... 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orchestrator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetOrchestratorAddress(sdk.ValAddress(clientCtx.GetFromAddress()), orchestrator, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewDepositClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-claim [event-nonce] [eth-block-height] [token-contract] [amount] [eth-sender] [receiver]",
+		Args:  cobra.ExactArgs(6),
+		Short: "attest a deposit to the bridge contract.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`attest, as the orchestrator of a validator, the deposit event of the bridge
+contract with the next event nonce of the validator. The amount is in the
+smallest unit of the token contract.
+
+Example:
+$ %s tx %s deposit-claim 1 15000000 0xdAC17F958D2ee523a2206206994597C13D831ec7 1000000 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed gauss1... --from orchestratorkey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			eventNonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			ethBlockHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			amount, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[3])
+			}
+
+			msg := types.NewMsgDepositClaim(
+				eventNonce, ethBlockHeight, args[2], amount, args[4], args[5], clientCtx.GetFromAddress(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewBatchExecutedClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-executed-claim [event-nonce] [eth-block-height] [token-contract] [batch-nonce]",
+		Args:  cobra.ExactArgs(4),
+		Short: "attest the execution of a batch by the bridge contract.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`attest, as the orchestrator of a validator, the batch execution event of the
+bridge contract with the next event nonce of the validator.
+
+Example:
+$ %s tx %s batch-executed-claim 2 15000100 0xdAC17F958D2ee523a2206206994597C13D831ec7 1 --from orchestratorkey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			eventNonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			ethBlockHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			batchNonce, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchExecutedClaim(eventNonce, ethBlockHeight, args[2], batchNonce, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSendToExternalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-to-external [eth-dest] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "burn vouchers to release the tokens they represent on the EVM chain.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`burn vouchers of the --from account for the bridge contract to release the
+tokens they represent to an EVM address once their batch is co-signed by the
+validators.
+
+Example:
+$ %s tx %s send-to-external 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed 1000000aevmdac17f958d2ee523a2206206994597c13d831ec7 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendToExternal(clientCtx.GetFromAddress(), args[0], amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewConfirmBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm-batch [token-contract] [batch-nonce] [eth-signer] [signature]",
+		Args:  cobra.ExactArgs(4),
+		Short: "submit the signature of the checkpoint of a batch.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`submit, as the orchestrator of a validator, the hex of the eth_sign signature
+of the checkpoint of a batch by the EVM address of the validator.
+
+Example:
+$ %s tx %s confirm-batch 0xdAC17F958D2ee523a2206206994597C13D831ec7 1 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed 1b2c... --from orchestratorkey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			batchNonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgConfirmBatch(
+				args[0], batchNonce, args[2], strings.TrimPrefix(args[3], "0x"), clientCtx.GetFromAddress(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,9 @@
 /*
-Package bridge implements a gauss module, that provides an implementation
-of the crosschain algorithm. 
+Package bridge implements a gauss module, that provides a bridge to an EVM
+chain relayed by the validators: the bonded validators attest the deposits to
+the bridge contract, which mint vouchers registered in the token module, and
+co-sign the batches of the vouchers burnt to release the tokens on the EVM
+chain.
 Please refer to the specification under /spec for further information.
 */
 package bridge
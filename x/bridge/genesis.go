@@ -0,0 +1,84 @@
+package bridge
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/bridge/keeper"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+// InitGenesis sets the orchestrator keys, the attestations, the outgoing
+// transfers and parameters for the provided keeper.
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) (res []abci.ValidatorUpdate) {
+	if err := ValidateGenesis(data); err != nil {
+		panic(err.Error())
+	}
+
+	keeper.SetParams(ctx, data.Params)
+	keeper.SetLastObservedEventNonce(ctx, data.LastObservedEventNonce)
+	keeper.SetLastObservedEthBlockHeight(ctx, data.LastObservedEthBlockHeight)
+	keeper.SetLastTxID(ctx, data.LastTxId)
+	keeper.SetLastBatchNonce(ctx, data.LastBatchNonce)
+
+	for _, keys := range data.OrchestratorKeys {
+		keeper.SetOrchestratorKeys(ctx, keys)
+	}
+
+	for _, nonce := range data.EventNonces {
+		validator, err := sdk.ValAddressFromBech32(nonce.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetValidatorEventNonce(ctx, validator, nonce.EventNonce)
+	}
+
+	for _, attestation := range data.Attestations {
+		keeper.SetAttestation(ctx, attestation)
+	}
+
+	for _, tx := range data.OutgoingTxs {
+		keeper.SetOutgoingTx(ctx, tx)
+	}
+
+	for _, batch := range data.Batches {
+		keeper.SetOutgoingTxBatch(ctx, batch)
+	}
+
+	for _, confirm := range data.BatchConfirms {
+		keeper.SetBatchConfirm(ctx, confirm)
+	}
+
+	return res
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	eventNonces := []types.ValidatorEventNonce{}
+	keeper.IterateValidatorEventNonces(ctx, func(validator sdk.ValAddress, eventNonce uint64) bool {
+		eventNonces = append(eventNonces, types.ValidatorEventNonce{
+			ValidatorAddress: validator.String(),
+			EventNonce:       eventNonce,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(
+		keeper.GetParams(ctx),
+		keeper.GetLastObservedEventNonce(ctx),
+		keeper.GetLastObservedEthBlockHeight(ctx),
+		keeper.GetLastTxID(ctx),
+		keeper.GetLastBatchNonce(ctx),
+		keeper.GetAllOrchestratorKeys(ctx),
+		eventNonces,
+		keeper.GetAllAttestations(ctx),
+		keeper.GetAllOutgoingTxs(ctx),
+		keeper.GetAllOutgoingTxBatches(ctx),
+		keeper.GetAllBatchConfirms(ctx),
+	)
+}
+
+// ValidateGenesis validates the provided bridge genesis state
+func ValidateGenesis(data *types.GenesisState) error {
+	return data.Validate()
+}
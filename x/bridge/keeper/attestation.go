@@ -0,0 +1,332 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+// GetAttestation returns the attestation of a claim by its event nonce and hash
+func (k Keeper) GetAttestation(ctx sdk.Context, eventNonce uint64, claimHash []byte) (attestation types.Attestation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetAttestationKey(eventNonce, claimHash))
+	if value == nil {
+		return attestation, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &attestation)
+	return attestation, true
+}
+
+// SetAttestation sets the attestation of a claim
+func (k Keeper) SetAttestation(ctx sdk.Context, attestation types.Attestation) {
+	store := ctx.KVStore(k.storeKey)
+	claim := attestation.Claim
+	store.Set(types.GetAttestationKey(claim.EventNonce, claim.Hash()), k.cdc.MustMarshalBinaryBare(&attestation))
+}
+
+// DeleteAttestation deletes the attestation of a claim
+func (k Keeper) DeleteAttestation(ctx sdk.Context, attestation types.Attestation) {
+	store := ctx.KVStore(k.storeKey)
+	claim := attestation.Claim
+	store.Delete(types.GetAttestationKey(claim.EventNonce, claim.Hash()))
+}
+
+// IterateAttestations iterates through the attestations by event nonce
+func (k Keeper) IterateAttestations(ctx sdk.Context, fn func(attestation types.Attestation) (stop bool)) {
+	k.iterateAttestations(ctx, types.AttestationKey, fn)
+}
+
+// GetAttestationsByNonce returns the attestations of the claims of an event
+// nonce, conflicting if the validators observed different events
+func (k Keeper) GetAttestationsByNonce(ctx sdk.Context, eventNonce uint64) (attestations []types.Attestation) {
+	k.iterateAttestations(ctx, types.GetAttestationsKey(eventNonce), func(attestation types.Attestation) bool {
+		attestations = append(attestations, attestation)
+		return false
+	})
+	return attestations
+}
+
+// GetAllAttestations returns all the attestations
+func (k Keeper) GetAllAttestations(ctx sdk.Context) (attestations []types.Attestation) {
+	k.IterateAttestations(ctx, func(attestation types.Attestation) bool {
+		attestations = append(attestations, attestation)
+		return false
+	})
+	return attestations
+}
+
+func (k Keeper) iterateAttestations(ctx sdk.Context, prefix []byte, fn func(attestation types.Attestation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var attestation types.Attestation
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &attestation)
+		if fn(attestation) {
+			break
+		}
+	}
+}
+
+// GetValidatorEventNonce returns the nonce of the last event a validator attested
+func (k Keeper) GetValidatorEventNonce(ctx sdk.Context, validator sdk.ValAddress) uint64 {
+	return k.getUint64(ctx, types.GetValidatorEventNonceKey(validator))
+}
+
+// SetValidatorEventNonce sets the nonce of the last event a validator attested
+func (k Keeper) SetValidatorEventNonce(ctx sdk.Context, validator sdk.ValAddress, eventNonce uint64) {
+	k.setUint64(ctx, types.GetValidatorEventNonceKey(validator), eventNonce)
+}
+
+// IterateValidatorEventNonces iterates through the last event nonces by validator
+func (k Keeper) IterateValidatorEventNonces(ctx sdk.Context, fn func(validator sdk.ValAddress, eventNonce uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorEventNonceKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		validator := sdk.ValAddress(iterator.Key()[len(types.ValidatorEventNonceKey):])
+		if fn(validator, sdk.BigEndianToUint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetLastObservedEventNonce returns the nonce of the last observed event
+func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context) uint64 {
+	return k.getUint64(ctx, types.LastObservedEventNonceKey)
+}
+
+// SetLastObservedEventNonce sets the nonce of the last observed event
+func (k Keeper) SetLastObservedEventNonce(ctx sdk.Context, eventNonce uint64) {
+	k.setUint64(ctx, types.LastObservedEventNonceKey, eventNonce)
+}
+
+// GetLastObservedEthBlockHeight returns the EVM block height of the last observed event
+func (k Keeper) GetLastObservedEthBlockHeight(ctx sdk.Context) uint64 {
+	return k.getUint64(ctx, types.LastObservedEthBlockHeightKey)
+}
+
+// SetLastObservedEthBlockHeight sets the EVM block height of the last observed event
+func (k Keeper) SetLastObservedEthBlockHeight(ctx sdk.Context, height uint64) {
+	k.setUint64(ctx, types.LastObservedEthBlockHeightKey, height)
+}
+
+// GetNextEventNonce returns the nonce of the next event a validator must
+// attest: the one after its last attested event, skipping the events observed
+// without its claims
+func (k Keeper) GetNextEventNonce(ctx sdk.Context, validator sdk.ValAddress) uint64 {
+	last := k.GetValidatorEventNonce(ctx, validator)
+	if observed := k.GetLastObservedEventNonce(ctx); observed > last {
+		last = observed
+	}
+	return last + 1
+}
+
+// Attest records the claim of a validator, signed by its orchestrator. The
+// validators attest the events of the bridge contract one by one in the order
+// of their nonces, a claim is observed at the end of the block once the
+// validators attesting it hold the attestation threshold of the bonded power
+func (k Keeper) Attest(ctx sdk.Context, orchestrator sdk.AccAddress, claim types.Claim) (types.Attestation, error) {
+	keys, err := k.GetBondedValidatorByOrchestrator(ctx, orchestrator)
+	if err != nil {
+		return types.Attestation{}, err
+	}
+	validator := keys.GetValidator()
+
+	if next := k.GetNextEventNonce(ctx, validator); claim.EventNonce != next {
+		return types.Attestation{}, sdkerrors.Wrapf(types.ErrNonContiguousNonce,
+			"expected event nonce %d of %s, got %d", next, validator, claim.EventNonce)
+	}
+
+	attestation, found := k.GetAttestation(ctx, claim.EventNonce, claim.Hash())
+	if !found {
+		attestation = types.NewAttestation(claim, ctx.BlockHeight())
+	}
+	attestation.Votes = append(attestation.Votes, validator.String())
+
+	k.SetAttestation(ctx, attestation)
+	k.SetValidatorEventNonce(ctx, validator, claim.EventNonce)
+
+	return attestation, nil
+}
+
+// TallyAttestations observes the claims of the events following the last
+// observed one, in the order of their nonces, as long as one of the claims of
+// the next event holds the attestation threshold of the bonded power
+func (k Keeper) TallyAttestations(ctx sdk.Context) {
+	powers, totalPower := k.bondedPowers(ctx)
+	if totalPower == 0 {
+		return
+	}
+	threshold := k.AttestationThreshold(ctx).MulInt64(totalPower)
+
+	for {
+		eventNonce := k.GetLastObservedEventNonce(ctx) + 1
+		attestations := k.GetAttestationsByNonce(ctx, eventNonce)
+
+		observed := -1
+		for i, attestation := range attestations {
+			var power int64
+			for _, vote := range attestation.Votes {
+				power += powers[vote]
+			}
+			if power > 0 && sdk.NewDec(power).GTE(threshold) {
+				observed = i
+				break
+			}
+		}
+		if observed < 0 {
+			return
+		}
+
+		k.observe(ctx, attestations[observed])
+
+		// the conflicting claims of the event can no longer be observed
+		for _, attestation := range attestations {
+			k.DeleteAttestation(ctx, attestation)
+		}
+	}
+}
+
+// observe applies an observed claim. A claim failing to apply is still
+// observed, so that it does not block the following events, and none of its
+// changes are kept: the tokens of a failed deposit are refunded to its EVM
+// sender instead
+func (k Keeper) observe(ctx sdk.Context, attestation types.Attestation) {
+	claim := attestation.Claim
+
+	k.SetLastObservedEventNonce(ctx, claim.EventNonce)
+	if claim.EthBlockHeight > k.GetLastObservedEthBlockHeight(ctx) {
+		k.SetLastObservedEthBlockHeight(ctx, claim.EthBlockHeight)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeObservation,
+			sdk.NewAttribute(types.AttributeKeyClaimType, claim.Type.String()),
+			sdk.NewAttribute(types.AttributeKeyEventNonce, strconv.FormatUint(claim.EventNonce, 10)),
+			sdk.NewAttribute(types.AttributeKeyClaimHash, hex.EncodeToString(claim.Hash())),
+		),
+	)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	var err error
+	switch claim.Type {
+	case types.ClaimTypeDeposit:
+		err = k.processDeposit(cacheCtx, claim)
+	case types.ClaimTypeBatchExecuted:
+		err = k.processBatchExecuted(cacheCtx, claim)
+	default:
+		err = sdkerrors.Wrapf(types.ErrInvalidClaim, "unknown claim type %s", claim.Type)
+	}
+
+	if err != nil {
+		k.Logger(ctx).Error("failed to apply observed claim", "event_nonce", claim.EventNonce, "err", err)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeClaimFailed,
+				sdk.NewAttribute(types.AttributeKeyEventNonce, strconv.FormatUint(claim.EventNonce, 10)),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			),
+		)
+
+		if claim.Type == types.ClaimTypeDeposit {
+			k.refundDeposit(ctx, claim)
+		}
+		return
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// processDeposit mints the vouchers of a deposit to its receiver, registering
+// the voucher of the token contract in x/token on its first deposit. The supply
+// of the vouchers is backed by the tokens locked in the bridge contract, it is
+// not bound by the total supply of x/token
+func (k Keeper) processDeposit(ctx sdk.Context, claim types.Claim) error {
+	receiver, err := sdk.AccAddressFromBech32(claim.Receiver)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidReceiver, "%s: %s", claim.Receiver, err)
+	}
+
+	unit := types.GetVoucherUnit(claim.TokenContract)
+	if !k.tokenKeeper.HasTokenWithUnit(ctx, unit) {
+		if err := k.tokenKeeper.AddToken(ctx, types.NewVoucherToken(claim.TokenContract)); err != nil {
+			return err
+		}
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(unit, claim.Amount))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeposit,
+			sdk.NewAttribute(types.AttributeKeyEventNonce, strconv.FormatUint(claim.EventNonce, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokenContract, claim.TokenContract),
+			sdk.NewAttribute(types.AttributeKeyReceiver, claim.Receiver),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+
+	return nil
+}
+
+// refundDeposit adds the transfer of the tokens of a failed deposit back to its
+// EVM sender to the pool of the outgoing transfers, sent by the bridge module as
+// no vouchers were minted for them
+func (k Keeper) refundDeposit(ctx sdk.Context, claim types.Claim) {
+	id := k.GetLastTxID(ctx) + 1
+	k.SetLastTxID(ctx, id)
+	k.SetOutgoingTx(ctx, types.NewOutgoingTransferTx(
+		id, k.authKeeper.GetModuleAddress(types.ModuleName), claim.EthSender, claim.TokenContract, claim.Amount,
+	))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDepositRefund,
+			sdk.NewAttribute(types.AttributeKeyEventNonce, strconv.FormatUint(claim.EventNonce, 10)),
+			sdk.NewAttribute(types.AttributeKeyTxID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyEthDest, claim.EthSender),
+			sdk.NewAttribute(types.AttributeKeyAmount, claim.Amount.String()),
+		),
+	)
+}
+
+// processBatchExecuted deletes a batch released by the bridge contract
+func (k Keeper) processBatchExecuted(ctx sdk.Context, claim types.Claim) error {
+	batch, found := k.GetOutgoingTxBatch(ctx, claim.TokenContract, claim.BatchNonce)
+	if !found {
+		return sdkerrors.Wrap(types.ErrBatchNotFound, fmt.Sprintf("%s/%d", claim.TokenContract, claim.BatchNonce))
+	}
+
+	k.deleteBatch(ctx, batch)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBatchExecuted,
+			sdk.NewAttribute(types.AttributeKeyTokenContract, batch.TokenContract),
+			sdk.NewAttribute(types.AttributeKeyBatchNonce, strconv.FormatUint(batch.BatchNonce, 10)),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,306 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/bridge"
+	"github.com/gauss/gauss/v4/x/bridge/keeper"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+	tokenkeeper "github.com/gauss/gauss/v4/x/token/keeper"
+)
+
+const (
+	tokenContract = "0xdAC17F958D2ee523a2206206994597C13D831ec7"
+	ethSender     = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+)
+
+func TestDepositAttestation(t *testing.T) {
+	app, ctx, signers := setupBridgeTest(t)
+	contract := newFakeBridgeContract(app.BridgeKeeper.BridgeID(ctx))
+	receiver := sdk.AccAddress(simapp.CreateTestPubKeys(7)[6].Address())
+	unit := types.GetVoucherUnit(tokenContract)
+
+	claim := contract.Deposit(tokenContract, sdk.NewInt(1000), ethSender, receiver.String())
+
+	// the validators of half of the power do not hold the attestation threshold
+	relay(t, ctx, app.BridgeKeeper, signers[:2], claim)
+	bridge.EndBlocker(ctx, app.BridgeKeeper)
+	require.Zero(t, app.BridgeKeeper.GetLastObservedEventNonce(ctx))
+	require.False(t, app.TokenKeeper.HasTokenWithUnit(ctx, unit))
+
+	attestation, found := app.BridgeKeeper.GetAttestation(ctx, claim.EventNonce, claim.Hash())
+	require.True(t, found)
+	require.Len(t, attestation.Votes, 2)
+
+	// a validator cannot attest the same event twice
+	require.ErrorIs(t, claimErr(ctx, app.BridgeKeeper, signers[0], claim), types.ErrNonContiguousNonce)
+
+	relay(t, ctx, app.BridgeKeeper, signers[2:], claim)
+	bridge.EndBlocker(ctx, app.BridgeKeeper)
+	require.Equal(t, claim.EventNonce, app.BridgeKeeper.GetLastObservedEventNonce(ctx))
+	require.Equal(t, claim.EthBlockHeight, app.BridgeKeeper.GetLastObservedEthBlockHeight(ctx))
+	require.Empty(t, app.BridgeKeeper.GetAllAttestations(ctx))
+
+	// the voucher is registered in x/token and minted to the receiver
+	require.True(t, app.TokenKeeper.HasTokenWithUnit(ctx, unit))
+	token, err := app.TokenKeeper.GetTokenWithUnit(ctx, unit)
+	require.NoError(t, err)
+	require.Equal(t, types.GetVoucherSymbol(tokenContract), token.GetSymbol())
+	require.Equal(t, sdk.NewInt(1000), app.BankKeeper.GetBalance(ctx, receiver, unit).Amount)
+
+	// the next deposit mints the registered voucher
+	claim = contract.Deposit(tokenContract, sdk.NewInt(500), ethSender, receiver.String())
+	relay(t, ctx, app.BridgeKeeper, signers, claim)
+	bridge.EndBlocker(ctx, app.BridgeKeeper)
+	require.Equal(t, sdk.NewInt(1500), app.BankKeeper.GetBalance(ctx, receiver, unit).Amount)
+}
+
+func TestDepositAbove64Bits(t *testing.T) {
+	app, ctx, signers := setupBridgeTest(t)
+	contract := newFakeBridgeContract(app.BridgeKeeper.BridgeID(ctx))
+	receiver := sdk.AccAddress(simapp.CreateTestPubKeys(7)[6].Address())
+	unit := types.GetVoucherUnit(tokenContract)
+
+	// 100 tokens of 18 decimals exceed the uint64 total supply of x/token
+	amount, ok := sdk.NewIntFromString("100000000000000000000")
+	require.True(t, ok)
+	first := contract.Deposit(tokenContract, amount, ethSender, receiver.String())
+	second := contract.Deposit(tokenContract, amount, ethSender, receiver.String())
+	relay(t, ctx, app.BridgeKeeper, signers, first, second)
+	bridge.EndBlocker(ctx, app.BridgeKeeper)
+
+	require.Equal(t, second.EventNonce, app.BridgeKeeper.GetLastObservedEventNonce(ctx))
+	require.Equal(t, amount.MulRaw(2), app.BankKeeper.GetBalance(ctx, receiver, unit).Amount)
+	require.Empty(t, app.BridgeKeeper.GetAllOutgoingTxBatches(ctx))
+
+	_, broken := tokenkeeper.AllInvariants(app.TokenKeeper, app.BankKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestClaimNonceOrder(t *testing.T) {
+	app, ctx, signers := setupBridgeTest(t)
+	contract := newFakeBridgeContract(app.BridgeKeeper.BridgeID(ctx))
+	receiver := sdk.AccAddress(simapp.CreateTestPubKeys(7)[6].Address())
+
+	first := contract.Deposit(tokenContract, sdk.NewInt(1000), ethSender, receiver.String())
+	second := contract.Deposit(tokenContract, sdk.NewInt(2000), ethSender, receiver.String())
+
+	// only the orchestrators may attest, one event after another
+	require.ErrorIs(t, claimErr(ctx, app.BridgeKeeper, signer{orchestrator: receiver}, first), types.ErrNotOrchestrator)
+	require.ErrorIs(t, claimErr(ctx, app.BridgeKeeper, signers[1], second), types.ErrNonContiguousNonce)
+
+	// the events are observed in order even if attested in the same block
+	relay(t, ctx, app.BridgeKeeper, signers[1:], first, second)
+	bridge.EndBlocker(ctx, app.BridgeKeeper)
+	require.Equal(t, second.EventNonce, app.BridgeKeeper.GetLastObservedEventNonce(ctx))
+	require.Equal(t, sdk.NewInt(3000), app.BankKeeper.GetBalance(ctx, receiver, types.GetVoucherUnit(tokenContract)).Amount)
+
+	// a validator late to attest skips the events observed without it
+	require.Equal(t, uint64(3), app.BridgeKeeper.GetNextEventNonce(ctx, signers[0].validator))
+	require.ErrorIs(t, claimErr(ctx, app.BridgeKeeper, signers[0], first), types.ErrNonContiguousNonce)
+
+	// a conflicting claim of the same event does not block the honest one
+	third := contract.Deposit(tokenContract, sdk.NewInt(3000), ethSender, receiver.String())
+	forged := third
+	forged.Amount = sdk.NewInt(3000000)
+	relay(t, ctx, app.BridgeKeeper, signers[:1], forged)
+	relay(t, ctx, app.BridgeKeeper, signers[1:], third)
+	require.Len(t, app.BridgeKeeper.GetAttestationsByNonce(ctx, third.EventNonce), 2)
+
+	bridge.EndBlocker(ctx, app.BridgeKeeper)
+	require.Equal(t, third.EventNonce, app.BridgeKeeper.GetLastObservedEventNonce(ctx))
+	require.Empty(t, app.BridgeKeeper.GetAllAttestations(ctx))
+	require.Equal(t, sdk.NewInt(6000), app.BankKeeper.GetBalance(ctx, receiver, types.GetVoucherUnit(tokenContract)).Amount)
+}
+
+func TestFailedClaim(t *testing.T) {
+	app, ctx, signers := setupBridgeTest(t)
+	contract := newFakeBridgeContract(app.BridgeKeeper.BridgeID(ctx))
+	receiver := sdk.AccAddress(simapp.CreateTestPubKeys(7)[6].Address())
+
+	// a deposit to an invalid receiver is observed without minting vouchers,
+	// the events following it are not blocked
+	invalid := contract.Deposit(tokenContract, sdk.NewInt(1000), ethSender, "gauss1invalid")
+	valid := contract.Deposit(tokenContract, sdk.NewInt(1000), ethSender, receiver.String())
+	relay(t, ctx, app.BridgeKeeper, signers, invalid, valid)
+	bridge.EndBlocker(ctx, app.BridgeKeeper)
+
+	require.Equal(t, valid.EventNonce, app.BridgeKeeper.GetLastObservedEventNonce(ctx))
+	require.Equal(t, sdk.NewInt(1000), app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(types.GetVoucherUnit(tokenContract)))
+
+	// its tokens are refunded to the EVM sender by the bridge module with the next batch
+	moduleAddr := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	batches := app.BridgeKeeper.GetAllOutgoingTxBatches(ctx)
+	require.Len(t, batches, 1)
+	require.Equal(t, []types.OutgoingTransferTx{
+		types.NewOutgoingTransferTx(1, moduleAddr, ethSender, tokenContract, sdk.NewInt(1000)),
+	}, batches[0].Transactions)
+}
+
+// signer is a validator of the in-process signer set, its orchestrator
+// account and its EVM key
+type signer struct {
+	validator    sdk.ValAddress
+	orchestrator sdk.AccAddress
+	ethKey       *ecdsa.PrivateKey
+	ethAddress   string
+}
+
+// fakeBridgeContract mimics the bridge contract on the EVM chain: it emits
+// deposit events and executes the batches signed by the validator set
+type fakeBridgeContract struct {
+	bridgeID        string
+	eventNonce      uint64
+	ethBlockHeight  uint64
+	lastBatchNonces map[string]uint64
+	balances        map[string]sdk.Int
+}
+
+func newFakeBridgeContract(bridgeID string) *fakeBridgeContract {
+	return &fakeBridgeContract{
+		bridgeID:        bridgeID,
+		ethBlockHeight:  100,
+		lastBatchNonces: make(map[string]uint64),
+		balances:        make(map[string]sdk.Int),
+	}
+}
+
+// Deposit locks tokens in the contract and returns the claim of the event
+func (c *fakeBridgeContract) Deposit(tokenContract string, amount sdk.Int, sender, receiver string) types.Claim {
+	c.eventNonce++
+	c.ethBlockHeight++
+	return types.NewDepositClaim(c.eventNonce, c.ethBlockHeight, tokenContract, amount, sender, receiver)
+}
+
+// SubmitBatch releases the transfers of a batch if the validators of the valset
+// holding the power threshold signed its checkpoint, and returns the claim of
+// the event
+func (c *fakeBridgeContract) SubmitBatch(
+	valset []types.BridgeValidator, powerThreshold uint64, batch types.OutgoingTxBatch, confirms []types.BatchConfirm,
+) (types.Claim, error) {
+	c.ethBlockHeight++
+	if batch.BatchTimeout <= c.ethBlockHeight {
+		return types.Claim{}, fmt.Errorf("batch timed out")
+	}
+	if batch.BatchNonce <= c.lastBatchNonces[batch.TokenContract] {
+		return types.Claim{}, fmt.Errorf("batch already executed")
+	}
+
+	checkpoint := types.GetBatchCheckpoint(c.bridgeID, batch)
+	signatures := make(map[string][]byte)
+	for _, confirm := range confirms {
+		signature, err := types.ParseEthSignature(confirm.Signature)
+		if err != nil {
+			return types.Claim{}, err
+		}
+		signatures[confirm.EthSigner] = signature
+	}
+
+	var power, totalPower uint64
+	for _, v := range valset {
+		totalPower += v.Power
+		if signature, found := signatures[v.EthAddress]; found {
+			if err := types.ValidateEthSignature(checkpoint, signature, v.EthAddress); err != nil {
+				return types.Claim{}, err
+			}
+			power += v.Power
+		}
+	}
+	if power < powerThreshold {
+		return types.Claim{}, fmt.Errorf("insufficient power %d of %d", power, totalPower)
+	}
+
+	c.lastBatchNonces[batch.TokenContract] = batch.BatchNonce
+	for _, tx := range batch.Transactions {
+		balance, found := c.balances[tx.DestAddress]
+		if !found {
+			balance = sdk.ZeroInt()
+		}
+		c.balances[tx.DestAddress] = balance.Add(tx.Amount)
+	}
+
+	c.eventNonce++
+	return types.NewBatchExecutedClaim(c.eventNonce, c.ethBlockHeight, batch.TokenContract, batch.BatchNonce), nil
+}
+
+// relay submits the claims of the events to the chain, as the orchestrators of
+// the signers would
+func relay(t *testing.T, ctx sdk.Context, k keeper.Keeper, signers []signer, claims ...types.Claim) {
+	for _, claim := range claims {
+		for _, s := range signers {
+			require.NoError(t, claimErr(ctx, k, s, claim))
+		}
+	}
+}
+
+func claimErr(ctx sdk.Context, k keeper.Keeper, s signer, claim types.Claim) error {
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	var err error
+	switch claim.Type {
+	case types.ClaimTypeDeposit:
+		_, err = msgServer.DepositClaim(sdk.WrapSDKContext(ctx), types.NewMsgDepositClaim(
+			claim.EventNonce, claim.EthBlockHeight, claim.TokenContract, claim.Amount,
+			claim.EthSender, claim.Receiver, s.orchestrator,
+		))
+	default:
+		_, err = msgServer.BatchExecutedClaim(sdk.WrapSDKContext(ctx), types.NewMsgBatchExecutedClaim(
+			claim.EventNonce, claim.EthBlockHeight, claim.TokenContract, claim.BatchNonce, s.orchestrator,
+		))
+	}
+	return err
+}
+
+// setupBridgeTest creates three bonded validators of the consensus powers 10,
+// 20 and 30, and registers their orchestrator accounts and EVM keys
+func setupBridgeTest(t *testing.T) (*simapp.SimApp, sdk.Context, []signer) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.BridgeKeeper)
+
+	pubKeys := simapp.CreateTestPubKeys(6)
+	signers := make([]signer, 3)
+	for i, pubKey := range pubKeys[:3] {
+		addr := sdk.AccAddress(pubKey.Address())
+		valAddr := sdk.ValAddress(addr)
+
+		tokens := sdk.TokensFromConsensusPower(10 * int64(i+1))
+		simapp.AddTestAddrsFromPubKeys(app, ctx, pubKeys[i:i+1], tokens)
+		require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, addr, stakingtypes.NotBondedPoolName,
+			sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), tokens))))
+
+		validator, err := stakingtypes.NewValidator(valAddr, pubKey, stakingtypes.Description{})
+		require.NoError(t, err)
+		validator, _ = validator.AddTokensFromDel(tokens)
+
+		app.StakingKeeper.SetValidator(ctx, validator)
+		require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+		app.StakingKeeper.AfterValidatorCreated(ctx, valAddr)
+		validator = stakingkeeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+		require.True(t, validator.IsBonded())
+
+		ethKey := types.EthPrivKeyFromSecret([]byte(fmt.Sprintf("signer-%d", i)))
+		signers[i] = signer{
+			validator:    valAddr,
+			orchestrator: sdk.AccAddress(pubKeys[3+i].Address()),
+			ethKey:       ethKey,
+			ethAddress:   types.EthAddressFromPubKey(&ethKey.PublicKey),
+		}
+
+		_, err = msgServer.SetOrchestratorAddress(sdk.WrapSDKContext(ctx),
+			types.NewMsgSetOrchestratorAddress(valAddr, signers[i].orchestrator, signers[i].ethAddress))
+		require.NoError(t, err)
+	}
+
+	return app, ctx, signers
+}
@@ -0,0 +1,364 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+// GetOutgoingTx returns a transfer waiting to be batched
+func (k Keeper) GetOutgoingTx(ctx sdk.Context, tokenContract string, id uint64) (tx types.OutgoingTransferTx, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetOutgoingTxKey(tokenContract, id))
+	if value == nil {
+		return tx, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &tx)
+	return tx, true
+}
+
+// SetOutgoingTx sets a transfer waiting to be batched
+func (k Keeper) SetOutgoingTx(ctx sdk.Context, tx types.OutgoingTransferTx) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOutgoingTxKey(tx.TokenContract, tx.Id), k.cdc.MustMarshalBinaryBare(&tx))
+}
+
+// DeleteOutgoingTx deletes a transfer waiting to be batched
+func (k Keeper) DeleteOutgoingTx(ctx sdk.Context, tx types.OutgoingTransferTx) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOutgoingTxKey(tx.TokenContract, tx.Id))
+}
+
+// IterateOutgoingTxs iterates through the transfers waiting to be batched by
+// token contract and id
+func (k Keeper) IterateOutgoingTxs(ctx sdk.Context, fn func(tx types.OutgoingTransferTx) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.OutgoingTxKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tx types.OutgoingTransferTx
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &tx)
+		if fn(tx) {
+			break
+		}
+	}
+}
+
+// GetAllOutgoingTxs returns all the transfers waiting to be batched
+func (k Keeper) GetAllOutgoingTxs(ctx sdk.Context) (txs []types.OutgoingTransferTx) {
+	k.IterateOutgoingTxs(ctx, func(tx types.OutgoingTransferTx) bool {
+		txs = append(txs, tx)
+		return false
+	})
+	return txs
+}
+
+// GetOutgoingTxBatch returns a batch by its token contract and nonce
+func (k Keeper) GetOutgoingTxBatch(ctx sdk.Context, tokenContract string, batchNonce uint64) (batch types.OutgoingTxBatch, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetOutgoingTxBatchKey(tokenContract, batchNonce))
+	if value == nil {
+		return batch, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &batch)
+	return batch, true
+}
+
+// SetOutgoingTxBatch sets a batch
+func (k Keeper) SetOutgoingTxBatch(ctx sdk.Context, batch types.OutgoingTxBatch) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce), k.cdc.MustMarshalBinaryBare(&batch))
+}
+
+// IterateOutgoingTxBatches iterates through the batches by token contract and nonce
+func (k Keeper) IterateOutgoingTxBatches(ctx sdk.Context, fn func(batch types.OutgoingTxBatch) (stop bool)) {
+	k.iterateOutgoingTxBatches(ctx, types.OutgoingTxBatchKey, fn)
+}
+
+// GetAllOutgoingTxBatches returns all the batches
+func (k Keeper) GetAllOutgoingTxBatches(ctx sdk.Context) (batches []types.OutgoingTxBatch) {
+	k.IterateOutgoingTxBatches(ctx, func(batch types.OutgoingTxBatch) bool {
+		batches = append(batches, batch)
+		return false
+	})
+	return batches
+}
+
+// HasOutgoingTxBatch returns whether a batch of a token waits to be executed
+func (k Keeper) HasOutgoingTxBatch(ctx sdk.Context, tokenContract string) (found bool) {
+	k.iterateOutgoingTxBatches(ctx, types.GetOutgoingTxBatchesKey(tokenContract), func(types.OutgoingTxBatch) bool {
+		found = true
+		return true
+	})
+	return found
+}
+
+func (k Keeper) iterateOutgoingTxBatches(ctx sdk.Context, prefix []byte, fn func(batch types.OutgoingTxBatch) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var batch types.OutgoingTxBatch
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &batch)
+		if fn(batch) {
+			break
+		}
+	}
+}
+
+// GetBatchConfirm returns the confirmation of a batch by a validator
+func (k Keeper) GetBatchConfirm(
+	ctx sdk.Context, tokenContract string, batchNonce uint64, validator sdk.ValAddress,
+) (confirm types.BatchConfirm, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetBatchConfirmKey(tokenContract, batchNonce, validator))
+	if value == nil {
+		return confirm, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &confirm)
+	return confirm, true
+}
+
+// SetBatchConfirm sets the confirmation of a batch by a validator
+func (k Keeper) SetBatchConfirm(ctx sdk.Context, confirm types.BatchConfirm) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetBatchConfirmKey(confirm.TokenContract, confirm.BatchNonce, confirm.GetValidator())
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&confirm))
+}
+
+// GetBatchConfirms returns the confirmations of a batch
+func (k Keeper) GetBatchConfirms(ctx sdk.Context, tokenContract string, batchNonce uint64) (confirms []types.BatchConfirm) {
+	k.iterateBatchConfirms(ctx, types.GetBatchConfirmsKey(tokenContract, batchNonce), func(confirm types.BatchConfirm) bool {
+		confirms = append(confirms, confirm)
+		return false
+	})
+	return confirms
+}
+
+// GetAllBatchConfirms returns the confirmations of all the batches
+func (k Keeper) GetAllBatchConfirms(ctx sdk.Context) (confirms []types.BatchConfirm) {
+	k.iterateBatchConfirms(ctx, types.BatchConfirmKey, func(confirm types.BatchConfirm) bool {
+		confirms = append(confirms, confirm)
+		return false
+	})
+	return confirms
+}
+
+func (k Keeper) iterateBatchConfirms(ctx sdk.Context, prefix []byte, fn func(confirm types.BatchConfirm) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var confirm types.BatchConfirm
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &confirm)
+		if fn(confirm) {
+			break
+		}
+	}
+}
+
+// GetLastTxID returns the id of the last outgoing transfer
+func (k Keeper) GetLastTxID(ctx sdk.Context) uint64 {
+	return k.getUint64(ctx, types.LastTxIDKey)
+}
+
+// SetLastTxID sets the id of the last outgoing transfer
+func (k Keeper) SetLastTxID(ctx sdk.Context, id uint64) {
+	k.setUint64(ctx, types.LastTxIDKey, id)
+}
+
+// GetLastBatchNonce returns the nonce of the last batch
+func (k Keeper) GetLastBatchNonce(ctx sdk.Context) uint64 {
+	return k.getUint64(ctx, types.LastBatchNonceKey)
+}
+
+// SetLastBatchNonce sets the nonce of the last batch
+func (k Keeper) SetLastBatchNonce(ctx sdk.Context, batchNonce uint64) {
+	k.setUint64(ctx, types.LastBatchNonceKey, batchNonce)
+}
+
+// SendToExternal burns vouchers of a sender and adds their transfer to an EVM
+// address to the pool of the transfers waiting to be batched
+func (k Keeper) SendToExternal(ctx sdk.Context, sender sdk.AccAddress, ethDest string, amount sdk.Coin) (uint64, error) {
+	tokenContract, ok := types.GetTokenContract(amount.Denom)
+	if !ok {
+		return 0, sdkerrors.Wrap(types.ErrInvalidVoucher, amount.Denom)
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return 0, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return 0, err
+	}
+
+	id := k.GetLastTxID(ctx) + 1
+	k.SetLastTxID(ctx, id)
+	k.SetOutgoingTx(ctx, types.NewOutgoingTransferTx(id, sender, ethDest, tokenContract, amount.Amount))
+
+	return id, nil
+}
+
+// BuildBatches batches the oldest transfers of every token which has no batch
+// waiting to be executed, up to the maximum size of a batch
+func (k Keeper) BuildBatches(ctx sdk.Context) {
+	maxSize := int(k.BatchMaxSize(ctx))
+	timeout := k.GetLastObservedEthBlockHeight(ctx) + k.BatchTimeout(ctx)
+
+	var (
+		tokenContracts []string
+		pending        = make(map[string][]types.OutgoingTransferTx)
+		batched        = make(map[string]bool)
+	)
+	k.IterateOutgoingTxs(ctx, func(tx types.OutgoingTransferTx) bool {
+		tokenContract := tx.TokenContract
+		if _, seen := batched[tokenContract]; !seen {
+			batched[tokenContract] = k.HasOutgoingTxBatch(ctx, tokenContract)
+			if !batched[tokenContract] {
+				tokenContracts = append(tokenContracts, tokenContract)
+			}
+		}
+
+		if !batched[tokenContract] && len(pending[tokenContract]) < maxSize {
+			pending[tokenContract] = append(pending[tokenContract], tx)
+		}
+		return false
+	})
+
+	for _, tokenContract := range tokenContracts {
+		txs := pending[tokenContract]
+		for _, tx := range txs {
+			k.DeleteOutgoingTx(ctx, tx)
+		}
+
+		batchNonce := k.GetLastBatchNonce(ctx) + 1
+		k.SetLastBatchNonce(ctx, batchNonce)
+
+		batch := types.NewOutgoingTxBatch(batchNonce, tokenContract, txs, timeout, ctx.BlockHeight())
+		k.SetOutgoingTxBatch(ctx, batch)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOutgoingBatch,
+				sdk.NewAttribute(types.AttributeKeyTokenContract, tokenContract),
+				sdk.NewAttribute(types.AttributeKeyBatchNonce, strconv.FormatUint(batchNonce, 10)),
+				sdk.NewAttribute(types.AttributeKeyBatchTimeout, strconv.FormatUint(timeout, 10)),
+				sdk.NewAttribute(types.AttributeKeyCheckpoint,
+					hex.EncodeToString(types.GetBatchCheckpoint(k.BridgeID(ctx), batch))),
+			),
+		)
+	}
+}
+
+// CancelTimedOutBatches returns the transfers of the batches the bridge
+// contract can no longer execute, as an event past their timeout was
+// observed, to the pool to be batched again
+func (k Keeper) CancelTimedOutBatches(ctx sdk.Context) {
+	height := k.GetLastObservedEthBlockHeight(ctx)
+
+	var timedOut []types.OutgoingTxBatch
+	k.IterateOutgoingTxBatches(ctx, func(batch types.OutgoingTxBatch) bool {
+		if batch.BatchTimeout <= height {
+			timedOut = append(timedOut, batch)
+		}
+		return false
+	})
+
+	for _, batch := range timedOut {
+		k.deleteBatch(ctx, batch)
+		for _, tx := range batch.Transactions {
+			k.SetOutgoingTx(ctx, tx)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBatchCanceled,
+				sdk.NewAttribute(types.AttributeKeyTokenContract, batch.TokenContract),
+				sdk.NewAttribute(types.AttributeKeyBatchNonce, strconv.FormatUint(batch.BatchNonce, 10)),
+			),
+		)
+	}
+}
+
+// deleteBatch deletes a batch and its confirmations
+func (k Keeper) deleteBatch(ctx sdk.Context, batch types.OutgoingTxBatch) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, confirm := range k.GetBatchConfirms(ctx, batch.TokenContract, batch.BatchNonce) {
+		store.Delete(types.GetBatchConfirmKey(confirm.TokenContract, confirm.BatchNonce, confirm.GetValidator()))
+	}
+	store.Delete(types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce))
+}
+
+// ConfirmBatch records the signature of the checkpoint of a batch by the EVM
+// key of a validator, signed by its orchestrator. It returns whether the batch
+// reached the attestation threshold of the bonded power with this confirmation
+func (k Keeper) ConfirmBatch(
+	ctx sdk.Context, orchestrator sdk.AccAddress, tokenContract string, batchNonce uint64, ethSigner, signature string,
+) (bool, error) {
+	keys, err := k.GetBondedValidatorByOrchestrator(ctx, orchestrator)
+	if err != nil {
+		return false, err
+	}
+	validator := keys.GetValidator()
+
+	if keys.EthAddress != types.NormalizeEthAddress(ethSigner) {
+		return false, sdkerrors.Wrapf(types.ErrInvalidSignature, "%s is not the EVM address of %s", ethSigner, validator)
+	}
+
+	tokenContract = types.NormalizeEthAddress(tokenContract)
+	batch, found := k.GetOutgoingTxBatch(ctx, tokenContract, batchNonce)
+	if !found {
+		return false, sdkerrors.Wrapf(types.ErrBatchNotFound, "%s/%d", tokenContract, batchNonce)
+	}
+	if _, found := k.GetBatchConfirm(ctx, tokenContract, batchNonce, validator); found {
+		return false, sdkerrors.Wrap(types.ErrDuplicateConfirm, validator.String())
+	}
+
+	bz, err := types.ParseEthSignature(signature)
+	if err != nil {
+		return false, err
+	}
+	if err := types.ValidateEthSignature(types.GetBatchCheckpoint(k.BridgeID(ctx), batch), bz, keys.EthAddress); err != nil {
+		return false, err
+	}
+
+	signedBefore := k.IsBatchSigned(ctx, batch)
+	k.SetBatchConfirm(ctx, types.NewBatchConfirm(tokenContract, batchNonce, validator, keys.EthAddress, bz))
+
+	return !signedBefore && k.IsBatchSigned(ctx, batch), nil
+}
+
+// IsBatchSigned returns whether the confirmations of a batch hold the power
+// threshold of the current valset, as checked by the bridge contract
+func (k Keeper) IsBatchSigned(ctx sdk.Context, batch types.OutgoingTxBatch) bool {
+	signers := make(map[string]bool)
+	for _, confirm := range k.GetBatchConfirms(ctx, batch.TokenContract, batch.BatchNonce) {
+		signers[confirm.EthSigner] = true
+	}
+
+	var power uint64
+	for _, v := range k.GetCurrentValset(ctx) {
+		if signers[v.EthAddress] {
+			power += v.Power
+		}
+	}
+
+	return power > 0 && power >= k.PowerThreshold(ctx)
+}
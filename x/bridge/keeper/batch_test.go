@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/bridge"
+	"github.com/gauss/gauss/v4/x/bridge/keeper"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+const ethDest = "0x1111111111111111111111111111111111111111"
+
+func TestBatchRelay(t *testing.T) {
+	app, ctx, signers := setupBridgeTest(t)
+	msgServer := keeper.NewMsgServerImpl(app.BridgeKeeper)
+	contract := newFakeBridgeContract(app.BridgeKeeper.BridgeID(ctx))
+	sender := sdk.AccAddress(simapp.CreateTestPubKeys(7)[6].Address())
+	unit := types.GetVoucherUnit(tokenContract)
+
+	relay(t, ctx, app.BridgeKeeper, signers, contract.Deposit(tokenContract, sdk.NewInt(1000), ethSender, sender.String()))
+	bridge.EndBlocker(ctx, app.BridgeKeeper)
+
+	// the vouchers sent out are burnt and batched at the end of the block
+	for _, amount := range []int64{300, 200} {
+		_, err := msgServer.SendToExternal(sdk.WrapSDKContext(ctx),
+			types.NewMsgSendToExternal(sender, ethDest, sdk.NewCoin(unit, sdk.NewInt(amount))))
+		require.NoError(t, err)
+	}
+	_, err := msgServer.SendToExternal(sdk.WrapSDKContext(ctx),
+		types.NewMsgSendToExternal(sender, ethDest, sdk.NewCoin(unit, sdk.NewInt(1000))))
+	require.Error(t, err)
+
+	require.Equal(t, sdk.NewInt(500), app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(unit))
+	require.Len(t, app.BridgeKeeper.GetAllOutgoingTxs(ctx), 2)
+
+	bridge.EndBlocker(ctx, app.BridgeKeeper)
+	require.Empty(t, app.BridgeKeeper.GetAllOutgoingTxs(ctx))
+	batches := app.BridgeKeeper.GetAllOutgoingTxBatches(ctx)
+	require.Len(t, batches, 1)
+	batch := batches[0]
+	require.Len(t, batch.Transactions, 2)
+	require.Equal(t, contract.ethBlockHeight+app.BridgeKeeper.BatchTimeout(ctx), batch.BatchTimeout)
+
+	// the validators co-sign the checkpoint of the batch
+	checkpoint := types.GetBatchCheckpoint(app.BridgeKeeper.BridgeID(ctx), batch)
+	confirm := func(s signer, ethSigner string) (*types.MsgConfirmBatchResponse, error) {
+		signature, err := types.SignEthMessage(s.ethKey, checkpoint)
+		require.NoError(t, err)
+		return msgServer.ConfirmBatch(sdk.WrapSDKContext(ctx), types.NewMsgConfirmBatch(
+			batch.TokenContract, batch.BatchNonce, ethSigner, hex.EncodeToString(signature), s.orchestrator))
+	}
+
+	_, err = confirm(signers[0], signers[1].ethAddress)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	_, err = confirm(signer{ethKey: signers[1].ethKey, orchestrator: signers[0].orchestrator}, signers[0].ethAddress)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	_, err = confirm(signers[2], signers[2].ethAddress)
+	require.NoError(t, err)
+	require.False(t, app.BridgeKeeper.IsBatchSigned(ctx, batch))
+	_, err = confirm(signers[2], signers[2].ethAddress)
+	require.ErrorIs(t, err, types.ErrDuplicateConfirm)
+
+	// the contract rejects the batch until the threshold is reached
+	valset := app.BridgeKeeper.GetCurrentValset(ctx)
+	threshold := app.BridgeKeeper.PowerThreshold(ctx)
+	_, err = contract.SubmitBatch(valset, threshold, batch, app.BridgeKeeper.GetBatchConfirms(ctx, batch.TokenContract, batch.BatchNonce))
+	require.Error(t, err)
+
+	_, err = confirm(signers[0], signers[0].ethAddress)
+	require.NoError(t, err)
+	require.True(t, app.BridgeKeeper.IsBatchSigned(ctx, batch))
+
+	claim, err := contract.SubmitBatch(valset, threshold, batch, app.BridgeKeeper.GetBatchConfirms(ctx, batch.TokenContract, batch.BatchNonce))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(500), contract.balances[ethDest])
+
+	// the batch and its confirmations are deleted once its execution is observed
+	relay(t, ctx, app.BridgeKeeper, signers, claim)
+	bridge.EndBlocker(ctx, app.BridgeKeeper)
+	require.Empty(t, app.BridgeKeeper.GetAllOutgoingTxBatches(ctx))
+	require.Empty(t, app.BridgeKeeper.GetAllBatchConfirms(ctx))
+
+	// a batch cannot be executed twice
+	_, err = contract.SubmitBatch(valset, threshold, batch, []types.BatchConfirm{})
+	require.Error(t, err)
+}
+
+func TestBatchTimeout(t *testing.T) {
+	app, ctx, signers := setupBridgeTest(t)
+	msgServer := keeper.NewMsgServerImpl(app.BridgeKeeper)
+	contract := newFakeBridgeContract(app.BridgeKeeper.BridgeID(ctx))
+	sender := sdk.AccAddress(simapp.CreateTestPubKeys(7)[6].Address())
+	unit := types.GetVoucherUnit(tokenContract)
+
+	relay(t, ctx, app.BridgeKeeper, signers, contract.Deposit(tokenContract, sdk.NewInt(1000), ethSender, sender.String()))
+	bridge.EndBlocker(ctx, app.BridgeKeeper)
+
+	_, err := msgServer.SendToExternal(sdk.WrapSDKContext(ctx),
+		types.NewMsgSendToExternal(sender, ethDest, sdk.NewCoin(unit, sdk.NewInt(300))))
+	require.NoError(t, err)
+	bridge.EndBlocker(ctx, app.BridgeKeeper)
+
+	batch, found := app.BridgeKeeper.GetOutgoingTxBatch(ctx, tokenContract, 1)
+	require.True(t, found)
+
+	// once an event past its timeout is observed, the contract can no longer
+	// execute the batch and its transfers are batched again
+	contract.ethBlockHeight = batch.BatchTimeout
+	relay(t, ctx, app.BridgeKeeper, signers, contract.Deposit(tokenContract, sdk.NewInt(1000), ethSender, sender.String()))
+	bridge.EndBlocker(ctx, app.BridgeKeeper)
+
+	_, found = app.BridgeKeeper.GetOutgoingTxBatch(ctx, tokenContract, 1)
+	require.False(t, found)
+	rebatched, found := app.BridgeKeeper.GetOutgoingTxBatch(ctx, tokenContract, 2)
+	require.True(t, found)
+	require.Equal(t, batch.Transactions, rebatched.Transactions)
+	require.Greater(t, rebatched.BatchTimeout, contract.ethBlockHeight)
+
+	_, err = contract.SubmitBatch(app.BridgeKeeper.GetCurrentValset(ctx), app.BridgeKeeper.PowerThreshold(ctx), batch, nil)
+	require.Error(t, err)
+}
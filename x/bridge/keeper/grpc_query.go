@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+// Params queries the bridge parameters
+func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// OrchestratorKeys queries the orchestrator account and the EVM address of a validator
+func (k Querier) OrchestratorKeys(
+	c context.Context, req *types.QueryOrchestratorKeysRequest,
+) (*types.QueryOrchestratorKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	validator, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	keys, found := k.GetOrchestratorKeys(sdk.UnwrapSDKContext(c), validator)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "orchestrator keys of %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryOrchestratorKeysResponse{Keys: keys}, nil
+}
+
+// LastEventNonce queries the nonce of the next event a validator must attest
+// and the last observed event
+func (k Querier) LastEventNonce(
+	c context.Context, req *types.QueryLastEventNonceRequest,
+) (*types.QueryLastEventNonceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	validator, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryLastEventNonceResponse{
+		EventNonce:                 k.GetNextEventNonce(ctx, validator) - 1,
+		LastObservedEventNonce:     k.GetLastObservedEventNonce(ctx),
+		LastObservedEthBlockHeight: k.GetLastObservedEthBlockHeight(ctx),
+	}, nil
+}
+
+// Attestations queries the attestations of the claims not observed yet
+func (k Querier) Attestations(
+	c context.Context, req *types.QueryAttestationsRequest,
+) (*types.QueryAttestationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var attestations []types.Attestation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	attestationStore := prefix.NewStore(store, types.AttestationKey)
+
+	pageRes, err := query.Paginate(attestationStore, req.Pagination, func(key []byte, value []byte) error {
+		var attestation types.Attestation
+		if err := k.cdc.UnmarshalBinaryBare(value, &attestation); err != nil {
+			return err
+		}
+
+		attestations = append(attestations, attestation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAttestationsResponse{Attestations: attestations, Pagination: pageRes}, nil
+}
+
+// OutgoingTxPool queries the transfers waiting to be batched
+func (k Querier) OutgoingTxPool(
+	c context.Context, req *types.QueryOutgoingTxPoolRequest,
+) (*types.QueryOutgoingTxPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var txs []types.OutgoingTransferTx
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	txStore := prefix.NewStore(store, types.OutgoingTxKey)
+
+	pageRes, err := query.Paginate(txStore, req.Pagination, func(key []byte, value []byte) error {
+		var tx types.OutgoingTransferTx
+		if err := k.cdc.UnmarshalBinaryBare(value, &tx); err != nil {
+			return err
+		}
+
+		txs = append(txs, tx)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOutgoingTxPoolResponse{Transactions: txs, Pagination: pageRes}, nil
+}
+
+// OutgoingTxBatches queries the batches waiting to be executed
+func (k Querier) OutgoingTxBatches(
+	c context.Context, req *types.QueryOutgoingTxBatchesRequest,
+) (*types.QueryOutgoingTxBatchesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var batches []types.OutgoingTxBatch
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	batchStore := prefix.NewStore(store, types.OutgoingTxBatchKey)
+
+	pageRes, err := query.Paginate(batchStore, req.Pagination, func(key []byte, value []byte) error {
+		var batch types.OutgoingTxBatch
+		if err := k.cdc.UnmarshalBinaryBare(value, &batch); err != nil {
+			return err
+		}
+
+		batches = append(batches, batch)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOutgoingTxBatchesResponse{Batches: batches, Pagination: pageRes}, nil
+}
+
+// BatchConfirms queries the confirmations of a batch
+func (k Querier) BatchConfirms(
+	c context.Context, req *types.QueryBatchConfirmsRequest,
+) (*types.QueryBatchConfirmsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateEthAddress(req.TokenContract); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	tokenContract := types.NormalizeEthAddress(req.TokenContract)
+	if _, found := k.GetOutgoingTxBatch(ctx, tokenContract, req.BatchNonce); !found {
+		return nil, status.Errorf(codes.NotFound, "batch %s/%d not found", tokenContract, req.BatchNonce)
+	}
+
+	confirms := k.GetBatchConfirms(ctx, tokenContract, req.BatchNonce)
+	return &types.QueryBatchConfirmsResponse{Confirms: confirms}, nil
+}
+
+// CurrentValset queries the EVM addresses and the normalized powers of the
+// bonded validators
+func (k Querier) CurrentValset(
+	c context.Context, _ *types.QueryCurrentValsetRequest,
+) (*types.QueryCurrentValsetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryCurrentValsetResponse{Validators: k.GetCurrentValset(ctx)}, nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+// keeper of the bridge store
+type Keeper struct {
+	storeKey      sdk.StoreKey
+	cdc           codec.BinaryMarshaler
+	authKeeper    types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
+	tokenKeeper   types.TokenKeeper
+	paramstore    paramtypes.Subspace
+}
+
+// NewKeeper creates a new bridge Keeper instance
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey, ps paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, tk types.TokenKeeper,
+) Keeper {
+	// ensure bridge module account is set, it mints and burns the vouchers
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		authKeeper:    ak,
+		bankKeeper:    bk,
+		stakingKeeper: sk,
+		tokenKeeper:   tk,
+		paramstore:    ps,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// getUint64 returns the counter stored at a key, zero if unset
+func (k Keeper) getUint64(ctx sdk.Context, key []byte) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(key)
+	if value == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(value)
+}
+
+// setUint64 sets the counter stored at a key
+func (k Keeper) setUint64(ctx sdk.Context, key []byte, value uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, sdk.Uint64ToBigEndian(value))
+}
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the bridge MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) SetOrchestratorAddress(
+	goCtx context.Context, msg *types.MsgSetOrchestratorAddress,
+) (*types.MsgSetOrchestratorAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+	orchestrator, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetOrchestratorAddress(ctx, validator, orchestrator, msg.EthAddress); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetOrchestrator,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Validator),
+			sdk.NewAttribute(types.AttributeKeyOrchestrator, msg.Orchestrator),
+			sdk.NewAttribute(types.AttributeKeyEthAddress, types.NormalizeEthAddress(msg.EthAddress)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sdk.AccAddress(validator).String()),
+		),
+	})
+
+	return &types.MsgSetOrchestratorAddressResponse{}, nil
+}
+
+func (k msgServer) DepositClaim(
+	goCtx context.Context, msg *types.MsgDepositClaim,
+) (*types.MsgDepositClaimResponse, error) {
+	if err := k.attest(sdk.UnwrapSDKContext(goCtx), msg.Orchestrator, msg.Claim()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositClaimResponse{}, nil
+}
+
+func (k msgServer) BatchExecutedClaim(
+	goCtx context.Context, msg *types.MsgBatchExecutedClaim,
+) (*types.MsgBatchExecutedClaimResponse, error) {
+	if err := k.attest(sdk.UnwrapSDKContext(goCtx), msg.Orchestrator, msg.Claim()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBatchExecutedClaimResponse{}, nil
+}
+
+// attest records the claim of the validator of an orchestrator
+func (k msgServer) attest(ctx sdk.Context, orchestratorAddr string, claim types.Claim) error {
+	orchestrator, err := sdk.AccAddressFromBech32(orchestratorAddr)
+	if err != nil {
+		return err
+	}
+
+	attestation, err := k.Attest(ctx, orchestrator, claim)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaim,
+			sdk.NewAttribute(types.AttributeKeyClaimType, claim.Type.String()),
+			sdk.NewAttribute(types.AttributeKeyEventNonce, strconv.FormatUint(claim.EventNonce, 10)),
+			sdk.NewAttribute(types.AttributeKeyClaimHash, hex.EncodeToString(claim.Hash())),
+			sdk.NewAttribute(types.AttributeKeyValidator, attestation.Votes[len(attestation.Votes)-1]),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, orchestratorAddr),
+		),
+	})
+
+	return nil
+}
+
+func (k msgServer) SendToExternal(
+	goCtx context.Context, msg *types.MsgSendToExternal,
+) (*types.MsgSendToExternalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.SendToExternal(ctx, sender, msg.EthDest, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSendToExternal,
+			sdk.NewAttribute(types.AttributeKeyTxID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyEthDest, types.NormalizeEthAddress(msg.EthDest)),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSendToExternalResponse{Id: id}, nil
+}
+
+func (k msgServer) ConfirmBatch(
+	goCtx context.Context, msg *types.MsgConfirmBatch,
+) (*types.MsgConfirmBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	orchestrator, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		return nil, err
+	}
+
+	signed, err := k.Keeper.ConfirmBatch(ctx, orchestrator, msg.TokenContract, msg.BatchNonce, msg.EthSigner, msg.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenContract := types.NormalizeEthAddress(msg.TokenContract)
+	batchNonce := strconv.FormatUint(msg.BatchNonce, 10)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBatchConfirm,
+			sdk.NewAttribute(types.AttributeKeyTokenContract, tokenContract),
+			sdk.NewAttribute(types.AttributeKeyBatchNonce, batchNonce),
+			sdk.NewAttribute(types.AttributeKeyEthAddress, types.NormalizeEthAddress(msg.EthSigner)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Orchestrator),
+		),
+	})
+
+	// relayers wait for this event to submit the batch to the bridge contract
+	if signed {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBatchSigned,
+				sdk.NewAttribute(types.AttributeKeyTokenContract, tokenContract),
+				sdk.NewAttribute(types.AttributeKeyBatchNonce, batchNonce),
+			),
+		)
+	}
+
+	return &types.MsgConfirmBatchResponse{}, nil
+}
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+// GetOrchestratorKeys returns the orchestrator keys of a validator
+func (k Keeper) GetOrchestratorKeys(ctx sdk.Context, validator sdk.ValAddress) (keys types.OrchestratorKeys, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetOrchestratorKeysKey(validator))
+	if value == nil {
+		return keys, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &keys)
+	return keys, true
+}
+
+// SetOrchestratorKeys sets the orchestrator keys of a validator and indexes the
+// validator by its orchestrator account and its EVM address
+func (k Keeper) SetOrchestratorKeys(ctx sdk.Context, keys types.OrchestratorKeys) {
+	store := ctx.KVStore(k.storeKey)
+
+	validator := keys.GetValidator()
+	store.Set(types.GetOrchestratorKeysKey(validator), k.cdc.MustMarshalBinaryBare(&keys))
+	store.Set(types.GetOrchestratorIndexKey(keys.GetOrchestrator()), validator.Bytes())
+	store.Set(types.GetEthAddressIndexKey(keys.EthAddress), validator.Bytes())
+}
+
+// deleteOrchestratorKeys deletes the orchestrator keys of a validator and their indexes
+func (k Keeper) deleteOrchestratorKeys(ctx sdk.Context, keys types.OrchestratorKeys) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetOrchestratorKeysKey(keys.GetValidator()))
+	store.Delete(types.GetOrchestratorIndexKey(keys.GetOrchestrator()))
+	store.Delete(types.GetEthAddressIndexKey(keys.EthAddress))
+}
+
+// IterateOrchestratorKeys iterates through the orchestrator keys by validator
+func (k Keeper) IterateOrchestratorKeys(ctx sdk.Context, fn func(keys types.OrchestratorKeys) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.OrchestratorKeysKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var keys types.OrchestratorKeys
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &keys)
+		if fn(keys) {
+			break
+		}
+	}
+}
+
+// GetAllOrchestratorKeys returns the orchestrator keys of all the validators
+func (k Keeper) GetAllOrchestratorKeys(ctx sdk.Context) (keys []types.OrchestratorKeys) {
+	k.IterateOrchestratorKeys(ctx, func(orchestratorKeys types.OrchestratorKeys) bool {
+		keys = append(keys, orchestratorKeys)
+		return false
+	})
+	return keys
+}
+
+// GetValidatorByOrchestrator returns the validator an account is the orchestrator of
+func (k Keeper) GetValidatorByOrchestrator(ctx sdk.Context, orchestrator sdk.AccAddress) (sdk.ValAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetOrchestratorIndexKey(orchestrator))
+	if value == nil {
+		return nil, false
+	}
+
+	return sdk.ValAddress(value), true
+}
+
+// GetValidatorByEthAddress returns the validator signing with an EVM address
+func (k Keeper) GetValidatorByEthAddress(ctx sdk.Context, ethAddress string) (sdk.ValAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetEthAddressIndexKey(ethAddress))
+	if value == nil {
+		return nil, false
+	}
+
+	return sdk.ValAddress(value), true
+}
+
+// SetOrchestratorAddress registers the orchestrator account and the EVM address
+// of a validator, replacing its previous ones. The keys may be registered
+// before the validator is bonded but cannot be shared with another validator
+func (k Keeper) SetOrchestratorAddress(
+	ctx sdk.Context, validator sdk.ValAddress, orchestrator sdk.AccAddress, ethAddress string,
+) error {
+	if k.stakingKeeper.Validator(ctx, validator) == nil {
+		return sdkerrors.Wrap(types.ErrNotValidator, validator.String())
+	}
+
+	if owner, found := k.GetValidatorByOrchestrator(ctx, orchestrator); found && !owner.Equals(validator) {
+		return sdkerrors.Wrapf(types.ErrKeysInUse, "%s is the orchestrator of %s", orchestrator, owner)
+	}
+	if owner, found := k.GetValidatorByEthAddress(ctx, ethAddress); found && !owner.Equals(validator) {
+		return sdkerrors.Wrapf(types.ErrKeysInUse, "%s is the EVM address of %s", ethAddress, owner)
+	}
+
+	if previous, found := k.GetOrchestratorKeys(ctx, validator); found {
+		k.deleteOrchestratorKeys(ctx, previous)
+	}
+	k.SetOrchestratorKeys(ctx, types.NewOrchestratorKeys(validator, orchestrator, ethAddress))
+
+	return nil
+}
+
+// GetBondedValidatorByOrchestrator returns the validator an account is the
+// orchestrator of, which must be bonded to attest claims and confirm batches
+func (k Keeper) GetBondedValidatorByOrchestrator(
+	ctx sdk.Context, orchestrator sdk.AccAddress,
+) (types.OrchestratorKeys, error) {
+	validator, found := k.GetValidatorByOrchestrator(ctx, orchestrator)
+	if !found {
+		return types.OrchestratorKeys{}, sdkerrors.Wrap(types.ErrNotOrchestrator, orchestrator.String())
+	}
+
+	if !k.IsBonded(ctx, validator) {
+		return types.OrchestratorKeys{}, sdkerrors.Wrap(types.ErrNotValidator, validator.String())
+	}
+
+	keys, _ := k.GetOrchestratorKeys(ctx, validator)
+	return keys, nil
+}
+
+// IsBonded returns whether a validator exists and is bonded
+func (k Keeper) IsBonded(ctx sdk.Context, validator sdk.ValAddress) bool {
+	v := k.stakingKeeper.Validator(ctx, validator)
+	return v != nil && v.IsBonded()
+}
+
+// bondedPowers returns the consensus power of each bonded validator by operator
+// address and their total power
+func (k Keeper) bondedPowers(ctx sdk.Context) (map[string]int64, int64) {
+	powers := make(map[string]int64)
+	var totalPower int64
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		power := validator.GetConsensusPower()
+		powers[validator.GetOperator().String()] = power
+		totalPower += power
+		return false
+	})
+	return powers, totalPower
+}
+
+// GetCurrentValset returns the EVM addresses of the bonded validators which
+// registered one, with their power normalized so that the power of all the
+// bonded validators adds up to 2^32, sorted by decreasing power
+func (k Keeper) GetCurrentValset(ctx sdk.Context) []types.BridgeValidator {
+	powers, totalPower := k.bondedPowers(ctx)
+	if totalPower == 0 {
+		return []types.BridgeValidator{}
+	}
+
+	total := sdk.NewInt(totalPower)
+	valset := []types.BridgeValidator{}
+	k.IterateOrchestratorKeys(ctx, func(keys types.OrchestratorKeys) bool {
+		power, bonded := powers[keys.Validator]
+		if !bonded || power == 0 {
+			return false
+		}
+
+		normalized := sdk.NewInt(power).MulRaw(1 << 32).Quo(total)
+		valset = append(valset, types.BridgeValidator{Power: normalized.Uint64(), EthAddress: keys.EthAddress})
+		return false
+	})
+
+	sort.SliceStable(valset, func(i, j int) bool {
+		if valset[i].Power != valset[j].Power {
+			return valset[i].Power > valset[j].Power
+		}
+		return valset[i].EthAddress < valset[j].EthAddress
+	})
+
+	return valset
+}
+
+// PowerThreshold returns the normalized power of the valset the signatures of
+// a batch must hold for the bridge contract to execute it
+func (k Keeper) PowerThreshold(ctx sdk.Context) uint64 {
+	return k.AttestationThreshold(ctx).MulInt64(1 << 32).TruncateInt().Uint64()
+}
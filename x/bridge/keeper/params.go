@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+// BridgeID - Identifier of the bridge signed in the checkpoints
+func (k Keeper) BridgeID(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyBridgeID, &res)
+	return
+}
+
+// BridgeChainID - Chain id of the EVM chain of the bridge contract
+func (k Keeper) BridgeChainID(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyBridgeChainID, &res)
+	return
+}
+
+// BridgeContractAddress - Address of the bridge contract
+func (k Keeper) BridgeContractAddress(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyBridgeContractAddress, &res)
+	return
+}
+
+// AttestationThreshold - Rate of the bonded power needed to observe a claim or release a batch
+func (k Keeper) AttestationThreshold(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyAttestationThreshold, &res)
+	return
+}
+
+// BatchMaxSize - Maximum number of transfers of a batch
+func (k Keeper) BatchMaxSize(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyBatchMaxSize, &res)
+	return
+}
+
+// BatchTimeout - Number of EVM blocks a batch can be executed in
+func (k Keeper) BatchTimeout(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyBatchTimeout, &res)
+	return
+}
+
+// Get all parameteras as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
+}
+
+// set the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
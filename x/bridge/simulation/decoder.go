@@ -0,0 +1,66 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding bridge type.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.OrchestratorKeysKey):
+			var keysA, keysB types.OrchestratorKeys
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &keysA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &keysB)
+
+			return fmt.Sprintf("%v\n%v", keysA, keysB)
+		case bytes.Equal(kvA.Key[:1], types.OrchestratorIndexKey),
+			bytes.Equal(kvA.Key[:1], types.EthAddressIndexKey):
+			return fmt.Sprintf("%s\n%s", sdk.ValAddress(kvA.Value), sdk.ValAddress(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.AttestationKey):
+			var attestationA, attestationB types.Attestation
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &attestationA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &attestationB)
+
+			return fmt.Sprintf("%v\n%v", attestationA, attestationB)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorEventNonceKey),
+			bytes.Equal(kvA.Key[:1], types.LastObservedEventNonceKey),
+			bytes.Equal(kvA.Key[:1], types.LastObservedEthBlockHeightKey),
+			bytes.Equal(kvA.Key[:1], types.LastTxIDKey),
+			bytes.Equal(kvA.Key[:1], types.LastBatchNonceKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.OutgoingTxKey):
+			var txA, txB types.OutgoingTransferTx
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &txA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &txB)
+
+			return fmt.Sprintf("%v\n%v", txA, txB)
+		case bytes.Equal(kvA.Key[:1], types.OutgoingTxBatchKey):
+			var batchA, batchB types.OutgoingTxBatch
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &batchA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &batchB)
+
+			return fmt.Sprintf("%v\n%v", batchA, batchB)
+		case bytes.Equal(kvA.Key[:1], types.BatchConfirmKey):
+			var confirmA, confirmB types.BatchConfirm
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &confirmA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &confirmB)
+
+			return fmt.Sprintf("%v\n%v", confirmA, confirmB)
+		default:
+			panic(fmt.Sprintf("invalid bridge key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
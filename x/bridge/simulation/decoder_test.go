@@ -0,0 +1,74 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/bridge/simulation"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+var (
+	addr1    = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	valAddr1 = sdk.ValAddress(addr1)
+
+	ethAddress    = "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	tokenContract = "0xdac17f958d2ee523a2206206994597c13d831ec7"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc, _ := simapp.MakeCodecs()
+	dec := simulation.NewDecodeStore(cdc)
+
+	keys := types.NewOrchestratorKeys(valAddr1, addr1, ethAddress)
+	claim := types.NewDepositClaim(1, 100, tokenContract, sdk.NewInt(1000), ethAddress, addr1.String())
+	attestation := types.NewAttestation(claim, 10)
+	attestation.Votes = []string{valAddr1.String()}
+	tx := types.NewOutgoingTransferTx(1, addr1, ethAddress, tokenContract, sdk.NewInt(1000))
+	batch := types.NewOutgoingTxBatch(1, tokenContract, []types.OutgoingTransferTx{tx}, 200, 10)
+	confirm := types.NewBatchConfirm(tokenContract, 1, valAddr1, ethAddress, make([]byte, types.EthSignatureLength))
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetOrchestratorKeysKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&keys)},
+			{Key: types.GetOrchestratorIndexKey(addr1), Value: valAddr1.Bytes()},
+			{Key: types.GetAttestationKey(1, claim.Hash()), Value: cdc.MustMarshalBinaryBare(&attestation)},
+			{Key: types.GetValidatorEventNonceKey(valAddr1), Value: sdk.Uint64ToBigEndian(1)},
+			{Key: types.GetOutgoingTxKey(tokenContract, 1), Value: cdc.MustMarshalBinaryBare(&tx)},
+			{Key: types.GetOutgoingTxBatchKey(tokenContract, 1), Value: cdc.MustMarshalBinaryBare(&batch)},
+			{Key: types.GetBatchConfirmKey(tokenContract, 1, valAddr1), Value: cdc.MustMarshalBinaryBare(&confirm)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"orchestratorKeys", fmt.Sprintf("%v\n%v", keys, keys)},
+		{"orchestratorIndex", fmt.Sprintf("%s\n%s", valAddr1, valAddr1)},
+		{"attestation", fmt.Sprintf("%v\n%v", attestation, attestation)},
+		{"validatorEventNonce", "1\n1"},
+		{"outgoingTx", fmt.Sprintf("%v\n%v", tx, tx)},
+		{"outgoingTxBatch", fmt.Sprintf("%v\n%v", batch, batch)},
+		{"batchConfirm", fmt.Sprintf("%v\n%v", confirm, confirm)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
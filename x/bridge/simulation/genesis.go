@@ -0,0 +1,99 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+// Simulation parameter constants
+const (
+	bridgeChainID         = "bridge_chain_id"
+	bridgeContractAddress = "bridge_contract_address"
+	attestationThreshold  = "attestation_threshold"
+	batchMaxSize          = "batch_max_size"
+	batchTimeout          = "batch_timeout"
+)
+
+// GenBridgeChainID randomized bridgeChainID
+func GenBridgeChainID(r *rand.Rand) uint64 {
+	return uint64(1 + r.Intn(100))
+}
+
+// GenBridgeContractAddress randomized bridgeContractAddress
+func GenBridgeContractAddress(r *rand.Rand) string {
+	return types.EthAddressFromPubKey(&types.EthPrivKeyFromSecret([]byte(fmt.Sprint(r.Int63()))).PublicKey)
+}
+
+// GenAttestationThreshold randomized attestationThreshold, above half of the power
+func GenAttestationThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(51+int64(r.Intn(50)), 2)
+}
+
+// GenBatchMaxSize randomized batchMaxSize
+func GenBatchMaxSize(r *rand.Rand) uint64 {
+	return uint64(1 + r.Intn(100))
+}
+
+// GenBatchTimeout randomized batchTimeout
+func GenBatchTimeout(r *rand.Rand) uint64 {
+	return uint64(10 + r.Intn(1000))
+}
+
+// RandomizedGenState generates a random GenesisState for bridge
+func RandomizedGenState(simState *module.SimulationState) {
+	// params
+	var (
+		bridgeChainIDL         uint64
+		bridgeContractAddressL string
+		attestationThresholdL  sdk.Dec
+		batchMaxSizeL          uint64
+		batchTimeoutL          uint64
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, bridgeChainID, &bridgeChainIDL, simState.Rand,
+		func(r *rand.Rand) { bridgeChainIDL = GenBridgeChainID(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, bridgeContractAddress, &bridgeContractAddressL, simState.Rand,
+		func(r *rand.Rand) { bridgeContractAddressL = GenBridgeContractAddress(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, attestationThreshold, &attestationThresholdL, simState.Rand,
+		func(r *rand.Rand) { attestationThresholdL = GenAttestationThreshold(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, batchMaxSize, &batchMaxSizeL, simState.Rand,
+		func(r *rand.Rand) { batchMaxSizeL = GenBatchMaxSize(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, batchTimeout, &batchTimeoutL, simState.Rand,
+		func(r *rand.Rand) { batchTimeoutL = GenBatchTimeout(r) },
+	)
+
+	params := types.NewParams(
+		types.DefaultBridgeID, bridgeChainIDL, bridgeContractAddressL,
+		attestationThresholdL, batchMaxSizeL, batchTimeoutL,
+	)
+
+	bridgeGenesis := types.DefaultGenesisState()
+	bridgeGenesis.Params = params
+
+	bz, err := json.MarshalIndent(&bridgeGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated bridge parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(bridgeGenesis)
+}
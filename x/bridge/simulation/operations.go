@@ -0,0 +1,427 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	gaussimappparams "github.com/gauss/gauss/v4/simapp/params"
+	"github.com/gauss/gauss/v4/x/bridge/keeper"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSetOrchestratorAddress = "op_weight_msg_set_orchestrator_address"
+	OpWeightMsgClaim                  = "op_weight_msg_claim"
+	OpWeightMsgSendToExternal         = "op_weight_msg_send_to_external"
+	OpWeightMsgConfirmBatch           = "op_weight_msg_confirm_batch"
+)
+
+// simTokenContracts are the token contracts deposited to the simulated bridge
+var simTokenContracts = []string{
+	ethAddressFromSecret([]byte("token-a")),
+	ethAddressFromSecret([]byte("token-b")),
+	ethAddressFromSecret([]byte("token-c")),
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler, ak types.AccountKeeper,
+	bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgSetOrchestratorAddress int
+		weightMsgClaim                  int
+		weightMsgSendToExternal         int
+		weightMsgConfirmBatch           int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetOrchestratorAddress, &weightMsgSetOrchestratorAddress, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetOrchestratorAddress = gaussimappparams.DefaultWeightMsgSetOrchestratorAddress
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgClaim, &weightMsgClaim, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaim = gaussimappparams.DefaultWeightMsgBridgeClaim
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendToExternal, &weightMsgSendToExternal, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendToExternal = gaussimappparams.DefaultWeightMsgSendToExternal
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgConfirmBatch, &weightMsgConfirmBatch, nil,
+		func(_ *rand.Rand) {
+			weightMsgConfirmBatch = gaussimappparams.DefaultWeightMsgConfirmBatch
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgSetOrchestratorAddress,
+			SimulateMsgSetOrchestratorAddress(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClaim,
+			SimulateMsgClaim(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSendToExternal,
+			SimulateMsgSendToExternal(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgConfirmBatch,
+			SimulateMsgConfirmBatch(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgSetOrchestratorAddress generates a MsgSetOrchestratorAddress for
+// a bonded validator without orchestrator keys. The operator account is the
+// orchestrator and the EVM key is derived from the operator address, so that
+// the other operations can sign with it
+func SimulateMsgSetOrchestratorAddress(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		for _, i := range r.Perm(len(accs)) {
+			operator := accs[i]
+			validator := sdk.ValAddress(operator.Address)
+
+			if _, found := k.GetOrchestratorKeys(ctx, validator); found {
+				continue
+			}
+			if _, found := k.GetValidatorByOrchestrator(ctx, operator.Address); found {
+				continue
+			}
+			// only the bonded validators are known not to have been removed
+			if !k.IsBonded(ctx, validator) {
+				continue
+			}
+
+			msg := types.NewMsgSetOrchestratorAddress(validator, operator.Address, ethAddressFromSecret(validator))
+
+			return deliverMsg(r, app, ctx, ak, bk, operator, msg, nil, chainID)
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetOrchestratorAddress, "no bonded validator without keys"), nil, nil
+	}
+}
+
+// SimulateMsgClaim generates the claim of the next event of a registered
+// validator, acting as the fake relayer of the simulation: it attests a claim
+// already attested at this nonce by the other validators, or the execution of a
+// signed batch, or else a deposit of a simulated token to a random account. The
+// other orchestrators attest a new event in the next block
+func SimulateMsgClaim(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		orchestrator, keys, found := randomOrchestrator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDepositClaim, "no bonded orchestrator"), nil, nil
+		}
+
+		nonce := k.GetNextEventNonce(ctx, keys.GetValidator())
+		if attestations := k.GetAttestationsByNonce(ctx, nonce); len(attestations) > 0 {
+			return deliverMsg(r, app, ctx, ak, bk, orchestrator, claimMsg(attestations[0].Claim, orchestrator), nil, chainID)
+		}
+
+		var claim types.Claim
+		ethBlockHeight := k.GetLastObservedEthBlockHeight(ctx) + 1 + uint64(r.Intn(10))
+		if batch, found := randomSignedBatch(r, ctx, k); found {
+			claim = types.NewBatchExecutedClaim(nonce, ethBlockHeight, batch.TokenContract, batch.BatchNonce)
+		} else {
+			receiver, _ := simtypes.RandomAcc(r, accs)
+			claim = types.NewDepositClaim(
+				nonce, ethBlockHeight,
+				simTokenContracts[r.Intn(len(simTokenContracts))],
+				sdk.NewInt(1+r.Int63n(1e12)),
+				ethAddressFromSecret(receiver.Address),
+				receiver.Address.String(),
+			)
+		}
+
+		opMsg, _, err := deliverMsg(r, app, ctx, ak, bk, orchestrator, claimMsg(claim, orchestrator), nil, chainID)
+		if err != nil {
+			return opMsg, nil, err
+		}
+
+		return opMsg, relayOperations(ctx, k, accs, keys, func(orchestrator simtypes.Account) simtypes.Operation {
+			return simulateMsgClaimOf(ak, bk, k, orchestrator)
+		}), nil
+	}
+}
+
+// simulateMsgClaimOf generates the claim of an orchestrator attesting the
+// pending claim of its next event
+func simulateMsgClaimOf(
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, orchestrator simtypes.Account,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		keys, err := k.GetBondedValidatorByOrchestrator(ctx, orchestrator.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDepositClaim, "no bonded orchestrator"), nil, nil
+		}
+
+		attestations := k.GetAttestationsByNonce(ctx, k.GetNextEventNonce(ctx, keys.GetValidator()))
+		if len(attestations) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDepositClaim, "no pending claim"), nil, nil
+		}
+
+		return deliverMsg(r, app, ctx, ak, bk, orchestrator, claimMsg(attestations[0].Claim, orchestrator), nil, chainID)
+	}
+}
+
+// SimulateMsgSendToExternal generates a MsgSendToExternal of a random amount of
+// the vouchers of an account
+func SimulateMsgSendToExternal(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		for _, i := range r.Perm(len(accs)) {
+			simAccount := accs[i]
+
+			vouchers := sdk.Coins{}
+			for _, coin := range bk.SpendableCoins(ctx, simAccount.Address) {
+				if _, ok := types.GetTokenContract(coin.Denom); ok {
+					vouchers = append(vouchers, coin)
+				}
+			}
+			if vouchers.Empty() {
+				continue
+			}
+
+			voucher := vouchers[r.Intn(len(vouchers))]
+			amount, err := simtypes.RandPositiveInt(r, voucher.Amount)
+			if err != nil {
+				continue
+			}
+			coin := sdk.NewCoin(voucher.Denom, amount)
+
+			msg := types.NewMsgSendToExternal(simAccount.Address, ethAddressFromSecret(simAccount.Address), coin)
+
+			return deliverMsg(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(coin), chainID)
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSendToExternal, "no voucher"), nil, nil
+	}
+}
+
+// SimulateMsgConfirmBatch generates a MsgConfirmBatch of a batch not confirmed
+// yet by a registered validator, signed with its derived EVM key. The other
+// orchestrators confirm a new batch in the next block
+func SimulateMsgConfirmBatch(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		orchestrator, keys, found := randomOrchestrator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConfirmBatch, "no bonded orchestrator"), nil, nil
+		}
+		validator := keys.GetValidator()
+
+		var unconfirmed []types.OutgoingTxBatch
+		k.IterateOutgoingTxBatches(ctx, func(batch types.OutgoingTxBatch) bool {
+			if _, found := k.GetBatchConfirm(ctx, batch.TokenContract, batch.BatchNonce, validator); !found {
+				unconfirmed = append(unconfirmed, batch)
+			}
+			return false
+		})
+		if len(unconfirmed) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConfirmBatch, "no unconfirmed batch"), nil, nil
+		}
+
+		batch := unconfirmed[r.Intn(len(unconfirmed))]
+		isNew := len(k.GetBatchConfirms(ctx, batch.TokenContract, batch.BatchNonce)) == 0
+
+		opMsg, _, err := deliverConfirmBatch(r, app, ctx, ak, bk, k, orchestrator, keys, batch, chainID)
+		if err != nil || !isNew {
+			return opMsg, nil, err
+		}
+
+		return opMsg, relayOperations(ctx, k, accs, keys, func(orchestrator simtypes.Account) simtypes.Operation {
+			return simulateMsgConfirmBatchOf(ak, bk, k, orchestrator, batch.TokenContract, batch.BatchNonce)
+		}), nil
+	}
+}
+
+// simulateMsgConfirmBatchOf generates the MsgConfirmBatch of a batch by an
+// orchestrator, unless the batch is gone or already confirmed
+func simulateMsgConfirmBatchOf(
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, orchestrator simtypes.Account,
+	tokenContract string, batchNonce uint64,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		keys, err := k.GetBondedValidatorByOrchestrator(ctx, orchestrator.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConfirmBatch, "no bonded orchestrator"), nil, nil
+		}
+
+		batch, found := k.GetOutgoingTxBatch(ctx, tokenContract, batchNonce)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConfirmBatch, "batch not found"), nil, nil
+		}
+		if _, found := k.GetBatchConfirm(ctx, tokenContract, batchNonce, keys.GetValidator()); found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConfirmBatch, "batch already confirmed"), nil, nil
+		}
+
+		return deliverConfirmBatch(r, app, ctx, ak, bk, k, orchestrator, keys, batch, chainID)
+	}
+}
+
+func deliverConfirmBatch(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+	orchestrator simtypes.Account, keys types.OrchestratorKeys, batch types.OutgoingTxBatch, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	signature, err := types.SignEthMessage(
+		types.EthPrivKeyFromSecret(keys.GetValidator()), types.GetBatchCheckpoint(k.BridgeID(ctx), batch),
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConfirmBatch, "unable to sign the batch"), nil, err
+	}
+
+	msg := types.NewMsgConfirmBatch(
+		batch.TokenContract, batch.BatchNonce, keys.EthAddress, hex.EncodeToString(signature), orchestrator.Address,
+	)
+
+	return deliverMsg(r, app, ctx, ak, bk, orchestrator, msg, nil, chainID)
+}
+
+// relayOperations returns the operations of the orchestrators of the other
+// registered validators in the next block
+func relayOperations(
+	ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, keys types.OrchestratorKeys,
+	op func(orchestrator simtypes.Account) simtypes.Operation,
+) []simtypes.FutureOperation {
+	var futureOps []simtypes.FutureOperation
+	k.IterateOrchestratorKeys(ctx, func(other types.OrchestratorKeys) bool {
+		if other.Validator == keys.Validator {
+			return false
+		}
+		if orchestrator, found := simtypes.FindAccount(accs, other.GetOrchestrator()); found {
+			futureOps = append(futureOps, simtypes.FutureOperation{
+				BlockHeight: int(ctx.BlockHeight()) + 1,
+				Op:          op(orchestrator),
+			})
+		}
+		return false
+	})
+
+	return futureOps
+}
+
+// randomOrchestrator returns the account and the keys of a random orchestrator
+// of a bonded validator among the simulated accounts
+func randomOrchestrator(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account,
+) (simtypes.Account, types.OrchestratorKeys, bool) {
+	for _, i := range r.Perm(len(accs)) {
+		keys, err := k.GetBondedValidatorByOrchestrator(ctx, accs[i].Address)
+		if err == nil {
+			return accs[i], keys, true
+		}
+	}
+
+	return simtypes.Account{}, types.OrchestratorKeys{}, false
+}
+
+// randomSignedBatch returns a random batch signed by the validators
+func randomSignedBatch(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (types.OutgoingTxBatch, bool) {
+	var signed []types.OutgoingTxBatch
+	k.IterateOutgoingTxBatches(ctx, func(batch types.OutgoingTxBatch) bool {
+		if k.IsBatchSigned(ctx, batch) {
+			signed = append(signed, batch)
+		}
+		return false
+	})
+	if len(signed) == 0 {
+		return types.OutgoingTxBatch{}, false
+	}
+
+	return signed[r.Intn(len(signed))], true
+}
+
+func claimMsg(claim types.Claim, orchestrator simtypes.Account) sdk.Msg {
+	if claim.Type == types.ClaimTypeDeposit {
+		return types.NewMsgDepositClaim(
+			claim.EventNonce, claim.EthBlockHeight, claim.TokenContract, claim.Amount,
+			claim.EthSender, claim.Receiver, orchestrator.Address,
+		)
+	}
+
+	return types.NewMsgBatchExecutedClaim(
+		claim.EventNonce, claim.EthBlockHeight, claim.TokenContract, claim.BatchNonce, orchestrator.Address,
+	)
+}
+
+func ethAddressFromSecret(secret []byte) string {
+	privKey := types.EthPrivKeyFromSecret(secret)
+	return types.EthAddressFromPubKey(&privKey.PublicKey)
+}
+
+func deliverMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	simAccount simtypes.Account, msg sdk.Msg, spent sdk.Coins, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := ak.GetAccount(ctx, simAccount.Address)
+	if account == nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "account not found"), nil, nil
+	}
+
+	spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+	var (
+		fees sdk.Coins
+		err  error
+	)
+
+	coins, hasNeg := spendable.SafeSub(spent)
+	if hasNeg {
+		coins = nil
+	}
+
+	if !coins.Empty() {
+		fees, err = simtypes.RandomFees(r, ctx, coins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
@@ -0,0 +1,35 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyAttestationThreshold),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenAttestationThreshold(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBatchMaxSize),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenBatchMaxSize(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBatchTimeout),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenBatchTimeout(r))
+			},
+		),
+	}
+}
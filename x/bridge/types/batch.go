@@ -0,0 +1,144 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewOutgoingTransferTx creates a transfer of burnt vouchers to an EVM address
+func NewOutgoingTransferTx(id uint64, sender sdk.AccAddress, destAddress, tokenContract string, amount sdk.Int) OutgoingTransferTx {
+	return OutgoingTransferTx{
+		Id:            id,
+		Sender:        sender.String(),
+		DestAddress:   NormalizeEthAddress(destAddress),
+		TokenContract: NormalizeEthAddress(tokenContract),
+		Amount:        amount,
+	}
+}
+
+// Validate performs a basic validation of the transfer
+func (tx OutgoingTransferTx) Validate() error {
+	if tx.Id == 0 {
+		return fmt.Errorf("transfer id must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(tx.Sender); err != nil {
+		return err
+	}
+	if err := ValidateEthAddress(tx.DestAddress); err != nil {
+		return err
+	}
+	if err := ValidateEthAddress(tx.TokenContract); err != nil {
+		return err
+	}
+	if tx.Amount.IsNil() || !tx.Amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "transfer amount must be positive: %s", tx.Amount)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (tx OutgoingTransferTx) String() string {
+	out, _ := yaml.Marshal(tx)
+	return string(out)
+}
+
+// NewOutgoingTxBatch creates a batch of transfers of a token
+func NewOutgoingTxBatch(
+	batchNonce uint64, tokenContract string, transactions []OutgoingTransferTx, batchTimeout uint64, block int64,
+) OutgoingTxBatch {
+	return OutgoingTxBatch{
+		BatchNonce:    batchNonce,
+		TokenContract: NormalizeEthAddress(tokenContract),
+		Transactions:  transactions,
+		BatchTimeout:  batchTimeout,
+		Block:         block,
+	}
+}
+
+// Validate performs a basic validation of the batch
+func (b OutgoingTxBatch) Validate() error {
+	if b.BatchNonce == 0 {
+		return fmt.Errorf("batch nonce must be positive")
+	}
+	if err := ValidateEthAddress(b.TokenContract); err != nil {
+		return err
+	}
+	if len(b.Transactions) == 0 {
+		return fmt.Errorf("batch %d has no transfer", b.BatchNonce)
+	}
+	for _, tx := range b.Transactions {
+		if err := tx.Validate(); err != nil {
+			return err
+		}
+		if tx.TokenContract != b.TokenContract {
+			return fmt.Errorf("transfer %d of batch %d is not of token %s", tx.Id, b.BatchNonce, b.TokenContract)
+		}
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (b OutgoingTxBatch) String() string {
+	out, _ := yaml.Marshal(b)
+	return string(out)
+}
+
+// NewBatchConfirm creates the confirmation of a batch by a validator
+func NewBatchConfirm(
+	tokenContract string, batchNonce uint64, validator sdk.ValAddress, ethSigner string, signature []byte,
+) BatchConfirm {
+	return BatchConfirm{
+		BatchNonce:    batchNonce,
+		TokenContract: NormalizeEthAddress(tokenContract),
+		Validator:     validator.String(),
+		EthSigner:     NormalizeEthAddress(ethSigner),
+		Signature:     hex.EncodeToString(signature),
+	}
+}
+
+// GetValidator returns the validator of the confirmation
+func (c BatchConfirm) GetValidator() sdk.ValAddress {
+	validator, err := sdk.ValAddressFromBech32(c.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return validator
+}
+
+// Validate performs a basic validation of the confirmation
+func (c BatchConfirm) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(c.Validator); err != nil {
+		return err
+	}
+	if err := ValidateEthAddress(c.TokenContract); err != nil {
+		return err
+	}
+	if err := ValidateEthAddress(c.EthSigner); err != nil {
+		return err
+	}
+	_, err := ParseEthSignature(c.Signature)
+	return err
+}
+
+// String implements the Stringer interface.
+func (c BatchConfirm) String() string {
+	out, _ := yaml.Marshal(c)
+	return string(out)
+}
+
+// ParseEthSignature returns the bytes of the hex of an EVM signature
+func ParseEthSignature(signature string) ([]byte, error) {
+	bz, err := hex.DecodeString(signature)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidSignature, err.Error())
+	}
+	if len(bz) != EthSignatureLength {
+		return nil, sdkerrors.Wrapf(ErrInvalidSignature, "signature is not %d bytes long", EthSignatureLength)
+	}
+	return bz, nil
+}
@@ -0,0 +1,2987 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gauss/bridge/bridge.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ClaimType enumerates the events of the bridge contract the validators attest.
+type ClaimType int32
+
+const (
+	// UNSPECIFIED defines an invalid claim type.
+	ClaimTypeUnspecified ClaimType = 0
+	// DEPOSIT defines a deposit of tokens locked in the bridge contract.
+	ClaimTypeDeposit ClaimType = 1
+	// BATCH_EXECUTED defines the release of the transfers of a batch by the
+	// bridge contract.
+	ClaimTypeBatchExecuted ClaimType = 2
+)
+
+var ClaimType_name = map[int32]string{
+	0: "CLAIM_TYPE_UNSPECIFIED",
+	1: "CLAIM_TYPE_DEPOSIT",
+	2: "CLAIM_TYPE_BATCH_EXECUTED",
+}
+
+var ClaimType_value = map[string]int32{
+	"CLAIM_TYPE_UNSPECIFIED":    0,
+	"CLAIM_TYPE_DEPOSIT":        1,
+	"CLAIM_TYPE_BATCH_EXECUTED": 2,
+}
+
+func (x ClaimType) String() string {
+	return proto.EnumName(ClaimType_name, int32(x))
+}
+
+func (ClaimType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_80baa54becede095, []int{0}
+}
+
+// Params defines the parameters for the bridge module.
+type Params struct {
+	// identifier of the bridge, signed in the checkpoints of the batches so that
+	// the signatures cannot be replayed on another bridge
+	BridgeId string `protobuf:"bytes,1,opt,name=bridge_id,json=bridgeId,proto3" json:"bridge_id,omitempty" yaml:"bridge_id"`
+	// chain id of the EVM chain the bridge contract is deployed on
+	BridgeChainId uint64 `protobuf:"varint,2,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty" yaml:"bridge_chain_id"`
+	// address of the bridge contract on the EVM chain
+	BridgeContractAddress string `protobuf:"bytes,3,opt,name=bridge_contract_address,json=bridgeContractAddress,proto3" json:"bridge_contract_address,omitempty" yaml:"bridge_contract_address"`
+	// minimum rate of the bonded power that must attest a claim for it to be
+	// observed and must confirm a batch for it to be released
+	AttestationThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=attestation_threshold,json=attestationThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"attestation_threshold" yaml:"attestation_threshold"`
+	// maximum number of transfers of a batch
+	BatchMaxSize uint64 `protobuf:"varint,5,opt,name=batch_max_size,json=batchMaxSize,proto3" json:"batch_max_size,omitempty" yaml:"batch_max_size"`
+	// number of EVM blocks after which a batch can no longer be executed
+	BatchTimeout uint64 `protobuf:"varint,6,opt,name=batch_timeout,json=batchTimeout,proto3" json:"batch_timeout,omitempty" yaml:"batch_timeout"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80baa54becede095, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetBridgeId() string {
+	if m != nil {
+		return m.BridgeId
+	}
+	return ""
+}
+
+func (m *Params) GetBridgeChainId() uint64 {
+	if m != nil {
+		return m.BridgeChainId
+	}
+	return 0
+}
+
+func (m *Params) GetBridgeContractAddress() string {
+	if m != nil {
+		return m.BridgeContractAddress
+	}
+	return ""
+}
+
+func (m *Params) GetBatchMaxSize() uint64 {
+	if m != nil {
+		return m.BatchMaxSize
+	}
+	return 0
+}
+
+func (m *Params) GetBatchTimeout() uint64 {
+	if m != nil {
+		return m.BatchTimeout
+	}
+	return 0
+}
+
+// OrchestratorKeys defines the keys a validator signs the bridge messages with:
+// the account submitting its claims and confirmations, and the EVM address
+// signing the checkpoints of the batches.
+type OrchestratorKeys struct {
+	Validator    string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Orchestrator string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	EthAddress   string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty" yaml:"eth_address"`
+}
+
+func (m *OrchestratorKeys) Reset()      { *m = OrchestratorKeys{} }
+func (*OrchestratorKeys) ProtoMessage() {}
+func (*OrchestratorKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80baa54becede095, []int{1}
+}
+func (m *OrchestratorKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrchestratorKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrchestratorKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrchestratorKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrchestratorKeys.Merge(m, src)
+}
+func (m *OrchestratorKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrchestratorKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrchestratorKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrchestratorKeys proto.InternalMessageInfo
+
+// Claim defines an event of the bridge contract observed by a validator.
+type Claim struct {
+	Type ClaimType `protobuf:"varint,1,opt,name=type,proto3,enum=gauss.bridge.ClaimType" json:"type,omitempty"`
+	// nonce of the event in the bridge contract
+	EventNonce uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty" yaml:"event_nonce"`
+	// height of the EVM block the event was emitted in
+	EthBlockHeight uint64 `protobuf:"varint,3,opt,name=eth_block_height,json=ethBlockHeight,proto3" json:"eth_block_height,omitempty" yaml:"eth_block_height"`
+	TokenContract  string `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty" yaml:"token_contract"`
+	// amount deposited, only set for a deposit
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// EVM sender of a deposit
+	EthSender string `protobuf:"bytes,6,opt,name=eth_sender,json=ethSender,proto3" json:"eth_sender,omitempty" yaml:"eth_sender"`
+	// receiver of a deposit, as written in the bridge contract
+	Receiver string `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// nonce of the executed batch, only set for a batch execution
+	BatchNonce uint64 `protobuf:"varint,8,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty" yaml:"batch_nonce"`
+}
+
+func (m *Claim) Reset()      { *m = Claim{} }
+func (*Claim) ProtoMessage() {}
+func (*Claim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80baa54becede095, []int{2}
+}
+func (m *Claim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Claim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Claim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Claim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Claim.Merge(m, src)
+}
+func (m *Claim) XXX_Size() int {
+	return m.Size()
+}
+func (m *Claim) XXX_DiscardUnknown() {
+	xxx_messageInfo_Claim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Claim proto.InternalMessageInfo
+
+// Attestation defines the validators attesting a claim until it is observed.
+type Attestation struct {
+	Claim Claim `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim"`
+	// operator addresses of the validators attesting the claim
+	Votes []string `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
+	// height of the block the claim was first attested in
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *Attestation) Reset()      { *m = Attestation{} }
+func (*Attestation) ProtoMessage() {}
+func (*Attestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80baa54becede095, []int{3}
+}
+func (m *Attestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Attestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Attestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Attestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attestation.Merge(m, src)
+}
+func (m *Attestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Attestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attestation proto.InternalMessageInfo
+
+// OutgoingTransferTx defines a transfer of burnt vouchers waiting to be
+// released by the bridge contract.
+type OutgoingTransferTx struct {
+	Id            uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender        string                                 `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	DestAddress   string                                 `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty" yaml:"dest_address"`
+	TokenContract string                                 `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty" yaml:"token_contract"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *OutgoingTransferTx) Reset()      { *m = OutgoingTransferTx{} }
+func (*OutgoingTransferTx) ProtoMessage() {}
+func (*OutgoingTransferTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80baa54becede095, []int{4}
+}
+func (m *OutgoingTransferTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutgoingTransferTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutgoingTransferTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutgoingTransferTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutgoingTransferTx.Merge(m, src)
+}
+func (m *OutgoingTransferTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutgoingTransferTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutgoingTransferTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutgoingTransferTx proto.InternalMessageInfo
+
+// OutgoingTxBatch defines a batch of transfers of a token the validators
+// co-sign for the bridge contract to release them.
+type OutgoingTxBatch struct {
+	BatchNonce    uint64               `protobuf:"varint,1,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty" yaml:"batch_nonce"`
+	TokenContract string               `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty" yaml:"token_contract"`
+	Transactions  []OutgoingTransferTx `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions"`
+	// height of the EVM block from which the bridge contract rejects the batch
+	BatchTimeout uint64 `protobuf:"varint,4,opt,name=batch_timeout,json=batchTimeout,proto3" json:"batch_timeout,omitempty" yaml:"batch_timeout"`
+	// height of the block the batch was built in
+	Block int64 `protobuf:"varint,5,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (m *OutgoingTxBatch) Reset()      { *m = OutgoingTxBatch{} }
+func (*OutgoingTxBatch) ProtoMessage() {}
+func (*OutgoingTxBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80baa54becede095, []int{5}
+}
+func (m *OutgoingTxBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutgoingTxBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutgoingTxBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutgoingTxBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutgoingTxBatch.Merge(m, src)
+}
+func (m *OutgoingTxBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutgoingTxBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutgoingTxBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutgoingTxBatch proto.InternalMessageInfo
+
+// BatchConfirm defines the signature of the checkpoint of a batch by the EVM
+// key of a validator.
+type BatchConfirm struct {
+	BatchNonce    uint64 `protobuf:"varint,1,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty" yaml:"batch_nonce"`
+	TokenContract string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty" yaml:"token_contract"`
+	Validator     string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	EthSigner     string `protobuf:"bytes,4,opt,name=eth_signer,json=ethSigner,proto3" json:"eth_signer,omitempty" yaml:"eth_signer"`
+	// hex of the 65 bytes EVM signature
+	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *BatchConfirm) Reset()      { *m = BatchConfirm{} }
+func (*BatchConfirm) ProtoMessage() {}
+func (*BatchConfirm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80baa54becede095, []int{6}
+}
+func (m *BatchConfirm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchConfirm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchConfirm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchConfirm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchConfirm.Merge(m, src)
+}
+func (m *BatchConfirm) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchConfirm) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchConfirm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchConfirm proto.InternalMessageInfo
+
+// BridgeValidator defines the EVM address of a bonded validator and its power,
+// normalized to 2^32, as checked by the bridge contract.
+type BridgeValidator struct {
+	Power      uint64 `protobuf:"varint,1,opt,name=power,proto3" json:"power,omitempty"`
+	EthAddress string `protobuf:"bytes,2,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty" yaml:"eth_address"`
+}
+
+func (m *BridgeValidator) Reset()         { *m = BridgeValidator{} }
+func (m *BridgeValidator) String() string { return proto.CompactTextString(m) }
+func (*BridgeValidator) ProtoMessage()    {}
+func (*BridgeValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80baa54becede095, []int{7}
+}
+func (m *BridgeValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeValidator.Merge(m, src)
+}
+func (m *BridgeValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeValidator proto.InternalMessageInfo
+
+func (m *BridgeValidator) GetPower() uint64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *BridgeValidator) GetEthAddress() string {
+	if m != nil {
+		return m.EthAddress
+	}
+	return ""
+}
+
+// ValidatorEventNonce defines the nonce of the last event a validator attested.
+type ValidatorEventNonce struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	EventNonce       uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty" yaml:"event_nonce"`
+}
+
+func (m *ValidatorEventNonce) Reset()         { *m = ValidatorEventNonce{} }
+func (m *ValidatorEventNonce) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEventNonce) ProtoMessage()    {}
+func (*ValidatorEventNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80baa54becede095, []int{8}
+}
+func (m *ValidatorEventNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorEventNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorEventNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorEventNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEventNonce.Merge(m, src)
+}
+func (m *ValidatorEventNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorEventNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEventNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorEventNonce proto.InternalMessageInfo
+
+func (m *ValidatorEventNonce) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorEventNonce) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("gauss.bridge.ClaimType", ClaimType_name, ClaimType_value)
+	proto.RegisterType((*Params)(nil), "gauss.bridge.Params")
+	proto.RegisterType((*OrchestratorKeys)(nil), "gauss.bridge.OrchestratorKeys")
+	proto.RegisterType((*Claim)(nil), "gauss.bridge.Claim")
+	proto.RegisterType((*Attestation)(nil), "gauss.bridge.Attestation")
+	proto.RegisterType((*OutgoingTransferTx)(nil), "gauss.bridge.OutgoingTransferTx")
+	proto.RegisterType((*OutgoingTxBatch)(nil), "gauss.bridge.OutgoingTxBatch")
+	proto.RegisterType((*BatchConfirm)(nil), "gauss.bridge.BatchConfirm")
+	proto.RegisterType((*BridgeValidator)(nil), "gauss.bridge.BridgeValidator")
+	proto.RegisterType((*ValidatorEventNonce)(nil), "gauss.bridge.ValidatorEventNonce")
+}
+
+func init() { proto.RegisterFile("gauss/bridge/bridge.proto", fileDescriptor_80baa54becede095) }
+
+var fileDescriptor_80baa54becede095 = []byte{
+	// 1111 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x4f, 0xe3, 0x46,
+	0x18, 0x8e, 0x93, 0x40, 0xc9, 0x10, 0x20, 0x3b, 0x04, 0x30, 0x29, 0x8a, 0x23, 0x1f, 0x2a, 0xd4,
+	0x8f, 0x44, 0xa5, 0x48, 0x55, 0x91, 0xaa, 0x16, 0x87, 0xac, 0x36, 0x6d, 0x17, 0xd0, 0x10, 0xaa,
+	0x76, 0x2f, 0xae, 0xb1, 0x87, 0xd8, 0x82, 0x78, 0x22, 0x7b, 0x42, 0x03, 0xd7, 0x5e, 0xb6, 0x9c,
+	0xf6, 0xd8, 0xaa, 0x42, 0x42, 0xea, 0x1f, 0xe8, 0xbd, 0x7f, 0x60, 0x8f, 0x7b, 0x6b, 0xd5, 0x83,
+	0x55, 0xc1, 0xa5, 0x52, 0x6f, 0xfe, 0x05, 0xd5, 0xcc, 0x38, 0x8e, 0x63, 0x38, 0x2c, 0xbd, 0xb4,
+	0x97, 0xc4, 0xef, 0xa7, 0x67, 0x9e, 0xe7, 0xf1, 0x3b, 0x03, 0x56, 0xbb, 0xc6, 0xc0, 0xf7, 0x1b,
+	0x47, 0x9e, 0x63, 0x75, 0x71, 0xf4, 0x57, 0xef, 0x7b, 0x84, 0x12, 0x58, 0xe4, 0xa1, 0xba, 0xf0,
+	0x55, 0xca, 0x5d, 0xd2, 0x25, 0x3c, 0xd0, 0x60, 0x4f, 0x22, 0x47, 0xfd, 0x3b, 0x07, 0xa6, 0xf7,
+	0x0d, 0xcf, 0xe8, 0xf9, 0xf0, 0x7d, 0x50, 0x10, 0xa9, 0xba, 0x63, 0xc9, 0x52, 0x4d, 0x5a, 0x2f,
+	0x68, 0xe5, 0x30, 0x50, 0x4a, 0xe7, 0x46, 0xef, 0x74, 0x4b, 0x8d, 0x43, 0x2a, 0x9a, 0x11, 0xcf,
+	0x6d, 0x0b, 0x6a, 0x60, 0x21, 0xf2, 0x9b, 0xb6, 0xe1, 0xb8, 0xac, 0x30, 0x5b, 0x93, 0xd6, 0xf3,
+	0x5a, 0x25, 0x0c, 0x94, 0xe5, 0x89, 0xc2, 0x51, 0x82, 0x8a, 0xe6, 0x84, 0xa7, 0xc9, 0x1c, 0x6d,
+	0x0b, 0x3e, 0x03, 0x2b, 0xa3, 0x14, 0xe2, 0x52, 0xcf, 0x30, 0xa9, 0x6e, 0x58, 0x96, 0x87, 0x7d,
+	0x5f, 0xce, 0xf1, 0x45, 0xa8, 0x61, 0xa0, 0x54, 0x27, 0x7b, 0xa5, 0x12, 0x55, 0xb4, 0x14, 0xf5,
+	0x8c, 0x02, 0xdb, 0xc2, 0x0f, 0xbf, 0x93, 0xc0, 0x92, 0x41, 0x29, 0xf6, 0xa9, 0x41, 0x1d, 0xe2,
+	0xea, 0xd4, 0xf6, 0xb0, 0x6f, 0x93, 0x53, 0x4b, 0xce, 0xf3, 0xd6, 0xbb, 0x2f, 0x03, 0x25, 0xf3,
+	0x47, 0xa0, 0xbc, 0xd5, 0x75, 0xa8, 0x3d, 0x38, 0xaa, 0x9b, 0xa4, 0xd7, 0x30, 0x89, 0xdf, 0x23,
+	0x7e, 0xf4, 0xf7, 0x9e, 0x6f, 0x9d, 0x34, 0xe8, 0x79, 0x1f, 0xfb, 0xf5, 0x1d, 0x6c, 0x86, 0x81,
+	0xb2, 0x26, 0x16, 0x72, 0x6f, 0x53, 0x15, 0x95, 0x13, 0xfe, 0xce, 0xc8, 0x0d, 0x3f, 0x01, 0xf3,
+	0x47, 0x06, 0x35, 0x6d, 0xbd, 0x67, 0x0c, 0x75, 0xdf, 0xb9, 0xc0, 0xf2, 0x14, 0x07, 0x69, 0x35,
+	0x0c, 0x94, 0xa5, 0x68, 0x63, 0x13, 0x71, 0x15, 0x15, 0xb9, 0xe3, 0xa9, 0x31, 0x3c, 0x70, 0x2e,
+	0x30, 0xfc, 0x18, 0xcc, 0x89, 0x04, 0xea, 0xf4, 0x30, 0x19, 0x50, 0x79, 0x9a, 0xd7, 0xcb, 0x61,
+	0xa0, 0x94, 0x93, 0xf5, 0x51, 0x78, 0x54, 0xde, 0x11, 0xe6, 0xd6, 0xcc, 0x0f, 0xd7, 0x4a, 0xe6,
+	0xaf, 0x6b, 0x45, 0x52, 0x7f, 0x92, 0x40, 0x69, 0xcf, 0x33, 0x6d, 0xec, 0x53, 0xcf, 0xa0, 0xc4,
+	0xfb, 0x1c, 0x9f, 0xfb, 0x70, 0x0d, 0x14, 0xce, 0x8c, 0x53, 0xc7, 0x62, 0x0e, 0xc1, 0x3b, 0x1a,
+	0x3b, 0xa0, 0x0a, 0x8a, 0x24, 0x51, 0xc1, 0xf9, 0x2d, 0xa0, 0x09, 0x1f, 0xfc, 0x10, 0xcc, 0x62,
+	0x6a, 0xa7, 0x68, 0x5b, 0x0e, 0x03, 0x05, 0x8a, 0xd5, 0x25, 0x82, 0x2a, 0x02, 0x98, 0xda, 0x11,
+	0x3f, 0x5b, 0xc5, 0xe7, 0xd7, 0x4a, 0x26, 0x5a, 0x5d, 0x46, 0xfd, 0x2d, 0x07, 0xa6, 0x9a, 0xa7,
+	0x86, 0xd3, 0x83, 0xef, 0x80, 0x3c, 0x03, 0x9d, 0xaf, 0x66, 0x7e, 0x63, 0xa5, 0x9e, 0x14, 0x72,
+	0x9d, 0xa7, 0x74, 0xce, 0xfb, 0x18, 0xf1, 0x24, 0xfe, 0xf6, 0x33, 0xec, 0x52, 0xdd, 0x25, 0xae,
+	0x89, 0x23, 0x01, 0x26, 0xdf, 0x3e, 0x0e, 0xb2, 0xb7, 0x33, 0x6b, 0x97, 0x19, 0xb0, 0x05, 0x4a,
+	0x6c, 0x65, 0x47, 0xa7, 0xc4, 0x3c, 0xd1, 0x6d, 0xec, 0x74, 0x6d, 0xca, 0xd7, 0x9e, 0xd7, 0xde,
+	0x0c, 0x03, 0x65, 0x65, 0xbc, 0xf6, 0x64, 0x86, 0x8a, 0xe6, 0x31, 0xb5, 0x35, 0xe6, 0x79, 0xc2,
+	0x1d, 0xf0, 0x53, 0x30, 0x4f, 0xc9, 0x09, 0x76, 0x63, 0x59, 0x46, 0xe2, 0x4a, 0xd0, 0x3b, 0x19,
+	0x57, 0xd1, 0x1c, 0x77, 0x8c, 0xd4, 0x0a, 0x1f, 0x83, 0x69, 0xa3, 0x47, 0x06, 0x2e, 0xe5, 0xc2,
+	0x28, 0x68, 0xf5, 0x07, 0xc8, 0xb2, 0xed, 0x52, 0x14, 0x55, 0xc3, 0x4d, 0xc0, 0xc0, 0xd5, 0x7d,
+	0xec, 0x5a, 0xd8, 0xe3, 0x22, 0x29, 0x68, 0x4b, 0x61, 0xa0, 0x3c, 0x1a, 0x6f, 0x45, 0xc4, 0x54,
+	0x54, 0xc0, 0xd4, 0x3e, 0xe0, 0xcf, 0xb0, 0x02, 0x66, 0x3c, 0x6c, 0x62, 0xe7, 0x0c, 0x7b, 0xf2,
+	0x1b, 0x9c, 0xdd, 0xd8, 0x66, 0xd8, 0x0a, 0x69, 0x09, 0x6c, 0x67, 0xd2, 0xd8, 0x26, 0x82, 0x2a,
+	0x02, 0xdc, 0xe2, 0xd8, 0x4e, 0x30, 0x2b, 0xa9, 0x17, 0x60, 0x76, 0x7b, 0xfc, 0x65, 0xc0, 0x06,
+	0x98, 0x32, 0x19, 0x89, 0x9c, 0xdf, 0xd9, 0x8d, 0xc5, 0x7b, 0xf8, 0xd5, 0xf2, 0x0c, 0x03, 0x24,
+	0xf2, 0x60, 0x19, 0x4c, 0x9d, 0x11, 0x8a, 0x7d, 0x39, 0x5b, 0xcb, 0xad, 0x17, 0x90, 0x30, 0xe0,
+	0x32, 0x98, 0x4e, 0xb0, 0x96, 0x43, 0x91, 0x95, 0x52, 0xd5, 0x8b, 0x2c, 0x80, 0x7b, 0x03, 0xda,
+	0x25, 0x8e, 0xdb, 0xed, 0x78, 0x86, 0xeb, 0x1f, 0x63, 0xaf, 0x33, 0x84, 0xf3, 0x20, 0x1b, 0x8d,
+	0xb9, 0x3c, 0xca, 0x3a, 0x16, 0x6b, 0x16, 0xe1, 0x26, 0x14, 0x1e, 0x59, 0x70, 0x0b, 0x14, 0x2d,
+	0xec, 0xa7, 0x67, 0xd2, 0x4a, 0x18, 0x28, 0x8b, 0x02, 0x82, 0x64, 0x54, 0x45, 0xb3, 0xcc, 0x1c,
+	0x8d, 0x9f, 0xff, 0x8d, 0x32, 0x52, 0x90, 0xfc, 0x9a, 0x05, 0x0b, 0x31, 0x24, 0x43, 0x8d, 0xb1,
+	0x96, 0x66, 0x5a, 0x7a, 0x5d, 0xa6, 0xef, 0xd9, 0x64, 0xf6, 0x81, 0x9b, 0xfc, 0x0c, 0x14, 0x29,
+	0x23, 0xc6, 0x30, 0x99, 0x3a, 0x18, 0xc4, 0xb9, 0xf5, 0xd9, 0x8d, 0xda, 0xa4, 0x2a, 0xee, 0x52,
+	0x18, 0x49, 0x64, 0xa2, 0xf6, 0xee, 0xa8, 0xcc, 0x3f, 0x64, 0x54, 0x32, 0xa1, 0xf1, 0x8f, 0x9d,
+	0xc3, 0x9d, 0x43, 0xc2, 0x48, 0xa1, 0xf7, 0x7d, 0x16, 0x14, 0x39, 0x66, 0x4d, 0xe2, 0x1e, 0x3b,
+	0x5e, 0xef, 0xbf, 0x84, 0x6e, 0x62, 0x76, 0xe7, 0xd2, 0xb3, 0x7b, 0x34, 0x0f, 0x9c, 0xae, 0x8b,
+	0x3d, 0x39, 0x7f, 0xef, 0x3c, 0xe0, 0xb1, 0x68, 0x1e, 0xf0, 0x67, 0xd6, 0x93, 0x79, 0x0d, 0x3a,
+	0xf0, 0xc4, 0x49, 0x55, 0x40, 0x63, 0x47, 0x0a, 0x8b, 0x6f, 0xc0, 0x82, 0xc6, 0xf9, 0xf9, 0x32,
+	0x7e, 0x69, 0x19, 0x4c, 0xf5, 0xc9, 0xb7, 0xd8, 0x8b, 0xbe, 0x2d, 0x61, 0xa4, 0x8f, 0x88, 0xec,
+	0xeb, 0x1e, 0x11, 0xea, 0x8f, 0x12, 0x58, 0x8c, 0x9b, 0xb7, 0xc6, 0xc3, 0xbb, 0x0d, 0x1e, 0xc5,
+	0x1b, 0x8d, 0xdb, 0x8a, 0x5b, 0xcb, 0x5a, 0x18, 0x28, 0xb2, 0x68, 0x7b, 0x27, 0x45, 0x45, 0xa5,
+	0xd8, 0x37, 0xfa, 0x4c, 0xff, 0xed, 0x01, 0xf2, 0xf6, 0x2f, 0x12, 0x28, 0xc4, 0xa7, 0x11, 0xdc,
+	0x04, 0xcb, 0xcd, 0x2f, 0xb6, 0xdb, 0x4f, 0xf5, 0xce, 0xd7, 0xfb, 0x2d, 0xfd, 0x70, 0xf7, 0x60,
+	0xbf, 0xd5, 0x6c, 0x3f, 0x6e, 0xb7, 0x76, 0x4a, 0x99, 0x8a, 0x7c, 0x79, 0x55, 0x2b, 0xc7, 0xa9,
+	0x87, 0xae, 0xdf, 0xc7, 0xa6, 0x73, 0xec, 0x60, 0x0b, 0xbe, 0x0b, 0x60, 0xa2, 0x6a, 0xa7, 0xb5,
+	0xbf, 0x77, 0xd0, 0xee, 0x94, 0xa4, 0x4a, 0xf9, 0xf2, 0xaa, 0x56, 0x8a, 0x2b, 0x76, 0x70, 0x9f,
+	0xf8, 0x0e, 0x85, 0x1f, 0x81, 0xd5, 0x44, 0xb6, 0xb6, 0xdd, 0x69, 0x3e, 0xd1, 0x5b, 0x5f, 0xb5,
+	0x9a, 0x87, 0x9d, 0xd6, 0x4e, 0x29, 0x5b, 0xa9, 0x5c, 0x5e, 0xd5, 0x96, 0xe3, 0x22, 0x2e, 0xd2,
+	0xd6, 0x10, 0x9b, 0x03, 0x8a, 0xad, 0x4a, 0xfe, 0xf9, 0xcf, 0xd5, 0x8c, 0xa6, 0xbd, 0xbc, 0xa9,
+	0x4a, 0xaf, 0x6e, 0xaa, 0xd2, 0x9f, 0x37, 0x55, 0xe9, 0xc5, 0x6d, 0x35, 0xf3, 0xea, 0xb6, 0x9a,
+	0xf9, 0xfd, 0xb6, 0x9a, 0x79, 0xb6, 0x9e, 0x18, 0x29, 0xe2, 0x4e, 0x29, 0x7e, 0xcf, 0x36, 0x1b,
+	0xc3, 0xd1, 0xf5, 0x92, 0x0f, 0x96, 0xa3, 0x69, 0x7e, 0x75, 0xfc, 0xe0, 0x9f, 0x01, 0x00, 0xb5,
+	0xdc, 0x77, 0x07, 0x7b, 0x0a, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BridgeId != that1.BridgeId {
+		return false
+	}
+	if this.BridgeChainId != that1.BridgeChainId {
+		return false
+	}
+	if this.BridgeContractAddress != that1.BridgeContractAddress {
+		return false
+	}
+	if !this.AttestationThreshold.Equal(that1.AttestationThreshold) {
+		return false
+	}
+	if this.BatchMaxSize != that1.BatchMaxSize {
+		return false
+	}
+	if this.BatchTimeout != that1.BatchTimeout {
+		return false
+	}
+	return true
+}
+func (this *Claim) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Claim)
+	if !ok {
+		that2, ok := that.(Claim)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.EventNonce != that1.EventNonce {
+		return false
+	}
+	if this.EthBlockHeight != that1.EthBlockHeight {
+		return false
+	}
+	if this.TokenContract != that1.TokenContract {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.EthSender != that1.EthSender {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	if this.BatchNonce != that1.BatchNonce {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchTimeout != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.BatchTimeout))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BatchMaxSize != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.BatchMaxSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.AttestationThreshold.Size()
+		i -= size
+		if _, err := m.AttestationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.BridgeContractAddress) > 0 {
+		i -= len(m.BridgeContractAddress)
+		copy(dAtA[i:], m.BridgeContractAddress)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.BridgeContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BridgeChainId != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.BridgeChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BridgeId) > 0 {
+		i -= len(m.BridgeId)
+		copy(dAtA[i:], m.BridgeId)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.BridgeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrchestratorKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrchestratorKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrchestratorKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Claim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Claim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Claim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.EthSender) > 0 {
+		i -= len(m.EthSender)
+		copy(dAtA[i:], m.EthSender)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.EthSender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EthBlockHeight != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.EthBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Attestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Votes[iNdEx])
+			copy(dAtA[i:], m.Votes[iNdEx])
+			i = encodeVarintBridge(dAtA, i, uint64(len(m.Votes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OutgoingTransferTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutgoingTransferTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutgoingTransferTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutgoingTxBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutgoingTxBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutgoingTxBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Block != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BatchTimeout != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.BatchTimeout))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchConfirm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchConfirm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EthSigner) > 0 {
+		i -= len(m.EthSigner)
+		copy(dAtA[i:], m.EthSigner)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.EthSigner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Power != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorEventNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEventNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEventNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBridge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBridge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BridgeId)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if m.BridgeChainId != 0 {
+		n += 1 + sovBridge(uint64(m.BridgeChainId))
+	}
+	l = len(m.BridgeContractAddress)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = m.AttestationThreshold.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	if m.BatchMaxSize != 0 {
+		n += 1 + sovBridge(uint64(m.BatchMaxSize))
+	}
+	if m.BatchTimeout != 0 {
+		n += 1 + sovBridge(uint64(m.BatchTimeout))
+	}
+	return n
+}
+
+func (m *OrchestratorKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	return n
+}
+
+func (m *Claim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovBridge(uint64(m.Type))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovBridge(uint64(m.EventNonce))
+	}
+	if m.EthBlockHeight != 0 {
+		n += 1 + sovBridge(uint64(m.EthBlockHeight))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	l = len(m.EthSender)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovBridge(uint64(m.BatchNonce))
+	}
+	return n
+}
+
+func (m *Attestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Claim.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	if len(m.Votes) > 0 {
+		for _, s := range m.Votes {
+			l = len(s)
+			n += 1 + l + sovBridge(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovBridge(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *OutgoingTransferTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBridge(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	return n
+}
+
+func (m *OutgoingTxBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovBridge(uint64(m.BatchNonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovBridge(uint64(l))
+		}
+	}
+	if m.BatchTimeout != 0 {
+		n += 1 + sovBridge(uint64(m.BatchTimeout))
+	}
+	if m.Block != 0 {
+		n += 1 + sovBridge(uint64(m.Block))
+	}
+	return n
+}
+
+func (m *BatchConfirm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovBridge(uint64(m.BatchNonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.EthSigner)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Power != 0 {
+		n += 1 + sovBridge(uint64(m.Power))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorEventNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovBridge(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func sovBridge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBridge(x uint64) (n int) {
+	return sovBridge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AttestationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchMaxSize", wireType)
+			}
+			m.BatchMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchMaxSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTimeout", wireType)
+			}
+			m.BatchTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrchestratorKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrchestratorKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrchestratorKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Claim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Claim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Claim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ClaimType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
+			}
+			m.EthBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutgoingTransferTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutgoingTransferTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutgoingTxBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutgoingTxBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutgoingTxBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, OutgoingTransferTx{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTimeout", wireType)
+			}
+			m.BatchTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchConfirm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchConfirm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSigner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEventNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEventNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEventNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBridge(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBridge
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBridge
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBridge
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBridge        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBridge          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBridge = fmt.Errorf("proto: unexpected end of group")
+)
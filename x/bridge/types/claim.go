@@ -0,0 +1,145 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxReceiverLength is the maximum length of the receiver of a deposit, which
+// is not checked by the bridge contract
+const MaxReceiverLength = 128
+
+// NewDepositClaim creates a claim of a deposit to the bridge contract
+func NewDepositClaim(
+	eventNonce, ethBlockHeight uint64, tokenContract string, amount sdk.Int, ethSender, receiver string,
+) Claim {
+	return Claim{
+		Type:           ClaimTypeDeposit,
+		EventNonce:     eventNonce,
+		EthBlockHeight: ethBlockHeight,
+		TokenContract:  NormalizeEthAddress(tokenContract),
+		Amount:         amount,
+		EthSender:      NormalizeEthAddress(ethSender),
+		Receiver:       receiver,
+	}
+}
+
+// NewBatchExecutedClaim creates a claim of the execution of a batch by the
+// bridge contract
+func NewBatchExecutedClaim(eventNonce, ethBlockHeight uint64, tokenContract string, batchNonce uint64) Claim {
+	return Claim{
+		Type:           ClaimTypeBatchExecuted,
+		EventNonce:     eventNonce,
+		EthBlockHeight: ethBlockHeight,
+		TokenContract:  NormalizeEthAddress(tokenContract),
+		Amount:         sdk.ZeroInt(),
+		BatchNonce:     batchNonce,
+	}
+}
+
+// Hash returns the hash the attestations of a claim are keyed by, the same for
+// every validator observing the same event
+func (c Claim) Hash() []byte {
+	bz, err := c.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	sum := sha256.Sum256(bz)
+	return sum[:]
+}
+
+// Validate performs a basic validation of the claim. The receiver of a deposit
+// is not validated as the event is emitted whatever the depositor wrote in it,
+// so that a wrong receiver cannot block the following events
+func (c Claim) Validate() error {
+	if c.EventNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalidClaim, "event nonce must be positive")
+	}
+	if err := ValidateEthAddress(c.TokenContract); err != nil {
+		return err
+	}
+	if c.TokenContract != NormalizeEthAddress(c.TokenContract) {
+		return sdkerrors.Wrapf(ErrInvalidClaim, "token contract %s is not lowercase", c.TokenContract)
+	}
+
+	switch c.Type {
+	case ClaimTypeDeposit:
+		if c.Amount.IsNil() || !c.Amount.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidAmount, "deposit amount must be positive: %s", c.Amount)
+		}
+		if err := ValidateEthAddress(c.EthSender); err != nil {
+			return err
+		}
+		if c.EthSender != NormalizeEthAddress(c.EthSender) {
+			return sdkerrors.Wrapf(ErrInvalidClaim, "sender %s is not lowercase", c.EthSender)
+		}
+		if len(c.Receiver) > MaxReceiverLength {
+			return sdkerrors.Wrapf(ErrInvalidClaim, "receiver is longer than %d bytes", MaxReceiverLength)
+		}
+	case ClaimTypeBatchExecuted:
+		if c.BatchNonce == 0 {
+			return sdkerrors.Wrap(ErrInvalidClaim, "batch nonce must be positive")
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidClaim, "unknown claim type %s", c.Type)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (c Claim) String() string {
+	out, _ := yaml.Marshal(c)
+	return string(out)
+}
+
+// NewAttestation creates the attestation of a claim, voted by no validator yet
+func NewAttestation(claim Claim, height int64) Attestation {
+	return Attestation{
+		Claim:  claim,
+		Votes:  []string{},
+		Height: height,
+	}
+}
+
+// HasVote returns whether a validator attested the claim
+func (a Attestation) HasVote(voter sdk.ValAddress) bool {
+	for _, vote := range a.Votes {
+		if vote == voter.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate performs a basic validation of the attestation
+func (a Attestation) Validate() error {
+	if err := a.Claim.Validate(); err != nil {
+		return err
+	}
+
+	votes := make(map[string]bool)
+	for _, vote := range a.Votes {
+		if _, err := sdk.ValAddressFromBech32(vote); err != nil {
+			return err
+		}
+		if votes[vote] {
+			return fmt.Errorf("duplicate vote of %s on the claim of event %d", vote, a.Claim.EventNonce)
+		}
+		votes[vote] = true
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (a Attestation) String() string {
+	out, _ := yaml.Marshal(a)
+	return string(out)
+}
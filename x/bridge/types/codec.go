@@ -0,0 +1,49 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/bridge interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSetOrchestratorAddress{}, "gauss/bridge/MsgSetOrchestratorAddress", nil)
+	cdc.RegisterConcrete(&MsgDepositClaim{}, "gauss/bridge/MsgDepositClaim", nil)
+	cdc.RegisterConcrete(&MsgBatchExecutedClaim{}, "gauss/bridge/MsgBatchExecutedClaim", nil)
+	cdc.RegisterConcrete(&MsgSendToExternal{}, "gauss/bridge/MsgSendToExternal", nil)
+	cdc.RegisterConcrete(&MsgConfirmBatch{}, "gauss/bridge/MsgConfirmBatch", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetOrchestratorAddress{},
+		&MsgDepositClaim{},
+		&MsgBatchExecutedClaim{},
+		&MsgSendToExternal{},
+		&MsgConfirmBatch{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/bridge module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/bridge and
+	// defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
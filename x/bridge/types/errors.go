@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/bridge module sentinel errors
+var (
+	ErrInvalidEthAddress  = sdkerrors.Register(ModuleName, 2, "invalid EVM address")
+	ErrInvalidSignature   = sdkerrors.Register(ModuleName, 3, "invalid EVM signature")
+	ErrNotValidator       = sdkerrors.Register(ModuleName, 4, "not a bonded validator")
+	ErrNotOrchestrator    = sdkerrors.Register(ModuleName, 5, "account is not the orchestrator of a validator")
+	ErrKeysInUse          = sdkerrors.Register(ModuleName, 6, "orchestrator keys already used by another validator")
+	ErrInvalidClaim       = sdkerrors.Register(ModuleName, 7, "invalid claim")
+	ErrNonContiguousNonce = sdkerrors.Register(ModuleName, 8, "event nonce is not the next one of the validator")
+	ErrInvalidVoucher     = sdkerrors.Register(ModuleName, 9, "denom is not a bridge voucher")
+	ErrBatchNotFound      = sdkerrors.Register(ModuleName, 11, "batch not found")
+	ErrDuplicateConfirm   = sdkerrors.Register(ModuleName, 12, "batch already confirmed by the validator")
+	ErrInvalidAmount      = sdkerrors.Register(ModuleName, 13, "invalid amount")
+	ErrInvalidReceiver    = sdkerrors.Register(ModuleName, 14, "invalid receiver")
+)
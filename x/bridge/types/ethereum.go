@@ -0,0 +1,163 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"golang.org/x/crypto/sha3"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// EthAddressLength is the length in bytes of an EVM address
+	EthAddressLength = 20
+	// EthSignatureLength is the length in bytes of an EVM signature, r || s || v
+	EthSignatureLength = 65
+
+	// BatchMethodName is signed in the checkpoints of the batches so that they
+	// cannot be mistaken for the checkpoints of other calls of the contract
+	BatchMethodName = "transactionBatch"
+
+	ethSignedMessagePrefix = "\x19Ethereum Signed Message:\n32"
+)
+
+// ValidateEthAddress checks that an address is the 0x-prefixed hex of 20 bytes
+func ValidateEthAddress(address string) error {
+	if !strings.HasPrefix(address, "0x") {
+		return sdkerrors.Wrapf(ErrInvalidEthAddress, "%s has no 0x prefix", address)
+	}
+
+	bz, err := hex.DecodeString(address[2:])
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidEthAddress, "%s: %s", address, err)
+	}
+	if len(bz) != EthAddressLength {
+		return sdkerrors.Wrapf(ErrInvalidEthAddress, "%s is not %d bytes long", address, EthAddressLength)
+	}
+
+	return nil
+}
+
+// NormalizeEthAddress returns the lowercase form of an EVM address, ignoring
+// its EIP-55 checksum
+func NormalizeEthAddress(address string) string {
+	return strings.ToLower(address)
+}
+
+// EthAddressBytes returns the bytes of a valid EVM address
+func EthAddressBytes(address string) []byte {
+	bz, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// EthAddressFromPubKey returns the EVM address of a secp256k1 public key, the
+// last 20 bytes of the keccak256 of its uncompressed form
+func EthAddressFromPubKey(pubKey *ecdsa.PublicKey) string {
+	uncompressed := (*btcec.PublicKey)(pubKey).SerializeUncompressed()
+	return "0x" + hex.EncodeToString(Keccak256(uncompressed[1:])[12:])
+}
+
+// Keccak256 returns the legacy keccak256 hash of the data, as computed by the EVM
+func Keccak256(data ...[]byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	for _, bz := range data {
+		hasher.Write(bz)
+	}
+	return hasher.Sum(nil)
+}
+
+// GetBatchCheckpoint returns the hash the validators sign for the bridge
+// contract to release the transfers of a batch: the keccak256 of the bridge
+// id, the method name, the token contract, the batch nonce, the batch timeout
+// and the destination and amount of every transfer, each padded to 32 bytes
+func GetBatchCheckpoint(bridgeID string, batch OutgoingTxBatch) []byte {
+	data := [][]byte{
+		padBytes32([]byte(bridgeID)),
+		padBytes32([]byte(BatchMethodName)),
+		padAddress32(batch.TokenContract),
+		padUint256(new(big.Int).SetUint64(batch.BatchNonce)),
+		padUint256(new(big.Int).SetUint64(batch.BatchTimeout)),
+	}
+	for _, tx := range batch.Transactions {
+		data = append(data, padAddress32(tx.DestAddress), padUint256(tx.Amount.BigInt()))
+	}
+
+	return Keccak256(data...)
+}
+
+// SignEthMessage signs a 32 bytes hash with an EVM key the way eth_sign does,
+// prefixing it with the Ethereum signed message header
+func SignEthMessage(privKey *ecdsa.PrivateKey, hash []byte) ([]byte, error) {
+	compact, err := btcec.SignCompact(btcec.S256(), (*btcec.PrivateKey)(privKey), ethSignedMessageHash(hash), false)
+	if err != nil {
+		return nil, err
+	}
+
+	// the compact signature is v || r || s with v = 27 + recovery id, the EVM
+	// expects r || s || v
+	return append(compact[1:], compact[0]), nil
+}
+
+// ValidateEthSignature checks that a signature of a 32 bytes hash by
+// SignEthMessage was made by the key of an EVM address
+func ValidateEthSignature(hash []byte, signature []byte, ethAddress string) error {
+	if len(signature) != EthSignatureLength {
+		return sdkerrors.Wrapf(ErrInvalidSignature, "signature is not %d bytes long", EthSignatureLength)
+	}
+
+	v := signature[64]
+	if v < 27 {
+		v += 27
+	}
+	if v != 27 && v != 28 {
+		return sdkerrors.Wrapf(ErrInvalidSignature, "invalid recovery id %d", signature[64])
+	}
+
+	compact := append([]byte{v}, signature[:64]...)
+	pubKey, _, err := btcec.RecoverCompact(btcec.S256(), compact, ethSignedMessageHash(hash))
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidSignature, err.Error())
+	}
+
+	if signer := EthAddressFromPubKey(pubKey.ToECDSA()); signer != NormalizeEthAddress(ethAddress) {
+		return sdkerrors.Wrapf(ErrInvalidSignature, "signed by %s instead of %s", signer, ethAddress)
+	}
+
+	return nil
+}
+
+// ethSignedMessageHash returns the hash eth_sign signs for a 32 bytes hash
+func ethSignedMessageHash(hash []byte) []byte {
+	return Keccak256([]byte(ethSignedMessagePrefix), hash)
+}
+
+func padBytes32(bz []byte) []byte {
+	if len(bz) > 32 {
+		panic(fmt.Sprintf("%x is longer than 32 bytes", bz))
+	}
+	return append(bz[:len(bz):len(bz)], make([]byte, 32-len(bz))...)
+}
+
+func padAddress32(address string) []byte {
+	return append(make([]byte, 32-EthAddressLength), EthAddressBytes(address)...)
+}
+
+func padUint256(i *big.Int) []byte {
+	bz := i.Bytes()
+	return append(make([]byte, 32-len(bz)), bz...)
+}
+
+// EthPrivKeyFromSecret derives a secp256k1 EVM key from a secret, only used
+// for simulation and tests
+func EthPrivKeyFromSecret(secret []byte) *ecdsa.PrivateKey {
+	privKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), Keccak256(secret))
+	return privKey.ToECDSA()
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/x/bridge/types"
+)
+
+func TestValidateEthAddress(t *testing.T) {
+	require.NoError(t, types.ValidateEthAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"))
+	require.Error(t, types.ValidateEthAddress("5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"))
+	require.Error(t, types.ValidateEthAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA"))
+	require.Error(t, types.ValidateEthAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg"))
+}
+
+func TestEthAddressFromPubKey(t *testing.T) {
+	// the address of the private key 1
+	privKey := types.EthPrivKeyFromSecret(nil)
+	privKey.D.SetInt64(1)
+	privKey.PublicKey.X, privKey.PublicKey.Y = privKey.Curve.ScalarBaseMult(privKey.D.Bytes())
+
+	require.Equal(t, "0x7e5f4552091a69125d5dfcb7b8c2659029395bdf", types.EthAddressFromPubKey(&privKey.PublicKey))
+}
+
+func TestEthSignature(t *testing.T) {
+	privKey := types.EthPrivKeyFromSecret([]byte("signer"))
+	address := types.EthAddressFromPubKey(&privKey.PublicKey)
+	hash := types.Keccak256([]byte("checkpoint"))
+
+	signature, err := types.SignEthMessage(privKey, hash)
+	require.NoError(t, err)
+	require.Len(t, signature, types.EthSignatureLength)
+	require.NoError(t, types.ValidateEthSignature(hash, signature, address))
+
+	other := types.EthAddressFromPubKey(&types.EthPrivKeyFromSecret([]byte("other")).PublicKey)
+	require.ErrorIs(t, types.ValidateEthSignature(hash, signature, other), types.ErrInvalidSignature)
+	require.Error(t, types.ValidateEthSignature(types.Keccak256([]byte("other")), signature, address))
+	require.Error(t, types.ValidateEthSignature(hash, signature[:64], address))
+}
+
+func TestBatchCheckpoint(t *testing.T) {
+	tokenContract := "0xdAC17F958D2ee523a2206206994597C13D831ec7"
+	tx := types.NewOutgoingTransferTx(1, sdk.AccAddress("sender"), "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", tokenContract, sdk.NewInt(1000))
+	batch := types.NewOutgoingTxBatch(1, tokenContract, []types.OutgoingTransferTx{tx}, 200, 10)
+
+	checkpoint := types.GetBatchCheckpoint("gauss-bridge", batch)
+	require.Len(t, checkpoint, 32)
+
+	// the block of the batch is not signed, its contents and the bridge id are
+	other := batch
+	other.Block = 20
+	require.Equal(t, checkpoint, types.GetBatchCheckpoint("gauss-bridge", other))
+	require.NotEqual(t, checkpoint, types.GetBatchCheckpoint("other-bridge", batch))
+
+	other = batch
+	other.BatchTimeout = 201
+	require.NotEqual(t, checkpoint, types.GetBatchCheckpoint("gauss-bridge", other))
+
+	other = types.NewOutgoingTxBatch(1, tokenContract, []types.OutgoingTransferTx{tx, tx}, 200, 10)
+	require.NotEqual(t, checkpoint, types.GetBatchCheckpoint("gauss-bridge", other))
+}
@@ -0,0 +1,34 @@
+package types
+
+const (
+	AttributeValueCategory = ModuleName
+
+	EventTypeSetOrchestrator = "set_orchestrator"
+	EventTypeClaim           = "bridge_claim"
+	EventTypeObservation     = "bridge_observation"
+	EventTypeDeposit         = "bridge_deposit"
+	EventTypeClaimFailed     = "bridge_claim_failed"
+	EventTypeDepositRefund   = "bridge_deposit_refund"
+	EventTypeSendToExternal  = "send_to_external"
+	EventTypeOutgoingBatch   = "outgoing_batch"
+	EventTypeBatchConfirm    = "batch_confirm"
+	EventTypeBatchSigned     = "batch_signed"
+	EventTypeBatchExecuted   = "batch_executed"
+	EventTypeBatchCanceled   = "batch_canceled"
+
+	AttributeKeyValidator     = "validator"
+	AttributeKeyOrchestrator  = "orchestrator"
+	AttributeKeyEthAddress    = "eth_address"
+	AttributeKeyClaimType     = "claim_type"
+	AttributeKeyEventNonce    = "event_nonce"
+	AttributeKeyClaimHash     = "claim_hash"
+	AttributeKeyTokenContract = "token_contract"
+	AttributeKeyReceiver      = "receiver"
+	AttributeKeyAmount        = "amount"
+	AttributeKeyEthDest       = "eth_dest"
+	AttributeKeyTxID          = "tx_id"
+	AttributeKeyBatchNonce    = "batch_nonce"
+	AttributeKeyBatchTimeout  = "batch_timeout"
+	AttributeKeyCheckpoint    = "checkpoint"
+	AttributeKeyReason        = "reason"
+)
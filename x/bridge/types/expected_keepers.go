@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	tokentypes "github.com/gauss/gauss/v4/x/token/types"
+)
+
+// AccountKeeper defines the expected account keeper (noalias)
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI // only used for simulation
+
+	GetModuleAddress(name string) sdk.AccAddress
+}
+
+// BankKeeper defines the expected interface needed to mint and burn the vouchers
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx sdk.Context) bankexported.SupplyI
+
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// StakingKeeper defines the expected staking keeper used to weigh the claims
+// and the confirmations by bonded power
+type StakingKeeper interface {
+	Validator(ctx sdk.Context, address sdk.ValAddress) stakingtypes.ValidatorI
+	IterateBondedValidatorsByPower(ctx sdk.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool))
+}
+
+// TokenKeeper defines the expected token keeper the vouchers are registered with
+type TokenKeeper interface {
+	AddToken(ctx sdk.Context, token tokentypes.Token) error
+	HasTokenWithUnit(ctx sdk.Context, unit string) bool
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(
+	params Params, lastObservedEventNonce, lastObservedEthBlockHeight, lastTxID, lastBatchNonce uint64,
+	orchestratorKeys []OrchestratorKeys, eventNonces []ValidatorEventNonce, attestations []Attestation,
+	outgoingTxs []OutgoingTransferTx, batches []OutgoingTxBatch, batchConfirms []BatchConfirm,
+) *GenesisState {
+	return &GenesisState{
+		Params:                     params,
+		LastObservedEventNonce:     lastObservedEventNonce,
+		LastObservedEthBlockHeight: lastObservedEthBlockHeight,
+		LastTxId:                   lastTxID,
+		LastBatchNonce:             lastBatchNonce,
+		OrchestratorKeys:           orchestratorKeys,
+		EventNonces:                eventNonces,
+		Attestations:               attestations,
+		OutgoingTxs:                outgoingTxs,
+		Batches:                    batches,
+		BatchConfirms:              batchConfirms,
+	}
+}
+
+// DefaultGenesisState returns a default bridge module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(
+		DefaultParams(), 0, 0, 0, 0,
+		[]OrchestratorKeys{}, []ValidatorEventNonce{}, []Attestation{},
+		[]OutgoingTransferTx{}, []OutgoingTxBatch{}, []BatchConfirm{},
+	)
+}
+
+// Validate performs basic validation of the bridge genesis state
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	validators := make(map[string]bool)
+	orchestrators := make(map[string]bool)
+	ethAddresses := make(map[string]bool)
+	for _, keys := range gs.OrchestratorKeys {
+		if err := keys.Validate(); err != nil {
+			return err
+		}
+		if validators[keys.Validator] || orchestrators[keys.Orchestrator] || ethAddresses[keys.EthAddress] {
+			return fmt.Errorf("duplicate orchestrator keys of %s", keys.Validator)
+		}
+		validators[keys.Validator] = true
+		orchestrators[keys.Orchestrator] = true
+		ethAddresses[keys.EthAddress] = true
+	}
+
+	for _, attestation := range gs.Attestations {
+		if err := attestation.Validate(); err != nil {
+			return err
+		}
+		if attestation.Claim.EventNonce <= gs.LastObservedEventNonce {
+			return fmt.Errorf("attestation of the observed event %d", attestation.Claim.EventNonce)
+		}
+	}
+
+	ids := make(map[uint64]bool)
+	for _, tx := range gs.OutgoingTxs {
+		if err := tx.Validate(); err != nil {
+			return err
+		}
+		if ids[tx.Id] || tx.Id > gs.LastTxId {
+			return fmt.Errorf("invalid outgoing transfer id %d", tx.Id)
+		}
+		ids[tx.Id] = true
+	}
+
+	nonces := make(map[uint64]bool)
+	for _, batch := range gs.Batches {
+		if err := batch.Validate(); err != nil {
+			return err
+		}
+		if nonces[batch.BatchNonce] || batch.BatchNonce > gs.LastBatchNonce {
+			return fmt.Errorf("invalid batch nonce %d", batch.BatchNonce)
+		}
+		nonces[batch.BatchNonce] = true
+
+		for _, tx := range batch.Transactions {
+			if ids[tx.Id] || tx.Id > gs.LastTxId {
+				return fmt.Errorf("invalid outgoing transfer id %d", tx.Id)
+			}
+			ids[tx.Id] = true
+		}
+	}
+
+	for _, confirm := range gs.BatchConfirms {
+		if err := confirm.Validate(); err != nil {
+			return err
+		}
+		if !nonces[confirm.BatchNonce] {
+			return fmt.Errorf("confirmation of the unknown batch %d", confirm.BatchNonce)
+		}
+	}
+
+	return nil
+}
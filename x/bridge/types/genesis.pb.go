@@ -0,0 +1,856 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gauss/bridge/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the bridge module's genesis state.
+type GenesisState struct {
+	Params                     Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	LastObservedEventNonce     uint64                `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty" yaml:"last_observed_event_nonce"`
+	LastObservedEthBlockHeight uint64                `protobuf:"varint,3,opt,name=last_observed_eth_block_height,json=lastObservedEthBlockHeight,proto3" json:"last_observed_eth_block_height,omitempty" yaml:"last_observed_eth_block_height"`
+	LastTxId                   uint64                `protobuf:"varint,4,opt,name=last_tx_id,json=lastTxId,proto3" json:"last_tx_id,omitempty" yaml:"last_tx_id"`
+	LastBatchNonce             uint64                `protobuf:"varint,5,opt,name=last_batch_nonce,json=lastBatchNonce,proto3" json:"last_batch_nonce,omitempty" yaml:"last_batch_nonce"`
+	OrchestratorKeys           []OrchestratorKeys    `protobuf:"bytes,6,rep,name=orchestrator_keys,json=orchestratorKeys,proto3" json:"orchestrator_keys" yaml:"orchestrator_keys"`
+	EventNonces                []ValidatorEventNonce `protobuf:"bytes,7,rep,name=event_nonces,json=eventNonces,proto3" json:"event_nonces" yaml:"event_nonces"`
+	Attestations               []Attestation         `protobuf:"bytes,8,rep,name=attestations,proto3" json:"attestations"`
+	OutgoingTxs                []OutgoingTransferTx  `protobuf:"bytes,9,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs" yaml:"outgoing_txs"`
+	Batches                    []OutgoingTxBatch     `protobuf:"bytes,10,rep,name=batches,proto3" json:"batches"`
+	BatchConfirms              []BatchConfirm        `protobuf:"bytes,11,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms" yaml:"batch_confirms"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd69bd8fe4f55fe, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetLastObservedEventNonce() uint64 {
+	if m != nil {
+		return m.LastObservedEventNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastObservedEthBlockHeight() uint64 {
+	if m != nil {
+		return m.LastObservedEthBlockHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastTxId() uint64 {
+	if m != nil {
+		return m.LastTxId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastBatchNonce() uint64 {
+	if m != nil {
+		return m.LastBatchNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetOrchestratorKeys() []OrchestratorKeys {
+	if m != nil {
+		return m.OrchestratorKeys
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEventNonces() []ValidatorEventNonce {
+	if m != nil {
+		return m.EventNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestations() []Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOutgoingTxs() []OutgoingTransferTx {
+	if m != nil {
+		return m.OutgoingTxs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBatches() []OutgoingTxBatch {
+	if m != nil {
+		return m.Batches
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBatchConfirms() []BatchConfirm {
+	if m != nil {
+		return m.BatchConfirms
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "gauss.bridge.GenesisState")
+}
+
+func init() { proto.RegisterFile("gauss/bridge/genesis.proto", fileDescriptor_7cd69bd8fe4f55fe) }
+
+var fileDescriptor_7cd69bd8fe4f55fe = []byte{
+	// 564 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0x6f, 0xd8, 0x58, 0x87, 0x5b, 0xa6, 0xcd, 0x6c, 0xe0, 0x76, 0x5a, 0x12, 0x22, 0x90, 0xca,
+	0xa5, 0x95, 0x3a, 0x4e, 0x48, 0x1c, 0xc8, 0x34, 0x01, 0x42, 0x62, 0x28, 0x54, 0x1c, 0xb8, 0x64,
+	0x4e, 0xea, 0x25, 0xd1, 0xda, 0xb8, 0xca, 0xe7, 0x56, 0xe9, 0x5b, 0xf0, 0x18, 0x3c, 0xca, 0x8e,
+	0x3b, 0x72, 0x8a, 0x50, 0xfb, 0x06, 0x7d, 0x02, 0x14, 0x3b, 0x65, 0x49, 0x61, 0x97, 0xc4, 0xf6,
+	0xef, 0xdf, 0xe7, 0x7c, 0xf9, 0x50, 0x3b, 0xa0, 0x53, 0x80, 0x9e, 0x97, 0x44, 0xc3, 0x80, 0xf5,
+	0x02, 0x16, 0x33, 0x88, 0xa0, 0x3b, 0x49, 0xb8, 0xe0, 0xb8, 0x29, 0xb1, 0xae, 0xc2, 0xda, 0x87,
+	0x01, 0x0f, 0xb8, 0x04, 0x7a, 0xf9, 0x4a, 0x71, 0xda, 0xad, 0x8a, 0x5e, 0xbd, 0x14, 0x64, 0xfd,
+	0xac, 0xa3, 0xe6, 0x7b, 0x65, 0xf8, 0x55, 0x50, 0xc1, 0x70, 0x1f, 0xed, 0x4c, 0x68, 0x42, 0xc7,
+	0x40, 0x34, 0x53, 0xeb, 0x34, 0xfa, 0x87, 0xdd, 0x72, 0x40, 0xf7, 0x8b, 0xc4, 0xec, 0xed, 0x9b,
+	0xcc, 0xa8, 0x39, 0x05, 0x13, 0xbb, 0xa8, 0x35, 0xa2, 0x20, 0x5c, 0xee, 0x01, 0x4b, 0x66, 0x6c,
+	0xe8, 0xb2, 0x19, 0x8b, 0x85, 0x1b, 0xf3, 0xd8, 0x67, 0xe4, 0x81, 0xa9, 0x75, 0xb6, 0xed, 0x17,
+	0xab, 0xcc, 0x30, 0xe7, 0x74, 0x3c, 0x7a, 0x63, 0xdd, 0x4b, 0xb5, 0x9c, 0xa7, 0x39, 0x76, 0x51,
+	0x40, 0xe7, 0x39, 0xf2, 0x39, 0x07, 0xf0, 0x18, 0xe9, 0x1b, 0x2a, 0x11, 0xba, 0xde, 0x88, 0xfb,
+	0xd7, 0x6e, 0xc8, 0xa2, 0x20, 0x14, 0x64, 0x4b, 0xa6, 0xbc, 0x5a, 0x65, 0xc6, 0xcb, 0xff, 0xa6,
+	0x6c, 0xf0, 0x2d, 0xa7, 0x5d, 0x89, 0x12, 0xa1, 0x9d, 0xa3, 0x1f, 0x24, 0x88, 0x4f, 0x11, 0x92,
+	0x72, 0x91, 0xba, 0xd1, 0x90, 0x6c, 0x4b, 0xeb, 0xa3, 0x55, 0x66, 0x1c, 0x94, 0xac, 0x25, 0x66,
+	0x39, 0xbb, 0xf9, 0x66, 0x90, 0x7e, 0x1c, 0xe2, 0x73, 0xb4, 0x2f, 0x01, 0x8f, 0x0a, 0x3f, 0x2c,
+	0xee, 0xfe, 0x50, 0x4a, 0x8f, 0x57, 0x99, 0xf1, 0xac, 0x24, 0x2d, 0x31, 0x2c, 0x67, 0x2f, 0x3f,
+	0xb2, 0xf3, 0x93, 0xf5, 0x55, 0x0f, 0x78, 0xe2, 0x87, 0x0c, 0x44, 0x42, 0x05, 0x4f, 0xdc, 0x6b,
+	0x36, 0x07, 0xb2, 0x63, 0x6e, 0x75, 0x1a, 0x7d, 0xbd, 0xda, 0x8a, 0x8b, 0x12, 0xed, 0x13, 0x9b,
+	0x83, 0x6d, 0xe6, 0x4d, 0x59, 0x65, 0x06, 0x51, 0x59, 0xff, 0xd8, 0x58, 0xce, 0x3e, 0xdf, 0xd0,
+	0x60, 0x8a, 0x9a, 0xa5, 0x0e, 0x00, 0xa9, 0xcb, 0xa4, 0xe7, 0xd5, 0xa4, 0x6f, 0x74, 0x14, 0x0d,
+	0x73, 0xc9, 0x5d, 0x4b, 0xec, 0xe3, 0x22, 0xec, 0x89, 0x0a, 0x2b, 0x9b, 0x58, 0x4e, 0x83, 0xfd,
+	0x25, 0x02, 0x3e, 0x43, 0x4d, 0x2a, 0x04, 0x03, 0x41, 0x45, 0xc4, 0x63, 0x20, 0xbb, 0x32, 0xa2,
+	0x55, 0x8d, 0x78, 0x77, 0xc7, 0x28, 0x7e, 0xae, 0x8a, 0x08, 0x5f, 0xa2, 0x26, 0x9f, 0x8a, 0x80,
+	0x47, 0x71, 0xe0, 0x8a, 0x14, 0xc8, 0x23, 0x69, 0x62, 0x6e, 0x7c, 0x91, 0x82, 0x31, 0x48, 0x68,
+	0x0c, 0x57, 0x2c, 0x19, 0xa4, 0x9b, 0x65, 0x96, 0x3d, 0x2c, 0xa7, 0xb1, 0xde, 0x0e, 0x52, 0xc0,
+	0x6f, 0x51, 0x5d, 0x36, 0x86, 0x01, 0x41, 0xd2, 0xfc, 0xe4, 0x1e, 0xf3, 0x54, 0x76, 0xab, 0xa8,
+	0x72, 0xad, 0xc1, 0x97, 0x68, 0x4f, 0x2e, 0x5d, 0x9f, 0xc7, 0x57, 0x51, 0x32, 0x06, 0xd2, 0x90,
+	0x2e, 0xed, 0xaa, 0x8b, 0xd4, 0x9e, 0x29, 0x8a, 0x7d, 0x52, 0x14, 0x77, 0xa4, 0x8a, 0xab, 0xea,
+	0x2d, 0xe7, 0xb1, 0x57, 0x22, 0x83, 0x6d, 0xdf, 0x2c, 0x74, 0xed, 0x76, 0xa1, 0x6b, 0xbf, 0x17,
+	0xba, 0xf6, 0x63, 0xa9, 0xd7, 0x6e, 0x97, 0x7a, 0xed, 0xd7, 0x52, 0xaf, 0x7d, 0xef, 0x04, 0x91,
+	0x08, 0xa7, 0x5e, 0xd7, 0xe7, 0xe3, 0x9e, 0x1a, 0x75, 0xf5, 0x9c, 0xbd, 0xee, 0xa5, 0xeb, 0xa9,
+	0x17, 0xf3, 0x09, 0x03, 0x6f, 0x47, 0x4e, 0xfd, 0xe9, 0x9f, 0x01, 0x00, 0x2b, 0x07, 0xdb, 0x13,
+	0x52, 0x04, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BatchConfirms) > 0 {
+		for iNdEx := len(m.BatchConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.OutgoingTxs) > 0 {
+		for iNdEx := len(m.OutgoingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutgoingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.EventNonces) > 0 {
+		for iNdEx := len(m.EventNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EventNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.OrchestratorKeys) > 0 {
+		for iNdEx := len(m.OrchestratorKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrchestratorKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.LastBatchNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBatchNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastTxId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTxId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastObservedEthBlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedEthBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastObservedEventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedEventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.LastObservedEventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastObservedEventNonce))
+	}
+	if m.LastObservedEthBlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastObservedEthBlockHeight))
+	}
+	if m.LastTxId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTxId))
+	}
+	if m.LastBatchNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastBatchNonce))
+	}
+	if len(m.OrchestratorKeys) > 0 {
+		for _, e := range m.OrchestratorKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EventNonces) > 0 {
+		for _, e := range m.EventNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OutgoingTxs) > 0 {
+		for _, e := range m.OutgoingTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for _, e := range m.BatchConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+			}
+			m.LastObservedEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthBlockHeight", wireType)
+			}
+			m.LastObservedEthBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedEthBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTxId", wireType)
+			}
+			m.LastTxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBatchNonce", wireType)
+			}
+			m.LastBatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorKeys = append(m.OrchestratorKeys, OrchestratorKeys{})
+			if err := m.OrchestratorKeys[len(m.OrchestratorKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventNonces = append(m.EventNonces, ValidatorEventNonce{})
+			if err := m.EventNonces[len(m.EventNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutgoingTxs = append(m.OutgoingTxs, OutgoingTransferTx{})
+			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, OutgoingTxBatch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchConfirms = append(m.BatchConfirms, BatchConfirm{})
+			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,108 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "bridge"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace default name for parameter store
+	DefaultParamspace = ModuleName
+)
+
+var (
+	OrchestratorKeysKey           = []byte{0x11} // prefix for each key to the orchestrator keys of a validator
+	OrchestratorIndexKey          = []byte{0x12} // prefix for each key to the validator of an orchestrator account
+	EthAddressIndexKey            = []byte{0x13} // prefix for each key to the validator of an EVM address
+	AttestationKey                = []byte{0x21} // prefix for each key to the attestation of a claim
+	ValidatorEventNonceKey        = []byte{0x22} // prefix for each key to the last event nonce attested by a validator
+	LastObservedEventNonceKey     = []byte{0x23} // key to the nonce of the last observed event
+	LastObservedEthBlockHeightKey = []byte{0x24} // key to the EVM block height of the last observed event
+	OutgoingTxKey                 = []byte{0x31} // prefix for each key to a transfer waiting to be batched
+	OutgoingTxBatchKey            = []byte{0x32} // prefix for each key to a batch
+	BatchConfirmKey               = []byte{0x33} // prefix for each key to the confirmation of a batch by a validator
+	LastTxIDKey                   = []byte{0x34} // key to the id of the last outgoing transfer
+	LastBatchNonceKey             = []byte{0x35} // key to the nonce of the last batch
+)
+
+// GetOrchestratorKeysKey returns the key of the orchestrator keys of a validator
+// VALUE: bridge/OrchestratorKeys
+func GetOrchestratorKeysKey(valAddr sdk.ValAddress) []byte {
+	return append(OrchestratorKeysKey, valAddr.Bytes()...)
+}
+
+// GetOrchestratorIndexKey returns the key of the validator of an orchestrator account
+// VALUE: sdk.ValAddress
+func GetOrchestratorIndexKey(orchestrator sdk.AccAddress) []byte {
+	return append(OrchestratorIndexKey, orchestrator.Bytes()...)
+}
+
+// GetEthAddressIndexKey returns the key of the validator of an EVM address
+// VALUE: sdk.ValAddress
+func GetEthAddressIndexKey(ethAddress string) []byte {
+	return append(EthAddressIndexKey, EthAddressBytes(ethAddress)...)
+}
+
+// GetAttestationsKey returns the prefix of the keys to the attestations of the
+// claims of an event nonce
+func GetAttestationsKey(eventNonce uint64) []byte {
+	return append(AttestationKey, sdk.Uint64ToBigEndian(eventNonce)...)
+}
+
+// GetAttestationKey returns the key of the attestation of a claim by its hash
+// VALUE: bridge/Attestation
+func GetAttestationKey(eventNonce uint64, claimHash []byte) []byte {
+	return append(GetAttestationsKey(eventNonce), claimHash...)
+}
+
+// GetValidatorEventNonceKey returns the key of the last event nonce attested by a validator
+// VALUE: uint64 event nonce
+func GetValidatorEventNonceKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorEventNonceKey, valAddr.Bytes()...)
+}
+
+// GetOutgoingTxsKey returns the prefix of the keys to the transfers of a token
+// waiting to be batched
+func GetOutgoingTxsKey(tokenContract string) []byte {
+	return append(OutgoingTxKey, EthAddressBytes(tokenContract)...)
+}
+
+// GetOutgoingTxKey returns the key of a transfer waiting to be batched
+// VALUE: bridge/OutgoingTransferTx
+func GetOutgoingTxKey(tokenContract string, id uint64) []byte {
+	return append(GetOutgoingTxsKey(tokenContract), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetOutgoingTxBatchesKey returns the prefix of the keys to the batches of a token
+func GetOutgoingTxBatchesKey(tokenContract string) []byte {
+	return append(OutgoingTxBatchKey, EthAddressBytes(tokenContract)...)
+}
+
+// GetOutgoingTxBatchKey returns the key of a batch
+// VALUE: bridge/OutgoingTxBatch
+func GetOutgoingTxBatchKey(tokenContract string, batchNonce uint64) []byte {
+	return append(GetOutgoingTxBatchesKey(tokenContract), sdk.Uint64ToBigEndian(batchNonce)...)
+}
+
+// GetBatchConfirmsKey returns the prefix of the keys to the confirmations of a batch
+func GetBatchConfirmsKey(tokenContract string, batchNonce uint64) []byte {
+	key := append(BatchConfirmKey, EthAddressBytes(tokenContract)...)
+	return append(key, sdk.Uint64ToBigEndian(batchNonce)...)
+}
+
+// GetBatchConfirmKey returns the key of the confirmation of a batch by a validator
+// VALUE: bridge/BatchConfirm
+func GetBatchConfirmKey(tokenContract string, batchNonce uint64, valAddr sdk.ValAddress) []byte {
+	return append(GetBatchConfirmsKey(tokenContract, batchNonce), valAddr.Bytes()...)
+}
@@ -0,0 +1,262 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgSetOrchestratorAddress = "set_orchestrator_address"
+	TypeMsgDepositClaim           = "deposit_claim"
+	TypeMsgBatchExecutedClaim     = "batch_executed_claim"
+	TypeMsgSendToExternal         = "send_to_external"
+	TypeMsgConfirmBatch           = "confirm_batch"
+)
+
+var (
+	_ sdk.Msg = &MsgSetOrchestratorAddress{}
+	_ sdk.Msg = &MsgDepositClaim{}
+	_ sdk.Msg = &MsgBatchExecutedClaim{}
+	_ sdk.Msg = &MsgSendToExternal{}
+	_ sdk.Msg = &MsgConfirmBatch{}
+)
+
+// NewMsgSetOrchestratorAddress creates a new MsgSetOrchestratorAddress instance.
+func NewMsgSetOrchestratorAddress(
+	validator sdk.ValAddress, orchestrator sdk.AccAddress, ethAddress string,
+) *MsgSetOrchestratorAddress {
+	return &MsgSetOrchestratorAddress{
+		Validator:    validator.String(),
+		Orchestrator: orchestrator.String(),
+		EthAddress:   ethAddress,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSetOrchestratorAddress) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSetOrchestratorAddress) Type() string { return TypeMsgSetOrchestratorAddress }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetOrchestratorAddress) GetSigners() []sdk.AccAddress {
+	validator, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(validator)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgSetOrchestratorAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetOrchestratorAddress) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.Validator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid orchestrator address (%s)", err)
+	}
+	return ValidateEthAddress(msg.EthAddress)
+}
+
+// NewMsgDepositClaim creates a new MsgDepositClaim instance.
+func NewMsgDepositClaim(
+	eventNonce, ethBlockHeight uint64, tokenContract string, amount sdk.Int,
+	ethSender, receiver string, orchestrator sdk.AccAddress,
+) *MsgDepositClaim {
+	return &MsgDepositClaim{
+		EventNonce:     eventNonce,
+		EthBlockHeight: ethBlockHeight,
+		TokenContract:  tokenContract,
+		Amount:         amount,
+		EthSender:      ethSender,
+		Receiver:       receiver,
+		Orchestrator:   orchestrator.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgDepositClaim) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgDepositClaim) Type() string { return TypeMsgDepositClaim }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgDepositClaim) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Orchestrator)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgDepositClaim) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgDepositClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid orchestrator address (%s)", err)
+	}
+	if err := ValidateEthAddress(msg.TokenContract); err != nil {
+		return err
+	}
+	if err := ValidateEthAddress(msg.EthSender); err != nil {
+		return err
+	}
+	return msg.Claim().Validate()
+}
+
+// Claim returns the claim attested by the message
+func (msg MsgDepositClaim) Claim() Claim {
+	return NewDepositClaim(msg.EventNonce, msg.EthBlockHeight, msg.TokenContract, msg.Amount, msg.EthSender, msg.Receiver)
+}
+
+// NewMsgBatchExecutedClaim creates a new MsgBatchExecutedClaim instance.
+func NewMsgBatchExecutedClaim(
+	eventNonce, ethBlockHeight uint64, tokenContract string, batchNonce uint64, orchestrator sdk.AccAddress,
+) *MsgBatchExecutedClaim {
+	return &MsgBatchExecutedClaim{
+		EventNonce:     eventNonce,
+		EthBlockHeight: ethBlockHeight,
+		TokenContract:  tokenContract,
+		BatchNonce:     batchNonce,
+		Orchestrator:   orchestrator.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgBatchExecutedClaim) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgBatchExecutedClaim) Type() string { return TypeMsgBatchExecutedClaim }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgBatchExecutedClaim) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Orchestrator)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgBatchExecutedClaim) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgBatchExecutedClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid orchestrator address (%s)", err)
+	}
+	if err := ValidateEthAddress(msg.TokenContract); err != nil {
+		return err
+	}
+	return msg.Claim().Validate()
+}
+
+// Claim returns the claim attested by the message
+func (msg MsgBatchExecutedClaim) Claim() Claim {
+	return NewBatchExecutedClaim(msg.EventNonce, msg.EthBlockHeight, msg.TokenContract, msg.BatchNonce)
+}
+
+// NewMsgSendToExternal creates a new MsgSendToExternal instance.
+func NewMsgSendToExternal(sender sdk.AccAddress, ethDest string, amount sdk.Coin) *MsgSendToExternal {
+	return &MsgSendToExternal{
+		Sender:  sender.String(),
+		EthDest: ethDest,
+		Amount:  amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSendToExternal) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSendToExternal) Type() string { return TypeMsgSendToExternal }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSendToExternal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Sender)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgSendToExternal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSendToExternal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := ValidateEthAddress(msg.EthDest); err != nil {
+		return err
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "amount must be positive: %s", msg.Amount)
+	}
+	if _, ok := GetTokenContract(msg.Amount.Denom); !ok {
+		return sdkerrors.Wrap(ErrInvalidVoucher, msg.Amount.Denom)
+	}
+	return nil
+}
+
+// NewMsgConfirmBatch creates a new MsgConfirmBatch instance.
+func NewMsgConfirmBatch(
+	tokenContract string, batchNonce uint64, ethSigner, signature string, orchestrator sdk.AccAddress,
+) *MsgConfirmBatch {
+	return &MsgConfirmBatch{
+		TokenContract: tokenContract,
+		BatchNonce:    batchNonce,
+		EthSigner:     ethSigner,
+		Signature:     signature,
+		Orchestrator:  orchestrator.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgConfirmBatch) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgConfirmBatch) Type() string { return TypeMsgConfirmBatch }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgConfirmBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Orchestrator)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgConfirmBatch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgConfirmBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid orchestrator address (%s)", err)
+	}
+	if err := ValidateEthAddress(msg.TokenContract); err != nil {
+		return err
+	}
+	if msg.BatchNonce == 0 {
+		return sdkerrors.Wrap(ErrBatchNotFound, "batch nonce must be positive")
+	}
+	if err := ValidateEthAddress(msg.EthSigner); err != nil {
+		return err
+	}
+	_, err := ParseEthSignature(msg.Signature)
+	return err
+}
+
+func mustAccAddress(address string) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
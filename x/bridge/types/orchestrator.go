@@ -0,0 +1,47 @@
+package types
+
+import (
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewOrchestratorKeys creates the orchestrator keys of a validator
+func NewOrchestratorKeys(validator sdk.ValAddress, orchestrator sdk.AccAddress, ethAddress string) OrchestratorKeys {
+	return OrchestratorKeys{
+		Validator:    validator.String(),
+		Orchestrator: orchestrator.String(),
+		EthAddress:   NormalizeEthAddress(ethAddress),
+	}
+}
+
+// GetValidator returns the validator of the keys
+func (k OrchestratorKeys) GetValidator() sdk.ValAddress {
+	validator, err := sdk.ValAddressFromBech32(k.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return validator
+}
+
+// GetOrchestrator returns the orchestrator account of the keys
+func (k OrchestratorKeys) GetOrchestrator() sdk.AccAddress {
+	return mustAccAddress(k.Orchestrator)
+}
+
+// Validate performs a basic validation of the orchestrator keys
+func (k OrchestratorKeys) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(k.Validator); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(k.Orchestrator); err != nil {
+		return err
+	}
+	return ValidateEthAddress(k.EthAddress)
+}
+
+// String implements the Stringer interface.
+func (k OrchestratorKeys) String() string {
+	out, _ := yaml.Marshal(k)
+	return string(out)
+}
@@ -0,0 +1,185 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	// DefaultBridgeID is the default identifier of the bridge signed in the checkpoints
+	DefaultBridgeID = "gauss-bridge"
+	// DefaultBridgeChainID is the default chain id of the EVM chain, the Ethereum mainnet
+	DefaultBridgeChainID uint64 = 1
+	// DefaultAttestationThreshold is the default rate of the bonded power needed
+	// to observe a claim or release a batch, two thirds
+	DefaultAttestationThreshold = sdk.NewDec(2).QuoTruncate(sdk.NewDec(3))
+	// DefaultBatchMaxSize is the default maximum number of transfers of a batch
+	DefaultBatchMaxSize uint64 = 100
+	// DefaultBatchTimeout is the default number of EVM blocks a batch can be
+	// executed in, about 12 hours
+	DefaultBatchTimeout uint64 = 3600
+)
+
+var (
+	KeyBridgeID              = []byte("BridgeID")
+	KeyBridgeChainID         = []byte("BridgeChainID")
+	KeyBridgeContractAddress = []byte("BridgeContractAddress")
+	KeyAttestationThreshold  = []byte("AttestationThreshold")
+	KeyBatchMaxSize          = []byte("BatchMaxSize")
+	KeyBatchTimeout          = []byte("BatchTimeout")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable for bridge module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the bridge module
+func NewParams(
+	bridgeID string, bridgeChainID uint64, bridgeContractAddress string,
+	attestationThreshold sdk.Dec, batchMaxSize, batchTimeout uint64,
+) Params {
+	return Params{
+		BridgeId:              bridgeID,
+		BridgeChainId:         bridgeChainID,
+		BridgeContractAddress: bridgeContractAddress,
+		AttestationThreshold:  attestationThreshold,
+		BatchMaxSize:          batchMaxSize,
+		BatchTimeout:          batchTimeout,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the bridge module,
+// the address of the bridge contract is set by governance once it is deployed
+func DefaultParams() Params {
+	return NewParams(
+		DefaultBridgeID, DefaultBridgeChainID, "",
+		DefaultAttestationThreshold, DefaultBatchMaxSize, DefaultBatchTimeout,
+	)
+}
+
+// Validate all bridge module parameters
+func (p Params) Validate() error {
+	if err := validateBridgeID(p.BridgeId); err != nil {
+		return err
+	}
+	if err := validateBridgeChainID(p.BridgeChainId); err != nil {
+		return err
+	}
+	if err := validateBridgeContractAddress(p.BridgeContractAddress); err != nil {
+		return err
+	}
+	if err := validateAttestationThreshold(p.AttestationThreshold); err != nil {
+		return err
+	}
+	if err := validateBatchMaxSize(p.BatchMaxSize); err != nil {
+		return err
+	}
+
+	return validateBatchTimeout(p.BatchTimeout)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyBridgeID, &p.BridgeId, validateBridgeID),
+		paramtypes.NewParamSetPair(KeyBridgeChainID, &p.BridgeChainId, validateBridgeChainID),
+		paramtypes.NewParamSetPair(KeyBridgeContractAddress, &p.BridgeContractAddress, validateBridgeContractAddress),
+		paramtypes.NewParamSetPair(KeyAttestationThreshold, &p.AttestationThreshold, validateAttestationThreshold),
+		paramtypes.NewParamSetPair(KeyBatchMaxSize, &p.BatchMaxSize, validateBatchMaxSize),
+		paramtypes.NewParamSetPair(KeyBatchTimeout, &p.BatchTimeout, validateBatchTimeout),
+	}
+}
+
+func validateBridgeID(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// the bridge id is signed as a single 32 bytes word
+	if strings.TrimSpace(v) == "" || len(v) > 32 {
+		return fmt.Errorf("bridge id should be between 1 and 32 bytes long: %s", v)
+	}
+
+	return nil
+}
+
+func validateBridgeChainID(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("bridge chain id must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateBridgeContractAddress(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+
+	return ValidateEthAddress(v)
+}
+
+func validateAttestationThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// two conflicting claims of the same event could be observed otherwise
+	if v.IsNil() || v.LTE(sdk.NewDecWithPrec(5, 1)) || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("attestation threshold should be between (0.5, 1]: %s", v)
+	}
+
+	return nil
+}
+
+func validateBatchMaxSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("batch max size must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateBatchTimeout(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("batch timeout must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"strings"
+
+	tokentypes "github.com/gauss/gauss/v4/x/token/types"
+)
+
+const (
+	// VoucherSymbolPrefix prefixes the hex of the token contract in the symbol of a voucher
+	VoucherSymbolPrefix = "evm"
+	// VoucherUnitPrefix prefixes the hex of the token contract in the smallest
+	// unit of a voucher, atto as the unit has 18 decimals less than the symbol
+	VoucherUnitPrefix = "aevm"
+	// VoucherDecimals is the number of decimals of the vouchers, the ones of
+	// most ERC20 tokens as the amounts are bridged in their smallest unit
+	VoucherDecimals = uint32(18)
+)
+
+// GetVoucherUnit returns the smallest unit of the voucher of a token contract,
+// the denom the vouchers are minted in
+func GetVoucherUnit(tokenContract string) string {
+	return VoucherUnitPrefix + strings.TrimPrefix(NormalizeEthAddress(tokenContract), "0x")
+}
+
+// GetVoucherSymbol returns the symbol of the voucher of a token contract
+func GetVoucherSymbol(tokenContract string) string {
+	return VoucherSymbolPrefix + strings.TrimPrefix(NormalizeEthAddress(tokenContract), "0x")
+}
+
+// GetTokenContract returns the token contract of the smallest unit of a voucher
+func GetTokenContract(unit string) (string, bool) {
+	if !strings.HasPrefix(unit, VoucherUnitPrefix) {
+		return "", false
+	}
+
+	tokenContract := "0x" + strings.TrimPrefix(unit, VoucherUnitPrefix)
+	if ValidateEthAddress(tokenContract) != nil || tokenContract != NormalizeEthAddress(tokenContract) {
+		return "", false
+	}
+
+	return tokenContract, true
+}
+
+// NewVoucherToken returns the token registered in x/token for the vouchers of
+// a token contract, minted and burnt by the bridge module only. Having no owner,
+// its supply is not capped by its total supply
+func NewVoucherToken(tokenContract string) tokentypes.Token {
+	tokenContract = NormalizeEthAddress(tokenContract)
+
+	// the name is capped by x/token, the first bytes of the contract tell the
+	// vouchers apart in wallets
+	name := "EVM " + tokenContract[:10]
+
+	return tokentypes.NewToken(
+		name, GetVoucherSymbol(tokenContract), GetVoucherUnit(tokenContract), VoucherDecimals,
+		0, tokentypes.MaximumAmount, false, nil,
+	)
+}
@@ -24,6 +24,8 @@ const (
 
 	FlagMinSelfDelegation = "min-self-delegation"
 
+	FlagJail = "jail"
+
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
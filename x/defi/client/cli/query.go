@@ -32,17 +32,21 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDefis(),
 		GetCmdQueryDefiDelegations(),
 		GetCmdQueryDefiUnbondingDelegations(),
+		GetCmdQueryDefiRedelegations(),
 		GetCmdQueryDefiOutstandingRewards(),
 		GetCmdQueryDefiCommission(),
 		GetCmdQueryDelegation(),
 		GetCmdQueryDelegations(),
 		GetCmdQueryUnbondingDelegation(),
 		GetCmdQueryUnbondingDelegations(),
+		GetCmdQueryRedelegation(),
+		GetCmdQueryRedelegations(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryPool(),
 		GetCmdQueryParams(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryInflation(),
 	)
 
 	return cmd
@@ -560,6 +564,177 @@ $ %s query %s unbonding-delegations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 	return cmd
 }
 
+// GetCmdQueryDefiRedelegations implements the query all redelegatations
+// from a defi command.
+func GetCmdQueryDefiRedelegations() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "redelegations-from [defi-addr]",
+		Short: "Query all outgoing redelegatations from a defi",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query delegations that are redelegating _from_ a defi.
+
+Example:
+$ %s query %s redelegations-from %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, types.ModuleName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			defiSrcAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryRedelegationsRequest{
+				SrcDefiAddress: defiSrcAddr.String(),
+				Pagination:     pageReq,
+			}
+
+			res, err := queryClient.DefiRedelegations(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "defi redelegations")
+
+	return cmd
+}
+
+// GetCmdQueryRedelegation implements the command to query a single
+// redelegation record.
+func GetCmdQueryRedelegation() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "redelegation [delegator-addr] [src-defi-addr] [dst-defi-addr]",
+		Short: "Query a redelegation record based on delegator and a source and destination defi address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a redelegation record for an individual delegator between a source and destination defi.
+
+Example:
+$ %s query %s redelegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr, bech32PrefixValAddr, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			defiSrcAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			defiDstAddr, err := sdk.ValAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryRedelegationsRequest{
+				DelegatorAddress: delAddr.String(),
+				SrcDefiAddress:   defiSrcAddr.String(),
+				DstDefiAddress:   defiDstAddr.String(),
+			}
+
+			res, err := queryClient.DefiRedelegations(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRedelegations implements the command to query all the
+// redelegation records for a delegator.
+func GetCmdQueryRedelegations() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "redelegations [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all redelegations records for one delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all redelegation records for an individual delegator.
+
+Example:
+$ %s query %s redelegations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryRedelegationsRequest{
+				DelegatorAddress: delAddr.String(),
+				Pagination:       pageReq,
+			}
+
+			res, err := queryClient.DefiRedelegations(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegator redelegations")
+
+	return cmd
+}
+
 // GetCmdQueryDelegatorRewards implements the query delegator rewards command.
 func GetCmdQueryDelegatorRewards() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -774,3 +949,38 @@ $ %s query %s community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryInflation returns the command for fetching the defi mint inflation.
+func GetCmdQueryInflation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation",
+		Args:  cobra.NoArgs,
+		Short: "Query the provision minted by the next trade",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the provision minted by the next trade along with the annual mint period.
+
+Example:
+$ %s query %s inflation
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DefiInflation(context.Background(), &types.QueryDefiInflationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -14,6 +15,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/gauss/gauss/v4/x/defi/types"
 )
 
@@ -24,6 +27,9 @@ var (
 
 	DefaultTokens                  = sdk.TokensFromConsensusPower(100)
 	defaultAmount                  = DefaultTokens.String() + sdk.DefaultBondDenom
+	defaultCommissionRate          = "0.1"
+	defaultCommissionMaxRate       = "0.2"
+	defaultCommissionMaxChangeRate = "0.01"
 	defaultMinSelfDelegation       = "1"
 )
 const (
@@ -45,11 +51,14 @@ func NewTxCmd() *cobra.Command {
 		NewCreateDefiCmd(),
 		NewEditDefiCmd(),
 		NewDelegateCmd(),
+		NewRedelegateCmd(),
 		NewUnbondCmd(),
 		NewSetWithdrawAddrCmd(),
 		NewWithdrawRewardsCmd(),
 		NewWithdrawAllRewardsCmd(),
 		NewFundCommunityPoolCmd(),
+		NewSlashDefiCmd(),
+		NewUnjailCmd(),
 	)
 
 	return txCmd
@@ -77,6 +86,7 @@ func NewCreateDefiCmd() *cobra.Command {
 
 	cmd.Flags().AddFlagSet(FlagSetAmount())
 	cmd.Flags().AddFlagSet(flagSetDescriptionCreate())
+	cmd.Flags().AddFlagSet(FlagSetCommissionCreate())
 	cmd.Flags().AddFlagSet(FlagSetMinSelfDelegation())
 
 	cmd.Flags().String(FlagIP, "", fmt.Sprintf("The node's public IP. It takes effect only when used in combination with --%s", flags.FlagGenerateOnly))
@@ -107,6 +117,18 @@ func NewEditDefiCmd() *cobra.Command {
 			details, _ := cmd.Flags().GetString(FlagDetails)
 			description := types.NewDescription(moniker, identity, website, security, details)
 
+			var newRate *sdk.Dec
+
+			commissionRate, _ := cmd.Flags().GetString(FlagCommissionRate)
+			if commissionRate != "" {
+				rate, err := sdk.NewDecFromStr(commissionRate)
+				if err != nil {
+					return fmt.Errorf("invalid new commission rate: %v", err)
+				}
+
+				newRate = &rate
+			}
+
 			var newMinSelfDelegation *sdk.Int
 
 			minSelfDelegationString, _ := cmd.Flags().GetString(FlagMinSelfDelegation)
@@ -119,7 +141,7 @@ func NewEditDefiCmd() *cobra.Command {
 				newMinSelfDelegation = &msb
 			}
 
-			msg := types.NewMsgEditDefi(sdk.ValAddress(defiAddr), description, newMinSelfDelegation)
+			msg := types.NewMsgEditDefi(sdk.ValAddress(defiAddr), description, newRate, newMinSelfDelegation)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -129,6 +151,7 @@ func NewEditDefiCmd() *cobra.Command {
 	}
 
 	cmd.Flags().AddFlagSet(flagSetDescriptionEdit())
+	cmd.Flags().AddFlagSet(flagSetCommissionUpdate())
 	cmd.Flags().AddFlagSet(FlagSetMinSelfDelegation())
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -183,6 +206,57 @@ $ %s tx %s delegate %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 1000stake --from m
 	return cmd
 }
 
+func NewRedelegateCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "redelegate [src-defi-addr] [dst-defi-addr] [amount]",
+		Short: "Redelegate illiquid tokens from one defi to another",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redelegate an amount of illiquid staking tokens from one defi to another.
+
+Example:
+$ %s tx %s redelegate %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 100stake --from mykey
+`,
+				version.AppName, types.ModuleName, bech32PrefixValAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			defiSrcAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			defiDstAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDefiBeginRedelegate(delAddr, defiSrcAddr, defiDstAddr, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewUnbondCmd() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
 
@@ -435,6 +509,16 @@ func NewBuildCreateDefiMsg(clientCtx client.Context, txf tx.Factory, fs *flag.Fl
 		details,
 	)
 
+	// get the initial defi commission parameters
+	rateStr, _ := fs.GetString(FlagCommissionRate)
+	maxRateStr, _ := fs.GetString(FlagCommissionMaxRate)
+	maxChangeRateStr, _ := fs.GetString(FlagCommissionMaxChangeRate)
+
+	commissionRates, err := buildCommissionRates(rateStr, maxRateStr, maxChangeRateStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
 	// get the initial defi min self delegation
 	msbStr, _ := fs.GetString(FlagMinSelfDelegation)
 
@@ -444,7 +528,7 @@ func NewBuildCreateDefiMsg(clientCtx client.Context, txf tx.Factory, fs *flag.Fl
 	}
 
 	msg, err := types.NewMsgCreateDefi(
-		sdk.ValAddress(defiAddr), amount, description, minSelfDelegation,
+		sdk.ValAddress(defiAddr), amount, description, commissionRates, minSelfDelegation,
 	)
 	if err != nil {
 		return txf, nil, err
@@ -477,13 +561,19 @@ func CreateDefiMsgFlagSet(ipDefault string) (fs *flag.FlagSet, defaultsDesc stri
 	fsCreateDefi.String(FlagSecurityContact, "", "The defi's (optional) security contact email")
 	fsCreateDefi.String(FlagDetails, "", "The defi's (optional) details")
 	fsCreateDefi.String(FlagIdentity, "", "The (optional) identity signature (ex. UPort or Keybase)")
+	fsCreateDefi.AddFlagSet(FlagSetCommissionCreate())
 	fsCreateDefi.AddFlagSet(FlagSetMinSelfDelegation())
 	fsCreateDefi.AddFlagSet(FlagSetAmount())
 
 	defaultsDesc = fmt.Sprintf(`
 	delegation amount:           %s
+	commission rate:             %s
+	commission max rate:         %s
+	commission max change rate:  %s
 	minimum self delegation:     %s
-`, defaultAmount, defaultMinSelfDelegation)
+`, defaultAmount, defaultCommissionRate,
+		defaultCommissionMaxRate, defaultCommissionMaxChangeRate,
+		defaultMinSelfDelegation)
 
 	return fsCreateDefi, defaultsDesc
 }
@@ -495,6 +585,9 @@ type TxCreateDefiConfig struct {
 
 	Amount string
 
+	CommissionRate          string
+	CommissionMaxRate       string
+	CommissionMaxChangeRate string
 	MinSelfDelegation       string
 
 	IP              string
@@ -546,6 +639,21 @@ func PrepareConfigForTxCreateDefi(flagSet *flag.FlagSet, moniker, nodeID, chainI
 		return c, err
 	}
 
+	c.CommissionRate, err = flagSet.GetString(FlagCommissionRate)
+	if err != nil {
+		return c, err
+	}
+
+	c.CommissionMaxRate, err = flagSet.GetString(FlagCommissionMaxRate)
+	if err != nil {
+		return c, err
+	}
+
+	c.CommissionMaxChangeRate, err = flagSet.GetString(FlagCommissionMaxChangeRate)
+	if err != nil {
+		return c, err
+	}
+
 	c.MinSelfDelegation, err = flagSet.GetString(FlagMinSelfDelegation)
 	if err != nil {
 		return c, err
@@ -563,6 +671,18 @@ func PrepareConfigForTxCreateDefi(flagSet *flag.FlagSet, moniker, nodeID, chainI
 		c.Amount = defaultAmount
 	}
 
+	if c.CommissionRate == "" {
+		c.CommissionRate = defaultCommissionRate
+	}
+
+	if c.CommissionMaxRate == "" {
+		c.CommissionMaxRate = defaultCommissionMaxRate
+	}
+
+	if c.CommissionMaxChangeRate == "" {
+		c.CommissionMaxChangeRate = defaultCommissionMaxChangeRate
+	}
+
 	if c.MinSelfDelegation == "" {
 		c.MinSelfDelegation = defaultMinSelfDelegation
 	}
@@ -589,6 +709,16 @@ func BuildCreateDefiMsg(clientCtx client.Context, config TxCreateDefiConfig, txB
 		config.Details,
 	)
 
+	// get the initial defi commission parameters
+	rateStr := config.CommissionRate
+	maxRateStr := config.CommissionMaxRate
+	maxChangeRateStr := config.CommissionMaxChangeRate
+	commissionRates, err := buildCommissionRates(rateStr, maxRateStr, maxChangeRateStr)
+
+	if err != nil {
+		return txBldr, nil, err
+	}
+
 	// get the initial defi min self delegation
 	msbStr := config.MinSelfDelegation
 	minSelfDelegation, ok := sdk.NewIntFromString(msbStr)
@@ -598,7 +728,7 @@ func BuildCreateDefiMsg(clientCtx client.Context, config TxCreateDefiConfig, txB
 	}
 
 	msg, err := types.NewMsgCreateDefi(
-		sdk.ValAddress(defiAddr), amount, description, minSelfDelegation,
+		sdk.ValAddress(defiAddr), amount, description, commissionRates, minSelfDelegation,
 	)
 	if err != nil {
 		return txBldr, msg, err
@@ -653,3 +783,176 @@ $ %s tx %s fund-community-pool 100uatom --from mykey
 
 	return cmd
 }
+
+func NewSlashDefiCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash [defi-addr] [infraction-height] [fraction]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Slash a defi for an infraction as a registered evidence handler",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Slash a fraction of the tokens bonded to a defi and of the unbonding delegations
+and redelegations created from it since the infraction height. Only the evidence
+handlers listed in the module params may sign it, with --jail the defi is also jailed.
+
+Example:
+$ %s tx %s slash gaussvaloper1... 1000 0.05 --jail --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			defiAddr, infractionHeight, fraction, err := parseSlashArgs(args)
+			if err != nil {
+				return err
+			}
+
+			jail, err := cmd.Flags().GetBool(FlagJail)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSlashDefi(clientCtx.GetFromAddress(), defiAddr, infractionHeight, fraction, jail)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagJail, false, "jail the defi after slashing it")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewUnjailCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail",
+		Args:  cobra.NoArgs,
+		Short: "Unjail a jailed defi once its jail period has passed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unjail the defi operated by the signer, putting it back in the defi set.
+The jail period must have passed and the operator must hold its minimum self delegation.
+
+Example:
+$ %s tx %s unjail --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnjailDefi(sdk.ValAddress(clientCtx.GetFromAddress()))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitSlashDefiProposal implements the command to submit a slash defi proposal
+func GetCmdSubmitSlashDefiProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-defi [defi-addr] [infraction-height] [fraction]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal slashing a defi and optionally jailing it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal slashing a fraction of the tokens bonded to a defi and of the
+unbonding delegations and redelegations created from it since the infraction height,
+along with an initial deposit. With --jail, the defi is also jailed.
+
+Example:
+$ %s tx gov submit-proposal slash-defi gaussvaloper1... 1000 0.05 --jail --title="Slash defi" --description="Misrouted rewards" --deposit=1000ugauss --from=my_key
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			defiAddr, infractionHeight, fraction, err := parseSlashArgs(args)
+			if err != nil {
+				return err
+			}
+
+			jail, err := cmd.Flags().GetBool(FlagJail)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSlashDefiProposal(title, description, defiAddr, infractionHeight, fraction, jail)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(FlagJail, false, "jail the defi after slashing it")
+
+	return cmd
+}
+
+func parseSlashArgs(args []string) (defiAddr sdk.ValAddress, infractionHeight int64, fraction sdk.Dec, err error) {
+	defiAddr, err = sdk.ValAddressFromBech32(args[0])
+	if err != nil {
+		return
+	}
+
+	infractionHeight, err = strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return
+	}
+
+	fraction, err = sdk.NewDecFromStr(args[2])
+	return
+}
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/x/defi/types"
+)
+
+func buildCommissionRates(rateStr, maxRateStr, maxChangeRateStr string) (commission types.CommissionRates, err error) {
+	if rateStr == "" || maxRateStr == "" || maxChangeRateStr == "" {
+		return commission, errors.New("must specify all defi commission parameters")
+	}
+
+	rate, err := sdk.NewDecFromStr(rateStr)
+	if err != nil {
+		return commission, err
+	}
+
+	maxRate, err := sdk.NewDecFromStr(maxRateStr)
+	if err != nil {
+		return commission, err
+	}
+
+	maxChangeRate, err := sdk.NewDecFromStr(maxChangeRateStr)
+	if err != nil {
+		return commission, err
+	}
+
+	commission = types.NewCommissionRates(rate, maxRate, maxChangeRate)
+
+	return commission, nil
+}
@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/gauss/gauss/v4/x/defi/client/cli"
+	"github.com/gauss/gauss/v4/x/defi/client/rest"
+)
+
+// ProposalHandler is the slash defi proposal handler
+var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitSlashDefiProposal, rest.ProposalRESTHandler)
@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/gauss/gauss/v4/x/defi/types"
+)
+
+// SlashDefiProposalReq defines a slash defi proposal request body
+type SlashDefiProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title            string         `json:"title" yaml:"title"`
+	Description      string         `json:"description" yaml:"description"`
+	DefiAddress      sdk.ValAddress `json:"defi_address" yaml:"defi_address"`
+	InfractionHeight int64          `json:"infraction_height" yaml:"infraction_height"`
+	SlashFraction    sdk.Dec        `json:"slash_fraction" yaml:"slash_fraction"`
+	Jail             bool           `json:"jail" yaml:"jail"`
+	Proposer         sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit          sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the slash defi REST handler
+func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "slash_defi",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SlashDefiProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewSlashDefiProposal(req.Title, req.Description, req.DefiAddress, req.InfractionHeight, req.SlashFraction, req.Jail)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
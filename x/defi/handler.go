@@ -26,6 +26,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgDefiBeginRedelegate:
+			res, err := msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgDefiUndelegate:
 			res, err := msgServer.Undelegate(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -46,6 +50,14 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.FundCommunityPool(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSlashDefi:
+			res, err := msgServer.SlashDefi(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgUnjailDefi:
+			res, err := msgServer.UnjailDefi(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
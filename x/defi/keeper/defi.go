@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	gogotypes "github.com/gogo/protobuf/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gauss/gauss/v4/x/defi/types"
 )
@@ -37,11 +39,30 @@ func (k Keeper) SetDefi(ctx sdk.Context, defi types.Defi) {
 	store.Set(types.GetDefiKey(defi.GetOperator()), bz)
 }
 
+// defi index
+func (k Keeper) SetDefiByPowerIndex(ctx sdk.Context, defi types.Defi) {
+	// jailed defis are not kept in the power index
+	if defi.Jailed {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDefisByPowerIndexKey(defi), defi.GetOperator())
+}
+
+// defi index
+func (k Keeper) DeleteDefiByPowerIndex(ctx sdk.Context, defi types.Defi) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDefisByPowerIndexKey(defi))
+}
+
 // Update the tokens of an existing defi
 func (k Keeper) AddDefiTokensAndShares(ctx sdk.Context, defi types.Defi,
 	tokensToAdd sdk.Int) (defiOut types.Defi, addedShares sdk.Dec) {
+	k.DeleteDefiByPowerIndex(ctx, defi)
 	defi, addedShares = defi.AddTokensFromDel(tokensToAdd)
 	k.SetDefi(ctx, defi)
+	k.SetDefiByPowerIndex(ctx, defi)
 
 	return defi, addedShares
 }
@@ -49,8 +70,10 @@ func (k Keeper) AddDefiTokensAndShares(ctx sdk.Context, defi types.Defi,
 // Update the tokens of an existing defi
 func (k Keeper) RemoveDefiTokensAndShares(ctx sdk.Context, defi types.Defi,
 	sharesToRemove sdk.Dec) (defiOut types.Defi, removedTokens sdk.Int) {
+	k.DeleteDefiByPowerIndex(ctx, defi)
 	defi, removedTokens = defi.RemoveDelShares(sharesToRemove)
 	k.SetDefi(ctx, defi)
+	k.SetDefiByPowerIndex(ctx, defi)
 
 	return defi, removedTokens
 }
@@ -58,14 +81,37 @@ func (k Keeper) RemoveDefiTokensAndShares(ctx sdk.Context, defi types.Defi,
 // Update the tokens of an existing defi
 func (k Keeper) RemoveDefiTokens(ctx sdk.Context,
 	defi types.Defi, tokensToRemove sdk.Int) types.Defi {
+	k.DeleteDefiByPowerIndex(ctx, defi)
 	defi = defi.RemoveTokens(tokensToRemove)
 	k.SetDefi(ctx, defi)
+	k.SetDefiByPowerIndex(ctx, defi)
 
 	return defi
 }
 
+// UpdateDefiCommission attempts to update a defi's commission rate.
+// An error is returned if the new commission rate is invalid.
+func (k Keeper) UpdateDefiCommission(ctx sdk.Context,
+	defi types.Defi, newRate sdk.Dec) (types.Commission, error) {
+	commission := defi.Commission
+	blockTime := ctx.BlockHeader().Time
+
+	if err := commission.ValidateNewRate(newRate, blockTime); err != nil {
+		return commission, err
+	}
+
+	if newRate.GT(k.MaxCommissionRate(ctx)) {
+		return commission, types.ErrCommissionGTMaxCommissionRate
+	}
+
+	commission.Rate = newRate
+	commission.UpdateTime = blockTime
+
+	return commission, nil
+}
+
 // remove the defi record and associated indexes
-// except for the bonded defi index which is only handled in ApplyAndReturnTendermintUpdates
+// except for the bonded defi index which is only handled in ApplyAndReturnDefiSetUpdates
 // TODO, this function panics, and it's not good.
 func (k Keeper) RemoveDefi(ctx sdk.Context, address sdk.ValAddress) {
 	// first retrieve the old defi record
@@ -85,6 +131,7 @@ func (k Keeper) RemoveDefi(ctx sdk.Context, address sdk.ValAddress) {
 	// delete the old defi record
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetDefiKey(address))
+	store.Delete(types.GetDefisByPowerIndexKey(defi))
 
 	// call hooks
 	k.AfterDefiRemoved(ctx, defi.GetOperator())
@@ -125,6 +172,82 @@ func (k Keeper) GetDefis(ctx sdk.Context, maxRetrieve uint32) (defis []types.Def
 	return defis[:i] // trim if the array length < maxRetrieve
 }
 
+// returns an iterator for the current defi power store
+func (k Keeper) DefisPowerStoreIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStoreReversePrefixIterator(store, types.DefisByPowerIndexKey)
+}
+
+//_______________________________________________________________________
+// Last Defi Index
+
+// Load the last defi power.
+// Returns zero if the operator was not a defi last block.
+func (k Keeper) GetLastDefiPower(ctx sdk.Context, operator sdk.ValAddress) (power int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetLastDefiPowerKey(operator))
+	if bz == nil {
+		return 0
+	}
+
+	intV := gogotypes.Int64Value{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &intV)
+
+	return intV.GetValue()
+}
+
+// Set the last defi power.
+func (k Keeper) SetLastDefiPower(ctx sdk.Context, operator sdk.ValAddress, power int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&gogotypes.Int64Value{Value: power})
+	store.Set(types.GetLastDefiPowerKey(operator), bz)
+}
+
+// Delete the last defi power.
+func (k Keeper) DeleteLastDefiPower(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLastDefiPowerKey(operator))
+}
+
+// returns an iterator for the consensus defis in the last block
+func (k Keeper) LastDefisIterator(ctx sdk.Context) (iterator sdk.Iterator) {
+	store := ctx.KVStore(k.storeKey)
+	iterator = sdk.KVStorePrefixIterator(store, types.LastDefiPowerKey)
+
+	return iterator
+}
+
+// Iterate over last defi powers.
+func (k Keeper) IterateLastDefiPowers(ctx sdk.Context, handler func(operator sdk.ValAddress, power int64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.LastDefiPowerKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		addr := sdk.ValAddress(types.AddressFromLastDefiPowerKey(iter.Key()))
+		intV := &gogotypes.Int64Value{}
+
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), intV)
+
+		if handler(addr, intV.GetValue()) {
+			break
+		}
+	}
+}
+
+// get the group of the bonded defis, the set may briefly hold more than
+// max_defis defis after the parameter is lowered, until the next EndBlock
+func (k Keeper) GetLastDefis(ctx sdk.Context) (defis []types.Defi) {
+	k.IterateLastDefiPowers(ctx, func(operator sdk.ValAddress, _ int64) bool {
+		defis = append(defis, k.mustGetDefi(ctx, operator))
+		return false
+	})
+
+	return defis
+}
+
 //_______________________________________________________________________
 // GetUnbondingDefis returns a slice of mature defi addresses that
 // complete their unbonding at a given time and height.
@@ -302,6 +425,27 @@ func (k Keeper) IncrementDefiPeriod(ctx sdk.Context, defi types.DefiI) uint64 {
 	return rewards.Period
 }
 
+// update the defi's F1 periods for a slash, recording the slash fraction
+// so delegator rewards before and after the slash are split correctly
+func (k Keeper) updateDefiSlashFraction(ctx sdk.Context, defiAddr sdk.ValAddress, fraction sdk.Dec) {
+	if fraction.GT(sdk.OneDec()) || fraction.IsNegative() {
+		panic(fmt.Sprintf("fraction must be >=0 and <=1, current fraction: %v", fraction))
+	}
+
+	defi := k.mustGetDefi(ctx, defiAddr)
+
+	// increment current period
+	newPeriod := k.IncrementDefiPeriod(ctx, defi)
+
+	// increment reference count on period we need to track
+	k.incrementReferenceCount(ctx, defiAddr, newPeriod)
+
+	slashEvent := types.NewDefiSlashEvent(newPeriod, fraction)
+	height := uint64(ctx.BlockHeight())
+
+	k.SetDefiSlashEvent(ctx, defiAddr, height, newPeriod, slashEvent)
+}
+
 // increment the reference count for a historical rewards value
 func (k Keeper) incrementReferenceCount(ctx sdk.Context, defiAddr sdk.ValAddress, period uint64) {
 	historical := k.GetDefiHistoricalRewards(ctx, defiAddr, period)
@@ -1,7 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
+	"strconv"
+	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -55,23 +59,187 @@ func (k Keeper) BlockDefiUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 		)
 	}
 
+	// Remove all mature redelegations from the red queue.
+	matureRedelegations := k.DequeueAllMatureRedelegationQueue(ctx, ctx.BlockHeader().Time)
+	for _, dddTriplet := range matureRedelegations {
+		defiSrcAddr, err := sdk.ValAddressFromBech32(dddTriplet.DefiSrcAddress)
+		if err != nil {
+			panic(err)
+		}
+		defiDstAddr, err := sdk.ValAddressFromBech32(dddTriplet.DefiDstAddress)
+		if err != nil {
+			panic(err)
+		}
+		delegatorAddress, err := sdk.AccAddressFromBech32(dddTriplet.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		balances, err := k.CompleteRedelegation(
+			ctx,
+			delegatorAddress,
+			defiSrcAddr,
+			defiDstAddr,
+		)
+		if err != nil {
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteRedelegation,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, balances.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, dddTriplet.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeySrcDefi, dddTriplet.DefiSrcAddress),
+				sdk.NewAttribute(types.AttributeKeyDstDefi, dddTriplet.DefiDstAddress),
+			),
+		)
+	}
+
 	return defiUpdates
 }
 
 // Apply and return accumulated updates to the bonded defi set. Also,
+// * Updates the active defi set as keyed by LastDefiPowerKey.
+// * Updates the total power as keyed by LastTotalPowerKey.
 // * Updates defi status' according to updated powers.
 // * Updates the fee pool bonded vs not-bonded tokens.
 // * Updates relevant indices.
 // It gets called once after genesis, another time maybe after genesis transactions,
 // then once at every EndBlock.
 //
-// CONTRACT: Only defis with non-zero power or zero-power that were bonded
-// at the previous block height or were removed from the defi set entirely
-// are returned to Tendermint.
+// CONTRACT: defis hold no consensus key, so no update is ever returned to
+// Tendermint. The changes of the bonded defi set are emitted as events instead.
 func (k Keeper) ApplyAndReturnDefiSetUpdates(ctx sdk.Context) (updates []abci.ValidatorUpdate, err error) {
+	maxDefis := k.MaxDefis(ctx)
+	totalPower := sdk.ZeroInt()
+	amtFromBondedToNotBonded, amtFromNotBondedToBonded := sdk.ZeroInt(), sdk.ZeroInt()
+	changed := false
+
+	// Retrieve the last defi set.
+	// The persistent set is updated later in this function.
+	// (see LastDefiPowerKey).
+	last := k.getLastDefisByAddr(ctx)
+
+	// Iterate over defis, highest power to lowest.
+	iterator := k.DefisPowerStoreIterator(ctx)
+	defer iterator.Close()
+
+	for count := 0; iterator.Valid() && count < int(maxDefis); iterator.Next() {
+		// everything that is iterated in this loop is becoming or already a
+		// part of the bonded defi set
+		defiAddr := sdk.ValAddress(iterator.Value())
+		defi := k.mustGetDefi(ctx, defiAddr)
+
+		if defi.Jailed {
+			panic("should never retrieve a jailed defi from the power store")
+		}
+
+		// if we get to a zero-power defi (which we don't bond),
+		// there are no more possible bonded defis
+		if defi.PotentialConsensusPower() == 0 {
+			break
+		}
+
+		// a defi whose operator undelegated below the minimum self
+		// delegation is left out of the bonded set
+		if !k.hasMinSelfDelegation(ctx, defi) {
+			continue
+		}
+
+		// apply the appropriate state change if necessary
+		switch {
+		case defi.IsUnbonded():
+			defi, err = k.unbondedToBonded(ctx, defi)
+			if err != nil {
+				return
+			}
+			amtFromNotBondedToBonded = amtFromNotBondedToBonded.Add(defi.GetTokens())
+		case defi.IsUnbonding():
+			defi, err = k.unbondingToBonded(ctx, defi)
+			if err != nil {
+				return
+			}
+			amtFromNotBondedToBonded = amtFromNotBondedToBonded.Add(defi.GetTokens())
+		case defi.IsBonded():
+			// no state change
+		default:
+			panic("unexpected defi status")
+		}
+
+		// fetch the old power
+		oldPower, found := last[defi.OperatorAddress]
+		newPower := defi.ConsensusPower()
+
+		// update the defi set if power has changed
+		if !found || oldPower != newPower {
+			k.SetLastDefiPower(ctx, defiAddr, newPower)
+			k.emitDefiPowerUpdate(ctx, defi.OperatorAddress, newPower)
+			changed = true
+		}
+
+		delete(last, defi.OperatorAddress)
+		count++
+
+		totalPower = totalPower.Add(sdk.NewInt(newPower))
+	}
+
+	for _, defiAddr := range sortNoLongerBonded(last) {
+		defi := k.mustGetDefi(ctx, defiAddr)
+		defi, err = k.bondedToUnbonding(ctx, defi)
+		if err != nil {
+			return
+		}
+		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(defi.GetTokens())
+		k.DeleteLastDefiPower(ctx, defi.GetOperator())
+		k.emitDefiPowerUpdate(ctx, defi.OperatorAddress, 0)
+		changed = true
+	}
+
+	// Update the pools based on the recent updates in the defi set:
+	// - The tokens from the non-bonded candidates that enter the new defi set need to be transferred
+	// to the Bonded pool.
+	// - The tokens from the bonded defis that are being kicked out from the defi set
+	// need to be transferred to the NotBonded pool.
+	switch {
+	// Compare and subtract the respective amounts to only perform one transfer.
+	// This is done in order to avoid doing multiple updates inside each iterator/loop.
+	case amtFromNotBondedToBonded.GT(amtFromBondedToNotBonded):
+		k.notBondedTokensToBonded(ctx, amtFromNotBondedToBonded.Sub(amtFromBondedToNotBonded))
+	case amtFromNotBondedToBonded.LT(amtFromBondedToNotBonded):
+		k.bondedTokensToNotBonded(ctx, amtFromBondedToNotBonded.Sub(amtFromNotBondedToBonded))
+	default:
+		// equal amounts of tokens; no update required
+	}
+
+	// set total power on lookup index if there are any updates
+	if changed {
+		k.SetLastTotalPower(ctx, totalPower)
+	}
+
 	return updates, err
 }
 
+// hasMinSelfDelegation returns whether the operator of a defi still holds the
+// minimum self delegation the defi declared
+func (k Keeper) hasMinSelfDelegation(ctx sdk.Context, defi types.Defi) bool {
+	delegation, found := k.GetDelegation(ctx, sdk.AccAddress(defi.GetOperator()), defi.GetOperator())
+	if !found {
+		return false
+	}
+
+	return defi.TokensFromShares(delegation.Shares).TruncateInt().GTE(defi.MinSelfDelegation)
+}
+
+func (k Keeper) emitDefiPowerUpdate(ctx sdk.Context, defiAddr string, power int64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDefiPowerUpdate,
+			sdk.NewAttribute(types.AttributeKeyDefi, defiAddr),
+			sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(power, 10)),
+		),
+	)
+}
+
 // Defi state transitions
 
 func (k Keeper) bondedToUnbonding(ctx sdk.Context, defi types.Defi) (types.Defi, error) {
@@ -119,6 +287,14 @@ func (k Keeper) bondDefi(ctx sdk.Context, defi types.Defi) (types.Defi, error) {
 
 	k.AfterDefiBonded(ctx, defi.GetOperator())
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBondDefi,
+			sdk.NewAttribute(types.AttributeKeyDefi, defi.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyTokens, defi.Tokens.String()),
+		),
+	)
+
 	return defi, nil
 }
 
@@ -145,6 +321,15 @@ func (k Keeper) beginUnbondingDefi(ctx sdk.Context, defi types.Defi) (types.Defi
 
 	k.AfterDefiBeginUnbonding(ctx, defi.GetOperator())
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBeginUnbondingDefi,
+			sdk.NewAttribute(types.AttributeKeyDefi, defi.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyTokens, defi.Tokens.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, defi.UnbondingTime.Format(time.RFC3339)),
+		),
+	)
+
 	return defi, nil
 }
 
@@ -153,5 +338,50 @@ func (k Keeper) completeUnbondingDefi(ctx sdk.Context, defi types.Defi) types.De
 	defi = defi.UpdateStatus(types.Unbonded)
 	k.SetDefi(ctx, defi)
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteUnbondingDefi,
+			sdk.NewAttribute(types.AttributeKeyDefi, defi.OperatorAddress),
+		),
+	)
+
 	return defi
 }
+
+// map of operator bech32-addresses to their last power
+type defisByAddr map[string]int64
+
+// get the last defi set
+func (k Keeper) getLastDefisByAddr(ctx sdk.Context) defisByAddr {
+	last := make(defisByAddr)
+
+	k.IterateLastDefiPowers(ctx, func(operator sdk.ValAddress, power int64) bool {
+		last[operator.String()] = power
+		return false
+	})
+
+	return last
+}
+
+// given a map of remaining defis to previous bonded power
+// returns the list of defis to be unbonded, sorted by operator address
+func sortNoLongerBonded(last defisByAddr) []sdk.ValAddress {
+	noLongerBonded := make([]sdk.ValAddress, 0, len(last))
+
+	for defiAddr := range last {
+		addr, err := sdk.ValAddressFromBech32(defiAddr)
+		if err != nil {
+			panic(err)
+		}
+
+		noLongerBonded = append(noLongerBonded, addr)
+	}
+
+	// sorted by address - order doesn't matter
+	sort.SliceStable(noLongerBonded, func(i, j int) bool {
+		// -1 means strictly less than
+		return bytes.Compare(noLongerBonded[i], noLongerBonded[j]) == -1
+	})
+
+	return noLongerBonded
+}
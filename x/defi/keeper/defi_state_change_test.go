@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/defi/keeper"
+	"github.com/gauss/gauss/v4/x/defi/types"
+)
+
+func TestApplyAndReturnDefiSetUpdates(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.DefiKeeper)
+
+	params := app.DefiKeeper.GetParams(ctx)
+	params.MaxDefis = 2
+	app.DefiKeeper.SetParams(ctx, params)
+
+	power := sdk.TokensFromConsensusPower
+	bondDenom := app.DefiKeeper.BondDenom(ctx)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, power(100))
+	defiAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	commission := types.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+
+	// the defis are created unbonded with the powers 10, 20 and 30
+	for i, defiAddr := range defiAddrs {
+		msg, err := types.NewMsgCreateDefi(defiAddr, sdk.NewCoin(bondDenom, power(int64(10*(i+1)))),
+			types.NewDescription("moniker", "", "", "", ""), commission, power(int64(5*(i+1))))
+		require.NoError(t, err)
+		_, err = msgServer.CreateDefi(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+	}
+
+	status := func(i int) types.BondStatus {
+		defi, found := app.DefiKeeper.GetDefi(ctx, defiAddrs[i])
+		require.True(t, found)
+		return defi.Status
+	}
+	requirePools := func(bonded, notBonded int64) {
+		require.Equal(t, power(bonded), app.DefiKeeper.TotalBondedTokens(ctx))
+		require.Equal(t, power(notBonded), app.BankKeeper.GetBalance(ctx, app.DefiKeeper.GetNotBondedPool(ctx).GetAddress(), bondDenom).Amount)
+
+		msg, broken := keeper.ModuleAccountInvariants(app.DefiKeeper)(ctx)
+		require.False(t, broken, msg)
+	}
+
+	// only the max_defis most powerful defis are bonded
+	_, err := app.DefiKeeper.ApplyAndReturnDefiSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Unbonded, status(0))
+	require.Equal(t, types.Bonded, status(1))
+	require.Equal(t, types.Bonded, status(2))
+	require.Equal(t, int64(30), app.DefiKeeper.GetLastDefiPower(ctx, defiAddrs[2]))
+	require.Equal(t, sdk.NewInt(50), app.DefiKeeper.GetLastTotalPower(ctx))
+	require.Len(t, app.DefiKeeper.GetLastDefis(ctx), 2)
+	requirePools(50, 10)
+
+	// a delegation ranking the first defi above the second swaps them
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDefiDelegate(addrs[0], defiAddrs[0], sdk.NewCoin(bondDenom, power(15))))
+	require.NoError(t, err)
+	_, err = app.DefiKeeper.ApplyAndReturnDefiSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Bonded, status(0))
+	require.Equal(t, types.Unbonding, status(1))
+	require.Equal(t, int64(0), app.DefiKeeper.GetLastDefiPower(ctx, defiAddrs[1]))
+	require.Equal(t, sdk.NewInt(55), app.DefiKeeper.GetLastTotalPower(ctx))
+	requirePools(55, 20)
+
+	eventTypes := make(map[string]bool)
+	for _, event := range ctx.EventManager().Events() {
+		eventTypes[event.Type] = true
+	}
+	require.True(t, eventTypes[types.EventTypeBondDefi])
+	require.True(t, eventTypes[types.EventTypeBeginUnbondingDefi])
+	require.True(t, eventTypes[types.EventTypeDefiPowerUpdate])
+
+	// the defi whose operator undelegates below the minimum self delegation
+	// leaves the set and the unbonding defi is bonded again
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgDefiUndelegate(addrs[2], defiAddrs[2], sdk.NewCoin(bondDenom, power(20))))
+	require.NoError(t, err)
+	_, err = app.DefiKeeper.ApplyAndReturnDefiSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Bonded, status(0))
+	require.Equal(t, types.Bonded, status(1))
+	require.Equal(t, types.Unbonding, status(2))
+	requirePools(45, 30)
+
+	// the defi is unbonded once its unbonding time elapsed
+	defi, _ := app.DefiKeeper.GetDefi(ctx, defiAddrs[2])
+	ctx = ctx.WithBlockTime(defi.UnbondingTime)
+	app.DefiKeeper.BlockDefiUpdates(ctx)
+	require.Equal(t, types.Unbonded, status(2))
+
+	// the historical info tracks the bonded set
+	params.HistoricalEntries = 1
+	app.DefiKeeper.SetParams(ctx, params)
+	app.DefiKeeper.TrackHistoricalInfo(ctx)
+	info, found := app.DefiKeeper.GetHistoricalInfo(ctx, ctx.BlockHeight())
+	require.True(t, found)
+	require.Len(t, info.Defiset, 2)
+}
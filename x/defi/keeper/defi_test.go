@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/defi/keeper"
+	"github.com/gauss/gauss/v4/x/defi/types"
+)
+
+func TestDefiCommission(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)})
+	msgServer := keeper.NewMsgServerImpl(app.DefiKeeper)
+	querier := keeper.Querier{Keeper: app.DefiKeeper}
+
+	power := sdk.TokensFromConsensusPower
+	bondDenom := app.DefiKeeper.BondDenom(ctx)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, power(100))
+	defiAddr := sdk.ValAddress(addrs[0])
+	description := types.NewDescription("moniker", "", "", "", "")
+	dec := func(percent int64) sdk.Dec { return sdk.NewDecWithPrec(percent, 2) }
+
+	// the rewards are minted in a mintable token
+	err := app.TokenKeeper.IssueToken(ctx, "Bitcoin Network", "btc", "satoshi", 8, 1000, 10000, true, true, false, false, addrs[0])
+	require.NoError(t, err)
+
+	params := app.DefiKeeper.GetParams(ctx)
+	params.MaxCommissionRate = dec(30)
+	params.MintInflation = sdk.NewInt64Coin("satoshi", 1000)
+	params.CommunityTax = sdk.ZeroDec()
+	app.DefiKeeper.SetParams(ctx, params)
+
+	// the max rate of the commission can not exceed the max commission rate param
+	msg, err := types.NewMsgCreateDefi(defiAddr, sdk.NewCoin(bondDenom, power(10)), description,
+		types.NewCommissionRates(dec(10), dec(40), dec(5)), power(5))
+	require.NoError(t, err)
+	_, err = msgServer.CreateDefi(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrCommissionGTMaxCommissionRate)
+
+	msg.Commission = types.NewCommissionRates(dec(10), dec(20), dec(5))
+	require.NoError(t, msg.ValidateBasic())
+	_, err = msgServer.CreateDefi(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	res, err := querier.Defi(sdk.WrapSDKContext(ctx), &types.QueryDefiRequest{DefiAddress: defiAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, types.NewCommissionWithTime(dec(10), dec(20), dec(5), ctx.BlockTime()), res.Defi.Commission)
+
+	// the commission rate can be changed once a day within the max change rate
+	edit := func(rate sdk.Dec) error {
+		_, err := msgServer.EditDefi(sdk.WrapSDKContext(ctx), types.NewMsgEditDefi(defiAddr, description, &rate, nil))
+		return err
+	}
+	require.ErrorIs(t, edit(dec(12)), types.ErrCommissionUpdateTime)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	require.ErrorIs(t, edit(dec(16)), types.ErrCommissionGTMaxChangeRate)
+	require.ErrorIs(t, edit(dec(25)), types.ErrCommissionGTMaxRate)
+	require.NoError(t, edit(dec(15)))
+
+	defi, found := app.DefiKeeper.GetDefi(ctx, defiAddr)
+	require.True(t, found)
+	require.Equal(t, dec(15), defi.GetCommission())
+	require.Equal(t, ctx.BlockTime(), defi.Commission.UpdateTime)
+
+	// the defi commission is taken from the minted rewards
+	app.DefiKeeper.MintTokens(ctx, defiAddr, addrs[0].String(), sdk.ZeroDec(), false)
+	commission := app.DefiKeeper.GetDefiAccumulatedCommission(ctx, defiAddr).Commission
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("satoshi", 150)), commission)
+
+	// a lowered max commission rate param caps the commission at once
+	params.MaxCommissionRate = dec(5)
+	app.DefiKeeper.SetParams(ctx, params)
+	app.DefiKeeper.MintTokens(ctx, defiAddr, addrs[0].String(), sdk.ZeroDec(), false)
+	commission = app.DefiKeeper.GetDefiAccumulatedCommission(ctx, defiAddr).Commission
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("satoshi", 200)), commission)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	require.ErrorIs(t, edit(dec(10)), types.ErrCommissionGTMaxCommissionRate)
+
+	// the commission rates are validated statelessly
+	msg.Commission = types.NewCommissionRates(dec(30), dec(20), dec(5))
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrCommissionGTMaxRate)
+	rate := dec(110)
+	require.Error(t, types.NewMsgEditDefi(defiAddr, description, &rate, nil).ValidateBasic())
+}
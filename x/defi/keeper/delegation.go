@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
@@ -313,6 +314,233 @@ func (k Keeper) DequeueAllMatureUBDQueue(ctx sdk.Context, currTime time.Time) (m
 	return matureUnbonds
 }
 
+// return a given amount of all the delegator redelegations
+func (k Keeper) GetRedelegations(ctx sdk.Context, delegator sdk.AccAddress,
+	maxRetrieve uint16) (redelegations []types.Redelegation) {
+	redelegations = make([]types.Redelegation, maxRetrieve)
+
+	store := ctx.KVStore(k.storeKey)
+	delegatorPrefixKey := types.GetREDsKey(delegator)
+
+	iterator := sdk.KVStorePrefixIterator(store, delegatorPrefixKey)
+	defer iterator.Close()
+
+	i := 0
+	for ; iterator.Valid() && i < int(maxRetrieve); iterator.Next() {
+		redelegation := types.MustUnmarshalRED(k.cdc, iterator.Value())
+		redelegations[i] = redelegation
+		i++
+	}
+
+	return redelegations[:i] // trim if the array length < maxRetrieve
+}
+
+// return a redelegation
+func (k Keeper) GetRedelegation(ctx sdk.Context,
+	delAddr sdk.AccAddress, defiSrcAddr, defiDstAddr sdk.ValAddress) (red types.Redelegation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetREDKey(delAddr, defiSrcAddr, defiDstAddr)
+
+	value := store.Get(key)
+	if value == nil {
+		return red, false
+	}
+
+	red = types.MustUnmarshalRED(k.cdc, value)
+
+	return red, true
+}
+
+// return all redelegations from a particular defi
+func (k Keeper) GetRedelegationsFromSrcDefi(ctx sdk.Context, defiAddr sdk.ValAddress) (reds []types.Redelegation) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetREDsFromDefiSrcIndexKey(defiAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := types.GetREDKeyFromDefiSrcIndexKey(iterator.Key())
+		value := store.Get(key)
+		red := types.MustUnmarshalRED(k.cdc, value)
+		reds = append(reds, red)
+	}
+
+	return reds
+}
+
+// check if defi is receiving a redelegation
+func (k Keeper) HasReceivingRedelegation(ctx sdk.Context,
+	delAddr sdk.AccAddress, defiDstAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetREDsByDelToDefiDstIndexKey(delAddr, defiDstAddr)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// HasMaxRedelegationEntries - redelegation has maximum number of entries
+func (k Keeper) HasMaxRedelegationEntries(ctx sdk.Context,
+	delegatorAddr sdk.AccAddress, defiSrcAddr,
+	defiDstAddr sdk.ValAddress) bool {
+	red, found := k.GetRedelegation(ctx, delegatorAddr, defiSrcAddr, defiDstAddr)
+	if !found {
+		return false
+	}
+
+	return len(red.Entries) >= int(k.MaxEntries(ctx))
+}
+
+// set a redelegation and associated index
+func (k Keeper) SetRedelegation(ctx sdk.Context, red types.Redelegation) {
+	delegatorAddress, err := sdk.AccAddressFromBech32(red.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalRED(k.cdc, red)
+	defiSrcAddr, err := sdk.ValAddressFromBech32(red.DefiSrcAddress)
+	if err != nil {
+		panic(err)
+	}
+	defiDstAddr, err := sdk.ValAddressFromBech32(red.DefiDstAddress)
+	if err != nil {
+		panic(err)
+	}
+	key := types.GetREDKey(delegatorAddress, defiSrcAddr, defiDstAddr)
+	store.Set(key, bz)
+	store.Set(types.GetREDByDefiSrcIndexKey(delegatorAddress, defiSrcAddr, defiDstAddr), []byte{})
+	store.Set(types.GetREDByDefiDstIndexKey(delegatorAddress, defiSrcAddr, defiDstAddr), []byte{})
+}
+
+// SetRedelegationEntry adds an entry to the redelegation at the given
+// addresses. It creates the redelegation if it does not exist
+func (k Keeper) SetRedelegationEntry(ctx sdk.Context,
+	delegatorAddr sdk.AccAddress, defiSrcAddr,
+	defiDstAddr sdk.ValAddress, creationHeight int64,
+	minTime time.Time, balance sdk.Int, sharesDst sdk.Dec) types.Redelegation {
+	red, found := k.GetRedelegation(ctx, delegatorAddr, defiSrcAddr, defiDstAddr)
+	if found {
+		red.AddEntry(creationHeight, minTime, balance, sharesDst)
+	} else {
+		red = types.NewRedelegation(delegatorAddr, defiSrcAddr,
+			defiDstAddr, creationHeight, minTime, balance, sharesDst)
+	}
+
+	k.SetRedelegation(ctx, red)
+
+	return red
+}
+
+// iterate through all redelegations
+func (k Keeper) IterateRedelegations(ctx sdk.Context, fn func(index int64, red types.Redelegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.RedelegationKey)
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		red := types.MustUnmarshalRED(k.cdc, iterator.Value())
+		if stop := fn(i, red); stop {
+			break
+		}
+		i++
+	}
+}
+
+// remove a redelegation object and associated index
+func (k Keeper) RemoveRedelegation(ctx sdk.Context, red types.Redelegation) {
+	delegatorAddress, err := sdk.AccAddressFromBech32(red.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	defiSrcAddr, err := sdk.ValAddressFromBech32(red.DefiSrcAddress)
+	if err != nil {
+		panic(err)
+	}
+	defiDstAddr, err := sdk.ValAddressFromBech32(red.DefiDstAddress)
+	if err != nil {
+		panic(err)
+	}
+	redKey := types.GetREDKey(delegatorAddress, defiSrcAddr, defiDstAddr)
+	store.Delete(redKey)
+	store.Delete(types.GetREDByDefiSrcIndexKey(delegatorAddress, defiSrcAddr, defiDstAddr))
+	store.Delete(types.GetREDByDefiDstIndexKey(delegatorAddress, defiSrcAddr, defiDstAddr))
+}
+
+// redelegation queue timeslice operations
+
+// Gets a specific redelegation queue timeslice. A timeslice is a slice of DDDTriplets corresponding to redelegations
+// that expire at a certain time.
+func (k Keeper) GetRedelegationQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (dddTriplets []types.DDDTriplet) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetRedelegationTimeKey(timestamp))
+	if bz == nil {
+		return []types.DDDTriplet{}
+	}
+
+	triplets := types.DDDTriplets{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &triplets)
+
+	return triplets.Triplets
+}
+
+// Sets a specific redelegation queue timeslice.
+func (k Keeper) SetRedelegationQueueTimeSlice(ctx sdk.Context, timestamp time.Time, keys []types.DDDTriplet) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&types.DDDTriplets{Triplets: keys})
+	store.Set(types.GetRedelegationTimeKey(timestamp), bz)
+}
+
+// Insert an redelegation delegation to the appropriate timeslice in the redelegation queue
+func (k Keeper) InsertRedelegationQueue(ctx sdk.Context, red types.Redelegation,
+	completionTime time.Time) {
+	timeSlice := k.GetRedelegationQueueTimeSlice(ctx, completionTime)
+	dddTriplet := types.DDDTriplet{
+		DelegatorAddress: red.DelegatorAddress,
+		DefiSrcAddress:   red.DefiSrcAddress,
+		DefiDstAddress:   red.DefiDstAddress}
+
+	if len(timeSlice) == 0 {
+		k.SetRedelegationQueueTimeSlice(ctx, completionTime, []types.DDDTriplet{dddTriplet})
+	} else {
+		timeSlice = append(timeSlice, dddTriplet)
+		k.SetRedelegationQueueTimeSlice(ctx, completionTime, timeSlice)
+	}
+}
+
+// Returns all the redelegation queue timeslices from time 0 until endTime
+func (k Keeper) RedelegationQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.RedelegationQueueKey, sdk.InclusiveEndBytes(types.GetRedelegationTimeKey(endTime)))
+}
+
+// Returns a concatenated list of all the timeslices inclusively previous to
+// currTime, and deletes the timeslices from the queue
+func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time.Time) (matureRedelegations []types.DDDTriplet) {
+	store := ctx.KVStore(k.storeKey)
+
+	// gets an iterator for all timeslices from time 0 until the current Blockheader time
+	redelegationTimesliceIterator := k.RedelegationQueueIterator(ctx, ctx.BlockHeader().Time)
+	defer redelegationTimesliceIterator.Close()
+
+	for ; redelegationTimesliceIterator.Valid(); redelegationTimesliceIterator.Next() {
+		timeslice := types.DDDTriplets{}
+		value := redelegationTimesliceIterator.Value()
+		k.cdc.MustUnmarshalBinaryBare(value, &timeslice)
+
+		matureRedelegations = append(matureRedelegations, timeslice.Triplets...)
+
+		store.Delete(redelegationTimesliceIterator.Key())
+	}
+
+	return matureRedelegations
+}
+
 // Delegate performs a delegation, set/update everything necessary within the store.
 // tokenSrc indicates the bond status of the incoming funds.
 func (k Keeper) Delegate(
@@ -429,15 +657,9 @@ func (k Keeper) Unbond(
 		return amount, err
 	}
 
-	isDefiOperator := delegatorAddress.Equals(defi.GetOperator())
-
-	// If the delegation is the operator of the defi and undelegating will decrease the defi's
-	// self-delegation below their minimum.
-	if isDefiOperator &&
-		defi.TokensFromShares(delegation.Shares).TruncateInt().LT(defi.MinSelfDelegation) {
-		k.bondedToUnbonding(ctx, defi)
-		defi = k.mustGetDefi(ctx, defi.GetOperator())
-	}
+	// NOTE: if the delegation is the operator of the defi and undelegating decreases the
+	// defi's self-delegation below their minimum, the defi is left out of the bonded set
+	// by ApplyAndReturnDefiSetUpdates at the end of the block.
 
 	// remove the delegation
 	if delegation.Shares.IsZero() {
@@ -571,6 +793,103 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, defiA
 	return balances, nil
 }
 
+// BeginRedelegation begins unbonding / redelegation and creates a
+// redelegation record. The rewards of the delegator are withdrawn from both
+// defis by the delegation hooks.
+func (k Keeper) BeginRedelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, defiSrcAddr, defiDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
+) (completionTime time.Time, err error) {
+	if bytes.Equal(defiSrcAddr, defiDstAddr) {
+		return time.Time{}, types.ErrSelfRedelegation
+	}
+
+	dstDefi, found := k.GetDefi(ctx, defiDstAddr)
+	if !found {
+		return time.Time{}, types.ErrBadRedelegationDst
+	}
+
+	srcDefi, found := k.GetDefi(ctx, defiSrcAddr)
+	if !found {
+		return time.Time{}, types.ErrBadRedelegationDst
+	}
+
+	// check if this is a transitive redelegation
+	if k.HasReceivingRedelegation(ctx, delAddr, defiSrcAddr) {
+		return time.Time{}, types.ErrTransitiveRedelegation
+	}
+
+	if k.HasMaxRedelegationEntries(ctx, delAddr, defiSrcAddr, defiDstAddr) {
+		return time.Time{}, types.ErrMaxRedelegationEntries
+	}
+
+	returnAmount, err := k.Unbond(ctx, delAddr, defiSrcAddr, sharesAmount)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if returnAmount.IsZero() {
+		return time.Time{}, types.ErrTinyRedelegationAmount
+	}
+
+	sharesCreated, err := k.Delegate(ctx, delAddr, returnAmount, srcDefi.GetStatus(), dstDefi, false)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// create the unbonding delegation
+	completionTime, height, completeNow := k.getBeginInfo(ctx, defiSrcAddr)
+
+	if completeNow { // no need to create the redelegation object
+		return completionTime, nil
+	}
+
+	red := k.SetRedelegationEntry(
+		ctx, delAddr, defiSrcAddr, defiDstAddr,
+		height, completionTime, returnAmount, sharesCreated,
+	)
+	k.InsertRedelegationQueue(ctx, red, completionTime)
+
+	return completionTime, nil
+}
+
+// CompleteRedelegation completes the redelegations of all mature entries in the
+// retrieved redelegation object and returns the total redelegation (initial)
+// balance or an error upon failure.
+func (k Keeper) CompleteRedelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, defiSrcAddr, defiDstAddr sdk.ValAddress,
+) (sdk.Coins, error) {
+	red, found := k.GetRedelegation(ctx, delAddr, defiSrcAddr, defiDstAddr)
+	if !found {
+		return nil, types.ErrNoRedelegation
+	}
+
+	bondDenom := k.GetParams(ctx).BondDenom
+	balances := sdk.NewCoins()
+	ctxTime := ctx.BlockHeader().Time
+
+	// loop through all the entries and complete mature redelegation entries
+	for i := 0; i < len(red.Entries); i++ {
+		entry := red.Entries[i]
+		if entry.IsMature(ctxTime) {
+			red.RemoveEntry(int64(i))
+			i--
+
+			if !entry.InitialBalance.IsZero() {
+				balances = balances.Add(sdk.NewCoin(bondDenom, entry.InitialBalance))
+			}
+		}
+	}
+
+	// set the redelegation or remove it if there are no more entries
+	if len(red.Entries) == 0 {
+		k.RemoveRedelegation(ctx, red)
+	} else {
+		k.SetRedelegation(ctx, red)
+	}
+
+	return balances, nil
+}
+
 // DefiUnbondAmount validates that a given unbond or redelegation amount is
 // valied based on upon the converted shares. If the amount is valid, the total
 // amount of respective shares is returned, otherwise an error is returned.
@@ -684,6 +1003,20 @@ func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, defi types.DefiI, de
 	endingHeight := uint64(ctx.BlockHeight())
 
 	if endingHeight > startingHeight {
+		k.IterateDefiSlashEventsBetween(ctx, del.GetDefiAddr(), startingHeight, endingHeight,
+			func(height uint64, event types.DefiSlashEvent) (stop bool) {
+				endingPeriod := event.DefiPeriod
+				if endingPeriod > startingPeriod {
+					rewards = rewards.Add(k.calculateDelegationRewardsBetween(ctx, defi, startingPeriod, endingPeriod, stake)...)
+
+					// Note: It is necessary to truncate so we don't allow withdrawing
+					// more rewards than owed.
+					stake = stake.MulTruncate(sdk.OneDec().Sub(event.Fraction))
+					startingPeriod = endingPeriod
+				}
+				return false
+			},
+		)
 	}
 
 	// A total stake sanity check; Recalculated final stake should be less than or
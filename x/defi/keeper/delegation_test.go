@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/defi/keeper"
+	"github.com/gauss/gauss/v4/x/defi/types"
+)
+
+func TestBeginRedelegation(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)})
+	msgServer := keeper.NewMsgServerImpl(app.DefiKeeper)
+	querier := keeper.Querier{Keeper: app.DefiKeeper}
+
+	params := app.DefiKeeper.GetParams(ctx)
+	params.MaxDefis = 3
+	app.DefiKeeper.SetParams(ctx, params)
+
+	power := sdk.TokensFromConsensusPower
+	bondDenom := app.DefiKeeper.BondDenom(ctx)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 4, power(100))
+	defiAddrs := simapp.ConvertAddrsToValAddrs(addrs[:3])
+	commission := types.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+	delAddr := addrs[3]
+
+	for _, defiAddr := range defiAddrs {
+		msg, err := types.NewMsgCreateDefi(defiAddr, sdk.NewCoin(bondDenom, power(10)),
+			types.NewDescription("moniker", "", "", "", ""), commission, power(5))
+		require.NoError(t, err)
+		_, err = msgServer.CreateDefi(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+	}
+	_, err := app.DefiKeeper.ApplyAndReturnDefiSetUpdates(ctx)
+	require.NoError(t, err)
+
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDefiDelegate(delAddr, defiAddrs[0], sdk.NewCoin(bondDenom, power(10))))
+	require.NoError(t, err)
+
+	redelegate := func(src, dst sdk.ValAddress, amount int64) (*types.MsgDefiBeginRedelegateResponse, error) {
+		msg := types.NewMsgDefiBeginRedelegate(delAddr, src, dst, sdk.NewCoin(bondDenom, power(amount)))
+		return msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), msg)
+	}
+
+	// the delegator can not redelegate to the same defi
+	_, err = redelegate(defiAddrs[0], defiAddrs[0], 4)
+	require.ErrorIs(t, err, types.ErrSelfRedelegation)
+
+	// the redelegated tokens are moved to the destination defi at once
+	res, err := redelegate(defiAddrs[0], defiAddrs[1], 4)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(params.UnbondingTime), res.CompletionTime)
+
+	delegation, found := app.DefiKeeper.GetDelegation(ctx, delAddr, defiAddrs[1])
+	require.True(t, found)
+	require.Equal(t, power(4).ToDec(), delegation.Shares)
+
+	red, found := app.DefiKeeper.GetRedelegation(ctx, delAddr, defiAddrs[0], defiAddrs[1])
+	require.True(t, found)
+	require.Len(t, red.Entries, 1)
+	require.Equal(t, power(4), red.Entries[0].InitialBalance)
+
+	msg, broken := keeper.ModuleAccountInvariants(app.DefiKeeper)(ctx)
+	require.False(t, broken, msg)
+
+	// the tokens received through a redelegation can not be redelegated again
+	_, err = redelegate(defiAddrs[1], defiAddrs[2], 2)
+	require.ErrorIs(t, err, types.ErrTransitiveRedelegation)
+
+	reds, err := querier.DefiRedelegations(sdk.WrapSDKContext(ctx), &types.QueryRedelegationsRequest{DelegatorAddress: delAddr.String()})
+	require.NoError(t, err)
+	require.Len(t, reds.RedelegationResponses, 1)
+	reds, err = querier.DefiRedelegations(sdk.WrapSDKContext(ctx), &types.QueryRedelegationsRequest{SrcDefiAddress: defiAddrs[0].String()})
+	require.NoError(t, err)
+	require.Len(t, reds.RedelegationResponses, 1)
+
+	gs := app.DefiKeeper.ExportGenesis(ctx)
+	require.Len(t, gs.Redelegations, 1)
+
+	// the redelegation is completed once mature
+	ctx = ctx.WithBlockTime(res.CompletionTime)
+	app.DefiKeeper.BlockDefiUpdates(ctx)
+	_, found = app.DefiKeeper.GetRedelegation(ctx, delAddr, defiAddrs[0], defiAddrs[1])
+	require.False(t, found)
+
+	_, err = redelegate(defiAddrs[1], defiAddrs[2], 2)
+	require.NoError(t, err)
+
+	msg, broken = keeper.ModuleAccountInvariants(app.DefiKeeper)(ctx)
+	require.False(t, broken, msg)
+}
@@ -31,11 +31,15 @@ func (k Keeper) InitGenesis(
 
 	k.SetFeePool(ctx, data.FeePool)
 	k.SetParams(ctx, data.Params)
+	k.SetDefiMinter(ctx, data.Minter)
 
 	// Defi-Delegation
 	for _, defi := range data.Defis {
 		k.SetDefi(ctx, defi)
 
+		// Manually set indices for the first time
+		k.SetDefiByPowerIndex(ctx, defi)
+
 		// Call the creation hook if not exported
 		if !data.Exported {
 			k.AfterDefiCreated(ctx, defi.GetOperator())
@@ -83,6 +87,14 @@ func (k Keeper) InitGenesis(
 		}
 	}
 
+	for _, red := range data.Redelegations {
+		k.SetRedelegation(ctx, red)
+
+		for _, entry := range red.Entries {
+			k.InsertRedelegationQueue(ctx, red, entry.CompletionTime)
+		}
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -116,6 +128,16 @@ func (k Keeper) InitGenesis(
 	}
 	// final
 	if data.Exported {
+		// the exported bonded defis are the set applied in the last block
+		lastTotalPower := sdk.ZeroInt()
+		for _, defi := range data.Defis {
+			if defi.IsBonded() {
+				k.SetLastDefiPower(ctx, defi.GetOperator(), defi.ConsensusPower())
+				lastTotalPower = lastTotalPower.Add(sdk.NewInt(defi.ConsensusPower()))
+			}
+		}
+
+		k.SetLastTotalPower(ctx, lastTotalPower)
 	}else{
 		var err error
 		res, err = k.ApplyAndReturnDefiSetUpdates(ctx)
@@ -184,6 +206,14 @@ func (k Keeper) InitGenesis(
 		k.SetDelegatorStartingInfo(ctx, defiAddr, delegatorAddress, del.StartingInfo)
 	}
 
+	for _, evt := range data.DefiSlashEvents {
+		defiAddr, err := sdk.ValAddressFromBech32(evt.DefiAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetDefiSlashEvent(ctx, defiAddr, evt.Height, evt.Period, evt.DefiSlashEvent)
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 	// check if the module account exists
@@ -214,6 +244,13 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
+	var redelegations []types.Redelegation
+
+	k.IterateRedelegations(ctx, func(_ int64, red types.Redelegation) (stop bool) {
+		redelegations = append(redelegations, red)
+		return false
+	})
+
 	// reward
 	feePool := k.GetFeePool(ctx)
 	params := k.GetParams(ctx)
@@ -284,18 +321,34 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
+	slashes := make([]types.DefiSlashEventRecord, 0)
+	k.IterateDefiSlashEvents(ctx,
+		func(val sdk.ValAddress, height uint64, event types.DefiSlashEvent) (stop bool) {
+			slashes = append(slashes, types.DefiSlashEventRecord{
+				DefiAddress:    val.String(),
+				Height:         height,
+				Period:         event.DefiPeriod,
+				DefiSlashEvent: event,
+			})
+			return false
+		},
+	)
+
 	return &types.GenesisState{
 		FeePool:		       feePool,
 		Params:                        params,
 		Defis:                         k.GetAllDefis(ctx),
 		Delegations:                   k.GetAllDelegations(ctx),
 		UnbondingDelegations:          unbondingDelegations,
+		Redelegations:                 redelegations,
 		DelegatorWithdrawInfos:        dwi,
 		OutstandingRewards:            outstanding,
 		DefiAccumulatedCommissions:    acc,
 		DefiHistoricalRewards:         his,
 		DefiCurrentRewards:            cur,
 		DelegatorStartingInfos:        dels,
+		DefiSlashEvents:               slashes,
+		Minter:                        k.GetDefiMinter(ctx),
 		Exported:                      true,
 	}
 
@@ -310,6 +363,9 @@ func ValidateGenesis(data *types.GenesisState) error {
 	if err := data.FeePool.ValidateGenesis(); err != nil {
 		return err
 	}
+	if err := types.ValidateDefiMinter(data.Minter); err != nil {
+		return err
+	}
 
 	return data.Params.Validate()
 }
@@ -317,6 +373,10 @@ func ValidateGenesis(data *types.GenesisState) error {
 func validateGenesisStateDefis(defis []types.Defi) error {
 	for i := 0; i < len(defis); i++ {
 		defi := defis[i]
+		if defi.IsBonded() && defi.IsJailed() {
+			return fmt.Errorf("defi is bonded and jailed in genesis state: moniker %v, address %v",
+				defi.Description.Moniker, defi.OperatorAddress)
+		}
 		if defi.DelegatorShares.IsZero() && !defi.IsUnbonding() {
 			return fmt.Errorf("bonded/unbonded genesis defi cannot have zero delegator shares, defi: %v", defi)
 		}
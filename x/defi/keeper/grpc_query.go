@@ -403,6 +403,37 @@ func (k Querier) DefiDelegatorUnbondingDelegations(c context.Context, req *types
 		UnbondingResponses: unbondingDelegations, Pagination: pageRes}, nil
 }
 
+// DefiRedelegations queries redelegations of given address
+func (k Querier) DefiRedelegations(c context.Context, req *types.QueryRedelegationsRequest) (*types.QueryRedelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var redels types.Redelegations
+	var pageRes *query.PageResponse
+	var err error
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	switch {
+	case req.DelegatorAddress != "" && req.SrcDefiAddress != "" && req.DstDefiAddress != "":
+		redels, err = queryRedelegation(ctx, k, req)
+	case req.DelegatorAddress == "" && req.SrcDefiAddress != "" && req.DstDefiAddress == "":
+		redels, pageRes, err = queryRedelegationsFromSrcDefi(store, k, req)
+	default:
+		redels, pageRes, err = queryAllRedelegations(store, k, req)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	redelResponses, err := RedelegationsToRedelegationResponses(ctx, k.Keeper, redels)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedelegationsResponse{RedelegationResponses: redelResponses, Pagination: pageRes}, nil
+}
+
 // HistoricalInfo queries the historical info for given height
 func (k Querier) DefiHistoricalInfo(c context.Context, req *types.QueryHistoricalInfoRequest) (*types.QueryHistoricalInfoResponse, error) {
 	if req == nil {
@@ -620,3 +651,85 @@ func (k Querier) DefiCommunityPool(c context.Context, req *types.QueryCommunityP
 
         return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// DefiInflation queries the provision minted by the next trade
+func (k Querier) DefiInflation(c context.Context, _ *types.QueryDefiInflationRequest) (*types.QueryDefiInflationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	provision, minter := k.Keeper.DefiInflation(ctx)
+
+	return &types.QueryDefiInflationResponse{
+		Provision:   provision,
+		BondedRatio: k.BondedRatio(ctx),
+		Supply:      k.DefiTokenSupply(ctx),
+		Minter:      minter,
+	}, nil
+}
+
+func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	srcDefiAddr, err := sdk.ValAddressFromBech32(req.SrcDefiAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	dstDefiAddr, err := sdk.ValAddressFromBech32(req.DstDefiAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	redel, found := k.GetRedelegation(ctx, delAddr, srcDefiAddr, dstDefiAddr)
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"redelegation not found for delegator address %s from defi address %s",
+			req.DelegatorAddress, req.SrcDefiAddress)
+	}
+	redels = []types.Redelegation{redel}
+
+	return redels, err
+}
+
+func queryRedelegationsFromSrcDefi(store sdk.KVStore, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, res *query.PageResponse, err error) {
+	defiAddr, err := sdk.ValAddressFromBech32(req.SrcDefiAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	srcDefiPrefix := types.GetREDsFromDefiSrcIndexKey(defiAddr)
+	redStore := prefix.NewStore(store, srcDefiPrefix)
+	res, err = query.Paginate(redStore, req.Pagination, func(key []byte, value []byte) error {
+		storeKey := types.GetREDKeyFromDefiSrcIndexKey(append(srcDefiPrefix, key...))
+		storeValue := store.Get(storeKey)
+		red, err := types.UnmarshalRED(k.cdc, storeValue)
+		if err != nil {
+			return err
+		}
+		redels = append(redels, red)
+		return nil
+	})
+
+	return redels, res, err
+}
+
+func queryAllRedelegations(store sdk.KVStore, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, res *query.PageResponse, err error) {
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	redStore := prefix.NewStore(store, types.GetREDsKey(delAddr))
+	res, err = query.Paginate(redStore, req.Pagination, func(key []byte, value []byte) error {
+		redelegation, err := types.UnmarshalRED(k.cdc, value)
+		if err != nil {
+			return err
+		}
+		redels = append(redels, redelegation)
+		return nil
+	})
+
+	return redels, res, err
+}
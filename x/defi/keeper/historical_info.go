@@ -90,7 +90,7 @@ func (k Keeper) TrackHistoricalInfo(ctx sdk.Context) {
 	}
 
 	// Create HistoricalInfo struct
-	defis := k.GetDefis(ctx, k.MaxDefis(ctx))
+	defis := k.GetLastDefis(ctx)
 	historicalEntry := types.NewHistoricalInfo(ctx.BlockHeader(), defis)
 
 	// Set latest HistoricalInfo at current height
@@ -70,3 +70,10 @@ func (k Keeper) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress,
 		k.hooks.AfterDelegationModified(ctx, delAddr, defiAddr)
 	}
 }
+
+// BeforeDefiSlashed - call hook if registered
+func (k Keeper) BeforeDefiSlashed(ctx sdk.Context, defiAddr sdk.ValAddress, fraction sdk.Dec) {
+	if k.hooks != nil {
+		k.hooks.BeforeDefiSlashed(ctx, defiAddr, fraction)
+	}
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/defi/types"
+)
+
+// get the defi minter, a store without one starts its first period with the
+// next provision
+func (k Keeper) GetDefiMinter(ctx sdk.Context) (minter types.DefiMinter) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.DefiMinterKey)
+	if b == nil {
+		return types.InitialDefiMinter()
+	}
+	k.cdc.MustUnmarshalBinaryBare(b, &minter)
+	return
+}
+
+// set the defi minter
+func (k Keeper) SetDefiMinter(ctx sdk.Context, minter types.DefiMinter) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryBare(&minter)
+	store.Set(types.DefiMinterKey, b)
+}
+
+// DefiInflation returns the coin minted by the next trade along with the
+// minter moved to the annual period of the current block
+func (k Keeper) DefiInflation(ctx sdk.Context) (sdk.Coin, types.DefiMinter) {
+	minter := k.GetDefiMinter(ctx).NextPeriod(ctx.BlockTime())
+	provision := minter.TransactionProvision(k.GetParams(ctx), k.BondedRatio(ctx), k.DefiTokenSupply(ctx))
+	return provision, minter
+}
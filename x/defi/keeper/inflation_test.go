@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/defi/keeper"
+	"github.com/gauss/gauss/v4/x/defi/types"
+)
+
+func TestDefiInflation(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)})
+	msgServer := keeper.NewMsgServerImpl(app.DefiKeeper)
+	querier := keeper.Querier{Keeper: app.DefiKeeper}
+
+	power := sdk.TokensFromConsensusPower
+	bondDenom := app.DefiKeeper.BondDenom(ctx)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, power(100))
+	defiAddr := sdk.ValAddress(addrs[0])
+	dec := func(percent int64) sdk.Dec { return sdk.NewDecWithPrec(percent, 2) }
+
+	err := app.TokenKeeper.IssueToken(ctx, "Bitcoin Network", "btc", "satoshi", 8, 1000, 10000, true, true, false, false, addrs[0])
+	require.NoError(t, err)
+
+	params := app.DefiKeeper.GetParams(ctx)
+	params.MintInflation = sdk.NewInt64Coin("satoshi", 1000)
+	params.AnnualProvisionCap = sdk.NewInt(2500)
+	app.DefiKeeper.SetParams(ctx, params)
+
+	msg, err := types.NewMsgCreateDefi(defiAddr, sdk.NewCoin(bondDenom, power(10)),
+		types.NewDescription("moniker", "", "", "", ""), types.NewCommissionRates(dec(10), dec(20), dec(5)), power(5))
+	require.NoError(t, err)
+	_, err = msgServer.CreateDefi(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	supply := func() sdk.Int { return app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf("satoshi") }
+	inflation := func() *types.QueryDefiInflationResponse {
+		res, err := querier.DefiInflation(sdk.WrapSDKContext(ctx), &types.QueryDefiInflationRequest{})
+		require.NoError(t, err)
+		return res
+	}
+	mint := func() { app.DefiKeeper.MintTokens(ctx, defiAddr, addrs[0].String(), sdk.ZeroDec(), false) }
+
+	// the first annual period starts with the first provision
+	res := inflation()
+	require.Equal(t, sdk.NewInt64Coin("satoshi", 1000), res.Provision)
+	require.Equal(t, supply(), res.Supply)
+	require.Equal(t, types.NewDefiMinter(0, ctx.BlockTime(), sdk.ZeroInt()), res.Minter)
+
+	// the provisions stop at the annual cap
+	initialSupply := supply()
+	mint()
+	mint()
+	require.Equal(t, sdk.NewInt64Coin("satoshi", 500), inflation().Provision)
+	mint()
+	require.Equal(t, initialSupply.AddRaw(2500), supply())
+	require.True(t, inflation().Provision.IsZero())
+	mint()
+	require.Equal(t, initialSupply.AddRaw(2500), supply())
+
+	minter := app.DefiKeeper.GetDefiMinter(ctx)
+	require.Equal(t, sdk.NewInt(2500), minter.PeriodProvisions)
+
+	// a new annual period resets the provisions and decays the inflation
+	params.InflationDecay = dec(10)
+	app.DefiKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.AnnualPeriod + time.Hour))
+
+	res = inflation()
+	require.Equal(t, sdk.NewInt64Coin("satoshi", 900), res.Provision)
+	require.Equal(t, types.NewDefiMinter(1, minter.PeriodStart.Add(types.AnnualPeriod), sdk.ZeroInt()), res.Minter)
+
+	// the provisions stop at the supply ceiling
+	params.MaxSupply = supply().AddRaw(1200)
+	app.DefiKeeper.SetParams(ctx, params)
+	mint()
+	require.Equal(t, sdk.NewInt64Coin("satoshi", 300), inflation().Provision)
+	mint()
+	require.Equal(t, params.MaxSupply, supply())
+	require.True(t, inflation().Provision.IsZero())
+
+	gs := app.DefiKeeper.ExportGenesis(ctx)
+	require.Equal(t, types.NewDefiMinter(1, minter.PeriodStart.Add(types.AnnualPeriod), sdk.NewInt(1200)), gs.Minter)
+	require.NoError(t, keeper.ValidateGenesis(gs))
+
+	// the provision is scaled towards the goal bonded ratio
+	params = types.DefaultParams()
+	params.MintInflation = sdk.NewInt64Coin("satoshi", 1000)
+	params.GoalBonded = dec(50)
+	minter = types.InitialDefiMinter()
+	require.Equal(t, sdk.NewInt64Coin("satoshi", 1500), minter.TransactionProvision(params, dec(25), sdk.ZeroInt()))
+	require.Equal(t, sdk.NewInt64Coin("satoshi", 1000), minter.TransactionProvision(params, dec(50), sdk.ZeroInt()))
+	require.Equal(t, sdk.NewInt64Coin("satoshi", 500), minter.TransactionProvision(params, dec(75), sdk.ZeroInt()))
+	require.True(t, minter.TransactionProvision(params, dec(100), sdk.ZeroInt()).IsZero())
+}
@@ -252,16 +252,22 @@ func ReferenceCountInvariant(k Keeper) sdk.Invariant {
 			return false
 		})
 		dels := k.GetAllSDKDelegations(ctx)
+		slashCount := uint64(0)
+		k.IterateDefiSlashEvents(ctx,
+			func(_ sdk.ValAddress, _ uint64, _ types.DefiSlashEvent) (stop bool) {
+				slashCount++
+				return false
+			})
 
 		// one record per defi (last tracked period), one record per
 		// delegation (previous period), one record per slash (previous period)
-		expected := defiCount + uint64(len(dels))
+		expected := defiCount + uint64(len(dels)) + slashCount
 		count := k.GetDefiHistoricalReferenceCount(ctx)
 		broken := count != expected
 
 		return sdk.FormatInvariant(types.ModuleName, "reference count",
-			fmt.Sprintf("expected historical reference count: %d = %v defis + %v delegations \n"+
+			fmt.Sprintf("expected historical reference count: %d = %v defis + %v delegations + %v slashes\n"+
 				"total defi historical reference count: %d\n",
-				expected, defiCount, len(dels), count)), broken
+				expected, defiCount, len(dels), slashCount, count)), broken
 	}
 }
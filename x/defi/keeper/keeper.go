@@ -83,6 +83,28 @@ func (k *Keeper) SetHooks(sh types.DefiHooks) *Keeper {
 	return k
 }
 
+// Load the last total defi power.
+func (k Keeper) GetLastTotalPower(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTotalPowerKey)
+
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &ip)
+
+	return ip.Int
+}
+
+// Set the last total defi power.
+func (k Keeper) SetLastTotalPower(ctx sdk.Context, power sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&sdk.IntProto{Int: power})
+	store.Set(types.LastTotalPowerKey, bz)
+}
+
 // SetWithdrawAddr sets a new address that will receive the rewards upon withdrawal
 func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, withdrawAddr sdk.AccAddress) error {
 	if k.blockedAddrs[withdrawAddr.String()] {
@@ -56,6 +56,20 @@ func (k msgServer) CreateDefi(goCtx context.Context, msg *types.MsgCreateDefi) (
 		return nil, err
 	}
 
+	if msg.Commission.MaxRate.GT(k.MaxCommissionRate(ctx)) {
+		return nil, types.ErrCommissionGTMaxCommissionRate
+	}
+
+	commission := types.NewCommissionWithTime(
+		msg.Commission.Rate, msg.Commission.MaxRate,
+		msg.Commission.MaxChangeRate, ctx.BlockHeader().Time,
+	)
+
+	defi, err = defi.SetInitialCommission(commission)
+	if err != nil {
+		return nil, err
+	}
+
 	defi.MinSelfDelegation = msg.MinSelfDelegation
 
 	k.SetDefi(ctx, defi)
@@ -107,6 +121,18 @@ func (k msgServer) EditDefi(goCtx context.Context, msg *types.MsgEditDefi) (*typ
 
 	defi.Description = description
 
+	if msg.CommissionRate != nil {
+		commission, err := k.UpdateDefiCommission(ctx, defi, *msg.CommissionRate)
+		if err != nil {
+			return nil, err
+		}
+
+		// call the before-modification hook since we're about to update the commission
+		k.BeforeDefiModified(ctx, defiAddr)
+
+		defi.Commission = commission
+	}
+
 	if msg.MinSelfDelegation != nil {
 		if !msg.MinSelfDelegation.GT(defi.MinSelfDelegation) {
 			return nil, types.ErrMinSelfDelegationDecreased
@@ -124,6 +150,7 @@ func (k msgServer) EditDefi(goCtx context.Context, msg *types.MsgEditDefi) (*typ
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeEditDefi,
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, defi.Commission.String()),
 			sdk.NewAttribute(types.AttributeKeyMinSelfDelegation, defi.MinSelfDelegation.String()),
 		),
 		sdk.NewEvent(
@@ -192,6 +219,71 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDefiDelegate) (
 	return &types.MsgDefiDelegateResponse{}, nil
 }
 
+func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgDefiBeginRedelegate) (*types.MsgDefiBeginRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	defiSrcAddress, err := sdk.ValAddressFromBech32(msg.DefiSrcAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := k.DefiUnbondAmount(
+		ctx, delegatorAddress, defiSrcAddress, msg.Amount.Amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.Wrapf(types.ErrBadDenom, "got %s, expected %s", msg.Amount.Denom, bondDenom)
+	}
+
+	defiDstAddress, err := sdk.ValAddressFromBech32(msg.DefiDstAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime, err := k.BeginRedelegation(
+		ctx, delegatorAddress, defiSrcAddress, defiDstAddress, shares,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Amount.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, types.ModuleName, "redelegate")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", msg.Type()},
+				float32(msg.Amount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", msg.Amount.Denom)},
+			)
+		}()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedelegate,
+			sdk.NewAttribute(types.AttributeKeySrcDefi, msg.DefiSrcAddress),
+			sdk.NewAttribute(types.AttributeKeyDstDefi, msg.DefiDstAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgDefiBeginRedelegateResponse{
+		CompletionTime: completionTime,
+	}, nil
+}
+
 func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgDefiUndelegate) (*types.MsgDefiUndelegateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -371,3 +463,58 @@ func (k msgServer) FundCommunityPool(goCtx context.Context, msg *types.MsgFundDe
 
 	return &types.MsgFundDefiCommunityPoolResponse{}, nil
 }
+
+func (k msgServer) SlashDefi(goCtx context.Context, msg *types.MsgSlashDefi) (*types.MsgSlashDefiResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorized := false
+	for _, handler := range k.EvidenceHandlers(ctx) {
+		if handler == msg.Authority {
+			authorized = true
+			break
+		}
+	}
+	if !authorized {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedSlash, msg.Authority)
+	}
+
+	defiAddr, err := sdk.ValAddressFromBech32(msg.DefiAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.slashDefi(ctx, defiAddr, msg.InfractionHeight, msg.SlashFraction, msg.Jail); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgSlashDefiResponse{}, nil
+}
+
+func (k msgServer) UnjailDefi(goCtx context.Context, msg *types.MsgUnjailDefi) (*types.MsgUnjailDefiResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	defiAddr, err := sdk.ValAddressFromBech32(msg.DefiAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Unjail(ctx, defiAddr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DefiAddress),
+		),
+	)
+
+	return &types.MsgUnjailDefiResponse{}, nil
+}
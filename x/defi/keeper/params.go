@@ -19,9 +19,9 @@ func (k Keeper) CommunityTax(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
-// CommisssionRate
-func (k Keeper) CommissionRate(ctx sdk.Context) (res sdk.Dec) {
-	k.paramstore.Get(ctx, types.KeyCommissionRate, &res)
+// MaxCommissionRate - the ceiling of the defi commission rates
+func (k Keeper) MaxCommissionRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMaxCommissionRate, &res)
 	return
 }
 
@@ -57,6 +57,42 @@ func (k Keeper) HistoricalEntries(ctx sdk.Context) (res uint32) {
 	return
 }
 
+// JailDuration - min time a slashed defi stays jailed
+func (k Keeper) JailDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyJailDuration, &res)
+	return
+}
+
+// EvidenceHandlers - addresses allowed to slash the defis besides governance
+func (k Keeper) EvidenceHandlers(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyEvidenceHandlers, &res)
+	return
+}
+
+// AnnualProvisionCap - max amount minted per annual period, zero for no cap
+func (k Keeper) AnnualProvisionCap(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyAnnualProvisionCap, &res)
+	return
+}
+
+// MaxSupply - total supply ceiling of the defi bond denom, zero for no ceiling
+func (k Keeper) MaxSupply(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyMaxSupply, &res)
+	return
+}
+
+// InflationDecay - decay of the mint inflation per annual period
+func (k Keeper) InflationDecay(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyInflationDecay, &res)
+	return
+}
+
+// GoalBonded - target bonded ratio the provision is adjusted towards
+func (k Keeper) GoalBonded(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyGoalBonded, &res)
+	return
+}
+
 // BondDenom - Bondable coin denomination
 func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	k.paramstore.Get(ctx, types.KeyBondDenom, &res)
@@ -77,7 +113,7 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 		k.BondDenom(ctx),
 		k.MintInflation(ctx),
 		k.CommunityTax(ctx),
-		k.CommissionRate(ctx),
+		k.MaxCommissionRate(ctx),
 		k.UnbondingTime(ctx),
 		k.MaxDefis(ctx),
 		k.MaxEntries(ctx),
@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/x/defi/types"
+)
+
+// HandleSlashDefiProposal is a handler for executing a passed slash defi proposal
+func (k Keeper) HandleSlashDefiProposal(ctx sdk.Context, p *types.SlashDefiProposal) error {
+	defiAddr, err := sdk.ValAddressFromBech32(p.DefiAddress)
+	if err != nil {
+		return err
+	}
+
+	return k.slashDefi(ctx, defiAddr, p.InfractionHeight, p.SlashFraction, p.Jail)
+}
@@ -43,3 +43,49 @@ func DelegationsToDelegationResponses(
 
 	return resp, nil
 }
+
+func RedelegationsToRedelegationResponses(
+	ctx sdk.Context, k Keeper, redels types.Redelegations,
+) (types.RedelegationResponses, error) {
+	resp := make(types.RedelegationResponses, len(redels))
+
+	for i, redel := range redels {
+		defiSrcAddr, err := sdk.ValAddressFromBech32(redel.DefiSrcAddress)
+		if err != nil {
+			panic(err)
+		}
+		defiDstAddr, err := sdk.ValAddressFromBech32(redel.DefiDstAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		delegatorAddress, err := sdk.AccAddressFromBech32(redel.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		defi, found := k.GetDefi(ctx, defiDstAddr)
+		if !found {
+			return nil, types.ErrNoDefiFound
+		}
+
+		entryResponses := make([]types.RedelegationEntryResponse, len(redel.Entries))
+		for j, entry := range redel.Entries {
+			entryResponses[j] = types.NewRedelegationEntryResponse(
+				entry.CreationHeight,
+				entry.CompletionTime,
+				entry.SharesDst,
+				entry.InitialBalance,
+				defi.TokensFromShares(entry.SharesDst).TruncateInt(),
+			)
+		}
+
+		resp[i] = types.NewRedelegationResponse(
+			delegatorAddress,
+			defiSrcAddr,
+			defiDstAddr,
+			entryResponses,
+		)
+	}
+
+	return resp, nil
+}
@@ -50,13 +50,16 @@ func (k Keeper) MintTokens(
 func (k Keeper) mintTokens(
 	ctx sdk.Context, defiAddr sdk.ValAddress,
 ) sdk.DecCoins {
-	mintedCoin := k.transactionProvision(ctx)
+	mintedCoin, minter := k.DefiInflation(ctx)
 
 	if !mintedCoin.IsZero() {
 		if err := k.mintCoin(ctx, mintedCoin) ; err != nil {
 			return sdk.NewDecCoins(sdk.NewDecCoin(mintedCoin.Denom, sdk.NewInt(0)))
 		}
 
+		minter.PeriodProvisions = minter.PeriodProvisions.Add(mintedCoin.Amount)
+		k.SetDefiMinter(ctx, minter)
+
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeMint,
@@ -104,8 +107,10 @@ func (k Keeper) allocateTokensToMarket(ctx sdk.Context, marketAddrString string,
 
 // allocateTokensToDefi allocate tokens to a particular defi, splitting according to commission
 func (k Keeper) allocateTokensToDefi(ctx sdk.Context, defi types.DefiI, tokens sdk.DecCoins) {
-	// split tokens between defi and delegators according to commission
-	commission := tokens.MulDec(k.CommissionRate(ctx))
+	// split tokens between defi and delegators according to commission, a
+	// lowered max commission rate param applies to the defis at once
+	commissionRate := sdk.MinDec(defi.GetCommission(), k.MaxCommissionRate(ctx))
+	commission := tokens.MulDec(commissionRate)
 	shared := tokens.Sub(commission)
 
 	// update current commission
@@ -138,14 +143,6 @@ func (k Keeper) allocateTokensToDefi(ctx sdk.Context, defi types.DefiI, tokens s
 	k.SetDefiOutstandingRewards(ctx, defi.GetOperator(), outstanding)
 }
 
-func (k Keeper) transactionProvision(ctx sdk.Context) sdk.Coin {
-	// if k.DefiTokenSupply(ctx).GT(defiTotalSupply){
-	//	return sdk.NewCoin(k.DefiBondDenom(ctx), sdk.NewInt(0))
-	//}
-	
-	return k.MintInflation(ctx)
-}
-
 // MintCoins implements an alias call to the underlying supply keeper's
 // MintCoins to be used in per Transaction.
 func (k Keeper) mintCoin(ctx sdk.Context, newCoin sdk.Coin) error {
@@ -62,6 +62,9 @@ func (h Hooks) AfterDefiRemoved(ctx sdk.Context, defiAddr sdk.ValAddress) {
 	// remove commission record
 	h.k.DeleteDefiAccumulatedCommission(ctx, defiAddr)
 
+	// clear slashes
+	h.k.DeleteDefiSlashEvents(ctx, defiAddr)
+
 	// clear historical rewards
 	h.k.DeleteDefiHistoricalRewards(ctx, defiAddr)
 
@@ -85,6 +88,11 @@ func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAd
 	}
 }
 
+// record the slash event
+func (h Hooks) BeforeDefiSlashed(ctx sdk.Context, defiAddr sdk.ValAddress, fraction sdk.Dec) {
+	h.k.updateDefiSlashFraction(ctx, defiAddr, fraction)
+}
+
 // create new delegation period record
 func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, defiAddr sdk.ValAddress) {
 	h.k.initializeDelegation(ctx, defiAddr, delAddr)
@@ -287,3 +287,75 @@ func (k Keeper) IterateDefiOutstandingRewards(ctx sdk.Context, handler func(val
 		}
 	}
 }
+
+// get slash event for height
+func (k Keeper) GetDefiSlashEvent(ctx sdk.Context, val sdk.ValAddress, height, period uint64) (event types.DefiSlashEvent, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetDefiSlashEventKey(val, height, period))
+	if b == nil {
+		return types.DefiSlashEvent{}, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(b, &event)
+	return event, true
+}
+
+// set slash event for height
+func (k Keeper) SetDefiSlashEvent(ctx sdk.Context, val sdk.ValAddress, height, period uint64, event types.DefiSlashEvent) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryBare(&event)
+	store.Set(types.GetDefiSlashEventKey(val, height, period), b)
+}
+
+// iterate over slash events between heights, inclusive
+func (k Keeper) IterateDefiSlashEventsBetween(ctx sdk.Context, val sdk.ValAddress, startingHeight uint64, endingHeight uint64,
+	handler func(height uint64, event types.DefiSlashEvent) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(
+		types.GetDefiSlashEventKeyPrefix(val, startingHeight),
+		types.GetDefiSlashEventKeyPrefix(val, endingHeight+1),
+	)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var event types.DefiSlashEvent
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &event)
+		_, height := types.GetDefiSlashEventAddressHeight(iter.Key())
+		if handler(height, event) {
+			break
+		}
+	}
+}
+
+// iterate over all slash events
+func (k Keeper) IterateDefiSlashEvents(ctx sdk.Context, handler func(val sdk.ValAddress, height uint64, event types.DefiSlashEvent) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DefiSlashEventPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var event types.DefiSlashEvent
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &event)
+		val, height := types.GetDefiSlashEventAddressHeight(iter.Key())
+		if handler(val, height, event) {
+			break
+		}
+	}
+}
+
+// delete slash events for a particular defi
+func (k Keeper) DeleteDefiSlashEvents(ctx sdk.Context, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDefiSlashEventPrefix(val))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
+
+// delete all slash events
+func (k Keeper) DeleteAllDefiSlashEvents(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DefiSlashEventPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
@@ -0,0 +1,382 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gauss/gauss/v4/x/defi/types"
+)
+
+// Slash a defi for an infraction committed at a known height
+// Burns slashFactor of the defi's stake at the infraction height: first from
+// every unbonding delegation and redelegation created from the defi at or
+// after the infraction height, then the rest from the defi's current tokens.
+// The slash is recorded in the F1 rewards periods so delegators are paid
+// according to their pre-slash stake.
+// Returns the amount of tokens burned.
+func (k Keeper) Slash(ctx sdk.Context, defiAddr sdk.ValAddress, infractionHeight int64, slashFactor sdk.Dec) (sdk.Int, error) {
+	logger := k.Logger(ctx)
+
+	if slashFactor.IsNegative() || slashFactor.GT(sdk.OneDec()) {
+		return sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrInvalidSlashFraction, "%s", slashFactor)
+	}
+
+	defi, found := k.GetDefi(ctx, defiAddr)
+	if !found {
+		return sdk.ZeroInt(), types.ErrNoDefiFound
+	}
+
+	// should not be slashing at future heights
+	if infractionHeight > ctx.BlockHeight() {
+		return sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrFutureInfraction,
+			"infraction height %d, current height %d", infractionHeight, ctx.BlockHeight())
+	}
+
+	// call the before-modification hook
+	k.BeforeDefiModified(ctx, defiAddr)
+
+	// amount of slashing = slash slashFactor * stake at time of infraction
+	slashAmount := slashFactor.MulInt(k.stakeAtInfraction(ctx, defi, infractionHeight)).TruncateInt()
+	burnedFromEntries := sdk.ZeroInt()
+
+	// entries created at the current height hold tokens that were bonded
+	// through the infraction only when it is in the past
+	if infractionHeight < ctx.BlockHeight() {
+		// Iterate through unbonding delegations from slashed defi
+		unbondingDelegations := k.GetUnbondingDelegationsFromDefi(ctx, defiAddr)
+		for _, unbondingDelegation := range unbondingDelegations {
+			amountSlashed := k.SlashUnbondingDelegation(ctx, unbondingDelegation, infractionHeight, slashFactor)
+			burnedFromEntries = burnedFromEntries.Add(amountSlashed)
+		}
+
+		// Iterate through redelegations from slashed source defi
+		redelegations := k.GetRedelegationsFromSrcDefi(ctx, defiAddr)
+		for _, redelegation := range redelegations {
+			amountSlashed := k.SlashRedelegation(ctx, redelegation, infractionHeight, slashFactor)
+			burnedFromEntries = burnedFromEntries.Add(amountSlashed)
+		}
+
+		// redelegation slashing may have unbonded shares of this defi
+		defi = k.mustGetDefi(ctx, defiAddr)
+	}
+
+	// the entries already paid part of the slash, the rest is burned from
+	// the defi, which cannot decrease its balance below zero
+	tokensToBurn := sdk.MinInt(slashAmount.Sub(burnedFromEntries), defi.Tokens)
+	tokensToBurn = sdk.MaxInt(tokensToBurn, sdk.ZeroInt()) // defensive.
+
+	if defi.Tokens.IsPositive() {
+		// we need to calculate the *effective* slash fraction for the F1
+		// periods, since the burn is truncated
+		effectiveFraction := tokensToBurn.ToDec().QuoRoundUp(defi.Tokens.ToDec())
+		// possible if tokens are slashed from entries which are
+		// redelegated into this defi
+		if effectiveFraction.GT(sdk.OneDec()) {
+			effectiveFraction = sdk.OneDec()
+		}
+
+		// call the before-slashed hook
+		k.BeforeDefiSlashed(ctx, defiAddr, effectiveFraction)
+	}
+
+	// Deduct from defi's bonded tokens and update the defi.
+	// Burn the slashed tokens from the pool account and decrease the total supply.
+	defi = k.RemoveDefiTokens(ctx, defi, tokensToBurn)
+
+	switch defi.GetStatus() {
+	case types.Bonded:
+		if err := k.burnBondedTokens(ctx, tokensToBurn); err != nil {
+			return sdk.ZeroInt(), err
+		}
+	case types.Unbonding, types.Unbonded:
+		if err := k.burnNotBondedTokens(ctx, tokensToBurn); err != nil {
+			return sdk.ZeroInt(), err
+		}
+	default:
+		panic("invalid defi status")
+	}
+
+	burned := tokensToBurn.Add(burnedFromEntries)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyDefi, defiAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, slashFactor.String()),
+			sdk.NewAttribute(types.AttributeKeyInfractionHeight, fmt.Sprintf("%d", infractionHeight)),
+			sdk.NewAttribute(types.AttributeKeyBurnedTokens, burned.String()),
+		),
+	)
+
+	logger.Info(
+		"defi slashed by slash factor",
+		"defi", defi.GetOperator().String(),
+		"slash_factor", slashFactor.String(),
+		"burned", burned,
+	)
+
+	return burned, nil
+}
+
+// stakeAtInfraction returns the tokens the defi held at the infraction
+// height, read from the historical info of that height when the defi was
+// bonded then, or rebuilt from its current tokens and the tokens which left
+// it since through unbonding delegations and redelegations
+func (k Keeper) stakeAtInfraction(ctx sdk.Context, defi types.Defi, infractionHeight int64) sdk.Int {
+	if infractionHeight == ctx.BlockHeight() {
+		return defi.Tokens
+	}
+
+	if hi, found := k.GetHistoricalInfo(ctx, infractionHeight); found {
+		for _, histDefi := range hi.Defiset {
+			if histDefi.OperatorAddress == defi.OperatorAddress {
+				return histDefi.Tokens
+			}
+		}
+	}
+
+	stake := defi.Tokens
+	for _, ubd := range k.GetUnbondingDelegationsFromDefi(ctx, defi.GetOperator()) {
+		for _, entry := range ubd.Entries {
+			if entry.CreationHeight >= infractionHeight {
+				stake = stake.Add(entry.InitialBalance)
+			}
+		}
+	}
+	for _, red := range k.GetRedelegationsFromSrcDefi(ctx, defi.GetOperator()) {
+		for _, entry := range red.Entries {
+			if entry.CreationHeight >= infractionHeight {
+				stake = stake.Add(entry.InitialBalance)
+			}
+		}
+	}
+
+	return stake
+}
+
+// SlashUnbondingDelegation slashes an unbonding delegation and updates the
+// pool. Entries created before the infraction height or already mature are
+// left untouched, they did not contribute stake to the infraction.
+// Returns the amount of tokens burned.
+func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation types.UnbondingDelegation,
+	infractionHeight int64, slashFactor sdk.Dec) sdk.Int {
+	now := ctx.BlockHeader().Time
+	burnedAmount := sdk.ZeroInt()
+
+	// perform slashing on all entries within the unbonding delegation
+	for i, entry := range unbondingDelegation.Entries {
+		// If unbonding started before this height, stake didn't contribute to infraction
+		if entry.CreationHeight < infractionHeight {
+			continue
+		}
+
+		if entry.IsMature(now) {
+			// Unbonding delegation no longer eligible for slashing, skip it
+			continue
+		}
+
+		// Calculate slash amount proportional to stake contributing to infraction
+		slashAmountDec := slashFactor.MulInt(entry.InitialBalance)
+		slashAmount := slashAmountDec.TruncateInt()
+
+		// Don't slash more tokens than held
+		// Possible since the unbonding delegation may already
+		// have been slashed, and slash amounts are calculated
+		// according to stake held at time of infraction
+		unbondingSlashAmount := sdk.MinInt(slashAmount, entry.Balance)
+
+		// Update unbonding delegation if necessary
+		if unbondingSlashAmount.IsZero() {
+			continue
+		}
+
+		burnedAmount = burnedAmount.Add(unbondingSlashAmount)
+		entry.Balance = entry.Balance.Sub(unbondingSlashAmount)
+		unbondingDelegation.Entries[i] = entry
+		k.SetUnbondingDelegation(ctx, unbondingDelegation)
+	}
+
+	if err := k.burnNotBondedTokens(ctx, burnedAmount); err != nil {
+		panic(err)
+	}
+
+	return burnedAmount
+}
+
+// SlashRedelegation slashes a redelegation by unbonding the destination
+// shares created by entries at or after the infraction height and burning
+// the resulting tokens from the pool the destination defi holds them in.
+// Returns the amount of tokens burned.
+func (k Keeper) SlashRedelegation(ctx sdk.Context, redelegation types.Redelegation,
+	infractionHeight int64, slashFactor sdk.Dec) sdk.Int {
+	now := ctx.BlockHeader().Time
+	bondedBurnedAmount, notBondedBurnedAmount := sdk.ZeroInt(), sdk.ZeroInt()
+
+	// perform slashing on all entries within the redelegation
+	for _, entry := range redelegation.Entries {
+		// If redelegation started before this height, stake didn't contribute to infraction
+		if entry.CreationHeight < infractionHeight {
+			continue
+		}
+
+		if entry.IsMature(now) {
+			// Redelegation no longer eligible for slashing, skip it
+			continue
+		}
+
+		// Unbond from target defi
+		sharesToUnbond := slashFactor.Mul(entry.SharesDst)
+		if sharesToUnbond.IsZero() {
+			continue
+		}
+
+		defiDstAddress, err := sdk.ValAddressFromBech32(redelegation.DefiDstAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		delegatorAddress, err := sdk.AccAddressFromBech32(redelegation.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		delegation, found := k.GetDelegation(ctx, delegatorAddress, defiDstAddress)
+		if !found {
+			// If deleted, delegation has zero shares, and we can't unbond any more
+			continue
+		}
+
+		if sharesToUnbond.GT(delegation.Shares) {
+			sharesToUnbond = delegation.Shares
+		}
+
+		tokensToBurn, err := k.Unbond(ctx, delegatorAddress, defiDstAddress, sharesToUnbond)
+		if err != nil {
+			panic(fmt.Errorf("error unbonding delegator: %v", err))
+		}
+
+		dstDefi, found := k.GetDefi(ctx, defiDstAddress)
+		if !found {
+			// the destination defi was removed once its last shares were
+			// unbonded, so the tokens left the not bonded pool
+			notBondedBurnedAmount = notBondedBurnedAmount.Add(tokensToBurn)
+			continue
+		}
+
+		// tokens of a redelegation currently live in the destination defi pool
+		switch {
+		case dstDefi.IsBonded():
+			bondedBurnedAmount = bondedBurnedAmount.Add(tokensToBurn)
+		case dstDefi.IsUnbonded() || dstDefi.IsUnbonding():
+			notBondedBurnedAmount = notBondedBurnedAmount.Add(tokensToBurn)
+		default:
+			panic("unknown defi status")
+		}
+	}
+
+	if err := k.burnBondedTokens(ctx, bondedBurnedAmount); err != nil {
+		panic(err)
+	}
+
+	if err := k.burnNotBondedTokens(ctx, notBondedBurnedAmount); err != nil {
+		panic(err)
+	}
+
+	return bondedBurnedAmount.Add(notBondedBurnedAmount)
+}
+
+// slashDefi slashes a defi and optionally jails it, shared by the evidence
+// handler message and the governance proposal
+func (k Keeper) slashDefi(ctx sdk.Context, defiAddr sdk.ValAddress, infractionHeight int64, slashFactor sdk.Dec, jail bool) error {
+	if _, err := k.Slash(ctx, defiAddr, infractionHeight, slashFactor); err != nil {
+		return err
+	}
+
+	if jail {
+		return k.Jail(ctx, defiAddr)
+	}
+
+	return nil
+}
+
+// Jail a defi until the block time plus the jail duration
+func (k Keeper) Jail(ctx sdk.Context, defiAddr sdk.ValAddress) error {
+	defi, found := k.GetDefi(ctx, defiAddr)
+	if !found {
+		return types.ErrNoDefiFound
+	}
+
+	k.jailDefi(ctx, defi, ctx.BlockHeader().Time.Add(k.JailDuration(ctx)))
+	k.Logger(ctx).Info("defi jailed", "defi", defiAddr.String())
+
+	return nil
+}
+
+// Unjail a defi once its jail period has passed and its operator holds the
+// minimum self delegation again
+func (k Keeper) Unjail(ctx sdk.Context, defiAddr sdk.ValAddress) error {
+	defi, found := k.GetDefi(ctx, defiAddr)
+	if !found {
+		return types.ErrNoDefiFound
+	}
+
+	if !defi.IsJailed() {
+		return types.ErrDefiNotJailed
+	}
+
+	if !k.hasMinSelfDelegation(ctx, defi) {
+		return types.ErrSelfDelegationBelowMinimum
+	}
+
+	if ctx.BlockHeader().Time.Before(defi.JailedUntil) {
+		return sdkerrors.Wrapf(types.ErrDefiJailed, "jailed until %s", defi.JailedUntil)
+	}
+
+	k.unjailDefi(ctx, defi)
+	k.Logger(ctx).Info("defi unjailed", "defi", defiAddr.String())
+
+	return nil
+}
+
+// jail a defi, taking it out of the power index so it leaves the bonded set
+// at the end of the block
+func (k Keeper) jailDefi(ctx sdk.Context, defi types.Defi, until time.Time) {
+	if !defi.Jailed {
+		k.DeleteDefiByPowerIndex(ctx, defi)
+		defi.Jailed = true
+	}
+
+	// a jail never shortens an earlier one
+	if until.After(defi.JailedUntil) {
+		defi.JailedUntil = until
+	}
+	k.SetDefi(ctx, defi)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJail,
+			sdk.NewAttribute(types.AttributeKeyDefi, defi.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyJailedUntil, defi.JailedUntil.Format(time.RFC3339)),
+		),
+	)
+}
+
+// unjail a defi, putting it back in the power index
+func (k Keeper) unjailDefi(ctx sdk.Context, defi types.Defi) {
+	if !defi.Jailed {
+		panic(fmt.Sprintf("cannot unjail already unjailed defi, defi: %v\n", defi))
+	}
+
+	defi.Jailed = false
+	k.SetDefi(ctx, defi)
+	k.SetDefiByPowerIndex(ctx, defi)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnjail,
+			sdk.NewAttribute(types.AttributeKeyDefi, defi.OperatorAddress),
+		),
+	)
+}
@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/defi/keeper"
+	"github.com/gauss/gauss/v4/x/defi/types"
+)
+
+func TestSlashDefi(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)})
+	msgServer := keeper.NewMsgServerImpl(app.DefiKeeper)
+
+	power := sdk.TokensFromConsensusPower
+	bondDenom := app.DefiKeeper.BondDenom(ctx)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, power(100))
+	defiAddr := sdk.ValAddress(addrs[0])
+	delAddr, handlerAddr := addrs[1], addrs[2]
+
+	params := app.DefiKeeper.GetParams(ctx)
+	params.JailDuration = time.Hour
+	params.EvidenceHandlers = []string{handlerAddr.String()}
+	app.DefiKeeper.SetParams(ctx, params)
+
+	commission := types.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+	msg, err := types.NewMsgCreateDefi(defiAddr, sdk.NewCoin(bondDenom, power(10)),
+		types.NewDescription("moniker", "", "", "", ""), commission, power(5))
+	require.NoError(t, err)
+	_, err = msgServer.CreateDefi(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	_, err = app.DefiKeeper.ApplyAndReturnDefiSetUpdates(ctx)
+	require.NoError(t, err)
+
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDefiDelegate(delAddr, defiAddr, sdk.NewCoin(bondDenom, power(10))))
+	require.NoError(t, err)
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgDefiUndelegate(delAddr, defiAddr, sdk.NewCoin(bondDenom, power(4))))
+	require.NoError(t, err)
+
+	getDefi := func() types.Defi {
+		defi, found := app.DefiKeeper.GetDefi(ctx, defiAddr)
+		require.True(t, found)
+		return defi
+	}
+	requireInvariants := func() {
+		msg, broken := keeper.ModuleAccountInvariants(app.DefiKeeper)(ctx)
+		require.False(t, broken, msg)
+	}
+	slash := func(authority sdk.AccAddress, infractionHeight int64, fraction sdk.Dec, jail bool) error {
+		_, err := msgServer.SlashDefi(sdk.WrapSDKContext(ctx), types.NewMsgSlashDefi(authority, defiAddr, infractionHeight, fraction, jail))
+		return err
+	}
+
+	ctx = ctx.WithBlockHeight(12)
+
+	// only the registered evidence handlers can slash
+	err = slash(delAddr, 5, sdk.NewDecWithPrec(1, 1), true)
+	require.ErrorIs(t, err, types.ErrUnauthorizedSlash)
+	err = slash(handlerAddr, 13, sdk.NewDecWithPrec(1, 1), true)
+	require.ErrorIs(t, err, types.ErrFutureInfraction)
+
+	// the bonded tokens and the unbonding entries created since the
+	// infraction are slashed and the defi is jailed
+	supply := app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(bondDenom)
+	require.NoError(t, slash(handlerAddr, 5, sdk.NewDecWithPrec(1, 1), true))
+
+	defi := getDefi()
+	require.Equal(t, power(16).Sub(power(16).QuoRaw(10)), defi.Tokens)
+	require.True(t, defi.IsJailed())
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), defi.JailedUntil)
+
+	ubd, found := app.DefiKeeper.GetUnbondingDelegation(ctx, delAddr, defiAddr)
+	require.True(t, found)
+	require.Equal(t, power(4).Sub(power(4).QuoRaw(10)), ubd.Entries[0].Balance)
+	require.Equal(t, supply.Sub(power(2)), app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(bondDenom))
+
+	events := 0
+	app.DefiKeeper.IterateDefiSlashEventsBetween(ctx, defiAddr, 0, 12, func(_ uint64, event types.DefiSlashEvent) bool {
+		require.Equal(t, sdk.NewDecWithPrec(1, 1), event.Fraction)
+		events++
+		return false
+	})
+	require.Equal(t, 1, events)
+	requireInvariants()
+
+	// the jailed defi leaves the bonded set
+	_, err = app.DefiKeeper.ApplyAndReturnDefiSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Unbonding, getDefi().Status)
+
+	// the delegator rewards are split around the slash
+	ctx = ctx.WithBlockHeight(13)
+	_, err = msgServer.WithdrawDelegatorReward(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawDefiDelegatorReward(delAddr, defiAddr))
+	require.NoError(t, err)
+	requireInvariants()
+
+	// the defi can only be unjailed once the jail duration passed
+	_, err = msgServer.UnjailDefi(sdk.WrapSDKContext(ctx), types.NewMsgUnjailDefi(defiAddr))
+	require.ErrorIs(t, err, types.ErrDefiJailed)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	_, err = msgServer.UnjailDefi(sdk.WrapSDKContext(ctx), types.NewMsgUnjailDefi(defiAddr))
+	require.NoError(t, err)
+	require.False(t, getDefi().IsJailed())
+	_, err = msgServer.UnjailDefi(sdk.WrapSDKContext(ctx), types.NewMsgUnjailDefi(defiAddr))
+	require.ErrorIs(t, err, types.ErrDefiNotJailed)
+
+	_, err = app.DefiKeeper.ApplyAndReturnDefiSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Bonded, getDefi().Status)
+
+	// a governance slash at the current height leaves the unbonding entries
+	proposal := types.NewSlashDefiProposal("title", "description", defiAddr, ctx.BlockHeight(), sdk.NewDecWithPrec(5, 1), false)
+	require.NoError(t, proposal.ValidateBasic())
+	tokens := getDefi().Tokens
+	require.NoError(t, app.DefiKeeper.HandleSlashDefiProposal(ctx, proposal))
+	require.Equal(t, tokens.Sub(tokens.QuoRaw(2)), getDefi().Tokens)
+	require.False(t, getDefi().IsJailed())
+
+	ubd, _ = app.DefiKeeper.GetUnbondingDelegation(ctx, delAddr, defiAddr)
+	require.Equal(t, power(4).Sub(power(4).QuoRaw(10)), ubd.Entries[0].Balance)
+	requireInvariants()
+
+	gs := app.DefiKeeper.ExportGenesis(ctx)
+	require.Len(t, gs.DefiSlashEvents, 2)
+	require.NoError(t, keeper.ValidateGenesis(gs))
+}
+
+func TestSlashDefiStakeAtInfraction(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 5, Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)})
+	msgServer := keeper.NewMsgServerImpl(app.DefiKeeper)
+
+	power := sdk.TokensFromConsensusPower
+	bondDenom := app.DefiKeeper.BondDenom(ctx)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, power(100))
+	defiAddr := sdk.ValAddress(addrs[0])
+	delAddr := addrs[1]
+
+	commission := types.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+	msg, err := types.NewMsgCreateDefi(defiAddr, sdk.NewCoin(bondDenom, power(10)),
+		types.NewDescription("moniker", "", "", "", ""), commission, power(5))
+	require.NoError(t, err)
+	_, err = msgServer.CreateDefi(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	_, err = app.DefiKeeper.ApplyAndReturnDefiSetUpdates(ctx)
+	require.NoError(t, err)
+
+	// the defi holds 10 tokens at the infraction height
+	app.DefiKeeper.TrackHistoricalInfo(ctx)
+
+	ctx = ctx.WithBlockHeight(10)
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDefiDelegate(delAddr, defiAddr, sdk.NewCoin(bondDenom, power(10))))
+	require.NoError(t, err)
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgDefiUndelegate(delAddr, defiAddr, sdk.NewCoin(bondDenom, power(4))))
+	require.NoError(t, err)
+
+	// 10% of the stake at the infraction is burned in total: the live
+	// unbonding entry pays its share first and the defi pays the rest
+	ctx = ctx.WithBlockHeight(12)
+	supply := app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(bondDenom)
+	burned, err := app.DefiKeeper.Slash(ctx, defiAddr, 5, sdk.NewDecWithPrec(1, 1))
+	require.NoError(t, err)
+	require.Equal(t, power(1), burned)
+	require.Equal(t, supply.Sub(power(1)), app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(bondDenom))
+
+	ubd, found := app.DefiKeeper.GetUnbondingDelegation(ctx, delAddr, defiAddr)
+	require.True(t, found)
+	require.Equal(t, power(4).Sub(power(4).QuoRaw(10)), ubd.Entries[0].Balance)
+
+	defi, found := app.DefiKeeper.GetDefi(ctx, defiAddr)
+	require.True(t, found)
+	require.Equal(t, power(16).Sub(power(1)).Add(power(4).QuoRaw(10)), defi.Tokens)
+
+	invariantMsg, broken := keeper.ModuleAccountInvariants(app.DefiKeeper)(ctx)
+	require.False(t, broken, invariantMsg)
+}
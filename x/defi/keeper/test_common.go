@@ -1,6 +1,7 @@
 package keeper // noalias
 
 import (
+	"bytes"
 	"math/rand"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +12,28 @@ import (
 func TestingUpdateDefi(keeper Keeper, ctx sdk.Context, defi types.Defi, apply bool) types.Defi {
 	keeper.SetDefi(ctx, defi)
 
+	// Remove any existing power key for defi.
+	store := ctx.KVStore(keeper.storeKey)
+	deleted := false
+
+	iterator := sdk.KVStorePrefixIterator(store, types.DefisByPowerIndexKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		defiAddr := types.ParseDefiPowerRankKey(iterator.Key())
+		if bytes.Equal(defiAddr, defi.GetOperator()) {
+			if deleted {
+				panic("found duplicate power index key")
+			} else {
+				deleted = true
+			}
+
+			store.Delete(iterator.Key())
+		}
+	}
+
+	keeper.SetDefiByPowerIndex(ctx, defi)
+
 	if !apply {
 		ctx, _ = ctx.CacheContext()
 	}
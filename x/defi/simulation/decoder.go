@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 
+	gogotypes "github.com/gogo/protobuf/types"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
@@ -15,6 +17,14 @@ import (
 func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
+		case bytes.Equal(kvA.Key[:1], types.LastTotalPowerKey):
+			var powerA, powerB sdk.IntProto
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &powerA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &powerB)
+
+			return fmt.Sprintf("%v\n%v", powerA, powerB)
+
 		case bytes.Equal(kvA.Key[:1], types.DefisKey):
 			var defiA, defiB types.Defi
 
@@ -23,6 +33,17 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 
 			return fmt.Sprintf("%v\n%v", defiA, defiB)
 
+		case bytes.Equal(kvA.Key[:1], types.LastDefiPowerKey):
+			var powerA, powerB gogotypes.Int64Value
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &powerA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &powerB)
+
+			return fmt.Sprintf("%v\n%v", powerA.Value, powerB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.DefisByPowerIndexKey):
+			return fmt.Sprintf("%v\n%v", sdk.ValAddress(kvA.Value), sdk.ValAddress(kvB.Value))
+
 		case bytes.Equal(kvA.Key[:1], types.DelegationKey):
 			var delegationA, delegationB types.Delegation
 
@@ -40,6 +61,16 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 
 			return fmt.Sprintf("%v\n%v", ubdA, ubdB)
 
+		case bytes.Equal(kvA.Key[:1], types.RedelegationKey),
+			bytes.Equal(kvA.Key[:1], types.RedelegationByDefiSrcIndexKey),
+			bytes.Equal(kvA.Key[:1], types.RedelegationByDefiDstIndexKey):
+			var redA, redB types.Redelegation
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &redA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &redB)
+
+			return fmt.Sprintf("%v\n%v", redA, redB)
+
 		case bytes.Equal(kvA.Key[:1], types.DefiOutstandingRewardsPrefix):
 			var rewardsA, rewardsB types.DefiOutstandingRewards
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &rewardsA)
@@ -73,6 +104,18 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &commissionB)
 			return fmt.Sprintf("%v\n%v", commissionA, commissionB)
 
+		case bytes.Equal(kvA.Key[:1], types.DefiSlashEventPrefix):
+			var eventA, eventB types.DefiSlashEvent
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &eventA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &eventB)
+			return fmt.Sprintf("%v\n%v", eventA, eventB)
+
+		case bytes.Equal(kvA.Key[:1], types.DefiMinterKey):
+			var minterA, minterB types.DefiMinter
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &minterA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &minterB)
+			return fmt.Sprintf("%v\n%v", minterA, minterB)
+
 
 		default:
 			panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
@@ -16,14 +16,20 @@ import (
 
 // Simulation parameter constants
 const (
-	mintInflationKey  = "mint_inflation"
-	communityTaxKey   = "community_tax"
-	commissionRateKey = "commission_rate"
-	marketRateKey     = "market_rate"
-	unbondingTimeKey  = "unbonding_time"
-	maxDefisKey       = "max_defis"
-	maxEntriesKey     = "max_entries"
-	historicalEntriesKey = "historical_entries"
+	mintInflationKey      = "mint_inflation"
+	communityTaxKey       = "community_tax"
+	maxCommissionRateKey  = "max_commission_rate"
+	marketRateKey         = "market_rate"
+	unbondingTimeKey      = "unbonding_time"
+	maxDefisKey           = "max_defis"
+	maxEntriesKey         = "max_entries"
+	historicalEntriesKey  = "historical_entries"
+	jailDurationKey       = "jail_duration"
+	evidenceHandlersKey   = "evidence_handlers"
+	annualProvisionCapKey = "annual_provision_cap"
+	maxSupplyKey          = "max_supply"
+	inflationDecayKey     = "inflation_decay"
+	goalBondedKey         = "goal_bonded"
 )
 
 // GenMintInflation randomized MintInflation
@@ -36,9 +42,9 @@ func GenCommunityTax(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2))
 }
 
-// GenCommissionRate randomized CommissionRate
-func GenCommissionRate(r *rand.Rand) sdk.Dec {
-	return sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2))
+// GenMaxCommissionRate randomized MaxCommissionRate between 20% and 100%
+func GenMaxCommissionRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 20, 101)), 2)
 }
 
 // GenMarketRate randomized MarketRate
@@ -66,18 +72,77 @@ func GetHistEntries(r *rand.Rand) uint32 {
 	return uint32(r.Intn(int(types.DefaultHistoricalEntries + 1)))
 }
 
+// GenJailDuration randomized JailDuration
+func GenJailDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 60, 60*60*24*2)) * time.Second
+}
+
+// GenEvidenceHandlers randomized EvidenceHandlers, registering one or two of
+// the simulation accounts
+func GenEvidenceHandlers(r *rand.Rand, accs []simulation.Account) []string {
+	n := simulation.RandIntBetween(r, 1, 3)
+	if n > len(accs) {
+		n = len(accs)
+	}
+
+	handlers := []string{}
+	for _, acc := range accs[:n] {
+		handlers = append(handlers, acc.Address.String())
+	}
+
+	return handlers
+}
+
+// GenAnnualProvisionCap randomized AnnualProvisionCap, uncapped half of the time
+func GenAnnualProvisionCap(r *rand.Rand) sdk.Int {
+	if r.Intn(2) == 0 {
+		return sdk.ZeroInt()
+	}
+
+	return sdk.NewInt(int64(simulation.RandIntBetween(r, 1000, 1000000)))
+}
+
+// GenMaxSupply randomized MaxSupply, without a ceiling half of the time
+func GenMaxSupply(r *rand.Rand) sdk.Int {
+	if r.Intn(2) == 0 {
+		return sdk.ZeroInt()
+	}
+
+	return sdk.TokensFromConsensusPower(int64(simulation.RandIntBetween(r, 1, 1000000000)))
+}
+
+// GenInflationDecay randomized InflationDecay between 0% and 20%
+func GenInflationDecay(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(21)), 2)
+}
+
+// GenGoalBonded randomized GoalBonded, between 50% and 80% or disabled
+func GenGoalBonded(r *rand.Rand) sdk.Dec {
+	if r.Intn(2) == 0 {
+		return sdk.ZeroDec()
+	}
+
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 50, 81)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for staking
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
 	var (
-		mintInflation sdk.Coin
-		communityTax  sdk.Dec
-		commissionRate sdk.Dec
-		marketRate    sdk.Dec
-		unbondTime    time.Duration
-		maxDefis      uint32
-		maxEntries    uint32
-		histEntries   uint32
+		mintInflation  sdk.Coin
+		communityTax   sdk.Dec
+		maxCommRate    sdk.Dec
+		marketRate     sdk.Dec
+		unbondTime     time.Duration
+		maxDefis       uint32
+		maxEntries     uint32
+		histEntries    uint32
+		jailDuration   time.Duration
+		handlers       []string
+		provisionCap   sdk.Int
+		maxSupply      sdk.Int
+		inflationDecay sdk.Dec
+		goalBonded     sdk.Dec
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -91,8 +156,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 	)
 
 	simState.AppParams.GetOrGenerate(
-		simState.Cdc, commissionRateKey, &commissionRate, simState.Rand,
-		func(r *rand.Rand) { commissionRate = GenCommissionRate(r) },
+		simState.Cdc, maxCommissionRateKey, &maxCommRate, simState.Rand,
+		func(r *rand.Rand) { maxCommRate = GenMaxCommissionRate(r) },
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -100,6 +165,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { marketRate = GenMarketRate(r) },
 	)
 
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, unbondingTimeKey, &unbondTime, simState.Rand,
+		func(r *rand.Rand) { unbondTime = GenUnbondingTime(r) },
+	)
+
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, maxDefisKey, &maxDefis, simState.Rand,
 		func(r *rand.Rand) { maxDefis = GenMaxDefis(r) },
@@ -115,47 +185,49 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { histEntries = GetHistEntries(r) },
 	)
 
-	
-	// NOTE: the slashing module need to be defined after the staking module on the
-	// NewSimulationManager constructor for this to work
-	simState.UnbondTime = unbondTime
-	params := types.NewParams(sdk.DefaultBondDenom, mintInflation, communityTax, commissionRate, marketRate,
-		 simState.UnbondTime, maxDefis, maxEntries, histEntries)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, jailDurationKey, &jailDuration, simState.Rand,
+		func(r *rand.Rand) { jailDuration = GenJailDuration(r) },
+	)
 
-	// defis & delegations
-	var (
-		defis  []types.Defi
-		delegations []types.Delegation
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, evidenceHandlersKey, &handlers, simState.Rand,
+		func(r *rand.Rand) { handlers = GenEvidenceHandlers(r, simState.Accounts) },
 	)
 
-	defiAddrs := make([]sdk.ValAddress, simState.NumBonded)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, annualProvisionCapKey, &provisionCap, simState.Rand,
+		func(r *rand.Rand) { provisionCap = GenAnnualProvisionCap(r) },
+	)
 
-	for i := 0; i < int(simState.NumBonded); i++ {
-		defiAddr := sdk.ValAddress(simState.Accounts[i].Address)
-		defiAddrs[i] = defiAddr
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxSupplyKey, &maxSupply, simState.Rand,
+		func(r *rand.Rand) { maxSupply = GenMaxSupply(r) },
+	)
 
-		defi, err := types.NewDefi(defiAddr, types.Description{})
-		if err != nil {
-			panic(err)
-		}
-		defi.Tokens = sdk.NewInt(simState.InitialStake)
-		defi.DelegatorShares = sdk.NewDec(simState.InitialStake)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, inflationDecayKey, &inflationDecay, simState.Rand,
+		func(r *rand.Rand) { inflationDecay = GenInflationDecay(r) },
+	)
 
-		delegation := types.NewDelegation(
-			simState.Accounts[i].Address,
-			defiAddr,
-			sdk.NewDec(simState.InitialStake),
-		)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, goalBondedKey, &goalBonded, simState.Rand,
+		func(r *rand.Rand) { goalBonded = GenGoalBonded(r) },
+	)
 
-		defis = append(defis, defi)
-		delegations = append(delegations, delegation)
-	}
+	// NOTE: simState.UnbondTime belongs to the staking module and is used by
+	// slashing, so the defi unbonding time is kept to the defi params only
+	params := types.NewParams(sdk.DefaultBondDenom, mintInflation, communityTax, maxCommRate, marketRate,
+		unbondTime, maxDefis, maxEntries, histEntries, jailDuration, handlers,
+		provisionCap, maxSupply, inflationDecay, goalBonded)
 
+	// no defis nor delegations are set at genesis: their tokens would have to be
+	// backed by the bank genesis supply, which is only adjusted for the staking
+	// module by the app state function. Defis are created by the operations.
 	stakingGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
-		Params: params,
-		Defis: defis,
-		Delegations: delegations,
+		Params:  params,
+		Minter:  types.InitialDefiMinter(),
 	}
 
 	bz, err := json.MarshalIndent(&stakingGenesis, "", " ")
@@ -21,10 +21,13 @@ const (
 	DefaultWeightMsgEditDefi                 int = 5
 	DefaultWeightMsgDelegate                 int = 100
 	DefaultWeightMsgUndelegate               int = 100
+	DefaultWeightMsgBeginRedelegate          int = 100
 	DefaultWeightMsgSetWithdrawAddress       int = 50
 	DefaultWeightMsgWithdrawDelegationReward int = 50
 	DefaultWeightMsgWithdrawDefiCommission   int = 50
 	DefaultWeightMsgFundCommunityPool        int = 50
+	DefaultWeightMsgSlashDefi                int = 5
+	DefaultWeightMsgUnjailDefi               int = 50
 	
 	
 	
@@ -33,10 +36,13 @@ const (
 	OpWeightMsgEditDefi                    = "op_weight_msg_edit_defi"
 	OpWeightMsgDelegate                    = "op_weight_msg_delegate"
 	OpWeightMsgUndelegate                  = "op_weight_msg_undelegate"
+	OpWeightMsgBeginRedelegate             = "op_weight_msg_begin_redelegate"
 	OpWeightMsgSetWithdrawAddress          = "op_weight_msg_set_withdraw_address"
 	OpWeightMsgWithdrawDelegationReward    = "op_weight_msg_withdraw_delegation_reward"
 	OpWeightMsgWithdrawDefiCommission      = "op_weight_msg_withdraw_defi_commission"
 	OpWeightMsgFundCommunityPool           = "op_weight_msg_fund_community_pool"
+	OpWeightMsgSlashDefi                   = "op_weight_msg_slash_defi"
+	OpWeightMsgUnjailDefi                  = "op_weight_msg_unjail_defi"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -49,10 +55,13 @@ func WeightedOperations(
 		weightMsgEditDefi                    int
 		weightMsgDelegate                    int
 		weightMsgUndelegate                  int
+		weightMsgBeginRedelegate             int
 		weightMsgSetWithdrawAddress          int
 		weightMsgWithdrawDelegationReward    int
 		weightMsgWithdrawDefiCommission      int
 		weightMsgFundCommunityPool           int
+		weightMsgSlashDefi                   int
+		weightMsgUnjailDefi                  int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateDefi, &weightMsgCreateDefi, nil,
@@ -79,6 +88,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgBeginRedelegate, &weightMsgBeginRedelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgBeginRedelegate = DefaultWeightMsgBeginRedelegate
+		},
+	)
+
 	appParams.GetOrGenerate(cdc, OpWeightMsgSetWithdrawAddress, &weightMsgSetWithdrawAddress, nil,
 		func(_ *rand.Rand) {
 			weightMsgSetWithdrawAddress = DefaultWeightMsgSetWithdrawAddress
@@ -103,6 +118,18 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgSlashDefi, &weightMsgSlashDefi, nil,
+		func(_ *rand.Rand) {
+			weightMsgSlashDefi = DefaultWeightMsgSlashDefi
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUnjailDefi, &weightMsgUnjailDefi, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnjailDefi = DefaultWeightMsgUnjailDefi
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateDefi,
@@ -120,6 +147,10 @@ func WeightedOperations(
 			weightMsgUndelegate,
 			SimulateMsgUndelegate(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgBeginRedelegate,
+			SimulateMsgBeginRedelegate(ak, bk, k),
+		),
 		simulation.NewWeightedOperation(
 			weightMsgSetWithdrawAddress,
 			SimulateMsgSetWithdrawAddress(ak, bk, k),
@@ -136,6 +167,14 @@ func WeightedOperations(
 			weightMsgFundCommunityPool,
 			SimulateMsgFundCommunityPool(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgSlashDefi,
+			SimulateMsgSlashDefi(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUnjailDefi,
+			SimulateMsgUnjailDefi(ak, bk, k),
+		),
 	}
 }
 
@@ -189,7 +228,14 @@ func SimulateMsgCreateDefi(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 			simtypes.RandStringOfLength(r, 10),
 		)
 
-		msg, err := types.NewMsgCreateDefi(address, selfDelegation, description, sdk.OneInt())
+		maxCommission := simtypes.RandomDecAmount(r, k.MaxCommissionRate(ctx))
+		commission := types.NewCommissionRates(
+			simtypes.RandomDecAmount(r, maxCommission),
+			maxCommission,
+			simtypes.RandomDecAmount(r, maxCommission),
+		)
+
+		msg, err := types.NewMsgCreateDefi(address, selfDelegation, description, commission, sdk.OneInt())
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to create CreateDefi message"), nil, err
 		}
@@ -235,6 +281,18 @@ func SimulateMsgEditDefi(ak types.AccountKeeper, bk types.BankKeeper, k keeper.K
 
 		address := defi.GetOperator()
 
+		newCommissionRate := simtypes.RandomDecAmount(r, defi.Commission.MaxRate)
+
+		if err := defi.Commission.ValidateNewRate(newCommissionRate, ctx.BlockHeader().Time); err != nil {
+			// skip as the commission is invalid
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEditDefi, "invalid commission rate"), nil, nil
+		}
+
+		if newCommissionRate.GT(k.MaxCommissionRate(ctx)) {
+			// skip as the commission is above the max commission rate param
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEditDefi, "commission rate above the max commission rate"), nil, nil
+		}
+
 		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(defi.GetOperator()))
 		if !found {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEditDefi, "unable to find account"), nil, fmt.Errorf("defi %s not found", defi.GetOperator())
@@ -256,7 +314,7 @@ func SimulateMsgEditDefi(ak types.AccountKeeper, bk types.BankKeeper, k keeper.K
 			simtypes.RandStringOfLength(r, 10),
 		)
 
-		msg := types.NewMsgEditDefi(address, description, nil)
+		msg := types.NewMsgEditDefi(address, description, &newCommissionRate, nil)
 
 		txGen := simappparams.MakeTestEncodingConfig().TxConfig
 		tx, err := helpers.GenTx(
@@ -445,6 +503,119 @@ func SimulateMsgUndelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 	}
 }
 
+// SimulateMsgBeginRedelegate generates a MsgDefiBeginRedelegate with random values
+// nolint: interfacer
+func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// get random source defi
+		srcDefi, ok := keeper.RandomDefi(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDefiBeginRedelegate, "unable to pick defi"), nil, nil
+		}
+
+		srcAddr := srcDefi.GetOperator()
+		delegations := k.GetDefiDelegations(ctx, srcAddr)
+		if delegations == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDefiBeginRedelegate, "keeper does have any delegation entries"), nil, nil
+		}
+
+		// get random delegator from defi
+		delegation := delegations[r.Intn(len(delegations))]
+		delAddr := delegation.GetDelegatorAddr()
+
+		if k.HasReceivingRedelegation(ctx, delAddr, srcAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDefiBeginRedelegate, "receveing redelegation is not allowed"), nil, nil // skip
+		}
+
+		// get random destination defi
+		destDefi, ok := keeper.RandomDefi(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDefiBeginRedelegate, "unable to pick defi"), nil, nil
+		}
+
+		destAddr := destDefi.GetOperator()
+		if srcAddr.Equals(destAddr) || destDefi.InvalidExRate() || k.HasMaxRedelegationEntries(ctx, delAddr, srcAddr, destAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDefiBeginRedelegate, "checks failed"), nil, nil
+		}
+
+		totalBond := srcDefi.TokensFromShares(delegation.GetShares()).TruncateInt()
+		if !totalBond.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDefiBeginRedelegate, "total bond is negative"), nil, nil
+		}
+
+		redAmt, err := simtypes.RandPositiveInt(r, totalBond)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDefiBeginRedelegate, "unable to generate positive amount"), nil, err
+		}
+
+		if redAmt.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDefiBeginRedelegate, "amount is zero"), nil, nil
+		}
+
+		// check if the shares truncate to zero
+		shares, err := srcDefi.SharesFromTokens(redAmt)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDefiBeginRedelegate, "invalid shares"), nil, err
+		}
+
+		if srcDefi.TokensFromShares(shares).TruncateInt().IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDefiBeginRedelegate, "shares truncate to zero"), nil, nil // skip
+		}
+
+		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
+		var simAccount simtypes.Account
+
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(delAddr) {
+				simAccount = simAcc
+				break
+			}
+		}
+
+		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
+		if simAccount.PrivKey == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDefiBeginRedelegate, "account private key is nil"), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
+		}
+
+		account := ak.GetAccount(ctx, delAddr)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDefiBeginRedelegate, "unable to generate fees"), nil, err
+		}
+
+		msg := types.NewMsgDefiBeginRedelegate(
+			delAddr, srcAddr, destAddr,
+			sdk.NewCoin(k.BondDenom(ctx), redAmt),
+		)
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // SimulateMsgSetWithdrawAddress generates a MsgSetWithdrawAddress with random values.
 func SimulateMsgSetWithdrawAddress(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
 	return func(
@@ -649,3 +820,137 @@ func SimulateMsgFundCommunityPool(ak types.AccountKeeper, bk types.BankKeeper, k
 		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
+
+// SimulateMsgSlashDefi generates a MsgSlashDefi signed by a registered evidence handler
+// nolint: interfacer
+func SimulateMsgSlashDefi(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		handlers := k.EvidenceHandlers(ctx)
+		if len(handlers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSlashDefi, "no evidence handlers"), nil, nil
+		}
+
+		authority, err := sdk.AccAddressFromBech32(handlers[r.Intn(len(handlers))])
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSlashDefi, "invalid evidence handler"), nil, err
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, authority)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSlashDefi, "evidence handler account not found"), nil, nil
+		}
+
+		defi, ok := keeper.RandomDefi(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSlashDefi, "unable to pick a defi"), nil, nil
+		}
+
+		infractionHeight := ctx.BlockHeight() - int64(r.Intn(10))
+		if infractionHeight < 0 {
+			infractionHeight = 0
+		}
+
+		// keep the slashes small so the defis keep their delegators
+		fraction := simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 1))
+		if !fraction.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSlashDefi, "slash fraction is zero"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSlashDefi, "unable to generate fees"), nil, err
+		}
+
+		msg := types.NewMsgSlashDefi(simAccount.Address, defi.GetOperator(), infractionHeight, fraction, r.Intn(2) == 0)
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgUnjailDefi generates a MsgUnjailDefi for a jailed defi whose jail period has passed
+// nolint: interfacer
+func SimulateMsgUnjailDefi(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		defi, ok := keeper.RandomDefi(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnjailDefi, "unable to pick a defi"), nil, nil
+		}
+
+		if !defi.IsJailed() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnjailDefi, "defi is not jailed"), nil, nil
+		}
+
+		if ctx.BlockHeader().Time.Before(defi.JailedUntil) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnjailDefi, "defi still jailed"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(defi.GetOperator()))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnjailDefi, "unable to find account"), nil, fmt.Errorf("defi %s not found", defi.GetOperator())
+		}
+
+		delegation, found := k.GetDelegation(ctx, simAccount.Address, defi.GetOperator())
+		if !found || defi.TokensFromShares(delegation.Shares).TruncateInt().LT(defi.MinSelfDelegation) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnjailDefi, "self delegation below minimum"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnjailDefi, "unable to generate fees"), nil, err
+		}
+
+		msg := types.NewMsgUnjailDefi(defi.GetOperator())
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/defi interfaces and concrete types
@@ -15,10 +16,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgEditDefi{}, "gauss/defi/MsgEditDefi", nil)
 	cdc.RegisterConcrete(&MsgDefiDelegate{}, "gauss/defi/MsgDefiDelegate", nil)
 	cdc.RegisterConcrete(&MsgDefiUndelegate{}, "gauss/defi/MsgDefiUndelegate", nil)
+	cdc.RegisterConcrete(&MsgDefiBeginRedelegate{}, "gauss/defi/MsgDefiBeginRedelegate", nil)
 	cdc.RegisterConcrete(&MsgSetDefiWithdrawAddress{}, "gauss/defi/MsgSetDefiWithdrawAddress", nil)
 	cdc.RegisterConcrete(&MsgWithdrawDefiDelegatorReward{}, "gauss/defi/MsgWithdrawDefiDelegatorReward", nil)
 	cdc.RegisterConcrete(&MsgWithdrawDefiCommission{}, "gauss/defi/MsgWithdrawDefiCommission", nil)
 	cdc.RegisterConcrete(&MsgFundDefiCommunityPool{}, "gauss/defi/MsgFundDefiCommunityPool", nil)
+	cdc.RegisterConcrete(&MsgSlashDefi{}, "gauss/defi/MsgSlashDefi", nil)
+	cdc.RegisterConcrete(&MsgUnjailDefi{}, "gauss/defi/MsgUnjailDefi", nil)
+
+	cdc.RegisterConcrete(&SlashDefiProposal{}, "gauss/SlashDefiProposal", nil)
 }
 
 // RegisterInterfaces registers the x/defi interfaces types with the interface registry
@@ -28,10 +34,16 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgEditDefi{},
 		&MsgDefiDelegate{},
 		&MsgDefiUndelegate{},
+		&MsgDefiBeginRedelegate{},
 		&MsgSetDefiWithdrawAddress{},
 		&MsgWithdrawDefiDelegatorReward{},
 		&MsgWithdrawDefiCommission{},
 		&MsgFundDefiCommunityPool{},
+		&MsgSlashDefi{},
+		&MsgUnjailDefi{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&SlashDefiProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -44,6 +44,8 @@ func NewDefi(operator sdk.ValAddress, description Description) (Defi, error) {
 		Description:       description,
 		UnbondingHeight:   int64(0),
 		UnbondingTime:     time.Unix(0, 0).UTC(),
+		JailedUntil:       time.Unix(0, 0).UTC(),
+		Commission:        NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
 		MinSelfDelegation: sdk.OneInt(),
 	}, nil
 }
@@ -218,6 +220,18 @@ func (d Description) EnsureLength() (Description, error) {
 	return d, nil
 }
 
+// SetInitialCommission attempts to set a defi's initial commission. An
+// error is returned if the commission is invalid.
+func (v Defi) SetInitialCommission(commission Commission) (Defi, error) {
+	if err := commission.Validate(); err != nil {
+		return v, err
+	}
+
+	v.Commission = commission
+
+	return v, nil
+}
+
 // In some situations, the exchange rate becomes invalid, e.g. if
 // Defi loses all tokens due to slashing. In this case,
 // make all future delegations invalid.
@@ -270,6 +284,21 @@ func (v Defi) BondedTokens() sdk.Int {
 	return sdk.ZeroInt()
 }
 
+// ConsensusPower gets the power of a bonded defi, a reduction of 10^6 from
+// the defi tokens is applied
+func (v Defi) ConsensusPower() int64 {
+	if v.IsBonded() {
+		return v.PotentialConsensusPower()
+	}
+
+	return 0
+}
+
+// PotentialConsensusPower returns the power the defi would have if bonded
+func (v Defi) PotentialConsensusPower() int64 {
+	return sdk.TokensToConsensusPower(v.Tokens)
+}
+
 // UpdateStatus updates the location of the shares within a defi
 // to reflect the new status
 func (v Defi) UpdateStatus(newStatus BondStatus) Defi {
@@ -346,9 +375,11 @@ func (v Defi) RemoveDelShares(delShares sdk.Dec) (Defi, sdk.Int) {
 func (v *Defi) MinEqual(other *Defi) bool {
 	return v.OperatorAddress == other.OperatorAddress &&
 		v.Status == other.Status &&
+		v.Jailed == other.Jailed &&
 		v.Tokens.Equal(other.Tokens) &&
 		v.DelegatorShares.Equal(other.DelegatorShares) &&
 		v.Description.Equal(other.Description) &&
+		v.Commission.Equal(other.Commission) &&
 		v.MinSelfDelegation.Equal(other.MinSelfDelegation)
 
 }
@@ -357,9 +388,11 @@ func (v *Defi) MinEqual(other *Defi) bool {
 func (v *Defi) Equal(v2 *Defi) bool {
 	return v.MinEqual(v2) &&
 		v.UnbondingHeight == v2.UnbondingHeight &&
-		v.UnbondingTime.Equal(v2.UnbondingTime)
+		v.UnbondingTime.Equal(v2.UnbondingTime) &&
+		v.JailedUntil.Equal(v2.JailedUntil)
 }
 
+func (v Defi) IsJailed() bool        { return v.Jailed }
 func (v Defi) GetMoniker() string    { return v.Description.Moniker }
 func (v Defi) GetStatus() BondStatus { return v.Status }
 func (v Defi) GetOperator() sdk.ValAddress {
@@ -375,6 +408,8 @@ func (v Defi) GetOperator() sdk.ValAddress {
 
 func (v Defi) GetTokens() sdk.Int            { return v.Tokens }
 func (v Defi) GetBondedTokens() sdk.Int      { return v.BondedTokens() }
+func (v Defi) GetConsensusPower() int64      { return v.ConsensusPower() }
+func (v Defi) GetCommission() sdk.Dec        { return v.Commission.Rate }
 func (v Defi) GetMinSelfDelegation() sdk.Int { return v.MinSelfDelegation }
 func (v Defi) GetDelegatorShares() sdk.Dec   { return v.DelegatorShares }
 
@@ -401,6 +436,14 @@ func NewDefiCurrentRewards(rewards sdk.DecCoins, period uint64) DefiCurrentRewar
 	}
 }
 
+// create a new DefiSlashEvent
+func NewDefiSlashEvent(defiPeriod uint64, fraction sdk.Dec) DefiSlashEvent {
+	return DefiSlashEvent{
+		DefiPeriod: defiPeriod,
+		Fraction:   fraction,
+	}
+}
+
 // return the initial accumulated commission (zero)
 func InitialDefiAccumulatedCommission() DefiAccumulatedCommission {
 	return DefiAccumulatedCommission{}
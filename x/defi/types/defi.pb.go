@@ -132,6 +132,96 @@ func (m *HistoricalInfo) GetDefiset() []Defi {
 	return nil
 }
 
+// CommissionRates defines the initial commission rates to be used for creating
+// a defi.
+type CommissionRates struct {
+	// rate is the commission rate charged to delegators, as a fraction.
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// max_rate defines the maximum commission rate which defi can ever charge, as a fraction.
+	MaxRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_rate,json=maxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_rate" yaml:"max_rate"`
+	// max_change_rate defines the maximum daily increase of the defi commission, as a fraction.
+	MaxChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_rate" yaml:"max_change_rate"`
+}
+
+func (m *CommissionRates) Reset()      { *m = CommissionRates{} }
+func (*CommissionRates) ProtoMessage() {}
+func (*CommissionRates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e68f0e8642f790a9, []int{1}
+}
+func (m *CommissionRates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionRates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionRates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionRates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionRates.Merge(m, src)
+}
+func (m *CommissionRates) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionRates) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionRates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionRates proto.InternalMessageInfo
+
+// Commission defines commission parameters for a given defi.
+type Commission struct {
+	// commission_rates defines the initial commission rates to be used for creating a defi.
+	CommissionRates `protobuf:"bytes,1,opt,name=commission_rates,json=commissionRates,proto3,embedded=commission_rates" json:"commission_rates"`
+	// update_time is the last time the commission rate was changed.
+	UpdateTime time.Time `protobuf:"bytes,2,opt,name=update_time,json=updateTime,proto3,stdtime" json:"update_time" yaml:"update_time"`
+}
+
+func (m *Commission) Reset()      { *m = Commission{} }
+func (*Commission) ProtoMessage() {}
+func (*Commission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e68f0e8642f790a9, []int{2}
+}
+func (m *Commission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Commission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Commission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Commission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Commission.Merge(m, src)
+}
+func (m *Commission) XXX_Size() int {
+	return m.Size()
+}
+func (m *Commission) XXX_DiscardUnknown() {
+	xxx_messageInfo_Commission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Commission proto.InternalMessageInfo
+
+func (m *Commission) GetUpdateTime() time.Time {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return time.Time{}
+}
+
 // Description defines a defi description.
 type Description struct {
 	// moniker defines a human-readable name for the defi.
@@ -149,7 +239,7 @@ type Description struct {
 func (m *Description) Reset()      { *m = Description{} }
 func (*Description) ProtoMessage() {}
 func (*Description) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{1}
+	return fileDescriptor_e68f0e8642f790a9, []int{3}
 }
 func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -230,12 +320,18 @@ type Defi struct {
 	Tokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens"`
 	// delegator_shares defines total shares issued to a defi's delegators.
 	DelegatorShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=delegator_shares,json=delegatorShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegator_shares" yaml:"delegator_shares"`
+	// jailed defines whether the defi has been jailed from the bonded set.
+	Jailed bool `protobuf:"varint,5,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// jailed_until defines the min time at which a jailed defi can be unjailed.
+	JailedUntil time.Time `protobuf:"bytes,6,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until" yaml:"jailed_until"`
 	// description defines the description terms for the defi.
 	Description Description `protobuf:"bytes,7,opt,name=description,proto3" json:"description"`
 	// unbonding_height defines, if unbonding, the height at which this defi has begun unbonding.
 	UnbondingHeight int64 `protobuf:"varint,8,opt,name=unbonding_height,json=unbondingHeight,proto3" json:"unbonding_height,omitempty" yaml:"unbonding_height"`
 	// unbonding_time defines, if unbonding, the min time for the defi to complete unbonding.
 	UnbondingTime time.Time `protobuf:"bytes,9,opt,name=unbonding_time,json=unbondingTime,proto3,stdtime" json:"unbonding_time" yaml:"unbonding_time"`
+	// commission defines the commission parameters.
+	Commission Commission `protobuf:"bytes,10,opt,name=commission,proto3" json:"commission"`
 	// min_self_delegation is the defi's self declared minimum self delegation.
 	MinSelfDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation" yaml:"min_self_delegation"`
 }
@@ -243,7 +339,7 @@ type Defi struct {
 func (m *Defi) Reset()      { *m = Defi{} }
 func (*Defi) ProtoMessage() {}
 func (*Defi) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{2}
+	return fileDescriptor_e68f0e8642f790a9, []int{4}
 }
 func (m *Defi) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +376,7 @@ type DefiAddresses struct {
 func (m *DefiAddresses) Reset()      { *m = DefiAddresses{} }
 func (*DefiAddresses) ProtoMessage() {}
 func (*DefiAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{3}
+	return fileDescriptor_e68f0e8642f790a9, []int{5}
 }
 func (m *DefiAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,7 +423,7 @@ type DDPair struct {
 func (m *DDPair) Reset()      { *m = DDPair{} }
 func (*DDPair) ProtoMessage() {}
 func (*DDPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{4}
+	return fileDescriptor_e68f0e8642f790a9, []int{6}
 }
 func (m *DDPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -365,7 +461,7 @@ func (m *DDPairs) Reset()         { *m = DDPairs{} }
 func (m *DDPairs) String() string { return proto.CompactTextString(m) }
 func (*DDPairs) ProtoMessage()    {}
 func (*DDPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{5}
+	return fileDescriptor_e68f0e8642f790a9, []int{7}
 }
 func (m *DDPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,6 +497,93 @@ func (m *DDPairs) GetPairs() []DDPair {
 	return nil
 }
 
+// DDDTriplet is struct that just has a delegator-defi-defi triplet
+// with no other data. It is intended to be used as a marshalable pointer. For
+// example, a DDDTriplet can be used to construct the key to getting a
+// Redelegation from state.
+type DDDTriplet struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	DefiSrcAddress   string `protobuf:"bytes,2,opt,name=defi_src_address,json=defiSrcAddress,proto3" json:"defi_src_address,omitempty" yaml:"defi_src_address"`
+	DefiDstAddress   string `protobuf:"bytes,3,opt,name=defi_dst_address,json=defiDstAddress,proto3" json:"defi_dst_address,omitempty" yaml:"defi_dst_address"`
+}
+
+func (m *DDDTriplet) Reset()      { *m = DDDTriplet{} }
+func (*DDDTriplet) ProtoMessage() {}
+func (*DDDTriplet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e68f0e8642f790a9, []int{8}
+}
+func (m *DDDTriplet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DDDTriplet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DDDTriplet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DDDTriplet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DDDTriplet.Merge(m, src)
+}
+func (m *DDDTriplet) XXX_Size() int {
+	return m.Size()
+}
+func (m *DDDTriplet) XXX_DiscardUnknown() {
+	xxx_messageInfo_DDDTriplet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DDDTriplet proto.InternalMessageInfo
+
+// DDDTriplets defines an array of DDDTriplet objects.
+type DDDTriplets struct {
+	Triplets []DDDTriplet `protobuf:"bytes,1,rep,name=triplets,proto3" json:"triplets"`
+}
+
+func (m *DDDTriplets) Reset()         { *m = DDDTriplets{} }
+func (m *DDDTriplets) String() string { return proto.CompactTextString(m) }
+func (*DDDTriplets) ProtoMessage()    {}
+func (*DDDTriplets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e68f0e8642f790a9, []int{9}
+}
+func (m *DDDTriplets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DDDTriplets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DDDTriplets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DDDTriplets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DDDTriplets.Merge(m, src)
+}
+func (m *DDDTriplets) XXX_Size() int {
+	return m.Size()
+}
+func (m *DDDTriplets) XXX_DiscardUnknown() {
+	xxx_messageInfo_DDDTriplets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DDDTriplets proto.InternalMessageInfo
+
+func (m *DDDTriplets) GetTriplets() []DDDTriplet {
+	if m != nil {
+		return m.Triplets
+	}
+	return nil
+}
+
 // Delegation represents the bond with tokens held by an account. It is
 // owned by one delegator, and is associated with the voting power of one
 // defi.
@@ -416,7 +599,7 @@ type Delegation struct {
 func (m *Delegation) Reset()      { *m = Delegation{} }
 func (*Delegation) ProtoMessage() {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{6}
+	return fileDescriptor_e68f0e8642f790a9, []int{10}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +642,7 @@ type UnbondingDelegation struct {
 func (m *UnbondingDelegation) Reset()      { *m = UnbondingDelegation{} }
 func (*UnbondingDelegation) ProtoMessage() {}
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{7}
+	return fileDescriptor_e68f0e8642f790a9, []int{11}
 }
 func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -503,7 +686,7 @@ type UnbondingDelegationEntry struct {
 func (m *UnbondingDelegationEntry) Reset()      { *m = UnbondingDelegationEntry{} }
 func (*UnbondingDelegationEntry) ProtoMessage() {}
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{8}
+	return fileDescriptor_e68f0e8642f790a9, []int{12}
 }
 func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,6 +729,109 @@ func (m *UnbondingDelegationEntry) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+// RedelegationEntry defines a redelegation object with relevant metadata.
+type RedelegationEntry struct {
+	// creation_height  defines the height which the redelegation took place.
+	CreationHeight int64 `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
+	// completion_time defines the unix time for redelegation completion.
+	CompletionTime time.Time `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+	// initial_balance defines the initial balance when redelegation started.
+	InitialBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=initial_balance,json=initialBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_balance" yaml:"initial_balance"`
+	// shares_dst is the amount of destination-defi shares created by redelegation.
+	SharesDst github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=shares_dst,json=sharesDst,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares_dst"`
+}
+
+func (m *RedelegationEntry) Reset()      { *m = RedelegationEntry{} }
+func (*RedelegationEntry) ProtoMessage() {}
+func (*RedelegationEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e68f0e8642f790a9, []int{13}
+}
+func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedelegationEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationEntry.Merge(m, src)
+}
+func (m *RedelegationEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationEntry proto.InternalMessageInfo
+
+func (m *RedelegationEntry) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *RedelegationEntry) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+// Redelegation contains the list of a particular delegator's redelegating bonds
+// from a particular source defi to a particular destination defi.
+type Redelegation struct {
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// defi_src_address is the defi redelegation source operator address.
+	DefiSrcAddress string `protobuf:"bytes,2,opt,name=defi_src_address,json=defiSrcAddress,proto3" json:"defi_src_address,omitempty" yaml:"defi_src_address"`
+	// defi_dst_address is the defi redelegation destination operator address.
+	DefiDstAddress string `protobuf:"bytes,3,opt,name=defi_dst_address,json=defiDstAddress,proto3" json:"defi_dst_address,omitempty" yaml:"defi_dst_address"`
+	// entries are the redelegation entries.
+	Entries []RedelegationEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *Redelegation) Reset()      { *m = Redelegation{} }
+func (*Redelegation) ProtoMessage() {}
+func (*Redelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e68f0e8642f790a9, []int{14}
+}
+func (m *Redelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Redelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Redelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Redelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redelegation.Merge(m, src)
+}
+func (m *Redelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Redelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Redelegation proto.InternalMessageInfo
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -556,7 +842,7 @@ type DelegationResponse struct {
 func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
 func (*DelegationResponse) ProtoMessage() {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{9}
+	return fileDescriptor_e68f0e8642f790a9, []int{15}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,6 +885,109 @@ func (m *DelegationResponse) GetBalance() types1.Coin {
 	return types1.Coin{}
 }
 
+// RedelegationEntryResponse is equivalent to a RedelegationEntry except that it
+// contains a balance in addition to shares which is more suitable for client
+// responses.
+type RedelegationEntryResponse struct {
+	RedelegationEntry RedelegationEntry                      `protobuf:"bytes,1,opt,name=redelegation_entry,json=redelegationEntry,proto3" json:"redelegation_entry"`
+	Balance           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+}
+
+func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResponse{} }
+func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
+func (*RedelegationEntryResponse) ProtoMessage()    {}
+func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e68f0e8642f790a9, []int{16}
+}
+func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationEntryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedelegationEntryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationEntryResponse.Merge(m, src)
+}
+func (m *RedelegationEntryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationEntryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationEntryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationEntryResponse proto.InternalMessageInfo
+
+func (m *RedelegationEntryResponse) GetRedelegationEntry() RedelegationEntry {
+	if m != nil {
+		return m.RedelegationEntry
+	}
+	return RedelegationEntry{}
+}
+
+// RedelegationResponse is equivalent to a Redelegation except that its entries
+// contain a balance in addition to shares which is more suitable for client
+// responses.
+type RedelegationResponse struct {
+	Redelegation Redelegation                `protobuf:"bytes,1,opt,name=redelegation,proto3" json:"redelegation"`
+	Entries      []RedelegationEntryResponse `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
+func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*RedelegationResponse) ProtoMessage()    {}
+func (*RedelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e68f0e8642f790a9, []int{17}
+}
+func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationResponse.Merge(m, src)
+}
+func (m *RedelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationResponse proto.InternalMessageInfo
+
+func (m *RedelegationResponse) GetRedelegation() Redelegation {
+	if m != nil {
+		return m.Redelegation
+	}
+	return Redelegation{}
+}
+
+func (m *RedelegationResponse) GetEntries() []RedelegationEntryResponse {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 // Params defines the parameters for the defi module.
 type Params struct {
 	// bond_denom defines the bondable coin denomination.
@@ -607,24 +996,36 @@ type Params struct {
 	MintInflation types1.Coin `protobuf:"bytes,2,opt,name=mint_inflation,json=mintInflation,proto3" json:"mint_inflation" yaml:"mint_inflation"`
 	//
 	CommunityTax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=community_tax,json=communityTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_tax" yaml:"community_tax"`
-	// rate is the commission rate charged to delegators, as a fraction.
-	CommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
+	// max_commission_rate is the ceiling of the commission rates the defis can charge, as a fraction.
+	MaxCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_commission_rate" yaml:"max_commission_rate"`
 	// rate is the market rate charged to makers, as a fraction.
 	MarketRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=market_rate,json=marketRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"market_rate"`
 	// unbonding_time is the time duration of unbonding.
 	UnbondingTime time.Duration `protobuf:"bytes,6,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration" json:"unbonding_time" yaml:"unbonding_time"`
 	// max_defis is the maximum number of defis.
 	MaxDefis uint32 `protobuf:"varint,7,opt,name=max_defis,json=maxDefis,proto3" json:"max_defis,omitempty" yaml:"max_defis"`
-	// max_entries is the max entries for unbonding delegation(per pair/trio).
+	// max_entries is the max entries for either unbonding delegation or redelegation (per pair/trio).
 	MaxEntries uint32 `protobuf:"varint,8,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty" yaml:"max_entries"`
 	// historical_entries is the number of historical entries to persist.
 	HistoricalEntries uint32 `protobuf:"varint,9,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
+	// jail_duration is the min time a slashed defi stays jailed before it can be unjailed.
+	JailDuration time.Duration `protobuf:"bytes,10,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration" yaml:"jail_duration"`
+	// evidence_handlers are the addresses allowed to slash the defis besides governance.
+	EvidenceHandlers []string `protobuf:"bytes,11,rep,name=evidence_handlers,json=evidenceHandlers,proto3" json:"evidence_handlers,omitempty" yaml:"evidence_handlers"`
+	// annual_provision_cap is the max amount of the mint_inflation denom minted per annual period, zero for no cap.
+	AnnualProvisionCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=annual_provision_cap,json=annualProvisionCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"annual_provision_cap" yaml:"annual_provision_cap"`
+	// max_supply is the total supply ceiling of the mint_inflation denom, zero for no ceiling.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply" yaml:"max_supply"`
+	// inflation_decay is the fraction the mint_inflation provision decays by every annual period.
+	InflationDecay github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=inflation_decay,json=inflationDecay,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_decay" yaml:"inflation_decay"`
+	// goal_bonded is the target bonded ratio the per-trade provision is adjusted towards, zero for no adjustment.
+	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded" yaml:"goal_bonded"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{10}
+	return fileDescriptor_e68f0e8642f790a9, []int{18}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,20 +1096,91 @@ func (m *Params) GetHistoricalEntries() uint32 {
 	return 0
 }
 
-// Pool is used for tracking bonded and not-bonded token supply of the bond
-// denomination.
-type Pool struct {
-	NotBondedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=not_bonded_tokens,json=notBondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"not_bonded_tokens"`
-	BondedTokens    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=bonded_tokens,json=bondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bonded_tokens" yaml:"bonded_tokens"`
+func (m *Params) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
 }
 
-func (m *Pool) Reset()         { *m = Pool{} }
-func (m *Pool) String() string { return proto.CompactTextString(m) }
-func (*Pool) ProtoMessage()    {}
-func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{11}
+func (m *Params) GetEvidenceHandlers() []string {
+	if m != nil {
+		return m.EvidenceHandlers
+	}
+	return nil
 }
-func (m *Pool) XXX_Unmarshal(b []byte) error {
+
+// DefiMinter tracks the annual periods of the defi mint inflation.
+type DefiMinter struct {
+	// year is the number of annual periods elapsed, driving the decay schedule.
+	Year uint64 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// period_start is the start time of the current annual period.
+	PeriodStart time.Time `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3,stdtime" json:"period_start" yaml:"period_start"`
+	// period_provisions is the amount minted during the current annual period.
+	PeriodProvisions github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=period_provisions,json=periodProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"period_provisions" yaml:"period_provisions"`
+}
+
+func (m *DefiMinter) Reset()         { *m = DefiMinter{} }
+func (m *DefiMinter) String() string { return proto.CompactTextString(m) }
+func (*DefiMinter) ProtoMessage()    {}
+func (*DefiMinter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e68f0e8642f790a9, []int{19}
+}
+func (m *DefiMinter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DefiMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DefiMinter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DefiMinter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DefiMinter.Merge(m, src)
+}
+func (m *DefiMinter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DefiMinter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DefiMinter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DefiMinter proto.InternalMessageInfo
+
+func (m *DefiMinter) GetYear() uint64 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *DefiMinter) GetPeriodStart() time.Time {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return time.Time{}
+}
+
+// Pool is used for tracking bonded and not-bonded token supply of the bond
+// denomination.
+type Pool struct {
+	NotBondedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=not_bonded_tokens,json=notBondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"not_bonded_tokens"`
+	BondedTokens    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=bonded_tokens,json=bondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bonded_tokens" yaml:"bonded_tokens"`
+}
+
+func (m *Pool) Reset()         { *m = Pool{} }
+func (m *Pool) String() string { return proto.CompactTextString(m) }
+func (*Pool) ProtoMessage()    {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e68f0e8642f790a9, []int{20}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
@@ -742,11 +1214,12 @@ var xxx_messageInfo_Pool proto.InternalMessageInfo
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per defi for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per defi for the zeroeth period, set on initialization
 type DefiHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio" yaml:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty" yaml:"reference_count"`
@@ -756,7 +1229,7 @@ func (m *DefiHistoricalRewards) Reset()         { *m = DefiHistoricalRewards{} }
 func (m *DefiHistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*DefiHistoricalRewards) ProtoMessage()    {}
 func (*DefiHistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{12}
+	return fileDescriptor_e68f0e8642f790a9, []int{21}
 }
 func (m *DefiHistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +1284,7 @@ func (m *DefiCurrentRewards) Reset()         { *m = DefiCurrentRewards{} }
 func (m *DefiCurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*DefiCurrentRewards) ProtoMessage()    {}
 func (*DefiCurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{13}
+	return fileDescriptor_e68f0e8642f790a9, []int{22}
 }
 func (m *DefiCurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -864,7 +1337,7 @@ func (m *DefiOutstandingRewards) Reset()         { *m = DefiOutstandingRewards{}
 func (m *DefiOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*DefiOutstandingRewards) ProtoMessage()    {}
 func (*DefiOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{14}
+	return fileDescriptor_e68f0e8642f790a9, []int{23}
 }
 func (m *DefiOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -910,7 +1383,7 @@ func (m *DefiAccumulatedCommission) Reset()         { *m = DefiAccumulatedCommis
 func (m *DefiAccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*DefiAccumulatedCommission) ProtoMessage()    {}
 func (*DefiAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{15}
+	return fileDescriptor_e68f0e8642f790a9, []int{24}
 }
 func (m *DefiAccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -959,7 +1432,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{16}
+	return fileDescriptor_e68f0e8642f790a9, []int{25}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1002,6 +1475,55 @@ func (m *DelegatorStartingInfo) GetHeight() uint64 {
 	return 0
 }
 
+// DefiSlashEvent represents a defi slash event.
+// Height is implicit within the store key.
+// This is needed to calculate appropriate amount of staking tokens
+// for delegations which are withdrawn after a slash has occurred.
+type DefiSlashEvent struct {
+	DefiPeriod uint64                                 `protobuf:"varint,1,opt,name=defi_period,json=defiPeriod,proto3" json:"defi_period,omitempty" yaml:"defi_period"`
+	Fraction   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraction"`
+}
+
+func (m *DefiSlashEvent) Reset()         { *m = DefiSlashEvent{} }
+func (m *DefiSlashEvent) String() string { return proto.CompactTextString(m) }
+func (*DefiSlashEvent) ProtoMessage()    {}
+func (*DefiSlashEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e68f0e8642f790a9, []int{26}
+}
+func (m *DefiSlashEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DefiSlashEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DefiSlashEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DefiSlashEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DefiSlashEvent.Merge(m, src)
+}
+func (m *DefiSlashEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *DefiSlashEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DefiSlashEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DefiSlashEvent proto.InternalMessageInfo
+
+func (m *DefiSlashEvent) GetDefiPeriod() uint64 {
+	if m != nil {
+		return m.DefiPeriod
+	}
+	return 0
+}
+
 // DelegationDelegatorReward represents the properties
 // of a delegator's delegation reward.
 type DelegationDelegatorReward struct {
@@ -1013,7 +1535,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{17}
+	return fileDescriptor_e68f0e8642f790a9, []int{27}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1051,7 +1573,7 @@ func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e68f0e8642f790a9, []int{18}
+	return fileDescriptor_e68f0e8642f790a9, []int{28}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,146 +1609,251 @@ func (m *FeePool) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.DecCoins
 	return nil
 }
 
+// SlashDefiProposal defines a governance proposal slashing a fraction of the
+// tokens a defi held at the infraction height, and jailing the defi when jail
+// is true
+type SlashDefiProposal struct {
+	Title            string                                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string                                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DefiAddress      string                                 `protobuf:"bytes,3,opt,name=defi_address,json=defiAddress,proto3" json:"defi_address,omitempty" yaml:"defi_address"`
+	InfractionHeight int64                                  `protobuf:"varint,4,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty" yaml:"infraction_height"`
+	SlashFraction    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
+	Jail             bool                                   `protobuf:"varint,6,opt,name=jail,proto3" json:"jail,omitempty"`
+}
+
+func (m *SlashDefiProposal) Reset()      { *m = SlashDefiProposal{} }
+func (*SlashDefiProposal) ProtoMessage() {}
+func (*SlashDefiProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e68f0e8642f790a9, []int{29}
+}
+func (m *SlashDefiProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashDefiProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashDefiProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashDefiProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashDefiProposal.Merge(m, src)
+}
+func (m *SlashDefiProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashDefiProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashDefiProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashDefiProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("gauss.defi.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "gauss.defi.HistoricalInfo")
+	proto.RegisterType((*CommissionRates)(nil), "gauss.defi.CommissionRates")
+	proto.RegisterType((*Commission)(nil), "gauss.defi.Commission")
 	proto.RegisterType((*Description)(nil), "gauss.defi.Description")
 	proto.RegisterType((*Defi)(nil), "gauss.defi.Defi")
 	proto.RegisterType((*DefiAddresses)(nil), "gauss.defi.DefiAddresses")
 	proto.RegisterType((*DDPair)(nil), "gauss.defi.DDPair")
 	proto.RegisterType((*DDPairs)(nil), "gauss.defi.DDPairs")
+	proto.RegisterType((*DDDTriplet)(nil), "gauss.defi.DDDTriplet")
+	proto.RegisterType((*DDDTriplets)(nil), "gauss.defi.DDDTriplets")
 	proto.RegisterType((*Delegation)(nil), "gauss.defi.Delegation")
 	proto.RegisterType((*UnbondingDelegation)(nil), "gauss.defi.UnbondingDelegation")
 	proto.RegisterType((*UnbondingDelegationEntry)(nil), "gauss.defi.UnbondingDelegationEntry")
+	proto.RegisterType((*RedelegationEntry)(nil), "gauss.defi.RedelegationEntry")
+	proto.RegisterType((*Redelegation)(nil), "gauss.defi.Redelegation")
 	proto.RegisterType((*DelegationResponse)(nil), "gauss.defi.DelegationResponse")
+	proto.RegisterType((*RedelegationEntryResponse)(nil), "gauss.defi.RedelegationEntryResponse")
+	proto.RegisterType((*RedelegationResponse)(nil), "gauss.defi.RedelegationResponse")
 	proto.RegisterType((*Params)(nil), "gauss.defi.Params")
+	proto.RegisterType((*DefiMinter)(nil), "gauss.defi.DefiMinter")
 	proto.RegisterType((*Pool)(nil), "gauss.defi.Pool")
 	proto.RegisterType((*DefiHistoricalRewards)(nil), "gauss.defi.DefiHistoricalRewards")
 	proto.RegisterType((*DefiCurrentRewards)(nil), "gauss.defi.DefiCurrentRewards")
 	proto.RegisterType((*DefiOutstandingRewards)(nil), "gauss.defi.DefiOutstandingRewards")
 	proto.RegisterType((*DefiAccumulatedCommission)(nil), "gauss.defi.DefiAccumulatedCommission")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "gauss.defi.DelegatorStartingInfo")
+	proto.RegisterType((*DefiSlashEvent)(nil), "gauss.defi.DefiSlashEvent")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "gauss.defi.DelegationDelegatorReward")
 	proto.RegisterType((*FeePool)(nil), "gauss.defi.FeePool")
+	proto.RegisterType((*SlashDefiProposal)(nil), "gauss.defi.SlashDefiProposal")
 }
 
 func init() { proto.RegisterFile("gauss/defi/defi.proto", fileDescriptor_e68f0e8642f790a9) }
 
 var fileDescriptor_e68f0e8642f790a9 = []byte{
-	// 1801 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcb, 0x6f, 0x23, 0x49,
-	0x19, 0x77, 0xe7, 0xe1, 0x24, 0x95, 0xc4, 0x4e, 0x6a, 0x26, 0x89, 0xc7, 0xcc, 0xba, 0x4d, 0x09,
-	0xad, 0x22, 0x60, 0x6d, 0x66, 0x76, 0xc5, 0x6a, 0x23, 0x04, 0xac, 0xed, 0x84, 0x44, 0x1a, 0xcd,
-	0x44, 0x9d, 0x44, 0x2b, 0x71, 0x69, 0x95, 0xbb, 0xcb, 0x4e, 0x29, 0xee, 0x2e, 0x6f, 0x57, 0x79,
-	0x36, 0x41, 0x8b, 0xc4, 0x71, 0x95, 0x03, 0x2c, 0x27, 0x96, 0x43, 0xa4, 0x91, 0xb8, 0xc1, 0x1d,
-	0xfe, 0x85, 0x45, 0xe2, 0xb0, 0x47, 0x84, 0x90, 0x07, 0xcd, 0x5c, 0x60, 0x4f, 0x2b, 0xdf, 0x38,
-	0x81, 0xea, 0xd1, 0xdd, 0x76, 0x27, 0x30, 0xe3, 0x91, 0x56, 0x9a, 0x4b, 0xe2, 0xfa, 0xea, 0x7b,
-	0xd7, 0xef, 0x7b, 0xd8, 0x60, 0xa3, 0x8b, 0x07, 0x9c, 0xd7, 0x7d, 0xd2, 0xa1, 0xea, 0x4f, 0xad,
-	0x1f, 0x31, 0xc1, 0x20, 0x50, 0xe4, 0x9a, 0xa4, 0x94, 0x6f, 0x77, 0x59, 0x97, 0x29, 0x72, 0x5d,
-	0x7e, 0xd2, 0x1c, 0xe5, 0x3b, 0x5d, 0xc6, 0xba, 0x3d, 0x52, 0x57, 0xa7, 0xf6, 0xa0, 0x53, 0xc7,
-	0xe1, 0x85, 0xb9, 0xaa, 0x64, 0xaf, 0xfc, 0x41, 0x84, 0x05, 0x65, 0xa1, 0xb9, 0xb7, 0xb3, 0xf7,
-	0x82, 0x06, 0x84, 0x0b, 0x1c, 0xf4, 0x63, 0xdd, 0x1e, 0xe3, 0x01, 0xe3, 0xae, 0x36, 0xaa, 0x0f,
-	0xb1, 0x6e, 0x7d, 0xaa, 0xb7, 0x31, 0x27, 0xf5, 0xc7, 0xf7, 0xda, 0x44, 0xe0, 0x7b, 0x75, 0x8f,
-	0xd1, 0x58, 0xf7, 0x5d, 0x41, 0x42, 0x9f, 0x44, 0x01, 0x0d, 0x45, 0x5d, 0x5c, 0xf4, 0x09, 0xd7,
-	0x7f, 0xf5, 0x2d, 0xfa, 0x19, 0x28, 0xec, 0x53, 0x2e, 0x58, 0x44, 0x3d, 0xdc, 0x3b, 0x08, 0x3b,
-	0x0c, 0x7e, 0x1f, 0xe4, 0x4f, 0x09, 0xf6, 0x49, 0x54, 0xb2, 0xaa, 0xd6, 0xf6, 0xf2, 0xfd, 0x52,
-	0x2d, 0x55, 0x50, 0xd3, 0xa2, 0xfb, 0xea, 0xbe, 0x31, 0xf7, 0xf9, 0xd0, 0xce, 0x39, 0x86, 0x1b,
-	0x7e, 0x0f, 0x2c, 0xc8, 0xe4, 0x70, 0x22, 0x4a, 0x33, 0xd5, 0xd9, 0xed, 0xe5, 0xfb, 0x6b, 0xb5,
-	0x34, 0x65, 0xb5, 0x16, 0xe9, 0x50, 0x23, 0x10, 0xb3, 0xa1, 0x3f, 0x5b, 0x60, 0xb9, 0x45, 0xb8,
-	0x17, 0xd1, 0xbe, 0xcc, 0x05, 0x2c, 0x81, 0x85, 0x80, 0x85, 0xf4, 0xcc, 0x98, 0x5e, 0x72, 0xe2,
-	0x23, 0x2c, 0x83, 0x45, 0xea, 0x93, 0x50, 0x50, 0x71, 0x51, 0x9a, 0x51, 0x57, 0xc9, 0x59, 0x4a,
-	0x7d, 0x44, 0xda, 0x9c, 0x0a, 0x52, 0x9a, 0xd5, 0x52, 0xe6, 0x08, 0xf7, 0xc0, 0x1a, 0x27, 0xde,
-	0x20, 0xa2, 0xe2, 0xc2, 0xf5, 0x58, 0x28, 0xb0, 0x27, 0x4a, 0x73, 0x92, 0xa5, 0xf1, 0x8d, 0xd1,
-	0xd0, 0xde, 0xba, 0xc0, 0x41, 0x6f, 0x07, 0x65, 0x39, 0x90, 0x53, 0x8c, 0x49, 0x4d, 0x4d, 0x91,
-	0x16, 0x7c, 0x22, 0x30, 0xed, 0xf1, 0xd2, 0xbc, 0xb6, 0x60, 0x8e, 0x3b, 0x8b, 0x9f, 0x3d, 0xb1,
-	0x73, 0xff, 0x7c, 0x62, 0x5b, 0xe8, 0x4f, 0xf3, 0x60, 0x4e, 0xc6, 0x28, 0x8d, 0xb2, 0x3e, 0x89,
-	0xb0, 0x60, 0x91, 0x8b, 0x7d, 0x3f, 0x22, 0x9c, 0xeb, 0x68, 0xc6, 0x8d, 0x66, 0x39, 0x90, 0x53,
-	0x8c, 0x49, 0xef, 0x6b, 0x0a, 0xac, 0x81, 0x3c, 0x17, 0x58, 0x0c, 0xb8, 0x0a, 0xb8, 0x70, 0x7f,
-	0x73, 0x3c, 0x9b, 0x0d, 0x16, 0xfa, 0x47, 0xea, 0xd6, 0x31, 0x5c, 0x70, 0x0f, 0xe4, 0x05, 0x3b,
-	0x23, 0x21, 0xd7, 0x59, 0x68, 0xd4, 0x64, 0xae, 0xff, 0x36, 0xb4, 0xdf, 0xec, 0x52, 0x71, 0x3a,
-	0x68, 0xd7, 0x3c, 0x16, 0x18, 0xdc, 0x98, 0x7f, 0x6f, 0x71, 0xff, 0xcc, 0x40, 0xe1, 0x20, 0x14,
-	0x8e, 0x91, 0x86, 0x02, 0xac, 0xf9, 0xa4, 0x47, 0xba, 0xca, 0x3d, 0x7e, 0x8a, 0x23, 0xc2, 0x4d,
-	0xd2, 0x0e, 0xa6, 0xd0, 0xd8, 0x22, 0x5e, 0x1a, 0x6d, 0x56, 0x1f, 0x72, 0x8a, 0x09, 0xe9, 0x48,
-	0x51, 0xe0, 0x8f, 0xc0, 0xb2, 0x9f, 0x22, 0xa1, 0xb4, 0xa0, 0x90, 0xb7, 0x35, 0x09, 0xa0, 0xe4,
-	0xda, 0xe0, 0x68, 0x5c, 0x42, 0xa6, 0x7d, 0x10, 0xb6, 0x59, 0xe8, 0xd3, 0xb0, 0xeb, 0x9e, 0x12,
-	0xda, 0x3d, 0x15, 0xa5, 0xc5, 0xaa, 0xb5, 0x3d, 0x3b, 0x9e, 0xf6, 0x2c, 0x07, 0x72, 0x8a, 0x09,
-	0x69, 0x5f, 0x51, 0xa0, 0x0f, 0x0a, 0x29, 0x97, 0xac, 0xc2, 0xd2, 0x92, 0xf2, 0xa5, 0x5c, 0xd3,
-	0x25, 0x5a, 0x8b, 0x4b, 0xb4, 0x76, 0x1c, 0x97, 0x68, 0xe3, 0x9b, 0xd2, 0x9d, 0xd1, 0xd0, 0xde,
-	0xc8, 0x5a, 0x91, 0xf2, 0xe8, 0xd3, 0xa7, 0xb6, 0xe5, 0xac, 0x26, 0x44, 0x29, 0x06, 0x3f, 0x06,
-	0xb7, 0x02, 0x1a, 0xba, 0x9c, 0xf4, 0x3a, 0xae, 0x49, 0x85, 0x0c, 0x7b, 0x59, 0xe5, 0xf9, 0xc1,
-	0x74, 0x2f, 0x37, 0x1a, 0xda, 0x65, 0x6d, 0xf8, 0x06, 0x95, 0xc8, 0x59, 0x0f, 0x68, 0x78, 0x44,
-	0x7a, 0x9d, 0x56, 0x42, 0xdb, 0x59, 0xf9, 0xe4, 0x89, 0x9d, 0x33, 0xc8, 0xcd, 0xa1, 0x77, 0xc1,
-	0xaa, 0x04, 0xae, 0xc1, 0x1d, 0xe1, 0xf0, 0x2e, 0x58, 0xc2, 0xf1, 0xa1, 0x64, 0x55, 0x67, 0xb7,
-	0x97, 0x9c, 0x94, 0xa0, 0x21, 0xff, 0x8b, 0xbf, 0x57, 0x2d, 0x74, 0x65, 0x81, 0x7c, 0xab, 0x75,
-	0x88, 0x69, 0x04, 0x0f, 0xc0, 0x7a, 0xfa, 0xc8, 0x93, 0xa8, 0xbf, 0x3b, 0x1a, 0xda, 0xa5, 0x2c,
-	0x0e, 0x12, 0xd8, 0xa7, 0x58, 0x8b, 0x71, 0xbf, 0x03, 0x56, 0xe4, 0x7b, 0x27, 0x5a, 0x54, 0xb9,
-	0x37, 0xb6, 0x46, 0x43, 0xfb, 0x56, 0xac, 0x25, 0xbd, 0x45, 0x12, 0x04, 0x89, 0xef, 0x99, 0xc0,
-	0xde, 0x03, 0x0b, 0xda, 0x3d, 0x59, 0x4c, 0xf3, 0x7d, 0xf9, 0x41, 0x85, 0xb3, 0x7c, 0x1f, 0x4e,
-	0x00, 0x4b, 0xf1, 0x18, 0x4c, 0x69, 0x36, 0xf4, 0x2f, 0x0b, 0x80, 0x34, 0x61, 0xaf, 0x49, 0x78,
-	0xb2, 0xc4, 0x4d, 0x41, 0x4e, 0x5f, 0xe2, 0x2d, 0xe2, 0x39, 0x46, 0x3a, 0x93, 0xa6, 0xaf, 0x2c,
-	0x70, 0xeb, 0x24, 0x46, 0xe7, 0xeb, 0x17, 0x74, 0x0b, 0x2c, 0x90, 0x50, 0x44, 0x54, 0x45, 0x2d,
-	0x1f, 0xef, 0x5b, 0xe3, 0x8f, 0x77, 0x83, 0xe3, 0xbb, 0xa1, 0x88, 0x2e, 0xe2, 0x51, 0x63, 0x44,
-	0x33, 0x21, 0xff, 0x6a, 0x16, 0x94, 0xfe, 0x97, 0x24, 0x6c, 0x82, 0xa2, 0x17, 0x11, 0x45, 0x88,
-	0x1b, 0x89, 0xa5, 0x1a, 0x49, 0x79, 0x34, 0xb4, 0x37, 0xb5, 0xbf, 0x19, 0x06, 0xe4, 0x14, 0x62,
-	0x8a, 0x69, 0x23, 0x5d, 0x50, 0xf4, 0x58, 0xd0, 0xef, 0x11, 0xc5, 0xa5, 0xfa, 0xc8, 0xcc, 0x0b,
-	0xfb, 0x08, 0x32, 0x7d, 0x24, 0x36, 0x32, 0xa9, 0x40, 0x37, 0x92, 0x42, 0x4a, 0x55, 0x9d, 0xe4,
-	0x43, 0x50, 0xa4, 0x21, 0x15, 0x14, 0xf7, 0xdc, 0x36, 0xee, 0xe1, 0xd0, 0x33, 0x53, 0xb0, 0xb1,
-	0x3f, 0x75, 0x17, 0x31, 0x66, 0x33, 0xea, 0x90, 0x53, 0x30, 0x94, 0x86, 0x26, 0xc0, 0x7d, 0xb0,
-	0x10, 0x9b, 0x9a, 0x7b, 0xa5, 0x51, 0x13, 0x8b, 0x8f, 0x8d, 0xcf, 0xdf, 0x58, 0x00, 0xa6, 0x0f,
-	0xe1, 0x10, 0xde, 0x67, 0x21, 0x27, 0xf0, 0x07, 0x00, 0x8c, 0xb5, 0x47, 0xbd, 0x8f, 0x6c, 0x4e,
-	0x4e, 0x85, 0xf8, 0xd6, 0xbc, 0xf8, 0x18, 0x3f, 0x7c, 0x2f, 0x75, 0x54, 0x27, 0xff, 0x4e, 0xcd,
-	0x6c, 0x4e, 0x72, 0x57, 0xaa, 0x99, 0x5d, 0xa9, 0xd6, 0x64, 0x34, 0x96, 0xbe, 0xe6, 0x59, 0x0e,
-	0x3d, 0x9d, 0x07, 0xf9, 0x43, 0x1c, 0xe1, 0x80, 0xc3, 0x77, 0x00, 0x90, 0x98, 0x71, 0x7d, 0x12,
-	0xb2, 0xc0, 0x94, 0xc2, 0xc6, 0x68, 0x68, 0xaf, 0xeb, 0xc4, 0xa5, 0x77, 0xc8, 0x59, 0x92, 0x87,
-	0x96, 0xfc, 0x0c, 0x5d, 0x50, 0x90, 0xab, 0x93, 0x4b, 0xc3, 0x4e, 0x4f, 0xc7, 0xf1, 0x42, 0x67,
-	0xde, 0x98, 0x1c, 0x28, 0x93, 0xe2, 0xc8, 0x59, 0x95, 0x84, 0x83, 0xf8, 0x0c, 0xcf, 0xc0, 0xaa,
-	0xc7, 0x82, 0x60, 0x10, 0xca, 0x2d, 0x46, 0xe0, 0x73, 0x03, 0x80, 0xbd, 0xa9, 0xc7, 0xf5, 0xed,
-	0x04, 0x77, 0xa9, 0x32, 0xe4, 0xac, 0x24, 0xe7, 0x63, 0x7c, 0x0e, 0x3f, 0x50, 0xc0, 0x0e, 0x28,
-	0xe7, 0x12, 0x97, 0x11, 0x16, 0xaf, 0x02, 0x02, 0xd9, 0x8c, 0x0a, 0xa9, 0x1a, 0x07, 0x0b, 0x02,
-	0x1f, 0x81, 0xe5, 0x00, 0x47, 0x67, 0x44, 0x68, 0xa5, 0xf3, 0xaf, 0xa4, 0x14, 0x68, 0x15, 0x4a,
-	0xa1, 0x77, 0x6d, 0x92, 0xe7, 0x4d, 0xde, 0xb3, 0x15, 0xd8, 0x32, 0xcb, 0xf8, 0x0b, 0x06, 0xf9,
-	0x67, 0x37, 0x0c, 0xf2, 0x7b, 0x60, 0x29, 0xc0, 0xe7, 0xae, 0xda, 0x68, 0xd5, 0xd6, 0xb2, 0xda,
-	0xb8, 0x3d, 0x1a, 0xda, 0x6b, 0xe6, 0xe1, 0xe2, 0x2b, 0xe4, 0x2c, 0x06, 0xf8, 0x5c, 0x8e, 0x59,
-	0x0e, 0xdf, 0x95, 0x81, 0x9e, 0xbb, 0x71, 0x53, 0x5b, 0x54, 0x42, 0x9b, 0xa3, 0xa1, 0x0d, 0x53,
-	0x21, 0x73, 0x89, 0x64, 0x40, 0xe7, 0xbb, 0xfa, 0x00, 0x1f, 0x00, 0x78, 0x9a, 0xac, 0xea, 0x89,
-	0xfc, 0x92, 0x92, 0x7f, 0x63, 0x34, 0xb4, 0xef, 0x68, 0xf9, 0xeb, 0x3c, 0xc8, 0x59, 0x4f, 0x89,
-	0x46, 0xdb, 0x58, 0xed, 0xfd, 0xc7, 0x02, 0x73, 0x87, 0x8c, 0xf5, 0x20, 0x03, 0xeb, 0x21, 0x13,
-	0xae, 0x8c, 0x8f, 0xf8, 0xae, 0xd9, 0x26, 0x35, 0xcc, 0x9b, 0xd3, 0x95, 0xf8, 0x97, 0x43, 0xfb,
-	0xba, 0x2a, 0xa7, 0x18, 0x32, 0xd1, 0x50, 0x94, 0x63, 0xbd, 0x6b, 0x7e, 0x0c, 0x56, 0x27, 0x8d,
-	0xe9, 0xc1, 0xf0, 0xc1, 0xd4, 0xc6, 0x26, 0xd5, 0xa4, 0x50, 0x9e, 0x20, 0x23, 0x67, 0xa5, 0x3d,
-	0x66, 0x7d, 0x67, 0x51, 0x46, 0xff, 0x95, 0xcc, 0xc0, 0xe5, 0x0c, 0xd8, 0x90, 0x8f, 0x93, 0x7e,
-	0x13, 0x72, 0xc8, 0x47, 0x38, 0xf2, 0x39, 0xfc, 0x83, 0x05, 0xb6, 0xbc, 0x41, 0x30, 0x90, 0xa5,
-	0xf6, 0x98, 0xb8, 0x91, 0x22, 0xbb, 0x0a, 0x2e, 0x66, 0x97, 0xb8, 0x7b, 0x63, 0x19, 0xb7, 0x88,
-	0xa7, 0x2a, 0xf9, 0xc4, 0x20, 0xaa, 0x62, 0x4a, 0xeb, 0x66, 0x55, 0xe8, 0xf7, 0x4f, 0xed, 0xef,
-	0xbc, 0x1c, 0xc4, 0xa5, 0x56, 0xee, 0x6c, 0xa4, 0x8a, 0xb4, 0xa7, 0x8e, 0x54, 0x23, 0x47, 0x57,
-	0x44, 0x3a, 0x24, 0x22, 0xa1, 0x47, 0x5c, 0x8f, 0x0d, 0x42, 0xa1, 0x32, 0xba, 0x3a, 0x3e, 0xba,
-	0x32, 0x0c, 0xc8, 0x29, 0x24, 0x94, 0xa6, 0x22, 0xfc, 0x56, 0xb5, 0xe2, 0x0e, 0x6d, 0x0e, 0xa2,
-	0x88, 0x84, 0x22, 0xce, 0xc4, 0x19, 0x58, 0xd0, 0x2e, 0xf3, 0x97, 0x0a, 0xfc, 0x6d, 0x19, 0xf8,
-	0xb4, 0x61, 0xc5, 0x16, 0xe0, 0x26, 0xc8, 0xf7, 0x49, 0x44, 0x99, 0xaf, 0xfc, 0x9f, 0x73, 0xcc,
-	0x49, 0x8e, 0x89, 0x4d, 0xe9, 0xdb, 0xa3, 0x81, 0xe0, 0x02, 0xab, 0x32, 0x8c, 0xfd, 0xfb, 0xf9,
-	0x74, 0xfe, 0xed, 0x9a, 0x87, 0x29, 0xc4, 0x59, 0x51, 0xa2, 0xe8, 0x55, 0x3d, 0x46, 0xbf, 0xb4,
-	0xc0, 0x1d, 0xb5, 0x46, 0x7b, 0xe6, 0x69, 0x88, 0xdf, 0x4c, 0x1a, 0x1c, 0xfc, 0x10, 0x80, 0xb4,
-	0xdd, 0x7d, 0x7d, 0xf9, 0x1b, 0x33, 0x82, 0xfe, 0x6d, 0x49, 0x4c, 0xc7, 0xdf, 0xb2, 0x04, 0x8e,
-	0x04, 0x0d, 0xbb, 0xea, 0x0b, 0x7e, 0x13, 0x14, 0xfb, 0x11, 0x79, 0x4c, 0xd9, 0x80, 0xbb, 0x26,
-	0xcb, 0xb2, 0xc8, 0xe7, 0xc6, 0x51, 0x92, 0x61, 0x40, 0x4e, 0x21, 0xa6, 0x1c, 0x2a, 0x02, 0x3c,
-	0x06, 0xf3, 0x5c, 0xe0, 0x33, 0x62, 0x4a, 0xf6, 0x87, 0x53, 0x0f, 0x9b, 0x15, 0xf3, 0xf5, 0x5b,
-	0x2a, 0x41, 0x8e, 0x56, 0x06, 0x77, 0x41, 0xde, 0xac, 0x5c, 0xb3, 0xca, 0xa3, 0xb7, 0xbe, 0x1c,
-	0xda, 0xd9, 0x6d, 0xec, 0xff, 0x6c, 0x61, 0x46, 0x18, 0xfd, 0x45, 0x3d, 0x46, 0xbc, 0x07, 0x24,
-	0x59, 0xd0, 0x50, 0xb9, 0xb6, 0x8d, 0x5a, 0x53, 0x6c, 0xa3, 0x14, 0xe4, 0xf5, 0x8b, 0x9b, 0xdf,
-	0x38, 0xbe, 0x86, 0x47, 0x34, 0x06, 0x76, 0x16, 0xcd, 0xca, 0xaa, 0xbe, 0x68, 0x2d, 0xec, 0x11,
-	0xa2, 0x7a, 0xf4, 0xaf, 0x2d, 0x50, 0x48, 0x07, 0x74, 0x9f, 0xb1, 0xde, 0x4b, 0xc1, 0xe9, 0xc1,
-	0xe4, 0x64, 0x9b, 0xd4, 0x30, 0x35, 0xea, 0xd3, 0x7d, 0x43, 0xfa, 0xf4, 0xed, 0x3f, 0x5a, 0x00,
-	0xa4, 0xbf, 0x48, 0xc0, 0xef, 0x82, 0xad, 0xc6, 0xa3, 0x87, 0x2d, 0xf7, 0xe8, 0xf8, 0xfd, 0xe3,
-	0x93, 0x23, 0xf7, 0xe4, 0xe1, 0xd1, 0xe1, 0x6e, 0xf3, 0x60, 0xef, 0x60, 0xb7, 0xb5, 0x96, 0x2b,
-	0x17, 0x2f, 0xaf, 0xaa, 0xcb, 0x27, 0x21, 0xef, 0x13, 0x8f, 0x76, 0x28, 0xf1, 0xe1, 0x9b, 0xe0,
-	0xf6, 0x24, 0xb7, 0x3c, 0xed, 0xb6, 0xd6, 0xac, 0xf2, 0xca, 0xe5, 0x55, 0x75, 0x51, 0xaf, 0xe9,
-	0xc4, 0x87, 0xdb, 0x60, 0xe3, 0x3a, 0xdf, 0xc1, 0xc3, 0x9f, 0xac, 0xcd, 0x94, 0x57, 0x2f, 0xaf,
-	0xaa, 0x4b, 0xc9, 0x3e, 0x0f, 0x11, 0x80, 0xe3, 0x9c, 0x46, 0xdf, 0x6c, 0x19, 0x5c, 0x5e, 0x55,
-	0xf3, 0x7a, 0xfe, 0x94, 0xe7, 0x3e, 0xf9, 0x5d, 0x25, 0xd7, 0xf8, 0xf1, 0xe7, 0xcf, 0x2a, 0xd6,
-	0x17, 0xcf, 0x2a, 0xd6, 0x3f, 0x9e, 0x55, 0xac, 0x4f, 0x9f, 0x57, 0x72, 0x5f, 0x3c, 0xaf, 0xe4,
-	0xfe, 0xfa, 0xbc, 0x92, 0xfb, 0xe9, 0x38, 0x8e, 0xf5, 0xef, 0x81, 0xfa, 0xef, 0xe3, 0x77, 0xea,
-	0xe7, 0xfa, 0xa7, 0x41, 0x95, 0x92, 0x76, 0x5e, 0x2d, 0x11, 0x6f, 0xff, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x70, 0x8f, 0xfd, 0x6d, 0x35, 0x14, 0x00, 0x00,
+	// 2596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1a, 0x4b, 0x6c, 0x24, 0x47,
+	0xd5, 0xed, 0x99, 0xb5, 0xc7, 0x6f, 0xec, 0x19, 0xbb, 0x62, 0x7b, 0x7b, 0xbd, 0x1b, 0x8f, 0x69,
+	0x41, 0xb4, 0x02, 0x32, 0x26, 0x9b, 0x88, 0x90, 0x15, 0xbf, 0xd8, 0xe3, 0xcd, 0x3a, 0xca, 0xc7,
+	0x2a, 0xdb, 0x42, 0x02, 0x44, 0x53, 0xee, 0xae, 0x19, 0x17, 0x9e, 0xe9, 0x9e, 0x74, 0xd5, 0x38,
+	0x36, 0x0a, 0x82, 0x63, 0xb4, 0x12, 0x10, 0x0e, 0x88, 0x70, 0x58, 0x29, 0x12, 0x07, 0x24, 0x38,
+	0x70, 0x41, 0x5c, 0x39, 0x21, 0x05, 0x89, 0x43, 0x8e, 0x08, 0xa1, 0x09, 0x4a, 0x38, 0x40, 0x24,
+	0xa4, 0xc8, 0x9c, 0x38, 0x81, 0xea, 0xd3, 0xdf, 0x71, 0xb2, 0x3b, 0x26, 0x91, 0x22, 0xc4, 0xc5,
+	0x3b, 0xf5, 0xea, 0x7d, 0xea, 0x7d, 0xea, 0xd5, 0x7b, 0xaf, 0x17, 0x96, 0x3a, 0x64, 0xc0, 0xf9,
+	0xba, 0x4f, 0xdb, 0x4c, 0xfd, 0x69, 0xf6, 0xa3, 0x50, 0x84, 0x08, 0x14, 0xb8, 0x29, 0x21, 0x2b,
+	0x8b, 0x9d, 0xb0, 0x13, 0x2a, 0xf0, 0xba, 0xfc, 0xa5, 0x31, 0x56, 0xae, 0x74, 0xc2, 0xb0, 0xd3,
+	0xa5, 0xeb, 0x6a, 0x75, 0x30, 0x68, 0xaf, 0x93, 0xe0, 0xd4, 0x6c, 0xad, 0x16, 0xb7, 0xfc, 0x41,
+	0x44, 0x04, 0x0b, 0x03, 0xb3, 0xdf, 0x28, 0xee, 0x0b, 0xd6, 0xa3, 0x5c, 0x90, 0x5e, 0x3f, 0xe6,
+	0xed, 0x85, 0xbc, 0x17, 0x72, 0x57, 0x0b, 0xd5, 0x8b, 0x98, 0xb7, 0x5e, 0xad, 0x1f, 0x10, 0x4e,
+	0xd7, 0x8f, 0x1f, 0x39, 0xa0, 0x82, 0x3c, 0xb2, 0xee, 0x85, 0x2c, 0xe6, 0x7d, 0x4d, 0xd0, 0xc0,
+	0xa7, 0x51, 0x8f, 0x05, 0x62, 0x5d, 0x9c, 0xf6, 0x29, 0xd7, 0x7f, 0xf5, 0xae, 0xf3, 0x6d, 0xa8,
+	0xdd, 0x66, 0x5c, 0x84, 0x11, 0xf3, 0x48, 0x77, 0x3b, 0x68, 0x87, 0xe8, 0xb3, 0x30, 0x75, 0x48,
+	0x89, 0x4f, 0x23, 0xdb, 0x5a, 0xb3, 0xae, 0x57, 0x6f, 0xd8, 0xcd, 0x94, 0x41, 0x53, 0x93, 0xde,
+	0x56, 0xfb, 0x1b, 0xe5, 0xd7, 0x87, 0x8d, 0x09, 0x6c, 0xb0, 0xd1, 0x67, 0x60, 0x5a, 0x1a, 0x87,
+	0x53, 0x61, 0x4f, 0xae, 0x95, 0xae, 0x57, 0x6f, 0xcc, 0x37, 0x53, 0x93, 0x35, 0x5b, 0xb4, 0xcd,
+	0x0c, 0x41, 0x8c, 0xe6, 0xfc, 0x6a, 0x12, 0xea, 0x9b, 0x61, 0xaf, 0xc7, 0x38, 0x67, 0x61, 0x80,
+	0x89, 0xa0, 0x1c, 0x6d, 0x40, 0x39, 0x22, 0x82, 0x2a, 0xd9, 0x33, 0x1b, 0x4d, 0x49, 0xf0, 0xa7,
+	0x61, 0xe3, 0xa1, 0x0e, 0x13, 0x87, 0x83, 0x83, 0xa6, 0x17, 0xf6, 0x8c, 0xf2, 0xe6, 0x9f, 0x87,
+	0xb9, 0x7f, 0x64, 0xf4, 0x69, 0x51, 0x0f, 0x2b, 0x5a, 0xf4, 0x75, 0xa8, 0xf4, 0xc8, 0x89, 0xab,
+	0xf8, 0x4c, 0x2a, 0x3e, 0x4f, 0x8e, 0xc7, 0xe7, 0x6c, 0xd8, 0xa8, 0x9f, 0x92, 0x5e, 0xf7, 0xa6,
+	0x13, 0xf3, 0x71, 0xf0, 0x74, 0x8f, 0x9c, 0xc8, 0x23, 0xa2, 0x3e, 0xd4, 0x25, 0xd4, 0x3b, 0x24,
+	0x41, 0x87, 0x6a, 0x21, 0x25, 0x25, 0xe4, 0xf6, 0xd8, 0x42, 0x96, 0x53, 0x21, 0x19, 0x76, 0x0e,
+	0x9e, 0xeb, 0x91, 0x93, 0x4d, 0x05, 0x90, 0x12, 0x6f, 0x56, 0x5e, 0x7d, 0xad, 0x31, 0xf1, 0xb7,
+	0xd7, 0x1a, 0x96, 0xf3, 0x3b, 0x0b, 0x20, 0xb5, 0x18, 0xda, 0x81, 0x79, 0x2f, 0x59, 0x29, 0x5a,
+	0x6e, 0x9c, 0x76, 0x35, 0x6b, 0xfb, 0x82, 0x8d, 0x37, 0x2a, 0xf2, 0xa0, 0x6f, 0x0c, 0x1b, 0x16,
+	0xae, 0x7b, 0x05, 0xf3, 0x7f, 0x0d, 0xaa, 0x83, 0xbe, 0x4f, 0x04, 0x75, 0x65, 0x04, 0x2a, 0xeb,
+	0x55, 0x6f, 0xac, 0x34, 0x75, 0x78, 0x36, 0xe3, 0xf0, 0x6c, 0xee, 0xc5, 0xe1, 0xb9, 0xb1, 0x2a,
+	0x79, 0x9d, 0x0d, 0x1b, 0x48, 0xab, 0x92, 0x21, 0x76, 0x5e, 0x79, 0xb3, 0x61, 0x61, 0xd0, 0x10,
+	0x49, 0x90, 0xd1, 0xe3, 0xf7, 0x16, 0x54, 0x5b, 0x94, 0x7b, 0x11, 0xeb, 0xcb, 0x5b, 0x80, 0x6c,
+	0x98, 0xee, 0x85, 0x01, 0x3b, 0x32, 0x41, 0x37, 0x83, 0xe3, 0x25, 0x5a, 0x81, 0x0a, 0xf3, 0x69,
+	0x20, 0x98, 0x38, 0xd5, 0xbe, 0xc4, 0xc9, 0x5a, 0x52, 0xbd, 0x48, 0x0f, 0x38, 0x8b, 0x3d, 0x80,
+	0xe3, 0x25, 0xba, 0x05, 0xf3, 0x9c, 0x7a, 0x83, 0x88, 0x89, 0x53, 0xd7, 0x0b, 0x03, 0x41, 0x3c,
+	0x61, 0x97, 0x95, 0x93, 0xae, 0x9e, 0x0d, 0x1b, 0x97, 0xf5, 0x59, 0x8b, 0x18, 0x0e, 0xae, 0xc7,
+	0xa0, 0x4d, 0x0d, 0x91, 0x12, 0x7c, 0x2a, 0x08, 0xeb, 0x72, 0xfb, 0x92, 0x96, 0x60, 0x96, 0x19,
+	0x5d, 0xfe, 0x31, 0x05, 0x65, 0x19, 0xdd, 0x52, 0x68, 0xd8, 0xa7, 0x11, 0x11, 0x61, 0xe4, 0x12,
+	0xdf, 0x8f, 0x28, 0xe7, 0xb6, 0x55, 0x14, 0x5a, 0xc4, 0x70, 0x70, 0x3d, 0x06, 0x3d, 0xa9, 0x21,
+	0xa8, 0x09, 0x53, 0x5c, 0x10, 0x31, 0xe0, 0x4a, 0xe1, 0xda, 0x8d, 0xe5, 0xac, 0x2f, 0x37, 0xc2,
+	0xc0, 0xdf, 0x55, 0xbb, 0xd8, 0x60, 0xa1, 0x5b, 0x30, 0x25, 0xc2, 0x23, 0x1a, 0x70, 0xbb, 0x34,
+	0xf6, 0xa5, 0xd9, 0x0e, 0x04, 0x36, 0xd4, 0x48, 0xc0, 0xbc, 0x4f, 0xbb, 0xb4, 0xa3, 0x8e, 0xc7,
+	0x0f, 0x49, 0x44, 0xb9, 0x31, 0xda, 0xf6, 0xd8, 0x91, 0x6d, 0xb4, 0x2d, 0xf2, 0x73, 0x70, 0x3d,
+	0x01, 0xed, 0x2a, 0x08, 0x5a, 0x86, 0xa9, 0x6f, 0x11, 0xd6, 0xa5, 0xbe, 0xb2, 0x70, 0x05, 0x9b,
+	0x15, 0xfa, 0x06, 0xcc, 0xea, 0x5f, 0xee, 0x20, 0x10, 0xac, 0x6b, 0x4f, 0xdd, 0x33, 0x14, 0x1b,
+	0x26, 0x14, 0x1f, 0xd0, 0xb2, 0xb3, 0xd4, 0x3a, 0x16, 0xab, 0x1a, 0xb4, 0x2f, 0x21, 0xe8, 0x4b,
+	0x50, 0xf5, 0xd3, 0x08, 0xb4, 0xa7, 0x15, 0xfb, 0xcb, 0xf9, 0x94, 0x95, 0x6c, 0x9b, 0xcc, 0x95,
+	0xa5, 0x90, 0xee, 0x1e, 0x04, 0x07, 0x61, 0xe0, 0xb3, 0xa0, 0xe3, 0x1e, 0x52, 0xd6, 0x39, 0x14,
+	0x76, 0x65, 0xcd, 0xba, 0x5e, 0xca, 0xba, 0xbb, 0x88, 0xe1, 0xe0, 0x7a, 0x02, 0xba, 0xad, 0x20,
+	0xc8, 0x87, 0x5a, 0x8a, 0xa5, 0x6e, 0xdd, 0xcc, 0x3d, 0x55, 0xfd, 0x98, 0x51, 0x75, 0xa9, 0x28,
+	0x25, 0xbd, 0x78, 0x73, 0x09, 0x50, 0x92, 0xa1, 0xcf, 0x03, 0xa4, 0x77, 0xdd, 0x06, 0x25, 0x61,
+	0xf9, 0xfc, 0x24, 0x61, 0x94, 0xcd, 0xe0, 0xa3, 0x97, 0xe0, 0x81, 0x1e, 0x0b, 0x5c, 0x4e, 0xbb,
+	0x6d, 0xd7, 0x38, 0x50, 0xb2, 0xa9, 0xaa, 0xe8, 0x78, 0x66, 0xbc, 0x78, 0x3b, 0x1b, 0x36, 0x56,
+	0x4c, 0xde, 0x1b, 0x65, 0xe9, 0xe0, 0x85, 0x1e, 0x0b, 0x76, 0x69, 0xb7, 0xdd, 0x4a, 0x60, 0x37,
+	0x67, 0x5f, 0x7e, 0xad, 0x31, 0x61, 0xee, 0xdb, 0x84, 0xf3, 0x38, 0xcc, 0xc9, 0xeb, 0x66, 0x6e,
+	0x0b, 0xe5, 0xe8, 0x1a, 0xcc, 0x90, 0x78, 0x61, 0x5b, 0x6b, 0xa5, 0xeb, 0x33, 0x38, 0x05, 0xe8,
+	0x8b, 0xfa, 0xbd, 0x3f, 0xaf, 0x59, 0xce, 0x5d, 0x0b, 0xa6, 0x5a, 0xad, 0x1d, 0xc2, 0x22, 0xb4,
+	0x0d, 0x0b, 0x69, 0x68, 0xe6, 0xef, 0xea, 0xb5, 0xb3, 0x61, 0xc3, 0x2e, 0x46, 0x6f, 0x72, 0x59,
+	0xd3, 0x1b, 0x12, 0xdf, 0xd6, 0x9b, 0x30, 0x2b, 0xed, 0x97, 0x70, 0xd1, 0x0f, 0xce, 0xe5, 0x34,
+	0x0e, 0xb3, 0xbb, 0x8e, 0x0c, 0xa1, 0xe4, 0xec, 0x05, 0xc5, 0x9e, 0x80, 0x69, 0x7d, 0x3c, 0x99,
+	0x02, 0x2e, 0xf5, 0xe5, 0x0f, 0xa5, 0x4e, 0xf5, 0x06, 0xca, 0x85, 0xa5, 0xc2, 0x31, 0x4e, 0xd2,
+	0x68, 0xce, 0x3f, 0x2d, 0x80, 0x56, 0xab, 0xb5, 0x17, 0xb1, 0x7e, 0x97, 0x8a, 0x0f, 0x52, 0xbd,
+	0x2d, 0x99, 0x14, 0xda, 0xcc, 0xe5, 0x91, 0x57, 0x50, 0xf1, 0x6a, 0xf6, 0x9a, 0xe7, 0x31, 0x1c,
+	0x5c, 0x93, 0xa0, 0xdd, 0xc8, 0x2b, 0xb2, 0xf1, 0xb9, 0x48, 0xd8, 0x94, 0xce, 0x65, 0x93, 0xc1,
+	0x30, 0x6c, 0x5a, 0x5c, 0x9c, 0x6f, 0xb0, 0xa7, 0xa0, 0x9a, 0x2a, 0xcd, 0xd1, 0xe7, 0xa0, 0x22,
+	0xcc, 0x6f, 0x63, 0xb7, 0xe5, 0xbc, 0xdd, 0x62, 0x54, 0x63, 0xbb, 0x04, 0xdb, 0xf9, 0xbb, 0x34,
+	0x5f, 0x12, 0x6f, 0x1f, 0x91, 0xe8, 0x90, 0x79, 0xdd, 0x64, 0xe1, 0xd2, 0x85, 0x8a, 0x21, 0x43,
+	0x5d, 0x30, 0xda, 0xbb, 0x16, 0x3c, 0xb0, 0x1f, 0xa7, 0x86, 0x8f, 0x9e, 0xd2, 0x2d, 0x98, 0xa6,
+	0x81, 0x88, 0x98, 0xd2, 0x5a, 0xfa, 0xf0, 0xe3, 0x59, 0x1f, 0x9e, 0x73, 0xf0, 0xad, 0x40, 0x44,
+	0xa7, 0x71, 0x65, 0x69, 0x48, 0x0b, 0x2a, 0xff, 0xb0, 0x04, 0xf6, 0x7b, 0x51, 0xa2, 0x4d, 0xa8,
+	0x7b, 0x11, 0x55, 0x80, 0x38, 0x8b, 0x5b, 0x2a, 0x8b, 0xaf, 0xa4, 0x05, 0x5a, 0x01, 0xc1, 0xc1,
+	0xb5, 0x18, 0x62, 0x72, 0x78, 0x07, 0x64, 0x25, 0x25, 0x83, 0x49, 0x62, 0xdd, 0x67, 0xe9, 0xe4,
+	0x98, 0x24, 0x1e, 0x0b, 0xc9, 0x33, 0xd0, 0x59, 0xbc, 0x96, 0x42, 0x55, 0x1a, 0x7f, 0x01, 0xea,
+	0x2c, 0x60, 0x82, 0x91, 0xae, 0x7b, 0x40, 0xba, 0x24, 0xf0, 0x2e, 0x52, 0x7c, 0xea, 0x24, 0x6c,
+	0xc4, 0x16, 0xd8, 0x39, 0xb8, 0x66, 0x20, 0x1b, 0x1a, 0x80, 0x6e, 0xc3, 0x74, 0x2c, 0xaa, 0x7c,
+	0xa1, 0xfa, 0x22, 0x26, 0xcf, 0xd4, 0x4c, 0xdf, 0x2f, 0xc1, 0x02, 0xa6, 0xfe, 0xff, 0x5d, 0x31,
+	0x9e, 0x2b, 0x9e, 0x05, 0xd0, 0x77, 0x5a, 0x66, 0x49, 0xbb, 0x7c, 0xa1, 0xac, 0x30, 0xa3, 0x39,
+	0xb4, 0xb8, 0xc8, 0xf8, 0xe3, 0xd7, 0x93, 0x30, 0x9b, 0xf5, 0xc7, 0xff, 0xec, 0x0b, 0x82, 0xbe,
+	0x90, 0xe6, 0x97, 0xb2, 0xca, 0x2f, 0x0f, 0x66, 0xf3, 0xcb, 0x48, 0x4c, 0xbe, 0x7f, 0x62, 0xf9,
+	0x89, 0x05, 0x28, 0xcd, 0x27, 0x98, 0xf2, 0x7e, 0x18, 0x70, 0x55, 0x6b, 0xa5, 0x6c, 0x4c, 0x43,
+	0x96, 0x7f, 0x8a, 0x92, 0xdd, 0xb8, 0xd6, 0xca, 0x98, 0xfe, 0x89, 0xf4, 0xbe, 0xe9, 0xc0, 0xbd,
+	0xd2, 0x34, 0xfd, 0xbe, 0xec, 0xf0, 0x9b, 0xa6, 0xc3, 0x6f, 0x6e, 0x86, 0x2c, 0xa6, 0x1e, 0xb9,
+	0x60, 0x13, 0xce, 0x6f, 0x2d, 0xb8, 0x32, 0xa2, 0x4c, 0x72, 0x40, 0x0c, 0x28, 0xca, 0x6c, 0xba,
+	0x52, 0xbb, 0x53, 0x73, 0xd0, 0xfb, 0xb2, 0xc7, 0x42, 0x34, 0x72, 0x79, 0x3f, 0xb8, 0x34, 0x51,
+	0x56, 0x21, 0xf9, 0x73, 0x0b, 0x16, 0xb3, 0xe2, 0x93, 0xc3, 0x6f, 0xc0, 0x6c, 0x56, 0x7a, 0x32,
+	0xa5, 0x78, 0x8f, 0x63, 0x9b, 0x13, 0xe7, 0x68, 0xd0, 0x56, 0x1a, 0x05, 0x7a, 0x56, 0xf1, 0x89,
+	0xf7, 0xd5, 0x3a, 0x96, 0x5d, 0x8c, 0x86, 0xb2, 0x7e, 0x51, 0x01, 0xa6, 0x76, 0x48, 0x44, 0x7a,
+	0x1c, 0x3d, 0x06, 0x20, 0x9f, 0x19, 0xd7, 0xa7, 0x41, 0xd8, 0x33, 0xf7, 0x65, 0xe9, 0x6c, 0xd8,
+	0x58, 0xd0, 0xe1, 0x99, 0xee, 0x39, 0x78, 0x46, 0x2e, 0x5a, 0xf2, 0x37, 0x72, 0xa1, 0x26, 0x87,
+	0x2b, 0x2e, 0x0b, 0xda, 0x5d, 0xad, 0xd3, 0x3d, 0x1d, 0xff, 0x60, 0xbe, 0x01, 0xc8, 0x93, 0xcb,
+	0x01, 0x02, 0x0b, 0xc4, 0x76, 0xbc, 0x46, 0x47, 0x30, 0x27, 0x8b, 0xf9, 0x41, 0x20, 0xbb, 0x5d,
+	0x41, 0x4e, 0xcc, 0xc5, 0xb9, 0x35, 0x76, 0x5b, 0xb7, 0x98, 0xe4, 0xc7, 0x94, 0x99, 0x83, 0x67,
+	0x93, 0xf5, 0x1e, 0x39, 0x51, 0xbd, 0x82, 0x1c, 0x68, 0xe4, 0x07, 0x13, 0x76, 0x79, 0xec, 0x5e,
+	0x41, 0x8b, 0x5c, 0xc9, 0xcc, 0x48, 0xf2, 0x2c, 0x65, 0xaf, 0x40, 0x4e, 0xf2, 0xc3, 0x0d, 0xf4,
+	0x3c, 0x54, 0x7b, 0x24, 0x3a, 0xa2, 0x42, 0x4b, 0xbd, 0x74, 0xa1, 0x1c, 0x09, 0x9a, 0x85, 0x62,
+	0xe8, 0x8d, 0xb4, 0x67, 0x53, 0xc6, 0x39, 0xc5, 0xe7, 0xa4, 0x65, 0x66, 0x7a, 0xf7, 0xe8, 0xce,
+	0x5e, 0x3d, 0xa7, 0x3b, 0x7b, 0x04, 0x66, 0xa4, 0x82, 0x6a, 0x30, 0xa6, 0x5a, 0xd1, 0xb9, 0x8d,
+	0xc5, 0xb3, 0x61, 0x63, 0x3e, 0xd5, 0x5d, 0x6d, 0x39, 0x58, 0x0e, 0xb6, 0x64, 0xf7, 0xc3, 0xd1,
+	0xe3, 0x52, 0xd1, 0x13, 0x37, 0x0e, 0xe3, 0x8a, 0x22, 0x5a, 0x4e, 0x27, 0x31, 0x99, 0x4d, 0x47,
+	0x2a, 0x74, 0xb2, 0xa5, 0x17, 0xe8, 0x19, 0x40, 0x87, 0xc9, 0xc4, 0x2f, 0xa1, 0x9f, 0x51, 0xf4,
+	0x0f, 0x9e, 0x0d, 0x1b, 0x57, 0x34, 0xfd, 0x28, 0x8e, 0x83, 0x17, 0x52, 0x60, 0xcc, 0xed, 0x9b,
+	0x30, 0x27, 0xbb, 0x6a, 0x37, 0x1e, 0x68, 0xda, 0x70, 0x2f, 0xeb, 0xac, 0x19, 0xeb, 0x2c, 0xa6,
+	0x6d, 0x7a, 0x42, 0xad, 0x8d, 0xa3, 0x1a, 0xff, 0x18, 0x5f, 0x3e, 0x45, 0xf4, 0x98, 0xf9, 0x34,
+	0xf0, 0xa8, 0x7b, 0x48, 0x02, 0xbf, 0x4b, 0x23, 0x6e, 0x57, 0xd7, 0x4a, 0xf9, 0xa7, 0x68, 0x04,
+	0xc5, 0xc1, 0xf3, 0x31, 0xec, 0xb6, 0x01, 0xa1, 0xef, 0xc2, 0x22, 0x09, 0x82, 0x01, 0xe9, 0xca,
+	0x39, 0xea, 0x31, 0x53, 0x91, 0xe4, 0x91, 0xbe, 0x3d, 0xab, 0xa2, 0xe4, 0xd9, 0xb1, 0xdf, 0xed,
+	0xab, 0x5a, 0xf6, 0x79, 0x3c, 0x1d, 0x8c, 0x34, 0x78, 0x27, 0x86, 0x6e, 0x92, 0x3e, 0x3a, 0x00,
+	0xe9, 0x09, 0x97, 0x0f, 0xfa, 0xfd, 0xee, 0xa9, 0x3d, 0xa7, 0xc4, 0x6e, 0x8e, 0x2d, 0x76, 0x21,
+	0xf5, 0xb0, 0xe6, 0xe4, 0x60, 0x19, 0x3e, 0xbb, 0xea, 0xb7, 0xae, 0x4b, 0xcc, 0xcd, 0x77, 0x7d,
+	0xea, 0x91, 0x53, 0xbb, 0xf6, 0xdf, 0xcd, 0x27, 0x0b, 0xec, 0x54, 0x5d, 0x62, 0x20, 0x2d, 0x09,
+	0x40, 0x14, 0xaa, 0x9d, 0x50, 0x16, 0x2e, 0x61, 0xe0, 0x53, 0xdf, 0xae, 0x2b, 0x71, 0xad, 0xb1,
+	0xc5, 0x99, 0xc8, 0xcd, 0xb0, 0x72, 0x30, 0xc8, 0xd5, 0x86, 0x5a, 0x64, 0xea, 0x95, 0x7f, 0xa9,
+	0x86, 0xad, 0xcd, 0x9e, 0x65, 0x81, 0xa0, 0x11, 0x42, 0x50, 0x3e, 0xa5, 0x44, 0xcf, 0x0e, 0xcb,
+	0x58, 0xfd, 0x96, 0xf3, 0xa3, 0x3e, 0x8d, 0x58, 0xe8, 0xbb, 0x5c, 0x90, 0x48, 0xd8, 0x93, 0xe3,
+	0xce, 0x8f, 0xb2, 0xd4, 0x66, 0x7e, 0xa4, 0x41, 0xbb, 0x12, 0x82, 0x5e, 0x84, 0x05, 0x83, 0x91,
+	0xf8, 0x3d, 0x2e, 0x48, 0x9e, 0x1e, 0xdb, 0xa3, 0x76, 0x4e, 0x64, 0xca, 0xd0, 0xc1, 0xf3, 0x1a,
+	0xb6, 0x93, 0x82, 0xfe, 0x6d, 0x41, 0x79, 0x27, 0x0c, 0xbb, 0x28, 0x84, 0x85, 0x20, 0x14, 0xc6,
+	0x52, 0xae, 0x19, 0x01, 0x5a, 0x17, 0x89, 0xa9, 0x77, 0x86, 0x8d, 0x51, 0x56, 0xb8, 0x1e, 0x84,
+	0x42, 0x5b, 0x7e, 0x4f, 0x01, 0xd0, 0x4b, 0x30, 0x97, 0x17, 0xa6, 0xcb, 0xb8, 0xaf, 0x8c, 0x2d,
+	0x2c, 0xcf, 0x26, 0xcd, 0x05, 0x39, 0xb0, 0x83, 0x67, 0x0f, 0x32, 0xd2, 0x6f, 0x56, 0xa4, 0xe7,
+	0xdf, 0x95, 0xde, 0xbf, 0x33, 0x09, 0x4b, 0xd2, 0xfb, 0xe9, 0x87, 0x0b, 0x4c, 0x5f, 0x24, 0x91,
+	0xcf, 0xd1, 0x2f, 0x2d, 0xb8, 0xec, 0x0d, 0x7a, 0x03, 0x19, 0x9c, 0xc7, 0xd4, 0x8d, 0x14, 0xd8,
+	0x55, 0x89, 0xc4, 0x8c, 0x04, 0xae, 0x9d, 0xfb, 0xa6, 0xb6, 0xa8, 0xa7, 0x9e, 0xd5, 0x7d, 0x13,
+	0x02, 0xab, 0xe6, 0x9d, 0x3b, 0x9f, 0x95, 0xf3, 0x8b, 0x37, 0x1b, 0x9f, 0xba, 0xbf, 0xa8, 0x96,
+	0x5c, 0x39, 0x5e, 0x4a, 0x19, 0xe9, 0x93, 0x62, 0xc9, 0x46, 0xf6, 0x3b, 0x11, 0x6d, 0xd3, 0x48,
+	0xe5, 0x2d, 0x2f, 0x1c, 0x04, 0x3a, 0x4a, 0xe7, 0xb2, 0xfd, 0x4e, 0x01, 0xc1, 0xc1, 0xb5, 0x04,
+	0xb2, 0xa9, 0x00, 0x3f, 0x55, 0x35, 0x68, 0x9b, 0x6d, 0x0e, 0xa2, 0x88, 0x06, 0x22, 0xb6, 0xc4,
+	0x11, 0x4c, 0xeb, 0x23, 0xf3, 0xfb, 0x52, 0xfc, 0x51, 0xa9, 0xf8, 0xb8, 0x6a, 0xc5, 0x12, 0xe4,
+	0x0c, 0x57, 0x87, 0xa9, 0x3a, 0x7f, 0x19, 0x9b, 0x95, 0xac, 0x8f, 0x97, 0xe5, 0xd9, 0x9e, 0x1f,
+	0x08, 0x2e, 0x88, 0x7a, 0xee, 0xe2, 0xf3, 0x7d, 0x67, 0xbc, 0xf3, 0x6d, 0x19, 0xc7, 0xd4, 0x62,
+	0xab, 0x28, 0x52, 0xe7, 0xa2, 0x27, 0x76, 0x7e, 0x60, 0xc1, 0x15, 0x35, 0x45, 0xf4, 0x8c, 0x6b,
+	0xa8, 0x9f, 0xf9, 0xae, 0xf2, 0x42, 0x6e, 0x58, 0xfa, 0xa1, 0xd9, 0x2f, 0x23, 0x44, 0x66, 0xb4,
+	0xa5, 0x56, 0x32, 0x1a, 0x97, 0x19, 0x86, 0x05, 0x1d, 0xf5, 0x3d, 0x6e, 0x13, 0xea, 0xfd, 0x88,
+	0x1e, 0xb3, 0x70, 0xc0, 0x5d, 0x63, 0x65, 0x95, 0xe7, 0xb2, 0x51, 0x52, 0x40, 0x70, 0x70, 0x2d,
+	0x86, 0xec, 0x28, 0x00, 0xda, 0x83, 0x4b, 0x5c, 0x90, 0xa3, 0xf8, 0x7b, 0xd8, 0x17, 0xc7, 0xce,
+	0xcd, 0xb3, 0x5a, 0x90, 0x62, 0xe2, 0x60, 0xcd, 0x0c, 0x6d, 0xc9, 0x4f, 0x85, 0xaa, 0x4f, 0x2f,
+	0xa9, 0x13, 0x3d, 0xfc, 0xce, 0xb0, 0x51, 0x6c, 0xe1, 0xdf, 0xa7, 0x75, 0x37, 0xc4, 0xce, 0x8f,
+	0x2d, 0xa8, 0x49, 0x67, 0xec, 0x76, 0x09, 0x3f, 0xdc, 0x3a, 0xa6, 0x81, 0x90, 0xd5, 0x8d, 0xea,
+	0xe5, 0x72, 0x0a, 0x67, 0xaa, 0x9b, 0xcc, 0xa6, 0x23, 0xbb, 0xa7, 0x36, 0x33, 0x8a, 0x3e, 0x0d,
+	0x95, 0x76, 0x44, 0xbc, 0xa4, 0x8a, 0x1e, 0xbf, 0xf8, 0x4b, 0xe8, 0x9d, 0x3f, 0xa8, 0x20, 0x89,
+	0x3b, 0x81, 0xc4, 0x3b, 0x3a, 0x84, 0x47, 0xa6, 0x5c, 0xd6, 0x18, 0x53, 0x2e, 0x06, 0x53, 0x3a,
+	0x12, 0xed, 0xc9, 0x0f, 0x2b, 0xb8, 0x8c, 0x80, 0x9b, 0x15, 0xd3, 0xb1, 0xaa, 0xf9, 0xf7, 0xf4,
+	0x2d, 0x4a, 0xd5, 0xdb, 0xf1, 0x23, 0x0b, 0x6a, 0x69, 0x15, 0xdf, 0x0f, 0xc3, 0xee, 0x7d, 0x85,
+	0xf9, 0x33, 0xf9, 0xca, 0x36, 0xcf, 0x61, 0xec, 0xdb, 0x98, 0x36, 0x25, 0xf2, 0x4c, 0xce, 0x5f,
+	0x27, 0x61, 0x41, 0x85, 0x80, 0x8c, 0x85, 0x9d, 0x28, 0xec, 0x87, 0x9c, 0x74, 0xd1, 0x22, 0x5c,
+	0x12, 0x4c, 0x74, 0xcd, 0x17, 0x61, 0xac, 0x17, 0x68, 0x2d, 0xff, 0xf5, 0x46, 0x7f, 0x19, 0xcc,
+	0x82, 0x46, 0xdc, 0x53, 0x1a, 0xc3, 0x3d, 0xdb, 0xb0, 0xc0, 0x82, 0x38, 0x0c, 0xe2, 0x51, 0x54,
+	0x59, 0x8d, 0xa2, 0x32, 0x25, 0xe7, 0x08, 0x8a, 0x83, 0xe7, 0x53, 0x98, 0x19, 0x47, 0x05, 0x50,
+	0xe3, 0x52, 0x27, 0x37, 0x89, 0x4a, 0xdd, 0x92, 0x3c, 0x35, 0xf6, 0x0d, 0x34, 0x36, 0xcf, 0x73,
+	0x73, 0xf0, 0x9c, 0x02, 0xdc, 0x32, 0x6b, 0x59, 0x0a, 0xc9, 0xea, 0x59, 0x35, 0x29, 0x15, 0xac,
+	0x7e, 0xe7, 0x87, 0x16, 0x9f, 0xfc, 0x8d, 0x05, 0x90, 0x7e, 0x45, 0x44, 0x9f, 0x86, 0xcb, 0x1b,
+	0xcf, 0x3f, 0xd7, 0x72, 0x77, 0xf7, 0x9e, 0xdc, 0xdb, 0xdf, 0x75, 0xf7, 0x9f, 0xdb, 0xdd, 0xd9,
+	0xda, 0xdc, 0xbe, 0xb5, 0xbd, 0xd5, 0x9a, 0x9f, 0x58, 0xa9, 0xdf, 0xb9, 0xbb, 0x56, 0xdd, 0x0f,
+	0x78, 0x9f, 0x7a, 0xac, 0xcd, 0xa8, 0x8f, 0x1e, 0x82, 0xc5, 0x3c, 0xb6, 0x5c, 0x6d, 0xb5, 0xe6,
+	0xad, 0x95, 0xd9, 0x3b, 0x77, 0xd7, 0x2a, 0x7a, 0xca, 0x4a, 0x7d, 0x74, 0x1d, 0x96, 0x46, 0xf1,
+	0xb6, 0x9f, 0x7b, 0x6a, 0x7e, 0x72, 0x65, 0xee, 0xce, 0xdd, 0xb5, 0x99, 0x64, 0x1c, 0x8b, 0x1c,
+	0x40, 0x59, 0x4c, 0xc3, 0xaf, 0xb4, 0x02, 0x77, 0xee, 0xae, 0x4d, 0xe9, 0xf2, 0x63, 0xa5, 0xfc,
+	0xf2, 0xcf, 0x56, 0x27, 0x36, 0xbe, 0xfc, 0xfa, 0x5b, 0xab, 0xd6, 0x1b, 0x6f, 0xad, 0x5a, 0x7f,
+	0x79, 0x6b, 0xd5, 0x7a, 0xe5, 0xed, 0xd5, 0x89, 0x37, 0xde, 0x5e, 0x9d, 0xf8, 0xe3, 0xdb, 0xab,
+	0x13, 0x5f, 0xcd, 0x1a, 0x51, 0xff, 0xef, 0x0d, 0xfd, 0xf7, 0xf8, 0xb1, 0xf5, 0x13, 0xfd, 0x1f,
+	0x39, 0x94, 0x21, 0x0f, 0xa6, 0x54, 0xd5, 0xf7, 0xe8, 0x7f, 0x06, 0x00, 0xc1, 0xd4, 0x47, 0xc1,
+	0xe3, 0x21, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1235,654 +1862,816 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func DefiDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 10339 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0xbd, 0x7b, 0x70, 0x1c, 0xc9,
-		0x79, 0x18, 0xce, 0xd9, 0x07, 0xb0, 0xfb, 0xe1, 0xb5, 0x68, 0x80, 0xe0, 0x72, 0x49, 0x02, 0xe0,
-		0xf0, 0x8e, 0xc7, 0xc7, 0x1d, 0x70, 0xc7, 0xe3, 0xe3, 0xb8, 0xd4, 0x1d, 0x8d, 0x05, 0x96, 0x20,
-		0xee, 0xf0, 0xd2, 0x00, 0xb8, 0x93, 0xe4, 0x9f, 0x6a, 0x6b, 0xb0, 0xdb, 0x58, 0xcc, 0x61, 0x77,
-		0x66, 0x6e, 0x66, 0x96, 0x24, 0x4e, 0x52, 0xd5, 0xfd, 0x24, 0x45, 0x0f, 0x3a, 0xb6, 0x24, 0x5b,
-		0x25, 0x4b, 0x27, 0x51, 0xd1, 0x59, 0x49, 0xa4, 0xc8, 0x4e, 0x62, 0xc9, 0x96, 0x64, 0x25, 0xa9,
-		0x8a, 0x92, 0x8a, 0x1c, 0x59, 0xe5, 0xa4, 0xa4, 0x72, 0x25, 0x76, 0x39, 0x36, 0xe5, 0x9c, 0x54,
-		0x89, 0xac, 0x28, 0xb1, 0xc2, 0xc8, 0x55, 0x71, 0xa9, 0x52, 0x49, 0xf5, 0x6b, 0x5e, 0x3b, 0x8b,
-		0xdd, 0xe5, 0x91, 0x77, 0x97, 0xfc, 0x43, 0x62, 0xba, 0xbf, 0xef, 0xeb, 0xaf, 0xbf, 0xfe, 0xfa,
-		0x7b, 0x75, 0xcf, 0x2c, 0x7c, 0xfa, 0x12, 0x4c, 0x56, 0x0d, 0xa3, 0x5a, 0xc3, 0xd3, 0xa6, 0x65,
-		0x38, 0xc6, 0x66, 0x63, 0x6b, 0xba, 0x82, 0xed, 0xb2, 0xa5, 0x99, 0x8e, 0x61, 0x4d, 0xd1, 0x36,
-		0x34, 0xc4, 0x20, 0xa6, 0x04, 0x84, 0xbc, 0x04, 0xc3, 0x57, 0xb4, 0x1a, 0x9e, 0x73, 0x01, 0xd7,
-		0xb0, 0x83, 0x9e, 0x80, 0xc4, 0x96, 0x56, 0xc3, 0x59, 0x69, 0x32, 0x7e, 0xa2, 0xef, 0xcc, 0x03,
-		0x53, 0x21, 0xa4, 0xa9, 0x20, 0xc6, 0x2a, 0x69, 0x56, 0x28, 0x86, 0xfc, 0xc3, 0x04, 0x8c, 0x44,
-		0xf4, 0x22, 0x04, 0x09, 0x5d, 0xad, 0x13, 0x8a, 0xd2, 0x89, 0xb4, 0x42, 0xff, 0x46, 0x59, 0xe8,
-		0x35, 0xd5, 0xf2, 0x8e, 0x5a, 0xc5, 0xd9, 0x18, 0x6d, 0x16, 0x8f, 0x68, 0x1c, 0xa0, 0x82, 0x4d,
-		0xac, 0x57, 0xb0, 0x5e, 0xde, 0xcd, 0xc6, 0x27, 0xe3, 0x27, 0xd2, 0x8a, 0xaf, 0x05, 0x9d, 0x86,
-		0x61, 0xb3, 0xb1, 0x59, 0xd3, 0xca, 0x25, 0x1f, 0x18, 0x4c, 0xc6, 0x4f, 0x24, 0x95, 0x0c, 0xeb,
-		0x98, 0xf3, 0x80, 0x1f, 0x82, 0xa1, 0xeb, 0x58, 0xdd, 0xf1, 0x83, 0xf6, 0x51, 0xd0, 0x41, 0xd2,
-		0xec, 0x03, 0x9c, 0x85, 0xfe, 0x3a, 0xb6, 0x6d, 0xb5, 0x8a, 0x4b, 0xce, 0xae, 0x89, 0xb3, 0x09,
-		0x3a, 0xfb, 0xc9, 0xa6, 0xd9, 0x87, 0x67, 0xde, 0xc7, 0xb1, 0xd6, 0x77, 0x4d, 0x8c, 0x66, 0x20,
-		0x8d, 0xf5, 0x46, 0x9d, 0x51, 0x48, 0xb6, 0x90, 0x5f, 0x51, 0x6f, 0xd4, 0xc3, 0x54, 0x52, 0x04,
-		0x8d, 0x93, 0xe8, 0xb5, 0xb1, 0x75, 0x4d, 0x2b, 0xe3, 0x6c, 0x0f, 0x25, 0xf0, 0x50, 0x13, 0x81,
-		0x35, 0xd6, 0x1f, 0xa6, 0x21, 0xf0, 0xd0, 0x2c, 0xa4, 0xf1, 0x0d, 0x07, 0xeb, 0xb6, 0x66, 0xe8,
-		0xd9, 0x5e, 0x4a, 0xe4, 0xc1, 0x88, 0x55, 0xc4, 0xb5, 0x4a, 0x98, 0x84, 0x87, 0x87, 0xce, 0x43,
-		0xaf, 0x61, 0x3a, 0x9a, 0xa1, 0xdb, 0xd9, 0xd4, 0xa4, 0x74, 0xa2, 0xef, 0xcc, 0xe1, 0x48, 0x45,
-		0x58, 0x61, 0x30, 0x8a, 0x00, 0x46, 0x0b, 0x90, 0xb1, 0x8d, 0x86, 0x55, 0xc6, 0xa5, 0xb2, 0x51,
-		0xc1, 0x25, 0x4d, 0xdf, 0x32, 0xb2, 0x69, 0x4a, 0x60, 0xa2, 0x79, 0x22, 0x14, 0x70, 0xd6, 0xa8,
-		0xe0, 0x05, 0x7d, 0xcb, 0x50, 0x06, 0xed, 0xc0, 0x33, 0x1a, 0x83, 0x1e, 0x7b, 0x57, 0x77, 0xd4,
-		0x1b, 0xd9, 0x7e, 0xaa, 0x21, 0xfc, 0x49, 0xfe, 0x46, 0x0f, 0x0c, 0x75, 0xa2, 0x62, 0x97, 0x20,
-		0xb9, 0x45, 0x66, 0x99, 0x8d, 0x75, 0x23, 0x03, 0x86, 0x13, 0x14, 0x62, 0xcf, 0x5d, 0x0a, 0x71,
-		0x06, 0xfa, 0x74, 0x6c, 0x3b, 0xb8, 0xc2, 0x34, 0x22, 0xde, 0xa1, 0x4e, 0x01, 0x43, 0x6a, 0x56,
-		0xa9, 0xc4, 0x5d, 0xa9, 0xd4, 0xdb, 0x60, 0xc8, 0x65, 0xa9, 0x64, 0xa9, 0x7a, 0x55, 0xe8, 0xe6,
-		0x74, 0x3b, 0x4e, 0xa6, 0x8a, 0x02, 0x4f, 0x21, 0x68, 0xca, 0x20, 0x0e, 0x3c, 0xa3, 0x39, 0x00,
-		0x43, 0xc7, 0xc6, 0x56, 0xa9, 0x82, 0xcb, 0xb5, 0x6c, 0xaa, 0x85, 0x94, 0x56, 0x08, 0x48, 0x93,
-		0x94, 0x0c, 0xd6, 0x5a, 0xae, 0xa1, 0x8b, 0x9e, 0xaa, 0xf5, 0xb6, 0xd0, 0x94, 0x25, 0xb6, 0xc9,
-		0x9a, 0xb4, 0x6d, 0x03, 0x06, 0x2d, 0x4c, 0xf4, 0x1e, 0x57, 0xf8, 0xcc, 0xd2, 0x94, 0x89, 0xa9,
-		0xb6, 0x33, 0x53, 0x38, 0x1a, 0x9b, 0xd8, 0x80, 0xe5, 0x7f, 0x44, 0xc7, 0xc0, 0x6d, 0x28, 0x51,
-		0xb5, 0x02, 0x6a, 0x85, 0xfa, 0x45, 0xe3, 0xb2, 0x5a, 0xc7, 0xb9, 0x17, 0x61, 0x30, 0x28, 0x1e,
-		0x34, 0x0a, 0x49, 0xdb, 0x51, 0x2d, 0x87, 0x6a, 0x61, 0x52, 0x61, 0x0f, 0x28, 0x03, 0x71, 0xac,
-		0x57, 0xa8, 0x95, 0x4b, 0x2a, 0xe4, 0x4f, 0xf4, 0x0b, 0xde, 0x84, 0xe3, 0x74, 0xc2, 0xc7, 0x9b,
-		0x57, 0x34, 0x40, 0x39, 0x3c, 0xef, 0xdc, 0x05, 0x18, 0x08, 0x4c, 0xa0, 0xd3, 0xa1, 0xe5, 0x77,
-		0xc3, 0xfe, 0x48, 0xd2, 0xe8, 0x6d, 0x30, 0xda, 0xd0, 0x35, 0xdd, 0xc1, 0x96, 0x69, 0x61, 0xa2,
-		0xb1, 0x6c, 0xa8, 0xec, 0x7f, 0xee, 0x6d, 0xa1, 0x73, 0x1b, 0x7e, 0x68, 0x46, 0x45, 0x19, 0x69,
-		0x34, 0x37, 0x9e, 0x4a, 0xa7, 0x7e, 0xd4, 0x9b, 0x79, 0xe9, 0xa5, 0x97, 0x5e, 0x8a, 0xc9, 0x9f,
-		0xec, 0x81, 0xd1, 0xa8, 0x3d, 0x13, 0xb9, 0x7d, 0xc7, 0xa0, 0x47, 0x6f, 0xd4, 0x37, 0xb1, 0x45,
-		0x85, 0x94, 0x54, 0xf8, 0x13, 0x9a, 0x81, 0x64, 0x4d, 0xdd, 0xc4, 0xb5, 0x6c, 0x62, 0x52, 0x3a,
-		0x31, 0x78, 0xe6, 0x74, 0x47, 0xbb, 0x72, 0x6a, 0x91, 0xa0, 0x28, 0x0c, 0x13, 0x3d, 0x05, 0x09,
-		0x6e, 0xa2, 0x09, 0x85, 0x53, 0x9d, 0x51, 0x20, 0x7b, 0x49, 0xa1, 0x78, 0xe8, 0x10, 0xa4, 0xc9,
-		0xff, 0x4c, 0x37, 0x7a, 0x28, 0xcf, 0x29, 0xd2, 0x40, 0xf4, 0x02, 0xe5, 0x20, 0x45, 0xb7, 0x49,
-		0x05, 0x0b, 0xd7, 0xe6, 0x3e, 0x13, 0xc5, 0xaa, 0xe0, 0x2d, 0xb5, 0x51, 0x73, 0x4a, 0xd7, 0xd4,
-		0x5a, 0x03, 0x53, 0x85, 0x4f, 0x2b, 0xfd, 0xbc, 0xf1, 0x59, 0xd2, 0x86, 0x26, 0xa0, 0x8f, 0xed,
-		0x2a, 0x4d, 0xaf, 0xe0, 0x1b, 0xd4, 0x7a, 0x26, 0x15, 0xb6, 0xd1, 0x16, 0x48, 0x0b, 0x19, 0xfe,
-		0x79, 0xdb, 0xd0, 0x85, 0x6a, 0xd2, 0x21, 0x48, 0x03, 0x1d, 0xfe, 0x42, 0xd8, 0x70, 0x1f, 0x89,
-		0x9e, 0x5e, 0x58, 0xa7, 0xe4, 0xaf, 0xc5, 0x20, 0x41, 0xed, 0xc5, 0x10, 0xf4, 0xad, 0xbf, 0x7d,
-		0xb5, 0x58, 0x9a, 0x5b, 0xd9, 0x28, 0x2c, 0x16, 0x33, 0x12, 0x1a, 0x04, 0xa0, 0x0d, 0x57, 0x16,
-		0x57, 0x66, 0xd6, 0x33, 0x31, 0xf7, 0x79, 0x61, 0x79, 0xfd, 0xfc, 0xd9, 0x4c, 0xdc, 0x45, 0xd8,
-		0x60, 0x0d, 0x09, 0x3f, 0xc0, 0xe3, 0x67, 0x32, 0x49, 0x94, 0x81, 0x7e, 0x46, 0x60, 0xe1, 0x6d,
-		0xc5, 0xb9, 0xf3, 0x67, 0x33, 0x3d, 0xc1, 0x96, 0xc7, 0xcf, 0x64, 0x7a, 0xd1, 0x00, 0xa4, 0x69,
-		0x4b, 0x61, 0x65, 0x65, 0x31, 0x93, 0x72, 0x69, 0xae, 0xad, 0x2b, 0x0b, 0xcb, 0xf3, 0x99, 0xb4,
-		0x4b, 0x73, 0x5e, 0x59, 0xd9, 0x58, 0xcd, 0x80, 0x4b, 0x61, 0xa9, 0xb8, 0xb6, 0x36, 0x33, 0x5f,
-		0xcc, 0xf4, 0xb9, 0x10, 0x85, 0xb7, 0xaf, 0x17, 0xd7, 0x32, 0xfd, 0x01, 0xb6, 0x1e, 0x3f, 0x93,
-		0x19, 0x70, 0x87, 0x28, 0x2e, 0x6f, 0x2c, 0x65, 0x06, 0xd1, 0x30, 0x0c, 0xb0, 0x21, 0x04, 0x13,
-		0x43, 0xa1, 0xa6, 0xf3, 0x67, 0x33, 0x19, 0x8f, 0x11, 0x46, 0x65, 0x38, 0xd0, 0x70, 0xfe, 0x6c,
-		0x06, 0xc9, 0xb3, 0x90, 0xa4, 0xda, 0x85, 0x10, 0x0c, 0x2e, 0xce, 0x14, 0x8a, 0x8b, 0xa5, 0x95,
-		0xd5, 0xf5, 0x85, 0x95, 0xe5, 0x99, 0xc5, 0x8c, 0xe4, 0xb5, 0x29, 0xc5, 0xb7, 0x6e, 0x2c, 0x28,
-		0xc5, 0xb9, 0x4c, 0xcc, 0xdf, 0xb6, 0x5a, 0x9c, 0x59, 0x2f, 0xce, 0x65, 0xe2, 0x72, 0x19, 0x46,
-		0xa3, 0xec, 0x64, 0xe4, 0xce, 0xf0, 0x2d, 0x71, 0xac, 0xc5, 0x12, 0x53, 0x5a, 0x4d, 0x4b, 0xfc,
-		0x83, 0x18, 0x8c, 0x44, 0xf8, 0x8a, 0xc8, 0x41, 0x2e, 0x43, 0x92, 0xa9, 0x28, 0xf3, 0x9e, 0x27,
-		0x23, 0x9d, 0x0e, 0x55, 0xd8, 0x26, 0x0f, 0x4a, 0xf1, 0xfc, 0x11, 0x44, 0xbc, 0x45, 0x04, 0x41,
-		0x48, 0x34, 0xd9, 0xf4, 0x77, 0x36, 0xd9, 0x74, 0xe6, 0xf6, 0xce, 0x77, 0xe2, 0xf6, 0x68, 0x5b,
-		0x77, 0xb6, 0x3d, 0x19, 0x61, 0xdb, 0x2f, 0xc1, 0x70, 0x13, 0xa1, 0x8e, 0x6d, 0xec, 0xfb, 0x24,
-		0xc8, 0xb6, 0x12, 0x4e, 0x1b, 0x4b, 0x17, 0x0b, 0x58, 0xba, 0x4b, 0x61, 0x09, 0x1e, 0x6d, 0xbd,
-		0x08, 0x4d, 0x6b, 0xfd, 0x05, 0x09, 0xc6, 0xa2, 0x23, 0xc5, 0x48, 0x1e, 0x9e, 0x82, 0x9e, 0x3a,
-		0x76, 0xb6, 0x0d, 0x11, 0x2d, 0x1d, 0x8f, 0xf0, 0xc1, 0xa4, 0x3b, 0xbc, 0xd8, 0x1c, 0xcb, 0xef,
-		0xc4, 0xe3, 0xad, 0xc2, 0x3d, 0xc6, 0x4d, 0x13, 0xa7, 0x1f, 0x8e, 0xc1, 0xfe, 0x48, 0xe2, 0x91,
-		0x8c, 0x1e, 0x01, 0xd0, 0x74, 0xb3, 0xe1, 0xb0, 0x88, 0x88, 0x19, 0xd8, 0x34, 0x6d, 0xa1, 0xc6,
-		0x8b, 0x18, 0xcf, 0x86, 0xe3, 0xf6, 0xc7, 0x69, 0x3f, 0xb0, 0x26, 0x0a, 0xf0, 0x84, 0xc7, 0x68,
-		0x82, 0x32, 0x3a, 0xde, 0x62, 0xa6, 0x4d, 0x8a, 0xf9, 0x28, 0x64, 0xca, 0x35, 0x0d, 0xeb, 0x4e,
-		0xc9, 0x76, 0x2c, 0xac, 0xd6, 0x35, 0xbd, 0x4a, 0x3d, 0x48, 0x2a, 0x9f, 0xdc, 0x52, 0x6b, 0x36,
-		0x56, 0x86, 0x58, 0xf7, 0x9a, 0xe8, 0x25, 0x18, 0x54, 0x81, 0x2c, 0x1f, 0x46, 0x4f, 0x00, 0x83,
-		0x75, 0xbb, 0x18, 0xf2, 0xaf, 0xa6, 0xa1, 0xcf, 0x17, 0x57, 0xa3, 0xa3, 0xd0, 0xff, 0xbc, 0x7a,
-		0x4d, 0x2d, 0x89, 0x5c, 0x89, 0x49, 0xa2, 0x8f, 0xb4, 0xad, 0xf2, 0x7c, 0xe9, 0x51, 0x18, 0xa5,
-		0x20, 0x46, 0xc3, 0xc1, 0x56, 0xa9, 0x5c, 0x53, 0x6d, 0x9b, 0x0a, 0x2d, 0x45, 0x41, 0x11, 0xe9,
-		0x5b, 0x21, 0x5d, 0xb3, 0xa2, 0x07, 0x9d, 0x83, 0x11, 0x8a, 0x51, 0x6f, 0xd4, 0x1c, 0xcd, 0xac,
-		0xe1, 0x12, 0xc9, 0xde, 0x6c, 0xea, 0x49, 0x5c, 0xce, 0x86, 0x09, 0xc4, 0x12, 0x07, 0x20, 0x1c,
-		0xd9, 0x68, 0x0e, 0x8e, 0x50, 0xb4, 0x2a, 0xd6, 0xb1, 0xa5, 0x3a, 0xb8, 0x84, 0x5f, 0x68, 0xa8,
-		0x35, 0xbb, 0xa4, 0xea, 0x95, 0xd2, 0xb6, 0x6a, 0x6f, 0x67, 0x47, 0x09, 0x81, 0x42, 0x2c, 0x2b,
-		0x29, 0x07, 0x09, 0xe0, 0x3c, 0x87, 0x2b, 0x52, 0xb0, 0x19, 0xbd, 0x72, 0x55, 0xb5, 0xb7, 0x51,
-		0x1e, 0xc6, 0x28, 0x15, 0xdb, 0xb1, 0x34, 0xbd, 0x5a, 0x2a, 0x6f, 0xe3, 0xf2, 0x4e, 0xa9, 0xe1,
-		0x6c, 0x3d, 0x91, 0x3d, 0xe4, 0x1f, 0x9f, 0x72, 0xb8, 0x46, 0x61, 0x66, 0x09, 0xc8, 0x86, 0xb3,
-		0xf5, 0x04, 0x5a, 0x83, 0x7e, 0xb2, 0x18, 0x75, 0xed, 0x45, 0x5c, 0xda, 0x32, 0x2c, 0xea, 0x1a,
-		0x07, 0x23, 0x4c, 0x93, 0x4f, 0x82, 0x53, 0x2b, 0x1c, 0x61, 0xc9, 0xa8, 0xe0, 0x7c, 0x72, 0x6d,
-		0xb5, 0x58, 0x9c, 0x53, 0xfa, 0x04, 0x95, 0x2b, 0x86, 0x45, 0x14, 0xaa, 0x6a, 0xb8, 0x02, 0xee,
-		0x63, 0x0a, 0x55, 0x35, 0x84, 0x78, 0xcf, 0xc1, 0x48, 0xb9, 0xcc, 0xe6, 0xac, 0x95, 0x4b, 0x3c,
-		0xc7, 0xb2, 0xb3, 0x99, 0x80, 0xb0, 0xca, 0xe5, 0x79, 0x06, 0xc0, 0x75, 0xdc, 0x46, 0x17, 0x61,
-		0xbf, 0x27, 0x2c, 0x3f, 0xe2, 0x70, 0xd3, 0x2c, 0xc3, 0xa8, 0xe7, 0x60, 0xc4, 0xdc, 0x6d, 0x46,
-		0x44, 0x81, 0x11, 0xcd, 0xdd, 0x30, 0xda, 0x05, 0x18, 0x35, 0xb7, 0xcd, 0x66, 0xbc, 0x53, 0x7e,
-		0x3c, 0x64, 0x6e, 0x9b, 0x61, 0xc4, 0x07, 0x69, 0xc2, 0x6d, 0xe1, 0xb2, 0xea, 0xe0, 0x4a, 0xf6,
-		0x80, 0x1f, 0xdc, 0xd7, 0x81, 0xa6, 0x21, 0x53, 0x2e, 0x97, 0xb0, 0xae, 0x6e, 0xd6, 0x70, 0x49,
-		0xb5, 0xb0, 0xae, 0xda, 0xd9, 0x09, 0x3f, 0xf0, 0x60, 0xb9, 0x5c, 0xa4, 0xbd, 0x33, 0xb4, 0x13,
-		0x9d, 0x82, 0x61, 0x63, 0xf3, 0xf9, 0x32, 0x53, 0xc9, 0x92, 0x69, 0xe1, 0x2d, 0xed, 0x46, 0xf6,
-		0x01, 0x2a, 0xdf, 0x21, 0xd2, 0x41, 0x15, 0x72, 0x95, 0x36, 0xa3, 0x93, 0x90, 0x29, 0xdb, 0xdb,
-		0xaa, 0x65, 0x52, 0x9b, 0x6c, 0x9b, 0x6a, 0x19, 0x67, 0x1f, 0x64, 0xa0, 0xac, 0x7d, 0x59, 0x34,
-		0x93, 0x2d, 0x61, 0x5f, 0xd7, 0xb6, 0x1c, 0x41, 0xf1, 0x21, 0xb6, 0x25, 0x68, 0x1b, 0xa7, 0x76,
-		0x02, 0x32, 0x44, 0x14, 0x81, 0x81, 0x4f, 0x50, 0xb0, 0x41, 0x73, 0xdb, 0xf4, 0x8f, 0x7b, 0x0c,
-		0x06, 0x08, 0xa4, 0x37, 0xe8, 0x49, 0x16, 0x90, 0x99, 0xdb, 0xbe, 0x11, 0xcf, 0xc2, 0x18, 0x01,
-		0xaa, 0x63, 0x47, 0xad, 0xa8, 0x8e, 0xea, 0x83, 0x7e, 0x98, 0x42, 0x13, 0xb9, 0x2f, 0xf1, 0xce,
-		0x00, 0x9f, 0x56, 0x63, 0x73, 0xd7, 0xd5, 0xac, 0x47, 0x18, 0x9f, 0xa4, 0x4d, 0xe8, 0xd6, 0x7d,
-		0x0b, 0xba, 0xe5, 0x3c, 0xf4, 0xfb, 0x15, 0x1f, 0xa5, 0x81, 0xa9, 0x7e, 0x46, 0x22, 0x51, 0xd0,
-		0xec, 0xca, 0x1c, 0x89, 0x5f, 0xde, 0x51, 0xcc, 0xc4, 0x48, 0x1c, 0xb5, 0xb8, 0xb0, 0x5e, 0x2c,
-		0x29, 0x1b, 0xcb, 0xeb, 0x0b, 0x4b, 0xc5, 0x4c, 0xdc, 0x17, 0xb0, 0x3f, 0x9d, 0x48, 0x1d, 0xcf,
-		0x3c, 0x24, 0x7f, 0x2f, 0x06, 0x83, 0xc1, 0x0c, 0x0c, 0xbd, 0x05, 0x0e, 0x88, 0x72, 0x89, 0x8d,
-		0x9d, 0xd2, 0x75, 0xcd, 0xa2, 0x3b, 0xb2, 0xae, 0x32, 0xef, 0xe8, 0xea, 0xc4, 0x28, 0x87, 0x5a,
-		0xc3, 0xce, 0x73, 0x9a, 0x45, 0xf6, 0x5b, 0x5d, 0x75, 0xd0, 0x22, 0x4c, 0xe8, 0x46, 0xc9, 0x76,
-		0x54, 0xbd, 0xa2, 0x5a, 0x95, 0x92, 0x57, 0xa8, 0x2a, 0xa9, 0xe5, 0x32, 0xb6, 0x6d, 0x83, 0x79,
-		0x42, 0x97, 0xca, 0x61, 0xdd, 0x58, 0xe3, 0xc0, 0x9e, 0x8b, 0x98, 0xe1, 0xa0, 0x21, 0xfd, 0x8d,
-		0xb7, 0xd2, 0xdf, 0x43, 0x90, 0xae, 0xab, 0x66, 0x09, 0xeb, 0x8e, 0xb5, 0x4b, 0xe3, 0xee, 0x94,
-		0x92, 0xaa, 0xab, 0x66, 0x91, 0x3c, 0xbf, 0x2e, 0xe9, 0xcf, 0xd3, 0x89, 0x54, 0x2a, 0x93, 0x7e,
-		0x3a, 0x91, 0x4a, 0x67, 0x40, 0x7e, 0x35, 0x0e, 0xfd, 0xfe, 0x38, 0x9c, 0xa4, 0x35, 0x65, 0xea,
-		0xb2, 0x24, 0x6a, 0xd4, 0x8e, 0xed, 0x19, 0xb5, 0x4f, 0xcd, 0x12, 0x5f, 0x96, 0xef, 0x61, 0xd1,
-		0xb1, 0xc2, 0x30, 0x49, 0x1c, 0x41, 0x94, 0x0d, 0xb3, 0x68, 0x24, 0xa5, 0xf0, 0x27, 0x34, 0x0f,
-		0x3d, 0xcf, 0xdb, 0x94, 0x76, 0x0f, 0xa5, 0xfd, 0xc0, 0xde, 0xb4, 0x9f, 0x5e, 0xa3, 0xc4, 0xd3,
-		0x4f, 0xaf, 0x95, 0x96, 0x57, 0x94, 0xa5, 0x99, 0x45, 0x85, 0xa3, 0xa3, 0x83, 0x90, 0xa8, 0xa9,
-		0x2f, 0xee, 0x06, 0xbd, 0x1e, 0x6d, 0xea, 0x74, 0x11, 0x0e, 0x42, 0xe2, 0x3a, 0x56, 0x77, 0x82,
-		0xbe, 0x86, 0x36, 0xdd, 0xc7, 0xcd, 0x30, 0x0d, 0x49, 0x2a, 0x2f, 0x04, 0xc0, 0x25, 0x96, 0xd9,
-		0x87, 0x52, 0x90, 0x98, 0x5d, 0x51, 0xc8, 0x86, 0xc8, 0x40, 0x3f, 0x6b, 0x2d, 0xad, 0x2e, 0x14,
-		0x67, 0x8b, 0x99, 0x98, 0x7c, 0x0e, 0x7a, 0x98, 0x10, 0xc8, 0x66, 0x71, 0xc5, 0x90, 0xd9, 0xc7,
-		0x1f, 0x39, 0x0d, 0x49, 0xf4, 0x6e, 0x2c, 0x15, 0x8a, 0x4a, 0x26, 0x16, 0x5c, 0xea, 0x44, 0x26,
-		0x29, 0xdb, 0xd0, 0xef, 0x0f, 0xc4, 0x5f, 0x9f, 0x24, 0xfb, 0x9b, 0x12, 0xf4, 0xf9, 0x02, 0x6b,
-		0x12, 0x11, 0xa9, 0xb5, 0x9a, 0x71, 0xbd, 0xa4, 0xd6, 0x34, 0xd5, 0xe6, 0xaa, 0x01, 0xb4, 0x69,
-		0x86, 0xb4, 0x74, 0xba, 0x74, 0xaf, 0xd3, 0x16, 0x49, 0x66, 0x7a, 0xe4, 0xcf, 0x4a, 0x90, 0x09,
-		0x47, 0xb6, 0x21, 0x36, 0xa5, 0x37, 0x92, 0x4d, 0xf9, 0x33, 0x12, 0x0c, 0x06, 0xc3, 0xd9, 0x10,
-		0x7b, 0x47, 0xdf, 0x50, 0xf6, 0xfe, 0x22, 0x06, 0x03, 0x81, 0x20, 0xb6, 0x53, 0xee, 0x5e, 0x80,
-		0x61, 0xad, 0x82, 0xeb, 0xa6, 0xe1, 0x60, 0xbd, 0xbc, 0x5b, 0xaa, 0xe1, 0x6b, 0xb8, 0x96, 0x95,
-		0xa9, 0xd1, 0x98, 0xde, 0x3b, 0x4c, 0x9e, 0x5a, 0xf0, 0xf0, 0x16, 0x09, 0x5a, 0x7e, 0x64, 0x61,
-		0xae, 0xb8, 0xb4, 0xba, 0xb2, 0x5e, 0x5c, 0x9e, 0x7d, 0x7b, 0x69, 0x63, 0xf9, 0x99, 0xe5, 0x95,
-		0xe7, 0x96, 0x95, 0x8c, 0x16, 0x02, 0xbb, 0x8f, 0xdb, 0x7e, 0x15, 0x32, 0x61, 0xa6, 0xd0, 0x01,
-		0x88, 0x62, 0x2b, 0xb3, 0x0f, 0x8d, 0xc0, 0xd0, 0xf2, 0x4a, 0x69, 0x6d, 0x61, 0xae, 0x58, 0x2a,
-		0x5e, 0xb9, 0x52, 0x9c, 0x5d, 0x5f, 0x63, 0x85, 0x0f, 0x17, 0x7a, 0x3d, 0xb0, 0xc1, 0xe5, 0x97,
-		0xe3, 0x30, 0x12, 0xc1, 0x09, 0x9a, 0xe1, 0x29, 0x0b, 0xcb, 0xa2, 0x1e, 0xe9, 0x84, 0xfb, 0x29,
-		0x12, 0x33, 0xac, 0xaa, 0x96, 0xc3, 0x33, 0x9c, 0x93, 0x40, 0xa4, 0xa4, 0x3b, 0xda, 0x96, 0x86,
-		0x2d, 0x5e, 0x27, 0x62, 0x79, 0xcc, 0x90, 0xd7, 0xce, 0x4a, 0x45, 0x0f, 0x03, 0x32, 0x0d, 0x5b,
-		0x73, 0xb4, 0x6b, 0xb8, 0xa4, 0xe9, 0xa2, 0xa8, 0x44, 0xf2, 0x9a, 0x84, 0x92, 0x11, 0x3d, 0x0b,
-		0xba, 0xe3, 0x42, 0xeb, 0xb8, 0xaa, 0x86, 0xa0, 0x89, 0x31, 0x8f, 0x2b, 0x19, 0xd1, 0xe3, 0x42,
-		0x1f, 0x85, 0xfe, 0x8a, 0xd1, 0x20, 0xc1, 0x1e, 0x83, 0x23, 0xbe, 0x43, 0x52, 0xfa, 0x58, 0x9b,
-		0x0b, 0xc2, 0xc3, 0x78, 0xaf, 0x9a, 0xd5, 0xaf, 0xf4, 0xb1, 0x36, 0x06, 0xf2, 0x10, 0x0c, 0xa9,
-		0xd5, 0xaa, 0x45, 0x88, 0x0b, 0x42, 0x2c, 0x31, 0x19, 0x74, 0x9b, 0x29, 0x60, 0xee, 0x69, 0x48,
-		0x09, 0x39, 0x10, 0x57, 0x4d, 0x24, 0x51, 0x32, 0x59, 0xb6, 0x1d, 0x3b, 0x91, 0x56, 0x52, 0xba,
-		0xe8, 0x3c, 0x0a, 0xfd, 0x9a, 0x5d, 0xf2, 0x8a, 0xf3, 0xb1, 0xc9, 0xd8, 0x89, 0x94, 0xd2, 0xa7,
-		0xd9, 0x6e, 0x61, 0x53, 0xfe, 0x42, 0x0c, 0x06, 0x83, 0x87, 0x0b, 0x68, 0x0e, 0x52, 0x35, 0xa3,
-		0xac, 0x52, 0xd5, 0x62, 0x27, 0x5b, 0x27, 0xda, 0x9c, 0x47, 0x4c, 0x2d, 0x72, 0x78, 0xc5, 0xc5,
-		0xcc, 0xfd, 0x5b, 0x09, 0x52, 0xa2, 0x19, 0x8d, 0x41, 0xc2, 0x54, 0x9d, 0x6d, 0x4a, 0x2e, 0x59,
-		0x88, 0x65, 0x24, 0x85, 0x3e, 0x93, 0x76, 0xdb, 0x54, 0x75, 0xaa, 0x02, 0xbc, 0x9d, 0x3c, 0x93,
-		0x75, 0xad, 0x61, 0xb5, 0x42, 0xb3, 0x1e, 0xa3, 0x5e, 0xc7, 0xba, 0x63, 0x8b, 0x75, 0xe5, 0xed,
-		0xb3, 0xbc, 0x19, 0x9d, 0x86, 0x61, 0xc7, 0x52, 0xb5, 0x5a, 0x00, 0x36, 0x41, 0x61, 0x33, 0xa2,
-		0xc3, 0x05, 0xce, 0xc3, 0x41, 0x41, 0xb7, 0x82, 0x1d, 0xb5, 0xbc, 0x8d, 0x2b, 0x1e, 0x52, 0x0f,
-		0xad, 0x6e, 0x1c, 0xe0, 0x00, 0x73, 0xbc, 0x5f, 0xe0, 0xca, 0xdf, 0x93, 0x60, 0x58, 0xe4, 0x69,
-		0x15, 0x57, 0x58, 0x4b, 0x00, 0xaa, 0xae, 0x1b, 0x8e, 0x5f, 0x5c, 0xcd, 0xaa, 0xdc, 0x84, 0x37,
-		0x35, 0xe3, 0x22, 0x29, 0x3e, 0x02, 0xb9, 0x3a, 0x80, 0xd7, 0xd3, 0x52, 0x6c, 0x13, 0xd0, 0xc7,
-		0x4f, 0x8e, 0xe8, 0xf1, 0x23, 0xcb, 0xec, 0x81, 0x35, 0x91, 0x84, 0x0e, 0x8d, 0x42, 0x72, 0x13,
-		0x57, 0x35, 0x9d, 0xd7, 0x83, 0xd9, 0x83, 0xa8, 0xbf, 0x24, 0xdc, 0xfa, 0x4b, 0xe1, 0x23, 0x12,
-		0x8c, 0x94, 0x8d, 0x7a, 0x98, 0xdf, 0x42, 0x26, 0x54, 0x5e, 0xb0, 0xaf, 0x4a, 0xef, 0x78, 0xaa,
-		0xaa, 0x39, 0xdb, 0x8d, 0xcd, 0xa9, 0xb2, 0x51, 0x9f, 0xae, 0x1a, 0x35, 0x55, 0xaf, 0x7a, 0xe7,
-		0xa7, 0xf4, 0x8f, 0xf2, 0x23, 0x55, 0xac, 0x3f, 0x52, 0x35, 0x7c, 0xa7, 0xa9, 0x97, 0xbc, 0x3f,
-		0xff, 0xa7, 0x24, 0xfd, 0x46, 0x2c, 0x3e, 0xbf, 0x5a, 0xf8, 0x52, 0x2c, 0x37, 0xcf, 0x86, 0x5b,
-		0x15, 0xe2, 0x51, 0xf0, 0x56, 0x0d, 0x97, 0xc9, 0x94, 0xe1, 0xc7, 0xa7, 0x61, 0xb4, 0x6a, 0x54,
-		0x0d, 0x4a, 0x71, 0x9a, 0xfc, 0xc5, 0x4f, 0x64, 0xd3, 0x6e, 0x6b, 0xae, 0xed, 0xf1, 0x6d, 0x7e,
-		0x19, 0x46, 0x38, 0x70, 0x89, 0x1e, 0x09, 0xb1, 0xc4, 0x06, 0xed, 0x59, 0x56, 0xcb, 0x7e, 0xf9,
-		0x87, 0xd4, 0xa1, 0x2b, 0xc3, 0x1c, 0x95, 0xf4, 0xb1, 0xdc, 0x27, 0xaf, 0xc0, 0xfe, 0x00, 0x3d,
-		0xb6, 0x6d, 0xb1, 0xd5, 0x86, 0xe2, 0xb7, 0x38, 0xc5, 0x11, 0x1f, 0xc5, 0x35, 0x8e, 0x9a, 0x9f,
-		0x85, 0x81, 0x6e, 0x68, 0xfd, 0x3e, 0xa7, 0xd5, 0x8f, 0xfd, 0x44, 0xe6, 0x61, 0x88, 0x12, 0x29,
-		0x37, 0x6c, 0xc7, 0xa8, 0x53, 0x9b, 0xb8, 0x37, 0x99, 0x7f, 0xfd, 0x43, 0xb6, 0x8f, 0x06, 0x09,
-		0xda, 0xac, 0x8b, 0x95, 0xcf, 0x03, 0x3d, 0x05, 0xab, 0xe0, 0x72, 0xad, 0x0d, 0x85, 0x6f, 0x73,
-		0x46, 0x5c, 0xf8, 0xfc, 0xb3, 0x30, 0x4a, 0xfe, 0xa6, 0x26, 0xcb, 0xcf, 0x49, 0xfb, 0x1a, 0x5c,
-		0xf6, 0x7b, 0xef, 0x63, 0x5b, 0x75, 0xc4, 0x25, 0xe0, 0xe3, 0xc9, 0xb7, 0x8a, 0x55, 0xec, 0x38,
-		0xd8, 0xb2, 0x4b, 0x6a, 0x2d, 0x8a, 0x3d, 0x5f, 0x11, 0x23, 0xfb, 0xa9, 0x9f, 0x04, 0x57, 0x71,
-		0x9e, 0x61, 0xce, 0xd4, 0x6a, 0xf9, 0x0d, 0x38, 0x10, 0xa1, 0x15, 0x1d, 0xd0, 0x7c, 0x99, 0xd3,
-		0x1c, 0x6d, 0xd2, 0x0c, 0x42, 0x76, 0x15, 0x44, 0xbb, 0xbb, 0x96, 0x1d, 0xd0, 0xfc, 0x34, 0xa7,
-		0x89, 0x38, 0xae, 0x58, 0x52, 0x42, 0xf1, 0x69, 0x18, 0xbe, 0x86, 0xad, 0x4d, 0xc3, 0xe6, 0x85,
-		0xa3, 0x0e, 0xc8, 0x7d, 0x86, 0x93, 0x1b, 0xe2, 0x88, 0xb4, 0x92, 0x44, 0x68, 0x5d, 0x84, 0xd4,
-		0x96, 0x5a, 0xc6, 0x1d, 0x90, 0xb8, 0xc5, 0x49, 0xf4, 0x12, 0x78, 0x82, 0x3a, 0x03, 0xfd, 0x55,
-		0x83, 0x7b, 0xad, 0xf6, 0xe8, 0x9f, 0xe5, 0xe8, 0x7d, 0x02, 0x87, 0x93, 0x30, 0x0d, 0xb3, 0x51,
-		0x23, 0x2e, 0xad, 0x3d, 0x89, 0xbf, 0x23, 0x48, 0x08, 0x1c, 0x4e, 0xa2, 0x0b, 0xb1, 0x7e, 0x4e,
-		0x90, 0xb0, 0x7d, 0xf2, 0xbc, 0x0c, 0x7d, 0x86, 0x5e, 0xdb, 0x35, 0xf4, 0x4e, 0x98, 0x78, 0x85,
-		0x53, 0x00, 0x8e, 0x42, 0x08, 0x5c, 0x82, 0x74, 0xa7, 0x0b, 0xf1, 0xf7, 0x7e, 0x22, 0xb6, 0x87,
-		0x58, 0x81, 0x79, 0x18, 0x12, 0x06, 0x4a, 0x33, 0xf4, 0x0e, 0x48, 0xfc, 0x7d, 0x4e, 0x62, 0xd0,
-		0x87, 0xc6, 0xa7, 0xe1, 0x60, 0xdb, 0xa9, 0xe2, 0x4e, 0x88, 0x7c, 0x41, 0x4c, 0x83, 0xa3, 0x70,
-		0x51, 0x6e, 0x62, 0xbd, 0xbc, 0xdd, 0x19, 0x85, 0x2f, 0x0a, 0x51, 0x0a, 0x1c, 0x42, 0x62, 0x16,
-		0x06, 0xea, 0xaa, 0x65, 0x6f, 0xab, 0xb5, 0x8e, 0x96, 0xe3, 0x1f, 0x70, 0x1a, 0xfd, 0x2e, 0x12,
-		0x97, 0x48, 0x43, 0xef, 0x86, 0xcc, 0x97, 0x84, 0x44, 0x7c, 0x68, 0x7c, 0xeb, 0xd9, 0x0e, 0xad,
-		0xb2, 0x75, 0x43, 0xed, 0x37, 0xc5, 0xd6, 0x63, 0xb8, 0x4b, 0x7e, 0x8a, 0x97, 0x20, 0x6d, 0x6b,
-		0x2f, 0x76, 0x44, 0xe6, 0xb7, 0xc4, 0x4a, 0x53, 0x04, 0x82, 0xfc, 0x76, 0x38, 0x18, 0xe9, 0x26,
-		0x3a, 0x20, 0xf6, 0x0f, 0x39, 0xb1, 0xb1, 0x08, 0x57, 0xc1, 0x4d, 0x42, 0xb7, 0x24, 0xff, 0x91,
-		0x30, 0x09, 0x38, 0x44, 0x6b, 0x95, 0xe4, 0x11, 0xb6, 0xba, 0xd5, 0x9d, 0xd4, 0xfe, 0xb1, 0x90,
-		0x1a, 0xc3, 0x0d, 0x48, 0x6d, 0x1d, 0xc6, 0x38, 0xc5, 0xee, 0xd6, 0xf5, 0xb7, 0x85, 0x61, 0x65,
-		0xd8, 0x1b, 0xc1, 0xd5, 0xfd, 0x45, 0xc8, 0xb9, 0xe2, 0x14, 0x01, 0xab, 0x5d, 0xaa, 0xab, 0x66,
-		0x07, 0x94, 0xbf, 0xcc, 0x29, 0x0b, 0x8b, 0xef, 0x46, 0xbc, 0xf6, 0x92, 0x6a, 0x12, 0xe2, 0x6f,
-		0x83, 0xac, 0x20, 0xde, 0xd0, 0x2d, 0x5c, 0x36, 0xaa, 0xba, 0xf6, 0x22, 0xae, 0x74, 0x40, 0xfa,
-		0x2b, 0xa1, 0xa5, 0xda, 0xf0, 0xa1, 0x13, 0xca, 0x0b, 0x90, 0x71, 0x63, 0x95, 0x92, 0x56, 0x37,
-		0x0d, 0xcb, 0x69, 0x43, 0xf1, 0x77, 0xc4, 0x4a, 0xb9, 0x78, 0x0b, 0x14, 0x2d, 0x5f, 0x84, 0x41,
-		0xfa, 0xd8, 0xa9, 0x4a, 0xfe, 0x2e, 0x27, 0x34, 0xe0, 0x61, 0x71, 0xc3, 0x51, 0x36, 0xea, 0xa6,
-		0x6a, 0x75, 0x62, 0xff, 0xbe, 0x2a, 0x0c, 0x07, 0x47, 0xe1, 0x86, 0xc3, 0xd9, 0x35, 0x31, 0xf1,
-		0xf6, 0x1d, 0x50, 0xf8, 0x9a, 0x30, 0x1c, 0x02, 0x87, 0x93, 0x10, 0x01, 0x43, 0x07, 0x24, 0xbe,
-		0x2e, 0x48, 0x08, 0x1c, 0x42, 0xe2, 0xad, 0x9e, 0xa3, 0xb5, 0x70, 0x55, 0xb3, 0x1d, 0x8b, 0x85,
-		0xc9, 0x7b, 0x93, 0xfa, 0xbd, 0x9f, 0x04, 0x83, 0x30, 0xc5, 0x87, 0x4a, 0x2c, 0x11, 0x2f, 0xbb,
-		0xd2, 0x2c, 0xaa, 0x3d, 0x63, 0xdf, 0x10, 0x96, 0xc8, 0x87, 0x46, 0x78, 0xf3, 0x45, 0x88, 0x44,
-		0xec, 0x65, 0x92, 0x3b, 0x74, 0x40, 0xee, 0x9f, 0x84, 0x98, 0x5b, 0x13, 0xb8, 0x84, 0xa6, 0x2f,
-		0xfe, 0x69, 0xe8, 0x3b, 0x78, 0xb7, 0x23, 0xed, 0xfc, 0xa7, 0xa1, 0xf8, 0x67, 0x83, 0x61, 0x32,
-		0x1b, 0x32, 0x14, 0x8a, 0xa7, 0x50, 0xbb, 0xfb, 0x43, 0xd9, 0xff, 0xff, 0x67, 0x7c, 0xbe, 0xc1,
-		0x70, 0x2a, 0xbf, 0x48, 0x94, 0x3c, 0x18, 0xf4, 0xb4, 0x27, 0xf6, 0xbe, 0x9f, 0xb9, 0x7a, 0x1e,
-		0x88, 0x79, 0xf2, 0x57, 0x60, 0x20, 0x10, 0xf0, 0xb4, 0x27, 0xf5, 0x7e, 0x4e, 0xaa, 0xdf, 0x1f,
-		0xef, 0xe4, 0xcf, 0x41, 0x82, 0x04, 0x2f, 0xed, 0xd1, 0xff, 0x16, 0x47, 0xa7, 0xe0, 0xf9, 0x27,
-		0x21, 0x25, 0x82, 0x96, 0xf6, 0xa8, 0x1f, 0xe0, 0xa8, 0x2e, 0x0a, 0x41, 0x17, 0x01, 0x4b, 0x7b,
-		0xf4, 0x0f, 0x0a, 0x74, 0x81, 0x42, 0xd0, 0x3b, 0x17, 0xe1, 0x37, 0x7f, 0x29, 0xc1, 0x9d, 0x8e,
-		0x90, 0xdd, 0x25, 0xe8, 0xe5, 0x91, 0x4a, 0x7b, 0xec, 0x0f, 0xf3, 0xc1, 0x05, 0x46, 0xfe, 0x02,
-		0x24, 0x3b, 0x14, 0xf8, 0x2f, 0x73, 0x54, 0x06, 0x9f, 0x9f, 0x85, 0x3e, 0x5f, 0x74, 0xd2, 0x1e,
-		0xfd, 0x57, 0x38, 0xba, 0x1f, 0x8b, 0xb0, 0xce, 0xa3, 0x93, 0xf6, 0x04, 0x3e, 0x22, 0x58, 0xe7,
-		0x18, 0x44, 0x6c, 0x22, 0x30, 0x69, 0x8f, 0xfd, 0x51, 0x21, 0x75, 0x81, 0x92, 0xbf, 0x0c, 0x69,
-		0xd7, 0xd9, 0xb4, 0xc7, 0xff, 0x18, 0xc7, 0xf7, 0x70, 0x88, 0x04, 0x7c, 0xce, 0xae, 0x3d, 0x89,
-		0x5f, 0x15, 0x12, 0xf0, 0x61, 0x91, 0x6d, 0x14, 0x0e, 0x60, 0xda, 0x53, 0xfa, 0x35, 0xb1, 0x8d,
-		0x42, 0xf1, 0x0b, 0x59, 0x4d, 0x6a, 0xf3, 0xdb, 0x93, 0xf8, 0xb8, 0x58, 0x4d, 0x0a, 0x4f, 0xd8,
-		0x08, 0x47, 0x04, 0xed, 0x69, 0xfc, 0xba, 0x60, 0x23, 0x14, 0x10, 0xe4, 0x57, 0x01, 0x35, 0x47,
-		0x03, 0xed, 0xe9, 0x7d, 0x92, 0xd3, 0x1b, 0x6e, 0x0a, 0x06, 0xf2, 0xcf, 0xc1, 0x58, 0x74, 0x24,
-		0xd0, 0x9e, 0xea, 0xa7, 0x7e, 0x16, 0xca, 0xdd, 0xfc, 0x81, 0x40, 0x7e, 0xdd, 0x73, 0x29, 0xfe,
-		0x28, 0xa0, 0x3d, 0xd9, 0x97, 0x7f, 0x16, 0x34, 0xdc, 0xfe, 0x20, 0x20, 0x3f, 0x03, 0xe0, 0x39,
-		0xe0, 0xf6, 0xb4, 0x3e, 0xc3, 0x69, 0xf9, 0x90, 0xc8, 0xd6, 0xe0, 0xfe, 0xb7, 0x3d, 0xfe, 0x2d,
-		0xb1, 0x35, 0x38, 0x06, 0xd9, 0x1a, 0xc2, 0xf5, 0xb6, 0xc7, 0xfe, 0xac, 0xd8, 0x1a, 0x02, 0x85,
-		0x68, 0xb6, 0xcf, 0xbb, 0xb5, 0xa7, 0xf0, 0x8a, 0xd0, 0x6c, 0x1f, 0x56, 0x7e, 0x19, 0x86, 0x9b,
-		0x1c, 0x62, 0x7b, 0x52, 0xbf, 0xc1, 0x49, 0x65, 0xc2, 0xfe, 0xd0, 0xef, 0xbc, 0xb8, 0x33, 0x6c,
-		0x4f, 0xed, 0xf3, 0x21, 0xe7, 0xc5, 0x7d, 0x61, 0xfe, 0x12, 0xa4, 0xf4, 0x46, 0xad, 0x46, 0x36,
-		0x0f, 0xda, 0xfb, 0xce, 0x5f, 0xf6, 0x2f, 0x7f, 0xce, 0xa5, 0x23, 0x10, 0xf2, 0xe7, 0x20, 0x89,
-		0xeb, 0x9b, 0xb8, 0xd2, 0x0e, 0xf3, 0xc7, 0x3f, 0x17, 0x06, 0x93, 0x40, 0xe7, 0x2f, 0x03, 0xb0,
-		0xd2, 0x08, 0x3d, 0x1e, 0x6c, 0x83, 0xfb, 0x5f, 0x7e, 0xce, 0x6f, 0xe3, 0x78, 0x28, 0x1e, 0x01,
-		0x76, 0xb7, 0x67, 0x6f, 0x02, 0x3f, 0x09, 0x12, 0xa0, 0x2b, 0x72, 0x11, 0x7a, 0x9f, 0xb7, 0x0d,
-		0xdd, 0x51, 0xab, 0xed, 0xb0, 0xff, 0x2b, 0xc7, 0x16, 0xf0, 0x44, 0x60, 0x75, 0xc3, 0xc2, 0x8e,
-		0x5a, 0xb5, 0xdb, 0xe1, 0xfe, 0x37, 0x8e, 0xeb, 0x22, 0x10, 0xe4, 0xb2, 0x6a, 0x3b, 0x9d, 0xcc,
-		0xfb, 0xaf, 0x04, 0xb2, 0x40, 0x20, 0x4c, 0x93, 0xbf, 0x77, 0xf0, 0x6e, 0x3b, 0xdc, 0x9f, 0x0a,
-		0xa6, 0x39, 0x7c, 0xfe, 0x49, 0x48, 0x93, 0x3f, 0xd9, 0x15, 0xbb, 0x36, 0xc8, 0xff, 0x9d, 0x23,
-		0x7b, 0x18, 0x64, 0x64, 0xdb, 0xa9, 0x38, 0x5a, 0x7b, 0x61, 0xdf, 0xe1, 0x2b, 0x2d, 0xe0, 0xf3,
-		0x33, 0xd0, 0x67, 0x3b, 0x95, 0x4a, 0x83, 0xc7, 0xa7, 0x6d, 0xd0, 0xff, 0xc7, 0xcf, 0xdd, 0x92,
-		0x85, 0x8b, 0x43, 0x56, 0xfb, 0xfa, 0x8e, 0x63, 0x1a, 0xf4, 0x08, 0xa4, 0x1d, 0x85, 0x9f, 0x71,
-		0x0a, 0x3e, 0x94, 0xfc, 0x2c, 0xf4, 0x93, 0xb9, 0x58, 0xd8, 0xc4, 0xf4, 0xbc, 0xaa, 0x0d, 0x89,
-		0xbf, 0xe6, 0x02, 0x08, 0x20, 0x15, 0xde, 0xf9, 0xed, 0x57, 0xc7, 0xa5, 0xef, 0xbe, 0x3a, 0x2e,
-		0xfd, 0xc5, 0xab, 0xe3, 0xd2, 0x47, 0x7f, 0x30, 0xbe, 0xef, 0xbb, 0x3f, 0x18, 0xdf, 0xf7, 0x27,
-		0x3f, 0x18, 0xdf, 0x17, 0x5d, 0x36, 0x86, 0x79, 0x63, 0xde, 0x60, 0x05, 0xe3, 0x77, 0xc8, 0x81,
-		0x72, 0x71, 0xd5, 0xf0, 0xaa, 0xb5, 0x6e, 0x92, 0x03, 0x7f, 0x2d, 0x91, 0x84, 0x39, 0x58, 0xcb,
-		0x55, 0xf5, 0xdd, 0x16, 0xef, 0xe0, 0xe4, 0x22, 0x0b, 0xc3, 0xf2, 0x5b, 0x20, 0x3e, 0xa3, 0xef,
-		0xa2, 0x83, 0xcc, 0xe6, 0x95, 0x1a, 0x56, 0x8d, 0x5f, 0xfd, 0xea, 0x25, 0xcf, 0x1b, 0x56, 0x0d,
-		0x8d, 0x7a, 0xf7, 0x33, 0xa5, 0x13, 0xfd, 0xfc, 0xd2, 0x65, 0x3e, 0xf1, 0xd3, 0x57, 0x26, 0xf6,
-		0x15, 0x76, 0xc2, 0x33, 0xfc, 0x66, 0xdb, 0x59, 0xa6, 0x66, 0xf4, 0x5d, 0x3a, 0xc9, 0x55, 0xe9,
-		0x1d, 0x49, 0x32, 0x86, 0x2d, 0x0a, 0xdb, 0xe3, 0xe1, 0xc2, 0xf6, 0x73, 0xb8, 0x56, 0x7b, 0x46,
-		0x37, 0xae, 0xeb, 0xeb, 0x04, 0x6c, 0xb3, 0x87, 0xd2, 0x78, 0x1c, 0xfe, 0x76, 0x0c, 0xc6, 0x9b,
-		0x6a, 0xd8, 0x7c, 0xe5, 0x5b, 0xbd, 0x80, 0x94, 0x87, 0xd4, 0x9c, 0x50, 0xa8, 0x2c, 0xf4, 0xda,
-		0xb8, 0x6c, 0xe8, 0x15, 0x9b, 0x4e, 0x35, 0xae, 0x88, 0x47, 0x32, 0x55, 0x5d, 0xd5, 0x0d, 0x9b,
-		0x5f, 0x8f, 0x64, 0x0f, 0x85, 0x8f, 0x4b, 0xdd, 0xad, 0xe3, 0x80, 0x18, 0x49, 0x4c, 0xf3, 0xd4,
-		0x5e, 0xb5, 0x7f, 0x2a, 0x02, 0x97, 0x7f, 0x5f, 0x9d, 0xbf, 0x53, 0x71, 0x7c, 0x34, 0x06, 0x13,
-		0x61, 0x71, 0x90, 0x7d, 0x64, 0x3b, 0x6a, 0xdd, 0x6c, 0x25, 0x8f, 0x4b, 0x90, 0x5e, 0x17, 0x30,
-		0x5d, 0x0b, 0xe4, 0x13, 0x5d, 0x0a, 0x64, 0xd0, 0x1d, 0x4a, 0x48, 0xe4, 0x74, 0x7b, 0x89, 0xb8,
-		0x53, 0xb8, 0x0b, 0x91, 0xbc, 0x37, 0x0e, 0x07, 0xcb, 0x86, 0x5d, 0x37, 0xec, 0x12, 0x53, 0x78,
-		0xf6, 0xc0, 0x85, 0xd1, 0xef, 0xef, 0xea, 0xe0, 0x38, 0xe4, 0x2a, 0x0c, 0x52, 0xa3, 0x40, 0x0b,
-		0xc1, 0xd4, 0x0e, 0xb7, 0x75, 0x9d, 0x7f, 0xf0, 0xef, 0x93, 0x74, 0x13, 0x0d, 0xb8, 0x88, 0xf4,
-		0xa6, 0xcb, 0x3a, 0x8c, 0x6a, 0x75, 0xb3, 0x86, 0xe9, 0x91, 0x58, 0xc9, 0xed, 0x6b, 0x4f, 0xef,
-		0x3b, 0x9c, 0xde, 0x88, 0x87, 0xbe, 0x20, 0xb0, 0xf3, 0x8b, 0x30, 0xac, 0x96, 0xcb, 0xd8, 0x0c,
-		0x90, 0x6c, 0x63, 0xb0, 0x04, 0x83, 0x19, 0x8e, 0xe9, 0x52, 0x2b, 0x5c, 0x6e, 0xb5, 0xb6, 0xef,
-		0x78, 0xd0, 0xb7, 0x68, 0x16, 0xae, 0x62, 0xfd, 0x11, 0x1d, 0x3b, 0xd7, 0x0d, 0x6b, 0x87, 0x8b,
-		0xf7, 0x11, 0x36, 0x94, 0x58, 0x84, 0xf7, 0xc7, 0x61, 0x9c, 0x75, 0x4c, 0x6f, 0xaa, 0x36, 0x9e,
-		0xbe, 0xf6, 0xd8, 0x26, 0x76, 0xd4, 0xc7, 0xa6, 0xcb, 0x86, 0x26, 0xb6, 0xe9, 0x08, 0x5f, 0x17,
-		0xd2, 0x3f, 0xc5, 0xfb, 0x5b, 0xd8, 0xa9, 0x79, 0x48, 0xcc, 0x1a, 0x9a, 0x4e, 0x34, 0xb2, 0x82,
-		0x75, 0xa3, 0xce, 0xad, 0x14, 0x7b, 0x40, 0xc7, 0xa0, 0x47, 0xad, 0x1b, 0x0d, 0xdd, 0x61, 0xa7,
-		0x79, 0x85, 0xbe, 0x6f, 0xdf, 0x9e, 0xd8, 0xf7, 0xa7, 0xb7, 0x27, 0xe2, 0x0b, 0xba, 0xa3, 0xf0,
-		0xae, 0x7c, 0xe2, 0x47, 0x9f, 0x9b, 0x90, 0xe4, 0xa7, 0xa1, 0x77, 0x0e, 0x97, 0xef, 0x86, 0xd6,
-		0x1c, 0x2e, 0x87, 0x68, 0x9d, 0x84, 0xd4, 0x82, 0xee, 0xb0, 0x2b, 0xc4, 0x47, 0x20, 0xae, 0xe9,
-		0xec, 0x52, 0x5a, 0x68, 0x7c, 0xd2, 0x4e, 0x40, 0xe7, 0x70, 0xd9, 0x05, 0xad, 0xe0, 0x72, 0x18,
-		0x94, 0x90, 0x27, 0xed, 0x85, 0xb9, 0x3f, 0xf9, 0x8f, 0xe3, 0xfb, 0x5e, 0x7a, 0x75, 0x7c, 0x5f,
-		0xcb, 0x95, 0xf0, 0x7b, 0x07, 0x2e, 0x62, 0xbe, 0x04, 0x76, 0x65, 0x67, 0xda, 0x09, 0xec, 0x85,
-		0x2f, 0x25, 0xe0, 0x08, 0x7d, 0x29, 0xc4, 0xaa, 0x6b, 0xba, 0x33, 0x5d, 0xb6, 0x76, 0x4d, 0x87,
-		0xba, 0x13, 0x63, 0x8b, 0xaf, 0xc2, 0xb0, 0xd7, 0x3d, 0xc5, 0xba, 0x5b, 0xac, 0xc1, 0x16, 0x24,
-		0x57, 0x09, 0x1e, 0x11, 0x9c, 0x63, 0x38, 0x6a, 0x8d, 0x9b, 0x0b, 0xf6, 0x40, 0x5a, 0xd9, 0x8b,
-		0x24, 0x31, 0xd6, 0xaa, 0x89, 0x77, 0x48, 0x6a, 0x58, 0xdd, 0x62, 0x17, 0x77, 0xe3, 0xd4, 0x85,
-		0xa4, 0x48, 0x03, 0xbd, 0xa3, 0x3b, 0x0a, 0x49, 0xb5, 0xc1, 0x8e, 0x9c, 0xe3, 0xc4, 0xb7, 0xd0,
-		0x07, 0xf9, 0x19, 0xe8, 0xe5, 0xc7, 0x5c, 0x28, 0x03, 0xf1, 0x1d, 0xbc, 0x4b, 0xc7, 0xe9, 0x57,
-		0xc8, 0x9f, 0x68, 0x0a, 0x92, 0x94, 0x79, 0xfe, 0x46, 0x42, 0x76, 0xaa, 0x89, 0xfb, 0x29, 0xca,
-		0xa4, 0xc2, 0xc0, 0xe4, 0xa7, 0x21, 0x35, 0x67, 0xd4, 0x35, 0xdd, 0x08, 0x52, 0x4b, 0x33, 0x6a,
-		0x94, 0x67, 0xb3, 0xc1, 0xd7, 0x5a, 0x61, 0x0f, 0x68, 0x0c, 0x7a, 0xd8, 0x45, 0x6e, 0x7e, 0x6c,
-		0xce, 0x9f, 0xe4, 0x59, 0xe8, 0xa5, 0xb4, 0x57, 0x4c, 0x84, 0xf8, 0x9b, 0x3d, 0xfc, 0xc6, 0x38,
-		0x35, 0x0b, 0x9c, 0x7c, 0xcc, 0x63, 0x16, 0x41, 0xa2, 0xa2, 0x3a, 0x2a, 0x9f, 0x37, 0xfd, 0x5b,
-		0x7e, 0x0a, 0x52, 0x9c, 0x88, 0x8d, 0xce, 0x40, 0xdc, 0x30, 0x6d, 0x7e, 0xf0, 0x9d, 0x6b, 0x35,
-		0x95, 0x15, 0xb3, 0x90, 0x20, 0x5a, 0xa2, 0x10, 0xe0, 0x82, 0xd2, 0x52, 0x2d, 0x9e, 0xf0, 0xa9,
-		0x85, 0x6f, 0xc9, 0x7d, 0x7f, 0xb2, 0x25, 0x6d, 0x52, 0x07, 0x57, 0x59, 0x5e, 0x89, 0xc1, 0xb8,
-		0xaf, 0xf7, 0x1a, 0xb6, 0x48, 0xae, 0xc7, 0x34, 0x8a, 0x6b, 0x0b, 0xf2, 0x31, 0xc9, 0xfb, 0x5b,
-		0xa8, 0xcb, 0x93, 0x10, 0x9f, 0x31, 0x4d, 0x94, 0x83, 0x14, 0x3b, 0xe0, 0x36, 0x98, 0xbe, 0x24,
-		0x14, 0xf7, 0x99, 0xf4, 0xd9, 0xc6, 0x96, 0x73, 0x5d, 0xb5, 0xdc, 0x57, 0x98, 0xc4, 0xb3, 0x7c,
-		0x11, 0xd2, 0xb3, 0x86, 0x6e, 0x63, 0xdd, 0x6e, 0x50, 0x47, 0xb4, 0x59, 0x33, 0xca, 0x3b, 0x9c,
-		0x02, 0x7b, 0x20, 0x02, 0x57, 0x4d, 0x93, 0x62, 0x26, 0x14, 0xf2, 0x27, 0xdb, 0x97, 0x85, 0xb5,
-		0x96, 0x22, 0xba, 0xd8, 0xbd, 0x88, 0xf8, 0x24, 0x5d, 0x19, 0xfd, 0x2f, 0x09, 0x0e, 0x37, 0x6f,
-		0xa8, 0x1d, 0xbc, 0x6b, 0x77, 0xbb, 0x9f, 0xde, 0x06, 0xe9, 0x55, 0xfa, 0x1e, 0xf1, 0x33, 0x78,
-		0x17, 0xe5, 0xa0, 0x17, 0x57, 0xce, 0x9c, 0x3b, 0xf7, 0xd8, 0x45, 0xa6, 0xed, 0x57, 0xf7, 0x29,
-		0xa2, 0x01, 0x8d, 0x43, 0xda, 0xc6, 0x65, 0xf3, 0xcc, 0xb9, 0xf3, 0x3b, 0x8f, 0x31, 0xf5, 0xba,
-		0xba, 0x4f, 0xf1, 0x9a, 0xf2, 0x29, 0x32, 0xeb, 0x1f, 0xbd, 0x32, 0x21, 0x15, 0x92, 0x10, 0xb7,
-		0x1b, 0xf5, 0xfb, 0xaa, 0x23, 0x2f, 0x27, 0x61, 0xd2, 0x8f, 0x49, 0xbd, 0xf5, 0x35, 0xb5, 0xa6,
-		0x55, 0x54, 0xef, 0x0d, 0xf0, 0x8c, 0x4f, 0x06, 0x14, 0x22, 0x5a, 0x04, 0xb9, 0x3d, 0x25, 0x29,
-		0x7f, 0x45, 0x82, 0xfe, 0x67, 0x05, 0xe5, 0x35, 0xec, 0xa0, 0x4b, 0x00, 0xee, 0x48, 0x62, 0xdb,
-		0x1c, 0x9a, 0x0a, 0x8f, 0x35, 0xe5, 0xe2, 0x28, 0x3e, 0x70, 0x74, 0x81, 0x2a, 0xa2, 0x69, 0xd8,
-		0xfc, 0xfd, 0x97, 0x36, 0xa8, 0x2e, 0x30, 0x7a, 0x18, 0x10, 0xb5, 0x70, 0xa5, 0x6b, 0x86, 0xa3,
-		0xe9, 0xd5, 0x92, 0x69, 0x5c, 0xe7, 0x2f, 0x0b, 0xc6, 0x95, 0x0c, 0xed, 0x79, 0x96, 0x76, 0xac,
-		0x92, 0x76, 0xc2, 0x74, 0xda, 0xa5, 0x42, 0x62, 0x2b, 0xb5, 0x52, 0xb1, 0xb0, 0x6d, 0x73, 0x23,
-		0x26, 0x1e, 0xd1, 0x25, 0xe8, 0x35, 0x1b, 0x9b, 0x25, 0x61, 0x31, 0xfa, 0xce, 0x1c, 0x8e, 0xda,
-		0xff, 0x42, 0x3f, 0xb8, 0x05, 0xe8, 0x31, 0x1b, 0x9b, 0x44, 0x5b, 0x8e, 0x42, 0x7f, 0x04, 0x33,
-		0x7d, 0xd7, 0x3c, 0x3e, 0xe8, 0xeb, 0xeb, 0x7c, 0x06, 0x25, 0xd3, 0xd2, 0x0c, 0x4b, 0x73, 0x76,
-		0xe9, 0xed, 0x95, 0xb8, 0x92, 0x11, 0x1d, 0xab, 0xbc, 0x5d, 0xde, 0x81, 0xa1, 0x35, 0x1a, 0x5b,
-		0x78, 0x9c, 0x9f, 0xf3, 0xf8, 0x93, 0xda, 0xf3, 0xd7, 0x92, 0xb3, 0x58, 0x13, 0x67, 0x85, 0xb7,
-		0xb6, 0xd4, 0xce, 0x0b, 0xdd, 0x6b, 0x67, 0xd0, 0xdb, 0xfd, 0xd5, 0xc1, 0xc0, 0xe6, 0xe4, 0xa1,
-		0xa4, 0xcf, 0x7c, 0x75, 0xaa, 0x98, 0xed, 0x42, 0xea, 0xdc, 0xde, 0x4e, 0x35, 0xd7, 0xc6, 0x8c,
-		0xe6, 0xda, 0x6e, 0x21, 0xf9, 0x22, 0x0c, 0xac, 0xaa, 0x96, 0xb3, 0x86, 0x9d, 0xab, 0x58, 0xad,
-		0x60, 0x2b, 0xe8, 0x75, 0x07, 0x84, 0xd7, 0x45, 0x90, 0xa0, 0xae, 0x95, 0x79, 0x1d, 0xfa, 0xb7,
-		0xbc, 0x0d, 0x09, 0x7a, 0x83, 0xcd, 0xf5, 0xc8, 0x1c, 0x83, 0x79, 0x64, 0x62, 0x4b, 0x77, 0x1d,
-		0x6c, 0x8b, 0x84, 0x8e, 0x3e, 0xa0, 0xb3, 0xc2, 0xaf, 0xc6, 0xf7, 0xf6, 0xab, 0x5c, 0x11, 0xb9,
-		0x77, 0xad, 0x41, 0x6f, 0x81, 0x98, 0xe2, 0x85, 0x39, 0x97, 0x11, 0xc9, 0x63, 0x04, 0x2d, 0xc1,
-		0x90, 0xa9, 0x5a, 0x0e, 0xbd, 0xba, 0xbf, 0x4d, 0x67, 0xc1, 0x75, 0x7d, 0xa2, 0x79, 0xe7, 0x05,
-		0x26, 0xcb, 0x47, 0x19, 0x30, 0xfd, 0x8d, 0xf2, 0x7f, 0x4a, 0x40, 0x0f, 0x17, 0xc6, 0x93, 0xd0,
-		0xcb, 0xc5, 0xca, 0xb5, 0xf3, 0xc8, 0x54, 0xb3, 0x63, 0x9a, 0x72, 0x1d, 0x08, 0xa7, 0x27, 0x70,
-		0xd0, 0x71, 0x48, 0x95, 0xb7, 0x55, 0x4d, 0x2f, 0x69, 0x15, 0x11, 0xe6, 0xbd, 0x7a, 0x7b, 0xa2,
-		0x77, 0x96, 0xb4, 0x2d, 0xcc, 0x29, 0xbd, 0xb4, 0x73, 0xa1, 0x42, 0x22, 0x81, 0x6d, 0xac, 0x55,
-		0xb7, 0x1d, 0xbe, 0xc3, 0xf8, 0x13, 0x7a, 0x02, 0x12, 0x44, 0x21, 0xf8, 0x9b, 0x5d, 0xb9, 0xa6,
-		0x60, 0xdb, 0xcd, 0x78, 0x0a, 0x29, 0x32, 0xf0, 0x47, 0xbf, 0x3f, 0x21, 0x29, 0x14, 0x03, 0xcd,
-		0xc2, 0x40, 0x4d, 0xb5, 0x9d, 0x12, 0xf5, 0x60, 0x64, 0xf8, 0x24, 0x25, 0x71, 0xb0, 0x59, 0x20,
-		0x5c, 0xb0, 0x9c, 0xf5, 0x3e, 0x82, 0xc5, 0x9a, 0x2a, 0xe8, 0x04, 0x64, 0x28, 0x91, 0xb2, 0x51,
-		0xaf, 0x6b, 0x0e, 0x8b, 0xad, 0x7a, 0xa8, 0xdc, 0x07, 0x49, 0xfb, 0x2c, 0x6d, 0xa6, 0x11, 0xd6,
-		0x21, 0x48, 0xd3, 0x57, 0x49, 0x28, 0x08, 0xbb, 0x36, 0x99, 0x22, 0x0d, 0xb4, 0xf3, 0x21, 0x18,
-		0xf2, 0xec, 0x23, 0x03, 0x49, 0x31, 0x2a, 0x5e, 0x33, 0x05, 0x7c, 0x14, 0x46, 0x75, 0x7c, 0x83,
-		0x5e, 0xe4, 0x0c, 0x40, 0xa7, 0x29, 0x34, 0x22, 0x7d, 0xcf, 0x06, 0x31, 0x1e, 0x84, 0xc1, 0xb2,
-		0x10, 0x3e, 0x83, 0x05, 0x0a, 0x3b, 0xe0, 0xb6, 0x52, 0xb0, 0x83, 0x90, 0x52, 0x4d, 0x93, 0x01,
-		0xf4, 0x71, 0xfb, 0x68, 0x9a, 0xb4, 0xeb, 0x14, 0x0c, 0xd3, 0x39, 0x5a, 0xd8, 0x6e, 0xd4, 0x1c,
-		0x4e, 0xa4, 0x9f, 0xc2, 0x0c, 0x91, 0x0e, 0x85, 0xb5, 0x53, 0xd8, 0x63, 0x30, 0x80, 0xaf, 0x69,
-		0x15, 0xac, 0x97, 0x31, 0x83, 0x1b, 0xa0, 0x70, 0xfd, 0xa2, 0x91, 0x02, 0x9d, 0x04, 0xd7, 0xee,
-		0x95, 0x84, 0x4d, 0x1e, 0x64, 0xf4, 0x44, 0xfb, 0x0c, 0x6b, 0x96, 0xb3, 0x90, 0x98, 0x53, 0x1d,
-		0x95, 0x04, 0x18, 0xce, 0x0d, 0xe6, 0x68, 0xfa, 0x15, 0xf2, 0xa7, 0xfc, 0xa3, 0x18, 0x24, 0x9e,
-		0x35, 0x1c, 0x8c, 0x1e, 0xf7, 0x05, 0x80, 0x83, 0x51, 0xfa, 0xbc, 0xa6, 0x55, 0x75, 0x5c, 0x59,
-		0xb2, 0xab, 0xbe, 0xf7, 0xb9, 0x3d, 0x75, 0x8a, 0x05, 0xd4, 0x69, 0x14, 0x92, 0x96, 0xd1, 0xd0,
-		0x2b, 0xe2, 0xc6, 0x21, 0x7d, 0x40, 0x45, 0x48, 0xb9, 0x5a, 0x92, 0x68, 0xa7, 0x25, 0x43, 0x44,
-		0x4b, 0x88, 0x0e, 0xf3, 0x06, 0xa5, 0x77, 0x93, 0x2b, 0x4b, 0x01, 0xd2, 0xae, 0xf1, 0xe2, 0xda,
-		0xd6, 0x99, 0xc2, 0x7a, 0x68, 0xc4, 0x99, 0xb8, 0x6b, 0xef, 0x0a, 0x8f, 0x69, 0x5c, 0xc6, 0xed,
-		0xe0, 0xd2, 0x0b, 0xa8, 0x15, 0x7f, 0xb7, 0xbc, 0x97, 0xce, 0xcb, 0x53, 0x2b, 0xf6, 0x7e, 0xf9,
-		0x61, 0x48, 0xdb, 0x5a, 0x55, 0x57, 0x9d, 0x86, 0x85, 0xb9, 0xe6, 0x79, 0x0d, 0xf2, 0x37, 0x25,
-		0xe8, 0x61, 0x9a, 0xec, 0x93, 0x9b, 0x14, 0x2d, 0xb7, 0x58, 0x2b, 0xb9, 0xc5, 0xef, 0x5e, 0x6e,
-		0x33, 0x00, 0x2e, 0x33, 0x36, 0x7f, 0x37, 0x38, 0x22, 0x62, 0x60, 0x2c, 0xae, 0x69, 0x55, 0xbe,
-		0x51, 0x7d, 0x48, 0xf2, 0x9f, 0x4b, 0x24, 0x88, 0xe5, 0xfd, 0x68, 0x06, 0x06, 0x04, 0x5f, 0xa5,
-		0xad, 0x9a, 0x5a, 0xe5, 0xba, 0x73, 0xa4, 0x25, 0x73, 0x57, 0x6a, 0x6a, 0x55, 0xe9, 0xe3, 0xfc,
-		0x90, 0x87, 0xe8, 0x75, 0x88, 0xb5, 0x58, 0x87, 0xc0, 0xc2, 0xc7, 0xef, 0x6e, 0xe1, 0x03, 0x4b,
-		0x94, 0x08, 0x2f, 0xd1, 0xef, 0xc4, 0x68, 0x32, 0x63, 0x1a, 0xb6, 0x5a, 0x7b, 0x3d, 0x76, 0xc4,
-		0x21, 0x48, 0x9b, 0x46, 0xad, 0xc4, 0x7a, 0xd8, 0x4d, 0xdc, 0x94, 0x69, 0xd4, 0x94, 0xa6, 0x65,
-		0x4f, 0xde, 0xa3, 0xed, 0xd2, 0x73, 0x0f, 0xa4, 0xd6, 0x1b, 0x96, 0x9a, 0x05, 0xfd, 0x4c, 0x14,
-		0xdc, 0x97, 0x3d, 0x4a, 0x64, 0x40, 0x9d, 0xa3, 0xd4, 0xec, 0x7b, 0x19, 0xdb, 0x0c, 0x52, 0xe1,
-		0x70, 0x04, 0x83, 0x99, 0xfe, 0xa8, 0x2c, 0xd8, 0xaf, 0x96, 0x0a, 0x87, 0x93, 0x3f, 0x21, 0x01,
-		0x2c, 0x12, 0xc9, 0xd2, 0xf9, 0x12, 0x2f, 0x64, 0x53, 0x16, 0x4a, 0x81, 0x91, 0xc7, 0x5b, 0x2d,
-		0x1a, 0x1f, 0xbf, 0xdf, 0xf6, 0xf3, 0x3d, 0x0b, 0x03, 0x9e, 0x32, 0xda, 0x58, 0x30, 0x33, 0xbe,
-		0x47, 0x54, 0xbd, 0x86, 0x1d, 0xa5, 0xff, 0x9a, 0xef, 0x49, 0xfe, 0x97, 0x12, 0xa4, 0x29, 0x4f,
-		0x4b, 0xd8, 0x51, 0x03, 0x6b, 0x28, 0xdd, 0xfd, 0x1a, 0x1e, 0x01, 0x60, 0x64, 0x6c, 0xed, 0x45,
-		0xcc, 0x35, 0x2b, 0x4d, 0x5b, 0xd6, 0xb4, 0x17, 0x31, 0x3a, 0xef, 0x0a, 0x3c, 0xbe, 0xb7, 0xc0,
-		0x45, 0xd4, 0xcd, 0xc5, 0x7e, 0x00, 0x7a, 0xe9, 0x27, 0x72, 0x6e, 0xd8, 0x3c, 0x90, 0xee, 0xd1,
-		0x1b, 0xf5, 0xf5, 0x1b, 0xb6, 0xfc, 0x3c, 0xf4, 0xae, 0xdf, 0x60, 0xb5, 0x91, 0x43, 0x90, 0xb6,
-		0x0c, 0x83, 0xfb, 0x64, 0x16, 0x0b, 0xa5, 0x48, 0x03, 0x75, 0x41, 0xa2, 0x1e, 0x10, 0xf3, 0xea,
-		0x01, 0x5e, 0x41, 0x23, 0xde, 0x51, 0x41, 0xe3, 0xd4, 0x1f, 0x4b, 0xd0, 0xe7, 0xb3, 0x0f, 0xe8,
-		0x31, 0xd8, 0x5f, 0x58, 0x5c, 0x99, 0x7d, 0xa6, 0xb4, 0x30, 0x57, 0xba, 0xb2, 0x38, 0x33, 0xef,
-		0xbd, 0x6b, 0x92, 0x1b, 0xbb, 0x79, 0x6b, 0x12, 0xf9, 0x60, 0x37, 0xf4, 0x1d, 0xdd, 0xb8, 0xae,
-		0xa3, 0x69, 0x18, 0x0d, 0xa2, 0xcc, 0x14, 0xd6, 0x8a, 0xcb, 0xeb, 0x19, 0x29, 0xb7, 0xff, 0xe6,
-		0xad, 0xc9, 0x61, 0x1f, 0xc6, 0xcc, 0xa6, 0x8d, 0x75, 0xa7, 0x19, 0x61, 0x76, 0x65, 0x69, 0x69,
-		0x61, 0x3d, 0x13, 0x6b, 0x42, 0xe0, 0x06, 0xfb, 0x24, 0x0c, 0x07, 0x11, 0x96, 0x17, 0x16, 0x33,
-		0xf1, 0x1c, 0xba, 0x79, 0x6b, 0x72, 0xd0, 0x07, 0xbd, 0xac, 0xd5, 0x72, 0xa9, 0x0f, 0x7d, 0x7e,
-		0x7c, 0xdf, 0x17, 0xff, 0xee, 0xb8, 0x44, 0x66, 0x36, 0x10, 0xb0, 0x11, 0xe8, 0x61, 0x38, 0xb0,
-		0xb6, 0x30, 0xbf, 0x5c, 0x9c, 0x2b, 0x2d, 0xad, 0xcd, 0x97, 0xd8, 0x47, 0x36, 0xdc, 0xd9, 0x0d,
-		0xdd, 0xbc, 0x35, 0xd9, 0xc7, 0xa7, 0xd4, 0x0a, 0x7a, 0x55, 0x29, 0x3e, 0xbb, 0xb2, 0x5e, 0xcc,
-		0x48, 0x0c, 0x7a, 0xd5, 0xc2, 0xd7, 0x0c, 0x87, 0x7d, 0x43, 0xeb, 0x51, 0x38, 0x18, 0x01, 0xed,
-		0x4e, 0x6c, 0xf8, 0xe6, 0xad, 0xc9, 0x81, 0x55, 0x0b, 0xb3, 0xfd, 0x43, 0x31, 0xa6, 0x20, 0xdb,
-		0x8c, 0xb1, 0xb2, 0xba, 0xb2, 0x36, 0xb3, 0x98, 0x99, 0xcc, 0x65, 0x6e, 0xde, 0x9a, 0xec, 0x17,
-		0xc6, 0x90, 0xc0, 0x7b, 0x33, 0xbb, 0x9f, 0x19, 0xcf, 0x57, 0x4f, 0xc0, 0xfe, 0xaa, 0xda, 0xb0,
-		0xed, 0xe9, 0x0a, 0xde, 0xd2, 0xe8, 0x3f, 0x3c, 0xd5, 0x01, 0xda, 0x3c, 0x45, 0x5a, 0x5a, 0x24,
-		0x39, 0xad, 0x8f, 0x8f, 0x72, 0x6d, 0x4e, 0x58, 0xda, 0xe7, 0x47, 0xad, 0x0b, 0xf0, 0xb9, 0x36,
-		0x65, 0xe1, 0xdc, 0x9e, 0x19, 0x9c, 0xfc, 0x22, 0x0c, 0x5e, 0xd5, 0x6c, 0xc7, 0xb0, 0xb4, 0xb2,
-		0x5a, 0xa3, 0x6f, 0x91, 0x9c, 0xef, 0xd4, 0x7e, 0x86, 0xb6, 0xf3, 0xa3, 0xd0, 0x4b, 0x84, 0xc3,
-		0x2c, 0x17, 0xf1, 0xee, 0x99, 0x29, 0x4f, 0x64, 0x53, 0x73, 0x78, 0x4b, 0x13, 0x69, 0x03, 0x07,
-		0x93, 0xff, 0x40, 0x82, 0xbe, 0x39, 0xdf, 0xc5, 0x9d, 0x2c, 0xf4, 0xd6, 0x0d, 0x5d, 0xdb, 0xe1,
-		0x43, 0xa7, 0x15, 0xf1, 0x88, 0x72, 0x90, 0x62, 0xef, 0x50, 0x39, 0xbb, 0xa2, 0xb2, 0x25, 0x9e,
-		0x09, 0xd6, 0x75, 0xbc, 0x69, 0x6b, 0x8e, 0x78, 0xdd, 0x4a, 0x3c, 0xa2, 0x2b, 0x90, 0xb1, 0x71,
-		0xb9, 0x41, 0x52, 0xf2, 0x52, 0xd9, 0xd0, 0x1d, 0xb5, 0xec, 0xb0, 0xb7, 0x71, 0x0a, 0x87, 0xee,
-		0xdc, 0x9e, 0x38, 0xb0, 0xab, 0xd6, 0x6b, 0x79, 0x39, 0x0c, 0x21, 0x2b, 0x43, 0xa2, 0x69, 0x96,
-		0xb5, 0x90, 0x11, 0x2a, 0xd8, 0x51, 0xb5, 0x9a, 0x9d, 0x65, 0x75, 0x7e, 0xf1, 0x98, 0x4f, 0x7d,
-		0xf2, 0x73, 0x13, 0xfb, 0x68, 0xf1, 0xfa, 0xeb, 0x49, 0x48, 0x90, 0x39, 0x92, 0x41, 0x0d, 0x13,
-		0x5b, 0x81, 0x90, 0x42, 0x0a, 0x0f, 0x1a, 0x86, 0x90, 0x95, 0x21, 0xd1, 0x24, 0xc2, 0x8d, 0x29,
-		0xe8, 0xb1, 0x1d, 0xd5, 0x69, 0xb0, 0x80, 0x64, 0xf0, 0xcc, 0x98, 0x5f, 0x9a, 0x05, 0x43, 0xaf,
-		0xac, 0xd1, 0x5e, 0x85, 0x43, 0xa1, 0x2b, 0xd0, 0xe3, 0x18, 0x3b, 0x98, 0x7f, 0xc8, 0x23, 0x5d,
-		0x98, 0xe2, 0x95, 0xf0, 0xe3, 0xed, 0xab, 0xdb, 0x53, 0xb4, 0xae, 0xcf, 0xb0, 0x91, 0x03, 0x99,
-		0x0a, 0xae, 0xe1, 0x2a, 0x73, 0x43, 0xdb, 0x2a, 0x8b, 0xd6, 0x08, 0xc5, 0x85, 0x2e, 0x28, 0xce,
-		0xe1, 0xb2, 0x37, 0xdb, 0x30, 0x3d, 0x59, 0x19, 0x72, 0x9b, 0xd6, 0x68, 0x0b, 0xba, 0x1c, 0xb8,
-		0xc2, 0xc5, 0x3f, 0x28, 0x76, 0x20, 0xa8, 0x40, 0x6e, 0xb7, 0xc8, 0xe1, 0xfc, 0x97, 0xbe, 0xae,
-		0x40, 0xa6, 0xa1, 0x6f, 0x1a, 0x3a, 0x7d, 0x9f, 0x8a, 0xc7, 0x40, 0x24, 0x06, 0x8e, 0xfb, 0xc5,
-		0x1e, 0x86, 0x90, 0x95, 0x21, 0xb7, 0xe9, 0x2a, 0x8b, 0x94, 0x2a, 0x30, 0xe8, 0x41, 0xd1, 0xa4,
-		0x34, 0xdd, 0x36, 0x68, 0x39, 0x4a, 0xd8, 0xb9, 0x73, 0x7b, 0x62, 0x7f, 0x78, 0x14, 0x82, 0x2f,
-		0xd3, 0x68, 0x66, 0xc0, 0x6d, 0x24, 0x68, 0xe8, 0xdd, 0x30, 0x52, 0xd7, 0xf4, 0x92, 0x8d, 0x6b,
-		0x5b, 0x25, 0x2e, 0x0a, 0x32, 0x6d, 0xfa, 0x15, 0x8b, 0xc2, 0x62, 0x77, 0x2b, 0x77, 0xe7, 0xf6,
-		0x44, 0x8e, 0x0d, 0x1c, 0x41, 0x52, 0x56, 0x86, 0xeb, 0x9a, 0xbe, 0x86, 0x6b, 0x5b, 0x73, 0x6e,
-		0x5b, 0xbe, 0xff, 0x43, 0x9f, 0x9b, 0xd8, 0xc7, 0x35, 0x77, 0x9f, 0x7c, 0x01, 0x06, 0x88, 0xe2,
-		0x72, 0xbd, 0xc3, 0x36, 0x09, 0xb7, 0x54, 0xf1, 0x40, 0x93, 0xb5, 0xb4, 0xe2, 0x35, 0x30, 0x95,
-		0x7f, 0xe9, 0xcf, 0x26, 0x25, 0xf9, 0x96, 0x04, 0x3d, 0x73, 0x73, 0xab, 0xaa, 0x66, 0xa1, 0x05,
-		0x18, 0xf6, 0x16, 0x39, 0xa8, 0xf5, 0x87, 0xef, 0xdc, 0x9e, 0xc8, 0x86, 0xf5, 0xc0, 0x55, 0x7b,
-		0x4f, 0xd7, 0x84, 0xde, 0xe7, 0xa1, 0x9f, 0xac, 0x77, 0x20, 0x1c, 0x4f, 0x17, 0x0e, 0xdc, 0xb9,
-		0x3d, 0x31, 0x22, 0xa8, 0x78, 0xbd, 0x32, 0x51, 0x02, 0x97, 0xf7, 0xd0, 0xc4, 0x2e, 0x42, 0x2f,
-		0x63, 0xcf, 0xa6, 0x51, 0x01, 0xf9, 0x83, 0x17, 0x39, 0x51, 0x40, 0xb1, 0x28, 0x8c, 0x5b, 0x88,
-		0x21, 0x60, 0xf2, 0x5f, 0x4a, 0x00, 0x9e, 0xc0, 0xde, 0x24, 0xd3, 0x23, 0x5b, 0x9c, 0x6f, 0xc8,
-		0xee, 0xb7, 0x38, 0x3d, 0x6e, 0x63, 0xd8, 0x21, 0x31, 0xfd, 0x54, 0x82, 0x91, 0x0d, 0xa1, 0x9d,
-		0x6f, 0xbe, 0x49, 0xcf, 0x41, 0x2f, 0xd6, 0x1d, 0x4b, 0xa3, 0xb3, 0xe6, 0xaf, 0x16, 0x7b, 0x8b,
-		0x17, 0xc1, 0x38, 0xfd, 0x32, 0x84, 0x70, 0x35, 0x1c, 0x35, 0x34, 0xe5, 0x8f, 0xc4, 0x21, 0xdb,
-		0x0a, 0x13, 0xcd, 0xc2, 0x50, 0xd9, 0xc2, 0xb4, 0xa1, 0xe4, 0x4f, 0x93, 0x0b, 0xb9, 0x3b, 0xb7,
-		0x27, 0xc6, 0x18, 0xbf, 0x21, 0x00, 0x59, 0x19, 0x14, 0x2d, 0xdc, 0x8c, 0x54, 0x61, 0xa8, 0x6c,
-		0xd4, 0xcd, 0x1a, 0xa6, 0x50, 0xd4, 0x8e, 0xc4, 0xda, 0xda, 0x11, 0x99, 0xdb, 0x11, 0x31, 0x48,
-		0x90, 0x00, 0x33, 0x24, 0x83, 0x5e, 0x2b, 0xb5, 0x24, 0x2f, 0xc0, 0x90, 0xa6, 0x6b, 0x8e, 0xa6,
-		0xd6, 0x4a, 0x9b, 0x6a, 0x4d, 0xd5, 0xcb, 0xdc, 0x0b, 0x16, 0xae, 0x76, 0x6d, 0x45, 0xf8, 0xb0,
-		0x21, 0x72, 0xb2, 0x32, 0xc8, 0x5b, 0x0a, 0xac, 0x01, 0x5d, 0x85, 0x5e, 0x31, 0x54, 0xe2, 0xae,
-		0x5c, 0x8d, 0x40, 0xf7, 0xb9, 0xcf, 0x5f, 0x97, 0x00, 0x79, 0x0b, 0xa1, 0x60, 0xdb, 0x34, 0x74,
-		0x1b, 0xa3, 0xb7, 0x00, 0xf8, 0xcc, 0x23, 0x8b, 0x47, 0xc6, 0x82, 0x5e, 0x41, 0xf4, 0x8a, 0x7a,
-		0x81, 0x07, 0x8f, 0x2e, 0x7a, 0x8c, 0xc6, 0x78, 0xf6, 0x13, 0x71, 0x44, 0x3e, 0x35, 0x6b, 0x68,
-		0x02, 0xbb, 0x89, 0xb3, 0x7d, 0xf2, 0xf7, 0x93, 0xd0, 0xb3, 0xaa, 0x5a, 0x6a, 0xdd, 0x46, 0x67,
-		0x01, 0x88, 0xce, 0x94, 0x7c, 0xc7, 0xdc, 0x85, 0xfd, 0x77, 0x6e, 0x4f, 0x0c, 0x33, 0xc1, 0x79,
-		0x7d, 0xb2, 0x92, 0x26, 0x0f, 0x73, 0xf4, 0x04, 0xbc, 0x04, 0x83, 0x24, 0x74, 0x2a, 0x69, 0xfa,
-		0x56, 0x8d, 0xcd, 0xa3, 0x2d, 0x33, 0x47, 0x82, 0x0e, 0x25, 0x88, 0x2e, 0x2b, 0x03, 0xa4, 0x61,
-		0x41, 0x3c, 0xa3, 0x1d, 0x18, 0x20, 0x61, 0x75, 0x43, 0x27, 0x51, 0x8c, 0xa3, 0xde, 0xe0, 0x0a,
-		0x70, 0xa5, 0x6b, 0x77, 0x3d, 0xea, 0xea, 0x9d, 0x47, 0x4c, 0x56, 0xfa, 0xdd, 0xe7, 0x75, 0xf5,
-		0x06, 0x7a, 0x8e, 0x2a, 0x76, 0x5d, 0xb3, 0xf9, 0x67, 0x49, 0x9d, 0xbb, 0x51, 0x02, 0x62, 0x8c,
-		0x06, 0x3d, 0x32, 0x8a, 0xea, 0x60, 0xb4, 0x02, 0x7d, 0x75, 0xd5, 0xda, 0xc1, 0x0e, 0x23, 0x9a,
-		0xbc, 0x2b, 0xa2, 0xc0, 0x48, 0x50, 0x82, 0xe5, 0x26, 0x4f, 0xde, 0xc3, 0xe5, 0xde, 0xf4, 0x91,
-		0x51, 0x1e, 0x8c, 0xb7, 0x71, 0xe4, 0x9f, 0x8c, 0x70, 0xe4, 0x8f, 0x41, 0xba, 0xae, 0xde, 0x28,
-		0xd1, 0x88, 0x96, 0x46, 0x2d, 0x03, 0x85, 0xd1, 0x3b, 0xb7, 0x27, 0x32, 0x7c, 0xe1, 0x44, 0x97,
-		0xac, 0xa4, 0xea, 0xea, 0x0d, 0xe2, 0x66, 0x6d, 0x74, 0x81, 0x4c, 0xf4, 0x46, 0x49, 0x18, 0xb5,
-		0x14, 0x45, 0x1a, 0xbb, 0x73, 0x7b, 0x02, 0x79, 0x48, 0xbc, 0x53, 0x26, 0x13, 0xba, 0x51, 0x64,
-		0x0f, 0x68, 0x11, 0xd0, 0xb6, 0x1b, 0xaa, 0xbb, 0xf8, 0x69, 0x8a, 0x7f, 0xe4, 0xce, 0xed, 0x89,
-		0x83, 0x0c, 0xbf, 0x19, 0x46, 0x56, 0x86, 0xbd, 0x46, 0x4e, 0xcd, 0xb7, 0xf7, 0xfe, 0xb7, 0x04,
-		0x89, 0x55, 0xc3, 0xa8, 0x21, 0x03, 0x86, 0x75, 0xc3, 0x29, 0x91, 0xf9, 0xe1, 0x4a, 0x89, 0x47,
-		0x93, 0x4c, 0xcd, 0x67, 0xbb, 0xdb, 0xe2, 0x3f, 0xbe, 0x3d, 0xd1, 0x4c, 0x4a, 0x19, 0xd2, 0x0d,
-		0xa7, 0x40, 0x5b, 0xd6, 0x59, 0xac, 0xf9, 0x6e, 0x18, 0x08, 0x0e, 0xc6, 0x1c, 0xc3, 0x73, 0x5d,
-		0x0f, 0x16, 0x24, 0xe3, 0xa9, 0x72, 0xa0, 0x59, 0x56, 0xfa, 0x37, 0x7d, 0xa3, 0xb3, 0x73, 0xde,
-		0x9f, 0x12, 0x09, 0xdc, 0x8c, 0xc1, 0x7e, 0xb2, 0x38, 0x5e, 0x26, 0xa4, 0xe0, 0xeb, 0xaa, 0x55,
-		0xb1, 0xd1, 0x6f, 0x4a, 0x70, 0xa0, 0xdc, 0xa8, 0x37, 0x6a, 0xec, 0xf3, 0x0b, 0x16, 0x6d, 0x2e,
-		0x51, 0x75, 0xe1, 0xb1, 0xc4, 0xe1, 0xc8, 0x6d, 0xcc, 0x2f, 0xc5, 0x14, 0x36, 0xb8, 0x46, 0x8d,
-		0xf3, 0xad, 0x15, 0x4d, 0x4a, 0xfe, 0xd2, 0xf7, 0x27, 0x4e, 0x77, 0xa6, 0xe2, 0x84, 0xaa, 0xad,
-		0xec, 0xf7, 0x08, 0x31, 0x4e, 0x15, 0x42, 0x86, 0xb8, 0x2e, 0x0b, 0x6f, 0x61, 0x8b, 0x56, 0xee,
-		0xcb, 0xee, 0xad, 0x9b, 0x01, 0xbf, 0xeb, 0x0a, 0x01, 0xc8, 0xca, 0xa0, 0xdb, 0x32, 0x4b, 0x1b,
-		0x3e, 0x45, 0x4d, 0xf1, 0x96, 0x36, 0xdb, 0xb0, 0x2c, 0xac, 0x3b, 0x42, 0x12, 0x3b, 0xd0, 0xcb,
-		0x58, 0xb6, 0x3b, 0x9a, 0xf8, 0xe3, 0x64, 0xe2, 0xdd, 0x4e, 0x4b, 0x8c, 0x40, 0x3f, 0x89, 0x84,
-		0x2d, 0xcd, 0xa8, 0xf0, 0xdb, 0x08, 0xfc, 0x89, 0xb8, 0x89, 0x31, 0xc2, 0xdb, 0x4a, 0xc3, 0xa1,
-		0x9f, 0xa1, 0xd2, 0xf4, 0xaa, 0xe0, 0xef, 0x3d, 0xdd, 0xf1, 0x57, 0xe4, 0x0b, 0x33, 0x28, 0xa4,
-		0x42, 0x51, 0xe5, 0xbb, 0xe5, 0x58, 0xfe, 0x15, 0x09, 0x0e, 0xd2, 0x30, 0xba, 0xcc, 0x97, 0x86,
-		0x7d, 0xad, 0x81, 0x19, 0x38, 0xf4, 0x02, 0x80, 0x67, 0xee, 0xee, 0x9f, 0xfc, 0x7c, 0x83, 0xc8,
-		0x7f, 0x23, 0x11, 0x9d, 0x16, 0x59, 0x96, 0xa3, 0x5a, 0x8e, 0xa6, 0x57, 0x69, 0x82, 0x3f, 0x0b,
-		0x43, 0xa6, 0x85, 0xaf, 0x69, 0x46, 0xc3, 0x2e, 0x71, 0x29, 0xd3, 0x7b, 0x20, 0x7e, 0x2d, 0x09,
-		0x01, 0xc8, 0xca, 0xa0, 0x68, 0x59, 0xa5, 0x0d, 0x68, 0x9d, 0x7e, 0x55, 0x73, 0x87, 0x5f, 0x34,
-		0x29, 0x3c, 0xd5, 0xb5, 0xb3, 0xe9, 0xe7, 0xe9, 0x37, 0x21, 0x22, 0x2b, 0x8c, 0x18, 0x2a, 0x06,
-		0x0e, 0x08, 0x13, 0x85, 0x47, 0x7e, 0x7c, 0x7b, 0x22, 0x1c, 0x8d, 0xed, 0x11, 0x85, 0x71, 0x64,
-		0xf9, 0x0f, 0xe9, 0x62, 0x88, 0x38, 0xc0, 0x95, 0x02, 0x53, 0x95, 0xa6, 0x68, 0x54, 0xea, 0x22,
-		0x1a, 0xd5, 0xa0, 0x87, 0xad, 0x38, 0xaf, 0x71, 0xdc, 0x87, 0x45, 0xe4, 0x03, 0xe4, 0x53, 0x3c,
-		0x64, 0xa5, 0x89, 0x56, 0xef, 0x15, 0x8c, 0xa9, 0x8d, 0xfe, 0x98, 0x04, 0x83, 0x9e, 0x83, 0x36,
-		0x0d, 0x7a, 0x0d, 0xa8, 0x3d, 0x27, 0x8b, 0x41, 0xcf, 0x16, 0xa4, 0xd0, 0xb5, 0xd6, 0x7b, 0xf1,
-		0x06, 0xe1, 0xe9, 0xd4, 0xd7, 0x24, 0x00, 0xaf, 0x22, 0x81, 0x1e, 0x86, 0x03, 0x85, 0x95, 0xe5,
-		0xb9, 0xd2, 0xda, 0xfa, 0xcc, 0xfa, 0xc6, 0x5a, 0x69, 0x63, 0x79, 0x6d, 0xb5, 0x38, 0xbb, 0x70,
-		0x65, 0xa1, 0x38, 0xe7, 0x95, 0x19, 0x6d, 0x13, 0x97, 0xb5, 0x2d, 0x0d, 0x57, 0xd0, 0x71, 0x18,
-		0x0d, 0x42, 0x93, 0xa7, 0xe2, 0x5c, 0x46, 0xca, 0xf5, 0xdf, 0xbc, 0x35, 0x99, 0x62, 0x61, 0x3a,
-		0xae, 0xa0, 0x13, 0xb0, 0xbf, 0x19, 0x6e, 0x61, 0x79, 0x3e, 0x13, 0xcb, 0x0d, 0xdc, 0xbc, 0x35,
-		0x99, 0x76, 0xe3, 0x79, 0x24, 0x03, 0xf2, 0x43, 0x72, 0x7a, 0xf1, 0x1c, 0xdc, 0xbc, 0x35, 0xd9,
-		0xc3, 0xfc, 0x4f, 0x2e, 0xf1, 0xa1, 0xcf, 0x8f, 0xef, 0x2b, 0xfc, 0x42, 0xcb, 0x42, 0xa2, 0x5f,
-		0x8f, 0x59, 0x3d, 0x90, 0xfd, 0x7b, 0xed, 0xec, 0xf4, 0x0d, 0x56, 0x1a, 0x0c, 0xd6, 0x0d, 0xbf,
-		0x91, 0x85, 0x11, 0x5f, 0xdd, 0xd0, 0xb9, 0x11, 0x51, 0x35, 0xdc, 0xa3, 0x3e, 0xd8, 0xb6, 0xfe,
-		0xd7, 0xa2, 0xe2, 0x78, 0xf7, 0x55, 0xc1, 0xe8, 0x2a, 0xa7, 0xfc, 0x89, 0x38, 0x0c, 0x2c, 0xd9,
-		0xd5, 0x59, 0xb2, 0xb3, 0x30, 0xad, 0x67, 0x85, 0x2a, 0x33, 0x52, 0xd7, 0x95, 0x99, 0x16, 0xb5,
-		0x8e, 0xd8, 0xeb, 0x52, 0xeb, 0x88, 0xce, 0x62, 0xe3, 0xf7, 0x24, 0x8b, 0x4d, 0x74, 0x61, 0x37,
-		0xce, 0x89, 0x6b, 0xff, 0xc9, 0xce, 0x12, 0x11, 0xfe, 0x5e, 0x00, 0xb5, 0x01, 0x34, 0x0d, 0x39,
-		0x00, 0xfb, 0x03, 0xeb, 0x22, 0x52, 0x24, 0xf9, 0xc3, 0x31, 0xe8, 0x5b, 0xb2, 0xab, 0xc5, 0x8a,
-		0xe6, 0xdc, 0x9b, 0xf5, 0x3a, 0x17, 0x99, 0xac, 0x23, 0xcf, 0x57, 0x46, 0xcf, 0xf0, 0x46, 0xf4,
-		0x32, 0xf3, 0x64, 0xf4, 0x7e, 0x96, 0xb3, 0x3c, 0x21, 0xed, 0x87, 0x11, 0x9f, 0x28, 0x5c, 0x11,
-		0xfd, 0x07, 0x09, 0x86, 0x96, 0xec, 0x2a, 0x69, 0xe3, 0x68, 0xf8, 0xcd, 0x52, 0xdd, 0xb8, 0xe0,
-		0x5e, 0x8f, 0x8e, 0x77, 0xa6, 0x18, 0xe2, 0xca, 0xb4, 0x37, 0xe9, 0x83, 0x70, 0x20, 0x34, 0x39,
-		0x77, 0xe2, 0x7f, 0x2e, 0xc1, 0x30, 0xef, 0xdb, 0xd0, 0x2b, 0xff, 0xef, 0x4d, 0xfd, 0x79, 0x38,
-		0xd8, 0x34, 0x3d, 0xb7, 0x76, 0xb0, 0xd4, 0x5c, 0x82, 0x91, 0xba, 0x38, 0x7f, 0x0e, 0x15, 0x5a,
-		0xe4, 0xdf, 0x93, 0xe8, 0x60, 0x6b, 0x98, 0xea, 0xd6, 0x73, 0x9a, 0xb3, 0x5d, 0xb1, 0xd4, 0xeb,
-		0x62, 0x32, 0xf7, 0x50, 0xa6, 0x57, 0x20, 0x73, 0x9d, 0x53, 0x0f, 0xc9, 0xd5, 0x57, 0xc9, 0x0e,
-		0x43, 0xc8, 0xca, 0xd0, 0xf5, 0x20, 0x4b, 0x3e, 0x31, 0x1d, 0x83, 0xa3, 0x2d, 0x39, 0x77, 0x75,
-		0xe5, 0xcb, 0x12, 0x8c, 0x2f, 0xd9, 0x55, 0xd1, 0xed, 0xd3, 0x27, 0x37, 0x70, 0x7a, 0x93, 0x54,
-		0x79, 0xbd, 0x89, 0x9d, 0x80, 0xe3, 0x7b, 0xb3, 0xec, 0xce, 0x4e, 0xa5, 0x8b, 0xe7, 0x87, 0xf4,
-		0x45, 0xe7, 0xaf, 0x21, 0x20, 0x6c, 0x92, 0x72, 0xf4, 0x10, 0x2e, 0x1f, 0x5f, 0x90, 0x20, 0xbb,
-		0x64, 0x57, 0xaf, 0x34, 0xf4, 0x8a, 0x80, 0x70, 0xe3, 0x28, 0x54, 0x76, 0x77, 0x04, 0x0b, 0xe9,
-		0xf6, 0xd8, 0x11, 0x8f, 0xf2, 0xc8, 0xf2, 0x44, 0x07, 0x46, 0x95, 0x87, 0x95, 0x8c, 0x34, 0x3a,
-		0x0c, 0xe9, 0x0a, 0xa6, 0xdf, 0x18, 0xe4, 0x9f, 0xeb, 0x4d, 0x2b, 0x5e, 0x83, 0x6f, 0x3a, 0x32,
-		0x4c, 0xb6, 0x62, 0x54, 0xcc, 0xe6, 0xcc, 0xdf, 0x24, 0x21, 0xbe, 0x64, 0x57, 0xd1, 0xd3, 0x00,
-		0xbe, 0x88, 0xe1, 0xa0, 0xdf, 0xd9, 0x04, 0x9c, 0x56, 0xee, 0x68, 0xcb, 0x2e, 0x77, 0xdb, 0xce,
-		0x41, 0xca, 0xf5, 0x65, 0x07, 0x42, 0xe0, 0xa2, 0x23, 0x37, 0xd1, 0xa2, 0xc3, 0xa5, 0xb2, 0x08,
-		0x29, 0xd7, 0xd4, 0x1f, 0x0a, 0x01, 0xfb, 0x4d, 0x65, 0xee, 0xd8, 0x1e, 0x9d, 0x2e, 0x35, 0x05,
-		0xc0, 0x67, 0x3f, 0x8f, 0x44, 0xa0, 0x78, 0xdd, 0xb9, 0x07, 0xf7, 0xec, 0x76, 0x69, 0x3e, 0x0f,
-		0x88, 0x7e, 0x5d, 0x39, 0x68, 0x47, 0xc2, 0xc8, 0xd1, 0x9b, 0x36, 0xf7, 0x48, 0x47, 0x60, 0xee,
-		0x58, 0xbb, 0x70, 0xc0, 0xd3, 0xcb, 0xe0, 0x9e, 0x3e, 0x15, 0xa2, 0xb4, 0xc7, 0x66, 0xca, 0x9d,
-		0xe9, 0x1c, 0xd6, 0x1d, 0x5a, 0x87, 0xb1, 0x16, 0xbb, 0xee, 0xc1, 0x3d, 0xa8, 0x79, 0x60, 0x4d,
-		0x53, 0xdd, 0x7b, 0x83, 0xa1, 0x2a, 0x0c, 0x13, 0x9d, 0x0d, 0x6e, 0xac, 0x07, 0x42, 0x34, 0x22,
-		0xb5, 0x3a, 0xf7, 0x70, 0x27, 0x50, 0x62, 0xa0, 0x7b, 0x98, 0x3b, 0x7c, 0x75, 0x3f, 0x64, 0x7d,
-		0xd1, 0x78, 0x15, 0xeb, 0xd8, 0xd6, 0xec, 0x8e, 0xaf, 0x1d, 0xdc, 0x65, 0xa4, 0xff, 0xbb, 0xfe,
-		0xfa, 0x80, 0x10, 0x2a, 0xad, 0x0f, 0xbc, 0xa9, 0x7d, 0xd9, 0x07, 0x63, 0x70, 0x38, 0xba, 0x02,
-		0xa4, 0xe0, 0xb2, 0xf1, 0x1a, 0xb3, 0xfb, 0xcf, 0x49, 0x30, 0x62, 0x78, 0x84, 0x4b, 0xa2, 0xa0,
-		0xd4, 0x49, 0xae, 0xff, 0x56, 0x9e, 0x61, 0xf3, 0xe0, 0x35, 0x82, 0x4c, 0xd7, 0x69, 0x36, 0x32,
-		0x9a, 0x26, 0xe9, 0x93, 0xc4, 0x1f, 0x4a, 0x30, 0xd1, 0xb2, 0xe2, 0x74, 0x0f, 0x84, 0x51, 0x86,
-		0x3e, 0xd5, 0x23, 0xcd, 0x0f, 0x2d, 0x1e, 0x0c, 0xdf, 0xe9, 0x88, 0x1c, 0xbd, 0x90, 0xe3, 0xc2,
-		0xe0, 0x25, 0x6d, 0x1f, 0x1d, 0x59, 0xf1, 0x53, 0xf5, 0x4d, 0xe7, 0xbb, 0x12, 0x1c, 0x8a, 0xac,
-		0xc1, 0xde, 0x83, 0xa9, 0xb4, 0x28, 0x27, 0xa2, 0x35, 0xaf, 0x66, 0xe8, 0xfe, 0x52, 0x4b, 0x70,
-		0x7a, 0x4d, 0xdc, 0x14, 0xc6, 0xa2, 0x0b, 0x87, 0x6e, 0x25, 0xd0, 0x37, 0xa5, 0xaf, 0x48, 0x90,
-		0x6d, 0xae, 0xa4, 0xde, 0x83, 0xf9, 0xac, 0x7a, 0x7c, 0x8b, 0x4b, 0x82, 0x21, 0xbe, 0x83, 0x43,
-		0x76, 0xc3, 0xf4, 0xc7, 0x63, 0x64, 0x1d, 0x22, 0xea, 0x86, 0x9c, 0xef, 0x37, 0x49, 0xf6, 0x50,
-		0x81, 0x01, 0x9b, 0x33, 0xc7, 0x7e, 0xad, 0x30, 0x72, 0x01, 0x23, 0xa6, 0x51, 0x38, 0xcc, 0x65,
-		0x31, 0xea, 0x16, 0x20, 0x3d, 0x2a, 0xb2, 0xd2, 0x6f, 0xfb, 0x60, 0x7d, 0x62, 0xf9, 0xfd, 0x34,
-		0xf4, 0xcf, 0x33, 0xcb, 0xbc, 0xe6, 0x90, 0x28, 0xe0, 0x51, 0xe8, 0x31, 0xe9, 0xb1, 0x20, 0xcf,
-		0x2a, 0x02, 0x77, 0x0a, 0xd8, 0x81, 0xa1, 0xfb, 0x96, 0x11, 0x3b, 0x3e, 0x7c, 0x18, 0x92, 0xec,
-		0x9c, 0x68, 0xef, 0xeb, 0x51, 0x0c, 0x08, 0x3d, 0x45, 0xf2, 0x78, 0x91, 0xe3, 0x8a, 0xb3, 0xef,
-		0xbd, 0xcf, 0x3e, 0xfd, 0x08, 0xe8, 0x45, 0xd8, 0xef, 0x9d, 0x5f, 0xf9, 0x29, 0xb1, 0xab, 0xd7,
-		0x13, 0x6d, 0x4e, 0xd1, 0x0b, 0x0f, 0x70, 0x31, 0x1d, 0x0e, 0x9f, 0x85, 0xf9, 0x68, 0xc9, 0xca,
-		0x68, 0xa3, 0x19, 0xd5, 0x46, 0xef, 0x97, 0x20, 0xeb, 0x69, 0x80, 0x6b, 0xdf, 0x89, 0x84, 0x6d,
-		0xfe, 0x23, 0x86, 0xd1, 0x0b, 0xe5, 0xf7, 0x43, 0x85, 0x87, 0x38, 0x07, 0x13, 0x61, 0x95, 0x0a,
-		0x12, 0x94, 0x95, 0xb1, 0x4a, 0x14, 0xbe, 0x8d, 0xde, 0x13, 0x6d, 0xcd, 0x7b, 0xc4, 0x77, 0xa4,
-		0x83, 0xe2, 0x6f, 0xe5, 0x51, 0xdc, 0x63, 0xf9, 0x3d, 0x2c, 0x7b, 0x94, 0xa9, 0x46, 0x2f, 0x4b,
-		0x70, 0x98, 0x69, 0xb0, 0x67, 0xf0, 0x4a, 0x5e, 0x85, 0xde, 0xe6, 0x3f, 0xf2, 0x79, 0xba, 0x23,
-		0x93, 0xca, 0x79, 0x39, 0xcd, 0x79, 0x39, 0xe6, 0xdf, 0x20, 0xd1, 0xe4, 0x65, 0x25, 0x57, 0x69,
-		0x45, 0xcd, 0x46, 0x1f, 0x94, 0xe0, 0x00, 0xc5, 0xf6, 0x1d, 0x17, 0x0a, 0x01, 0xa5, 0xc4, 0x2f,
-		0x98, 0xb6, 0xb1, 0x85, 0x9c, 0xa7, 0xe3, 0xc1, 0x33, 0xae, 0x16, 0x54, 0x65, 0x65, 0x7f, 0x25,
-		0xf2, 0x88, 0xed, 0x5d, 0x30, 0x4a, 0x51, 0xca, 0xcc, 0x64, 0xb9, 0x5c, 0xa4, 0x9b, 0x6f, 0x7b,
-		0xb4, 0x32, 0xa6, 0x85, 0x63, 0x9c, 0x85, 0x43, 0x3e, 0x16, 0x42, 0xf4, 0x64, 0x05, 0x55, 0x9a,
-		0x4f, 0xb5, 0x6e, 0x06, 0x34, 0x35, 0x60, 0x0b, 0x6c, 0xfa, 0xf3, 0x91, 0x4d, 0x72, 0x68, 0x69,
-		0x19, 0x5b, 0xeb, 0x6b, 0x90, 0xac, 0x5f, 0x5f, 0xfd, 0x54, 0x6c, 0x34, 0x0f, 0xa9, 0x2d, 0x8c,
-		0x59, 0x51, 0xbf, 0x8f, 0x1a, 0x95, 0x11, 0xff, 0xd8, 0xfc, 0x08, 0xa0, 0x70, 0x80, 0x8f, 0x33,
-		0xc4, 0xc6, 0x11, 0x28, 0xb2, 0xd2, 0xbb, 0xc5, 0x0f, 0x09, 0xe8, 0x6f, 0x19, 0x9a, 0x86, 0x45,
-		0xfc, 0x76, 0x3f, 0xff, 0x02, 0x2c, 0x7f, 0xbe, 0x87, 0x71, 0xeb, 0xbf, 0x8a, 0xc1, 0x29, 0x7f,
-		0xec, 0xf9, 0x42, 0x03, 0x5b, 0xbb, 0x6e, 0x04, 0x6a, 0xaa, 0x55, 0x4d, 0xf7, 0x7f, 0x45, 0xe4,
-		0xa0, 0x3f, 0x7a, 0xa2, 0xb0, 0x22, 0x86, 0x92, 0x75, 0xe8, 0x5b, 0x55, 0xab, 0x58, 0xc1, 0x2f,
-		0x34, 0xb0, 0xed, 0x44, 0xbc, 0xa6, 0x3e, 0x06, 0x3d, 0xc6, 0xd6, 0x96, 0xb8, 0x14, 0x9f, 0x50,
-		0xf8, 0x13, 0x1a, 0x85, 0x64, 0x4d, 0xab, 0x6b, 0xfc, 0xb8, 0x48, 0x61, 0x0f, 0x68, 0x02, 0xfa,
-		0xe8, 0xd9, 0x66, 0x89, 0xbd, 0xe0, 0x97, 0x10, 0x9f, 0x90, 0x6c, 0xe8, 0xce, 0x3a, 0x69, 0x91,
-		0x2f, 0x43, 0x3f, 0x1b, 0x8f, 0x27, 0x0d, 0x07, 0x21, 0x45, 0x5f, 0xc8, 0xf2, 0x46, 0xed, 0x25,
-		0xcf, 0xcf, 0xb0, 0x57, 0xda, 0x19, 0x15, 0x36, 0x30, 0x7b, 0x28, 0x14, 0x5a, 0x0a, 0xb1, 0x83,
-		0x84, 0x9c, 0x09, 0xca, 0x15, 0xe3, 0xb7, 0x92, 0xb0, 0x9f, 0x97, 0xff, 0x55, 0x53, 0x9b, 0xde,
-		0x76, 0x1c, 0xd3, 0x8d, 0xfd, 0x59, 0x9d, 0x4a, 0x35, 0x35, 0x79, 0x17, 0x12, 0x57, 0x1d, 0xc7,
-		0x44, 0xa7, 0x20, 0x69, 0x35, 0x6a, 0x58, 0x1c, 0x72, 0x8e, 0x4e, 0x79, 0x30, 0x53, 0x04, 0x40,
-		0x69, 0xd4, 0xb0, 0xc2, 0x40, 0x50, 0x11, 0x26, 0xb6, 0x1a, 0xb5, 0xda, 0x6e, 0xa9, 0x82, 0xe9,
-		0xaf, 0xfa, 0xba, 0x3f, 0xa0, 0x87, 0x6f, 0x98, 0xaa, 0xf8, 0x0a, 0x3f, 0x91, 0xcd, 0x61, 0x0a,
-		0x36, 0x47, 0xa1, 0xc4, 0x8f, 0xe7, 0x15, 0x05, 0x8c, 0xfc, 0xa7, 0x31, 0x48, 0x09, 0xd2, 0xf4,
-		0x1d, 0x73, 0x5c, 0xc3, 0x65, 0xc7, 0x10, 0x97, 0x74, 0xdd, 0x67, 0x84, 0x20, 0x5e, 0xe5, 0x4b,
-		0x94, 0xbe, 0xba, 0x4f, 0x21, 0x0f, 0xa4, 0xcd, 0x7d, 0xf3, 0x9f, 0xb4, 0x99, 0x0d, 0xb2, 0x6a,
-		0x09, 0xd3, 0xb0, 0xf9, 0x5d, 0xdc, 0xab, 0xfb, 0x14, 0xfa, 0x84, 0xb2, 0xd0, 0x43, 0xf6, 0x85,
-		0xb8, 0xfb, 0x71, 0x75, 0x9f, 0xc2, 0x9f, 0xd1, 0x18, 0x24, 0x4d, 0xd5, 0x29, 0xb3, 0x97, 0xf2,
-		0x48, 0x07, 0x7b, 0x44, 0x17, 0xa0, 0x87, 0x7d, 0x52, 0x2a, 0xfc, 0x93, 0x99, 0x44, 0x18, 0xec,
-		0xdb, 0xdd, 0x84, 0xef, 0x55, 0xd5, 0x71, 0xb0, 0xa5, 0x13, 0x82, 0x0c, 0x1c, 0x21, 0x48, 0x6c,
-		0x1a, 0x95, 0x5d, 0xfe, 0x33, 0x9e, 0xf4, 0x6f, 0xfe, 0x03, 0x83, 0x54, 0x1f, 0x4a, 0xb4, 0x93,
-		0xfd, 0x7a, 0x71, 0xbf, 0x68, 0x2c, 0x10, 0xa0, 0x22, 0x8c, 0xa8, 0x95, 0x8a, 0x46, 0xb4, 0x5a,
-		0xad, 0x95, 0x36, 0x35, 0x6a, 0xef, 0x6d, 0xfa, 0xdb, 0xd4, 0xad, 0xd6, 0x02, 0x79, 0x08, 0x05,
-		0x0e, 0x5f, 0x48, 0x43, 0xaf, 0xc9, 0x98, 0x92, 0x2f, 0xc1, 0x70, 0x13, 0xa7, 0x84, 0xbf, 0x1d,
-		0x4d, 0xaf, 0x88, 0xcf, 0x21, 0x90, 0xbf, 0x49, 0x1b, 0xfd, 0xfe, 0x3e, 0x2b, 0xd9, 0xd0, 0xbf,
-		0x0b, 0xef, 0x6d, 0xfd, 0xe9, 0x98, 0x41, 0xdf, 0xa7, 0x63, 0x54, 0x53, 0x2b, 0xa4, 0x29, 0x7d,
-		0xfe, 0xc1, 0x98, 0x19, 0xde, 0xc1, 0x3e, 0x16, 0x33, 0x65, 0x58, 0x55, 0x92, 0x75, 0x8a, 0xcc,
-		0x92, 0x74, 0xa9, 0xa6, 0x66, 0x53, 0x75, 0xf4, 0x7e, 0x0f, 0xc0, 0xbe, 0xe4, 0xfb, 0x9b, 0x7e,
-		0x46, 0x26, 0x31, 0x3f, 0xb3, 0xba, 0xe0, 0xea, 0xf1, 0xbf, 0x88, 0xc1, 0x61, 0x9f, 0x1e, 0xfb,
-		0x80, 0x9b, 0xd5, 0x39, 0x17, 0xad, 0xf1, 0x1d, 0x7c, 0x3e, 0xe6, 0x19, 0x48, 0x10, 0x78, 0xd4,
-		0xe6, 0xe7, 0xff, 0xb2, 0xbf, 0xfd, 0x9d, 0x7f, 0x26, 0x53, 0xa5, 0x88, 0x5e, 0x15, 0x4a, 0xa4,
-		0xf0, 0x81, 0xce, 0xe5, 0x97, 0xf1, 0x7e, 0x0a, 0xc1, 0xbe, 0x77, 0x62, 0x0c, 0xcb, 0xf0, 0xbd,
-		0x97, 0x61, 0xcc, 0x97, 0xae, 0x33, 0x2b, 0xd9, 0x5c, 0x08, 0xe8, 0xc2, 0xec, 0xb6, 0xfa, 0x52,
-		0xc0, 0x5e, 0x2b, 0x75, 0xb7, 0x25, 0x05, 0x1b, 0x86, 0xdf, 0x4a, 0x98, 0xa1, 0xf7, 0x9c, 0x84,
-		0x31, 0x1f, 0x73, 0xef, 0xb1, 0x4b, 0xfc, 0xe7, 0xc0, 0xc5, 0x7d, 0x75, 0xf0, 0x78, 0xe5, 0x69,
-		0xcc, 0xf1, 0xa9, 0x96, 0x3e, 0x62, 0xca, 0xe7, 0x20, 0x14, 0x1f, 0xa6, 0xfc, 0x4b, 0x12, 0x20,
-		0xff, 0xa8, 0xdc, 0xa4, 0xbb, 0xc1, 0xb6, 0xd4, 0x49, 0xb0, 0x3d, 0x1f, 0xc1, 0xcc, 0x43, 0x6d,
-		0x99, 0x61, 0x43, 0x05, 0xb8, 0x39, 0x07, 0x19, 0x97, 0x19, 0x21, 0x81, 0xa3, 0x51, 0x99, 0x5e,
-		0x20, 0x9b, 0x91, 0x2f, 0xfb, 0x24, 0xe7, 0x4e, 0xe1, 0x14, 0x24, 0x08, 0x0c, 0xcf, 0x2f, 0x5a,
-		0xcd, 0x80, 0xc2, 0xc8, 0x1f, 0x92, 0xe0, 0x90, 0x4b, 0xc1, 0x17, 0x8a, 0x77, 0xce, 0xc3, 0x3d,
-		0x5b, 0x90, 0x3f, 0x96, 0xe0, 0x70, 0x34, 0x2b, 0x6e, 0x49, 0x70, 0xd4, 0xcb, 0x21, 0x4a, 0xc2,
-		0xbe, 0x8a, 0x95, 0x1a, 0x8f, 0x4e, 0x71, 0xdc, 0xba, 0xdb, 0x21, 0x5e, 0xf9, 0x1e, 0x69, 0xee,
-		0xb3, 0x95, 0x91, 0x4a, 0x73, 0xe3, 0xbd, 0x5b, 0xdc, 0x8f, 0x49, 0xf0, 0x80, 0x3b, 0xb3, 0x88,
-		0x9c, 0xe9, 0x8d, 0x90, 0xf6, 0xb7, 0x25, 0x78, 0xb0, 0x0d, 0x4f, 0x5c, 0xec, 0xcf, 0xc2, 0x88,
-		0x97, 0xc4, 0x85, 0xa5, 0xde, 0x36, 0x1d, 0x64, 0xca, 0x86, 0x5c, 0x0a, 0xf7, 0x41, 0xbc, 0xf3,
-		0x20, 0xbb, 0x33, 0x89, 0x4a, 0xc6, 0x3a, 0xde, 0x4d, 0x1a, 0x1c, 0xdb, 0x93, 0x10, 0x17, 0x48,
-		0xc1, 0x7f, 0x63, 0x8c, 0x70, 0x2d, 0xb7, 0x4f, 0x09, 0xc5, 0x3d, 0x61, 0x91, 0x54, 0x5c, 0x86,
-		0x9c, 0x3b, 0x94, 0x3f, 0x59, 0xeb, 0x98, 0xd7, 0xe7, 0x7d, 0xfb, 0x36, 0xa2, 0x9c, 0xfd, 0x4c,
-		0xe8, 0xe2, 0x58, 0x17, 0x35, 0x38, 0x7e, 0x1f, 0xda, 0x77, 0x25, 0xcc, 0x82, 0x31, 0x3e, 0x96,
-		0xb7, 0x49, 0x18, 0xa3, 0xa7, 0x5b, 0x16, 0x75, 0x22, 0xca, 0x36, 0x47, 0xa3, 0xca, 0x36, 0xad,
-		0x4e, 0xc5, 0x9e, 0x87, 0x03, 0x4d, 0x63, 0xf2, 0xb9, 0xad, 0xc0, 0x48, 0x84, 0x1d, 0x70, 0xdf,
-		0x9d, 0xdd, 0xd3, 0x0c, 0x90, 0x64, 0x2e, 0xdc, 0x26, 0xbf, 0x0b, 0x26, 0xe8, 0x58, 0x11, 0xfa,
-		0x7b, 0xff, 0x27, 0xaa, 0xc2, 0x64, 0xeb, 0xc1, 0xf9, 0x8c, 0x9f, 0x84, 0x1e, 0xb6, 0x81, 0xf8,
-		0x24, 0x3b, 0xdc, 0x75, 0x1c, 0x49, 0x7e, 0x45, 0xe2, 0x63, 0xb8, 0x59, 0x68, 0x84, 0xed, 0xe9,
-		0x6a, 0x86, 0xf7, 0xc8, 0x0a, 0xf9, 0xc4, 0xf0, 0x2d, 0x09, 0x8e, 0xee, 0xc1, 0x23, 0x17, 0xc4,
-		0x73, 0xaf, 0xc9, 0x05, 0x30, 0xa9, 0xdc, 0x5f, 0x5b, 0xff, 0x25, 0x09, 0x4e, 0x06, 0xe7, 0xb1,
-		0x97, 0xc1, 0x7f, 0x83, 0x85, 0xfe, 0x6f, 0x24, 0x38, 0xd5, 0x09, 0xb3, 0xff, 0xb7, 0x78, 0x82,
-		0x4f, 0x4b, 0xae, 0x59, 0x75, 0x95, 0xc8, 0x17, 0x52, 0xbe, 0xc1, 0xd2, 0xfe, 0xb8, 0x17, 0x6b,
-		0x05, 0xb9, 0x7b, 0x63, 0x43, 0xcf, 0x06, 0x1c, 0x6c, 0xe6, 0xea, 0xfe, 0xdb, 0xbd, 0xab, 0x51,
-		0x4b, 0x75, 0x57, 0x31, 0xec, 0x2e, 0x1c, 0x69, 0x72, 0x15, 0x01, 0xd7, 0x7f, 0xff, 0x26, 0xf1,
-		0xcb, 0x12, 0x8c, 0xb7, 0x1a, 0x9b, 0xcf, 0xe4, 0xf5, 0xbc, 0xff, 0x2e, 0xff, 0xa2, 0x1b, 0x0a,
-		0x09, 0x76, 0x68, 0xe1, 0xea, 0x35, 0xc8, 0xc3, 0x37, 0xd9, 0x7f, 0x27, 0xb9, 0xf1, 0x51, 0x34,
-		0x75, 0x3e, 0xe3, 0x62, 0x78, 0xc6, 0x0f, 0x46, 0xdb, 0xe5, 0xd0, 0xd1, 0x7f, 0x28, 0x44, 0x42,
-		0x55, 0xaf, 0x82, 0x76, 0x9f, 0xc4, 0xc6, 0xe8, 0xcb, 0x1b, 0x6e, 0xde, 0xe1, 0xdf, 0x96, 0xc5,
-		0x1b, 0xaf, 0x51, 0x5c, 0x97, 0x83, 0x6a, 0xe9, 0x23, 0xcb, 0xe5, 0x34, 0xea, 0xdf, 0xef, 0x69,
-		0xbe, 0xaf, 0x7d, 0x04, 0xde, 0xe9, 0x66, 0x0d, 0xa1, 0x53, 0x0a, 0xf7, 0x9a, 0xc6, 0x6b, 0xe2,
-		0xef, 0xff, 0x73, 0x13, 0x80, 0x56, 0xe4, 0x39, 0x9f, 0x27, 0x23, 0x0e, 0xe3, 0x19, 0xf9, 0x3d,
-		0xce, 0xdb, 0xcf, 0xf2, 0xed, 0x1d, 0xfc, 0x48, 0x80, 0x2f, 0xb9, 0x8f, 0xfa, 0x92, 0x90, 0xbc,
-		0xc4, 0x2d, 0x64, 0x18, 0x8b, 0x73, 0x32, 0x05, 0x89, 0x6d, 0xcd, 0x76, 0xbc, 0xfb, 0x78, 0x9e,
-		0x5a, 0x85, 0x30, 0x28, 0x9c, 0x8c, 0x78, 0x56, 0xcd, 0x2e, 0x60, 0xd0, 0xa1, 0xdd, 0x94, 0xd9,
-		0x7f, 0x29, 0x83, 0x98, 0x1b, 0x7e, 0x25, 0xbe, 0xc9, 0xdc, 0xd0, 0xd2, 0x39, 0x37, 0x37, 0xb4,
-		0x5c, 0x3e, 0xca, 0xeb, 0x06, 0xec, 0xac, 0x4e, 0x90, 0x9d, 0x87, 0x91, 0x40, 0x2b, 0x27, 0xdc,
-		0xf5, 0x69, 0x9f, 0x7c, 0x88, 0x9b, 0xe3, 0xd0, 0xed, 0x11, 0x36, 0xca, 0xfb, 0x84, 0x83, 0x8b,
-		0xbc, 0x5b, 0x82, 0xb0, 0x3b, 0x8d, 0xfb, 0xb4, 0x63, 0x28, 0xf9, 0x33, 0xdf, 0x39, 0x08, 0x49,
-		0xca, 0x05, 0x52, 0x21, 0xc9, 0x5e, 0x4e, 0x0b, 0xdc, 0x6d, 0x6a, 0x2a, 0xe6, 0xe4, 0xc6, 0x5b,
-		0x75, 0xf3, 0x08, 0xfc, 0xe0, 0x7b, 0xff, 0xe8, 0x87, 0xbf, 0x16, 0x1b, 0x41, 0xc3, 0xd3, 0xa1,
-		0x0a, 0x91, 0x8d, 0xea, 0xfc, 0xfb, 0x08, 0x87, 0x23, 0x49, 0x88, 0x01, 0x8e, 0xb4, 0xe8, 0xe5,
-		0xf4, 0x4f, 0x50, 0xfa, 0x32, 0x9a, 0x6c, 0xa2, 0x3f, 0xfd, 0x2e, 0xbf, 0xb5, 0x7f, 0x0f, 0x7a,
-		0x59, 0x82, 0xa1, 0x50, 0x01, 0x02, 0x3d, 0x14, 0x49, 0xbc, 0x39, 0x9c, 0xcb, 0x9d, 0x68, 0x0f,
-		0xc8, 0x19, 0x3a, 0x4b, 0x19, 0x9a, 0x42, 0x0f, 0xb7, 0x63, 0x68, 0xda, 0x7f, 0x36, 0xfb, 0xcf,
-		0xf9, 0xc5, 0x80, 0xa8, 0x28, 0x0d, 0x3d, 0x1a, 0x39, 0xf8, 0x1e, 0xd1, 0x67, 0xee, 0xb1, 0x2e,
-		0x30, 0x38, 0xdf, 0x4f, 0x51, 0xbe, 0x9f, 0x40, 0xe7, 0xdb, 0xf2, 0x1d, 0x79, 0xf0, 0x8b, 0x3e,
-		0x21, 0xc1, 0x60, 0xe8, 0x3e, 0xd7, 0xf1, 0x48, 0x2e, 0x9a, 0x92, 0xe2, 0xdc, 0x43, 0x6d, 0xe1,
-		0x38, 0x8f, 0x8f, 0x53, 0x1e, 0x1f, 0x41, 0xa7, 0xdb, 0xf2, 0xe8, 0xe5, 0xb8, 0xe8, 0xeb, 0xad,
-		0xdf, 0x10, 0x9b, 0x8a, 0x1c, 0xb8, 0x65, 0xa5, 0x21, 0x37, 0xdd, 0x31, 0x3c, 0x67, 0xf8, 0x2d,
-		0x94, 0xe1, 0xf3, 0xe8, 0x6c, 0x5b, 0x86, 0x23, 0xce, 0x90, 0xd1, 0xe7, 0xb9, 0x48, 0x7d, 0x2f,
-		0x2f, 0xc8, 0x11, 0x1c, 0x84, 0x32, 0xda, 0xe0, 0x05, 0xc6, 0x16, 0xa9, 0xb6, 0x7c, 0x95, 0x72,
-		0x56, 0x40, 0xbf, 0xd0, 0x8d, 0x9a, 0x92, 0xae, 0x90, 0x3b, 0x7a, 0x0f, 0xfa, 0x23, 0x09, 0x0e,
-		0xb4, 0xd0, 0x2e, 0x74, 0xba, 0x89, 0x95, 0xd6, 0x99, 0x78, 0xf0, 0xb6, 0x5d, 0xbb, 0xcc, 0x59,
-		0x7e, 0x27, 0x9d, 0xc0, 0x73, 0x68, 0xe3, 0xb5, 0x4e, 0x20, 0x52, 0x9f, 0xd1, 0x6f, 0xf1, 0x0d,
-		0x19, 0x95, 0xb4, 0xa2, 0x87, 0x5b, 0x49, 0x38, 0x2a, 0xff, 0x0e, 0xde, 0x57, 0x6c, 0x9b, 0x09,
-		0xb7, 0x52, 0xf2, 0xbd, 0x17, 0xe1, 0xcf, 0x24, 0x38, 0x1a, 0x60, 0x37, 0xd2, 0x90, 0x9c, 0x6b,
-		0xcd, 0xc9, 0x5e, 0xd6, 0xe4, 0x7c, 0xb7, 0x68, 0x7b, 0xeb, 0x18, 0x47, 0xed, 0x7c, 0x31, 0x6c,
-		0xf4, 0x69, 0xba, 0x13, 0xfc, 0xd1, 0x56, 0xa4, 0x71, 0x89, 0x48, 0x0d, 0x23, 0x8d, 0x4b, 0x54,
-		0x92, 0x26, 0x3f, 0x41, 0xb9, 0x3d, 0x83, 0x1e, 0xed, 0x82, 0x5b, 0xe6, 0xc8, 0xbe, 0x20, 0xc1,
-		0x40, 0x80, 0x68, 0xf0, 0x26, 0x6b, 0xcb, 0x1c, 0x2c, 0x77, 0xbc, 0x1d, 0x18, 0x67, 0x6d, 0x9e,
-		0xb2, 0x36, 0x83, 0x2e, 0x77, 0xcb, 0x5a, 0xd8, 0x07, 0x7e, 0x43, 0x62, 0xaf, 0x35, 0x37, 0x25,
-		0x35, 0xe8, 0xe4, 0x9e, 0x46, 0x23, 0x60, 0x05, 0x4f, 0x75, 0x02, 0xca, 0x39, 0x5f, 0xa0, 0x9c,
-		0xcf, 0xa2, 0x99, 0x2e, 0x38, 0xe7, 0xe6, 0x2f, 0x82, 0xf7, 0x5c, 0x90, 0x77, 0x7f, 0x8e, 0x12,
-		0x69, 0xcb, 0xf7, 0x48, 0x95, 0x22, 0x6d, 0xf9, 0x5e, 0xc9, 0x8f, 0x9c, 0xa7, 0x53, 0x39, 0x8b,
-		0xce, 0x74, 0x3f, 0x15, 0xf4, 0x45, 0x09, 0x32, 0xe1, 0x6c, 0x01, 0x9d, 0x68, 0xa3, 0x99, 0x6e,
-		0x9e, 0x92, 0x3b, 0xd9, 0x01, 0x24, 0xe7, 0xf2, 0x32, 0xe5, 0xf2, 0x22, 0xba, 0xd0, 0xb5, 0xaa,
-		0xe0, 0x1b, 0xf4, 0xa4, 0xf9, 0xdb, 0x12, 0xbb, 0x4e, 0xdb, 0x2a, 0x79, 0x88, 0x8c, 0x46, 0xf6,
-		0x4c, 0x63, 0x22, 0xa3, 0x91, 0xbd, 0x33, 0x13, 0x79, 0x96, 0x4e, 0xe3, 0x49, 0x74, 0xa9, 0x8b,
-		0x69, 0x84, 0x53, 0x19, 0xf4, 0x59, 0xfe, 0xde, 0x7a, 0xe8, 0x73, 0x66, 0xcd, 0xbb, 0x2e, 0x32,
-		0x95, 0x89, 0xb0, 0x1c, 0xd1, 0xc9, 0x8b, 0x7c, 0x81, 0x32, 0xfb, 0x18, 0x9a, 0x6e, 0xc1, 0xac,
-		0xef, 0xea, 0x93, 0xa6, 0x6f, 0x19, 0xd3, 0xef, 0x62, 0x39, 0xd1, 0x7b, 0x50, 0x0d, 0x52, 0x84,
-		0x3f, 0x7a, 0x43, 0xa7, 0x39, 0x0a, 0xf6, 0xa5, 0x07, 0x11, 0x51, 0xb0, 0x3f, 0x3d, 0x90, 0x8f,
-		0x51, 0x0e, 0x8e, 0xa0, 0x43, 0x2d, 0x38, 0x20, 0xc1, 0x3d, 0xc2, 0xee, 0x67, 0x4b, 0x9a, 0x83,
-		0xf6, 0x40, 0xca, 0x13, 0x7c, 0x99, 0x22, 0x22, 0xf9, 0x91, 0x73, 0x74, 0xbc, 0x51, 0x84, 0xfc,
-		0xe3, 0xf1, 0x4b, 0x8d, 0x1f, 0x90, 0x60, 0xb8, 0xf9, 0x4d, 0x96, 0x66, 0x8b, 0x18, 0x95, 0x06,
-		0x45, 0x58, 0xc4, 0xc8, 0x7c, 0x48, 0x96, 0x29, 0x03, 0x87, 0x51, 0xce, 0xcf, 0x40, 0xf0, 0x1d,
-		0xe6, 0x7b, 0x77, 0xaf, 0xe9, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x8d, 0x01, 0xb7, 0x95,
-		0x9d, 0x00, 0x00,
+		// 12941 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x94, 0x1c, 0xd9,
+		0x55, 0x18, 0xae, 0xea, 0xee, 0x99, 0xe9, 0xbe, 0xf3, 0x55, 0xf3, 0x66, 0x24, 0xb5, 0x5a, 0x1f,
+		0x33, 0x2a, 0xed, 0x4a, 0x5a, 0xed, 0xee, 0x68, 0x57, 0xbb, 0x5a, 0xad, 0x66, 0x6d, 0xaf, 0xa7,
+		0xa7, 0x5b, 0xa3, 0xd1, 0xce, 0x97, 0x6b, 0x66, 0xb4, 0x1f, 0xe0, 0x5f, 0xff, 0x6a, 0xba, 0x6b,
+		0x7a, 0x6a, 0xd5, 0x53, 0xd5, 0xee, 0xaa, 0x96, 0x34, 0x6b, 0x9c, 0x18, 0x4c, 0xb0, 0x59, 0x63,
+		0x30, 0xe0, 0x80, 0x3f, 0x90, 0xb1, 0x81, 0xc4, 0xc4, 0x40, 0xf8, 0x72, 0xf8, 0x48, 0x72, 0x0e,
+		0x90, 0xc4, 0xc1, 0x70, 0x48, 0x8e, 0x39, 0x1c, 0x02, 0x21, 0x20, 0x12, 0xdb, 0xc7, 0x5e, 0x1b,
+		0x03, 0x66, 0x31, 0xe7, 0x90, 0xe3, 0x7c, 0x9d, 0xfb, 0x3e, 0xaa, 0x5e, 0x55, 0x57, 0x4f, 0x77,
+		0x6b, 0x25, 0xb3, 0xe1, 0xe4, 0x1f, 0x69, 0xea, 0xbd, 0x7b, 0xef, 0xbb, 0xef, 0xbe, 0xfb, 0xee,
+		0xbb, 0xf7, 0xbe, 0x8f, 0x86, 0x2f, 0xbc, 0x00, 0x53, 0x55, 0xc7, 0xa9, 0xd6, 0xcc, 0xb3, 0xf5,
+		0x86, 0xe3, 0x39, 0x9b, 0xcd, 0xad, 0xb3, 0x15, 0xd3, 0x2d, 0x37, 0xac, 0xba, 0xe7, 0x34, 0xa6,
+		0x69, 0x19, 0x19, 0x65, 0x10, 0xd3, 0x02, 0x42, 0x5b, 0x82, 0xb1, 0x4b, 0x56, 0xcd, 0x2c, 0xf8,
+		0x80, 0x6b, 0xa6, 0x47, 0x9e, 0x84, 0xd4, 0x96, 0x55, 0x33, 0xb3, 0xca, 0x54, 0xf2, 0xf4, 0xe0,
+		0xb9, 0xfb, 0xa6, 0x23, 0x48, 0xd3, 0x61, 0x8c, 0x55, 0x2c, 0xd6, 0x29, 0x86, 0xf6, 0xc1, 0x3e,
+		0x18, 0x8f, 0xa9, 0x25, 0x04, 0x52, 0xb6, 0xb1, 0x83, 0x14, 0x95, 0xd3, 0x19, 0x9d, 0xfe, 0x4d,
+		0xb2, 0x30, 0x50, 0x37, 0xca, 0xd7, 0x8c, 0xaa, 0x99, 0x4d, 0xd0, 0x62, 0xf1, 0x49, 0x8e, 0x01,
+		0x54, 0xcc, 0xba, 0x69, 0x57, 0x4c, 0xbb, 0xbc, 0x9b, 0x4d, 0x4e, 0x25, 0x4f, 0x67, 0x74, 0xa9,
+		0x84, 0x3c, 0x08, 0x63, 0xf5, 0xe6, 0x66, 0xcd, 0x2a, 0x97, 0x24, 0x30, 0x98, 0x4a, 0x9e, 0xee,
+		0xd3, 0x55, 0x56, 0x51, 0x08, 0x80, 0x4f, 0xc1, 0xe8, 0x0d, 0xd3, 0xb8, 0x26, 0x83, 0x0e, 0x52,
+		0xd0, 0x11, 0x2c, 0x96, 0x00, 0xe7, 0x60, 0x68, 0xc7, 0x74, 0x5d, 0xa3, 0x6a, 0x96, 0xbc, 0xdd,
+		0xba, 0x99, 0x4d, 0xd1, 0xde, 0x4f, 0xb5, 0xf4, 0x3e, 0xda, 0xf3, 0x41, 0x8e, 0xb5, 0xbe, 0x5b,
+		0x37, 0xc9, 0x2c, 0x64, 0x4c, 0xbb, 0xb9, 0xc3, 0x28, 0xf4, 0xb5, 0x91, 0x5f, 0xd1, 0x6e, 0xee,
+		0x44, 0xa9, 0xa4, 0x11, 0x8d, 0x93, 0x18, 0x70, 0xcd, 0xc6, 0x75, 0xab, 0x6c, 0x66, 0xfb, 0x29,
+		0x81, 0x53, 0x2d, 0x04, 0xd6, 0x58, 0x7d, 0x94, 0x86, 0xc0, 0x23, 0x73, 0x90, 0x31, 0x6f, 0x7a,
+		0xa6, 0xed, 0x5a, 0x8e, 0x9d, 0x1d, 0xa0, 0x44, 0xee, 0x8f, 0x19, 0x45, 0xb3, 0x56, 0x89, 0x92,
+		0x08, 0xf0, 0xc8, 0x13, 0x30, 0xe0, 0xd4, 0x3d, 0xcb, 0xb1, 0xdd, 0x6c, 0x7a, 0x4a, 0x39, 0x3d,
+		0x78, 0xee, 0x48, 0xac, 0x22, 0xac, 0x30, 0x18, 0x5d, 0x00, 0x93, 0x05, 0x50, 0x5d, 0xa7, 0xd9,
+		0x28, 0x9b, 0xa5, 0xb2, 0x53, 0x31, 0x4b, 0x96, 0xbd, 0xe5, 0x64, 0x33, 0x94, 0xc0, 0x64, 0x6b,
+		0x47, 0x28, 0xe0, 0x9c, 0x53, 0x31, 0x17, 0xec, 0x2d, 0x47, 0x1f, 0x71, 0x43, 0xdf, 0xe4, 0x00,
+		0xf4, 0xbb, 0xbb, 0xb6, 0x67, 0xdc, 0xcc, 0x0e, 0x51, 0x0d, 0xe1, 0x5f, 0xe4, 0x1c, 0x0c, 0x98,
+		0x15, 0x0b, 0x9b, 0xcb, 0x8e, 0x4c, 0x29, 0xa7, 0x47, 0xce, 0x65, 0x5b, 0x65, 0xcc, 0xea, 0x75,
+		0x01, 0xa8, 0xfd, 0x6a, 0x3f, 0x8c, 0x76, 0xa3, 0x96, 0x4f, 0x41, 0xdf, 0x16, 0x4a, 0x26, 0x9b,
+		0xe8, 0x45, 0x6e, 0x0c, 0x27, 0x2c, 0xf8, 0xfe, 0x3b, 0x14, 0xfc, 0x2c, 0x0c, 0xda, 0xa6, 0xeb,
+		0x99, 0x15, 0xa6, 0x45, 0xc9, 0x2e, 0xf5, 0x10, 0x18, 0x52, 0xab, 0x1a, 0xa6, 0xee, 0x48, 0x0d,
+		0x9f, 0x83, 0x51, 0x9f, 0xa5, 0x52, 0xc3, 0xb0, 0xab, 0x42, 0x9f, 0xcf, 0x76, 0xe2, 0x64, 0xba,
+		0x28, 0xf0, 0x74, 0x44, 0xd3, 0x47, 0xcc, 0xd0, 0x37, 0x29, 0x00, 0x38, 0xb6, 0xe9, 0x6c, 0x95,
+		0x2a, 0x66, 0xb9, 0x96, 0x4d, 0xb7, 0x91, 0xd2, 0x0a, 0x82, 0xb4, 0x48, 0xc9, 0x61, 0xa5, 0xe5,
+		0x1a, 0xb9, 0x18, 0xa8, 0xe7, 0x40, 0x1b, 0xed, 0x5a, 0x62, 0x13, 0xb3, 0x45, 0x43, 0x37, 0x60,
+		0xa4, 0x61, 0xe2, 0x5c, 0x31, 0x2b, 0xbc, 0x67, 0x19, 0xca, 0xc4, 0x74, 0xc7, 0x9e, 0xe9, 0x1c,
+		0x8d, 0x75, 0x6c, 0xb8, 0x21, 0x7f, 0x92, 0x13, 0xe0, 0x17, 0x94, 0xa8, 0x5a, 0x01, 0xb5, 0x5c,
+		0x43, 0xa2, 0x70, 0xd9, 0xd8, 0x31, 0x73, 0x2f, 0xc1, 0x48, 0x58, 0x3c, 0x64, 0x02, 0xfa, 0x5c,
+		0xcf, 0x68, 0x78, 0x54, 0x0b, 0xfb, 0x74, 0xf6, 0x41, 0x54, 0x48, 0x9a, 0x76, 0x85, 0x5a, 0xc6,
+		0x3e, 0x1d, 0xff, 0x24, 0x6f, 0x0e, 0x3a, 0x9c, 0xa4, 0x1d, 0x3e, 0xd9, 0x3a, 0xa2, 0x21, 0xca,
+		0xd1, 0x7e, 0xe7, 0x2e, 0xc0, 0x70, 0xa8, 0x03, 0xdd, 0x36, 0xad, 0xfd, 0x66, 0x0a, 0xf6, 0xc7,
+		0xd2, 0x26, 0xcf, 0xc1, 0x44, 0xd3, 0xb6, 0x6c, 0xcf, 0x6c, 0xd4, 0x1b, 0x26, 0xaa, 0x2c, 0x6b,
+		0x2b, 0xfb, 0xa5, 0x81, 0x36, 0x4a, 0xb7, 0x21, 0x43, 0x33, 0x2a, 0xfa, 0x78, 0xb3, 0xb5, 0x90,
+		0x3c, 0x0f, 0x83, 0xa8, 0x1f, 0x46, 0xc3, 0xa0, 0x04, 0xd9, 0x6c, 0x3c, 0xd7, 0x5d, 0x97, 0xa7,
+		0x0b, 0x01, 0x66, 0x3e, 0xf9, 0x1e, 0x25, 0xa1, 0xcb, 0xb4, 0xc8, 0x05, 0x48, 0x6f, 0x99, 0x86,
+		0xd7, 0x6c, 0x98, 0x6e, 0xf6, 0x1c, 0x15, 0xe5, 0xe1, 0xd6, 0x49, 0xca, 0x00, 0xd6, 0x4c, 0x4f,
+		0xf7, 0x81, 0xc9, 0x36, 0x0c, 0x5d, 0x37, 0x1b, 0xd6, 0x96, 0x55, 0x66, 0x4c, 0x25, 0xa9, 0xf1,
+		0x79, 0xb2, 0x4b, 0xa6, 0xae, 0x4a, 0xa8, 0x6b, 0x9e, 0xe1, 0x99, 0x33, 0xb0, 0xb1, 0x7c, 0xb5,
+		0xa8, 0x2f, 0x5c, 0x5a, 0x28, 0x16, 0xf4, 0x10, 0xe5, 0xdc, 0x07, 0x14, 0x18, 0x94, 0x3a, 0x81,
+		0x96, 0xd0, 0x6e, 0xee, 0x6c, 0x9a, 0x0d, 0x3e, 0x54, 0xfc, 0x8b, 0x1c, 0x86, 0xcc, 0x56, 0xb3,
+		0x56, 0x63, 0xfa, 0xc6, 0x96, 0xd1, 0x34, 0x16, 0xa0, 0xae, 0xa1, 0x79, 0xe3, 0x16, 0x84, 0x9a,
+		0x37, 0xfc, 0x9b, 0xe4, 0x20, 0x2d, 0xf4, 0x31, 0xdb, 0x37, 0xa5, 0x9c, 0x4e, 0xeb, 0xfe, 0x37,
+		0xab, 0xab, 0x9b, 0x86, 0x67, 0x56, 0xb2, 0xfd, 0xa2, 0x8e, 0x7d, 0x5f, 0x49, 0xa5, 0x53, 0x6a,
+		0x9f, 0xf6, 0x38, 0x8c, 0xb5, 0xf4, 0x82, 0x8c, 0xc2, 0x60, 0xa1, 0x38, 0xb7, 0x38, 0xab, 0xcf,
+		0xae, 0x2f, 0xac, 0x2c, 0xab, 0xfb, 0xc8, 0x08, 0x48, 0x1d, 0x53, 0x95, 0x33, 0x99, 0xf4, 0x2b,
+		0x03, 0xea, 0x3b, 0xdf, 0xf9, 0xce, 0x77, 0x26, 0xb4, 0xdf, 0xe8, 0x87, 0x89, 0x38, 0xfb, 0x17,
+		0x6b, 0x8a, 0x83, 0x4e, 0x27, 0x43, 0x9d, 0x9e, 0x85, 0xbe, 0x9a, 0xb1, 0x69, 0xd6, 0xb2, 0x29,
+		0x2a, 0xff, 0x07, 0xbb, 0xb2, 0xb0, 0xd3, 0x8b, 0x88, 0xa2, 0x33, 0x4c, 0xf2, 0x26, 0x2e, 0x9a,
+		0x3e, 0x4a, 0xe1, 0x4c, 0x77, 0x14, 0xd0, 0x2e, 0x72, 0x31, 0x1e, 0x86, 0x0c, 0xfe, 0xcf, 0xe4,
+		0xde, 0xcf, 0xe4, 0x8e, 0x05, 0x54, 0xee, 0x39, 0x48, 0x53, 0x93, 0x57, 0x31, 0xfd, 0x31, 0x11,
+		0xdf, 0x68, 0x24, 0x2a, 0xe6, 0x96, 0xd1, 0xac, 0x79, 0xa5, 0xeb, 0x46, 0xad, 0x69, 0x52, 0xe3,
+		0x95, 0xd1, 0x87, 0x78, 0xe1, 0x55, 0x2c, 0x23, 0x93, 0x30, 0xc8, 0x2c, 0xa4, 0x65, 0x57, 0xcc,
+		0x9b, 0x74, 0xf5, 0xec, 0xd3, 0x99, 0xd1, 0x5c, 0xc0, 0x12, 0x6c, 0xfe, 0x45, 0xd7, 0xb1, 0x85,
+		0x99, 0xa1, 0x4d, 0x60, 0x01, 0x6d, 0xfe, 0x42, 0x74, 0xe1, 0x3e, 0x1a, 0xdf, 0xbd, 0x16, 0xbb,
+		0x78, 0x0a, 0x46, 0x29, 0xc4, 0x63, 0x7c, 0x16, 0x1b, 0xb5, 0xec, 0x18, 0x55, 0x83, 0x11, 0x56,
+		0xbc, 0xc2, 0x4b, 0xb5, 0x5f, 0x4a, 0x40, 0x8a, 0x2e, 0x12, 0xa3, 0x30, 0xb8, 0xfe, 0xfc, 0x6a,
+		0xb1, 0x54, 0x58, 0xd9, 0xc8, 0x2f, 0x16, 0x55, 0x05, 0x87, 0x9e, 0x16, 0x5c, 0x5a, 0x5c, 0x99,
+		0x5d, 0x57, 0x13, 0xfe, 0xf7, 0xc2, 0xf2, 0xfa, 0x13, 0x8f, 0xab, 0x49, 0x1f, 0x61, 0x83, 0x15,
+		0xa4, 0x64, 0x80, 0xc7, 0xce, 0xa9, 0x7d, 0x44, 0x85, 0x21, 0x46, 0x60, 0xe1, 0xb9, 0x62, 0xe1,
+		0x89, 0xc7, 0xd5, 0xfe, 0x70, 0xc9, 0x63, 0xe7, 0xd4, 0x01, 0x32, 0x0c, 0x19, 0x5a, 0x92, 0x5f,
+		0x59, 0x59, 0x54, 0xd3, 0x3e, 0xcd, 0xb5, 0x75, 0x7d, 0x61, 0x79, 0x5e, 0xcd, 0xf8, 0x34, 0xe7,
+		0xf5, 0x95, 0x8d, 0x55, 0x15, 0x7c, 0x0a, 0x4b, 0xc5, 0xb5, 0xb5, 0xd9, 0xf9, 0xa2, 0x3a, 0xe8,
+		0x43, 0xe4, 0x9f, 0x5f, 0x2f, 0xae, 0xa9, 0x43, 0x21, 0xb6, 0x1e, 0x3b, 0xa7, 0x0e, 0xfb, 0x4d,
+		0x14, 0x97, 0x37, 0x96, 0xd4, 0x11, 0x32, 0x06, 0xc3, 0xac, 0x09, 0xc1, 0xc4, 0x68, 0xa4, 0xe8,
+		0x89, 0xc7, 0x55, 0x35, 0x60, 0x84, 0x51, 0x19, 0x0b, 0x15, 0x3c, 0xf1, 0xb8, 0x4a, 0xb4, 0x39,
+		0xe8, 0xa3, 0x6a, 0x48, 0x08, 0x8c, 0x2c, 0xce, 0xe6, 0x8b, 0x8b, 0xa5, 0x95, 0x55, 0x9c, 0x34,
+		0xb3, 0x8b, 0xaa, 0x12, 0x94, 0xe9, 0xc5, 0xd5, 0xe2, 0xec, 0x7a, 0xb1, 0xa0, 0x26, 0xe5, 0xb2,
+		0xb7, 0x6c, 0x2c, 0xe8, 0xc5, 0x82, 0x9a, 0xd0, 0xca, 0x30, 0x11, 0xb7, 0x38, 0xc6, 0x4e, 0x21,
+		0x49, 0x17, 0x12, 0x6d, 0x74, 0x81, 0xd2, 0x8a, 0xea, 0x82, 0xf6, 0xf9, 0x04, 0x8c, 0xc7, 0x38,
+		0x08, 0xb1, 0x8d, 0x3c, 0x0d, 0x7d, 0x4c, 0x97, 0x99, 0x91, 0x7e, 0x20, 0xd6, 0xd3, 0xa0, 0x9a,
+		0xdd, 0xe2, 0x36, 0x51, 0x3c, 0xd9, 0xd5, 0x4c, 0xb6, 0x71, 0x35, 0x91, 0x44, 0x8b, 0xc2, 0xbe,
+		0xb5, 0x65, 0x21, 0x67, 0xbe, 0xce, 0x13, 0xdd, 0xf8, 0x3a, 0xb4, 0xac, 0xb7, 0x05, 0xbd, 0x2f,
+		0x66, 0x41, 0x7f, 0x0a, 0xc6, 0x5a, 0x08, 0x75, 0xbd, 0xb0, 0xbe, 0x4b, 0x81, 0x6c, 0x3b, 0xe1,
+		0x74, 0x30, 0x89, 0x89, 0x90, 0x49, 0x7c, 0x2a, 0x2a, 0xc1, 0xe3, 0xed, 0x07, 0xa1, 0x65, 0xac,
+		0x3f, 0xa1, 0xc0, 0x81, 0xf8, 0x90, 0x22, 0x96, 0x87, 0x37, 0x41, 0xff, 0x8e, 0xe9, 0x6d, 0x3b,
+		0xc2, 0x45, 0x3e, 0x19, 0xe3, 0x78, 0x61, 0x75, 0x74, 0xb0, 0x39, 0x16, 0xb9, 0x18, 0xe5, 0x75,
+		0xb2, 0x5d, 0x80, 0xd3, 0xc2, 0xe9, 0x77, 0x27, 0x60, 0x7f, 0x2c, 0xf1, 0x58, 0x46, 0x8f, 0x02,
+		0x58, 0x76, 0xbd, 0xe9, 0x31, 0x37, 0x98, 0x59, 0xe2, 0x0c, 0x2d, 0xa1, 0xc6, 0x0b, 0xad, 0x6c,
+		0xd3, 0xf3, 0xeb, 0xd9, 0x2a, 0x09, 0xac, 0x88, 0x02, 0x3c, 0x19, 0x30, 0x9a, 0xa2, 0x8c, 0x1e,
+		0x6b, 0xd3, 0xd3, 0x16, 0xc5, 0x7c, 0x04, 0xd4, 0x72, 0xcd, 0x32, 0x6d, 0xaf, 0xe4, 0x7a, 0x0d,
+		0xd3, 0xd8, 0xb1, 0xec, 0x2a, 0x5b, 0x6d, 0x67, 0xfa, 0xb6, 0x8c, 0x9a, 0x6b, 0xea, 0xa3, 0xac,
+		0x7a, 0x4d, 0xd4, 0x22, 0x06, 0x55, 0xa0, 0x86, 0x84, 0xd1, 0x1f, 0xc2, 0x60, 0xd5, 0x3e, 0x86,
+		0xf6, 0xdb, 0x19, 0x18, 0x94, 0x02, 0x30, 0x72, 0x1c, 0x86, 0x5e, 0x34, 0xae, 0x1b, 0x25, 0x11,
+		0x54, 0x33, 0x49, 0x0c, 0x62, 0xd9, 0x2a, 0x2b, 0x22, 0x8f, 0xc0, 0x04, 0x05, 0x71, 0x9a, 0x9e,
+		0xd9, 0x28, 0x95, 0x6b, 0x86, 0xeb, 0x52, 0xa1, 0xa5, 0x29, 0x28, 0xc1, 0xba, 0x15, 0xac, 0x9a,
+		0x13, 0x35, 0xe4, 0x3c, 0x8c, 0x53, 0x8c, 0x9d, 0x66, 0xcd, 0xb3, 0xea, 0x35, 0xb3, 0x84, 0x61,
+		0xbe, 0x9b, 0x05, 0x99, 0xb3, 0x31, 0x84, 0x58, 0xe2, 0x00, 0xc8, 0x91, 0x4b, 0x0a, 0x70, 0x94,
+		0xa2, 0x55, 0x4d, 0xdb, 0x6c, 0x18, 0x9e, 0x59, 0x32, 0xdf, 0xd6, 0x34, 0x6a, 0x6e, 0xc9, 0xb0,
+		0x2b, 0xa5, 0x6d, 0xc3, 0xdd, 0xce, 0x4e, 0x20, 0x81, 0x7c, 0x22, 0xab, 0xe8, 0x87, 0x10, 0x70,
+		0x9e, 0xc3, 0x15, 0x29, 0xd8, 0xac, 0x5d, 0xb9, 0x6c, 0xb8, 0xdb, 0x64, 0x06, 0x0e, 0x50, 0x2a,
+		0xae, 0xd7, 0xb0, 0xec, 0x6a, 0xa9, 0xbc, 0x6d, 0x96, 0xaf, 0x95, 0x9a, 0xde, 0xd6, 0x93, 0xd9,
+		0xc3, 0x72, 0xfb, 0x94, 0xc3, 0x35, 0x0a, 0x33, 0x87, 0x20, 0x1b, 0xde, 0xd6, 0x93, 0x64, 0x0d,
+		0x86, 0x70, 0x30, 0x76, 0xac, 0x97, 0xcc, 0xd2, 0x96, 0xd3, 0xa0, 0x6b, 0xe8, 0x48, 0x8c, 0x69,
+		0x92, 0x24, 0x38, 0xbd, 0xc2, 0x11, 0x96, 0x9c, 0x8a, 0x39, 0xd3, 0xb7, 0xb6, 0x5a, 0x2c, 0x16,
+		0xf4, 0x41, 0x41, 0xe5, 0x92, 0xd3, 0x40, 0x85, 0xaa, 0x3a, 0xbe, 0x80, 0x07, 0x99, 0x42, 0x55,
+		0x1d, 0x21, 0xde, 0xf3, 0x30, 0x5e, 0x2e, 0xb3, 0x3e, 0x5b, 0xe5, 0x12, 0x0f, 0xc6, 0xdd, 0xac,
+		0x1a, 0x12, 0x56, 0xb9, 0x3c, 0xcf, 0x00, 0xb8, 0x8e, 0xbb, 0xe4, 0x22, 0xec, 0x0f, 0x84, 0x25,
+		0x23, 0x8e, 0xb5, 0xf4, 0x32, 0x8a, 0x7a, 0x1e, 0xc6, 0xeb, 0xbb, 0xad, 0x88, 0x24, 0xd4, 0x62,
+		0x7d, 0x37, 0x8a, 0x76, 0x01, 0x26, 0xea, 0xdb, 0xf5, 0x56, 0xbc, 0x33, 0x32, 0x1e, 0xa9, 0x6f,
+		0xd7, 0xa3, 0x88, 0xf7, 0xd3, 0xcc, 0x4c, 0xc3, 0x2c, 0x53, 0x1f, 0xf1, 0xa0, 0x0c, 0x2e, 0x55,
+		0x90, 0x69, 0x50, 0xcb, 0xe5, 0x92, 0x69, 0x1b, 0x9b, 0x35, 0xb3, 0x64, 0x34, 0x4c, 0xdb, 0x70,
+		0xb3, 0x93, 0x14, 0x38, 0xe5, 0x35, 0x9a, 0xa6, 0x3e, 0x52, 0x2e, 0x17, 0x69, 0xe5, 0x2c, 0xad,
+		0x23, 0x67, 0x60, 0xcc, 0xd9, 0x7c, 0xb1, 0xcc, 0x34, 0xb2, 0x54, 0x6f, 0x98, 0x5b, 0xd6, 0xcd,
+		0xec, 0x7d, 0x54, 0xbc, 0xa3, 0x58, 0x41, 0xf5, 0x71, 0x95, 0x16, 0x93, 0x07, 0x40, 0x2d, 0xbb,
+		0xdb, 0x46, 0xa3, 0x4e, 0x4d, 0xb2, 0x5b, 0x37, 0xca, 0x66, 0xf6, 0x7e, 0x06, 0xca, 0xca, 0x97,
+		0x45, 0x31, 0xce, 0x08, 0xf7, 0x86, 0xb5, 0xe5, 0x09, 0x8a, 0xa7, 0xd8, 0x8c, 0xa0, 0x65, 0x9c,
+		0xda, 0x69, 0x50, 0x51, 0x12, 0xa1, 0x86, 0x4f, 0x53, 0xb0, 0x91, 0xfa, 0x76, 0x5d, 0x6e, 0xf7,
+		0x04, 0x0c, 0xd7, 0xb7, 0xe5, 0x46, 0x1f, 0x60, 0x8e, 0x5b, 0x7d, 0x5b, 0x6a, 0xf1, 0x71, 0x38,
+		0x80, 0x40, 0x3b, 0xa6, 0x67, 0x54, 0x0c, 0xcf, 0x90, 0xa0, 0x1f, 0xa2, 0xd0, 0x28, 0xf6, 0x25,
+		0x5e, 0x19, 0xe2, 0xb3, 0xd1, 0xdc, 0xdc, 0xf5, 0x15, 0xeb, 0x61, 0xc6, 0x27, 0x96, 0x09, 0xd5,
+		0xba, 0xe3, 0x90, 0xe5, 0x9e, 0x05, 0x68, 0xda, 0x0c, 0x0c, 0xc9, 0x13, 0x86, 0x64, 0x80, 0x4d,
+		0x19, 0x55, 0x41, 0xef, 0x69, 0x6e, 0xa5, 0x80, 0x7e, 0xcf, 0x0b, 0x45, 0x35, 0x81, 0xfe, 0xd7,
+		0xe2, 0xc2, 0x7a, 0xb1, 0xa4, 0x6f, 0x2c, 0xaf, 0x2f, 0x2c, 0x15, 0xd5, 0xa4, 0x14, 0x11, 0x5c,
+		0x49, 0xa5, 0x4f, 0xaa, 0xa7, 0xb4, 0xaf, 0x27, 0x61, 0x24, 0x1c, 0xae, 0x93, 0x37, 0xc0, 0x41,
+		0x91, 0x8f, 0x73, 0x4d, 0xaf, 0x74, 0xc3, 0x6a, 0xd0, 0x99, 0xbc, 0x63, 0xb0, 0x55, 0xd5, 0x57,
+		0xbc, 0x09, 0x0e, 0xb5, 0x66, 0x7a, 0xcf, 0x5a, 0x0d, 0x9c, 0xa7, 0x3b, 0x86, 0x47, 0x16, 0x61,
+		0xd2, 0x76, 0x4a, 0xae, 0x67, 0xd8, 0x15, 0xa3, 0x51, 0x29, 0x05, 0x99, 0xd0, 0x92, 0x51, 0x2e,
+		0x9b, 0xae, 0xeb, 0xb0, 0x15, 0xd4, 0xa7, 0x72, 0xc4, 0x76, 0xd6, 0x38, 0x70, 0xb0, 0xb4, 0xcc,
+		0x72, 0xd0, 0x88, 0xde, 0x27, 0xdb, 0xe9, 0xfd, 0x61, 0xc8, 0xec, 0x18, 0xf5, 0x92, 0x69, 0x7b,
+		0x8d, 0x5d, 0xea, 0xd8, 0xa7, 0xf5, 0xf4, 0x8e, 0x51, 0x2f, 0xe2, 0x37, 0xb9, 0x0a, 0x27, 0x03,
+		0xd0, 0x52, 0xcd, 0xac, 0x1a, 0xe5, 0xdd, 0x12, 0xf5, 0xe2, 0x69, 0xee, 0xa8, 0x54, 0x76, 0xec,
+		0xad, 0x9a, 0x55, 0xf6, 0xdc, 0xec, 0xa0, 0x6f, 0x1c, 0xb5, 0x00, 0x63, 0x91, 0x22, 0x5c, 0x71,
+		0x1d, 0x9b, 0x3a, 0xef, 0x73, 0x02, 0x3a, 0xa4, 0x1a, 0x43, 0xaf, 0x0b, 0xd5, 0x08, 0x0f, 0x6f,
+		0x4a, 0xed, 0xbb, 0x92, 0x4a, 0xf7, 0xa9, 0xfd, 0x57, 0x52, 0xe9, 0x7e, 0x75, 0xe0, 0x4a, 0x2a,
+		0x9d, 0x56, 0x33, 0x57, 0x52, 0xe9, 0x8c, 0x0a, 0xda, 0xcf, 0x00, 0x0c, 0xc9, 0xb1, 0x08, 0x86,
+		0x76, 0x65, 0xba, 0x1a, 0x2b, 0xd4, 0x5e, 0x9f, 0xd8, 0x33, 0x72, 0x99, 0x9e, 0xc3, 0x65, 0x7a,
+		0xa6, 0x9f, 0x39, 0xfe, 0x3a, 0xc3, 0x44, 0x17, 0x09, 0x27, 0x92, 0xc9, 0x1c, 0xad, 0xb4, 0xce,
+		0xbf, 0xc8, 0x3c, 0xf4, 0xbf, 0xe8, 0x52, 0xda, 0xfd, 0x94, 0xf6, 0x7d, 0x7b, 0xd3, 0xbe, 0xb2,
+		0x46, 0x89, 0x67, 0xae, 0xac, 0x95, 0x96, 0x57, 0xf4, 0xa5, 0xd9, 0x45, 0x9d, 0xa3, 0x93, 0x43,
+		0x90, 0xaa, 0x19, 0x2f, 0xed, 0x86, 0x17, 0x74, 0x5a, 0x44, 0xa6, 0x61, 0xb4, 0x69, 0xb3, 0x40,
+		0x1e, 0xc7, 0x18, 0xa1, 0x46, 0x65, 0xa8, 0x91, 0xa0, 0x76, 0x11, 0xe1, 0xbb, 0xd4, 0xab, 0x43,
+		0x90, 0xc2, 0x64, 0x75, 0x78, 0xd9, 0xa5, 0x45, 0xe4, 0x34, 0x0c, 0x55, 0xcc, 0xcd, 0x66, 0xb5,
+		0xd4, 0x30, 0x2b, 0x46, 0xd9, 0x0b, 0x2f, 0x36, 0x83, 0xb4, 0x4a, 0xa7, 0x35, 0xe4, 0x19, 0xc8,
+		0xe0, 0x18, 0xd9, 0x74, 0x8c, 0xc7, 0xa8, 0x08, 0x1e, 0xde, 0x5b, 0x04, 0x7c, 0x88, 0x05, 0x92,
+		0x1e, 0xe0, 0x93, 0xcb, 0x30, 0xe0, 0x19, 0x8d, 0xaa, 0xe9, 0xb9, 0xd9, 0xf1, 0xa9, 0xe4, 0xe9,
+		0x91, 0x73, 0xd3, 0xdd, 0x90, 0x5a, 0xa7, 0x28, 0x34, 0x8c, 0x16, 0xe8, 0xe4, 0x59, 0x50, 0x79,
+		0x8a, 0xb6, 0xc4, 0x63, 0x60, 0x37, 0x3b, 0x41, 0x15, 0xf0, 0xa1, 0xbd, 0x49, 0xf2, 0x0c, 0x6f,
+		0x81, 0x21, 0xe9, 0xa3, 0x66, 0xe8, 0x3b, 0x3c, 0x2f, 0xf6, 0xbf, 0x2e, 0xe6, 0x45, 0xee, 0x05,
+		0x18, 0x09, 0x73, 0x2d, 0x67, 0xb2, 0x93, 0x5d, 0x66, 0xb2, 0x31, 0xb8, 0x10, 0xe1, 0x16, 0xae,
+		0x13, 0xec, 0x43, 0x3b, 0x0b, 0x7d, 0x74, 0x3a, 0x10, 0x00, 0x3e, 0x21, 0xd4, 0x7d, 0x24, 0x0d,
+		0xa9, 0xb9, 0x15, 0x1d, 0x4d, 0xb2, 0x0a, 0x43, 0xac, 0xb4, 0xb4, 0xba, 0x50, 0x9c, 0x2b, 0xaa,
+		0x09, 0xed, 0x3c, 0xf4, 0x33, 0x1d, 0x47, 0x73, 0xed, 0x6b, 0xb9, 0xba, 0x8f, 0x7f, 0x72, 0x1a,
+		0x8a, 0xa8, 0xdd, 0x58, 0xca, 0x17, 0x75, 0x35, 0xa1, 0x6d, 0xc0, 0x68, 0x44, 0x2f, 0xc8, 0x7e,
+		0x18, 0xd3, 0x8b, 0xeb, 0xc5, 0x65, 0x8c, 0x64, 0x4b, 0x1b, 0xcb, 0xcf, 0x2c, 0xaf, 0x3c, 0x8b,
+		0x69, 0xa0, 0x50, 0xb1, 0xb0, 0xfd, 0x0a, 0x99, 0x00, 0x35, 0x28, 0x5e, 0x5b, 0xd9, 0xd0, 0x29,
+		0x37, 0xdf, 0x93, 0x00, 0x35, 0xaa, 0x24, 0xe4, 0x20, 0x8c, 0xaf, 0xcf, 0xea, 0xf3, 0xc5, 0xf5,
+		0x12, 0x8b, 0xce, 0x7d, 0xd2, 0x13, 0xa0, 0xca, 0x15, 0x97, 0x16, 0x68, 0xf2, 0x61, 0x12, 0x0e,
+		0xcb, 0xa5, 0xc5, 0xe7, 0xd6, 0x8b, 0xcb, 0x6b, 0xb4, 0xf1, 0xd9, 0xe5, 0x79, 0x5c, 0x88, 0x22,
+		0xf4, 0x44, 0x3e, 0x20, 0x89, 0xac, 0x86, 0xe9, 0x15, 0x17, 0x0b, 0x6a, 0x2a, 0x5a, 0xbc, 0xb2,
+		0x5c, 0x5c, 0xb9, 0xa4, 0xf6, 0x45, 0x5b, 0xa7, 0x39, 0x82, 0x7e, 0x92, 0x83, 0x03, 0xd1, 0xd2,
+		0x52, 0x71, 0x79, 0x5d, 0x7f, 0x5e, 0x1d, 0x88, 0x36, 0xbc, 0x56, 0xd4, 0xaf, 0x2e, 0xcc, 0x15,
+		0xd5, 0x34, 0x39, 0x00, 0x24, 0xcc, 0xd1, 0xfa, 0xe5, 0x95, 0x82, 0x9a, 0x89, 0xb3, 0xa0, 0x44,
+		0x1d, 0xd7, 0x7e, 0x5a, 0x81, 0x21, 0x39, 0x5e, 0x0f, 0x29, 0xb9, 0xf2, 0x7a, 0x33, 0xfe, 0xda,
+		0xef, 0x26, 0x60, 0x50, 0x0a, 0xdc, 0x31, 0xe2, 0x32, 0x6a, 0x35, 0xe7, 0x46, 0xc9, 0xa8, 0x59,
+		0x86, 0xcb, 0xed, 0x33, 0xd0, 0xa2, 0x59, 0x2c, 0xe9, 0xd6, 0x1e, 0x76, 0xbf, 0x94, 0xf6, 0xdf,
+		0xf1, 0x52, 0x3a, 0xf0, 0x3a, 0x5c, 0x4a, 0xfb, 0xd4, 0x7e, 0xed, 0xdb, 0x13, 0xa0, 0x46, 0x43,
+		0xf9, 0x88, 0xdc, 0x94, 0x76, 0x72, 0x93, 0xfb, 0x97, 0xe8, 0xa5, 0x7f, 0xd1, 0x55, 0x26, 0xd9,
+		0x76, 0x95, 0xf9, 0xa6, 0xe8, 0xd5, 0xef, 0x2b, 0x30, 0x12, 0x4e, 0x11, 0x84, 0xba, 0xa6, 0xf5,
+		0xd2, 0xb5, 0xb0, 0xe8, 0x8e, 0xb7, 0x13, 0xdd, 0x37, 0xa5, 0x5f, 0x1f, 0x4a, 0xc2, 0x70, 0x28,
+		0xa3, 0xd0, 0x2d, 0x77, 0x6f, 0x83, 0x31, 0xab, 0x62, 0xee, 0xd4, 0x1d, 0x0f, 0xb7, 0xb2, 0x4b,
+		0x35, 0xf3, 0xba, 0x59, 0xa3, 0x62, 0x18, 0x89, 0xd9, 0xae, 0x0b, 0xb5, 0x30, 0xbd, 0x10, 0xe0,
+		0x2d, 0x22, 0xda, 0xcc, 0xf8, 0x42, 0xa1, 0xb8, 0xb4, 0xba, 0xb2, 0x5e, 0x5c, 0x9e, 0x7b, 0x5e,
+		0x98, 0x5c, 0x5d, 0xb5, 0x22, 0x60, 0x21, 0x81, 0x9f, 0x78, 0x7d, 0x44, 0x24, 0xab, 0xa0, 0x46,
+		0x7b, 0x83, 0x96, 0x37, 0xa6, 0x3f, 0xea, 0x3e, 0x32, 0x0e, 0xa3, 0xcb, 0x2b, 0xa5, 0xb5, 0x85,
+		0x42, 0xb1, 0x54, 0xbc, 0x74, 0xa9, 0x38, 0xb7, 0xbe, 0xc6, 0xd2, 0xd7, 0x3e, 0xf4, 0xba, 0x9a,
+		0x90, 0xc7, 0xe6, 0xc3, 0x49, 0x18, 0x8f, 0xe1, 0x84, 0xcc, 0xf2, 0xc4, 0x13, 0xcb, 0x85, 0x3d,
+		0xdc, 0x0d, 0xf7, 0xd3, 0x18, 0xfa, 0xad, 0x1a, 0x0d, 0x8f, 0xe7, 0xa9, 0x1e, 0x00, 0x14, 0xaf,
+		0xed, 0xa1, 0x5f, 0xd8, 0xe0, 0xdb, 0x02, 0x2c, 0x1b, 0x35, 0x1a, 0x94, 0xb3, 0x9d, 0x81, 0x87,
+		0x80, 0xd4, 0x1d, 0xd7, 0xf2, 0xac, 0xeb, 0xb8, 0xb3, 0x2e, 0xf6, 0x10, 0x30, 0x3b, 0x95, 0xd2,
+		0x55, 0x51, 0xb3, 0x60, 0x7b, 0x3e, 0xb4, 0x6d, 0x56, 0x8d, 0x08, 0x34, 0xfa, 0xad, 0x49, 0x5d,
+		0x15, 0x35, 0x3e, 0xf4, 0x71, 0x18, 0xaa, 0x38, 0x4d, 0x0c, 0xd9, 0x19, 0x1c, 0xda, 0x4e, 0x45,
+		0x1f, 0x64, 0x65, 0x3e, 0x08, 0x4f, 0xc6, 0x04, 0x9b, 0x17, 0x43, 0xfa, 0x20, 0x2b, 0x63, 0x20,
+		0xa7, 0x60, 0xd4, 0xa8, 0x56, 0x1b, 0x48, 0x5c, 0x10, 0x62, 0xe9, 0xa5, 0x11, 0xbf, 0x98, 0x02,
+		0xe6, 0xae, 0x40, 0x5a, 0xc8, 0x01, 0x03, 0x27, 0x94, 0x44, 0xa9, 0xce, 0x72, 0xa6, 0x09, 0xdc,
+		0xcf, 0xb0, 0x45, 0xe5, 0x71, 0x18, 0xb2, 0xdc, 0x52, 0xb0, 0xaf, 0x9e, 0x98, 0x4a, 0x9c, 0x4e,
+		0xeb, 0x83, 0x96, 0xeb, 0x6f, 0xb3, 0x69, 0xff, 0x23, 0x03, 0x10, 0x28, 0x1b, 0x79, 0xaf, 0x02,
+		0x23, 0x6c, 0x25, 0xa8, 0x37, 0x4c, 0xd7, 0xb4, 0xcb, 0x22, 0x9e, 0x78, 0x60, 0x0f, 0x15, 0x65,
+		0xde, 0xe5, 0x2a, 0x47, 0xc8, 0x5f, 0x7c, 0x8f, 0xa2, 0x7c, 0x50, 0x49, 0x7d, 0x50, 0x51, 0x7e,
+		0x4c, 0x19, 0x26, 0xe9, 0xe2, 0x73, 0xab, 0x8b, 0x0b, 0x73, 0x0b, 0xeb, 0xd9, 0x2f, 0x0e, 0xd0,
+		0xef, 0x85, 0x25, 0xfe, 0xfd, 0xa5, 0x81, 0x70, 0xfd, 0x2b, 0x03, 0xfa, 0xf0, 0x96, 0x4c, 0x89,
+		0x6c, 0xc9, 0x9b, 0xf1, 0x89, 0x76, 0xb1, 0x47, 0xc0, 0x47, 0x91, 0x6f, 0xc1, 0xe7, 0x4f, 0x50,
+		0x16, 0xfa, 0x29, 0x0b, 0x83, 0xa4, 0x7f, 0x6e, 0x71, 0x65, 0xad, 0x58, 0xa0, 0x0c, 0x64, 0x48,
+		0x6a, 0x65, 0xb5, 0xb8, 0x9c, 0xfd, 0xd2, 0x80, 0xb4, 0x63, 0xff, 0x03, 0x0a, 0x1c, 0x14, 0xfb,
+		0x75, 0x7c, 0x21, 0x34, 0xed, 0xb2, 0x53, 0xc1, 0x54, 0x22, 0x73, 0x2e, 0x1f, 0xdd, 0xab, 0x59,
+		0x9d, 0xa3, 0x52, 0x31, 0x14, 0x39, 0x62, 0xfe, 0x54, 0x8b, 0x18, 0x66, 0x97, 0x0b, 0x9c, 0x8b,
+		0x41, 0xd2, 0xbf, 0x3a, 0x3b, 0xf7, 0x4c, 0xb1, 0x80, 0x7c, 0xec, 0x6f, 0xc4, 0xe1, 0x93, 0x9b,
+		0x30, 0x8a, 0x19, 0x3b, 0xd4, 0x04, 0xab, 0xc2, 0x76, 0x4d, 0x53, 0xed, 0xf6, 0xdc, 0x02, 0x5e,
+		0x30, 0x85, 0x77, 0xd5, 0xc7, 0xc8, 0x9f, 0x90, 0x98, 0xc8, 0x90, 0xd4, 0xf2, 0xca, 0x72, 0x51,
+		0x30, 0x40, 0xb7, 0x19, 0x9f, 0x47, 0x06, 0x46, 0x9a, 0x21, 0x24, 0x72, 0x13, 0x54, 0x91, 0x3f,
+		0xf0, 0xc5, 0xd0, 0xd7, 0x6e, 0xc3, 0x30, 0x68, 0x9a, 0x67, 0x21, 0x7c, 0x01, 0x4c, 0x49, 0x6d,
+		0x4f, 0x90, 0xd1, 0xc5, 0xe2, 0xf2, 0xfc, 0xfa, 0xe5, 0xd2, 0xaa, 0x5e, 0xa4, 0xfb, 0x3e, 0xd9,
+		0x2f, 0x0e, 0xe8, 0xa3, 0x3b, 0x61, 0x14, 0xf2, 0x6d, 0x30, 0xc8, 0x9c, 0x11, 0x96, 0xad, 0x60,
+		0xe1, 0xe6, 0xc9, 0xbd, 0x1a, 0xa5, 0xbe, 0x08, 0x85, 0xce, 0x3f, 0x46, 0xdb, 0x4b, 0x8a, 0x71,
+		0x3f, 0x48, 0xc8, 0x62, 0x71, 0x7e, 0x76, 0xee, 0xf9, 0x52, 0xbe, 0xb8, 0xb6, 0x8e, 0xa6, 0x6a,
+		0x45, 0x67, 0x4a, 0x08, 0xa4, 0x6f, 0x76, 0x71, 0x71, 0xe5, 0x59, 0xec, 0x3b, 0xbc, 0xe8, 0x13,
+		0xd0, 0xbe, 0x15, 0x86, 0x43, 0x9a, 0x8c, 0x8e, 0x29, 0x75, 0x68, 0x91, 0xe9, 0xb5, 0xe2, 0xf2,
+		0x9c, 0xec, 0x48, 0x0f, 0x81, 0xaf, 0xb9, 0xaa, 0x82, 0x5f, 0x42, 0xaf, 0xd5, 0x04, 0x5a, 0x48,
+		0xde, 0xb4, 0xbf, 0xf9, 0x94, 0xd4, 0x2e, 0x40, 0x5a, 0xe8, 0x27, 0xba, 0xc7, 0xd4, 0xcb, 0x8d,
+		0x38, 0xe7, 0x69, 0xa0, 0xca, 0xa9, 0x2a, 0x18, 0x8a, 0x30, 0xa5, 0x55, 0x13, 0xda, 0x55, 0xd8,
+		0x1f, 0xab, 0x61, 0xe4, 0x04, 0x4c, 0x8a, 0x0d, 0x2f, 0xe6, 0x78, 0x97, 0x8a, 0xcb, 0x73, 0x2b,
+		0x05, 0x0c, 0x55, 0x02, 0x9a, 0x00, 0x5c, 0xd5, 0x18, 0x97, 0x42, 0x0d, 0xd5, 0x84, 0x36, 0x07,
+		0x23, 0x61, 0x6d, 0x21, 0x87, 0xe1, 0xe0, 0xc6, 0xfa, 0xa5, 0x27, 0x4b, 0x57, 0x67, 0x17, 0x17,
+		0x0a, 0xb3, 0x91, 0xa0, 0x24, 0x0d, 0x54, 0x7d, 0x18, 0x73, 0x4c, 0x79, 0xd4, 0x84, 0xb6, 0x06,
+		0xa3, 0x91, 0x71, 0x27, 0x47, 0x20, 0xcb, 0xe3, 0x83, 0x38, 0x7e, 0xc6, 0x21, 0xaa, 0x09, 0x2c,
+		0x52, 0x2a, 0x14, 0x17, 0x17, 0x96, 0x16, 0xd6, 0x29, 0x67, 0x97, 0x01, 0x82, 0x71, 0xc5, 0x85,
+		0xe8, 0xca, 0xda, 0xca, 0x72, 0xe9, 0x12, 0x86, 0x59, 0xeb, 0x12, 0xa9, 0x0c, 0xb0, 0x71, 0x54,
+		0x15, 0x8c, 0x06, 0x5a, 0x07, 0x5b, 0x4d, 0x9c, 0xe9, 0xc7, 0x65, 0xe8, 0xcb, 0x03, 0x67, 0xfa,
+		0xd3, 0x5f, 0x1e, 0x50, 0xbf, 0x82, 0xff, 0xbf, 0x77, 0x59, 0x7d, 0xff, 0xf2, 0x95, 0xfe, 0xf4,
+		0x97, 0x06, 0xd4, 0x57, 0x06, 0xb4, 0xff, 0x95, 0x00, 0x12, 0x68, 0x93, 0x1f, 0x01, 0x3f, 0x07,
+		0x69, 0x3f, 0xa4, 0x66, 0x67, 0xf9, 0xde, 0xb0, 0x87, 0x12, 0x0a, 0x34, 0xa9, 0x28, 0x12, 0x62,
+		0xfb, 0xd4, 0xc8, 0x2c, 0x8c, 0xee, 0x58, 0xb6, 0xb5, 0xd3, 0xdc, 0x29, 0x89, 0xf0, 0x35, 0xd5,
+		0x21, 0x7c, 0x1d, 0xe1, 0x08, 0xfc, 0x9b, 0x92, 0x30, 0x6e, 0x86, 0x48, 0xf4, 0x75, 0x24, 0xc1,
+		0x10, 0xf8, 0x77, 0xee, 0xdd, 0x0a, 0x64, 0xdb, 0x31, 0x7b, 0x47, 0x91, 0xf5, 0x9d, 0xfa, 0xc7,
+		0xda, 0x27, 0x12, 0x30, 0x12, 0x3e, 0xcb, 0x46, 0x0a, 0x90, 0xae, 0x39, 0xfc, 0x9c, 0x08, 0x13,
+		0xfe, 0xe9, 0x0e, 0xc7, 0xdf, 0xa6, 0x17, 0x39, 0xbc, 0xee, 0x63, 0xe6, 0xfe, 0xa3, 0x02, 0x69,
+		0x51, 0x4c, 0x0e, 0x40, 0xaa, 0x6e, 0x78, 0xdb, 0x94, 0x5c, 0x5f, 0x3e, 0xa1, 0x2a, 0x3a, 0xfd,
+		0xc6, 0x72, 0xb7, 0x6e, 0xb0, 0x33, 0x32, 0xbc, 0x1c, 0xbf, 0xd1, 0xaf, 0xa8, 0x99, 0x46, 0x85,
+		0xee, 0x9d, 0x38, 0x3b, 0x3b, 0xa6, 0xed, 0xb9, 0xc2, 0xaf, 0xe0, 0xe5, 0x73, 0xbc, 0x18, 0x8f,
+		0x54, 0x7a, 0x0d, 0xc3, 0xaa, 0x85, 0x60, 0x53, 0x14, 0x56, 0x15, 0x15, 0x3e, 0xf0, 0x0c, 0x1c,
+		0x12, 0x74, 0x2b, 0xa6, 0x67, 0x94, 0xb7, 0xcd, 0x4a, 0x80, 0xd4, 0x4f, 0xf7, 0x48, 0x0f, 0x72,
+		0x80, 0x02, 0xaf, 0x17, 0xb8, 0xda, 0x67, 0x13, 0x30, 0x26, 0x76, 0x7b, 0x2a, 0xbe, 0xb0, 0x96,
+		0x00, 0x0c, 0xdb, 0x76, 0x3c, 0x59, 0x5c, 0xad, 0xae, 0x54, 0x0b, 0xde, 0xf4, 0xac, 0x8f, 0xa4,
+		0x4b, 0x04, 0x72, 0x7f, 0xa6, 0x00, 0x04, 0x55, 0x6d, 0xe5, 0x36, 0x09, 0x83, 0xfc, 0xa4, 0x22,
+		0x3d, 0xee, 0xca, 0xd2, 0x29, 0xc0, 0x8a, 0x70, 0x5f, 0x08, 0x33, 0x2d, 0x9b, 0x66, 0xd5, 0xb2,
+		0xf9, 0xf9, 0x13, 0xf6, 0x21, 0xb6, 0x71, 0x53, 0xc1, 0xd1, 0x2c, 0x1d, 0xd2, 0xae, 0xb9, 0x63,
+		0xd8, 0x9e, 0x55, 0xe6, 0x4a, 0xfc, 0x44, 0x4f, 0xcc, 0x4f, 0xaf, 0x71, 0x6c, 0xdd, 0xa7, 0xa3,
+		0x9d, 0x86, 0xb4, 0x28, 0xf5, 0x8d, 0xd6, 0x3e, 0x32, 0x00, 0xc9, 0xb5, 0x22, 0x9a, 0x6a, 0x6a,
+		0x35, 0x16, 0x66, 0xd7, 0xd4, 0xc4, 0x99, 0xaf, 0x28, 0x30, 0x20, 0x66, 0xd5, 0x38, 0x8c, 0x16,
+		0x0b, 0x0b, 0x11, 0x9b, 0x37, 0x0e, 0x23, 0xa2, 0x70, 0x55, 0x5f, 0x59, 0x5f, 0x39, 0xa7, 0x7e,
+		0x71, 0xa0, 0xa5, 0xf0, 0x31, 0xf5, 0x4b, 0x03, 0x64, 0x0c, 0x86, 0x44, 0xe1, 0xb9, 0x47, 0xce,
+		0x3d, 0xa6, 0xbe, 0x42, 0x53, 0x17, 0xa2, 0xe8, 0xd1, 0xd2, 0x3a, 0x9a, 0xa5, 0x95, 0xe5, 0xc5,
+		0xe7, 0x55, 0x45, 0xae, 0x38, 0x27, 0x55, 0x24, 0xc8, 0x51, 0x38, 0x28, 0x2a, 0x2e, 0x5e, 0xbc,
+		0x78, 0xf1, 0x82, 0x54, 0x79, 0xeb, 0x7d, 0xfd, 0xd1, 0xea, 0x27, 0xa5, 0xea, 0x8f, 0xb6, 0x56,
+		0x5f, 0x94, 0xaa, 0x7f, 0xf4, 0x7d, 0xfd, 0xf9, 0x7f, 0x00, 0xe3, 0x65, 0x67, 0x27, 0x2a, 0xdd,
+		0xbc, 0x1a, 0xd9, 0x0f, 0x76, 0x2f, 0x2b, 0x2f, 0x3c, 0xcc, 0x81, 0xaa, 0x4e, 0xcd, 0xb0, 0xab,
+		0xd3, 0x4e, 0xa3, 0x1a, 0x9c, 0x8c, 0x46, 0x5f, 0xcc, 0x95, 0xce, 0x47, 0xd7, 0x37, 0xff, 0x56,
+		0x51, 0x7e, 0x2c, 0x91, 0x9c, 0x5f, 0xcd, 0x7f, 0x32, 0x91, 0x9b, 0x67, 0x88, 0xab, 0x62, 0xec,
+		0x74, 0x73, 0xab, 0x66, 0x96, 0x51, 0xc0, 0xf0, 0x95, 0x07, 0x61, 0xa2, 0xea, 0x54, 0x1d, 0x4a,
+		0xe9, 0x2c, 0xfe, 0xc5, 0x98, 0x20, 0x19, 0xbf, 0x34, 0xd7, 0xf1, 0x1c, 0xf6, 0xcc, 0x32, 0x8c,
+		0x73, 0xe0, 0x12, 0x75, 0x0d, 0xd9, 0xce, 0x13, 0xd9, 0xf3, 0xd8, 0x43, 0xf6, 0xe7, 0xbf, 0x40,
+		0xc3, 0x6e, 0x7d, 0x8c, 0xa3, 0x62, 0x1d, 0xdb, 0x9c, 0x9a, 0xd1, 0x61, 0x7f, 0x88, 0x1e, 0x73,
+		0xc8, 0xcd, 0x46, 0x07, 0x8a, 0x9f, 0xe6, 0x14, 0xc7, 0x25, 0x8a, 0x6b, 0x1c, 0x75, 0x66, 0x0e,
+		0x86, 0x7b, 0xa1, 0xf5, 0xef, 0x39, 0xad, 0x21, 0x53, 0x26, 0x32, 0x0f, 0xa3, 0x94, 0x48, 0xb9,
+		0xe9, 0x7a, 0xce, 0x0e, 0x8d, 0x76, 0xf6, 0x26, 0xf3, 0x9b, 0x5f, 0x60, 0x16, 0x6a, 0x04, 0xd1,
+		0xe6, 0x7c, 0xac, 0x99, 0x19, 0xa0, 0x8e, 0x2e, 0x1e, 0xe3, 0xeb, 0x40, 0xe1, 0x33, 0x9c, 0x11,
+		0x1f, 0x7e, 0xe6, 0x2a, 0x4c, 0xe0, 0xdf, 0x34, 0x18, 0x91, 0x39, 0xe9, 0x7c, 0x46, 0x22, 0xfb,
+		0xbb, 0xef, 0x62, 0x46, 0x70, 0xdc, 0x27, 0x20, 0xf1, 0x24, 0x8d, 0x62, 0xd5, 0xf4, 0x3c, 0xb3,
+		0xe1, 0x96, 0x8c, 0x5a, 0x1c, 0x7b, 0xd2, 0x26, 0x73, 0xf6, 0x43, 0x5f, 0x0d, 0x8f, 0xe2, 0x3c,
+		0xc3, 0x9c, 0xad, 0xd5, 0x66, 0x36, 0xe0, 0x60, 0x8c, 0x56, 0x74, 0x41, 0xf3, 0xc3, 0x9c, 0xe6,
+		0x44, 0x8b, 0x66, 0x20, 0xd9, 0x55, 0x10, 0xe5, 0xfe, 0x58, 0x76, 0x41, 0xf3, 0x23, 0x9c, 0x26,
+		0xe1, 0xb8, 0x62, 0x48, 0x91, 0xe2, 0x15, 0x18, 0xbb, 0x6e, 0x36, 0x36, 0x1d, 0x97, 0x6f, 0xec,
+		0x77, 0x41, 0xee, 0x47, 0x38, 0xb9, 0x51, 0x8e, 0x48, 0x77, 0xfa, 0x91, 0xd6, 0x45, 0x48, 0x6f,
+		0x19, 0x65, 0xb3, 0x0b, 0x12, 0xb7, 0x38, 0x89, 0x01, 0x84, 0x47, 0xd4, 0x59, 0x18, 0xaa, 0x3a,
+		0x3c, 0x1e, 0xed, 0x8c, 0xfe, 0x51, 0x8e, 0x3e, 0x28, 0x70, 0x38, 0x89, 0xba, 0x53, 0x6f, 0xd6,
+		0x30, 0x58, 0xed, 0x4c, 0xe2, 0x47, 0x05, 0x09, 0x81, 0xc3, 0x49, 0xf4, 0x20, 0xd6, 0x8f, 0x09,
+		0x12, 0xae, 0x24, 0xcf, 0xa7, 0xf1, 0xbc, 0x5f, 0x6d, 0xd7, 0xb1, 0xbb, 0x61, 0xe2, 0xe3, 0x9c,
+		0x02, 0x70, 0x14, 0x24, 0xf0, 0x14, 0x64, 0xba, 0x1d, 0x88, 0x7f, 0xf2, 0x55, 0x31, 0x3d, 0xc4,
+		0x08, 0xcc, 0xc3, 0xa8, 0x30, 0x50, 0xb8, 0x0b, 0xd3, 0x99, 0xc4, 0x3f, 0xe5, 0x24, 0x46, 0x24,
+		0x34, 0xde, 0x0d, 0xcf, 0x74, 0xbd, 0xaa, 0xd9, 0x0d, 0x91, 0x4f, 0x88, 0x6e, 0x70, 0x14, 0x2e,
+		0xca, 0x4d, 0xd3, 0x2e, 0x6f, 0x77, 0x47, 0xe1, 0x27, 0x85, 0x28, 0x05, 0x0e, 0x92, 0x98, 0x83,
+		0xe1, 0x1d, 0xa3, 0xe1, 0x6e, 0x1b, 0xb5, 0xae, 0x86, 0xe3, 0x9f, 0x71, 0x1a, 0x43, 0x3e, 0x12,
+		0x97, 0x48, 0xd3, 0xee, 0x85, 0xcc, 0x27, 0x85, 0x44, 0x9a, 0x76, 0x88, 0xd0, 0x2a, 0x4c, 0xb8,
+		0x1e, 0x3d, 0x05, 0xd1, 0x0b, 0xb5, 0x9f, 0x12, 0x53, 0x8f, 0xe1, 0x2e, 0xc9, 0x14, 0x9f, 0x82,
+		0x8c, 0x6b, 0xbd, 0xd4, 0x15, 0x99, 0x9f, 0x16, 0x23, 0x4d, 0x11, 0x10, 0xf9, 0x79, 0x38, 0x14,
+		0xbb, 0x4c, 0x74, 0x41, 0xec, 0x67, 0x38, 0xb1, 0x03, 0x31, 0x4b, 0x05, 0x37, 0x09, 0xbd, 0x92,
+		0xfc, 0xe7, 0xc2, 0x24, 0x98, 0x11, 0x5a, 0xab, 0x98, 0x21, 0x74, 0x8d, 0xad, 0xde, 0xa4, 0xf6,
+		0xb3, 0x42, 0x6a, 0x0c, 0x37, 0x24, 0xb5, 0x75, 0x38, 0xc0, 0x29, 0xf6, 0x36, 0xae, 0x3f, 0x27,
+		0x0c, 0x2b, 0xc3, 0xde, 0x08, 0x8f, 0xee, 0xb7, 0x40, 0xce, 0x17, 0xa7, 0x48, 0x45, 0xb9, 0x25,
+		0x3c, 0x01, 0xd0, 0x99, 0xf2, 0xcf, 0x73, 0xca, 0xc2, 0xe2, 0xfb, 0xb9, 0x2c, 0x77, 0xc9, 0xa8,
+		0x23, 0xf1, 0xe7, 0x20, 0x2b, 0x88, 0x37, 0xed, 0x86, 0x59, 0x76, 0xaa, 0xb6, 0xf5, 0x92, 0x59,
+		0xe9, 0x82, 0xf4, 0x2f, 0x44, 0x86, 0x6a, 0x43, 0x42, 0x47, 0xca, 0x0b, 0xa0, 0xfa, 0xbe, 0x4a,
+		0xc9, 0xda, 0xa9, 0x3b, 0x0d, 0xaf, 0x03, 0xc5, 0x5f, 0x14, 0x23, 0xe5, 0xe3, 0x2d, 0x50, 0xb4,
+		0x99, 0x22, 0xb0, 0xa3, 0xc1, 0xdd, 0xaa, 0xe4, 0xa7, 0x38, 0xa1, 0xe1, 0x00, 0x8b, 0x1b, 0x8e,
+		0xb2, 0xb3, 0x53, 0x37, 0x1a, 0xdd, 0xd8, 0xbf, 0x7f, 0x21, 0x0c, 0x07, 0x47, 0xe1, 0x86, 0x03,
+		0x3d, 0x3a, 0x5c, 0xed, 0xbb, 0xa0, 0xf0, 0x4b, 0xc2, 0x70, 0x08, 0x1c, 0x4e, 0x42, 0x38, 0x0c,
+		0x5d, 0x90, 0xf8, 0x65, 0x41, 0x42, 0xe0, 0x20, 0x89, 0xb7, 0x04, 0x0b, 0x6d, 0xc3, 0xac, 0x5a,
+		0xae, 0xc7, 0x0f, 0xef, 0xef, 0x4d, 0xea, 0x57, 0xbe, 0x1a, 0x76, 0xc2, 0x74, 0x09, 0x15, 0x2d,
+		0x11, 0xcf, 0x2e, 0xd1, 0xfc, 0x68, 0x67, 0xc6, 0x7e, 0x55, 0x58, 0x22, 0x09, 0x0d, 0x79, 0x93,
+		0x3c, 0x44, 0x14, 0x7b, 0x19, 0xa3, 0xb2, 0x2e, 0xc8, 0xfd, 0xcb, 0x08, 0x73, 0x6b, 0x02, 0x17,
+		0x69, 0x4a, 0xfe, 0x4f, 0xd3, 0xbe, 0x66, 0xee, 0x76, 0xa5, 0x9d, 0xff, 0x2a, 0xe2, 0xff, 0x6c,
+		0x30, 0x4c, 0x66, 0x43, 0x46, 0x23, 0xfe, 0x14, 0xe9, 0x74, 0xa9, 0x27, 0xfb, 0xed, 0x5f, 0xe7,
+		0xfd, 0x0d, 0xbb, 0x53, 0x33, 0x8b, 0xa0, 0xf2, 0x92, 0xc0, 0x81, 0xed, 0x48, 0xec, 0x5d, 0x5f,
+		0xf7, 0xf5, 0x3c, 0xe4, 0xf3, 0xcc, 0x5c, 0x82, 0xe1, 0x90, 0xc3, 0xd3, 0x99, 0xd4, 0x77, 0x72,
+		0x52, 0x43, 0xb2, 0xbf, 0x33, 0x73, 0x1e, 0x52, 0xe8, 0xbc, 0x74, 0x46, 0xff, 0x47, 0x1c, 0x9d,
+		0x82, 0xcf, 0xbc, 0x11, 0xd2, 0xc2, 0x69, 0xe9, 0x8c, 0xfa, 0x5d, 0x1c, 0xd5, 0x47, 0x41, 0x74,
+		0xe1, 0xb0, 0x74, 0x46, 0x7f, 0xb7, 0x40, 0x17, 0x28, 0x88, 0xde, 0xbd, 0x08, 0x7f, 0xfd, 0xbd,
+		0x29, 0x86, 0x2e, 0x50, 0x66, 0xf0, 0x68, 0x32, 0xf3, 0x54, 0x3a, 0x63, 0x7f, 0x37, 0x6f, 0x5c,
+		0x60, 0xcc, 0x5c, 0x80, 0xbe, 0x2e, 0x05, 0xfe, 0x3e, 0x8e, 0xca, 0xe0, 0x67, 0xe6, 0x60, 0x50,
+		0xf2, 0x4e, 0x3a, 0xa3, 0x7f, 0x2f, 0x47, 0x97, 0xb1, 0x90, 0x75, 0xee, 0x9d, 0x74, 0x26, 0xf0,
+		0x7d, 0x82, 0x75, 0x8e, 0x81, 0x62, 0x13, 0x8e, 0x49, 0x67, 0xec, 0xf7, 0x0b, 0xa9, 0x0b, 0x94,
+		0x99, 0xa7, 0x21, 0xe3, 0x2f, 0x36, 0x9d, 0xf1, 0xbf, 0x9f, 0xe3, 0x07, 0x38, 0x28, 0x81, 0xa6,
+		0xdd, 0x03, 0x89, 0x1f, 0x10, 0x12, 0x90, 0xb0, 0x70, 0x1a, 0x45, 0x1d, 0x98, 0xce, 0x94, 0x7e,
+		0x50, 0x4c, 0xa3, 0x88, 0xff, 0x82, 0xa3, 0x49, 0x6d, 0x7e, 0x67, 0x12, 0x1f, 0x10, 0xa3, 0x49,
+		0xe1, 0x91, 0x8d, 0xa8, 0x47, 0xd0, 0x99, 0xc6, 0x0f, 0x0b, 0x36, 0x22, 0x0e, 0xc1, 0xcc, 0x2a,
+		0x90, 0x56, 0x6f, 0xa0, 0x33, 0xbd, 0x0f, 0x72, 0x7a, 0x63, 0x2d, 0xce, 0xc0, 0xcc, 0xb3, 0x70,
+		0x20, 0xde, 0x13, 0xe8, 0x4c, 0xf5, 0x43, 0x5f, 0x8f, 0xc4, 0x6e, 0xb2, 0x23, 0x30, 0xb3, 0x0e,
+		0x13, 0x71, 0x5e, 0x40, 0x67, 0xb2, 0x1f, 0xfe, 0x7a, 0xd8, 0x70, 0xcb, 0x4e, 0xc0, 0xcc, 0x2c,
+		0x40, 0xb0, 0x00, 0x77, 0xa6, 0xf5, 0x23, 0x9c, 0x96, 0x84, 0x84, 0x53, 0x83, 0xaf, 0xbf, 0x9d,
+		0xf1, 0x6f, 0x89, 0xa9, 0xc1, 0x31, 0x70, 0x6a, 0x88, 0xa5, 0xb7, 0x33, 0xf6, 0x47, 0xc5, 0xd4,
+		0x10, 0x28, 0xa8, 0xd9, 0xd2, 0xea, 0xd6, 0x99, 0xc2, 0xc7, 0x85, 0x66, 0x4b, 0x58, 0x33, 0xcb,
+		0x30, 0xd6, 0xb2, 0x20, 0x76, 0x26, 0xf5, 0x63, 0x9c, 0x94, 0x1a, 0x5d, 0x0f, 0xe5, 0xc5, 0x8b,
+		0x2f, 0x86, 0x9d, 0xa9, 0xfd, 0x78, 0x64, 0xf1, 0xe2, 0x6b, 0xe1, 0xcc, 0x53, 0x90, 0xb6, 0x9b,
+		0xb5, 0x1a, 0x4e, 0x1e, 0xb2, 0xf7, 0xe5, 0xad, 0xec, 0x97, 0xbf, 0xc1, 0xa5, 0x23, 0x10, 0x66,
+		0xce, 0x43, 0x9f, 0xb9, 0xb3, 0x69, 0x56, 0x3a, 0x61, 0x7e, 0xe5, 0x1b, 0xc2, 0x60, 0x22, 0xf4,
+		0xcc, 0xd3, 0x00, 0x2c, 0x35, 0x42, 0xcf, 0x38, 0x76, 0xc0, 0xfd, 0xb3, 0x6f, 0xf0, 0xdb, 0x12,
+		0x01, 0x4a, 0x40, 0x80, 0xdd, 0xbd, 0xd8, 0x9b, 0xc0, 0x57, 0xc3, 0x04, 0xe8, 0x88, 0x5c, 0x84,
+		0x01, 0xdc, 0xc6, 0xf2, 0x8c, 0x6a, 0x27, 0xec, 0x3f, 0xe7, 0xd8, 0x02, 0x1e, 0x05, 0xb6, 0xe3,
+		0x34, 0x4c, 0xcf, 0xa8, 0xba, 0x9d, 0x70, 0xff, 0x82, 0xe3, 0xfa, 0x08, 0x88, 0x5c, 0x36, 0x5c,
+		0xaf, 0x9b, 0x7e, 0xff, 0xa5, 0x40, 0x16, 0x08, 0xc8, 0x34, 0xfe, 0x7d, 0xcd, 0xdc, 0xed, 0x84,
+		0xfb, 0x35, 0xc1, 0x34, 0x87, 0x9f, 0x79, 0x23, 0x64, 0xf0, 0x4f, 0x76, 0x05, 0xaa, 0x03, 0xf2,
+		0x5f, 0x71, 0xe4, 0x00, 0x03, 0x5b, 0x76, 0xbd, 0x8a, 0x67, 0x75, 0x16, 0xf6, 0xab, 0x7c, 0xa4,
+		0x05, 0xfc, 0xcc, 0x2c, 0x0c, 0xba, 0x5e, 0xa5, 0xd2, 0xe4, 0xfe, 0x69, 0x07, 0xf4, 0xbf, 0xfe,
+		0x86, 0x9f, 0xb2, 0xf0, 0x71, 0x70, 0xb4, 0x6f, 0x5c, 0xf3, 0xea, 0x0e, 0x3d, 0xdc, 0xd0, 0x89,
+		0xc2, 0xd7, 0x39, 0x05, 0x09, 0x65, 0x66, 0x0e, 0x86, 0xb0, 0x2f, 0x62, 0xd7, 0xb8, 0x13, 0x89,
+		0xbf, 0xe1, 0x02, 0x08, 0x21, 0xe5, 0xdf, 0xfa, 0x99, 0xcf, 0x1d, 0x53, 0x3e, 0xfb, 0xb9, 0x63,
+		0xca, 0x7f, 0xfd, 0xdc, 0x31, 0xe5, 0xfd, 0x9f, 0x3f, 0xb6, 0xef, 0xb3, 0x9f, 0x3f, 0xb6, 0xef,
+		0x0f, 0x3f, 0x7f, 0x6c, 0x5f, 0x7c, 0x96, 0x18, 0xe6, 0x9d, 0x79, 0x87, 0xe5, 0x87, 0x5f, 0xd0,
+		0xaa, 0x96, 0xb7, 0xdd, 0xdc, 0x9c, 0x2e, 0x3b, 0x3b, 0x34, 0x8d, 0x1b, 0x64, 0x6b, 0xfd, 0x20,
+		0x07, 0xfe, 0x46, 0x81, 0x43, 0x8c, 0x46, 0x50, 0x6b, 0xd8, 0xbb, 0x6d, 0x1e, 0xd3, 0xc8, 0xc5,
+		0x26, 0x86, 0xb5, 0x37, 0x40, 0x72, 0xd6, 0xde, 0x25, 0x87, 0x98, 0xcd, 0x2b, 0x35, 0x1b, 0x35,
+		0x7e, 0x35, 0x67, 0x00, 0xbf, 0x37, 0x1a, 0xb5, 0xf0, 0x81, 0xce, 0x21, 0x7e, 0xa0, 0x73, 0x26,
+		0xf5, 0xb5, 0x8f, 0x4f, 0xee, 0xcb, 0x5f, 0x8b, 0xf6, 0xf0, 0xd7, 0x3b, 0xf6, 0x32, 0x3d, 0x6b,
+		0xef, 0xd2, 0x4e, 0xae, 0x2a, 0x2f, 0xf4, 0x61, 0x1b, 0xae, 0x48, 0x6c, 0x1f, 0x8b, 0x26, 0xb6,
+		0x9f, 0x35, 0x6b, 0xb5, 0x67, 0x6c, 0xe7, 0x86, 0x8d, 0xfb, 0xb9, 0xee, 0x66, 0x3f, 0xbb, 0xe7,
+		0x09, 0x3f, 0x98, 0x80, 0x63, 0xd1, 0x7e, 0x8b, 0x91, 0x6f, 0xd3, 0x79, 0x6d, 0x06, 0xd2, 0x05,
+		0xa1, 0x50, 0x59, 0x7c, 0xc2, 0xa2, 0xec, 0xd8, 0x15, 0x76, 0x28, 0x31, 0xa9, 0x8b, 0x4f, 0xec,
+		0xaa, 0x6d, 0xd8, 0x8e, 0xcb, 0xaf, 0xaf, 0xb1, 0x8f, 0xfc, 0x47, 0x94, 0xde, 0xc6, 0x71, 0x58,
+		0xb4, 0x24, 0xba, 0xf9, 0x68, 0xc7, 0x54, 0xff, 0x35, 0xec, 0xa5, 0xdf, 0x89, 0x50, 0xba, 0xbf,
+		0x5b, 0xa9, 0xfc, 0x70, 0x02, 0x26, 0xa3, 0x52, 0xc1, 0xe9, 0xe4, 0x7a, 0xc6, 0x4e, 0xbd, 0x9d,
+		0x58, 0x9e, 0x82, 0xcc, 0xba, 0x80, 0xe9, 0x59, 0x2e, 0xb7, 0x7a, 0x94, 0xcb, 0x88, 0xdf, 0x94,
+		0x10, 0xcc, 0xb9, 0x2e, 0x05, 0xe3, 0xf7, 0xe3, 0x8e, 0x24, 0xf3, 0x1d, 0x49, 0x38, 0x54, 0x76,
+		0xdc, 0x1d, 0xc7, 0x2d, 0x31, 0xf5, 0x67, 0x1f, 0x5c, 0x26, 0x43, 0x72, 0x55, 0x17, 0x9b, 0x23,
+		0x97, 0x61, 0x84, 0x9a, 0x08, 0x9a, 0x16, 0xa6, 0x56, 0xb9, 0xe3, 0x42, 0xfa, 0x5b, 0xff, 0xa9,
+		0x8f, 0x4e, 0xa9, 0x61, 0x1f, 0x91, 0x1e, 0xde, 0x5f, 0x87, 0x09, 0x6b, 0xa7, 0x5e, 0x33, 0xe9,
+		0xd6, 0x63, 0xc9, 0xaf, 0xeb, 0x4c, 0xef, 0xb7, 0x39, 0xbd, 0xf1, 0x00, 0x7d, 0x41, 0x60, 0xcf,
+		0x2c, 0xc2, 0x18, 0xde, 0x21, 0xa9, 0x87, 0x48, 0x76, 0x30, 0x5f, 0x82, 0x41, 0x95, 0x63, 0xfa,
+		0xd4, 0xf2, 0x4f, 0xb7, 0x1b, 0xe2, 0x17, 0xee, 0x97, 0x2c, 0x54, 0xc3, 0xac, 0x9a, 0xf6, 0xc3,
+		0xb6, 0xe9, 0xdd, 0x70, 0x1a, 0xd7, 0xb8, 0x78, 0x1f, 0x66, 0x4d, 0x89, 0x41, 0xf8, 0xce, 0x24,
+		0x1c, 0x63, 0x15, 0x67, 0x37, 0x0d, 0xd7, 0x3c, 0x7b, 0xfd, 0xd1, 0x4d, 0xd3, 0x33, 0x1e, 0x3d,
+		0x5b, 0x76, 0x2c, 0x31, 0x69, 0xc7, 0xf9, 0xb8, 0x60, 0xfd, 0x34, 0xaf, 0x6f, 0x63, 0xb5, 0xe6,
+		0x21, 0x35, 0xe7, 0x58, 0xf4, 0xb0, 0x79, 0xc5, 0xb4, 0x9d, 0x1d, 0x6e, 0xb3, 0xd8, 0x07, 0x39,
+		0x01, 0xfd, 0xc6, 0x8e, 0xd3, 0xb4, 0x3d, 0xb6, 0x69, 0x9a, 0x1f, 0xfc, 0xcc, 0xed, 0xc9, 0x7d,
+		0x7f, 0x74, 0x7b, 0x32, 0xb9, 0x60, 0x7b, 0x3a, 0xaf, 0x9a, 0x49, 0xbd, 0xf2, 0xb1, 0x49, 0x45,
+		0xbb, 0x02, 0x03, 0x05, 0xb3, 0x7c, 0x27, 0xb4, 0x0a, 0x66, 0x39, 0x42, 0xeb, 0x01, 0x48, 0x2f,
+		0xd8, 0x1e, 0xbb, 0xf0, 0x79, 0x14, 0x92, 0x96, 0xcd, 0xae, 0x02, 0x45, 0xda, 0xc7, 0x72, 0x04,
+		0x2d, 0x98, 0x65, 0x1f, 0xb4, 0x62, 0x96, 0xb3, 0x4a, 0x2b, 0x79, 0x2c, 0xcf, 0x17, 0xfe, 0xf0,
+		0xbf, 0x1d, 0xdb, 0xf7, 0xce, 0xcf, 0x1d, 0xdb, 0xd7, 0x76, 0x24, 0xe4, 0xb5, 0x82, 0x8b, 0x98,
+		0x0f, 0x81, 0x5b, 0xb9, 0xc6, 0xe6, 0x91, 0x3f, 0x0c, 0x9f, 0x4c, 0xc1, 0x51, 0x7a, 0xd7, 0xbf,
+		0xb1, 0x63, 0xd9, 0xde, 0xd9, 0x72, 0x63, 0xb7, 0xee, 0xd1, 0xc5, 0xc5, 0xd9, 0xe2, 0xa3, 0x30,
+		0x16, 0x54, 0x4f, 0xb3, 0xea, 0x36, 0x63, 0xb0, 0x05, 0x7d, 0xab, 0x88, 0x87, 0x82, 0xf3, 0x1c,
+		0xcf, 0xa8, 0x71, 0xab, 0xc1, 0x3e, 0xb0, 0x94, 0xbd, 0x0f, 0x90, 0x60, 0xa5, 0x96, 0x78, 0x1a,
+		0xa0, 0x66, 0x1a, 0x5b, 0xec, 0x9a, 0x65, 0x92, 0x2e, 0x28, 0x69, 0x2c, 0xa0, 0x37, 0x2a, 0x27,
+		0xa0, 0xcf, 0x68, 0xb2, 0xad, 0xfd, 0x24, 0xae, 0x34, 0xf4, 0x43, 0x7b, 0x06, 0x06, 0xf8, 0xa6,
+		0x17, 0xee, 0x6d, 0x5f, 0x33, 0x77, 0x69, 0x3b, 0x43, 0x3a, 0xfe, 0x49, 0xa6, 0xa1, 0x8f, 0x32,
+		0xcf, 0x0f, 0x44, 0x64, 0xa7, 0x5b, 0xb8, 0x9f, 0xa6, 0x4c, 0xea, 0x0c, 0x4c, 0xbb, 0x02, 0xe9,
+		0x82, 0xb3, 0x63, 0xd9, 0x4e, 0x98, 0x5a, 0x86, 0x51, 0xa3, 0x3c, 0xd7, 0x9b, 0x9e, 0xb8, 0xbb,
+		0x40, 0x3f, 0xf0, 0xca, 0x0e, 0xbb, 0x76, 0xcb, 0x8f, 0x27, 0xf0, 0x2f, 0x6d, 0x0e, 0x06, 0x28,
+		0xed, 0x95, 0xba, 0xff, 0x96, 0x85, 0x22, 0xbd, 0x65, 0xc1, 0xc9, 0x27, 0x02, 0x66, 0x09, 0xa4,
+		0x2a, 0x86, 0x67, 0xf0, 0x7e, 0xd3, 0xbf, 0xb5, 0x37, 0x41, 0x9a, 0x13, 0x71, 0xc9, 0x39, 0x48,
+		0x3a, 0x75, 0x71, 0x18, 0x26, 0xd7, 0xae, 0x2b, 0x2b, 0xf5, 0x7c, 0x0a, 0xb5, 0x44, 0x47, 0xe0,
+		0xbc, 0xde, 0x56, 0x2d, 0x9e, 0x94, 0xd4, 0x42, 0x1a, 0x72, 0xe9, 0x4f, 0x36, 0xa4, 0x2d, 0xea,
+		0xe0, 0x2b, 0xcb, 0xc7, 0x13, 0x70, 0x4c, 0xaa, 0xbd, 0x6e, 0x36, 0x30, 0xf2, 0x63, 0x1a, 0xc5,
+		0xb5, 0x85, 0x48, 0x4c, 0xf2, 0xfa, 0x36, 0xea, 0xf2, 0x46, 0x48, 0xce, 0xd6, 0xeb, 0xf8, 0xfa,
+		0x04, 0xfd, 0x2e, 0x3b, 0x4c, 0x5f, 0x52, 0xba, 0xff, 0x8d, 0x75, 0xae, 0xb3, 0xe5, 0xdd, 0x30,
+		0x1a, 0xfe, 0xcb, 0x14, 0xe2, 0x5b, 0xbb, 0x08, 0x99, 0x39, 0xc7, 0x76, 0x4d, 0xdb, 0x6d, 0xd2,
+		0xf5, 0x68, 0xb3, 0xe6, 0x94, 0xaf, 0x71, 0x0a, 0xec, 0x03, 0x05, 0x6e, 0xd4, 0xeb, 0x14, 0x33,
+		0xa5, 0xe3, 0x9f, 0x6c, 0x5e, 0xe6, 0xd7, 0xda, 0x8a, 0xe8, 0x62, 0xef, 0x22, 0xe2, 0x9d, 0xf4,
+		0x65, 0xf4, 0x3f, 0x15, 0x38, 0xd2, 0x3a, 0xa1, 0xae, 0x99, 0xbb, 0x6e, 0xaf, 0xf3, 0xe9, 0x39,
+		0xc8, 0xac, 0xd2, 0xe7, 0xc1, 0x9e, 0x31, 0x77, 0x49, 0x0e, 0xcf, 0x07, 0x9d, 0x3b, 0x7f, 0xfe,
+		0xd1, 0x8b, 0x4c, 0xdb, 0x2f, 0xef, 0xd3, 0x45, 0x01, 0x39, 0x06, 0x19, 0xd7, 0x2c, 0xd7, 0xcf,
+		0x9d, 0x7f, 0xe2, 0xda, 0xa3, 0x4c, 0xbd, 0x2e, 0xef, 0xd3, 0x83, 0xa2, 0x99, 0x34, 0xf6, 0xfa,
+		0x95, 0x8f, 0x4f, 0x2a, 0xf9, 0x3e, 0x48, 0xba, 0xcd, 0x9d, 0x7b, 0xaa, 0x23, 0x1f, 0xee, 0x83,
+		0x29, 0x19, 0x93, 0xae, 0xda, 0xfc, 0x38, 0xa5, 0xff, 0xb0, 0x9b, 0x2a, 0xc9, 0x80, 0x42, 0xc4,
+		0x8b, 0x20, 0xb7, 0xa7, 0x24, 0xb5, 0x5f, 0x50, 0x60, 0xe8, 0xaa, 0xa0, 0x8c, 0x07, 0x69, 0x9f,
+		0x02, 0xf0, 0x5b, 0x12, 0xd3, 0xe6, 0xf0, 0x74, 0xb4, 0xad, 0x69, 0x1f, 0x47, 0x97, 0xc0, 0xf1,
+		0x34, 0x55, 0xbd, 0xe1, 0xd4, 0x1d, 0x97, 0xbf, 0x56, 0xd0, 0x01, 0xd5, 0x07, 0xc6, 0x63, 0xcb,
+		0xd4, 0xc2, 0x95, 0xae, 0x3b, 0x1e, 0xee, 0xed, 0xd6, 0x9d, 0x1b, 0xfc, 0x0d, 0x98, 0xa4, 0xae,
+		0xd2, 0x9a, 0xab, 0xb4, 0x62, 0x15, 0xcb, 0x91, 0xe9, 0x8c, 0x4f, 0x05, 0x5d, 0x2c, 0xa3, 0x52,
+		0x69, 0x98, 0xae, 0xcb, 0x8d, 0x98, 0xf8, 0xc4, 0x27, 0x12, 0xea, 0xcd, 0xcd, 0x92, 0xb0, 0x18,
+		0xf8, 0xc8, 0x44, 0xcc, 0xfc, 0x17, 0xfa, 0xc1, 0x2d, 0x40, 0x7f, 0xbd, 0xb9, 0x89, 0xda, 0x72,
+		0x1c, 0x86, 0x62, 0x98, 0x19, 0xbc, 0x1e, 0xf0, 0x41, 0x5f, 0xa5, 0xe3, 0x3d, 0x28, 0xd5, 0x1b,
+		0x96, 0xd3, 0xb0, 0xbc, 0x5d, 0x7a, 0x48, 0x28, 0xa9, 0xab, 0xa2, 0x62, 0x95, 0x97, 0x6b, 0xd7,
+		0x60, 0x74, 0x8d, 0xfa, 0x16, 0x01, 0xe7, 0xe7, 0x03, 0xfe, 0x94, 0xce, 0xfc, 0xb5, 0xe5, 0x2c,
+		0xd1, 0xc2, 0x59, 0xfe, 0x2d, 0x6d, 0xb5, 0xf3, 0x42, 0xef, 0xda, 0x19, 0x5e, 0xed, 0xfe, 0xf2,
+		0x10, 0x1c, 0x89, 0x56, 0x86, 0xcc, 0x57, 0xb7, 0x8a, 0xd9, 0xc9, 0xb3, 0xce, 0xed, 0xbd, 0xa8,
+		0xe6, 0x3a, 0x98, 0xd1, 0x5c, 0xc7, 0x29, 0xa4, 0x5d, 0x84, 0x61, 0x3c, 0x6e, 0xbe, 0x66, 0x7a,
+		0x97, 0x4d, 0xa3, 0x62, 0x36, 0xc2, 0xab, 0xee, 0xb0, 0x58, 0x75, 0x09, 0xa4, 0xe8, 0xd2, 0xca,
+		0x56, 0x1d, 0xfa, 0xb7, 0xb6, 0x0d, 0x29, 0x44, 0x0d, 0x56, 0x64, 0x8e, 0x41, 0x3f, 0xb0, 0x74,
+		0x73, 0xd7, 0xe3, 0x47, 0x0a, 0x87, 0x74, 0xf6, 0x41, 0x1e, 0x17, 0xeb, 0x6a, 0x72, 0xef, 0x75,
+		0x95, 0x2b, 0x22, 0x5f, 0x5d, 0x6b, 0x30, 0x90, 0x47, 0x53, 0xbc, 0x50, 0xf0, 0x19, 0x51, 0x02,
+		0x46, 0xc8, 0x12, 0x8c, 0xd6, 0x8d, 0x86, 0x47, 0x2f, 0x4c, 0x6f, 0xd3, 0x5e, 0x70, 0x5d, 0x9f,
+		0x6c, 0x9d, 0x79, 0xa1, 0xce, 0xf2, 0x56, 0x86, 0xeb, 0x72, 0xa1, 0xf6, 0xc5, 0x14, 0xf4, 0x73,
+		0x61, 0xbc, 0x11, 0x06, 0xb8, 0x58, 0xb9, 0x76, 0x1e, 0x9d, 0x6e, 0x5d, 0x98, 0xa6, 0xfd, 0x05,
+		0x84, 0xd3, 0x13, 0x38, 0xe4, 0x24, 0xa4, 0xcb, 0xdb, 0x86, 0x65, 0x97, 0xac, 0x8a, 0x70, 0xf3,
+		0x3e, 0x77, 0x7b, 0x72, 0x60, 0x0e, 0xcb, 0x16, 0x0a, 0xfa, 0x00, 0xad, 0x5c, 0xa8, 0xa0, 0x27,
+		0xb0, 0x6d, 0x5a, 0xd5, 0x6d, 0x8f, 0xcf, 0x30, 0xfe, 0x85, 0x4f, 0x52, 0xa2, 0x42, 0xf0, 0x77,
+		0x38, 0x72, 0x2d, 0xce, 0xb6, 0x1f, 0xf8, 0xe4, 0xd3, 0xd8, 0xf0, 0xfb, 0xff, 0x74, 0x52, 0xd1,
+		0x29, 0x06, 0x99, 0x83, 0xe1, 0x9a, 0xe1, 0x7a, 0x25, 0xba, 0x82, 0x61, 0xf3, 0x7d, 0x94, 0xc4,
+		0xa1, 0x56, 0x81, 0x70, 0xc1, 0x72, 0xd6, 0x07, 0x11, 0x8b, 0x15, 0x55, 0xf0, 0x99, 0x00, 0x4a,
+		0x04, 0x4f, 0x39, 0x5a, 0x1e, 0xf3, 0xad, 0xfa, 0xa9, 0xdc, 0x47, 0xb0, 0x7c, 0x8e, 0x16, 0x53,
+		0x0f, 0xeb, 0x30, 0x64, 0xe8, 0xcd, 0x7f, 0x0a, 0xc2, 0xae, 0x47, 0xa4, 0xb1, 0x80, 0x56, 0x9e,
+		0x82, 0xd1, 0xc0, 0x3e, 0x32, 0x90, 0x34, 0xa3, 0x12, 0x14, 0x53, 0xc0, 0x47, 0x60, 0xc2, 0x36,
+		0x6f, 0x7a, 0xa5, 0x28, 0x74, 0x86, 0x42, 0x13, 0xac, 0xbb, 0x1a, 0xc6, 0xb8, 0x1f, 0x46, 0xca,
+		0x42, 0xf8, 0x0c, 0x16, 0x28, 0xec, 0xb0, 0x5f, 0x4a, 0xc1, 0x0e, 0x41, 0xda, 0xa8, 0xd7, 0x19,
+		0xc0, 0x20, 0xb7, 0x8f, 0xf5, 0x3a, 0xad, 0x3a, 0x03, 0x63, 0xb4, 0x8f, 0x0d, 0xd3, 0xc5, 0x33,
+		0xbe, 0x0c, 0x66, 0x88, 0xc2, 0x8c, 0x62, 0x85, 0xce, 0xca, 0x29, 0xec, 0x09, 0x18, 0x36, 0xaf,
+		0x5b, 0x15, 0xd3, 0x2e, 0x9b, 0x0c, 0x6e, 0x98, 0xc2, 0x0d, 0x89, 0x42, 0x0a, 0xf4, 0x00, 0xf8,
+		0x76, 0xaf, 0x24, 0x6c, 0xf2, 0x08, 0xa3, 0x27, 0xca, 0x67, 0x59, 0xb1, 0x96, 0x85, 0x54, 0xc1,
+		0xf0, 0x0c, 0x74, 0x30, 0xbc, 0x9b, 0x6c, 0xa1, 0x19, 0xd2, 0xf1, 0x4f, 0xed, 0x95, 0x04, 0xa4,
+		0xae, 0x3a, 0x9e, 0x49, 0x1e, 0x93, 0x1c, 0xc0, 0x91, 0x38, 0x7d, 0x5e, 0xb3, 0xaa, 0xb6, 0x59,
+		0x59, 0x72, 0xab, 0xd2, 0x33, 0x5d, 0x81, 0x3a, 0x25, 0x42, 0xea, 0x34, 0x01, 0x7d, 0x0d, 0xa7,
+		0x69, 0x57, 0xc4, 0xc1, 0x4e, 0xfa, 0x41, 0x8a, 0x90, 0xf6, 0xb5, 0x24, 0xd5, 0x49, 0x4b, 0x46,
+		0x51, 0x4b, 0x50, 0x87, 0x79, 0x81, 0x3e, 0xb0, 0xc9, 0x95, 0x25, 0x0f, 0x19, 0xdf, 0x78, 0x65,
+		0xfb, 0x7a, 0x50, 0xd8, 0x00, 0x0d, 0x17, 0x13, 0x7f, 0xec, 0x7d, 0xe1, 0x31, 0x8d, 0x53, 0xfd,
+		0x0a, 0x2e, 0xbd, 0x90, 0x5a, 0xf1, 0x27, 0xc3, 0x06, 0x68, 0xbf, 0x02, 0xb5, 0x62, 0xcf, 0x86,
+		0x1d, 0xc1, 0xc3, 0x23, 0x55, 0x9b, 0x1e, 0x5a, 0xe6, 0x9a, 0x17, 0x14, 0x68, 0xbf, 0xae, 0x40,
+		0x3f, 0xd3, 0x64, 0x49, 0x6e, 0x4a, 0xbc, 0xdc, 0x12, 0xed, 0xe4, 0x96, 0xbc, 0x73, 0xb9, 0xcd,
+		0x02, 0xf8, 0xcc, 0xb8, 0xfc, 0x25, 0xa7, 0x18, 0x8f, 0x81, 0xb1, 0xb8, 0x66, 0x55, 0xf9, 0x44,
+		0x95, 0x90, 0xb4, 0x3f, 0x51, 0x20, 0xe3, 0xd7, 0x93, 0x59, 0x18, 0x16, 0x7c, 0x95, 0xb6, 0x6a,
+		0x46, 0x95, 0xeb, 0xce, 0xd1, 0xb6, 0xcc, 0x5d, 0xaa, 0x19, 0x55, 0x7d, 0x90, 0xf3, 0x83, 0x1f,
+		0xf1, 0xe3, 0x90, 0x68, 0x33, 0x0e, 0xa1, 0x81, 0x4f, 0xde, 0xd9, 0xc0, 0x87, 0x86, 0x28, 0x15,
+		0x1d, 0xa2, 0x5f, 0x4c, 0xd0, 0x60, 0xa6, 0xee, 0xb8, 0x46, 0xed, 0x9b, 0x31, 0x23, 0x0e, 0x43,
+		0xa6, 0xee, 0xd4, 0x4a, 0xac, 0x86, 0x1d, 0x78, 0x4e, 0xd7, 0x9d, 0x9a, 0xde, 0x32, 0xec, 0x7d,
+		0x77, 0x69, 0xba, 0xf4, 0xdf, 0x05, 0xa9, 0x0d, 0x44, 0xa5, 0xd6, 0x80, 0x21, 0x26, 0x0a, 0xbe,
+		0x96, 0x3d, 0x82, 0x32, 0xc0, 0xbf, 0xb2, 0x4a, 0xeb, 0xda, 0xcb, 0xd8, 0x66, 0x90, 0x7a, 0xff,
+		0xb6, 0x8f, 0xc1, 0x4c, 0x7f, 0x36, 0xd1, 0x0e, 0x83, 0xa9, 0x9d, 0xce, 0xe1, 0xb4, 0x1f, 0x52,
+		0x00, 0x16, 0x51, 0xb2, 0xb4, 0xbf, 0xb8, 0x0a, 0xb9, 0x94, 0x85, 0x52, 0xa8, 0xe5, 0x63, 0xed,
+		0x06, 0x8d, 0xb7, 0x3f, 0xe4, 0xca, 0x7c, 0xcf, 0xc1, 0x70, 0xa0, 0x8c, 0xae, 0x29, 0x98, 0x39,
+		0xb6, 0x87, 0x57, 0x8d, 0xd7, 0x14, 0x86, 0xae, 0x4b, 0x5f, 0xda, 0xbf, 0x51, 0x20, 0x43, 0x79,
+		0xc2, 0x77, 0x68, 0x42, 0x63, 0xa8, 0xdc, 0xf9, 0x18, 0x1e, 0x05, 0x60, 0x64, 0x70, 0x2b, 0x8d,
+		0x6b, 0x56, 0x86, 0x96, 0xe0, 0x06, 0x19, 0x79, 0xc2, 0x17, 0x78, 0x72, 0x6f, 0x81, 0x0b, 0xaf,
+		0x9b, 0x8b, 0xfd, 0x20, 0x0c, 0xd0, 0x8b, 0x73, 0x37, 0x5d, 0xee, 0x48, 0xe3, 0x73, 0x67, 0xeb,
+		0x37, 0x5d, 0xed, 0x45, 0x18, 0x58, 0xbf, 0xc9, 0x72, 0x23, 0x87, 0x21, 0xd3, 0x70, 0x1c, 0xbe,
+		0x26, 0x33, 0x5f, 0x28, 0x8d, 0x05, 0x74, 0x09, 0x12, 0xf9, 0x80, 0x44, 0x90, 0x0f, 0x08, 0x12,
+		0x1a, 0xc9, 0xae, 0x12, 0x1a, 0x67, 0xfe, 0x40, 0x81, 0x41, 0xc9, 0x3e, 0x90, 0x47, 0x61, 0x7f,
+		0x7e, 0x71, 0x65, 0xee, 0x99, 0xd2, 0x42, 0xa1, 0x74, 0x69, 0x71, 0x56, 0xba, 0x15, 0x94, 0x3b,
+		0xf0, 0xf2, 0xad, 0x29, 0x22, 0xc1, 0x6e, 0xd8, 0x34, 0xbb, 0x4a, 0xce, 0xc2, 0x44, 0x18, 0x65,
+		0x36, 0xbf, 0x86, 0x17, 0x4c, 0x95, 0xdc, 0xfe, 0x97, 0x6f, 0x4d, 0x8d, 0x49, 0x18, 0xb3, 0x9b,
+		0xae, 0x69, 0x7b, 0xad, 0x08, 0x73, 0x2b, 0x4b, 0x4b, 0x78, 0x29, 0xab, 0x05, 0x81, 0x1b, 0xec,
+		0x07, 0x60, 0x2c, 0x8c, 0xb0, 0xbc, 0xb0, 0xa8, 0x26, 0x73, 0xe4, 0xe5, 0x5b, 0x53, 0x23, 0x12,
+		0xf4, 0xb2, 0x55, 0xcb, 0xa5, 0xdf, 0xf3, 0xe3, 0xc7, 0xf6, 0xfd, 0xe4, 0x4f, 0x1c, 0x53, 0xb0,
+		0x67, 0xc3, 0x21, 0x1b, 0x41, 0x1e, 0x82, 0x83, 0x6b, 0x0b, 0xf3, 0xcb, 0xc5, 0x42, 0x69, 0x69,
+		0x6d, 0x3e, 0x72, 0xaf, 0x2b, 0x37, 0xfa, 0xf2, 0xad, 0xa9, 0x41, 0xde, 0xa5, 0x76, 0xd0, 0xab,
+		0x7a, 0xf1, 0xea, 0xca, 0x7a, 0x51, 0x55, 0x18, 0xf4, 0x6a, 0xc3, 0xbc, 0xee, 0x78, 0xec, 0x69,
+		0xec, 0x47, 0xe0, 0x50, 0x0c, 0xb4, 0xdf, 0xb1, 0xb1, 0x97, 0x6f, 0x4d, 0x0d, 0xaf, 0xe2, 0x26,
+		0x35, 0x76, 0x88, 0x62, 0x4c, 0x43, 0xb6, 0x15, 0x63, 0x65, 0x75, 0x65, 0x6d, 0x76, 0x51, 0x9d,
+		0xca, 0xa9, 0x2f, 0xdf, 0x9a, 0x1a, 0x12, 0xc6, 0x10, 0xe1, 0x83, 0x9e, 0xdd, 0xcb, 0x88, 0xe7,
+		0xf3, 0x73, 0xb0, 0xbf, 0x6a, 0x34, 0x5d, 0xbc, 0x3d, 0xb0, 0x65, 0xd1, 0x7f, 0x78, 0xa8, 0x03,
+		0xb4, 0x78, 0x1a, 0x4b, 0xda, 0x04, 0x39, 0xed, 0x37, 0x93, 0x72, 0x1d, 0xf6, 0x5b, 0x3a, 0xc7,
+		0x47, 0xed, 0x13, 0xf0, 0xb9, 0x0e, 0x69, 0xe1, 0xdc, 0x9e, 0x11, 0x9c, 0xf6, 0x12, 0x8c, 0x5c,
+		0xb6, 0x5c, 0xcf, 0x69, 0x58, 0x65, 0xa3, 0x46, 0x6f, 0xeb, 0x3c, 0xd1, 0xad, 0xfd, 0x8c, 0x4c,
+		0xe7, 0x47, 0x60, 0x00, 0x85, 0xc3, 0x2c, 0x17, 0xae, 0xee, 0xea, 0x74, 0x20, 0xb2, 0xe9, 0x82,
+		0xb9, 0x65, 0x89, 0xb0, 0x81, 0x83, 0x69, 0x3f, 0x9b, 0x80, 0x51, 0xaa, 0xe1, 0x2e, 0x7b, 0x4d,
+		0x17, 0x03, 0xa7, 0x3c, 0xa4, 0x1a, 0x86, 0xc7, 0x33, 0x81, 0xf9, 0x69, 0x9e, 0xce, 0x3d, 0xd9,
+		0x39, 0x45, 0x8b, 0xaf, 0x02, 0xeb, 0x14, 0x97, 0x7c, 0x2b, 0xa4, 0x77, 0x8c, 0x9b, 0x25, 0x4a,
+		0x87, 0x85, 0x23, 0xb3, 0xbd, 0xd1, 0x79, 0xf5, 0xf6, 0xe4, 0xe8, 0xae, 0xb1, 0x53, 0x9b, 0xd1,
+		0x04, 0x1d, 0x4d, 0x1f, 0xd8, 0x31, 0x6e, 0x22, 0x8b, 0xa4, 0x4e, 0xaf, 0xb6, 0x95, 0xca, 0xdb,
+		0x86, 0x5d, 0x35, 0x59, 0x23, 0x34, 0xaf, 0x99, 0xbf, 0xdc, 0x73, 0x23, 0x07, 0x82, 0x46, 0x24,
+		0x72, 0x9a, 0x3e, 0xbc, 0x63, 0xdc, 0x9c, 0xa3, 0x05, 0xd8, 0xe2, 0x4c, 0xfa, 0x83, 0x1f, 0x9b,
+		0xdc, 0x47, 0x53, 0xe4, 0x9f, 0x56, 0x00, 0x02, 0x89, 0x91, 0x55, 0x50, 0xcb, 0xfe, 0x17, 0xc5,
+		0x95, 0xde, 0x09, 0x09, 0x64, 0x1f, 0x91, 0x31, 0x5b, 0x64, 0x3f, 0x7b, 0x7b, 0x52, 0xd1, 0x47,
+		0xcb, 0x11, 0xf1, 0x7f, 0x0b, 0x0c, 0x36, 0xeb, 0x15, 0xc3, 0x33, 0x4b, 0x34, 0x20, 0x4b, 0x74,
+		0x5c, 0xb0, 0x8f, 0x21, 0xad, 0x57, 0x6f, 0x4f, 0x12, 0xd6, 0x15, 0x09, 0x59, 0xa3, 0xcb, 0x38,
+		0xb0, 0x12, 0x44, 0x90, 0xfa, 0xf1, 0x5b, 0xf4, 0x01, 0xe4, 0xe0, 0x00, 0x57, 0x16, 0x06, 0x76,
+		0x1c, 0xdb, 0xba, 0xc6, 0x95, 0x2e, 0xa3, 0x8b, 0x4f, 0xcc, 0x69, 0xb2, 0x5b, 0xf2, 0xde, 0xae,
+		0xc8, 0x69, 0x8a, 0x6f, 0xc4, 0xba, 0x61, 0x6e, 0xba, 0x96, 0x18, 0x01, 0x5d, 0x7c, 0x92, 0x4b,
+		0xf8, 0xde, 0x62, 0xb9, 0x89, 0xc9, 0x18, 0x7c, 0x2e, 0xc4, 0xc3, 0x57, 0x2d, 0xe8, 0x55, 0x8f,
+		0xfc, 0xe1, 0x57, 0x6f, 0x4f, 0x1e, 0x64, 0xbc, 0x46, 0x21, 0x34, 0x7d, 0x54, 0x14, 0xcd, 0xb1,
+		0x12, 0x6c, 0xa1, 0x62, 0x7a, 0x86, 0x55, 0x73, 0xb3, 0x6c, 0x87, 0x47, 0x7c, 0x4a, 0x7d, 0xf9,
+		0xf3, 0x7e, 0x48, 0xa1, 0x76, 0x63, 0xa3, 0x4e, 0xdd, 0x6c, 0x84, 0x9c, 0x49, 0x25, 0xda, 0x68,
+		0x14, 0x42, 0xd3, 0x47, 0x45, 0x91, 0x70, 0x34, 0xa7, 0xa1, 0xdf, 0xf5, 0x0c, 0xaf, 0xe9, 0xf2,
+		0xeb, 0xe4, 0x07, 0xe4, 0xb1, 0xcc, 0x3b, 0x76, 0x65, 0x8d, 0xd6, 0xea, 0x1c, 0x8a, 0x5c, 0x82,
+		0x7e, 0xcf, 0xb9, 0x66, 0xf2, 0x07, 0x37, 0x7b, 0x9b, 0x34, 0x74, 0x47, 0x87, 0x61, 0x13, 0x0f,
+		0xd4, 0x8a, 0x59, 0x33, 0xab, 0xcc, 0x01, 0xd9, 0x36, 0x98, 0x9f, 0x8e, 0x14, 0x17, 0x7a, 0xd6,
+		0x6c, 0xde, 0xdb, 0x28, 0x3d, 0x4d, 0x1f, 0xf5, 0x8b, 0xd6, 0x68, 0x09, 0xba, 0xac, 0x2f, 0x1a,
+		0x56, 0xcd, 0x7f, 0xb0, 0x9a, 0x7f, 0x91, 0xff, 0x0f, 0x86, 0xd8, 0x5f, 0xa5, 0xa6, 0xed, 0x59,
+		0xb5, 0x2e, 0x7c, 0xc7, 0x49, 0xae, 0x8a, 0xe3, 0xac, 0x6d, 0x19, 0x9b, 0xe9, 0xe2, 0x20, 0x2b,
+		0xda, 0xc0, 0x12, 0xf2, 0x74, 0xe8, 0x08, 0x21, 0x7f, 0x10, 0xe6, 0x60, 0xd8, 0x64, 0xf9, 0xd5,
+		0x22, 0x6b, 0x20, 0x61, 0xe0, 0x70, 0x37, 0xed, 0x4d, 0xc7, 0xa6, 0x37, 0x25, 0xb9, 0xd7, 0x8d,
+		0x51, 0x57, 0x52, 0x1e, 0xee, 0x28, 0x84, 0xa6, 0x8f, 0xfa, 0x45, 0x97, 0x69, 0x09, 0xa9, 0xc0,
+		0x48, 0x00, 0x45, 0x67, 0x5d, 0xa6, 0x63, 0x57, 0x8f, 0xf3, 0xae, 0xee, 0x8f, 0xb6, 0x12, 0x4c,
+		0xbc, 0x61, 0xbf, 0x10, 0xd1, 0xc8, 0x1b, 0x00, 0x82, 0xb9, 0x4e, 0xb3, 0x07, 0x83, 0xe7, 0x0e,
+		0xc4, 0x1b, 0x09, 0x11, 0x79, 0x05, 0xf0, 0xe4, 0xdb, 0x60, 0x7c, 0xc7, 0xb2, 0x4b, 0xae, 0x59,
+		0xdb, 0x2a, 0xf1, 0x01, 0x44, 0x32, 0xf4, 0x8d, 0xcc, 0xfc, 0x62, 0x6f, 0xfa, 0xf6, 0xea, 0xed,
+		0xc9, 0x1c, 0xb7, 0x7b, 0xad, 0x24, 0x35, 0x7d, 0x6c, 0xc7, 0xb2, 0xd7, 0xcc, 0xda, 0x56, 0xc1,
+		0x2f, 0x9b, 0x19, 0x7a, 0xcf, 0xc7, 0x26, 0xf7, 0xf1, 0xf9, 0xb6, 0x4f, 0xbb, 0x00, 0xc3, 0x38,
+		0xdd, 0xf8, 0x6c, 0x31, 0x5d, 0x0c, 0x0f, 0x0c, 0xf1, 0x41, 0x93, 0x0b, 0x19, 0x3d, 0x28, 0x60,
+		0x13, 0xf5, 0x9d, 0x7f, 0x3c, 0xa5, 0x68, 0xb7, 0x14, 0xe8, 0x2f, 0x14, 0x56, 0x0d, 0xab, 0x41,
+		0x16, 0x60, 0x2c, 0x50, 0xcd, 0xf0, 0x5c, 0x3d, 0xf2, 0xea, 0xed, 0xc9, 0x6c, 0x54, 0x7b, 0xfd,
+		0xc9, 0x1a, 0xcc, 0x10, 0x31, 0x5b, 0x67, 0xf0, 0xf1, 0x9c, 0x2d, 0x2b, 0x14, 0x3e, 0x66, 0xf2,
+		0x07, 0x03, 0x3d, 0x94, 0x6b, 0x35, 0x54, 0x21, 0x9f, 0xf7, 0x48, 0xc7, 0x2e, 0xc2, 0x00, 0x63,
+		0xcf, 0xa5, 0x5e, 0x2c, 0xfe, 0xc1, 0x93, 0xf2, 0x24, 0xa4, 0x96, 0x14, 0xc6, 0x4f, 0x1c, 0x22,
+		0x98, 0xf6, 0xd7, 0x0a, 0x40, 0xa1, 0x50, 0x58, 0x6f, 0x58, 0xf5, 0x9a, 0xe9, 0xdd, 0xcd, 0xee,
+		0x15, 0xd1, 0x28, 0x6c, 0x59, 0x25, 0xb7, 0x51, 0x8e, 0x74, 0xf1, 0xb0, 0x3c, 0xcd, 0xc3, 0x10,
+		0x1a, 0x5e, 0x1e, 0xda, 0xb2, 0xd6, 0x1a, 0xe5, 0x28, 0x99, 0x8a, 0xeb, 0xf9, 0x64, 0x92, 0xb1,
+		0x64, 0x24, 0x08, 0x4e, 0xa6, 0xe0, 0x7a, 0xf1, 0x02, 0x9b, 0x87, 0xc1, 0xa0, 0xd3, 0x2e, 0x79,
+		0x12, 0xd2, 0x1e, 0xff, 0x9b, 0xcb, 0xed, 0x40, 0x58, 0x6e, 0x02, 0x94, 0xcb, 0xce, 0x87, 0xd6,
+		0xbe, 0x8c, 0xe2, 0xf3, 0xf5, 0xed, 0x75, 0xa2, 0x1d, 0x68, 0xd7, 0xb9, 0x15, 0x4e, 0xde, 0x91,
+		0x33, 0xc4, 0xb1, 0x23, 0x42, 0xfb, 0x9a, 0x82, 0x2f, 0xdd, 0x70, 0xd3, 0xf0, 0xfa, 0xeb, 0x74,
+		0x01, 0x06, 0x4c, 0xdb, 0x6b, 0x58, 0xb4, 0xd7, 0xfc, 0xc5, 0xa0, 0x60, 0x0c, 0x63, 0x18, 0xa7,
+		0xcf, 0x6f, 0x0a, 0xcf, 0x92, 0xa3, 0x46, 0xba, 0xfc, 0x7d, 0x49, 0xc8, 0xb6, 0xc3, 0x24, 0x73,
+		0x30, 0x5a, 0x6e, 0x98, 0xb4, 0xa0, 0x24, 0x67, 0xc5, 0xf2, 0xb9, 0xc0, 0x41, 0x8b, 0x00, 0x68,
+		0xfa, 0x88, 0x28, 0xe1, 0x36, 0xbc, 0x0a, 0xe8, 0x49, 0xa1, 0x32, 0x21, 0x54, 0x97, 0xae, 0x93,
+		0xc6, 0x8d, 0xb8, 0x68, 0x24, 0x4c, 0x80, 0x59, 0xf1, 0x91, 0xa0, 0x94, 0x9a, 0xf1, 0xb7, 0xc1,
+		0xa8, 0x65, 0x5b, 0x9e, 0x65, 0xd4, 0x4a, 0x9b, 0x46, 0xcd, 0xc0, 0xb7, 0x6f, 0x7a, 0x77, 0x3e,
+		0x99, 0x11, 0xe6, 0xcd, 0x46, 0xc8, 0x69, 0xfa, 0x08, 0x2f, 0xc9, 0xb3, 0x02, 0x7c, 0x0c, 0x52,
+		0x34, 0x95, 0xba, 0x23, 0xff, 0x42, 0xa0, 0x4b, 0x3e, 0xd3, 0xfb, 0x92, 0x30, 0xa6, 0x9b, 0x95,
+		0xff, 0x37, 0x14, 0xbd, 0x0d, 0xc5, 0x12, 0x00, 0x9b, 0xd3, 0x68, 0x25, 0xb3, 0xa9, 0x3b, 0xb2,
+		0x0a, 0x19, 0x46, 0xa1, 0xe0, 0x7a, 0xd2, 0x78, 0x7c, 0x2a, 0x01, 0x43, 0xf2, 0x78, 0xfc, 0xbd,
+		0x5d, 0x41, 0x70, 0xa7, 0x4b, 0xd8, 0x17, 0x96, 0x83, 0x3e, 0x2a, 0xdb, 0x97, 0x16, 0x9d, 0xdc,
+		0xdb, 0xb0, 0xfc, 0xb0, 0x02, 0x24, 0xb0, 0x27, 0xba, 0xe9, 0xd6, 0x1d, 0xdb, 0xa5, 0xbe, 0x56,
+		0x40, 0x86, 0x07, 0x64, 0xe1, 0xa5, 0xc8, 0xaf, 0xe5, 0xf4, 0x25, 0x78, 0x7c, 0x40, 0x5f, 0xe8,
+		0x53, 0x82, 0xe7, 0xec, 0x62, 0x0e, 0x76, 0x4d, 0xe3, 0x99, 0x2b, 0xc1, 0x5d, 0x74, 0x82, 0xed,
+		0xd3, 0x7e, 0x4d, 0x81, 0x43, 0x2d, 0x9d, 0xf1, 0x19, 0xd4, 0x81, 0x34, 0xa4, 0x4a, 0xfe, 0xa4,
+		0xb1, 0xd8, 0xf9, 0xeb, 0x42, 0x1e, 0x63, 0x8d, 0x96, 0xc9, 0x7b, 0xf7, 0xcc, 0x04, 0x3b, 0x0d,
+		0xf6, 0x09, 0x05, 0x26, 0xe4, 0xe6, 0x7d, 0xe6, 0xf3, 0x30, 0x24, 0xb7, 0xee, 0x67, 0x29, 0xda,
+		0xb0, 0xcd, 0x39, 0x0e, 0xe1, 0x90, 0x62, 0xa0, 0x05, 0xfe, 0x0f, 0x81, 0xed, 0xd1, 0x6b, 0xd1,
+		0x76, 0x54, 0x1b, 0x52, 0x6c, 0x45, 0xc5, 0xe7, 0x8b, 0x8c, 0x86, 0xb1, 0x83, 0xdb, 0xbe, 0x80,
+		0xcb, 0x4c, 0x49, 0x3a, 0x08, 0x97, 0xdf, 0xff, 0xea, 0xed, 0xc9, 0x31, 0xa6, 0x9e, 0x41, 0x9d,
+		0xa6, 0x67, 0xf0, 0xa3, 0x80, 0x7f, 0x93, 0x12, 0xe0, 0xf3, 0x39, 0x1e, 0xfe, 0x98, 0x5a, 0x4d,
+		0xfc, 0x1e, 0x52, 0x87, 0x81, 0x3f, 0x1a, 0x0e, 0x00, 0xc2, 0xe8, 0x98, 0x40, 0xb0, 0x6c, 0x6f,
+		0x41, 0x7c, 0x93, 0x6b, 0x30, 0x8c, 0xce, 0x7c, 0xd3, 0xc6, 0x68, 0x17, 0x7f, 0x70, 0x8d, 0x4d,
+		0x9c, 0x4b, 0x3d, 0x87, 0x75, 0x13, 0xbe, 0x7d, 0x0c, 0x88, 0x69, 0xfa, 0x90, 0xff, 0xbd, 0x6e,
+		0xdc, 0xa4, 0xb1, 0x02, 0x26, 0x34, 0xc2, 0x89, 0x89, 0x6c, 0xaa, 0xe7, 0x58, 0x81, 0x35, 0x99,
+		0x93, 0x72, 0x24, 0x61, 0x92, 0x18, 0x2b, 0x18, 0x37, 0xc3, 0xc9, 0x0d, 0xb2, 0x02, 0x83, 0x3b,
+		0x46, 0xe3, 0x9a, 0xe9, 0xb1, 0x56, 0xfb, 0xee, 0xc8, 0x46, 0x02, 0x23, 0x41, 0x09, 0x96, 0x5b,
+		0xc2, 0xb3, 0x7e, 0x3e, 0x38, 0x2d, 0x3f, 0x27, 0xc6, 0x73, 0x7a, 0x1d, 0xa2, 0xb3, 0x0f, 0xc6,
+		0x44, 0x67, 0x8f, 0xe2, 0x75, 0xb0, 0x9b, 0x25, 0x9a, 0x18, 0xa3, 0xa1, 0xe8, 0x70, 0x7e, 0xe2,
+		0xd5, 0xdb, 0x93, 0x6a, 0xd0, 0x77, 0x5a, 0xa5, 0xe1, 0x83, 0xe3, 0x37, 0x31, 0xfa, 0xc1, 0xf3,
+		0x37, 0x83, 0x58, 0x2e, 0xd4, 0x38, 0x4d, 0x91, 0x0e, 0x04, 0x99, 0x18, 0xa9, 0x52, 0xc3, 0x0e,
+		0xdd, 0x2c, 0xb2, 0x0f, 0xb2, 0x08, 0x64, 0xdb, 0xcf, 0xf8, 0xf9, 0xf8, 0x19, 0x8a, 0x7f, 0xf4,
+		0xd5, 0xdb, 0x93, 0x87, 0x18, 0x7e, 0x2b, 0x8c, 0xa6, 0x8f, 0x05, 0x85, 0x82, 0xda, 0xff, 0x0f,
+		0xc3, 0x18, 0x55, 0x97, 0x44, 0x42, 0x33, 0x0b, 0x9d, 0xa4, 0x33, 0xc5, 0xa5, 0x33, 0x11, 0x84,
+		0xe9, 0x3e, 0x36, 0x13, 0x0e, 0x0d, 0xfc, 0x05, 0x3c, 0x2e, 0x45, 0xd2, 0x6e, 0xb4, 0x5d, 0xa9,
+		0x99, 0x0d, 0x97, 0xfe, 0xc8, 0x63, 0x68, 0x29, 0x6a, 0x01, 0xd1, 0x74, 0x35, 0xd8, 0xaf, 0x66,
+		0x45, 0xe4, 0x1f, 0xc2, 0x84, 0x61, 0xdb, 0xf8, 0x92, 0x42, 0xbd, 0xe1, 0x5c, 0xb7, 0xa8, 0x26,
+		0x95, 0x8d, 0x3a, 0xfb, 0xfd, 0xc1, 0xfc, 0x52, 0xcf, 0xeb, 0xf6, 0x61, 0xd6, 0x76, 0x1c, 0x4d,
+		0x4d, 0x27, 0xac, 0x78, 0x55, 0x94, 0xce, 0x19, 0x75, 0xb2, 0x09, 0x38, 0x12, 0x25, 0xb7, 0x59,
+		0xaf, 0xd7, 0x76, 0xe9, 0xb6, 0x7a, 0x26, 0x3f, 0xd7, 0x73, 0xb3, 0x63, 0xc1, 0x08, 0x33, 0x4a,
+		0x1a, 0xde, 0x0c, 0xbc, 0xb9, 0x46, 0xff, 0x66, 0x7e, 0x09, 0x9f, 0xf9, 0xf8, 0x23, 0x7c, 0xc6,
+		0x6e, 0x76, 0xa4, 0x67, 0xbf, 0x24, 0x94, 0x9f, 0x8c, 0x90, 0xa3, 0x7e, 0x09, 0x2f, 0x29, 0x60,
+		0x01, 0x31, 0x61, 0xb0, 0xea, 0xa0, 0xe3, 0xe2, 0xd8, 0x15, 0xb3, 0x42, 0x1f, 0x45, 0xcf, 0xe4,
+		0x0b, 0x3d, 0x37, 0xc7, 0x35, 0x57, 0x22, 0xa5, 0xe9, 0x80, 0x5f, 0x79, 0xfa, 0x21, 0xf9, 0x2b,
+		0xff, 0x9d, 0x06, 0x6c, 0x5b, 0xd6, 0x12, 0xbb, 0xa0, 0x42, 0x20, 0xb5, 0x6b, 0x1a, 0x0d, 0x7e,
+		0x9c, 0x91, 0xfe, 0x8d, 0xf9, 0xa3, 0xba, 0xd9, 0xb0, 0x9c, 0x4a, 0x89, 0xfd, 0x56, 0x4f, 0xa2,
+		0xd7, 0xfc, 0x91, 0x8c, 0xcd, 0xf3, 0x47, 0xac, 0x68, 0x0d, 0x4b, 0xc8, 0x0d, 0x18, 0xe3, 0x10,
+		0xfe, 0xb8, 0x0b, 0x87, 0xe4, 0x4a, 0xcf, 0x23, 0x9a, 0x0d, 0x35, 0x19, 0x10, 0xd4, 0x74, 0x95,
+		0x95, 0xad, 0x06, 0x45, 0xff, 0x5b, 0x81, 0xd4, 0xaa, 0xe3, 0xd4, 0x88, 0x03, 0x63, 0xb6, 0xe3,
+		0x71, 0x49, 0x95, 0x78, 0x0a, 0x50, 0xb9, 0x13, 0x9d, 0xfa, 0xca, 0xed, 0xc9, 0x56, 0x52, 0xfa,
+		0xa8, 0xed, 0x78, 0x4c, 0xf2, 0xeb, 0xb4, 0x80, 0x7c, 0x1b, 0x0c, 0x87, 0x1b, 0x63, 0x6e, 0xdc,
+		0xb3, 0x3d, 0x37, 0x16, 0x26, 0x13, 0xd8, 0x82, 0x50, 0xb1, 0xa6, 0x0f, 0x6d, 0x4a, 0xad, 0xb3,
+		0x63, 0x99, 0x5f, 0xc3, 0xd1, 0x7f, 0x39, 0x01, 0xfb, 0x71, 0xf4, 0x83, 0x8d, 0x0b, 0xdd, 0xbc,
+		0x61, 0x34, 0x2a, 0x2e, 0xf9, 0x29, 0x05, 0x0e, 0x96, 0x9b, 0x3b, 0xcd, 0x1a, 0x7b, 0x15, 0xb5,
+		0x41, 0x8b, 0x4b, 0xd4, 0x90, 0xf0, 0x94, 0xc0, 0x91, 0xd8, 0x35, 0x95, 0x9f, 0x61, 0xcf, 0x6f,
+		0x70, 0x15, 0x38, 0xc6, 0xd7, 0xb9, 0x78, 0x52, 0xda, 0x27, 0xff, 0x74, 0xf2, 0xc1, 0xee, 0xb4,
+		0x1a, 0xa9, 0xba, 0xfa, 0xfe, 0x80, 0x10, 0xe3, 0x54, 0x47, 0x32, 0x18, 0xef, 0x34, 0xcc, 0x2d,
+		0xb3, 0x41, 0xed, 0x56, 0xd9, 0x3f, 0x24, 0x3f, 0x2c, 0xc7, 0x3b, 0x11, 0x00, 0x4d, 0x1f, 0xf1,
+		0x4b, 0xe6, 0x68, 0xc1, 0x87, 0xa8, 0x0f, 0xba, 0x65, 0xcd, 0x35, 0x1b, 0x0d, 0xd3, 0xf6, 0x84,
+		0x24, 0xae, 0xc1, 0x00, 0x63, 0xd9, 0xed, 0xaa, 0xe3, 0x8f, 0x61, 0xc7, 0x7b, 0xed, 0x96, 0x68,
+		0x81, 0xfe, 0x28, 0x03, 0x55, 0x53, 0x7e, 0x78, 0x98, 0x7f, 0xa1, 0x7f, 0x7c, 0x00, 0x79, 0x5b,
+		0x69, 0x7a, 0xf4, 0xb7, 0x3a, 0x2c, 0xbb, 0x2a, 0xf8, 0x7b, 0x47, 0x6f, 0xfc, 0x15, 0xf9, 0xc0,
+		0x8c, 0x08, 0xa9, 0x50, 0x54, 0xed, 0x4e, 0x39, 0xd6, 0xbe, 0x57, 0x81, 0x43, 0x34, 0x8b, 0x58,
+		0xe6, 0x43, 0x63, 0x56, 0x02, 0x47, 0x82, 0xbc, 0x2d, 0x94, 0x2c, 0xbd, 0x67, 0xf2, 0x93, 0x1a,
+		0x41, 0x8b, 0xb6, 0xbf, 0xe0, 0xa7, 0xc6, 0xd1, 0xc2, 0x58, 0x76, 0x95, 0xee, 0xc7, 0xcd, 0xe1,
+		0xef, 0xf6, 0x99, 0xd7, 0x2d, 0xa7, 0xe9, 0x96, 0xb8, 0x94, 0xa9, 0x9d, 0x93, 0xb5, 0x24, 0x02,
+		0xa0, 0xe9, 0x23, 0xa2, 0x64, 0x95, 0x16, 0x90, 0x75, 0xfa, 0x93, 0x65, 0xd7, 0xc4, 0x7e, 0xd8,
+		0x9b, 0x7a, 0xb6, 0xcd, 0x43, 0xac, 0x21, 0x4a, 0x44, 0xd3, 0x19, 0x31, 0x52, 0x0c, 0x9d, 0xe7,
+		0x4b, 0xe5, 0x1f, 0xfe, 0xca, 0xed, 0xc9, 0x68, 0x08, 0xbf, 0x47, 0xe8, 0xce, 0x91, 0xb5, 0x7f,
+		0xac, 0xc0, 0x08, 0x0e, 0xc6, 0x5a, 0xcd, 0x70, 0xb7, 0x8b, 0xd7, 0x71, 0x8b, 0xfc, 0x02, 0xd0,
+		0xac, 0x50, 0xb8, 0xc3, 0x92, 0x77, 0x23, 0x55, 0x6a, 0x18, 0x3d, 0x6d, 0x59, 0xbc, 0xa3, 0x57,
+		0x20, 0xbd, 0xd5, 0x30, 0xca, 0xbe, 0x17, 0xdd, 0xbb, 0xf3, 0xe7, 0xe3, 0x6b, 0xbf, 0x43, 0x95,
+		0x44, 0x44, 0x02, 0xfe, 0xe8, 0x30, 0x15, 0x6e, 0xc9, 0x72, 0x29, 0x3d, 0x64, 0xb9, 0x2c, 0xe8,
+		0x67, 0x9a, 0x98, 0x4d, 0xdc, 0x2b, 0xe5, 0xe2, 0x0d, 0xcc, 0xa4, 0x79, 0xc4, 0x4a, 0xf3, 0xdf,
+		0x03, 0x97, 0x4c, 0x93, 0xae, 0x1d, 0xdf, 0xaf, 0xc0, 0x88, 0xef, 0xb5, 0x97, 0xea, 0x0e, 0xbd,
+		0x4d, 0xd0, 0x99, 0x93, 0xc5, 0xb0, 0x67, 0x1b, 0xa6, 0xd0, 0xf3, 0x6c, 0x0c, 0x82, 0x12, 0xe4,
+		0x49, 0xfb, 0x42, 0x02, 0xc6, 0xa8, 0x0a, 0xa0, 0x2e, 0xf8, 0xe7, 0xa0, 0xf0, 0x9c, 0xae, 0xe5,
+		0xd5, 0xc4, 0xdd, 0x10, 0xf6, 0x41, 0xa6, 0xc2, 0xbb, 0x37, 0x6c, 0x67, 0x50, 0x2e, 0x6a, 0x19,
+		0x9e, 0x64, 0x0f, 0xc3, 0xb3, 0x00, 0x63, 0x96, 0x2d, 0xd4, 0x40, 0xa4, 0xa2, 0xe8, 0x19, 0x15,
+		0xd9, 0xe5, 0x6c, 0x01, 0xd1, 0x74, 0x35, 0x28, 0xe3, 0xe9, 0x28, 0x1b, 0x46, 0x5c, 0xec, 0x53,
+		0xc9, 0xd7, 0x4a, 0x16, 0x92, 0xcc, 0xf7, 0x3c, 0x03, 0xb9, 0xcc, 0xc3, 0xd4, 0x34, 0x7d, 0x98,
+		0x16, 0x5c, 0xe2, 0xdf, 0xe8, 0x0a, 0xa1, 0xf7, 0xcc, 0x7f, 0xe1, 0x95, 0xfe, 0x1d, 0x4e, 0x5a,
+		0x9c, 0xf9, 0x25, 0x05, 0x20, 0xd8, 0x45, 0xc4, 0x63, 0x1e, 0xf9, 0x95, 0xe5, 0x42, 0x69, 0x6d,
+		0x7d, 0x76, 0x7d, 0x63, 0xad, 0xb4, 0xb1, 0xbc, 0xb6, 0x5a, 0x9c, 0x63, 0xbf, 0xed, 0xea, 0x1f,
+		0x0a, 0x71, 0xeb, 0x66, 0x99, 0xfe, 0xac, 0x0d, 0x39, 0x09, 0x13, 0x61, 0x68, 0xfc, 0xc2, 0xe7,
+		0x71, 0x73, 0x43, 0x2f, 0xdf, 0x9a, 0x4a, 0xb3, 0x2c, 0xab, 0x89, 0x47, 0x6a, 0xf7, 0xb7, 0xc2,
+		0xe1, 0x2f, 0x8e, 0x24, 0x72, 0xc3, 0x2f, 0xdf, 0x9a, 0xca, 0xf8, 0xe9, 0x58, 0xa2, 0x01, 0x91,
+		0x21, 0x39, 0xbd, 0x64, 0x0e, 0x5e, 0xbe, 0x35, 0xd5, 0xcf, 0xdc, 0x8f, 0x5c, 0x0a, 0x8f, 0x7e,
+		0xe4, 0xdf, 0xdc, 0xf6, 0xd8, 0x87, 0x2c, 0x44, 0x76, 0x7a, 0x83, 0xfd, 0x7b, 0xfd, 0xf1, 0xb3,
+		0x37, 0xd9, 0x41, 0x8e, 0xf0, 0x29, 0x8f, 0xef, 0x39, 0x04, 0x59, 0xe9, 0x94, 0x47, 0xd5, 0xb4,
+		0x4d, 0xd7, 0x72, 0xbb, 0x3e, 0xe8, 0xd1, 0xe9, 0xc4, 0x45, 0xfc, 0x09, 0x12, 0xed, 0x53, 0xb2,
+		0x89, 0x7f, 0xd6, 0xf2, 0xb6, 0x2b, 0x0d, 0xe3, 0x06, 0x35, 0xf1, 0x77, 0x31, 0xdb, 0x76, 0x09,
+		0xd4, 0x1b, 0x9c, 0x74, 0xfb, 0x6c, 0x5b, 0x14, 0x42, 0xd3, 0x47, 0x45, 0x91, 0xd8, 0x69, 0x49,
+		0xbf, 0x87, 0xeb, 0x8b, 0xf6, 0xee, 0x04, 0x1c, 0x89, 0x5f, 0xc4, 0x75, 0xb3, 0xec, 0xbc, 0x46,
+		0x43, 0xf8, 0x31, 0x05, 0xc6, 0x9d, 0x80, 0x70, 0x49, 0xf8, 0x04, 0xdd, 0x98, 0xc5, 0xb7, 0x70,
+		0x63, 0xc4, 0x33, 0x04, 0x31, 0x64, 0x7a, 0xb6, 0x48, 0xc4, 0x69, 0xe9, 0xa4, 0x24, 0x89, 0xdf,
+		0x51, 0x60, 0xb2, 0xad, 0xd3, 0x70, 0x17, 0x84, 0x51, 0x86, 0x41, 0x23, 0x20, 0xcd, 0x23, 0x96,
+		0xfb, 0xa3, 0xa7, 0x68, 0x62, 0x5b, 0xcf, 0xe7, 0xc2, 0xe7, 0x30, 0x24, 0x3a, 0x9a, 0x2e, 0x53,
+		0x95, 0xba, 0xf3, 0x59, 0x05, 0x0e, 0xc7, 0xba, 0xd1, 0x77, 0xa1, 0x2b, 0x6d, 0x3c, 0x42, 0xb2,
+		0x16, 0xb8, 0x7d, 0xfe, 0x2f, 0x99, 0x86, 0xbb, 0xd7, 0xc2, 0x4d, 0xfe, 0x40, 0xbc, 0xef, 0xe7,
+		0x3b, 0x73, 0x52, 0x97, 0x7e, 0x41, 0x81, 0x6c, 0xab, 0x33, 0x7c, 0x17, 0xfa, 0xb3, 0x1a, 0xf0,
+		0x2d, 0x8e, 0x65, 0x46, 0xf8, 0x0e, 0x37, 0xd9, 0x0b, 0xd3, 0x1f, 0x48, 0xe0, 0x38, 0xc4, 0xb8,
+		0x7e, 0x9c, 0xef, 0xd7, 0xc9, 0xce, 0x5c, 0x05, 0x86, 0x5d, 0xce, 0x1c, 0xa6, 0x1a, 0x9d, 0xf8,
+		0x01, 0x8c, 0xe9, 0x46, 0xfe, 0x48, 0x38, 0xe3, 0x13, 0xa2, 0xa2, 0xe9, 0x43, 0xae, 0x04, 0x2b,
+		0x89, 0xe5, 0x15, 0x05, 0x26, 0xc2, 0x5e, 0xe1, 0xdd, 0xd1, 0x4b, 0xe9, 0x80, 0x74, 0xca, 0x3f,
+		0x20, 0x1d, 0xe8, 0x6b, 0x32, 0xa4, 0xaf, 0x2f, 0x88, 0xcd, 0x0b, 0xba, 0xea, 0x9a, 0xc8, 0x45,
+		0x70, 0x4b, 0x25, 0xac, 0x00, 0x01, 0x9f, 0xf9, 0x09, 0xde, 0xe1, 0x21, 0x91, 0x9b, 0x32, 0x69,
+		0xe4, 0x56, 0x09, 0x41, 0xc9, 0x6a, 0x3b, 0x08, 0x43, 0xf3, 0x6c, 0x11, 0x62, 0x3f, 0xba, 0xfe,
+		0x08, 0xfe, 0xca, 0x1d, 0x66, 0x94, 0x79, 0x76, 0x3b, 0x74, 0x00, 0x80, 0xe5, 0x9a, 0xfd, 0x2b,
+		0x6c, 0xf4, 0x8b, 0x3c, 0x04, 0x7d, 0x2c, 0x7b, 0xb8, 0xf7, 0xd9, 0x3b, 0x06, 0x44, 0xde, 0x84,
+		0xee, 0x93, 0x70, 0x6c, 0xc5, 0x4e, 0xeb, 0xde, 0x5b, 0x14, 0x32, 0x02, 0x79, 0x09, 0xf6, 0x07,
+		0x59, 0x4d, 0x99, 0x12, 0xdb, 0x53, 0x99, 0xec, 0xb0, 0x67, 0x9b, 0xbf, 0x8f, 0x0b, 0xe8, 0x48,
+		0x34, 0x43, 0x2a, 0xd1, 0xd2, 0xf4, 0x09, 0xbf, 0xbc, 0x20, 0xb5, 0xfd, 0x9d, 0x0a, 0x64, 0x03,
+		0x65, 0xf7, 0x97, 0x32, 0x54, 0x26, 0x97, 0xfe, 0x86, 0x73, 0x3b, 0x9d, 0x94, 0x97, 0xdc, 0xfc,
+		0x29, 0xce, 0xc1, 0x64, 0x74, 0xf6, 0x84, 0x09, 0x6a, 0xfa, 0x81, 0x4a, 0x1c, 0x3e, 0x06, 0xb0,
+		0xb1, 0x0b, 0x57, 0xbf, 0x78, 0x0c, 0x3e, 0x2c, 0xfe, 0x76, 0x8b, 0x67, 0x5e, 0xeb, 0xbc, 0x88,
+		0xc5, 0xad, 0x4a, 0xe4, 0xc3, 0x0a, 0x1c, 0x61, 0x7a, 0x1e, 0xd8, 0x76, 0x29, 0x41, 0x8e, 0x59,
+		0x64, 0x64, 0xe4, 0xc1, 0xae, 0x56, 0x0f, 0xce, 0xcb, 0x83, 0x9c, 0x97, 0x13, 0xf2, 0x34, 0x8a,
+		0x27, 0xaf, 0xe9, 0xb9, 0x4a, 0x3b, 0x6a, 0x2e, 0x79, 0xb7, 0x02, 0x07, 0x29, 0xb6, 0x94, 0x44,
+		0x16, 0x02, 0x4a, 0x53, 0xbe, 0x4e, 0x75, 0x34, 0xfb, 0x9c, 0xa7, 0x93, 0xe1, 0x8c, 0x4c, 0x1b,
+		0xaa, 0x9a, 0xbe, 0xbf, 0x12, 0x47, 0x84, 0xbc, 0x1d, 0x26, 0x28, 0x4a, 0x99, 0x59, 0x67, 0x9f,
+		0x8b, 0x4c, 0xeb, 0xd9, 0x82, 0x76, 0xeb, 0x46, 0xfe, 0x04, 0x67, 0xe1, 0xb0, 0xc4, 0x42, 0x84,
+		0x9e, 0xa6, 0x93, 0x4a, 0x0b, 0x3a, 0x79, 0x39, 0xa4, 0xa9, 0x21, 0xb3, 0x87, 0x3f, 0xb2, 0x1c,
+		0x23, 0x87, 0xb6, 0x8b, 0x40, 0x7b, 0x7d, 0x0d, 0x93, 0x95, 0xf5, 0x55, 0xa6, 0xe2, 0x92, 0x79,
+		0xfc, 0xf5, 0x03, 0x93, 0x85, 0x7a, 0x83, 0xd4, 0xa8, 0x8c, 0xcb, 0x6d, 0xf3, 0xc0, 0x30, 0x7f,
+		0x90, 0xb7, 0xc3, 0xcf, 0xbf, 0x0a, 0x14, 0x4d, 0x1f, 0xd8, 0x62, 0x10, 0x78, 0x22, 0xd3, 0xbc,
+		0x59, 0x77, 0x1a, 0xe8, 0xa2, 0x0c, 0xf1, 0xc7, 0x86, 0xf9, 0x37, 0x29, 0xe0, 0x6f, 0xaa, 0xcb,
+		0xf6, 0x60, 0x78, 0x2a, 0xd9, 0xc5, 0xe6, 0x5c, 0x18, 0x89, 0xd8, 0x30, 0x16, 0x35, 0xba, 0x78,
+		0xb7, 0x0c, 0x29, 0x4d, 0xb5, 0xb7, 0xba, 0x5c, 0x50, 0x62, 0x7b, 0x21, 0x2b, 0x6f, 0x2c, 0x4b,
+		0x84, 0xe8, 0x11, 0x44, 0x19, 0x0f, 0x77, 0xed, 0xfa, 0x77, 0x68, 0x22, 0x99, 0x66, 0xae, 0x5b,
+		0x0c, 0xa1, 0x48, 0x33, 0x0b, 0x8b, 0xcb, 0x60, 0xef, 0x62, 0x38, 0xf2, 0xef, 0x12, 0x70, 0x46,
+		0x0e, 0x29, 0xde, 0xd6, 0x34, 0x1b, 0xbb, 0x7e, 0x60, 0x51, 0x37, 0xaa, 0x96, 0x2d, 0x3f, 0xce,
+		0x73, 0x48, 0x76, 0x8a, 0x29, 0xac, 0x70, 0x8d, 0x35, 0x1b, 0x06, 0x57, 0x8d, 0xaa, 0xa9, 0x9b,
+		0x6f, 0x6b, 0x9a, 0xae, 0x17, 0xf3, 0xde, 0x03, 0xbe, 0xc5, 0xb0, 0xb5, 0x25, 0x6e, 0x97, 0xa4,
+		0x74, 0xfe, 0x85, 0x51, 0x76, 0xcd, 0xc2, 0x1b, 0x30, 0x6c, 0xf9, 0x63, 0x1f, 0xf8, 0x13, 0x0a,
+		0x34, 0xeb, 0x58, 0x62, 0x37, 0x65, 0x53, 0xe2, 0x65, 0xd6, 0xa6, 0xed, 0xad, 0x63, 0x89, 0xf6,
+		0x34, 0x0c, 0xb1, 0xf6, 0xf8, 0xde, 0xec, 0x21, 0x48, 0xd3, 0x9b, 0x8d, 0x41, 0xab, 0x03, 0xf8,
+		0xfd, 0x0c, 0x7b, 0x1b, 0x82, 0x51, 0x61, 0x0d, 0xb3, 0x8f, 0x7c, 0xbe, 0xad, 0x10, 0x4f, 0x77,
+		0xf6, 0xdb, 0x99, 0xa0, 0x7c, 0x31, 0x7e, 0xba, 0x0f, 0xf6, 0xb3, 0xec, 0xfe, 0x59, 0xa3, 0x6e,
+		0x9d, 0xdd, 0xf6, 0xbc, 0xba, 0x1f, 0xd2, 0xd1, 0xe2, 0x69, 0xa3, 0x6e, 0x69, 0xbb, 0x90, 0xba,
+		0xec, 0x79, 0x75, 0x72, 0x06, 0xfa, 0x1a, 0xcd, 0x9a, 0x29, 0xd2, 0x8f, 0x13, 0xd3, 0x01, 0xcc,
+		0x34, 0x02, 0xe8, 0xcd, 0x9a, 0xa9, 0x33, 0x10, 0x52, 0x84, 0xc9, 0xad, 0x66, 0xad, 0xb6, 0x8b,
+		0xfb, 0x1f, 0x4e, 0x05, 0x93, 0xc1, 0xf4, 0xe7, 0xda, 0x2b, 0x25, 0xf3, 0x66, 0xdd, 0x10, 0x3f,
+		0x5b, 0x85, 0xb2, 0x39, 0x42, 0xc1, 0x0a, 0x14, 0x4a, 0xe7, 0x40, 0x45, 0x01, 0xa3, 0xfd, 0x51,
+		0x02, 0xd2, 0x82, 0x34, 0x4e, 0x23, 0xd7, 0xac, 0x99, 0x65, 0xcf, 0x11, 0x67, 0x9e, 0xfd, 0x6f,
+		0x42, 0x20, 0x59, 0xe5, 0x43, 0x94, 0xb9, 0xbc, 0x4f, 0xc7, 0x0f, 0x2c, 0xf3, 0x9f, 0xd0, 0xc0,
+		0x32, 0x7c, 0x59, 0x63, 0x02, 0x52, 0x75, 0x47, 0x9c, 0x04, 0xb9, 0xbc, 0x4f, 0xa7, 0x5f, 0x24,
+		0x0b, 0xfd, 0x38, 0x9b, 0xc4, 0xee, 0xe7, 0xe5, 0x7d, 0x3a, 0xff, 0x26, 0x07, 0xf0, 0x58, 0xa1,
+		0x57, 0x66, 0xb7, 0x5b, 0xb1, 0x82, 0x7d, 0x92, 0x0b, 0xd0, 0xcf, 0x5e, 0x6a, 0xcb, 0xa6, 0xc5,
+		0x19, 0x80, 0x40, 0x18, 0xec, 0x49, 0x7c, 0xe4, 0x7b, 0xd5, 0xf0, 0x3c, 0xb3, 0x61, 0x23, 0x41,
+		0x06, 0x8e, 0xd9, 0x86, 0x4d, 0xa7, 0xc2, 0x7e, 0x0d, 0x39, 0xa3, 0xd3, 0xbf, 0xf1, 0xf6, 0x68,
+		0x83, 0xeb, 0x43, 0x89, 0x56, 0xd2, 0xdd, 0x35, 0x7d, 0x48, 0x14, 0xe6, 0x11, 0xa8, 0x08, 0xe3,
+		0x46, 0x85, 0xfd, 0xaa, 0x05, 0x6e, 0xf6, 0x58, 0x74, 0x6d, 0x63, 0xdb, 0x7a, 0xed, 0xc6, 0x82,
+		0x04, 0x08, 0x79, 0x0e, 0x9f, 0xcf, 0xc0, 0x40, 0x9d, 0x31, 0xa5, 0x3d, 0x05, 0x63, 0x2d, 0x9c,
+		0x22, 0x7f, 0xd7, 0x2c, 0xbb, 0x22, 0xde, 0x15, 0xc1, 0xbf, 0xb1, 0x8c, 0xfe, 0x5e, 0x08, 0xcb,
+		0x19, 0xd1, 0xbf, 0xf3, 0xdf, 0xd1, 0xfe, 0x29, 0xa6, 0x11, 0xe9, 0x29, 0x26, 0xa3, 0x6e, 0xe5,
+		0x33, 0x94, 0x3e, 0x7f, 0x80, 0x69, 0xb6, 0xf5, 0x01, 0xa6, 0xaa, 0x69, 0x8b, 0x84, 0x01, 0x56,
+		0x19, 0x75, 0xcb, 0xa5, 0xea, 0x18, 0xfc, 0x80, 0x89, 0xfb, 0x94, 0xf4, 0x37, 0x7d, 0x8f, 0x29,
+		0x35, 0x3f, 0xbb, 0xba, 0xe0, 0xeb, 0xf1, 0x6f, 0x24, 0xe0, 0x88, 0xa4, 0xc7, 0x12, 0x70, 0xab,
+		0x3a, 0xe7, 0xe2, 0x35, 0xbe, 0x8b, 0x77, 0x98, 0x9e, 0x81, 0x14, 0xc2, 0x93, 0x63, 0x7b, 0xff,
+		0x82, 0x60, 0xf6, 0xe7, 0x7e, 0xfb, 0x5f, 0xb3, 0x5f, 0x5c, 0x8c, 0x1f, 0x15, 0x4a, 0x24, 0xff,
+		0x5d, 0xdd, 0xcb, 0x4f, 0x0d, 0x7e, 0x18, 0xc5, 0xbd, 0x7b, 0x62, 0x8c, 0xca, 0xf0, 0x8f, 0xe7,
+		0xe1, 0x80, 0x94, 0x85, 0x61, 0x56, 0xb2, 0x35, 0xbf, 0xd3, 0x83, 0xd9, 0x6d, 0xf7, 0xe4, 0xc6,
+		0x5e, 0x23, 0x75, 0xa7, 0x99, 0x22, 0x17, 0xc6, 0xde, 0x82, 0xcc, 0xd0, 0x9d, 0x7e, 0x61, 0xcc,
+		0x0f, 0xf8, 0xd7, 0x02, 0x98, 0x36, 0x07, 0xc7, 0xff, 0x21, 0xe0, 0x95, 0x47, 0xa7, 0x27, 0xa7,
+		0xdb, 0xae, 0x11, 0xd3, 0xd2, 0x02, 0xa1, 0x4b, 0x98, 0xda, 0x7b, 0x15, 0x20, 0x72, 0xab, 0xdc,
+		0xa4, 0xfb, 0x81, 0x85, 0xd2, 0x4d, 0x60, 0x31, 0x1f, 0xc3, 0xcc, 0xa9, 0x8e, 0xcc, 0xb0, 0xa6,
+		0x42, 0xdc, 0x9c, 0x07, 0xd5, 0x67, 0x46, 0x48, 0xe0, 0x78, 0x5c, 0xe0, 0x17, 0x8a, 0xef, 0xb4,
+		0xa7, 0x25, 0xc9, 0xf9, 0x5d, 0x38, 0x03, 0x29, 0x84, 0xe1, 0xb1, 0x54, 0xbb, 0x1e, 0x50, 0x18,
+		0xed, 0x3d, 0x0a, 0x1c, 0xf6, 0x29, 0x48, 0x61, 0x47, 0xf7, 0x3c, 0xdc, 0xb5, 0x01, 0xf9, 0x03,
+		0x05, 0x8e, 0xc4, 0xb3, 0xc2, 0xfb, 0x65, 0xa3, 0x73, 0x2b, 0x8a, 0x4b, 0xc2, 0xbe, 0x8a, 0x91,
+		0x3a, 0x16, 0x1f, 0xce, 0x09, 0xec, 0xfc, 0x61, 0xbe, 0xab, 0x30, 0xde, 0x5a, 0xe7, 0xea, 0xe3,
+		0xad, 0x07, 0xaf, 0xee, 0xe2, 0xe0, 0x7e, 0xbf, 0x02, 0xf7, 0xf9, 0x3d, 0x8b, 0x89, 0x0f, 0xff,
+		0x2e, 0xa4, 0xfd, 0x19, 0x05, 0xee, 0xef, 0xc0, 0x13, 0x17, 0xfb, 0x55, 0x18, 0x0f, 0x02, 0xd6,
+		0xa8, 0xd4, 0x3b, 0x86, 0xbe, 0x4c, 0xd9, 0x88, 0x4f, 0xe1, 0x1e, 0x88, 0x77, 0x1e, 0x34, 0xbf,
+		0x27, 0x71, 0x81, 0x67, 0xd7, 0xb3, 0xc9, 0x82, 0x13, 0x7b, 0x12, 0xf2, 0x4f, 0xe4, 0x49, 0x7b,
+		0xb9, 0xc8, 0xb5, 0xd6, 0x39, 0xfc, 0x15, 0x47, 0xe9, 0x44, 0x00, 0xf5, 0x34, 0xe4, 0xfc, 0xa6,
+		0xe4, 0xc0, 0xb4, 0x6b, 0x5e, 0x5f, 0x84, 0xc3, 0xb1, 0x04, 0x38, 0x8f, 0xcf, 0x44, 0xb6, 0x74,
+		0x7b, 0x48, 0xad, 0xb6, 0x5c, 0x87, 0xd1, 0x1a, 0x70, 0x80, 0xb7, 0x15, 0x4c, 0x12, 0xc6, 0xe8,
+		0x83, 0x6d, 0x73, 0x75, 0x31, 0xd9, 0xb8, 0xe3, 0x71, 0xd9, 0xb8, 0xf0, 0x0d, 0x91, 0x20, 0x47,
+		0xf4, 0x22, 0x1c, 0x6c, 0x69, 0x93, 0xf7, 0x6d, 0x05, 0xc6, 0x63, 0xec, 0x80, 0x7f, 0x09, 0x7d,
+		0x4f, 0x33, 0x80, 0x81, 0x6b, 0xb4, 0x4c, 0x7b, 0x3b, 0x4c, 0xd2, 0xb6, 0x62, 0xf4, 0xf7, 0xde,
+		0x77, 0xd4, 0x80, 0xa9, 0xf6, 0x8d, 0xf3, 0x1e, 0xbf, 0x11, 0xfa, 0xd9, 0x04, 0xe2, 0x9d, 0xec,
+		0x72, 0xd6, 0x71, 0x24, 0xed, 0xe3, 0x0a, 0x6f, 0xc3, 0x8f, 0xb8, 0x63, 0x6c, 0x4f, 0x4f, 0x3d,
+		0xbc, 0x4b, 0x56, 0x48, 0x12, 0xc3, 0xa7, 0x15, 0x38, 0xbe, 0x07, 0x8f, 0x5c, 0x10, 0xcf, 0xbe,
+		0xa6, 0x25, 0x80, 0x49, 0xe5, 0xde, 0xda, 0xfa, 0x4f, 0x2a, 0xf0, 0x40, 0xb8, 0x1f, 0x7b, 0x19,
+		0xfc, 0xbf, 0x63, 0xa1, 0xff, 0x07, 0x05, 0xce, 0x74, 0xc3, 0xec, 0xff, 0x2d, 0x2b, 0xc1, 0x5f,
+		0x28, 0x70, 0x88, 0xf6, 0x47, 0x4e, 0xba, 0xdc, 0x99, 0xb0, 0x4f, 0x83, 0x8a, 0xe7, 0xf3, 0x63,
+		0xe6, 0xf1, 0x88, 0xdb, 0x28, 0x4b, 0x37, 0xf2, 0x10, 0x12, 0x8f, 0xe0, 0xb7, 0xee, 0xbe, 0xeb,
+		0x23, 0x15, 0xd7, 0x2b, 0xb4, 0x5d, 0xbb, 0x53, 0x77, 0x61, 0x00, 0xff, 0x44, 0x81, 0x5c, 0x5c,
+		0x87, 0xf9, 0x80, 0x5d, 0x87, 0x03, 0xa1, 0x83, 0xef, 0xd1, 0x31, 0x9b, 0x6a, 0x97, 0xa8, 0xf2,
+		0xa7, 0xcc, 0x51, 0xee, 0x35, 0xed, 0x8f, 0xab, 0x75, 0xf1, 0x77, 0x92, 0xef, 0xe9, 0x6c, 0xfa,
+		0x88, 0xe2, 0xaf, 0x93, 0xbe, 0x55, 0xd8, 0xb2, 0xee, 0x6c, 0x44, 0xef, 0xfe, 0xf4, 0xf9, 0x40,
+		0xe0, 0x3c, 0x87, 0xb9, 0xfb, 0xbb, 0x8d, 0x25, 0x9a, 0x70, 0xa8, 0x95, 0xab, 0x7b, 0xbf, 0x90,
+		0x5d, 0x8e, 0x1b, 0xaa, 0x3b, 0x0a, 0x4a, 0x76, 0xe1, 0x68, 0xcb, 0xda, 0x1f, 0xf2, 0xe5, 0xee,
+		0x5d, 0x27, 0xde, 0xa7, 0xc0, 0xb1, 0x76, 0x6d, 0xf3, 0x9e, 0x7c, 0x33, 0x8f, 0x1a, 0x6a, 0xdf,
+		0x02, 0x5a, 0x84, 0x1d, 0x9a, 0x89, 0x7c, 0x0d, 0xf2, 0x90, 0x3a, 0xfb, 0xfb, 0x8a, 0xef, 0xf0,
+		0xc6, 0x53, 0xe7, 0x3d, 0x2e, 0x46, 0x7b, 0x7c, 0x7f, 0xfc, 0x42, 0x1b, 0x39, 0x32, 0x16, 0xf1,
+		0x79, 0x49, 0x35, 0x48, 0x89, 0xde, 0x23, 0xb1, 0x31, 0xfa, 0xda, 0x86, 0x1f, 0x48, 0xca, 0xd3,
+		0xb2, 0x78, 0xf3, 0x35, 0x8a, 0xeb, 0x69, 0x38, 0xda, 0x86, 0x2c, 0x97, 0xd3, 0x84, 0x3c, 0xdf,
+		0x33, 0x7c, 0x5e, 0x4b, 0x04, 0xde, 0x0a, 0xf7, 0x85, 0x09, 0x3c, 0x1b, 0x3e, 0x85, 0xf2, 0x1a,
+		0xf9, 0xfb, 0x56, 0x3f, 0xa2, 0x6b, 0x47, 0x9e, 0xf3, 0xf9, 0x40, 0xcc, 0xa1, 0x19, 0x46, 0x7e,
+		0x8f, 0x73, 0x31, 0x8f, 0xf3, 0xe9, 0x1d, 0x7e, 0x3e, 0x45, 0xca, 0xd6, 0xc4, 0xbd, 0xb1, 0xa6,
+		0x2d, 0xc1, 0xe1, 0x58, 0x2c, 0xce, 0xc9, 0x34, 0xa4, 0x70, 0x77, 0x2b, 0xab, 0xb4, 0xee, 0x31,
+		0x47, 0x30, 0x28, 0x9c, 0x46, 0x78, 0x9a, 0x04, 0x77, 0x66, 0x78, 0xd3, 0x7e, 0x0e, 0x84, 0x95,
+		0x05, 0xe6, 0x86, 0x9f, 0xf2, 0x6b, 0x31, 0x37, 0x08, 0x27, 0xcc, 0x0d, 0xc2, 0x68, 0x13, 0x3c,
+		0x11, 0xc4, 0x36, 0x9a, 0x05, 0xd9, 0x79, 0x18, 0x0f, 0x95, 0x72, 0xc2, 0x3d, 0x6f, 0x55, 0x6b,
+		0x87, 0xb9, 0x39, 0x9e, 0x93, 0x4f, 0xff, 0x89, 0x56, 0xde, 0x25, 0x16, 0xb8, 0x48, 0x2d, 0x6f,
+		0xcd, 0xf4, 0xbb, 0x71, 0x8f, 0x66, 0x0c, 0x93, 0xc0, 0x61, 0x7f, 0xc5, 0xd8, 0xb2, 0xfc, 0x6b,
+		0x54, 0x82, 0xc5, 0x7f, 0x9b, 0x80, 0x5c, 0x5c, 0xad, 0x1f, 0x9b, 0x64, 0xfc, 0x03, 0xfc, 0xfe,
+		0xab, 0x5b, 0x1d, 0x6e, 0xf0, 0x05, 0x18, 0x64, 0x1b, 0xf8, 0xb1, 0x77, 0x7e, 0x6c, 0x9d, 0x1d,
+		0xd1, 0x28, 0xf6, 0x7c, 0x5c, 0x70, 0x3c, 0x74, 0xa4, 0x9e, 0xd2, 0xd2, 0xf4, 0x41, 0xf6, 0xc9,
+		0x8e, 0x9f, 0xe3, 0xfd, 0x72, 0x76, 0x11, 0xe5, 0x0e, 0xdf, 0x0d, 0x61, 0xd8, 0xd2, 0xf6, 0x59,
+		0xaa, 0xfb, 0xed, 0xb3, 0x73, 0x5f, 0x3b, 0x0c, 0x7d, 0x54, 0x8a, 0xc4, 0x80, 0x3e, 0x76, 0xa5,
+		0x29, 0x74, 0xf5, 0xb0, 0x25, 0x01, 0x9a, 0x3b, 0xd6, 0xae, 0x9a, 0x47, 0xad, 0x87, 0xbe, 0xe3,
+		0xf7, 0xbe, 0xf0, 0x83, 0x89, 0x71, 0x32, 0x76, 0x36, 0x92, 0x55, 0x75, 0xc9, 0x0e, 0x7f, 0xa2,
+		0xe5, 0x48, 0x2c, 0x09, 0xd1, 0xc0, 0xd1, 0x36, 0xb5, 0x9c, 0xfe, 0x69, 0x4a, 0x5f, 0x23, 0x53,
+		0x2d, 0xf4, 0xcf, 0xbe, 0x5d, 0x5e, 0x50, 0xdf, 0x81, 0x9b, 0xf3, 0xa3, 0x91, 0xa4, 0x1d, 0x39,
+		0x15, 0x4b, 0xbc, 0x35, 0x04, 0xca, 0x9d, 0xee, 0x0c, 0xc8, 0x19, 0x7a, 0x9c, 0x32, 0x34, 0x4d,
+		0x1e, 0xea, 0xc4, 0xd0, 0x59, 0x79, 0x77, 0xf5, 0xd7, 0xf8, 0x19, 0xa9, 0xb8, 0xc8, 0x86, 0x3c,
+		0x12, 0xdb, 0xf8, 0x1e, 0x11, 0x5b, 0xee, 0xd1, 0x1e, 0x30, 0x38, 0xdf, 0x6f, 0xa2, 0x7c, 0x3f,
+		0x49, 0x9e, 0xe8, 0xc8, 0x77, 0xec, 0xc1, 0x10, 0xf2, 0x43, 0xfc, 0xbc, 0xb8, 0x74, 0x62, 0xff,
+		0x64, 0x2c, 0x17, 0x2d, 0x89, 0xa4, 0xdc, 0xa9, 0x8e, 0x70, 0x9c, 0xc7, 0xc7, 0x28, 0x8f, 0x0f,
+		0x93, 0x07, 0x3b, 0xf2, 0x28, 0x3d, 0x93, 0xf2, 0xcb, 0xed, 0xef, 0x3b, 0x4c, 0xc7, 0x36, 0xdc,
+		0x36, 0x3b, 0x97, 0x3b, 0xdb, 0x35, 0x3c, 0x67, 0xf8, 0x0d, 0x94, 0xe1, 0x27, 0xc8, 0xe3, 0x1d,
+		0x19, 0x8e, 0x39, 0x63, 0x42, 0x7e, 0x9c, 0x8b, 0x34, 0x18, 0x2e, 0xa2, 0xc5, 0x70, 0x10, 0xc9,
+		0x02, 0xe5, 0x4e, 0xec, 0x09, 0xc3, 0x39, 0xbb, 0x4c, 0x39, 0xcb, 0x93, 0x37, 0xf7, 0xa2, 0xa6,
+		0x67, 0xdf, 0xce, 0x3f, 0x82, 0x15, 0xff, 0x1d, 0xe4, 0xf7, 0x14, 0x38, 0xd8, 0x46, 0xbb, 0xc8,
+		0x83, 0x2d, 0xac, 0xb4, 0xcf, 0x5e, 0xe5, 0x1e, 0xea, 0x0e, 0x98, 0x77, 0xe0, 0xad, 0xb4, 0x03,
+		0xcf, 0x92, 0x8d, 0xd7, 0xda, 0x81, 0x58, 0x7d, 0x26, 0x3f, 0xcd, 0x27, 0x64, 0x5c, 0xa2, 0x87,
+		0x3c, 0xd4, 0x4e, 0xc2, 0x71, 0x39, 0xab, 0xdc, 0xc3, 0x5d, 0x42, 0xef, 0xad, 0xe4, 0x7b, 0x0f,
+		0xc2, 0x1f, 0x2b, 0x70, 0x3c, 0xc4, 0x6e, 0xac, 0x21, 0x39, 0xdf, 0x9e, 0x93, 0xbd, 0xac, 0xc9,
+		0x13, 0xbd, 0xa2, 0xed, 0xad, 0x63, 0x1c, 0xb5, 0xfb, 0xc1, 0x70, 0xc9, 0x4f, 0x28, 0x30, 0xc6,
+		0xcc, 0xbe, 0x5c, 0x7a, 0x7f, 0x0b, 0x5f, 0x71, 0x19, 0x95, 0xdc, 0xc9, 0x4e, 0x60, 0x9c, 0xdd,
+		0x37, 0x53, 0x76, 0x67, 0xc8, 0x93, 0x3d, 0xb0, 0x1b, 0x3e, 0x23, 0xf3, 0x11, 0x3a, 0x61, 0x65,
+		0xbf, 0x3b, 0xd6, 0x06, 0xc6, 0x24, 0x09, 0x72, 0xa7, 0x3a, 0xc2, 0x71, 0x2e, 0x9f, 0xa4, 0x5c,
+		0x9e, 0x23, 0x8f, 0xf4, 0xc0, 0x25, 0x5b, 0x6f, 0x3f, 0xa1, 0xe0, 0x23, 0x4d, 0x12, 0xd1, 0x18,
+		0x01, 0xc6, 0x45, 0xe3, 0xb9, 0x93, 0x9d, 0xc0, 0x38, 0x6b, 0xf3, 0x94, 0xb5, 0x59, 0xf2, 0x74,
+		0xaf, 0xac, 0x45, 0x97, 0xea, 0x5f, 0x55, 0xd8, 0x5d, 0xc2, 0x96, 0xf0, 0x96, 0x3c, 0xb0, 0xa7,
+		0x6d, 0x0b, 0x19, 0xeb, 0x33, 0xdd, 0x80, 0x72, 0xce, 0x17, 0x28, 0xe7, 0x73, 0x64, 0xb6, 0xa7,
+		0xa1, 0xa7, 0x34, 0x62, 0x78, 0xcf, 0x85, 0x79, 0x97, 0xa3, 0xd5, 0xd8, 0x25, 0x67, 0x8f, 0xa0,
+		0x39, 0x77, 0xb6, 0x6b, 0x78, 0xde, 0x95, 0x19, 0xda, 0x95, 0xc7, 0xc9, 0xb9, 0xde, 0xbb, 0x42,
+		0x7e, 0x52, 0x01, 0x35, 0x1a, 0x37, 0x92, 0xd3, 0x1d, 0x34, 0xd3, 0x8f, 0x58, 0x73, 0x0f, 0x74,
+		0x01, 0xc9, 0xb9, 0x7c, 0x9a, 0x72, 0x79, 0x91, 0x5c, 0xe8, 0x59, 0x55, 0xf0, 0xc7, 0x3e, 0x1b,
+		0x36, 0xf9, 0x8c, 0xc2, 0x2e, 0x40, 0xb4, 0x0b, 0x23, 0x63, 0x9d, 0xa6, 0x3d, 0x03, 0xda, 0xdc,
+		0xa3, 0x3d, 0x60, 0xf0, 0x6e, 0xcc, 0xd1, 0x6e, 0xbc, 0x91, 0x3c, 0xd5, 0x43, 0x37, 0xa2, 0x41,
+		0x2d, 0xf9, 0x28, 0xbf, 0x2c, 0x1a, 0x79, 0xf2, 0xb3, 0x75, 0xd6, 0xc5, 0x06, 0xb5, 0xb9, 0x53,
+		0x1d, 0xe1, 0x38, 0xb3, 0x17, 0x28, 0xb3, 0x8f, 0x92, 0xb3, 0x6d, 0x98, 0x95, 0x4e, 0x70, 0xe2,
+		0xb1, 0xc5, 0xb3, 0x6f, 0x67, 0xd1, 0xf1, 0x3b, 0x48, 0x0d, 0x7f, 0xd8, 0x69, 0xcb, 0xa2, 0x07,
+		0x0d, 0x5b, 0x9d, 0x75, 0x29, 0x50, 0xcc, 0x1d, 0x6d, 0x53, 0xcb, 0x39, 0x38, 0x41, 0x39, 0x38,
+		0x4a, 0x0e, 0xb7, 0xe1, 0x00, 0xc3, 0x3c, 0x62, 0xfa, 0x0f, 0x77, 0xb4, 0xc6, 0x16, 0xa1, 0xe0,
+		0x37, 0x37, 0xd9, 0xb6, 0x9e, 0xb7, 0x97, 0xa3, 0xed, 0x4d, 0x10, 0x22, 0xb7, 0xc7, 0xcf, 0x66,
+		0x7f, 0x17, 0x5f, 0x52, 0x42, 0x21, 0x6d, 0x8c, 0x45, 0x8c, 0x0b, 0x88, 0x73, 0x27, 0x3b, 0x81,
+		0x71, 0x06, 0x34, 0xca, 0xc0, 0x11, 0x92, 0x93, 0x19, 0x08, 0x5f, 0xd0, 0x23, 0xef, 0x80, 0xe1,
+		0x50, 0xcc, 0x1a, 0x6b, 0x95, 0x5b, 0x23, 0xde, 0xdc, 0xc9, 0x4e, 0x60, 0x9c, 0x87, 0xa3, 0x94,
+		0x87, 0x83, 0x64, 0xbf, 0xcc, 0x83, 0xff, 0x56, 0xc0, 0x5d, 0x3c, 0x31, 0xf9, 0x07, 0x1a, 0x8c,
+		0x4b, 0x4d, 0x78, 0x37, 0x63, 0xce, 0xf6, 0xec, 0xf1, 0x1c, 0x6f, 0xc7, 0xe7, 0x76, 0xdb, 0x3c,
+		0xf0, 0x7b, 0xe7, 0x8f, 0xf0, 0xb6, 0x39, 0xe8, 0xf3, 0x9f, 0x93, 0x30, 0xbc, 0xe4, 0x56, 0xe7,
+		0x1a, 0xa6, 0xe1, 0x99, 0x74, 0x9d, 0x8c, 0x3c, 0x4b, 0xa9, 0xf4, 0xfc, 0x2c, 0x65, 0x9b, 0xa7,
+		0x1a, 0x13, 0xdf, 0x94, 0xa7, 0x1a, 0xe3, 0xef, 0xaa, 0x24, 0xef, 0xca, 0x5d, 0x95, 0x54, 0x0f,
+		0xd7, 0x3c, 0xce, 0x8b, 0xdf, 0xdc, 0xec, 0xeb, 0x2e, 0xff, 0xc2, 0xa0, 0xf1, 0x37, 0x0a, 0xa4,
+		0x43, 0x02, 0xfd, 0x9d, 0x5f, 0xd2, 0x6d, 0x39, 0x1a, 0x20, 0xe5, 0x07, 0x0f, 0xc2, 0xfe, 0xd0,
+		0xd0, 0xfa, 0xb3, 0xe4, 0x6f, 0x13, 0x30, 0xb8, 0xe4, 0x56, 0x8b, 0x15, 0xcb, 0xbb, 0x3b, 0x43,
+		0x7e, 0x3e, 0xf6, 0x56, 0x0f, 0x09, 0x6e, 0x1f, 0xc5, 0x0b, 0xe9, 0x66, 0xbc, 0xa6, 0xf0, 0x47,
+		0xcc, 0xee, 0xa5, 0x96, 0xec, 0xc0, 0x68, 0xfc, 0xf3, 0x40, 0x85, 0x3b, 0x79, 0x9e, 0xa4, 0xe5,
+		0x59, 0xa0, 0x91, 0xf0, 0xab, 0xc6, 0xd2, 0x98, 0xec, 0x87, 0x71, 0x49, 0xf2, 0xfe, 0x88, 0xfc,
+		0x17, 0x05, 0x46, 0x97, 0xdc, 0xaa, 0xb4, 0xc8, 0x9b, 0xaf, 0x97, 0x5b, 0x57, 0x17, 0xfc, 0xdf,
+		0x4f, 0x4c, 0x76, 0xa7, 0xca, 0x1c, 0x5c, 0xea, 0xf4, 0x21, 0x38, 0x18, 0xe9, 0x9c, 0xdf, 0xf1,
+		0x4f, 0x25, 0xe0, 0x00, 0xaf, 0xcb, 0x9b, 0x55, 0xcb, 0xf6, 0xa3, 0x15, 0xf3, 0xef, 0xef, 0x0b,
+		0x70, 0x81, 0x44, 0x53, 0x77, 0x2a, 0x51, 0x07, 0x8e, 0xc5, 0x4b, 0xcd, 0x4f, 0x02, 0x2f, 0xb5,
+		0xbe, 0x42, 0xa8, 0xf4, 0xf0, 0xe3, 0x17, 0x91, 0xb7, 0x06, 0x71, 0x5b, 0x7b, 0x8c, 0xb7, 0xb8,
+		0x61, 0x57, 0xfe, 0xfe, 0xa9, 0xe8, 0x8b, 0x70, 0xa8, 0xa5, 0x7b, 0xf7, 0x4a, 0x96, 0xbf, 0xa2,
+		0xd0, 0xc6, 0xd6, 0x4c, 0x6a, 0x03, 0xa2, 0xbe, 0xfc, 0xeb, 0xfa, 0x2a, 0xf6, 0x09, 0x38, 0xde,
+		0x96, 0x73, 0x7f, 0x4e, 0xff, 0xbc, 0x42, 0xb5, 0x53, 0x54, 0x87, 0x22, 0x17, 0xfe, 0x74, 0xc5,
+		0xeb, 0xe4, 0xf9, 0xe3, 0xa0, 0x63, 0xa7, 0xe1, 0xe4, 0xde, 0x2c, 0xfb, 0xbd, 0x33, 0xe0, 0x50,
+		0x04, 0x52, 0xca, 0x02, 0xbf, 0x86, 0x9b, 0xa1, 0x2d, 0x52, 0x8e, 0x6f, 0xc2, 0xe7, 0xe3, 0x13,
+		0x0a, 0x64, 0x97, 0xdc, 0xea, 0xa5, 0xa6, 0x5d, 0x11, 0x10, 0x81, 0x6b, 0x5f, 0xf6, 0x67, 0x04,
+		0xdb, 0xa7, 0xda, 0x63, 0x46, 0x3c, 0xc2, 0x37, 0xa9, 0xba, 0xb8, 0xee, 0x32, 0xcd, 0x1f, 0xf6,
+		0x60, 0xa4, 0xf1, 0xd9, 0xeb, 0x8a, 0x59, 0x77, 0x5c, 0xcb, 0x73, 0x1a, 0x7c, 0x0f, 0x3f, 0x28,
+		0x90, 0xba, 0xa3, 0xc1, 0x54, 0x3b, 0x46, 0xfd, 0xde, 0xfc, 0x46, 0x02, 0x86, 0x50, 0xb3, 0xc4,
+		0xeb, 0x1b, 0x48, 0xdc, 0x68, 0x7a, 0xdb, 0xec, 0x67, 0x0e, 0xd9, 0x56, 0x68, 0x50, 0xf0, 0x9a,
+		0xac, 0x45, 0xec, 0xdb, 0x1a, 0xc9, 0xbb, 0xf4, 0xb6, 0x46, 0xea, 0x9b, 0xf2, 0xb6, 0x46, 0x9f,
+		0xf4, 0xb6, 0x46, 0x20, 0xe7, 0x03, 0x30, 0x21, 0x8b, 0xd0, 0x97, 0xed, 0x06, 0x75, 0xf1, 0x37,
+		0x6c, 0x04, 0xa7, 0xb2, 0xbd, 0x3b, 0x5a, 0xca, 0xdc, 0xcb, 0x80, 0xac, 0x68, 0xef, 0xdc, 0x5f,
+		0x0d, 0x40, 0x72, 0xc9, 0xad, 0x92, 0x2b, 0x00, 0x52, 0x5c, 0x71, 0x48, 0xf6, 0x27, 0x43, 0x7e,
+		0x69, 0xee, 0x78, 0xdb, 0x2a, 0xdf, 0x04, 0x17, 0x20, 0xed, 0xbb, 0xab, 0x07, 0x23, 0xe0, 0xa2,
+		0x22, 0x37, 0xd9, 0xa6, 0xc2, 0xa7, 0xb2, 0x08, 0x69, 0x3e, 0xad, 0x4d, 0x72, 0x38, 0x02, 0x2c,
+		0xbb, 0x27, 0xb9, 0x13, 0x7b, 0x54, 0xfa, 0xd4, 0x0c, 0x18, 0x8d, 0xfa, 0x2c, 0x5a, 0x0c, 0x5e,
+		0x04, 0x26, 0x77, 0xa6, 0x33, 0x8c, 0xf4, 0x4e, 0x2a, 0x48, 0xcb, 0xed, 0xd1, 0x18, 0xcc, 0xa0,
+		0x3a, 0x77, 0xff, 0x9e, 0xd5, 0x3e, 0xcd, 0x17, 0x81, 0xac, 0x99, 0x5e, 0x74, 0xd9, 0x89, 0x22,
+		0xc7, 0xdb, 0xf8, 0xdc, 0xc3, 0x5d, 0x81, 0xf9, 0x6d, 0xed, 0xc2, 0xc1, 0xc0, 0x8c, 0x85, 0x97,
+		0x80, 0xa8, 0x18, 0xf6, 0xb0, 0xbd, 0xb9, 0x73, 0xdd, 0xc3, 0x4a, 0x77, 0x13, 0x0e, 0xb4, 0x31,
+		0xd2, 0xf7, 0xef, 0x41, 0x2d, 0x00, 0xcb, 0x3d, 0xdc, 0x15, 0x98, 0xdf, 0x5e, 0x15, 0xc6, 0xd0,
+		0xc4, 0x85, 0xed, 0xf0, 0x7d, 0x11, 0x1a, 0xb1, 0x46, 0x30, 0xf7, 0x50, 0x37, 0x50, 0x7e, 0x43,
+		0xf3, 0x90, 0x09, 0xcc, 0x64, 0x36, 0x3a, 0x1e, 0xa2, 0x26, 0x37, 0xd5, 0xae, 0xc6, 0x27, 0x74,
+		0x05, 0x20, 0x98, 0xbd, 0x2d, 0xf3, 0x33, 0xa8, 0xca, 0x1d, 0x6f, 0x5b, 0x25, 0x68, 0xdd, 0xbd,
+		0xcc, 0xca, 0xff, 0x19, 0x00, 0x02, 0x38, 0x35, 0x2d, 0x69, 0xc6, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -1898,6 +2687,63 @@ func DefiDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descripto
 	}
 	return d
 }
+func (this *CommissionRates) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommissionRates)
+	if !ok {
+		that2, ok := that.(CommissionRates)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	if !this.MaxRate.Equal(that1.MaxRate) {
+		return false
+	}
+	if !this.MaxChangeRate.Equal(that1.MaxChangeRate) {
+		return false
+	}
+	return true
+}
+func (this *Commission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Commission)
+	if !ok {
+		that2, ok := that.(Commission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.CommissionRates.Equal(&that1.CommissionRates) {
+		return false
+	}
+	if !this.UpdateTime.Equal(that1.UpdateTime) {
+		return false
+	}
+	return true
+}
 func (this *Description) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1967,6 +2813,66 @@ func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RedelegationEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RedelegationEntry)
+	if !ok {
+		that2, ok := that.(RedelegationEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CreationHeight != that1.CreationHeight {
+		return false
+	}
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
+	}
+	if !this.InitialBalance.Equal(that1.InitialBalance) {
+		return false
+	}
+	if !this.SharesDst.Equal(that1.SharesDst) {
+		return false
+	}
+	return true
+}
+func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RedelegationEntryResponse)
+	if !ok {
+		that2, ok := that.(RedelegationEntryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RedelegationEntry.Equal(&that1.RedelegationEntry) {
+		return false
+	}
+	if !this.Balance.Equal(that1.Balance) {
+		return false
+	}
+	return true
+}
 func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1995,7 +2901,7 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.CommunityTax.Equal(that1.CommunityTax) {
 		return false
 	}
-	if !this.CommissionRate.Equal(that1.CommissionRate) {
+	if !this.MaxCommissionRate.Equal(that1.MaxCommissionRate) {
 		return false
 	}
 	if !this.MarketRate.Equal(that1.MarketRate) {
@@ -2013,6 +2919,29 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.HistoricalEntries != that1.HistoricalEntries {
 		return false
 	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	if len(this.EvidenceHandlers) != len(that1.EvidenceHandlers) {
+		return false
+	}
+	for i := range this.EvidenceHandlers {
+		if this.EvidenceHandlers[i] != that1.EvidenceHandlers[i] {
+			return false
+		}
+	}
+	if !this.AnnualProvisionCap.Equal(that1.AnnualProvisionCap) {
+		return false
+	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if !this.InflationDecay.Equal(that1.InflationDecay) {
+		return false
+	}
+	if !this.GoalBonded.Equal(that1.GoalBonded) {
+		return false
+	}
 	return true
 }
 func (this *Pool) Equal(that interface{}) bool {
@@ -2089,7 +3018,7 @@ func (m *HistoricalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Description) Marshal() (dAtA []byte, err error) {
+func (m *CommissionRates) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2099,40 +3028,134 @@ func (m *Description) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Description) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommissionRates) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommissionRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Details) > 0 {
-		i -= len(m.Details)
-		copy(dAtA[i:], m.Details)
-		i = encodeVarintDefi(dAtA, i, uint64(len(m.Details)))
-		i--
-		dAtA[i] = 0x2a
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDefi(dAtA, i, uint64(size))
 	}
-	if len(m.SecurityContact) > 0 {
-		i -= len(m.SecurityContact)
-		copy(dAtA[i:], m.SecurityContact)
-		i = encodeVarintDefi(dAtA, i, uint64(len(m.SecurityContact)))
-		i--
-		dAtA[i] = 0x22
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxRate.Size()
+		i -= size
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDefi(dAtA, i, uint64(size))
 	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintDefi(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x1a
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDefi(dAtA, i, uint64(size))
 	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Commission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Commission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintDefi(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CommissionRates.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDefi(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Description) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Description) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintDefi(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintDefi(dAtA, i, uint64(len(m.SecurityContact)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintDefi(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
 		i = encodeVarintDefi(dAtA, i, uint64(len(m.Identity)))
 		i--
 		dAtA[i] = 0x12
@@ -2177,12 +3200,22 @@ func (m *Defi) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x5a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
-	if err2 != nil {
-		return 0, err2
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDefi(dAtA, i, uint64(size))
 	}
-	i -= n2
-	i = encodeVarintDefi(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x52
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintDefi(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x4a
 	if m.UnbondingHeight != 0 {
@@ -2200,6 +3233,24 @@ func (m *Defi) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x3a
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintDefi(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x32
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.DelegatorShares.Size()
 		i -= size
@@ -2341,6 +3392,87 @@ func (m *DDPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DDDTriplet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DDDTriplet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DDDTriplet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DefiDstAddress) > 0 {
+		i -= len(m.DefiDstAddress)
+		copy(dAtA[i:], m.DefiDstAddress)
+		i = encodeVarintDefi(dAtA, i, uint64(len(m.DefiDstAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DefiSrcAddress) > 0 {
+		i -= len(m.DefiSrcAddress)
+		copy(dAtA[i:], m.DefiSrcAddress)
+		i = encodeVarintDefi(dAtA, i, uint64(len(m.DefiSrcAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDefi(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DDDTriplets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DDDTriplets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DDDTriplets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Triplets) > 0 {
+		for iNdEx := len(m.Triplets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Triplets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDefi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Delegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2479,12 +3611,12 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x1a
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err4 != nil {
-		return 0, err4
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n4
-	i = encodeVarintDefi(dAtA, i, uint64(n4))
+	i -= n8
+	i = encodeVarintDefi(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -2495,7 +3627,7 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *RedelegationEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2505,40 +3637,53 @@ func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RedelegationEntry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.SharesDst.Size()
+		i -= size
+		if _, err := m.SharesDst.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintDefi(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x22
 	{
-		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.InitialBalance.Size()
+		i -= size
+		if _, err := m.InitialBalance.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintDefi(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x1a
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintDefi(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	if m.CreationHeight != 0 {
+		i = encodeVarintDefi(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *Redelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2548,53 +3693,301 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *Redelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.HistoricalEntries != 0 {
-		i = encodeVarintDefi(dAtA, i, uint64(m.HistoricalEntries))
-		i--
-		dAtA[i] = 0x48
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDefi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.MaxEntries != 0 {
-		i = encodeVarintDefi(dAtA, i, uint64(m.MaxEntries))
+	if len(m.DefiDstAddress) > 0 {
+		i -= len(m.DefiDstAddress)
+		copy(dAtA[i:], m.DefiDstAddress)
+		i = encodeVarintDefi(dAtA, i, uint64(len(m.DefiDstAddress)))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x1a
 	}
-	if m.MaxDefis != 0 {
-		i = encodeVarintDefi(dAtA, i, uint64(m.MaxDefis))
+	if len(m.DefiSrcAddress) > 0 {
+		i -= len(m.DefiSrcAddress)
+		copy(dAtA[i:], m.DefiSrcAddress)
+		i = encodeVarintDefi(dAtA, i, uint64(len(m.DefiSrcAddress)))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x12
 	}
-	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err7 != nil {
-		return 0, err7
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDefi(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i -= n7
-	i = encodeVarintDefi(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.MarketRate.Size()
-		i -= size
-		if _, err := m.MarketRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintDefi(dAtA, i, uint64(size))
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.CommissionRate.Size()
+	return dAtA[:n], nil
+}
+
+func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDefi(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDefi(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDefi(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.RedelegationEntry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDefi(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDefi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Redelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDefi(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDefi(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.InflationDecay.Size()
+		i -= size
+		if _, err := m.InflationDecay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDefi(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDefi(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.AnnualProvisionCap.Size()
+		i -= size
+		if _, err := m.AnnualProvisionCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDefi(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.EvidenceHandlers) > 0 {
+		for iNdEx := len(m.EvidenceHandlers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EvidenceHandlers[iNdEx])
+			copy(dAtA[i:], m.EvidenceHandlers[iNdEx])
+			i = encodeVarintDefi(dAtA, i, uint64(len(m.EvidenceHandlers[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintDefi(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x52
+	if m.HistoricalEntries != 0 {
+		i = encodeVarintDefi(dAtA, i, uint64(m.HistoricalEntries))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxEntries != 0 {
+		i = encodeVarintDefi(dAtA, i, uint64(m.MaxEntries))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxDefis != 0 {
+		i = encodeVarintDefi(dAtA, i, uint64(m.MaxDefis))
+		i--
+		dAtA[i] = 0x38
+	}
+	n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintDefi(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MarketRate.Size()
+		i -= size
+		if _, err := m.MarketRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDefi(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxCommissionRate.Size()
 		i -= size
-		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintDefi(dAtA, i, uint64(size))
@@ -2631,6 +4024,52 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DefiMinter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DefiMinter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DefiMinter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PeriodProvisions.Size()
+		i -= size
+		if _, err := m.PeriodProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDefi(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PeriodStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodStart):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintDefi(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x12
+	if m.Year != 0 {
+		i = encodeVarintDefi(dAtA, i, uint64(m.Year))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Pool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2875,7 +4314,7 @@ func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
+func (m *DefiSlashEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2885,33 +4324,71 @@ func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
+func (m *DefiSlashEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DefiSlashEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reward) > 0 {
-		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDefi(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintDefi(dAtA, i, uint64(size))
 	}
-	if len(m.DefiAddress) > 0 {
-		i -= len(m.DefiAddress)
-		copy(dAtA[i:], m.DefiAddress)
+	i--
+	dAtA[i] = 0x12
+	if m.DefiPeriod != 0 {
+		i = encodeVarintDefi(dAtA, i, uint64(m.DefiPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDefi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DefiAddress) > 0 {
+		i -= len(m.DefiAddress)
+		copy(dAtA[i:], m.DefiAddress)
 		i = encodeVarintDefi(dAtA, i, uint64(len(m.DefiAddress)))
 		i--
 		dAtA[i] = 0xa
@@ -2956,6 +4433,75 @@ func (m *FeePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashDefiProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashDefiProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashDefiProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Jail {
+		i--
+		if m.Jail {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDefi(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.InfractionHeight != 0 {
+		i = encodeVarintDefi(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DefiAddress) > 0 {
+		i -= len(m.DefiAddress)
+		copy(dAtA[i:], m.DefiAddress)
+		i = encodeVarintDefi(dAtA, i, uint64(len(m.DefiAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDefi(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDefi(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDefi(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDefi(v)
 	base := offset
@@ -2984,6 +4530,34 @@ func (m *HistoricalInfo) Size() (n int) {
 	return n
 }
 
+func (m *CommissionRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovDefi(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovDefi(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovDefi(uint64(l))
+	return n
+}
+
+func (m *Commission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommissionRates.Size()
+	n += 1 + l + sovDefi(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovDefi(uint64(l))
+	return n
+}
+
 func (m *Description) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3030,6 +4604,11 @@ func (m *Defi) Size() (n int) {
 	n += 1 + l + sovDefi(uint64(l))
 	l = m.DelegatorShares.Size()
 	n += 1 + l + sovDefi(uint64(l))
+	if m.Jailed {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovDefi(uint64(l))
 	l = m.Description.Size()
 	n += 1 + l + sovDefi(uint64(l))
 	if m.UnbondingHeight != 0 {
@@ -3037,6 +4616,8 @@ func (m *Defi) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime)
 	n += 1 + l + sovDefi(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovDefi(uint64(l))
 	l = m.MinSelfDelegation.Size()
 	n += 1 + l + sovDefi(uint64(l))
 	return n
@@ -3089,6 +4670,42 @@ func (m *DDPairs) Size() (n int) {
 	return n
 }
 
+func (m *DDDTriplet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDefi(uint64(l))
+	}
+	l = len(m.DefiSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovDefi(uint64(l))
+	}
+	l = len(m.DefiDstAddress)
+	if l > 0 {
+		n += 1 + l + sovDefi(uint64(l))
+	}
+	return n
+}
+
+func (m *DDDTriplets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Triplets) > 0 {
+		for _, e := range m.Triplets {
+			l = e.Size()
+			n += 1 + l + sovDefi(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Delegation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3149,6 +4766,51 @@ func (m *UnbondingDelegationEntry) Size() (n int) {
 	return n
 }
 
+func (m *RedelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		n += 1 + sovDefi(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovDefi(uint64(l))
+	l = m.InitialBalance.Size()
+	n += 1 + l + sovDefi(uint64(l))
+	l = m.SharesDst.Size()
+	n += 1 + l + sovDefi(uint64(l))
+	return n
+}
+
+func (m *Redelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDefi(uint64(l))
+	}
+	l = len(m.DefiSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovDefi(uint64(l))
+	}
+	l = len(m.DefiDstAddress)
+	if l > 0 {
+		n += 1 + l + sovDefi(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovDefi(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *DelegationResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3162,6 +4824,36 @@ func (m *DelegationResponse) Size() (n int) {
 	return n
 }
 
+func (m *RedelegationEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RedelegationEntry.Size()
+	n += 1 + l + sovDefi(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovDefi(uint64(l))
+	return n
+}
+
+func (m *RedelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Redelegation.Size()
+	n += 1 + l + sovDefi(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovDefi(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3176,7 +4868,7 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovDefi(uint64(l))
 	l = m.CommunityTax.Size()
 	n += 1 + l + sovDefi(uint64(l))
-	l = m.CommissionRate.Size()
+	l = m.MaxCommissionRate.Size()
 	n += 1 + l + sovDefi(uint64(l))
 	l = m.MarketRate.Size()
 	n += 1 + l + sovDefi(uint64(l))
@@ -3191,6 +4883,38 @@ func (m *Params) Size() (n int) {
 	if m.HistoricalEntries != 0 {
 		n += 1 + sovDefi(uint64(m.HistoricalEntries))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovDefi(uint64(l))
+	if len(m.EvidenceHandlers) > 0 {
+		for _, s := range m.EvidenceHandlers {
+			l = len(s)
+			n += 1 + l + sovDefi(uint64(l))
+		}
+	}
+	l = m.AnnualProvisionCap.Size()
+	n += 1 + l + sovDefi(uint64(l))
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovDefi(uint64(l))
+	l = m.InflationDecay.Size()
+	n += 1 + l + sovDefi(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovDefi(uint64(l))
+	return n
+}
+
+func (m *DefiMinter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Year != 0 {
+		n += 1 + sovDefi(uint64(m.Year))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodStart)
+	n += 1 + l + sovDefi(uint64(l))
+	l = m.PeriodProvisions.Size()
+	n += 1 + l + sovDefi(uint64(l))
 	return n
 }
 
@@ -3290,6 +5014,20 @@ func (m *DelegatorStartingInfo) Size() (n int) {
 	return n
 }
 
+func (m *DefiSlashEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DefiPeriod != 0 {
+		n += 1 + sovDefi(uint64(m.DefiPeriod))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovDefi(uint64(l))
+	return n
+}
+
 func (m *DelegationDelegatorReward) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3324,18 +5062,47 @@ func (m *FeePool) Size() (n int) {
 	return n
 }
 
-func sovDefi(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDefi(x uint64) (n int) {
-	return sovDefi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *DefiAddresses) String() string {
-	if this == nil {
-		return "nil"
+func (m *SlashDefiProposal) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&DefiAddresses{`,
-		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDefi(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDefi(uint64(l))
+	}
+	l = len(m.DefiAddress)
+	if l > 0 {
+		n += 1 + l + sovDefi(uint64(l))
+	}
+	if m.InfractionHeight != 0 {
+		n += 1 + sovDefi(uint64(m.InfractionHeight))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovDefi(uint64(l))
+	if m.Jail {
+		n += 2
+	}
+	return n
+}
+
+func sovDefi(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDefi(x uint64) (n int) {
+	return sovDefi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DefiAddresses) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DefiAddresses{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3465,7 +5232,7 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Description) Unmarshal(dAtA []byte) error {
+func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3488,15 +5255,15 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3524,11 +5291,13 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3556,11 +5325,13 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3588,13 +5359,65 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDefi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDefi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -3604,29 +5427,30 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDefi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDefi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -3636,23 +5460,24 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDefi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDefi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3675,7 +5500,7 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Defi) Unmarshal(dAtA []byte) error {
+func (m *Description) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3698,15 +5523,15 @@ func (m *Defi) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Defi: wiretype end group for non-group")
+			return fmt.Errorf("proto: Description: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Defi: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3734,30 +5559,11 @@ func (m *Defi) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDefi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= BondStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3785,13 +5591,11 @@ func (m *Defi) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3819,67 +5623,13 @@ func (m *Defi) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDefi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDefi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDefi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Website = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
-			}
-			m.UnbondingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDefi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -3889,28 +5639,27 @@ func (m *Defi) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDefi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDefi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3938,9 +5687,7 @@ func (m *Defi) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Details = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3963,7 +5710,7 @@ func (m *Defi) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DefiAddresses) Unmarshal(dAtA []byte) error {
+func (m *Defi) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3986,15 +5733,15 @@ func (m *DefiAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DefiAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: Defi: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DefiAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Defi: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4022,12 +5769,1863 @@ func (m *DefiAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDefi(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+			}
+			m.UnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDefi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DefiAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDefi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DefiAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DefiAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDefi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DDPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDefi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DDPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DDPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefiAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDefi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DDPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDefi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DDPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DDPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, DDPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDefi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DDDTriplet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDefi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DDDTriplet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DDDTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefiSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefiDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDefi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DDDTriplets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDefi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DDDTriplets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DDDTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Triplets = append(m.Triplets, DDDTriplet{})
+			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDefi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDefi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefiAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDefi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDefi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefiAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDefi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDefi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDefi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDefi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDefi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDefi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefiSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefiDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, RedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDefi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDefi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDefi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDefi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDefi(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
@@ -4045,7 +7643,7 @@ func (m *DefiAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DDPair) Unmarshal(dAtA []byte) error {
+func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4068,15 +7666,197 @@ func (m *DDPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DDPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DDPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, RedelegationEntryResponse{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDefi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDefi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintInflation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4104,11 +7884,13 @@ func (m *DDPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4136,61 +7918,47 @@ func (m *DDPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefiAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDefi(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDefi
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketRate", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DDPairs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDefi
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MarketRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DDPairs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DDPairs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4217,66 +7985,72 @@ func (m *DDPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, DDPair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDefi(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDefis", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDefi
+			m.MaxDefis = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDefis |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Delegation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDefi
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -4286,27 +8060,28 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDefi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDefi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceHandlers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4334,11 +8109,11 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefiAddress = string(dAtA[iNdEx:postIndex])
+			m.EvidenceHandlers = append(m.EvidenceHandlers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisionCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4355,74 +8130,24 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDefi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDefi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDefi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDefi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDefi
-			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.AnnualProvisionCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4450,11 +8175,13 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationDecay", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4482,13 +8209,15 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefiAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.InflationDecay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -4498,23 +8227,23 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDefi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDefi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4539,7 +8268,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *DefiMinter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4562,17 +8291,17 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: DefiMinter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DefiMinter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Year", wireType)
 			}
-			m.CreationHeight = 0
+			m.Year = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -4582,14 +8311,14 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				m.Year |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4616,47 +8345,13 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PeriodStart, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDefi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDefi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDefi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodProvisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4684,7 +8379,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PeriodProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4709,7 +8404,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *Pool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4732,17 +8427,17 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -4752,30 +8447,31 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDefi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDefi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -4785,22 +8481,23 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDefi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDefi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4825,7 +8522,7 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DefiHistoricalRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4848,47 +8545,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DefiHistoricalRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DefiHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDefi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDefi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDefi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintInflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4915,15 +8580,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types1.DecCoin{})
+			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
 			}
-			var stringLen uint64
+			m.ReferenceCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -4933,97 +8599,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReferenceCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDefi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDefi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDefi(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDefi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDefi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDefi
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDefi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDefi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDefi
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DefiCurrentRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDefi
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarketRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DefiCurrentRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DefiCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5050,53 +8683,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types1.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDefis", wireType)
-			}
-			m.MaxDefis = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDefi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxDefis |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
-			}
-			m.MaxEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDefi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			m.HistoricalEntries = 0
+			m.Period = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -5106,7 +8702,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				m.Period |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5132,7 +8728,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *DefiOutstandingRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5155,17 +8751,17 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: DefiOutstandingRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DefiOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -5175,31 +8771,81 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDefi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDefi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types1.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDefi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DefiAccumulatedCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDefi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DefiAccumulatedCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DefiAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -5209,23 +8855,23 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDefi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDefi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commission = append(m.Commission, types1.DecCoin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5250,7 +8896,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DefiHistoricalRewards) Unmarshal(dAtA []byte) error {
+func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5273,17 +8919,36 @@ func (m *DefiHistoricalRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DefiHistoricalRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DefiHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
+			}
+			m.PreviousPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -5293,31 +8958,31 @@ func (m *DefiHistoricalRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDefi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDefi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types1.DecCoin{})
-			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.ReferenceCount = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -5327,7 +8992,7 @@ func (m *DefiHistoricalRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReferenceCount |= uint32(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5353,7 +9018,7 @@ func (m *DefiHistoricalRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DefiCurrentRewards) Unmarshal(dAtA []byte) error {
+func (m *DefiSlashEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5376,17 +9041,17 @@ func (m *DefiCurrentRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DefiCurrentRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: DefiSlashEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DefiCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DefiSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiPeriod", wireType)
 			}
-			var msglen int
+			m.DefiPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -5396,31 +9061,16 @@ func (m *DefiCurrentRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DefiPeriod |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDefi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDefi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Rewards = append(m.Rewards, types1.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
 			}
-			m.Period = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -5430,11 +9080,26 @@ func (m *DefiCurrentRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDefi(dAtA[iNdEx:])
@@ -5456,7 +9121,7 @@ func (m *DefiCurrentRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DefiOutstandingRewards) Unmarshal(dAtA []byte) error {
+func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5479,15 +9144,47 @@ func (m *DefiOutstandingRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DefiOutstandingRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DefiOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDefi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefiAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5514,8 +9211,8 @@ func (m *DefiOutstandingRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types1.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reward = append(m.Reward, types1.DecCoin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5540,7 +9237,7 @@ func (m *DefiOutstandingRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DefiAccumulatedCommission) Unmarshal(dAtA []byte) error {
+func (m *FeePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5563,15 +9260,15 @@ func (m *DefiAccumulatedCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DefiAccumulatedCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DefiAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5598,8 +9295,8 @@ func (m *DefiAccumulatedCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commission = append(m.Commission, types1.DecCoin{})
-			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CommunityPool = append(m.CommunityPool, types1.DecCoin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5624,7 +9321,7 @@ func (m *DefiAccumulatedCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
+func (m *SlashDefiProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5647,34 +9344,15 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashDefiProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashDefiProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
-			}
-			m.PreviousPeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDefi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PreviousPeriod |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5702,15 +9380,13 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -5720,62 +9396,25 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDefi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDefi
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDefi
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDefi
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
 			}
@@ -5807,11 +9446,11 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 			}
 			m.DefiAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
 			}
-			var msglen int
+			m.InfractionHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -5821,81 +9460,16 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InfractionHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDefi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDefi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reward = append(m.Reward, types1.DecCoin{})
-			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDefi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDefi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FeePool) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDefi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDefi
@@ -5905,26 +9479,46 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDefi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDefi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommunityPool = append(m.CommunityPool, types1.DecCoin{})
-			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jail", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDefi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jail = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDefi(dAtA[iNdEx:])
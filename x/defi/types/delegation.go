@@ -21,6 +21,12 @@ func (dv DDPair) String() string {
 	return string(out)
 }
 
+// String implements the Stringer interface for a DDDTriplet object.
+func (ddd DDDTriplet) String() string {
+	out, _ := yaml.Marshal(ddd)
+	return string(out)
+}
+
 // NewDelegation creates a new delegation object
 //nolint:interfacer
 func NewDelegation(delegatorAddr sdk.AccAddress, defiAddr sdk.ValAddress, shares sdk.Dec) Delegation {
@@ -181,6 +187,109 @@ func (ubds UnbondingDelegations) String() (out string) {
 	return strings.TrimSpace(out)
 }
 
+func NewRedelegationEntry(creationHeight int64, completionTime time.Time, balance sdk.Int, sharesDst sdk.Dec) RedelegationEntry {
+	return RedelegationEntry{
+		CreationHeight: creationHeight,
+		CompletionTime: completionTime,
+		InitialBalance: balance,
+		SharesDst:      sharesDst,
+	}
+}
+
+// String implements the Stringer interface for a RedelegationEntry object.
+func (e RedelegationEntry) String() string {
+	out, _ := yaml.Marshal(e)
+	return string(out)
+}
+
+// IsMature - is the current entry mature
+func (e RedelegationEntry) IsMature(currentTime time.Time) bool {
+	return !e.CompletionTime.After(currentTime)
+}
+
+// NewRedelegation - create a new redelegation object
+//nolint:interfacer
+func NewRedelegation(
+	delegatorAddr sdk.AccAddress, defiSrcAddr, defiDstAddr sdk.ValAddress,
+	creationHeight int64, minTime time.Time, balance sdk.Int, sharesDst sdk.Dec,
+) Redelegation {
+	return Redelegation{
+		DelegatorAddress: delegatorAddr.String(),
+		DefiSrcAddress:   defiSrcAddr.String(),
+		DefiDstAddress:   defiDstAddr.String(),
+		Entries: []RedelegationEntry{
+			NewRedelegationEntry(creationHeight, minTime, balance, sharesDst),
+		},
+	}
+}
+
+// AddEntry - append entry to the redelegation
+func (red *Redelegation) AddEntry(creationHeight int64, minTime time.Time, balance sdk.Int, sharesDst sdk.Dec) {
+	entry := NewRedelegationEntry(creationHeight, minTime, balance, sharesDst)
+	red.Entries = append(red.Entries, entry)
+}
+
+// RemoveEntry - remove entry at index i to the redelegation
+func (red *Redelegation) RemoveEntry(i int64) {
+	red.Entries = append(red.Entries[:i], red.Entries[i+1:]...)
+}
+
+// MustMarshalRED returns the Redelegation bytes. Panics if fails.
+func MustMarshalRED(cdc codec.BinaryMarshaler, red Redelegation) []byte {
+	return cdc.MustMarshalBinaryBare(&red)
+}
+
+// MustUnmarshalRED unmarshals a redelegation from a store value. Panics if fails.
+func MustUnmarshalRED(cdc codec.BinaryMarshaler, value []byte) Redelegation {
+	red, err := UnmarshalRED(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+
+	return red
+}
+
+// UnmarshalRED unmarshals a redelegation from a store value
+func UnmarshalRED(cdc codec.BinaryMarshaler, value []byte) (red Redelegation, err error) {
+	err = cdc.UnmarshalBinaryBare(value, &red)
+	return red, err
+}
+
+// String returns a human readable string representation of a Redelegation.
+func (red Redelegation) String() string {
+	out := fmt.Sprintf(`Redelegations between:
+  Delegator:                 %s
+  Source Defi:               %s
+  Destination Defi:          %s
+  Entries:
+`,
+		red.DelegatorAddress, red.DefiSrcAddress, red.DefiDstAddress,
+	)
+
+	for i, entry := range red.Entries {
+		out += fmt.Sprintf(`    Redelegation Entry #%d:
+      Creation height:           %v
+      Min time to unbond (unix): %v
+      Dest Shares:               %s
+`,
+			i, entry.CreationHeight, entry.CompletionTime, entry.SharesDst,
+		)
+	}
+
+	return strings.TrimRight(out, "\n")
+}
+
+// Redelegations are a collection of Redelegation
+type Redelegations []Redelegation
+
+func (d Redelegations) String() (out string) {
+	for _, red := range d {
+		out += red.String() + "\n"
+	}
+
+	return strings.TrimSpace(out)
+}
+
 // ----------------------------------------------------------------------------
 // Client Types
 
@@ -225,6 +334,55 @@ func (d DelegationResponses) String() (out string) {
 	return strings.TrimSpace(out)
 }
 
+// NewRedelegationResponse crates a new RedelegationEntryResponse instance.
+//nolint:interfacer
+func NewRedelegationResponse(
+	delegatorAddr sdk.AccAddress, defiSrc, defiDst sdk.ValAddress, entries []RedelegationEntryResponse,
+) RedelegationResponse {
+	return RedelegationResponse{
+		Redelegation: Redelegation{
+			DelegatorAddress: delegatorAddr.String(),
+			DefiSrcAddress:   defiSrc.String(),
+			DefiDstAddress:   defiDst.String(),
+		},
+		Entries: entries,
+	}
+}
+
+// NewRedelegationEntryResponse creates a new RedelegationEntryResponse instance.
+func NewRedelegationEntryResponse(
+	creationHeight int64, completionTime time.Time, sharesDst sdk.Dec, initialBalance, balance sdk.Int) RedelegationEntryResponse {
+	return RedelegationEntryResponse{
+		RedelegationEntry: NewRedelegationEntry(creationHeight, completionTime, initialBalance, sharesDst),
+		Balance:           balance,
+	}
+}
+
+type redelegationRespAlias RedelegationResponse
+
+// MarshalJSON implements the json.Marshaler interface. This is so we can
+// achieve a flattened structure while embedding other types.
+func (r RedelegationResponse) MarshalJSON() ([]byte, error) {
+	return json.Marshal((redelegationRespAlias)(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. This is so we can
+// achieve a flattened structure while embedding other types.
+func (r *RedelegationResponse) UnmarshalJSON(bz []byte) error {
+	return json.Unmarshal(bz, (*redelegationRespAlias)(r))
+}
+
+// RedelegationResponses are a collection of RedelegationResp
+type RedelegationResponses []RedelegationResponse
+
+func (r RedelegationResponses) String() (out string) {
+	for _, red := range r {
+		out += red.String() + "\n"
+	}
+
+	return strings.TrimSpace(out)
+}
+
 // ----------------------------------------------------------------------------
 // Distribution Types
 // create a new DelegatorStartingInfo
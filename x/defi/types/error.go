@@ -42,4 +42,23 @@ var (
 	ErrEmptyWithdrawAddr               = sdkerrors.Register(ModuleName, 29, "withdraw address is empty")
 	ErrBadDistribution                 = sdkerrors.Register(ModuleName, 30, "community pool does not have sufficient coins to defi")
 	ErrNoDefiCommission                = sdkerrors.Register(ModuleName, 31, "no defi commission to withdraw")
+	ErrNoRedelegation                  = sdkerrors.Register(ModuleName, 32, "no redelegation found")
+	ErrSelfRedelegation                = sdkerrors.Register(ModuleName, 33, "cannot redelegate to the same defi")
+	ErrTinyRedelegationAmount          = sdkerrors.Register(ModuleName, 34, "too few tokens to redelegate (truncates to zero tokens)")
+	ErrBadRedelegationDst              = sdkerrors.Register(ModuleName, 35, "redelegation destination defi not found")
+	ErrTransitiveRedelegation          = sdkerrors.Register(ModuleName, 36, "redelegation to this defi already in progress; first redelegation to this defi must complete before next redelegation")
+	ErrMaxRedelegationEntries          = sdkerrors.Register(ModuleName, 37, "too many redelegation entries for (delegator, src-defi, dst-defi) tuple")
+	ErrCommissionNegative              = sdkerrors.Register(ModuleName, 38, "commission must be positive")
+	ErrCommissionHuge                  = sdkerrors.Register(ModuleName, 39, "commission cannot be more than 100%")
+	ErrCommissionGTMaxRate             = sdkerrors.Register(ModuleName, 40, "commission cannot be more than the max rate")
+	ErrCommissionUpdateTime            = sdkerrors.Register(ModuleName, 41, "commission cannot be changed more than once in 24h")
+	ErrCommissionChangeRateNegative    = sdkerrors.Register(ModuleName, 42, "commission change rate must be positive")
+	ErrCommissionChangeRateGTMaxRate   = sdkerrors.Register(ModuleName, 43, "commission change rate cannot be more than the max rate")
+	ErrCommissionGTMaxChangeRate       = sdkerrors.Register(ModuleName, 44, "commission cannot be changed more than max change rate")
+	ErrCommissionGTMaxCommissionRate   = sdkerrors.Register(ModuleName, 45, "commission cannot be more than the max commission rate param")
+	ErrDefiJailed                      = sdkerrors.Register(ModuleName, 46, "defi still jailed; cannot be unjailed")
+	ErrDefiNotJailed                   = sdkerrors.Register(ModuleName, 47, "defi not jailed; cannot be unjailed")
+	ErrUnauthorizedSlash               = sdkerrors.Register(ModuleName, 48, "signer is not a registered evidence handler")
+	ErrInvalidSlashFraction            = sdkerrors.Register(ModuleName, 49, "slash fraction must be positive and not greater than one")
+	ErrFutureInfraction                = sdkerrors.Register(ModuleName, 50, "cannot slash an infraction committed at a future height")
 )
@@ -3,10 +3,12 @@ package types
 // defi module event types
 const (
 	EventTypeCompleteUnbonding    = "complete_unbonding"
+	EventTypeCompleteRedelegation = "complete_redelegation"
 	EventTypeCreateDefi           = "create_defi"
 	EventTypeEditDefi             = "edit_defi"
 	EventTypeDelegate             = "delegate"
 	EventTypeUnbond               = "unbond"
+	EventTypeRedelegate           = "redelegate"
 	EventTypeSetWithdrawAddress   = "set_withdraw_address"
 	EventTypeRewards              = "rewards"
 	EventTypeCommission           = "commission"
@@ -14,13 +16,29 @@ const (
 	EventTypeWithdrawCommission   = "withdraw_commission"
 	EventTypeWithdrawDelegatorRewards  = "withdraw_delegator_rewards"
 	EventTypeMint                 = "mint"
+	EventTypeBondDefi             = "bond_defi"
+	EventTypeBeginUnbondingDefi   = "begin_unbonding_defi"
+	EventTypeCompleteUnbondingDefi = "complete_unbonding_defi"
+	EventTypeDefiPowerUpdate      = "defi_power_update"
+	EventTypeSlash                = "slash"
+	EventTypeJail                 = "jail"
+	EventTypeUnjail               = "unjail"
 
 	AttributeKeyDefi              = "defi"
+	AttributeKeySrcDefi           = "source_defi"
+	AttributeKeyDstDefi           = "destination_defi"
 	AttributeKeyWithdrawAddress   = "withdraw_address"
+	AttributeKeyCommissionRate    = "commission_rate"
 	AttributeKeyMinSelfDelegation = "min_self_delegation"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyRecipient         = "recipient"
+	AttributeKeyTokens            = "tokens"
+	AttributeKeyPower             = "power"
+	AttributeKeySlashFraction     = "slash_fraction"
+	AttributeKeyInfractionHeight  = "infraction_height"
+	AttributeKeyBurnedTokens      = "burned_tokens"
+	AttributeKeyJailedUntil       = "jailed_until"
 
 	AttributeValueCategory        = ModuleName
 )
@@ -104,4 +104,5 @@ type DefiHooks interface {
 	BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, defiAddr sdk.ValAddress) // Must be called when a delegation's shares are modified
 	BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, defiAddr sdk.ValAddress)        // Must be called when a delegation is removed
 	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, defiAddr sdk.ValAddress)
+	BeforeDefiSlashed(ctx sdk.Context, defiAddr sdk.ValAddress, fraction sdk.Dec) // Must be called when a defi is slashed
 }
@@ -18,9 +18,12 @@ type DefiI interface {
 	IsBonded() bool                                         // check if has a bonded status
 	IsUnbonded() bool                                       // check if has status unbonded
 	IsUnbonding() bool                                      // check if has status unbonding
+	IsJailed() bool                                         // whether the defi is jailed
 	GetOperator() sdk.ValAddress                            // operator address to receive/return defis coins
 	GetTokens() sdk.Int                                     // validation tokens
 	GetBondedTokens() sdk.Int                               // defi bonded tokens
+	GetConsensusPower() int64                               // defi power in the bonded defi set
+	GetCommission() sdk.Dec                                 // defi commission rate
 	GetMinSelfDelegation() sdk.Int                          // defi minimum self delegation
 	GetDelegatorShares() sdk.Dec                            // total outstanding delegator shares
 	TokensFromShares(sdk.Dec) sdk.Dec                       // token worth of provided delegator shares
@@ -38,6 +38,7 @@ func DefaultGenesisState() *GenesisState {
 		DefiHistoricalRewards:           []DefiHistoricalRewardsRecord{},
 		DefiCurrentRewards:              []DefiCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
+		Minter:                          InitialDefiMinter(),
 	}
 }
 
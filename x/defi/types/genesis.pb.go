@@ -279,6 +279,51 @@ func (m *DelegatorStartingInfoRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorStartingInfoRecord proto.InternalMessageInfo
 
+// DefiSlashEventRecord is used for import / export via genesis json.
+type DefiSlashEventRecord struct {
+	// defi_address is the address of the defi.
+	DefiAddress string `protobuf:"bytes,1,opt,name=defi_address,json=defiAddress,proto3" json:"defi_address,omitempty" yaml:"defi_address"`
+	// height defines the block height at which the slash event occured.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// period is the period of the slash event.
+	Period uint64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	// defi_slash_event describes the slash event.
+	DefiSlashEvent DefiSlashEvent `protobuf:"bytes,4,opt,name=defi_slash_event,json=defiSlashEvent,proto3" json:"defi_slash_event" yaml:"event"`
+}
+
+func (m *DefiSlashEventRecord) Reset()         { *m = DefiSlashEventRecord{} }
+func (m *DefiSlashEventRecord) String() string { return proto.CompactTextString(m) }
+func (*DefiSlashEventRecord) ProtoMessage()    {}
+func (*DefiSlashEventRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426a1f17baf2431, []int{6}
+}
+func (m *DefiSlashEventRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DefiSlashEventRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DefiSlashEventRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DefiSlashEventRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DefiSlashEventRecord.Merge(m, src)
+}
+func (m *DefiSlashEventRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DefiSlashEventRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DefiSlashEventRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DefiSlashEventRecord proto.InternalMessageInfo
+
 // GenesisState defines the staking module's genesis state.
 type GenesisState struct {
 	// params defines all the paramaters of related to deposit.
@@ -304,13 +349,19 @@ type GenesisState struct {
 	// fee_pool defines the fee pool at genesis.
 	FeePool  FeePool `protobuf:"bytes,11,opt,name=fee_pool,json=feePool,proto3" json:"fee_pool" yaml:"fee_pool"`
 	Exported bool    `protobuf:"varint,12,opt,name=exported,proto3" json:"exported,omitempty"`
+	// redelegations defines the redelegations active at genesis.
+	Redelegations []Redelegation `protobuf:"bytes,13,rep,name=redelegations,proto3" json:"redelegations"`
+	// defi_slash_events defines the defi slash events at genesis.
+	DefiSlashEvents []DefiSlashEventRecord `protobuf:"bytes,14,rep,name=defi_slash_events,json=defiSlashEvents,proto3" json:"defi_slash_events" yaml:"defi_slash_events"`
+	// minter defines the annual mint inflation period at genesis.
+	Minter DefiMinter `protobuf:"bytes,15,opt,name=minter,proto3" json:"minter"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6426a1f17baf2431, []int{6}
+	return fileDescriptor_6426a1f17baf2431, []int{7}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -423,6 +474,27 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetRedelegations() []Redelegation {
+	if m != nil {
+		return m.Redelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDefiSlashEvents() []DefiSlashEventRecord {
+	if m != nil {
+		return m.DefiSlashEvents
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMinter() DefiMinter {
+	if m != nil {
+		return m.Minter
+	}
+	return DefiMinter{}
+}
+
 func init() {
 	proto.RegisterType((*DelegatorWithdrawInfo)(nil), "gauss.defi.DelegatorWithdrawInfo")
 	proto.RegisterType((*DefiOutstandingRewardsRecord)(nil), "gauss.defi.DefiOutstandingRewardsRecord")
@@ -430,74 +502,83 @@ func init() {
 	proto.RegisterType((*DefiHistoricalRewardsRecord)(nil), "gauss.defi.DefiHistoricalRewardsRecord")
 	proto.RegisterType((*DefiCurrentRewardsRecord)(nil), "gauss.defi.DefiCurrentRewardsRecord")
 	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "gauss.defi.DelegatorStartingInfoRecord")
+	proto.RegisterType((*DefiSlashEventRecord)(nil), "gauss.defi.DefiSlashEventRecord")
 	proto.RegisterType((*GenesisState)(nil), "gauss.defi.GenesisState")
 }
 
 func init() { proto.RegisterFile("gauss/defi/genesis.proto", fileDescriptor_6426a1f17baf2431) }
 
 var fileDescriptor_6426a1f17baf2431 = []byte{
-	// 962 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0xb3, 0xdd, 0x36, 0x9d, 0x14, 0xb6, 0x4c, 0x7f, 0x59, 0x69, 0x64, 0x17, 0xef, 0xb2,
-	0x5b, 0xa9, 0x60, 0xb3, 0x85, 0x53, 0x0f, 0x88, 0x75, 0xab, 0x5d, 0xf6, 0x44, 0x99, 0x0a, 0x21,
-	0x71, 0x89, 0x1c, 0x7b, 0x92, 0x8e, 0x48, 0x3c, 0x91, 0xc7, 0xd9, 0xee, 0x82, 0xb8, 0x21, 0x2d,
-	0xe2, 0xc4, 0x81, 0x13, 0xa7, 0x9e, 0x39, 0x22, 0xfe, 0x06, 0xb4, 0x07, 0x0e, 0x3d, 0x72, 0x0a,
-	0xa8, 0xbd, 0x70, 0xee, 0x5f, 0x80, 0xe6, 0x87, 0x9d, 0x89, 0xe3, 0xb0, 0x54, 0xca, 0x25, 0xad,
-	0x67, 0xde, 0xfb, 0xde, 0xf7, 0xbe, 0x37, 0xfe, 0x3c, 0xc0, 0xec, 0x06, 0x43, 0xc6, 0xbc, 0x08,
-	0x77, 0x88, 0xd7, 0xc5, 0x31, 0x66, 0x84, 0xb9, 0x83, 0x84, 0xa6, 0x14, 0x02, 0xb1, 0xe3, 0xf2,
-	0x9d, 0xc6, 0x7a, 0x97, 0x76, 0xa9, 0x58, 0xf6, 0xf8, 0x7f, 0x32, 0xa2, 0x61, 0x85, 0x94, 0xf5,
-	0x29, 0xf3, 0xda, 0x01, 0xc3, 0xde, 0xb3, 0x87, 0x6d, 0x9c, 0x06, 0x0f, 0xbd, 0x90, 0x92, 0x58,
-	0xed, 0x6f, 0x68, 0xd8, 0xfc, 0x47, 0x2e, 0x3b, 0xbf, 0x19, 0x60, 0xe3, 0x08, 0xf7, 0x70, 0x37,
-	0x48, 0x69, 0xf2, 0x05, 0x49, 0x4f, 0xa3, 0x24, 0x38, 0x7b, 0x1a, 0x77, 0x28, 0x7c, 0x0a, 0xde,
-	0x8a, 0xb2, 0x8d, 0x56, 0x10, 0x45, 0x09, 0x66, 0xcc, 0x34, 0x76, 0x8c, 0xdd, 0x65, 0xbf, 0x79,
-	0x3d, 0xb2, 0xcd, 0x17, 0x41, 0xbf, 0x77, 0xe0, 0x4c, 0x85, 0x38, 0x68, 0x35, 0x5f, 0x7b, 0x24,
-	0x97, 0xe0, 0x63, 0xb0, 0x7a, 0xa6, 0xa0, 0x73, 0xa4, 0xaa, 0x40, 0xda, 0xbe, 0x1e, 0xd9, 0x5b,
-	0x12, 0xa9, 0x18, 0xe1, 0xa0, 0x3b, 0xd9, 0x92, 0xc2, 0x39, 0xa8, 0x7d, 0x7f, 0x6e, 0x57, 0xfe,
-	0x39, 0xb7, 0x2b, 0xce, 0xcb, 0x2a, 0x68, 0x1e, 0xe1, 0x0e, 0xf9, 0x74, 0x98, 0xb2, 0x34, 0x88,
-	0x23, 0x12, 0x77, 0x11, 0x3e, 0x0b, 0x92, 0x88, 0x21, 0x1c, 0xd2, 0x24, 0x82, 0x07, 0x60, 0x85,
-	0x77, 0x59, 0x20, 0xbe, 0x75, 0x3d, 0xb2, 0xd7, 0x32, 0xe2, 0xe3, 0x5d, 0x07, 0xd5, 0xf9, 0x63,
-	0x46, 0xf7, 0xdc, 0x00, 0x6b, 0x74, 0x0c, 0xdc, 0x4a, 0x24, 0xb2, 0x59, 0xdd, 0xb9, 0xb5, 0x5b,
-	0xdf, 0x6f, 0xba, 0x52, 0x69, 0x97, 0x2b, 0xed, 0x2a, 0xa5, 0xdd, 0x23, 0x1c, 0x1e, 0x52, 0x12,
-	0xfb, 0x9f, 0xbd, 0x1a, 0xd9, 0x95, 0xeb, 0x91, 0xdd, 0x90, 0x55, 0x4a, 0x60, 0x9c, 0x5f, 0xfe,
-	0xb2, 0xf7, 0xba, 0x24, 0x3d, 0x1d, 0xb6, 0xdd, 0x90, 0xf6, 0x3d, 0x35, 0x37, 0xf9, 0xe7, 0x3d,
-	0x16, 0x7d, 0xe5, 0xa5, 0x2f, 0x06, 0x98, 0x65, 0x88, 0x0c, 0x41, 0x3a, 0xd5, 0xa4, 0xa6, 0xc4,
-	0x1f, 0x06, 0xb0, 0xb9, 0x12, 0x8f, 0xc2, 0x70, 0xd8, 0x1f, 0xf6, 0x82, 0x14, 0x47, 0x87, 0xb4,
-	0xdf, 0x27, 0x8c, 0x11, 0x1a, 0xcf, 0x41, 0x8c, 0x10, 0xd4, 0x83, 0x31, 0xb4, 0x18, 0x5b, 0x7d,
-	0xff, 0x1d, 0x77, 0x7c, 0x1e, 0xdd, 0x99, 0xd5, 0xfd, 0x86, 0x12, 0x03, 0xca, 0x2a, 0x1a, 0x8e,
-	0x83, 0x74, 0x54, 0xad, 0x9d, 0x0b, 0x03, 0x6c, 0x73, 0xc0, 0x4f, 0x08, 0x4b, 0x69, 0x42, 0xc2,
-	0xa0, 0x37, 0xbf, 0xb9, 0x6e, 0x82, 0xc5, 0x01, 0x4e, 0x08, 0x95, 0x5d, 0x2c, 0x20, 0xf5, 0x04,
-	0x4f, 0xc0, 0x52, 0x36, 0xe2, 0x5b, 0xa2, 0xbd, 0xb7, 0x8b, 0xed, 0x4d, 0xb1, 0xf1, 0x37, 0x55,
-	0x6b, 0x6f, 0xca, 0xaa, 0xd9, 0x6c, 0x51, 0x86, 0xa4, 0xb5, 0xf4, 0xab, 0x01, 0x4c, 0x0e, 0x72,
-	0x38, 0x4c, 0x12, 0x1c, 0xa7, 0xf3, 0xeb, 0xe7, 0x78, 0xcc, 0x5b, 0x8e, 0xc5, 0x2a, 0xf2, 0x9e,
-	0x2c, 0x79, 0x13, 0xd2, 0x3f, 0x55, 0xf9, 0x1c, 0xd4, 0x7b, 0x7c, 0x92, 0x06, 0x49, 0x4a, 0xe2,
-	0x2e, 0xf7, 0x05, 0xc5, 0x7b, 0x8e, 0xee, 0x50, 0x94, 0xa0, 0x7a, 0x03, 0x09, 0x22, 0xf0, 0x06,
-	0x53, 0xe4, 0x5a, 0x24, 0xee, 0xd0, 0xf2, 0x01, 0x96, 0xb4, 0xe1, 0x37, 0x95, 0x16, 0xeb, 0xb2,
-	0xc6, 0x04, 0x8a, 0x83, 0x56, 0x98, 0x16, 0xab, 0xc9, 0xf2, 0xfb, 0x32, 0x58, 0x79, 0x22, 0x9d,
-	0xf9, 0x24, 0x0d, 0x52, 0x0c, 0xdf, 0x07, 0x8b, 0x83, 0x20, 0x09, 0xfa, 0xb2, 0xf9, 0xfa, 0x3e,
-	0xd4, 0x2b, 0x1f, 0x8b, 0x1d, 0x7f, 0x81, 0x97, 0x42, 0x2a, 0x0e, 0xbe, 0x0b, 0x6e, 0xf3, 0xcd,
-	0xcc, 0x4e, 0x56, 0x8b, 0x33, 0x53, 0xe1, 0x32, 0x08, 0x7e, 0x04, 0xea, 0x4a, 0x30, 0x42, 0x63,
-	0x7e, 0x3e, 0x79, 0xce, 0x66, 0x49, 0x7b, 0xfc, 0x7d, 0x93, 0x99, 0x7a, 0x02, 0xfc, 0x1a, 0x6c,
-	0x0c, 0xe3, 0x36, 0x95, 0x0e, 0xa4, 0x23, 0x2d, 0x08, 0x24, 0x5b, 0x47, 0xfa, 0x3c, 0x0b, 0xd4,
-	0x20, 0xef, 0x29, 0x99, 0x9a, 0x52, 0xa6, 0x52, 0x2c, 0x07, 0xad, 0x0f, 0xa7, 0x53, 0x19, 0xfc,
-	0xce, 0x00, 0xe6, 0xf8, 0x04, 0xe4, 0xfe, 0xce, 0x15, 0x66, 0xe6, 0x6d, 0x51, 0xbf, 0x7c, 0x50,
-	0xfa, 0x77, 0xc8, 0x7f, 0xa0, 0x18, 0xd8, 0xc5, 0x23, 0x35, 0x09, 0xe8, 0xa0, 0xcd, 0xa8, 0x2c,
-	0x9f, 0xc1, 0x6f, 0xcb, 0xdd, 0x7c, 0x51, 0x10, 0xd8, 0x2d, 0xca, 0x3f, 0xeb, 0x8b, 0xe2, 0x3b,
-	0xaf, 0x77, 0xf6, 0x32, 0xab, 0x86, 0x3f, 0x1b, 0xa0, 0x29, 0x4f, 0xf0, 0xd8, 0xf0, 0x5a, 0x61,
-	0x6e, 0x92, 0xcc, 0x5c, 0x12, 0x44, 0xf6, 0xfe, 0x97, 0xa5, 0x2a, 0x2e, 0x7b, 0x8a, 0xcb, 0x5d,
-	0xfd, 0x05, 0x29, 0x87, 0x77, 0x50, 0x23, 0x9a, 0x85, 0xc6, 0xe0, 0x4b, 0x03, 0x6c, 0x89, 0xec,
-	0xd3, 0xdc, 0xe1, 0x72, 0x81, 0x6a, 0x82, 0xd7, 0x83, 0xd7, 0x7a, 0xa1, 0xe2, 0x74, 0x5f, 0x71,
-	0xb2, 0x34, 0x4e, 0xd3, 0xa8, 0x0e, 0xda, 0x88, 0xca, 0x40, 0xe0, 0x37, 0x60, 0x5d, 0xa4, 0x84,
-	0xd2, 0xb2, 0x72, 0x16, 0xcb, 0x82, 0xc5, 0xbd, 0xff, 0x76, 0x36, 0x45, 0xe1, 0xae, 0xa2, 0xb0,
-	0xad, 0x51, 0x28, 0xe0, 0x39, 0x08, 0x46, 0x53, 0xe9, 0xf0, 0x87, 0x89, 0x93, 0x3a, 0xe1, 0x05,
-	0xcc, 0x04, 0x65, 0x3a, 0xcc, 0x74, 0xc6, 0xd9, 0xe7, 0x75, 0x12, 0x56, 0x3f, 0xaf, 0x3a, 0x0a,
-	0x83, 0x4f, 0x40, 0xad, 0x83, 0x71, 0x6b, 0x40, 0x69, 0xcf, 0xac, 0x0b, 0x53, 0x59, 0xd3, 0x6b,
-	0x3f, 0xc6, 0xf8, 0x98, 0xd2, 0x9e, 0xbf, 0xa5, 0xea, 0xdc, 0x91, 0x75, 0xb2, 0x14, 0x07, 0x2d,
-	0x75, 0x64, 0x04, 0x6c, 0x80, 0x1a, 0x7e, 0x3e, 0xa0, 0x09, 0xff, 0x6e, 0xaf, 0xec, 0x18, 0xbb,
-	0x35, 0x94, 0x3f, 0xfb, 0x1f, 0xbf, 0xba, 0xb4, 0x8c, 0x8b, 0x4b, 0xcb, 0xf8, 0xfb, 0xd2, 0x32,
-	0x7e, 0xbc, 0xb2, 0x2a, 0x17, 0x57, 0x56, 0xe5, 0xcf, 0x2b, 0xab, 0xf2, 0xe5, 0x7d, 0xed, 0x6e,
-	0x22, 0xef, 0x8c, 0xf2, 0xf7, 0xd9, 0x87, 0xde, 0x73, 0x79, 0x7d, 0x14, 0xf7, 0x93, 0xf6, 0xa2,
-	0xb8, 0x40, 0x7e, 0xf0, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x7d, 0x8a, 0xf8, 0xb5, 0x0a,
-	0x00, 0x00,
+	// 1097 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xbd, 0x6f, 0xdb, 0xc6,
+	0x1b, 0x16, 0x6d, 0xc7, 0x56, 0x4e, 0xf2, 0x47, 0xce, 0xb2, 0x4d, 0xc8, 0x82, 0xa8, 0x1f, 0x93,
+	0x5f, 0x62, 0xc0, 0xad, 0xd4, 0xb8, 0x99, 0x3c, 0x14, 0x0d, 0xed, 0x26, 0xcd, 0x50, 0xd4, 0x3d,
+	0xa3, 0x28, 0x90, 0x45, 0xa0, 0xc8, 0x93, 0x74, 0xa8, 0xc4, 0x13, 0x78, 0x94, 0x9d, 0xb4, 0xe8,
+	0x56, 0x20, 0x45, 0xa7, 0x0e, 0x9d, 0x3a, 0x79, 0xee, 0x58, 0xf4, 0x8f, 0xc8, 0xd0, 0xc1, 0x63,
+	0x27, 0xb5, 0xb0, 0x97, 0xcc, 0xfe, 0x0b, 0x8a, 0xfb, 0xa0, 0x74, 0xa4, 0xa8, 0xa6, 0x01, 0xb4,
+	0xc8, 0x26, 0xef, 0x7d, 0x9f, 0xf7, 0x79, 0x3f, 0xf8, 0xbc, 0x24, 0x30, 0x3b, 0xee, 0x90, 0xb1,
+	0x86, 0x8f, 0xdb, 0xa4, 0xd1, 0xc1, 0x01, 0x66, 0x84, 0xd5, 0x07, 0x21, 0x8d, 0x28, 0x04, 0xe2,
+	0xa4, 0xce, 0x4f, 0xca, 0xa5, 0x0e, 0xed, 0x50, 0x71, 0xbb, 0xc1, 0xff, 0x93, 0x16, 0xe5, 0xaa,
+	0x47, 0x59, 0x9f, 0xb2, 0x46, 0xcb, 0x65, 0xb8, 0x71, 0xf6, 0xb0, 0x85, 0x23, 0xf7, 0x61, 0xc3,
+	0xa3, 0x24, 0x50, 0xe7, 0x5b, 0x1a, 0x36, 0xff, 0x91, 0xb7, 0xed, 0xdf, 0x0d, 0xb0, 0x75, 0x8c,
+	0x7b, 0xb8, 0xe3, 0x46, 0x34, 0xfc, 0x8a, 0x44, 0x5d, 0x3f, 0x74, 0xcf, 0x9f, 0x05, 0x6d, 0x0a,
+	0x9f, 0x81, 0x3b, 0x7e, 0x7c, 0xd0, 0x74, 0x7d, 0x3f, 0xc4, 0x8c, 0x99, 0x46, 0xcd, 0xd8, 0xbb,
+	0xed, 0x54, 0x6e, 0x46, 0x96, 0xf9, 0xd2, 0xed, 0xf7, 0x0e, 0xed, 0x29, 0x13, 0x1b, 0x6d, 0x8c,
+	0xef, 0x3d, 0x96, 0xb7, 0xe0, 0x13, 0xb0, 0x71, 0xae, 0xa0, 0xc7, 0x48, 0x0b, 0x02, 0x69, 0xf7,
+	0x66, 0x64, 0xed, 0x48, 0xa4, 0xb4, 0x85, 0x8d, 0xd6, 0xe3, 0x5b, 0x0a, 0xe7, 0x30, 0xff, 0xc3,
+	0x85, 0x95, 0x7b, 0x73, 0x61, 0xe5, 0xec, 0x57, 0x0b, 0xa0, 0x72, 0x8c, 0xdb, 0xe4, 0xf3, 0x61,
+	0xc4, 0x22, 0x37, 0xf0, 0x49, 0xd0, 0x41, 0xf8, 0xdc, 0x0d, 0x7d, 0x86, 0xb0, 0x47, 0x43, 0x1f,
+	0x1e, 0x82, 0x22, 0xcf, 0x32, 0x45, 0x7c, 0xe7, 0x66, 0x64, 0x6d, 0xc6, 0xc4, 0x27, 0xa7, 0x36,
+	0x2a, 0xf0, 0xcb, 0x98, 0xee, 0x85, 0x01, 0x36, 0xe9, 0x04, 0xb8, 0x19, 0x4a, 0x64, 0x73, 0xa1,
+	0xb6, 0xb8, 0x57, 0x38, 0xa8, 0xd4, 0x65, 0xa5, 0xeb, 0xbc, 0xd2, 0x75, 0x55, 0xe9, 0xfa, 0x31,
+	0xf6, 0x8e, 0x28, 0x09, 0x9c, 0x2f, 0x5e, 0x8f, 0xac, 0xdc, 0xcd, 0xc8, 0x2a, 0xcb, 0x28, 0x19,
+	0x30, 0xf6, 0xaf, 0x7f, 0x59, 0xfb, 0x1d, 0x12, 0x75, 0x87, 0xad, 0xba, 0x47, 0xfb, 0x0d, 0xd5,
+	0x37, 0xf9, 0xe7, 0x7d, 0xe6, 0x7f, 0xdd, 0x88, 0x5e, 0x0e, 0x30, 0x8b, 0x11, 0x19, 0x82, 0x74,
+	0x2a, 0x49, 0xad, 0x12, 0x7f, 0x18, 0xc0, 0xe2, 0x95, 0x78, 0xec, 0x79, 0xc3, 0xfe, 0xb0, 0xe7,
+	0x46, 0xd8, 0x3f, 0xa2, 0xfd, 0x3e, 0x61, 0x8c, 0xd0, 0x60, 0x0e, 0xc5, 0xf0, 0x40, 0xc1, 0x9d,
+	0x40, 0x8b, 0xb6, 0x15, 0x0e, 0xfe, 0x5f, 0x9f, 0xcc, 0x63, 0x7d, 0x66, 0x74, 0xa7, 0xac, 0x8a,
+	0x01, 0x65, 0x14, 0x0d, 0xc7, 0x46, 0x3a, 0xaa, 0x96, 0xce, 0xa5, 0x01, 0x76, 0x39, 0xe0, 0xa7,
+	0x84, 0x45, 0x34, 0x24, 0x9e, 0xdb, 0x9b, 0x5f, 0x5f, 0xb7, 0xc1, 0xf2, 0x00, 0x87, 0x84, 0xca,
+	0x2c, 0x96, 0x90, 0xba, 0x82, 0xa7, 0x60, 0x25, 0x6e, 0xf1, 0xa2, 0x48, 0xef, 0x7f, 0xe9, 0xf4,
+	0xa6, 0xd8, 0x38, 0xdb, 0x2a, 0xb5, 0x35, 0x19, 0x35, 0xee, 0x2d, 0x8a, 0x91, 0xb4, 0x94, 0x7e,
+	0x33, 0x80, 0xc9, 0x41, 0x8e, 0x86, 0x61, 0x88, 0x83, 0x68, 0x7e, 0xf9, 0x9c, 0x4c, 0x78, 0xcb,
+	0xb6, 0x54, 0xd3, 0xbc, 0x93, 0x21, 0xdf, 0x85, 0xf4, 0xcf, 0x0b, 0xbc, 0x0f, 0xea, 0x39, 0x3e,
+	0x8d, 0xdc, 0x30, 0x22, 0x41, 0x87, 0xeb, 0x82, 0xe2, 0x3d, 0x47, 0x75, 0x48, 0x97, 0x60, 0xe1,
+	0x1d, 0x4a, 0xe0, 0x83, 0x55, 0xa6, 0xc8, 0x35, 0x49, 0xd0, 0xa6, 0xd9, 0x0d, 0xcc, 0x48, 0xc3,
+	0xa9, 0xa8, 0x5a, 0x94, 0x64, 0x8c, 0x04, 0x8a, 0x8d, 0x8a, 0x4c, 0xb3, 0xd5, 0xca, 0xf2, 0xc6,
+	0x00, 0x25, 0x5e, 0xd8, 0xd3, 0x9e, 0xcb, 0xba, 0x9f, 0x9c, 0x89, 0xda, 0xce, 0x63, 0x2e, 0xbb,
+	0x98, 0x74, 0xba, 0x51, 0x3c, 0x97, 0xf2, 0x4a, 0x9b, 0xd7, 0xc5, 0xc4, 0xbc, 0x3e, 0x07, 0x1b,
+	0x02, 0x8d, 0x71, 0x12, 0x4d, 0xcc, 0x59, 0x98, 0x4b, 0x22, 0xef, 0x72, 0x7a, 0x00, 0x26, 0x3c,
+	0x9d, 0x92, 0x4a, 0xb8, 0x28, 0xf9, 0x08, 0x37, 0x1b, 0xad, 0xf9, 0x09, 0x2b, 0x7d, 0x6c, 0x0b,
+	0xa0, 0xf8, 0x54, 0x2e, 0xa1, 0xd3, 0xc8, 0x8d, 0x30, 0xfc, 0x00, 0x2c, 0x0f, 0xdc, 0xd0, 0xed,
+	0xcb, 0xe4, 0x0a, 0x07, 0x50, 0x0f, 0x76, 0x22, 0x4e, 0x9c, 0x25, 0x1e, 0x04, 0x29, 0x3b, 0xf8,
+	0x1e, 0xb8, 0xc5, 0x0f, 0x63, 0xe5, 0xdc, 0x48, 0xb3, 0x53, 0xe6, 0xd2, 0x08, 0x7e, 0x04, 0x0a,
+	0x6a, 0x36, 0x08, 0x0d, 0xf8, 0xa3, 0xc8, 0x7d, 0xb6, 0x33, 0x3a, 0xc9, 0xa5, 0x45, 0x7a, 0xea,
+	0x0e, 0xf0, 0x1b, 0xb0, 0x35, 0x0c, 0x5a, 0x54, 0x8a, 0xad, 0x8e, 0xb4, 0x24, 0x90, 0x2c, 0x1d,
+	0xe9, 0xcb, 0xd8, 0x50, 0x83, 0xbc, 0xa7, 0x0a, 0x54, 0x91, 0x05, 0xca, 0xc4, 0xb2, 0x51, 0x69,
+	0x38, 0xed, 0xca, 0xe0, 0xf7, 0x06, 0x30, 0x27, 0xc3, 0x3e, 0x5e, 0x65, 0x7c, 0x98, 0x98, 0x79,
+	0xab, 0xb6, 0x38, 0x73, 0x26, 0xf5, 0x95, 0xeb, 0x3c, 0x50, 0x0c, 0xac, 0xf4, 0xd3, 0x93, 0x04,
+	0xb4, 0xd1, 0xb6, 0x9f, 0xe5, 0xcf, 0xe0, 0x77, 0xd9, 0x8b, 0x6b, 0x59, 0x10, 0xd8, 0x4b, 0x97,
+	0x7f, 0xd6, 0xf2, 0x74, 0xec, 0xb7, 0x2f, 0xb1, 0xac, 0xad, 0x04, 0x7f, 0x31, 0x40, 0x45, 0xce,
+	0xf9, 0x44, 0xdb, 0x9b, 0xde, 0x78, 0x1f, 0x30, 0x73, 0x45, 0x10, 0xd9, 0xff, 0x4f, 0xdb, 0x43,
+	0x71, 0xd9, 0x57, 0x5c, 0xee, 0xea, 0x8f, 0x51, 0x36, 0xbc, 0x8d, 0xca, 0xfe, 0x2c, 0x34, 0x06,
+	0x5f, 0x19, 0x60, 0x47, 0x78, 0x77, 0xc7, 0x62, 0x3e, 0x2e, 0x50, 0x5e, 0xf0, 0x7a, 0xf0, 0x56,
+	0xd9, 0x57, 0x9c, 0xee, 0x2b, 0x4e, 0x55, 0x8d, 0xd3, 0x34, 0xaa, 0x8d, 0xb6, 0xfc, 0x2c, 0x10,
+	0xf8, 0x2d, 0x28, 0x09, 0x17, 0x4f, 0xaa, 0xf3, 0x98, 0xc5, 0x6d, 0xc1, 0xe2, 0xde, 0xbf, 0x8b,
+	0xb8, 0xa2, 0x70, 0x57, 0x51, 0xd8, 0xd5, 0x28, 0xa4, 0xf0, 0x6c, 0x04, 0xfd, 0x29, 0x77, 0xf8,
+	0x63, 0x62, 0x52, 0x13, 0xb2, 0xc7, 0x4c, 0x90, 0x55, 0x87, 0x99, 0x4b, 0x60, 0xf6, 0xbc, 0x26,
+	0x61, 0xf5, 0x79, 0xd5, 0x51, 0x18, 0x7c, 0x0a, 0xf2, 0x6d, 0x8c, 0x9b, 0x03, 0x4a, 0x7b, 0x66,
+	0x41, 0x88, 0xca, 0xa6, 0x1e, 0xfb, 0x09, 0xc6, 0x27, 0x94, 0xf6, 0x9c, 0x1d, 0x15, 0x67, 0x5d,
+	0xc6, 0x89, 0x5d, 0x6c, 0xb4, 0xd2, 0x96, 0x16, 0xb0, 0x0c, 0xf2, 0xf8, 0xc5, 0x80, 0x86, 0xfc,
+	0x15, 0xa5, 0x58, 0x33, 0xf6, 0xf2, 0x68, 0x7c, 0x0d, 0x8f, 0xc1, 0x6a, 0x88, 0x75, 0x3d, 0x58,
+	0x15, 0x59, 0x9a, 0x7a, 0x24, 0x84, 0xfd, 0xb4, 0xb6, 0x24, 0x9d, 0x60, 0x00, 0xee, 0xa4, 0x45,
+	0x97, 0x99, 0x6b, 0x02, 0xa9, 0x36, 0x5b, 0x75, 0x55, 0xa1, 0x6a, 0x2a, 0x01, 0x53, 0xeb, 0x96,
+	0x0e, 0x64, 0xa3, 0xf5, 0xa4, 0x0e, 0x33, 0xf8, 0x08, 0x2c, 0xf7, 0x49, 0x10, 0xe1, 0xd0, 0x5c,
+	0xaf, 0x19, 0xd3, 0x42, 0xd8, 0x26, 0x9f, 0x89, 0xd3, 0x58, 0x71, 0xa5, 0xad, 0xf3, 0xf1, 0xeb,
+	0xab, 0xaa, 0x71, 0x79, 0x55, 0x35, 0xfe, 0xbe, 0xaa, 0x1a, 0x3f, 0x5d, 0x57, 0x73, 0x97, 0xd7,
+	0xd5, 0xdc, 0x9f, 0xd7, 0xd5, 0xdc, 0xf3, 0xfb, 0xda, 0x2b, 0xa7, 0xfc, 0x14, 0x90, 0xbf, 0x67,
+	0x8f, 0x1a, 0x2f, 0xe4, 0x57, 0x81, 0x78, 0xed, 0x6c, 0x2d, 0x8b, 0xef, 0x82, 0x0f, 0xff, 0x19,
+	0x00, 0xa9, 0xc7, 0x02, 0x8f, 0x8c, 0x0c, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -753,6 +834,56 @@ func (m *DelegatorStartingInfoRecord) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *DefiSlashEventRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DefiSlashEventRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DefiSlashEventRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DefiSlashEvent.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Period != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DefiAddress) > 0 {
+		i -= len(m.DefiAddress)
+		copy(dAtA[i:], m.DefiAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DefiAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -773,6 +904,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Minter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.DefiSlashEvents) > 0 {
+		for iNdEx := len(m.DefiSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DefiSlashEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -1046,6 +1215,27 @@ func (m *DelegatorStartingInfoRecord) Size() (n int) {
 	return n
 }
 
+func (m *DefiSlashEventRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DefiAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.Period != 0 {
+		n += 1 + sovGenesis(uint64(m.Period))
+	}
+	l = m.DefiSlashEvent.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1113,6 +1303,20 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DefiSlashEvents) > 0 {
+		for _, e := range m.DefiSlashEvents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Minter.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1863,6 +2067,159 @@ func (m *DelegatorStartingInfoRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DefiSlashEventRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DefiSlashEventRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DefiSlashEventRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefiAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiSlashEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefiSlashEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2284,6 +2641,107 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redelegations = append(m.Redelegations, Redelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiSlashEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefiSlashEvents = append(m.DefiSlashEvents, DefiSlashEventRecord{})
+			if err := m.DefiSlashEvents[len(m.DefiSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -56,3 +56,8 @@ func (h MultiDefiHooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.Acc
 		h[i].AfterDelegationModified(ctx, delAddr, defiAddr)
 	}
 }
+func (h MultiDefiHooks) BeforeDefiSlashed(ctx sdk.Context, defiAddr sdk.ValAddress, fraction sdk.Dec) {
+	for i := range h {
+		h[i].BeforeDefiSlashed(ctx, defiAddr, fraction)
+	}
+}
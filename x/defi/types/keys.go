@@ -26,15 +26,23 @@ const (
 
 var (
 	FeePoolKey                        = []byte{0x11} // key for global defi state
+	LastDefiPowerKey                  = []byte{0x12} // prefix for each key to a defi index, for bonded defis
+	LastTotalPowerKey                 = []byte{0x13} // prefix for the total power
+	DefiMinterKey                     = []byte{0x14} // key for the defi mint inflation period
 
 	// Keys for store prefixes
 	DefisKey                          = []byte{0x21} // prefix for each key to a defi
+	DefisByPowerIndexKey              = []byte{0x23} // prefix for each key to a defi index, sorted by power
 
 	DelegationKey                     = []byte{0x31} // key for a delegation
 	UnbondingDelegationKey            = []byte{0x32} // key for an unbonding-delegation
 	UnbondingDelegationByDefiIndexKey = []byte{0x33} // prefix for each key for an unbonding-delegation, by defi operator
+	RedelegationKey                   = []byte{0x34} // key for a redelegation
+	RedelegationByDefiSrcIndexKey     = []byte{0x35} // prefix for each key for an redelegation, by source defi operator
+	RedelegationByDefiDstIndexKey     = []byte{0x36} // prefix for each key for an redelegation, by destination defi operator
 
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
+	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	DefiQueueKey         = []byte{0x43} // prefix for the timestamps in defi queue
 
 	HistoricalInfoKey    = []byte{0x50} // prefix for the historical info
@@ -45,6 +53,7 @@ var (
 	DefiHistoricalRewardsPrefix     = []byte{0x64} // key for historical defis rewards / stake
 	DefiCurrentRewardsPrefix        = []byte{0x65} // key for current defi rewards
 	DefiAccumulatedCommissionPrefix = []byte{0x66} // key for accumulated defi commission
+	DefiSlashEventPrefix            = []byte{0x67} // key for defi slash fraction
 )
 
 // gets the key for the defi with address
@@ -53,6 +62,63 @@ func GetDefiKey(operatorAddr sdk.ValAddress) []byte {
 	return append(DefisKey, operatorAddr.Bytes()...)
 }
 
+// AddressFromLastDefiPowerKey returns the defi operator address from a
+// key created by GetLastDefiPowerKey
+func AddressFromLastDefiPowerKey(key []byte) []byte {
+	return key[1:] // remove prefix bytes
+}
+
+// get the defi by power index.
+// Power index is the key used in the power-store, and represents the relative
+// power ranking of the defi.
+// VALUE: defi operator address ([]byte)
+func GetDefisByPowerIndexKey(defi Defi) []byte {
+	// NOTE the address doesn't need to be stored because counter bytes must always be different
+	// NOTE the larger values are of higher value
+	consensusPower := sdk.TokensToConsensusPower(defi.Tokens)
+	consensusPowerBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(consensusPowerBytes, uint64(consensusPower))
+
+	powerBytes := consensusPowerBytes
+	powerBytesLen := len(powerBytes) // 8
+
+	// key is of format prefix || powerbytes || addrBytes
+	key := make([]byte, 1+powerBytesLen+sdk.AddrLen)
+
+	key[0] = DefisByPowerIndexKey[0]
+	copy(key[1:powerBytesLen+1], powerBytes)
+	operAddrInvr := sdk.CopyBytes(defi.GetOperator())
+
+	for i, b := range operAddrInvr {
+		operAddrInvr[i] = ^b
+	}
+
+	copy(key[powerBytesLen+1:], operAddrInvr)
+
+	return key
+}
+
+// get the bonded defi index key for an operator address
+func GetLastDefiPowerKey(operator sdk.ValAddress) []byte {
+	return append(LastDefiPowerKey, operator...)
+}
+
+// parse the defis operator address from power rank key
+func ParseDefiPowerRankKey(key []byte) (operAddr []byte) {
+	powerBytesLen := 8
+	if len(key) != 1+powerBytesLen+sdk.AddrLen {
+		panic("Invalid defi power rank key length")
+	}
+
+	operAddr = sdk.CopyBytes(key[powerBytesLen+1:])
+
+	for i, b := range operAddr {
+		operAddr[i] = ^b
+	}
+
+	return operAddr
+}
+
 // GetDefiQueueKey returns the prefix key used for getting a set of unbonding
 // defis whose unbonding completion occurs at the given time and height.
 func GetDefiQueueKey(timestamp time.Time, height int64) []byte {
@@ -151,6 +217,107 @@ func GetUnbondingDelegationTimeKey(timestamp time.Time) []byte {
 	return append(UnbondingQueueKey, bz...)
 }
 
+// gets the key for a redelegation
+// VALUE: defi/RedelegateDelegation
+func GetREDKey(delAddr sdk.AccAddress, defiSrcAddr, defiDstAddr sdk.ValAddress) []byte {
+	key := make([]byte, 1+sdk.AddrLen*3)
+
+	copy(key[0:sdk.AddrLen+1], GetREDsKey(delAddr.Bytes()))
+	copy(key[sdk.AddrLen+1:2*sdk.AddrLen+1], defiSrcAddr.Bytes())
+	copy(key[2*sdk.AddrLen+1:3*sdk.AddrLen+1], defiDstAddr.Bytes())
+
+	return key
+}
+
+// gets the index-key for a redelegation, stored by source-defi-index
+// VALUE: none (key rearrangement used)
+func GetREDByDefiSrcIndexKey(delAddr sdk.AccAddress, defiSrcAddr, defiDstAddr sdk.ValAddress) []byte {
+	REDSFromDefiSrcKey := GetREDsFromDefiSrcIndexKey(defiSrcAddr)
+	offset := len(REDSFromDefiSrcKey)
+
+	// key is of the form REDSFromDefiSrcKey || delAddr || defiDstAddr
+	key := make([]byte, len(REDSFromDefiSrcKey)+2*sdk.AddrLen)
+	copy(key[0:offset], REDSFromDefiSrcKey)
+	copy(key[offset:offset+sdk.AddrLen], delAddr.Bytes())
+	copy(key[offset+sdk.AddrLen:offset+2*sdk.AddrLen], defiDstAddr.Bytes())
+
+	return key
+}
+
+// gets the index-key for a redelegation, stored by destination-defi-index
+// VALUE: none (key rearrangement used)
+func GetREDByDefiDstIndexKey(delAddr sdk.AccAddress, defiSrcAddr, defiDstAddr sdk.ValAddress) []byte {
+	REDSToDefiDstKey := GetREDsToDefiDstIndexKey(defiDstAddr)
+	offset := len(REDSToDefiDstKey)
+
+	// key is of the form REDSToDefiDstKey || delAddr || defiSrcAddr
+	key := make([]byte, len(REDSToDefiDstKey)+2*sdk.AddrLen)
+	copy(key[0:offset], REDSToDefiDstKey)
+	copy(key[offset:offset+sdk.AddrLen], delAddr.Bytes())
+	copy(key[offset+sdk.AddrLen:offset+2*sdk.AddrLen], defiSrcAddr.Bytes())
+
+	return key
+}
+
+// GetREDKeyFromDefiSrcIndexKey rearranges the DefiSrcIndexKey to get the REDKey
+func GetREDKeyFromDefiSrcIndexKey(indexKey []byte) []byte {
+	// note that first byte is prefix byte
+	if len(indexKey) != 3*sdk.AddrLen+1 {
+		panic("unexpected key length")
+	}
+
+	defiSrcAddr := indexKey[1 : sdk.AddrLen+1]
+	delAddr := indexKey[sdk.AddrLen+1 : 2*sdk.AddrLen+1]
+	defiDstAddr := indexKey[2*sdk.AddrLen+1 : 3*sdk.AddrLen+1]
+
+	return GetREDKey(delAddr, defiSrcAddr, defiDstAddr)
+}
+
+// GetREDKeyFromDefiDstIndexKey rearranges the DefiDstIndexKey to get the REDKey
+func GetREDKeyFromDefiDstIndexKey(indexKey []byte) []byte {
+	// note that first byte is prefix byte
+	if len(indexKey) != 3*sdk.AddrLen+1 {
+		panic("unexpected key length")
+	}
+
+	defiDstAddr := indexKey[1 : sdk.AddrLen+1]
+	delAddr := indexKey[sdk.AddrLen+1 : 2*sdk.AddrLen+1]
+	defiSrcAddr := indexKey[2*sdk.AddrLen+1 : 3*sdk.AddrLen+1]
+
+	return GetREDKey(delAddr, defiSrcAddr, defiDstAddr)
+}
+
+// GetRedelegationTimeKey returns a key prefix for indexing an unbonding
+// redelegation based on a completion time.
+func GetRedelegationTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(RedelegationQueueKey, bz...)
+}
+
+// GetREDsKey returns a key prefix for indexing a redelegation from a delegator
+// address.
+func GetREDsKey(delAddr sdk.AccAddress) []byte {
+	return append(RedelegationKey, delAddr.Bytes()...)
+}
+
+// GetREDsFromDefiSrcIndexKey returns a key prefix for indexing a redelegation to
+// a source defi.
+func GetREDsFromDefiSrcIndexKey(defiSrcAddr sdk.ValAddress) []byte {
+	return append(RedelegationByDefiSrcIndexKey, defiSrcAddr.Bytes()...)
+}
+
+// GetREDsToDefiDstIndexKey returns a key prefix for indexing a redelegation to a
+// destination (target) defi.
+func GetREDsToDefiDstIndexKey(defiDstAddr sdk.ValAddress) []byte {
+	return append(RedelegationByDefiDstIndexKey, defiDstAddr.Bytes()...)
+}
+
+// GetREDsByDelToDefiDstIndexKey returns a key prefix for indexing a redelegation
+// from an address to a destination defi.
+func GetREDsByDelToDefiDstIndexKey(delAddr sdk.AccAddress, defiDstAddr sdk.ValAddress) []byte {
+	return append(GetREDsToDefiDstIndexKey(defiDstAddr), delAddr.Bytes()...)
+}
+
 // GetHistoricalInfoKey returns a key prefix for indexing HistoricalInfo objects.
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
@@ -224,6 +391,18 @@ func GetDefiAccumulatedCommissionAddress(key []byte) (defiAddr sdk.ValAddress) {
 	return sdk.ValAddress(addr)
 }
 
+// gets the height from a defi's slash event key
+func GetDefiSlashEventAddressHeight(key []byte) (defiAddr sdk.ValAddress, height uint64) {
+	addr := key[1 : 1+sdk.AddrLen]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	defiAddr = sdk.ValAddress(addr)
+	startB := 1 + sdk.AddrLen
+	b := key[startB : startB+8] // the next 8 bytes represent the height
+	height = binary.BigEndian.Uint64(b)
+	return
+}
 
 // gets the outstanding rewards key for a defi
 func GetDefiOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
@@ -262,3 +441,25 @@ func GetDefiAccumulatedCommissionKey(v sdk.ValAddress) []byte {
 	return append(DefiAccumulatedCommissionPrefix, v.Bytes()...)
 }
 
+// gets the prefix key for a defi's slash fractions
+func GetDefiSlashEventPrefix(v sdk.ValAddress) []byte {
+	return append(DefiSlashEventPrefix, v.Bytes()...)
+}
+
+// gets the prefix key for a defi's slash fraction (DefiSlashEventPrefix + height)
+func GetDefiSlashEventKeyPrefix(v sdk.ValAddress, height uint64) []byte {
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, height)
+	return append(
+		DefiSlashEventPrefix,
+		append(v.Bytes(), heightBz...)...,
+	)
+}
+
+// gets the key for a defi's slash fraction
+func GetDefiSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
+	periodBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(periodBz, period)
+	prefix := GetDefiSlashEventKeyPrefix(v, height)
+	return append(prefix, periodBz...)
+}
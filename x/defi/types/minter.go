@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AnnualPeriod is the length of an annual mint inflation period
+const AnnualPeriod = time.Hour * 24 * 365
+
+// NewDefiMinter creates a new defi minter
+func NewDefiMinter(year uint64, periodStart time.Time, periodProvisions sdk.Int) DefiMinter {
+	return DefiMinter{
+		Year:             year,
+		PeriodStart:      periodStart,
+		PeriodProvisions: periodProvisions,
+	}
+}
+
+// InitialDefiMinter returns a minter whose first period starts with the
+// first block it is used in
+func InitialDefiMinter() DefiMinter {
+	return NewDefiMinter(0, time.Time{}, sdk.ZeroInt())
+}
+
+// ValidateDefiMinter validates the minter for a genesis state
+func ValidateDefiMinter(m DefiMinter) error {
+	if m.PeriodProvisions.IsNil() || m.PeriodProvisions.IsNegative() {
+		return fmt.Errorf("defi minter period provisions must not be negative: %s", m.PeriodProvisions)
+	}
+
+	return nil
+}
+
+// NextPeriod moves the minter to the annual period containing now, resetting
+// the period provisions whenever a new period begins
+func (m DefiMinter) NextPeriod(now time.Time) DefiMinter {
+	if m.PeriodStart.IsZero() {
+		return NewDefiMinter(m.Year, now, m.PeriodProvisions)
+	}
+
+	years := uint64(now.Sub(m.PeriodStart) / AnnualPeriod)
+	if years == 0 {
+		return m
+	}
+
+	periodStart := m.PeriodStart.Add(AnnualPeriod * time.Duration(years))
+	return NewDefiMinter(m.Year+years, periodStart, sdk.ZeroInt())
+}
+
+// TransactionProvision returns the coin minted by a single trade. The
+// mint inflation decays every annual period and is scaled up when the bonded
+// ratio is below the goal and down when it is above, before being bounded by
+// what is left of the annual cap and of the supply ceiling.
+func (m DefiMinter) TransactionProvision(params Params, bondedRatio sdk.Dec, supply sdk.Int) sdk.Coin {
+	provision := params.MintInflation.Amount.ToDec().
+		Mul(sdk.OneDec().Sub(params.InflationDecay).Power(m.Year))
+
+	if params.GoalBonded.IsPositive() {
+		factor := sdk.NewDec(2).Sub(bondedRatio.Quo(params.GoalBonded))
+		if factor.IsNegative() {
+			factor = sdk.ZeroDec()
+		}
+		provision = provision.Mul(factor)
+	}
+
+	amount := provision.TruncateInt()
+	if params.AnnualProvisionCap.IsPositive() {
+		amount = sdk.MinInt(amount, params.AnnualProvisionCap.Sub(m.PeriodProvisions))
+	}
+	if params.MaxSupply.IsPositive() {
+		amount = sdk.MinInt(amount, params.MaxSupply.Sub(supply))
+	}
+	if amount.IsNegative() {
+		amount = sdk.ZeroInt()
+	}
+
+	return sdk.NewCoin(params.MintInflation.Denom, amount)
+}
@@ -14,10 +14,13 @@ const (
 	TypeMsgEditDefi                    = "edit_defi"
 	TypeMsgDefiDelegate                    = "delegate"
 	TypeMsgDefiUndelegate                  = "begin_unbonding"
+	TypeMsgDefiBeginRedelegate             = "begin_redelegate"
 	TypeMsgSetDefiWithdrawAddress          = "set_withdraw_address"
 	TypeMsgWithdrawDefiCommission      = "withdraw_defi_commission"
 	TypeMsgWithdrawDefiDelegatorReward     = "withdraw-delegator-reward"
 	TypeMsgFundDefiCommunityPool           = "fund_community_pool"
+	TypeMsgSlashDefi                       = "slash_defi"
+	TypeMsgUnjailDefi                      = "unjail_defi"
 )
 
 var (
@@ -27,23 +30,27 @@ var (
 	_ sdk.Msg                            = &MsgEditDefi{}
 	_ sdk.Msg                            = &MsgDefiDelegate{}
 	_ sdk.Msg                            = &MsgDefiUndelegate{}
+	_ sdk.Msg                            = &MsgDefiBeginRedelegate{}
 	_ sdk.Msg                            = &MsgSetDefiWithdrawAddress{}
 	_ sdk.Msg                            = &MsgWithdrawDefiCommission{}
 	_ sdk.Msg                            = &MsgWithdrawDefiDelegatorReward{}
 	_ sdk.Msg                            = &MsgFundDefiCommunityPool{}
+	_ sdk.Msg                            = &MsgSlashDefi{}
+	_ sdk.Msg                            = &MsgUnjailDefi{}
 )
 
 // NewMsgCreateDefi creates a new MsgCreateDefi instance.
 // Delegator address and defi address are the same.
 func NewMsgCreateDefi(
 	defiAddr sdk.ValAddress, //nolint:interfacer
-	selfDelegation sdk.Coin, description Description, minSelfDelegation sdk.Int,
+	selfDelegation sdk.Coin, description Description, commission CommissionRates, minSelfDelegation sdk.Int,
 ) (*MsgCreateDefi, error) {
 	return &MsgCreateDefi{
 		Description:       description,
 		DelegatorAddress:  sdk.AccAddress(defiAddr).String(),
 		DefiAddress:       defiAddr.String(),
 		Value:             selfDelegation,
+		Commission:        commission,
 		MinSelfDelegation: minSelfDelegation,
 	}, nil
 }
@@ -113,6 +120,14 @@ func (msg MsgCreateDefi) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty description")
 	}
 
+	if msg.Commission == (CommissionRates{}) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty commission")
+	}
+
+	if err := msg.Commission.Validate(); err != nil {
+		return err
+	}
+
 	if !msg.MinSelfDelegation.IsPositive() {
 		return ErrMinSelfDelegationInvalid
 	}
@@ -131,10 +146,11 @@ func (msg MsgCreateDefi) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 
 // NewMsgEditDefi creates a new MsgEditDefi instance
 //nolint:interfacer
-func NewMsgEditDefi(defiAddr sdk.ValAddress, description Description, newMinSelfDelegation *sdk.Int) *MsgEditDefi {
+func NewMsgEditDefi(defiAddr sdk.ValAddress, description Description, newRate *sdk.Dec, newMinSelfDelegation *sdk.Int) *MsgEditDefi {
 	return &MsgEditDefi{
 		Description:       description,
 		DefiAddress:       defiAddr.String(),
+		CommissionRate:    newRate,
 		MinSelfDelegation: newMinSelfDelegation,
 	}
 }
@@ -174,6 +190,12 @@ func (msg MsgEditDefi) ValidateBasic() error {
 		return ErrMinSelfDelegationInvalid
 	}
 
+	if msg.CommissionRate != nil {
+		if msg.CommissionRate.GT(sdk.OneDec()) || msg.CommissionRate.IsNegative() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "commission rate must be between 0 and 1 (inclusive)")
+		}
+	}
+
 	return nil
 }
 
@@ -225,6 +247,61 @@ func (msg MsgDefiDelegate) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgDefiBeginRedelegate creates a new MsgDefiBeginRedelegate instance.
+//nolint:interfacer
+func NewMsgDefiBeginRedelegate(
+	delAddr sdk.AccAddress, defiSrcAddr, defiDstAddr sdk.ValAddress, amount sdk.Coin,
+) *MsgDefiBeginRedelegate {
+	return &MsgDefiBeginRedelegate{
+		DelegatorAddress: delAddr.String(),
+		DefiSrcAddress:   defiSrcAddr.String(),
+		DefiDstAddress:   defiDstAddr.String(),
+		Amount:           amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgDefiBeginRedelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgDefiBeginRedelegate) Type() string { return TypeMsgDefiBeginRedelegate }
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgDefiBeginRedelegate) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgDefiBeginRedelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgDefiBeginRedelegate) ValidateBasic() error {
+	if msg.DelegatorAddress == "" {
+		return ErrEmptyDelegatorAddr
+	}
+
+	if msg.DefiSrcAddress == "" {
+		return ErrEmptyDefiAddr
+	}
+
+	if msg.DefiDstAddress == "" {
+		return ErrEmptyDefiAddr
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return ErrBadSharesAmount
+	}
+
+	return nil
+}
+
 // NewMsgDefiUndelegate creates a new MsgDefiUndelegate instance.
 //nolint:interfacer
 func NewMsgDefiUndelegate(delAddr sdk.AccAddress, defiAddr sdk.ValAddress, amount sdk.Coin) *MsgDefiUndelegate {
@@ -427,3 +504,111 @@ func (msg MsgFundDefiCommunityPool) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgSlashDefi creates a new MsgSlashDefi instance.
+//nolint:interfacer
+func NewMsgSlashDefi(
+	authority sdk.AccAddress, defiAddr sdk.ValAddress, infractionHeight int64, slashFraction sdk.Dec, jail bool,
+) *MsgSlashDefi {
+	return &MsgSlashDefi{
+		Authority:        authority.String(),
+		DefiAddress:      defiAddr.String(),
+		InfractionHeight: infractionHeight,
+		SlashFraction:    slashFraction,
+		Jail:             jail,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSlashDefi) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSlashDefi) Type() string { return TypeMsgSlashDefi }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSlashDefi) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSlashDefi) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSlashDefi) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	return ValidateSlash(msg.DefiAddress, msg.InfractionHeight, msg.SlashFraction)
+}
+
+// NewMsgUnjailDefi creates a new MsgUnjailDefi instance.
+//nolint:interfacer
+func NewMsgUnjailDefi(defiAddr sdk.ValAddress) *MsgUnjailDefi {
+	return &MsgUnjailDefi{
+		DefiAddress: defiAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUnjailDefi) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUnjailDefi) Type() string { return TypeMsgUnjailDefi }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUnjailDefi) GetSigners() []sdk.AccAddress {
+	defiAddr, err := sdk.ValAddressFromBech32(msg.DefiAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{defiAddr.Bytes()}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUnjailDefi) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUnjailDefi) ValidateBasic() error {
+	if msg.DefiAddress == "" {
+		return ErrEmptyDefiAddr
+	}
+
+	if _, err := sdk.ValAddressFromBech32(msg.DefiAddress); err != nil {
+		return ErrBadDefiAddr
+	}
+
+	return nil
+}
+
+// ValidateSlash checks the defi address, the infraction height and the slash
+// fraction of a slash, whether submitted by an evidence handler or governance
+func ValidateSlash(defiAddress string, infractionHeight int64, slashFraction sdk.Dec) error {
+	if defiAddress == "" {
+		return ErrEmptyDefiAddr
+	}
+
+	if _, err := sdk.ValAddressFromBech32(defiAddress); err != nil {
+		return ErrBadDefiAddr
+	}
+
+	if infractionHeight < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "negative infraction height %d", infractionHeight)
+	}
+
+	if slashFraction.IsNil() || !slashFraction.IsPositive() || slashFraction.GT(sdk.OneDec()) {
+		return ErrInvalidSlashFraction
+	}
+
+	return nil
+}
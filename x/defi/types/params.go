@@ -29,18 +29,27 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultJailDuration is the min time a slashed defi stays jailed
+	DefaultJailDuration time.Duration = time.Hour * 24
 )
 
 var (
 	KeyBondDenom         = []byte("BondDenom")
 	KeyMintInflation     = []byte("MintInflation")
 	KeyCommunityTax      = []byte("CommunityTax")
-	KeyCommissionRate    = []byte("CommissionRate")
+	KeyMaxCommissionRate = []byte("MaxCommissionRate")
 	KeyMarketRate        = []byte("MarketRate")
 	KeyUnbondingTime     = []byte("UnbondingTime")
 	KeyMaxDefis          = []byte("MaxDefis")
 	KeyMaxEntries        = []byte("MaxEntries")
 	KeyHistoricalEntries = []byte("HistoricalEntries") 
+	KeyJailDuration      = []byte("JailDuration")
+	KeyEvidenceHandlers  = []byte("EvidenceHandlers")
+	KeyAnnualProvisionCap = []byte("AnnualProvisionCap")
+	KeyMaxSupply          = []byte("MaxSupply")
+	KeyInflationDecay     = []byte("InflationDecay")
+	KeyGoalBonded         = []byte("GoalBonded")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -51,18 +60,25 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the defi module
-func NewParams(bondDenom string, mintInflation sdk.Coin, communityTax, commissionRate, marketRate sdk.Dec, 
-	unbondingTime time.Duration, maxDefis, maxEntries, historicalEntries uint32) Params {
+func NewParams(bondDenom string, mintInflation sdk.Coin, communityTax, maxCommissionRate, marketRate sdk.Dec, 
+	unbondingTime time.Duration, maxDefis, maxEntries, historicalEntries uint32, jailDuration time.Duration,
+	evidenceHandlers []string, annualProvisionCap, maxSupply sdk.Int, inflationDecay, goalBonded sdk.Dec) Params {
 	return Params{
 		BondDenom: bondDenom,
 		MintInflation: mintInflation,
 		CommunityTax: communityTax,
-		CommissionRate: commissionRate,
+		MaxCommissionRate: maxCommissionRate,
 		MarketRate: marketRate,
 		UnbondingTime: unbondingTime,
 		MaxDefis: maxDefis,
 		MaxEntries: maxEntries,
 		HistoricalEntries: historicalEntries,
+		JailDuration: jailDuration,
+		EvidenceHandlers: evidenceHandlers,
+		AnnualProvisionCap: annualProvisionCap,
+		MaxSupply: maxSupply,
+		InflationDecay: inflationDecay,
+		GoalBonded: goalBonded,
 	}
 }
 
@@ -78,6 +94,12 @@ func DefaultParams() Params {
 		DefaultMaxDefis,
 		DefaultMaxEntries,
 		DefaultHistoricalEntries,
+		DefaultJailDuration,
+		[]string{},
+		sdk.ZeroInt(), // no annual cap
+		sdk.ZeroInt(), // no supply ceiling
+		sdk.ZeroDec(), // no decay
+		sdk.ZeroDec(), // no bonded adjustment
 	)
 }
 
@@ -87,12 +109,18 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
 		paramtypes.NewParamSetPair(KeyMintInflation, &p.MintInflation, validateMintInflation),
 		paramtypes.NewParamSetPair(KeyCommunityTax, &p.CommunityTax, validateCommunityTax),
-		paramtypes.NewParamSetPair(KeyCommissionRate, &p.CommissionRate, validateCommissionRate),
+		paramtypes.NewParamSetPair(KeyMaxCommissionRate, &p.MaxCommissionRate, validateMaxCommissionRate),
 		paramtypes.NewParamSetPair(KeyMarketRate, &p.MarketRate, validateMarketRate),
 		paramtypes.NewParamSetPair(KeyUnbondingTime, &p.UnbondingTime, validateUnbondingTime),
 		paramtypes.NewParamSetPair(KeyMaxDefis, &p.MaxDefis, validateMaxDefis),
 		paramtypes.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
+		paramtypes.NewParamSetPair(KeyJailDuration, &p.JailDuration, validateJailDuration),
+		paramtypes.NewParamSetPair(KeyEvidenceHandlers, &p.EvidenceHandlers, validateEvidenceHandlers),
+		paramtypes.NewParamSetPair(KeyAnnualProvisionCap, &p.AnnualProvisionCap, validateAnnualProvisionCap),
+		paramtypes.NewParamSetPair(KeyMaxSupply, &p.MaxSupply, validateMaxSupply),
+		paramtypes.NewParamSetPair(KeyInflationDecay, &p.InflationDecay, validateInflationDecay),
+		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
 	}
 }
 
@@ -130,7 +158,7 @@ func (p Params) Validate() error {
 	if err := validateCommunityTax(p.CommunityTax); err != nil {
 		return err
 	}
-	if err := validateCommissionRate(p.CommissionRate); err != nil {
+	if err := validateMaxCommissionRate(p.MaxCommissionRate); err != nil {
 		return err
 	}
 	if err := validateMarketRate(p.MarketRate); err != nil {
@@ -148,6 +176,24 @@ func (p Params) Validate() error {
 	if err := validateHistoricalEntries(p.HistoricalEntries); err != nil {
 		return err
 	}
+	if err := validateJailDuration(p.JailDuration); err != nil {
+		return err
+	}
+	if err := validateEvidenceHandlers(p.EvidenceHandlers); err != nil {
+		return err
+	}
+	if err := validateAnnualProvisionCap(p.AnnualProvisionCap); err != nil {
+		return err
+	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+	if err := validateInflationDecay(p.InflationDecay); err != nil {
+		return err
+	}
+	if err := validateGoalBonded(p.GoalBonded); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -185,22 +231,22 @@ func validateCommunityTax(i interface{}) error {
 	return nil
 }
 
-func validateCommissionRate(i interface{}) error {
+func validateMaxCommissionRate(i interface{}) error {
 	v, ok := i.(sdk.Dec)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
 	if v.IsNil() {
-		return fmt.Errorf("commission rate must be not nil")
+		return fmt.Errorf("max commission rate must be not nil")
 	}
 
 	if v.IsNegative() {
-		return fmt.Errorf("commission rate must be positive: %s", v)
+		return fmt.Errorf("max commission rate must be positive: %s", v)
 	}
 
 	if v.GT(sdk.OneDec()) {
-		return fmt.Errorf("commission rate too large: %s", v)
+		return fmt.Errorf("max commission rate too large: %s", v)
 	}
 
 	return nil
@@ -275,6 +321,116 @@ func validateHistoricalEntries(i interface{}) error {
 	return nil
 }
 
+func validateJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("jail duration must not be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateEvidenceHandlers(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, handler := range v {
+		if _, err := sdk.AccAddressFromBech32(handler); err != nil {
+			return fmt.Errorf("invalid evidence handler %s: %w", handler, err)
+		}
+
+		if seen[handler] {
+			return fmt.Errorf("duplicate evidence handler: %s", handler)
+		}
+		seen[handler] = true
+	}
+
+	return nil
+}
+
+func validateAnnualProvisionCap(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("annual provision cap must be not nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("annual provision cap must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max supply must be not nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max supply must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateInflationDecay(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("inflation decay must be not nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("inflation decay must not be negative: %s", v)
+	}
+
+	if v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("inflation decay too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateGoalBonded(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("goal bonded must be not nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("goal bonded must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("goal bonded too large: %s", v)
+	}
+
+	return nil
+}
+
 func validateBondDenom(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSlashDefi defines the type for a SlashDefiProposal
+	ProposalTypeSlashDefi = "SlashDefi"
+)
+
+// Assert SlashDefiProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &SlashDefiProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSlashDefi)
+	govtypes.RegisterProposalTypeCodec(&SlashDefiProposal{}, "gauss/SlashDefiProposal")
+}
+
+// NewSlashDefiProposal creates a new slash defi proposal
+func NewSlashDefiProposal(
+	title, description string, defiAddr sdk.ValAddress, infractionHeight int64, slashFraction sdk.Dec, jail bool,
+) *SlashDefiProposal {
+	return &SlashDefiProposal{
+		Title:            title,
+		Description:      description,
+		DefiAddress:      defiAddr.String(),
+		InfractionHeight: infractionHeight,
+		SlashFraction:    slashFraction,
+		Jail:             jail,
+	}
+}
+
+// GetTitle returns the title of a slash defi proposal
+func (p *SlashDefiProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a slash defi proposal
+func (p *SlashDefiProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a slash defi proposal
+func (p *SlashDefiProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a slash defi proposal
+func (p *SlashDefiProposal) ProposalType() string { return ProposalTypeSlashDefi }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SlashDefiProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return ValidateSlash(p.DefiAddress, p.InfractionHeight, p.SlashFraction)
+}
+
+// String implements the Stringer interface
+func (p SlashDefiProposal) String() string {
+	return fmt.Sprintf(`Slash Defi Proposal:
+  Title:             %s
+  Description:       %s
+  Defi:              %s
+  Infraction Height: %d
+  Slash Fraction:    %s
+  Jail:              %t
+`, p.Title, p.Description, p.DefiAddress, p.InfractionHeight, p.SlashFraction, p.Jail)
+}
@@ -1022,6 +1022,107 @@ func (m *QueryDelegatorUnbondingDelegationsResponse) GetPagination() *query.Page
 	return nil
 }
 
+// QueryRedelegationsRequest is request type for the Query/Redelegations RPC
+// method.
+type QueryRedelegationsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// src_defi_address defines the defi address to redelegate from.
+	SrcDefiAddress string `protobuf:"bytes,2,opt,name=src_defi_address,json=srcDefiAddress,proto3" json:"src_defi_address,omitempty"`
+	// dst_defi_address defines the defi address to redelegate to.
+	DstDefiAddress string `protobuf:"bytes,3,opt,name=dst_defi_address,json=dstDefiAddress,proto3" json:"dst_defi_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRedelegationsRequest) Reset()         { *m = QueryRedelegationsRequest{} }
+func (m *QueryRedelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedelegationsRequest) ProtoMessage()    {}
+func (*QueryRedelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b72c224955f0a544, []int{20}
+}
+func (m *QueryRedelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedelegationsRequest.Merge(m, src)
+}
+func (m *QueryRedelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedelegationsRequest proto.InternalMessageInfo
+
+// QueryRedelegationsResponse is response type for the Query/Redelegations RPC
+// method.
+type QueryRedelegationsResponse struct {
+	RedelegationResponses RedelegationResponses `protobuf:"bytes,1,rep,name=redelegation_responses,json=redelegationResponses,proto3,castrepeated=RedelegationResponses" json:"redelegation_responses"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRedelegationsResponse) Reset()         { *m = QueryRedelegationsResponse{} }
+func (m *QueryRedelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedelegationsResponse) ProtoMessage()    {}
+func (*QueryRedelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b72c224955f0a544, []int{21}
+}
+func (m *QueryRedelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedelegationsResponse.Merge(m, src)
+}
+func (m *QueryRedelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryRedelegationsResponse) GetRedelegationResponses() RedelegationResponses {
+	if m != nil {
+		return m.RedelegationResponses
+	}
+	return nil
+}
+
+func (m *QueryRedelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryDelegatorDefisRequest is request type for the
 // Query/DelegatorDefis RPC method.
 type QueryDelegatorDefisRequest struct {
@@ -1035,7 +1136,7 @@ func (m *QueryDelegatorDefisRequest) Reset()         { *m = QueryDelegatorDefisR
 func (m *QueryDelegatorDefisRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorDefisRequest) ProtoMessage()    {}
 func (*QueryDelegatorDefisRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{20}
+	return fileDescriptor_b72c224955f0a544, []int{22}
 }
 func (m *QueryDelegatorDefisRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1077,7 +1178,7 @@ func (m *QueryDelegatorDefisResponse) Reset()         { *m = QueryDelegatorDefis
 func (m *QueryDelegatorDefisResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorDefisResponse) ProtoMessage()    {}
 func (*QueryDelegatorDefisResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{21}
+	return fileDescriptor_b72c224955f0a544, []int{23}
 }
 func (m *QueryDelegatorDefisResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1133,7 +1234,7 @@ func (m *QueryDelegatorDefiRequest) Reset()         { *m = QueryDelegatorDefiReq
 func (m *QueryDelegatorDefiRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorDefiRequest) ProtoMessage()    {}
 func (*QueryDelegatorDefiRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{22}
+	return fileDescriptor_b72c224955f0a544, []int{24}
 }
 func (m *QueryDelegatorDefiRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1173,7 +1274,7 @@ func (m *QueryDelegatorDefiResponse) Reset()         { *m = QueryDelegatorDefiRe
 func (m *QueryDelegatorDefiResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorDefiResponse) ProtoMessage()    {}
 func (*QueryDelegatorDefiResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{23}
+	return fileDescriptor_b72c224955f0a544, []int{25}
 }
 func (m *QueryDelegatorDefiResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1222,7 +1323,7 @@ func (m *QueryDelegationRewardsRequest) Reset()         { *m = QueryDelegationRe
 func (m *QueryDelegationRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationRewardsRequest) ProtoMessage()    {}
 func (*QueryDelegationRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{24}
+	return fileDescriptor_b72c224955f0a544, []int{26}
 }
 func (m *QueryDelegationRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1262,7 +1363,7 @@ func (m *QueryDelegationRewardsResponse) Reset()         { *m = QueryDelegationR
 func (m *QueryDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationRewardsResponse) ProtoMessage()    {}
 func (*QueryDelegationRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{25}
+	return fileDescriptor_b72c224955f0a544, []int{27}
 }
 func (m *QueryDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1309,7 +1410,7 @@ func (m *QueryDelegationTotalRewardsRequest) Reset()         { *m = QueryDelegat
 func (m *QueryDelegationTotalRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationTotalRewardsRequest) ProtoMessage()    {}
 func (*QueryDelegationTotalRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{26}
+	return fileDescriptor_b72c224955f0a544, []int{28}
 }
 func (m *QueryDelegationTotalRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1351,7 +1452,7 @@ func (m *QueryDelegationTotalRewardsResponse) Reset()         { *m = QueryDelega
 func (m *QueryDelegationTotalRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationTotalRewardsResponse) ProtoMessage()    {}
 func (*QueryDelegationTotalRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{27}
+	return fileDescriptor_b72c224955f0a544, []int{29}
 }
 func (m *QueryDelegationTotalRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1405,7 +1506,7 @@ func (m *QueryDelegatorDefisExRequest) Reset()         { *m = QueryDelegatorDefi
 func (m *QueryDelegatorDefisExRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorDefisExRequest) ProtoMessage()    {}
 func (*QueryDelegatorDefisExRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{28}
+	return fileDescriptor_b72c224955f0a544, []int{30}
 }
 func (m *QueryDelegatorDefisExRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1445,7 +1546,7 @@ func (m *QueryDelegatorDefisExResponse) Reset()         { *m = QueryDelegatorDef
 func (m *QueryDelegatorDefisExResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorDefisExResponse) ProtoMessage()    {}
 func (*QueryDelegatorDefisExResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{29}
+	return fileDescriptor_b72c224955f0a544, []int{31}
 }
 func (m *QueryDelegatorDefisExResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1485,7 +1586,7 @@ func (m *QueryDelegatorWithdrawAddressRequest) Reset()         { *m = QueryDeleg
 func (m *QueryDelegatorWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressRequest) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{30}
+	return fileDescriptor_b72c224955f0a544, []int{32}
 }
 func (m *QueryDelegatorWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1525,7 +1626,7 @@ func (m *QueryDelegatorWithdrawAddressResponse) Reset()         { *m = QueryDele
 func (m *QueryDelegatorWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressResponse) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{31}
+	return fileDescriptor_b72c224955f0a544, []int{33}
 }
 func (m *QueryDelegatorWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1565,7 +1666,7 @@ func (m *QueryHistoricalInfoRequest) Reset()         { *m = QueryHistoricalInfoR
 func (m *QueryHistoricalInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHistoricalInfoRequest) ProtoMessage()    {}
 func (*QueryHistoricalInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{32}
+	return fileDescriptor_b72c224955f0a544, []int{34}
 }
 func (m *QueryHistoricalInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1612,7 +1713,7 @@ func (m *QueryHistoricalInfoResponse) Reset()         { *m = QueryHistoricalInfo
 func (m *QueryHistoricalInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHistoricalInfoResponse) ProtoMessage()    {}
 func (*QueryHistoricalInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{33}
+	return fileDescriptor_b72c224955f0a544, []int{35}
 }
 func (m *QueryHistoricalInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1656,7 +1757,7 @@ func (m *QueryPoolRequest) Reset()         { *m = QueryPoolRequest{} }
 func (m *QueryPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolRequest) ProtoMessage()    {}
 func (*QueryPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{34}
+	return fileDescriptor_b72c224955f0a544, []int{36}
 }
 func (m *QueryPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1695,7 +1796,7 @@ func (m *QueryPoolResponse) Reset()         { *m = QueryPoolResponse{} }
 func (m *QueryPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolResponse) ProtoMessage()    {}
 func (*QueryPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{35}
+	return fileDescriptor_b72c224955f0a544, []int{37}
 }
 func (m *QueryPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1739,7 +1840,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{36}
+	return fileDescriptor_b72c224955f0a544, []int{38}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1778,7 +1879,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{37}
+	return fileDescriptor_b72c224955f0a544, []int{39}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1823,7 +1924,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{38}
+	return fileDescriptor_b72c224955f0a544, []int{40}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1863,7 +1964,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b72c224955f0a544, []int{39}
+	return fileDescriptor_b72c224955f0a544, []int{41}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1899,6 +2000,104 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryDefiInflationRequest is the request type for the Query/DefiInflation RPC
+// method.
+type QueryDefiInflationRequest struct {
+}
+
+func (m *QueryDefiInflationRequest) Reset()         { *m = QueryDefiInflationRequest{} }
+func (m *QueryDefiInflationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDefiInflationRequest) ProtoMessage()    {}
+func (*QueryDefiInflationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b72c224955f0a544, []int{42}
+}
+func (m *QueryDefiInflationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDefiInflationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDefiInflationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDefiInflationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDefiInflationRequest.Merge(m, src)
+}
+func (m *QueryDefiInflationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDefiInflationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDefiInflationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDefiInflationRequest proto.InternalMessageInfo
+
+// QueryDefiInflationResponse is the response type for the Query/DefiInflation
+// RPC method.
+type QueryDefiInflationResponse struct {
+	// provision is the amount minted by the next trade.
+	Provision types.Coin `protobuf:"bytes,1,opt,name=provision,proto3" json:"provision"`
+	// bonded_ratio is the current bonded ratio the provision is adjusted with.
+	BondedRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=bonded_ratio,json=bondedRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonded_ratio" yaml:"bonded_ratio"`
+	// supply is the current total supply of the minted denom.
+	Supply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=supply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply"`
+	// minter is the current annual period.
+	Minter DefiMinter `protobuf:"bytes,4,opt,name=minter,proto3" json:"minter"`
+}
+
+func (m *QueryDefiInflationResponse) Reset()         { *m = QueryDefiInflationResponse{} }
+func (m *QueryDefiInflationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDefiInflationResponse) ProtoMessage()    {}
+func (*QueryDefiInflationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b72c224955f0a544, []int{43}
+}
+func (m *QueryDefiInflationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDefiInflationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDefiInflationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDefiInflationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDefiInflationResponse.Merge(m, src)
+}
+func (m *QueryDefiInflationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDefiInflationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDefiInflationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDefiInflationResponse proto.InternalMessageInfo
+
+func (m *QueryDefiInflationResponse) GetProvision() types.Coin {
+	if m != nil {
+		return m.Provision
+	}
+	return types.Coin{}
+}
+
+func (m *QueryDefiInflationResponse) GetMinter() DefiMinter {
+	if m != nil {
+		return m.Minter
+	}
+	return DefiMinter{}
+}
+
 func init() {
 	proto.RegisterType((*QueryDefisRequest)(nil), "gauss.defi.QueryDefisRequest")
 	proto.RegisterType((*QueryDefisResponse)(nil), "gauss.defi.QueryDefisResponse")
@@ -1920,6 +2119,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorDelegationsResponse)(nil), "gauss.defi.QueryDelegatorDelegationsResponse")
 	proto.RegisterType((*QueryDelegatorUnbondingDelegationsRequest)(nil), "gauss.defi.QueryDelegatorUnbondingDelegationsRequest")
 	proto.RegisterType((*QueryDelegatorUnbondingDelegationsResponse)(nil), "gauss.defi.QueryDelegatorUnbondingDelegationsResponse")
+	proto.RegisterType((*QueryRedelegationsRequest)(nil), "gauss.defi.QueryRedelegationsRequest")
+	proto.RegisterType((*QueryRedelegationsResponse)(nil), "gauss.defi.QueryRedelegationsResponse")
 	proto.RegisterType((*QueryDelegatorDefisRequest)(nil), "gauss.defi.QueryDelegatorDefisRequest")
 	proto.RegisterType((*QueryDelegatorDefisResponse)(nil), "gauss.defi.QueryDelegatorDefisResponse")
 	proto.RegisterType((*QueryDelegatorDefiRequest)(nil), "gauss.defi.QueryDelegatorDefiRequest")
@@ -1940,119 +2141,138 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "gauss.defi.QueryParamsResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "gauss.defi.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "gauss.defi.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryDefiInflationRequest)(nil), "gauss.defi.QueryDefiInflationRequest")
+	proto.RegisterType((*QueryDefiInflationResponse)(nil), "gauss.defi.QueryDefiInflationResponse")
 }
 
 func init() { proto.RegisterFile("gauss/defi/query.proto", fileDescriptor_b72c224955f0a544) }
 
 var fileDescriptor_b72c224955f0a544 = []byte{
-	// 1709 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdf, 0x6f, 0x14, 0xd5,
-	0x17, 0xef, 0x2d, 0x6d, 0xbf, 0x70, 0xf8, 0x2a, 0xed, 0x6d, 0x29, 0x65, 0xda, 0x6e, 0xcb, 0x60,
-	0x4b, 0x7f, 0xb1, 0x43, 0x4b, 0xf9, 0x21, 0x8a, 0xa5, 0x3f, 0xb0, 0x10, 0x63, 0xc0, 0x8d, 0x48,
-	0xa2, 0x92, 0x66, 0xba, 0x3b, 0xdd, 0x0e, 0xec, 0xce, 0x2c, 0x3b, 0xb3, 0x14, 0x42, 0x78, 0xd1,
-	0xc4, 0x90, 0x18, 0x13, 0x8d, 0xc6, 0x07, 0x49, 0x0c, 0xc6, 0x07, 0x0d, 0xf1, 0xdd, 0xf8, 0xe4,
-	0x93, 0x49, 0x63, 0x62, 0x42, 0x42, 0x8c, 0x3e, 0x18, 0x35, 0xe0, 0x83, 0x7f, 0x86, 0x99, 0x3b,
-	0x67, 0x66, 0xef, 0xcc, 0xdc, 0x99, 0xdd, 0xb5, 0x6c, 0x1a, 0x5f, 0x4a, 0x7b, 0xef, 0xb9, 0xe7,
-	0x7c, 0x3e, 0xe7, 0xde, 0x7b, 0xee, 0xf9, 0x0c, 0xd0, 0x9b, 0x57, 0x2b, 0x96, 0xa5, 0xe4, 0xb4,
-	0x35, 0x5d, 0xb9, 0x5e, 0xd1, 0xca, 0xb7, 0xd2, 0xa5, 0xb2, 0x69, 0x9b, 0x14, 0xd8, 0x78, 0xda,
-	0x19, 0x97, 0x26, 0xb2, 0xa6, 0x55, 0x34, 0x2d, 0x65, 0x55, 0xb5, 0x34, 0xd7, 0x48, 0xb9, 0x31,
-	0xbd, 0xaa, 0xd9, 0xea, 0xb4, 0x52, 0x52, 0xf3, 0xba, 0xa1, 0xda, 0xba, 0x69, 0xb8, 0xeb, 0xa4,
-	0x9e, 0xbc, 0x99, 0x37, 0xd9, 0xaf, 0x8a, 0xf3, 0x1b, 0x8e, 0x0e, 0xe4, 0x4d, 0x33, 0x5f, 0xd0,
-	0x14, 0xb5, 0xa4, 0x2b, 0xaa, 0x61, 0x98, 0x36, 0x5b, 0x62, 0xe1, 0x6c, 0x8a, 0xf7, 0xef, 0x79,
-	0xce, 0x9a, 0xba, 0xe7, 0x73, 0x2f, 0x87, 0xd1, 0xf9, 0xe1, 0x0e, 0xcb, 0x16, 0x74, 0xbd, 0xe6,
-	0x80, 0x59, 0xd2, 0xd6, 0x74, 0x2b, 0xa3, 0x5d, 0xaf, 0x68, 0x96, 0x4d, 0x7b, 0xa1, 0xc3, 0xb2,
-	0x55, 0xbb, 0x62, 0xf5, 0x91, 0x61, 0x32, 0xb6, 0x2b, 0x83, 0x7f, 0xd1, 0x97, 0x01, 0xaa, 0x58,
-	0xfb, 0x5a, 0x87, 0xc9, 0xd8, 0xee, 0x99, 0xd1, 0xb4, 0x1b, 0x38, 0xed, 0x04, 0x4e, 0xbb, 0xec,
-	0x31, 0x7c, 0xfa, 0xa2, 0x9a, 0xd7, 0xd0, 0x67, 0x86, 0x5b, 0x29, 0xbf, 0x4f, 0x80, 0xf2, 0x51,
-	0xad, 0x92, 0x69, 0x58, 0x1a, 0x9d, 0x82, 0x76, 0x07, 0x99, 0x13, 0x75, 0xc7, 0xd8, 0xee, 0x99,
-	0xce, 0x74, 0x35, 0x7d, 0x69, 0xc7, 0x72, 0xa1, 0x6d, 0xf3, 0xf7, 0xa1, 0x96, 0x8c, 0x6b, 0x44,
-	0x97, 0x05, 0x60, 0x0e, 0xd5, 0x04, 0xe3, 0x86, 0x0a, 0xa0, 0x39, 0x06, 0x9d, 0x3e, 0x18, 0x2f,
-	0x03, 0x07, 0xe0, 0xff, 0x4e, 0x94, 0x15, 0x35, 0x97, 0x2b, 0x6b, 0x96, 0x97, 0x87, 0xdd, 0xce,
-	0xd8, 0xbc, 0x3b, 0x24, 0xcf, 0x71, 0x99, 0xf3, 0x29, 0x4c, 0x40, 0x9b, 0x63, 0xc3, 0xec, 0xe3,
-	0x19, 0x30, 0x1b, 0xf9, 0x2e, 0x81, 0x7e, 0xdf, 0xc3, 0x92, 0x56, 0xd0, 0xf2, 0xee, 0x86, 0xd6,
-	0x8f, 0xe1, 0xa9, 0x6d, 0xc8, 0x2f, 0x04, 0x06, 0xc4, 0x50, 0x90, 0x97, 0x01, 0x3d, 0x39, 0x7f,
-	0x78, 0xa5, 0x8c, 0xc3, 0xde, 0x4e, 0xa5, 0x82, 0x3c, 0x3d, 0x3b, 0x6f, 0xf5, 0x42, 0xbf, 0xc3,
-	0xfa, 0xc1, 0x1f, 0x43, 0xdd, 0xd1, 0x39, 0x2b, 0xd3, 0x9d, 0x8b, 0x0e, 0x3e, 0xbd, 0xcd, 0xfd,
-	0x88, 0xc0, 0x73, 0x3e, 0xb3, 0x4b, 0xc6, 0xaa, 0x69, 0xe4, 0x74, 0x23, 0xbf, 0xbd, 0xd9, 0xde,
-	0x24, 0x30, 0x52, 0x03, 0x13, 0xa6, 0xfd, 0x0d, 0xe8, 0xae, 0x78, 0xf3, 0x91, 0xac, 0x0f, 0xf1,
-	0x59, 0x17, 0xb8, 0xc1, 0xc3, 0x46, 0x7d, 0x0f, 0x4d, 0x48, 0xef, 0x32, 0xc8, 0x3e, 0x93, 0x0b,
-	0x15, 0xdb, 0xb2, 0x55, 0x8c, 0xb4, 0xa1, 0x96, 0x73, 0x0d, 0xe4, 0x56, 0xd6, 0xe1, 0x60, 0xa2,
-	0x23, 0x4c, 0xc8, 0x02, 0xfc, 0xaf, 0xec, 0x0e, 0xe1, 0x15, 0x93, 0xc3, 0x57, 0x2c, 0xba, 0x18,
-	0xf3, 0xe0, 0x2d, 0x94, 0xe7, 0x40, 0xf2, 0x43, 0x2d, 0x9a, 0xc5, 0xa2, 0x6e, 0x59, 0xec, 0xec,
-	0xd5, 0x8d, 0xf5, 0x2a, 0x77, 0x6f, 0x79, 0x07, 0x88, 0xf1, 0x15, 0x80, 0xac, 0x3f, 0x8a, 0x30,
-	0x47, 0xc2, 0x30, 0xe7, 0xb3, 0xd9, 0x4a, 0xb1, 0x52, 0x50, 0x6d, 0x2d, 0x57, 0x75, 0x81, 0x48,
-	0xb9, 0xe5, 0x72, 0x19, 0x7a, 0x31, 0x56, 0xf5, 0x92, 0xb8, 0x40, 0x27, 0xa1, 0x0b, 0x6f, 0x8e,
-	0x59, 0x0e, 0xa1, 0xed, 0xf4, 0x27, 0xbc, 0xa3, 0x1b, 0x66, 0xd5, 0x1a, 0x61, 0x75, 0x6a, 0xe7,
-	0xdd, 0xfb, 0x43, 0x2d, 0x7f, 0xdf, 0x1f, 0x6a, 0x91, 0xaf, 0xc2, 0xbe, 0x48, 0x4c, 0xe4, 0x76,
-	0x01, 0xba, 0x05, 0x75, 0x00, 0x49, 0xd6, 0x28, 0x03, 0x19, 0x1a, 0xbd, 0xe9, 0xf2, 0x6d, 0x18,
-	0x62, 0xb1, 0x04, 0xe7, 0xb7, 0xf9, 0x44, 0x55, 0x18, 0x8e, 0x0f, 0x8e, 0x8c, 0x4f, 0x43, 0x87,
-	0x7b, 0x81, 0x90, 0x64, 0x9d, 0xb7, 0x0e, 0x17, 0xc9, 0x5f, 0x10, 0x8c, 0xb1, 0xe4, 0x21, 0x15,
-	0xd4, 0x9e, 0x86, 0x18, 0x3e, 0xa5, 0x2a, 0xc4, 0xa5, 0xe1, 0x07, 0x02, 0x07, 0x12, 0x30, 0x62,
-	0x22, 0x2e, 0x6f, 0xe9, 0x09, 0x70, 0xb3, 0xd2, 0xdc, 0x5a, 0xff, 0x80, 0xc0, 0x78, 0x90, 0x47,
-	0x52, 0xc1, 0xdf, 0xe6, 0xa4, 0xff, 0x44, 0x60, 0xa2, 0x1e, 0xb0, 0xff, 0x95, 0x97, 0xe0, 0x1e,
-	0xf1, 0xcb, 0xaa, 0x7f, 0x88, 0xb8, 0x96, 0x72, 0x9b, 0xb3, 0xfd, 0x49, 0xb5, 0xd7, 0x0a, 0xa2,
-	0xdb, 0xde, 0xd6, 0xb3, 0x02, 0xfb, 0xa3, 0xa8, 0x9a, 0x5f, 0xf7, 0xce, 0x89, 0xb6, 0xea, 0x5f,
-	0xf5, 0xb0, 0xb7, 0x60, 0x30, 0xf2, 0x54, 0x04, 0x9e, 0xfe, 0xe6, 0x91, 0xf8, 0x80, 0x40, 0x2a,
-	0x2e, 0x36, 0x32, 0xb9, 0xc6, 0x77, 0x0b, 0xce, 0xbe, 0x0e, 0x04, 0x36, 0xc9, 0xdb, 0x9e, 0x25,
-	0x2d, 0xbb, 0x68, 0xea, 0xc6, 0xc2, 0x51, 0x6c, 0x53, 0x27, 0xf3, 0xba, 0xbd, 0x5e, 0x59, 0x4d,
-	0x67, 0xcd, 0xa2, 0x82, 0xaa, 0xca, 0xfd, 0xe7, 0xb0, 0x95, 0xbb, 0xa6, 0xd8, 0xb7, 0x4a, 0x9a,
-	0xe5, 0xad, 0xb1, 0xaa, 0x6d, 0xc5, 0x5b, 0x7e, 0x2b, 0xe4, 0xc1, 0x79, 0xdd, 0xb4, 0xd5, 0xc2,
-	0x16, 0xf2, 0xc1, 0x91, 0xfd, 0x99, 0xf8, 0xfd, 0x91, 0xd8, 0x3b, 0x32, 0x3e, 0x1b, 0x66, 0x3c,
-	0x22, 0xae, 0xcb, 0xfe, 0xce, 0xbb, 0x0e, 0x42, 0x2d, 0x12, 0xcd, 0x43, 0xbb, 0xed, 0xb8, 0xef,
-	0x6b, 0x6d, 0x56, 0xda, 0x5c, 0xff, 0xf2, 0x25, 0x5f, 0x77, 0xf0, 0xd7, 0xf2, 0xec, 0xcd, 0x2d,
-	0xa6, 0x6b, 0x2e, 0x78, 0x2c, 0x39, 0xb7, 0x98, 0xa7, 0x1e, 0xfe, 0xbe, 0xef, 0xc2, 0x7b, 0xcd,
-	0x39, 0xb8, 0xe2, 0xab, 0x06, 0x74, 0x70, 0x59, 0xb7, 0xd7, 0x73, 0x65, 0x75, 0x03, 0x63, 0x6d,
-	0x11, 0xdf, 0xdb, 0xbe, 0x00, 0x88, 0x73, 0x8f, 0x38, 0xc7, 0xa1, 0x73, 0x03, 0xa7, 0x42, 0xee,
-	0xf7, 0x6c, 0x04, 0x97, 0x70, 0xde, 0x67, 0xf1, 0x7a, 0x9f, 0xd3, 0x2d, 0xdb, 0x2c, 0xeb, 0x59,
-	0xb5, 0x70, 0xde, 0x58, 0x33, 0x39, 0x71, 0xbf, 0xae, 0xe9, 0xf9, 0x75, 0x9b, 0x39, 0xda, 0x91,
-	0xc1, 0xbf, 0xe4, 0x57, 0xb1, 0x42, 0x86, 0x57, 0x21, 0x92, 0x34, 0xb4, 0xad, 0xeb, 0x96, 0x8d,
-	0x55, 0x41, 0xe2, 0x8f, 0x55, 0x68, 0x05, 0xb3, 0x93, 0x29, 0xaa, 0xea, 0x8b, 0xa6, 0x59, 0xc0,
-	0xd0, 0xbe, 0x64, 0x76, 0xc7, 0xaa, 0xe5, 0xa6, 0x64, 0x9a, 0x05, 0x51, 0xb9, 0x71, 0xec, 0xbc,
-	0x72, 0xe3, 0xd8, 0xc8, 0x3d, 0xf8, 0xdd, 0xe0, 0xa2, 0x5a, 0x56, 0x8b, 0xde, 0x26, 0xc8, 0xcb,
-	0xd0, 0x1d, 0x18, 0x45, 0xc7, 0x47, 0xa0, 0xa3, 0xc4, 0x46, 0xd0, 0x35, 0x0d, 0xb8, 0x66, 0x33,
-	0x5e, 0xb3, 0xe6, 0xda, 0xc9, 0xfd, 0x58, 0x8e, 0x9d, 0x8e, 0xbc, 0x62, 0xe8, 0x76, 0x00, 0xfc,
-	0xbb, 0xde, 0x03, 0x17, 0x9a, 0xc5, 0x68, 0x9a, 0x4f, 0xa3, 0x49, 0x37, 0x86, 0xb9, 0x9f, 0xf9,
-	0x71, 0x3f, 0xb4, 0x33, 0x14, 0x54, 0x85, 0x76, 0x76, 0xaa, 0xe9, 0x20, 0xcf, 0x2b, 0xf2, 0x31,
-	0x47, 0x4a, 0xc5, 0x4d, 0x63, 0x07, 0xbe, 0xff, 0x9d, 0x47, 0x7f, 0x7d, 0xdc, 0xda, 0x4d, 0xbb,
-	0x94, 0xd0, 0x17, 0x22, 0x8b, 0x16, 0xa1, 0xcd, 0xb1, 0xa5, 0x03, 0x42, 0x17, 0x5e, 0x80, 0xc1,
-	0x98, 0x59, 0xf4, 0x3f, 0xc6, 0xfc, 0xcb, 0x74, 0x38, 0xe2, 0x5f, 0xb9, 0xcd, 0x57, 0xfb, 0x3b,
-	0xf4, 0x33, 0x02, 0x7b, 0x42, 0x1f, 0x20, 0xe8, 0x21, 0xa1, 0xf3, 0x68, 0x3b, 0x27, 0x8d, 0xd5,
-	0x36, 0x44, 0x40, 0xb3, 0x0c, 0x50, 0x9a, 0x4e, 0xd5, 0x02, 0xa4, 0xe4, 0x38, 0x20, 0xdf, 0x13,
-	0xe8, 0x8b, 0xd3, 0xeb, 0xf4, 0x88, 0x30, 0x78, 0x42, 0xf7, 0x29, 0x4d, 0x37, 0xb0, 0x02, 0x71,
-	0xbf, 0xc4, 0x70, 0x9f, 0xa4, 0xc7, 0x6b, 0xe2, 0xae, 0x76, 0x8a, 0x3c, 0x83, 0x4f, 0x09, 0x3c,
-	0x1b, 0x94, 0xac, 0x74, 0x54, 0x88, 0x22, 0x22, 0x8a, 0xa5, 0x43, 0x35, 0xed, 0x10, 0xe3, 0x51,
-	0x86, 0xf1, 0x30, 0x9d, 0xac, 0x89, 0xb1, 0xaa, 0x71, 0xe9, 0xb7, 0x04, 0x7a, 0xc5, 0xd2, 0x9d,
-	0xa6, 0x85, 0x81, 0x63, 0xbf, 0x34, 0x48, 0x4a, 0xdd, 0xf6, 0x08, 0xf8, 0x45, 0x06, 0xf8, 0x38,
-	0x9d, 0xad, 0x09, 0xd8, 0xac, 0x3a, 0x59, 0xf1, 0xde, 0xc9, 0x2f, 0x31, 0xa5, 0xd5, 0xed, 0xa2,
-	0xb2, 0x00, 0x41, 0x48, 0xd1, 0x4a, 0x07, 0x13, 0x6d, 0x10, 0xd9, 0x39, 0x86, 0x6c, 0x81, 0x9e,
-	0x69, 0xe4, 0x98, 0x3a, 0x53, 0xa1, 0xe7, 0xe8, 0x0e, 0x7d, 0x44, 0x60, 0x5f, 0xcc, 0xe9, 0xa2,
-	0x93, 0x11, 0x28, 0xf1, 0x4a, 0x5c, 0x9a, 0xaa, 0xcf, 0x18, 0x09, 0x5c, 0x61, 0x04, 0x2e, 0xd3,
-	0x4b, 0x5b, 0x25, 0x20, 0x3c, 0xcf, 0xf4, 0x1b, 0xbc, 0x90, 0x22, 0xd1, 0x4a, 0xa7, 0xe2, 0x32,
-	0x2c, 0xd2, 0xdf, 0xd2, 0xe1, 0x3a, 0xad, 0x93, 0x0f, 0x79, 0xf2, 0x26, 0xfc, 0x46, 0xe0, 0x40,
-	0x00, 0xae, 0xb0, 0x90, 0x1c, 0x8b, 0x47, 0x92, 0x54, 0x4d, 0x8e, 0x37, 0xba, 0x2c, 0xf9, 0x8c,
-	0xe1, 0xd2, 0xfa, 0x37, 0xc3, 0xa2, 0xf7, 0xd8, 0x4d, 0xe0, 0xbb, 0x2d, 0x61, 0x71, 0x11, 0x48,
-	0x43, 0x61, 0x71, 0x11, 0x89, 0x34, 0xf9, 0x24, 0x43, 0x3b, 0x43, 0x8f, 0x34, 0x80, 0xd6, 0x7d,
-	0xc8, 0xbe, 0x22, 0xf0, 0x4c, 0xc0, 0x29, 0x1d, 0x49, 0x0e, 0xea, 0x61, 0x1b, 0xad, 0x65, 0x86,
-	0xd0, 0x96, 0x19, 0xb4, 0x79, 0x3a, 0xd7, 0x28, 0xb4, 0xf0, 0x1b, 0xf8, 0x1d, 0x81, 0xbd, 0xc1,
-	0x8a, 0xe2, 0x95, 0xc2, 0xf1, 0xc4, 0xa2, 0x11, 0xa8, 0x82, 0x13, 0xf5, 0x98, 0x22, 0xf2, 0xf3,
-	0x0c, 0xf9, 0x22, 0x9d, 0x6f, 0x00, 0x39, 0x96, 0x3f, 0x01, 0x76, 0x29, 0x88, 0x9d, 0xd7, 0x28,
-	0xc2, 0x5a, 0x9e, 0x20, 0x95, 0x84, 0xb5, 0x3c, 0x49, 0xfc, 0xc8, 0xa7, 0x18, 0x95, 0x59, 0x3a,
-	0xd3, 0x38, 0x15, 0xfa, 0x35, 0x81, 0xce, 0xb0, 0x5a, 0xa0, 0x63, 0x35, 0x4e, 0xa6, 0xaf, 0x53,
-	0xa4, 0xf1, 0x3a, 0x2c, 0x11, 0xe5, 0x1c, 0x43, 0xf9, 0x3c, 0x3d, 0xd1, 0xf0, 0x51, 0xd1, 0x6e,
-	0xda, 0x5a, 0xd9, 0xa0, 0x9b, 0x04, 0x06, 0x02, 0x95, 0x24, 0x24, 0x1e, 0x84, 0xdd, 0x48, 0xa2,
-	0x8c, 0x11, 0x76, 0x23, 0xc9, 0xca, 0x44, 0x5e, 0x64, 0x34, 0x4e, 0xd3, 0x17, 0x1a, 0xa0, 0x11,
-	0x96, 0x32, 0xf4, 0x73, 0x02, 0xd4, 0xa1, 0x12, 0x54, 0x10, 0x82, 0xca, 0x21, 0x94, 0x32, 0x82,
-	0xca, 0x21, 0x16, 0x2f, 0xf2, 0x09, 0x06, 0x76, 0x9a, 0x2a, 0x31, 0x60, 0xd7, 0xfd, 0x65, 0x2b,
-	0xba, 0xb1, 0x66, 0x2a, 0xb7, 0x5d, 0x4d, 0x74, 0x87, 0x16, 0x60, 0xa7, 0x83, 0xcf, 0xe9, 0xf4,
-	0x05, 0x5d, 0x30, 0x27, 0x0f, 0x04, 0x5d, 0x30, 0x2f, 0x0f, 0xe4, 0x83, 0x0c, 0xc1, 0x20, 0xed,
-	0x8f, 0x41, 0xe0, 0x34, 0xf7, 0x54, 0x83, 0x0e, 0x57, 0x97, 0xd0, 0x68, 0xd3, 0x1e, 0x90, 0x3c,
-	0xd2, 0x50, 0xec, 0x3c, 0xc6, 0x93, 0x58, 0xbc, 0x1e, 0x4a, 0xf9, 0x78, 0xae, 0xcc, 0xa1, 0xef,
-	0x11, 0xe8, 0xf2, 0xfa, 0x37, 0x5f, 0xc8, 0x08, 0x2a, 0xa2, 0x48, 0x06, 0x09, 0x2a, 0xa2, 0x50,
-	0x0f, 0xc9, 0x32, 0x03, 0x30, 0x40, 0x25, 0x1e, 0x40, 0xd6, 0x33, 0x5d, 0x71, 0xf8, 0x2e, 0x9c,
-	0xd9, 0x7c, 0x9c, 0x22, 0x0f, 0x1f, 0xa7, 0xc8, 0x9f, 0x8f, 0x53, 0xe4, 0xc3, 0x27, 0xa9, 0x96,
-	0x87, 0x4f, 0x52, 0x2d, 0xbf, 0x3e, 0x49, 0xb5, 0xbc, 0x39, 0xca, 0x29, 0x23, 0x77, 0xbd, 0xfb,
-	0xf3, 0xc6, 0xac, 0x72, 0xd3, 0x75, 0xc5, 0xd4, 0xd1, 0x6a, 0x07, 0xfb, 0x5f, 0xec, 0xa3, 0xff,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x6c, 0xae, 0x75, 0x82, 0x1f, 0x00, 0x00,
+	// 1974 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x9a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0xc0, 0x5d, 0x8e, 0x63, 0x36, 0x2f, 0xfb, 0xe1, 0x94, 0x3f, 0x92, 0xf4, 0x78, 0x66, 0x9c,
+	0xce, 0xc6, 0x9e, 0x7c, 0x4d, 0xc7, 0x8e, 0x37, 0x1b, 0x02, 0x8b, 0x93, 0x89, 0xb3, 0x49, 0x84,
+	0x56, 0x1b, 0x46, 0x84, 0x48, 0xc0, 0xca, 0x6a, 0xcf, 0xb4, 0xc7, 0xbd, 0x3b, 0xd3, 0x3d, 0xdb,
+	0xdd, 0x13, 0xc7, 0xb2, 0x7c, 0x01, 0x09, 0xad, 0x84, 0x90, 0x40, 0x20, 0x0e, 0xac, 0x84, 0x16,
+	0x81, 0x04, 0x5a, 0x71, 0x47, 0x9c, 0x38, 0x20, 0x24, 0x5f, 0x90, 0x56, 0x5a, 0x21, 0x10, 0x82,
+	0x80, 0x12, 0x0e, 0x9c, 0x10, 0xe2, 0x2f, 0x40, 0x5d, 0xf5, 0xba, 0xa7, 0xba, 0xbb, 0x7a, 0x7a,
+	0x26, 0xf6, 0x28, 0xda, 0x8b, 0x33, 0x5d, 0xf5, 0xea, 0xbd, 0xdf, 0x7b, 0x55, 0xf5, 0xaa, 0x5e,
+	0x29, 0x30, 0xd3, 0xd0, 0x3b, 0xae, 0xab, 0xd5, 0x8d, 0x0d, 0x53, 0x7b, 0xbf, 0x63, 0x38, 0xdb,
+	0xe5, 0xb6, 0x63, 0x7b, 0x36, 0x05, 0xd6, 0x5e, 0xf6, 0xdb, 0x95, 0x73, 0x35, 0xdb, 0x6d, 0xd9,
+	0xae, 0xb6, 0xae, 0xbb, 0x06, 0x17, 0xd2, 0x1e, 0x2e, 0xae, 0x1b, 0x9e, 0xbe, 0xa8, 0xb5, 0xf5,
+	0x86, 0x69, 0xe9, 0x9e, 0x69, 0x5b, 0x7c, 0x9c, 0x32, 0xd5, 0xb0, 0x1b, 0x36, 0xfb, 0xa9, 0xf9,
+	0xbf, 0xb0, 0x75, 0xb6, 0x61, 0xdb, 0x8d, 0xa6, 0xa1, 0xe9, 0x6d, 0x53, 0xd3, 0x2d, 0xcb, 0xf6,
+	0xd8, 0x10, 0x17, 0x7b, 0x0b, 0xa2, 0xfe, 0x40, 0x73, 0xcd, 0x36, 0x03, 0x9d, 0xd3, 0x02, 0xa3,
+	0xff, 0x87, 0x37, 0xab, 0x2e, 0x1c, 0xfb, 0x8a, 0x0f, 0xb3, 0x6a, 0x6c, 0x98, 0x6e, 0xd5, 0x78,
+	0xbf, 0x63, 0xb8, 0x1e, 0x9d, 0x81, 0x71, 0xd7, 0xd3, 0xbd, 0x8e, 0x7b, 0x82, 0xcc, 0x91, 0xd2,
+	0x91, 0x2a, 0x7e, 0xd1, 0x37, 0x01, 0xba, 0xac, 0x27, 0x46, 0xe7, 0x48, 0xe9, 0xe8, 0xd2, 0x7c,
+	0x99, 0x1b, 0x2e, 0xfb, 0x86, 0xcb, 0xdc, 0x7b, 0x34, 0x5f, 0xbe, 0xa7, 0x37, 0x0c, 0xd4, 0x59,
+	0x15, 0x46, 0xaa, 0xdf, 0x25, 0x40, 0x45, 0xab, 0x6e, 0xdb, 0xb6, 0x5c, 0x83, 0x5e, 0x80, 0xc3,
+	0x3e, 0x99, 0x6f, 0xf5, 0x50, 0xe9, 0xe8, 0xd2, 0x44, 0xb9, 0x1b, 0xbe, 0xb2, 0x2f, 0x59, 0x19,
+	0xdb, 0x7b, 0x5c, 0x1c, 0xa9, 0x72, 0x21, 0x7a, 0x5b, 0x02, 0xb3, 0x90, 0x09, 0xc3, 0x4d, 0x45,
+	0x68, 0x5e, 0x83, 0x89, 0x10, 0x26, 0x88, 0xc0, 0x29, 0x78, 0xd1, 0xb7, 0xb2, 0xa6, 0xd7, 0xeb,
+	0x8e, 0xe1, 0x06, 0x71, 0x38, 0xea, 0xb7, 0xdd, 0xe0, 0x4d, 0xea, 0x8a, 0x10, 0xb9, 0xd0, 0x85,
+	0x73, 0x30, 0xe6, 0xcb, 0x30, 0xf9, 0x74, 0x0f, 0x98, 0x8c, 0xfa, 0x01, 0x81, 0x5c, 0xa8, 0x61,
+	0xd5, 0x68, 0x1a, 0x0d, 0x3e, 0xa1, 0xfd, 0x33, 0x1c, 0xd8, 0x84, 0xfc, 0x99, 0xc0, 0xac, 0x1c,
+	0x05, 0xfd, 0xb2, 0x60, 0xaa, 0x1e, 0x36, 0xaf, 0x39, 0xd8, 0x1c, 0xcc, 0x54, 0x21, 0xea, 0x67,
+	0x20, 0x17, 0x8c, 0xae, 0xe4, 0x7c, 0xaf, 0x3f, 0xfe, 0x47, 0x71, 0x32, 0xd9, 0xe7, 0x56, 0x27,
+	0xeb, 0xc9, 0xc6, 0x83, 0x9b, 0xdc, 0x1f, 0x10, 0x78, 0x35, 0xf4, 0xec, 0xbe, 0xb5, 0x6e, 0x5b,
+	0x75, 0xd3, 0x6a, 0x3c, 0xdf, 0x68, 0xef, 0x11, 0x38, 0x93, 0xc1, 0x84, 0x61, 0xff, 0x1a, 0x4c,
+	0x76, 0x82, 0xfe, 0x44, 0xd4, 0x8b, 0x62, 0xd4, 0x25, 0x6a, 0x70, 0xb1, 0xd1, 0x50, 0xc3, 0x10,
+	0xc2, 0x7b, 0x1b, 0xd4, 0xd0, 0x93, 0xb7, 0x3b, 0x9e, 0xeb, 0xe9, 0x68, 0x69, 0x4b, 0x77, 0xea,
+	0x03, 0xc4, 0x56, 0x35, 0xe1, 0x74, 0x4f, 0x45, 0x18, 0x90, 0x0a, 0x7c, 0xce, 0xe1, 0x4d, 0xb8,
+	0xc5, 0xd4, 0xf8, 0x16, 0x4b, 0x0e, 0xc6, 0x38, 0x04, 0x03, 0xd5, 0x15, 0x50, 0x42, 0x53, 0x37,
+	0xed, 0x56, 0xcb, 0x74, 0x5d, 0xb6, 0xf6, 0xfa, 0x66, 0x7d, 0x17, 0x72, 0x52, 0x05, 0xc8, 0xf8,
+	0x65, 0x80, 0x5a, 0xd8, 0x8a, 0x98, 0x67, 0xe2, 0x98, 0x37, 0x6a, 0xb5, 0x4e, 0xab, 0xd3, 0xd4,
+	0x3d, 0xa3, 0xde, 0x55, 0x81, 0xa4, 0xc2, 0x70, 0xd5, 0x81, 0x19, 0xb4, 0xd5, 0xdd, 0x24, 0x1c,
+	0xf4, 0x3c, 0x1c, 0xc3, 0x9d, 0x63, 0x3b, 0x31, 0xda, 0x89, 0xb0, 0x23, 0x58, 0xba, 0x71, 0xaf,
+	0x46, 0x13, 0x5e, 0x5d, 0x7b, 0xe1, 0x83, 0x8f, 0x8a, 0x23, 0xff, 0xfe, 0xa8, 0x38, 0xa2, 0xbe,
+	0x0b, 0xc7, 0x13, 0x36, 0xd1, 0xb7, 0xb7, 0x61, 0x52, 0x92, 0x07, 0xd0, 0xc9, 0x8c, 0x34, 0x50,
+	0xa5, 0xc9, 0x9d, 0xae, 0xee, 0x40, 0x91, 0xd9, 0x92, 0xac, 0xdf, 0xe1, 0x3b, 0xaa, 0xc3, 0x5c,
+	0xba, 0x71, 0xf4, 0xf8, 0x0d, 0x18, 0xe7, 0x1b, 0x08, 0x9d, 0xec, 0x73, 0xd7, 0xe1, 0x20, 0xf5,
+	0x67, 0x04, 0x6d, 0xac, 0x06, 0xa4, 0x92, 0xdc, 0x33, 0x90, 0x87, 0x07, 0x94, 0x85, 0x84, 0x30,
+	0xfc, 0x81, 0xc0, 0xa9, 0x1e, 0x8c, 0x18, 0x88, 0x07, 0xfb, 0x3a, 0x02, 0x78, 0x54, 0x86, 0x9b,
+	0xeb, 0x3f, 0x26, 0x70, 0x36, 0xea, 0x47, 0xaf, 0x84, 0xff, 0x9c, 0x83, 0xfe, 0x47, 0x02, 0xe7,
+	0xfa, 0x81, 0xfd, 0xac, 0x9c, 0x04, 0xff, 0x21, 0x70, 0x92, 0xf9, 0x53, 0x35, 0xea, 0xfb, 0x0c,
+	0x76, 0x09, 0x26, 0x5c, 0xa7, 0xb6, 0x26, 0xd9, 0xc7, 0x2f, 0xbb, 0x4e, 0x6d, 0x55, 0x38, 0x91,
+	0x4b, 0x30, 0x51, 0x77, 0xbd, 0xa8, 0xe4, 0x21, 0x2e, 0x59, 0x77, 0xbd, 0xd5, 0xd4, 0xb3, 0x7b,
+	0xec, 0x00, 0x26, 0xf0, 0xef, 0x04, 0x14, 0x99, 0xc3, 0x38, 0x61, 0x0f, 0x61, 0xc6, 0x31, 0x7a,
+	0x6c, 0x98, 0x39, 0x71, 0xce, 0x44, 0x15, 0xe1, 0x96, 0xc9, 0xe3, 0xad, 0x69, 0x5a, 0xd6, 0xeb,
+	0x56, 0xa7, 0x1d, 0x63, 0xa8, 0xbb, 0xe9, 0x43, 0x12, 0x9e, 0x93, 0x61, 0x56, 0xd8, 0x30, 0x9f,
+	0x6d, 0x46, 0x0f, 0x7e, 0xfb, 0xfc, 0xa8, 0x7b, 0x79, 0x8e, 0xd2, 0x3d, 0xdf, 0x5a, 0xa2, 0x03,
+	0x27, 0x93, 0x54, 0xc3, 0x3f, 0xc8, 0xee, 0xc8, 0xa6, 0xea, 0x99, 0x8a, 0x92, 0x6d, 0xc8, 0x27,
+	0xce, 0xfe, 0xc8, 0x5d, 0x6e, 0x78, 0x4e, 0x7c, 0x8f, 0x40, 0x21, 0xcd, 0x36, 0x7a, 0xf2, 0x9e,
+	0x78, 0xfd, 0xf3, 0xe7, 0x75, 0x36, 0x32, 0x49, 0xc1, 0xf4, 0xac, 0x1a, 0xb5, 0x9b, 0xb6, 0x69,
+	0x55, 0x2e, 0xe3, 0x0e, 0x3a, 0xdf, 0x30, 0xbd, 0xcd, 0xce, 0x7a, 0xb9, 0x66, 0xb7, 0x34, 0x2e,
+	0x8f, 0xff, 0x5c, 0x74, 0xeb, 0xef, 0x69, 0xde, 0x76, 0xdb, 0x70, 0x83, 0x31, 0x6e, 0xf7, 0x9e,
+	0xf8, 0x0d, 0x50, 0x63, 0x38, 0x5f, 0xb5, 0x3d, 0xbd, 0xb9, 0x8f, 0x78, 0x08, 0xce, 0xfe, 0x89,
+	0x84, 0x17, 0x5e, 0xb9, 0x76, 0xf4, 0xf8, 0x56, 0xdc, 0xe3, 0x33, 0xf2, 0x83, 0x36, 0x9c, 0x79,
+	0xae, 0x20, 0x76, 0xe7, 0xa5, 0x0d, 0x38, 0xec, 0xf9, 0xea, 0x4f, 0x8c, 0x0e, 0x2b, 0x6c, 0x5c,
+	0xbf, 0x7a, 0x3f, 0x2c, 0x24, 0xc5, 0x6d, 0x79, 0xeb, 0xd1, 0x3e, 0xc3, 0xb5, 0x02, 0xf9, 0x14,
+	0xb5, 0x18, 0xa7, 0x29, 0x71, 0xbf, 0x1f, 0xc1, 0x7d, 0x2d, 0x28, 0x78, 0x07, 0x5e, 0x8d, 0x2a,
+	0x78, 0x60, 0x7a, 0x9b, 0x75, 0x47, 0xdf, 0x42, 0x5b, 0xfb, 0xe4, 0xfb, 0x66, 0x58, 0xd1, 0xa5,
+	0xa9, 0x47, 0xce, 0xb3, 0x30, 0xb1, 0x85, 0x5d, 0x31, 0xf5, 0xaf, 0x6c, 0x45, 0x87, 0x08, 0xda,
+	0x97, 0x71, 0x7b, 0xdf, 0x31, 0x5d, 0xcf, 0x76, 0xcc, 0x9a, 0xde, 0xbc, 0x6b, 0x6d, 0xd8, 0xc2,
+	0x6b, 0xcd, 0xa6, 0x61, 0x36, 0x36, 0x3d, 0xa6, 0xe8, 0x50, 0x15, 0xbf, 0xd4, 0xb7, 0x20, 0x27,
+	0x1d, 0x85, 0x24, 0x65, 0x18, 0xdb, 0x34, 0x5d, 0x0f, 0xb3, 0x82, 0x22, 0x2e, 0xab, 0xd8, 0x08,
+	0x26, 0xa7, 0x52, 0x7c, 0x26, 0xb9, 0x67, 0xdb, 0x4d, 0x34, 0x1d, 0xbe, 0x81, 0xf0, 0xb6, 0x6e,
+	0xba, 0x69, 0xdb, 0x76, 0x53, 0x96, 0x6e, 0x7c, 0xb9, 0x20, 0xdd, 0xf8, 0x32, 0xea, 0x14, 0x3e,
+	0x04, 0xdd, 0xd3, 0x1d, 0xbd, 0x15, 0x4c, 0x82, 0x7a, 0x1b, 0x26, 0x23, 0xad, 0xa8, 0xf8, 0x12,
+	0x8c, 0xb7, 0x59, 0x0b, 0xaa, 0xa6, 0x11, 0xd5, 0xac, 0x27, 0xb8, 0x7d, 0x73, 0x39, 0x35, 0x87,
+	0xe9, 0xd8, 0x2f, 0xb1, 0x3a, 0x96, 0xe9, 0x45, 0xe0, 0xbf, 0x1d, 0x1c, 0x70, 0xb1, 0x5e, 0xb4,
+	0x66, 0x84, 0x6e, 0x0c, 0x69, 0xc7, 0xf0, 0x08, 0xe4, 0xc2, 0x13, 0x63, 0xc3, 0xbc, 0x6b, 0x6d,
+	0x34, 0xc5, 0xd2, 0x47, 0xfd, 0xfd, 0x28, 0x28, 0xb2, 0xde, 0xb0, 0x36, 0x39, 0xd2, 0x76, 0xec,
+	0x87, 0xa6, 0x50, 0x68, 0x9e, 0x94, 0x72, 0x32, 0x48, 0x1e, 0x9a, 0xee, 0x08, 0xba, 0x09, 0x2f,
+	0xfa, 0xf7, 0x41, 0xa3, 0xbe, 0xe6, 0xf8, 0x7a, 0x79, 0x76, 0xae, 0xdc, 0xf2, 0xc5, 0xfe, 0xfa,
+	0xb8, 0x38, 0xdf, 0x9f, 0x2f, 0xff, 0x7b, 0x5c, 0x9c, 0xdc, 0xd6, 0x5b, 0xcd, 0x6b, 0xaa, 0xa8,
+	0x4b, 0xad, 0x1e, 0xe5, 0x9f, 0x55, 0xff, 0x8b, 0xbe, 0x09, 0xe3, 0x6e, 0xa7, 0xdd, 0x6e, 0x6e,
+	0xf3, 0xdb, 0x59, 0xa5, 0x3c, 0x80, 0x8d, 0xbb, 0x96, 0x57, 0xc5, 0xd1, 0x74, 0x19, 0xc6, 0x5b,
+	0xa6, 0xe5, 0x19, 0x0e, 0xde, 0xe0, 0x66, 0xe2, 0x67, 0xd9, 0x5b, 0xac, 0x37, 0x58, 0x05, 0x5c,
+	0x76, 0xe9, 0xbf, 0x39, 0x38, 0xcc, 0xa2, 0x48, 0x75, 0x38, 0xbc, 0xca, 0x0e, 0xfc, 0xbc, 0x38,
+	0x30, 0xf1, 0x00, 0xaa, 0x14, 0xd2, 0xba, 0xb1, 0x6a, 0x3d, 0xf9, 0xad, 0x4f, 0xff, 0xf5, 0xc3,
+	0xd1, 0x49, 0x7a, 0x4c, 0x8b, 0xbd, 0xaa, 0xba, 0xb4, 0x05, 0x63, 0xbe, 0x2c, 0x9d, 0x95, 0xaa,
+	0x08, 0x0c, 0xe4, 0x53, 0x7a, 0x51, 0x7f, 0x89, 0xe9, 0x57, 0xe9, 0x5c, 0x42, 0xbf, 0xb6, 0x23,
+	0x1e, 0xa8, 0xbb, 0xf4, 0x27, 0x04, 0x5e, 0x89, 0x3d, 0xda, 0xd1, 0x05, 0xa9, 0xf2, 0x64, 0x09,
+	0xa4, 0x94, 0xb2, 0x05, 0x11, 0x68, 0x99, 0x01, 0x95, 0xe9, 0x85, 0x2c, 0x20, 0x4d, 0xb8, 0x0b,
+	0xd3, 0xdf, 0x11, 0x38, 0x91, 0xf6, 0xc6, 0x45, 0x2f, 0x49, 0x8d, 0xf7, 0xa8, 0xd8, 0x94, 0xc5,
+	0x01, 0x46, 0x20, 0xf7, 0x97, 0x18, 0xf7, 0x55, 0x7a, 0x25, 0x93, 0xbb, 0x5b, 0x5d, 0x89, 0x1e,
+	0xfc, 0x98, 0xc0, 0xcb, 0xd1, 0x67, 0x1e, 0x3a, 0x2f, 0xa5, 0x48, 0x3c, 0x24, 0x29, 0x0b, 0x99,
+	0x72, 0xc8, 0x78, 0x99, 0x31, 0x5e, 0xa4, 0xe7, 0x33, 0x19, 0xbb, 0xef, 0x42, 0xf4, 0x37, 0x04,
+	0x66, 0xe4, 0xcf, 0x5d, 0xb4, 0x2c, 0x35, 0x9c, 0xfa, 0x3a, 0xa7, 0x68, 0x7d, 0xcb, 0x23, 0xf0,
+	0x17, 0x19, 0xf0, 0x15, 0xba, 0x9c, 0x09, 0x6c, 0x77, 0x95, 0xac, 0x05, 0x57, 0x91, 0x9f, 0x63,
+	0x48, 0xbb, 0xd3, 0x45, 0x55, 0x09, 0x41, 0xec, 0x15, 0x48, 0x39, 0xdd, 0x53, 0x06, 0xc9, 0xee,
+	0x30, 0xb2, 0x0a, 0xbd, 0x3e, 0xc8, 0x32, 0xd5, 0x76, 0xf0, 0xa3, 0x7b, 0xe2, 0xef, 0xd2, 0x4f,
+	0x09, 0x1c, 0x4f, 0x59, 0x5d, 0xf4, 0x7c, 0x02, 0x25, 0xfd, 0xf5, 0x4a, 0xb9, 0xd0, 0x9f, 0x30,
+	0x3a, 0xf0, 0x0e, 0x73, 0xe0, 0x01, 0xbd, 0xbf, 0x5f, 0x07, 0xa4, 0xeb, 0x99, 0xfe, 0x1a, 0x37,
+	0xa4, 0xec, 0xa1, 0x87, 0x5e, 0x48, 0x8b, 0xb0, 0xec, 0xcd, 0x4a, 0xb9, 0xd8, 0xa7, 0x74, 0xef,
+	0x45, 0xde, 0x7b, 0x12, 0xfe, 0x46, 0xe0, 0x54, 0x04, 0x57, 0x9a, 0x48, 0x5e, 0x4b, 0x27, 0xe9,
+	0x95, 0x4d, 0xae, 0x0c, 0x3a, 0xac, 0xf7, 0x1a, 0xc3, 0xa1, 0xfd, 0x4f, 0x86, 0x4b, 0x7f, 0x41,
+	0xe0, 0x18, 0x4f, 0xfb, 0x62, 0xeb, 0x99, 0x04, 0x97, 0xec, 0x45, 0x45, 0x99, 0xcf, 0x12, 0x43,
+	0xdc, 0xeb, 0x0c, 0xf7, 0x1a, 0xbd, 0x3a, 0x00, 0xae, 0x13, 0x01, 0xfa, 0x90, 0x6d, 0x58, 0xf1,
+	0xde, 0x2d, 0xcd, 0x81, 0x92, 0x47, 0x02, 0x65, 0x21, 0x53, 0x0e, 0x29, 0xaf, 0x32, 0xca, 0x25,
+	0x7a, 0x69, 0x00, 0x4a, 0x7e, 0xde, 0xfe, 0x92, 0xc0, 0x4b, 0x11, 0xa5, 0x92, 0x00, 0xca, 0xaa,
+	0x71, 0x65, 0x3e, 0x4b, 0x0c, 0xd1, 0x6e, 0x33, 0xb4, 0x1b, 0x74, 0x65, 0x50, 0xb4, 0xf8, 0x51,
+	0xfd, 0x5b, 0x02, 0xd3, 0xd1, 0xc4, 0x17, 0x64, 0xec, 0xb3, 0x3d, 0x73, 0x5b, 0x24, 0x59, 0x9f,
+	0xeb, 0x47, 0x14, 0xc9, 0xef, 0x32, 0xf2, 0x9b, 0xf4, 0xc6, 0x40, 0x53, 0xcf, 0x74, 0x48, 0xd8,
+	0x95, 0x28, 0xbb, 0x58, 0xad, 0x4a, 0x8f, 0x9c, 0x1e, 0x45, 0xb3, 0xa2, 0xf5, 0x2d, 0x8f, 0xae,
+	0x5c, 0x63, 0xae, 0x2c, 0xd3, 0xa5, 0xc1, 0x5d, 0xa1, 0xbf, 0x22, 0x30, 0x11, 0xaf, 0x1b, 0x69,
+	0x29, 0x63, 0x65, 0x86, 0x15, 0xab, 0x72, 0xb6, 0x0f, 0x49, 0xa4, 0x5c, 0x61, 0x94, 0x9f, 0xa7,
+	0xaf, 0x0f, 0xbc, 0x54, 0x8c, 0x47, 0x9e, 0xe1, 0x58, 0x74, 0x8f, 0xc0, 0x6c, 0x24, 0xe1, 0xc5,
+	0xca, 0x48, 0xe9, 0xa5, 0xa9, 0x67, 0x41, 0xab, 0x2c, 0x0e, 0x30, 0x02, 0xdd, 0xb8, 0xc9, 0xdc,
+	0x78, 0x83, 0x7e, 0x61, 0x00, 0x37, 0xe2, 0x45, 0x2d, 0xfd, 0x29, 0x01, 0xea, 0xbb, 0x12, 0xad,
+	0x25, 0x25, 0x99, 0x43, 0x5a, 0xd4, 0x2a, 0x0b, 0x99, 0x72, 0x08, 0xfb, 0x3a, 0x83, 0x5d, 0xa4,
+	0x5a, 0x0a, 0xec, 0x66, 0x38, 0x6c, 0xcd, 0xb4, 0x36, 0x6c, 0x6d, 0x87, 0x57, 0xc7, 0xbb, 0xb4,
+	0x09, 0x2f, 0xf8, 0x7c, 0x7e, 0xcd, 0x27, 0xb9, 0xac, 0x0b, 0x85, 0xa2, 0x92, 0x4f, 0xe9, 0x45,
+	0x82, 0xd3, 0x8c, 0x20, 0x4f, 0x73, 0x29, 0x04, 0x7e, 0x99, 0x47, 0x0d, 0x18, 0xe7, 0x15, 0x2a,
+	0x4d, 0xd6, 0x16, 0x91, 0xe2, 0x57, 0x29, 0xa6, 0xf6, 0xa3, 0x3d, 0x85, 0xd9, 0x9b, 0xa2, 0x54,
+	0xb4, 0xc7, 0x0b, 0x5e, 0xfa, 0x1d, 0x3c, 0x52, 0x22, 0x25, 0xad, 0x24, 0x23, 0xca, 0x0a, 0x62,
+	0x65, 0x3e, 0x4b, 0x0c, 0x01, 0x54, 0x06, 0x30, 0x4b, 0x15, 0x11, 0xa0, 0x16, 0x88, 0xae, 0x31,
+	0x7f, 0x77, 0xe1, 0xa5, 0x48, 0xcd, 0x2a, 0xcd, 0xca, 0xc9, 0x8a, 0x57, 0x99, 0xcf, 0x12, 0x43,
+	0x86, 0x3c, 0x63, 0x38, 0x4e, 0xa7, 0x45, 0x06, 0x33, 0x10, 0xab, 0x5c, 0xdf, 0x7b, 0x52, 0x20,
+	0x9f, 0x3c, 0x29, 0x90, 0x7f, 0x3e, 0x29, 0x90, 0xef, 0x3f, 0x2d, 0x8c, 0x7c, 0xf2, 0xb4, 0x30,
+	0xf2, 0x97, 0xa7, 0x85, 0x91, 0xaf, 0x8b, 0x25, 0x27, 0x1f, 0xca, 0xff, 0x3e, 0x5c, 0xd6, 0x1e,
+	0x71, 0x2d, 0xac, 0xec, 0x5c, 0x1f, 0x67, 0xff, 0x3f, 0xe6, 0xf2, 0xff, 0x07, 0x00, 0x66, 0xa2,
+	0xa6, 0x09, 0xdc, 0x23, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2089,6 +2309,8 @@ type QueryClient interface {
 	// DelegatorUnbondingDelegations queries all unbonding delegations of a given
 	// delegator address.
 	DefiDelegatorUnbondingDelegations(ctx context.Context, in *QueryDelegatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorUnbondingDelegationsResponse, error)
+	// DefiRedelegations queries redelegations of given address.
+	DefiRedelegations(ctx context.Context, in *QueryRedelegationsRequest, opts ...grpc.CallOption) (*QueryRedelegationsResponse, error)
 	// DelegatorDefis queries all defis info for given delegator
 	// address.
 	DelegatorDefis(ctx context.Context, in *QueryDelegatorDefisRequest, opts ...grpc.CallOption) (*QueryDelegatorDefisResponse, error)
@@ -2112,6 +2334,8 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// CommunityPool queries the community pool coins.
 	DefiCommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// DefiInflation queries the current per-trade mint provision and the annual period.
+	DefiInflation(ctx context.Context, in *QueryDefiInflationRequest, opts ...grpc.CallOption) (*QueryDefiInflationResponse, error)
 }
 
 type queryClient struct {
@@ -2212,6 +2436,15 @@ func (c *queryClient) DefiDelegatorUnbondingDelegations(ctx context.Context, in
 	return out, nil
 }
 
+func (c *queryClient) DefiRedelegations(ctx context.Context, in *QueryRedelegationsRequest, opts ...grpc.CallOption) (*QueryRedelegationsResponse, error) {
+	out := new(QueryRedelegationsResponse)
+	err := c.cc.Invoke(ctx, "/gauss.defi.Query/DefiRedelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DelegatorDefis(ctx context.Context, in *QueryDelegatorDefisRequest, opts ...grpc.CallOption) (*QueryDelegatorDefisResponse, error) {
 	out := new(QueryDelegatorDefisResponse)
 	err := c.cc.Invoke(ctx, "/gauss.defi.Query/DelegatorDefis", in, out, opts...)
@@ -2302,6 +2535,15 @@ func (c *queryClient) DefiCommunityPool(ctx context.Context, in *QueryCommunityP
 	return out, nil
 }
 
+func (c *queryClient) DefiInflation(ctx context.Context, in *QueryDefiInflationRequest, opts ...grpc.CallOption) (*QueryDefiInflationResponse, error) {
+	out := new(QueryDefiInflationResponse)
+	err := c.cc.Invoke(ctx, "/gauss.defi.Query/DefiInflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Defi queries all defis that match the given status
@@ -2326,6 +2568,8 @@ type QueryServer interface {
 	// DelegatorUnbondingDelegations queries all unbonding delegations of a given
 	// delegator address.
 	DefiDelegatorUnbondingDelegations(context.Context, *QueryDelegatorUnbondingDelegationsRequest) (*QueryDelegatorUnbondingDelegationsResponse, error)
+	// DefiRedelegations queries redelegations of given address.
+	DefiRedelegations(context.Context, *QueryRedelegationsRequest) (*QueryRedelegationsResponse, error)
 	// DelegatorDefis queries all defis info for given delegator
 	// address.
 	DelegatorDefis(context.Context, *QueryDelegatorDefisRequest) (*QueryDelegatorDefisResponse, error)
@@ -2349,6 +2593,8 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// CommunityPool queries the community pool coins.
 	DefiCommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// DefiInflation queries the current per-trade mint provision and the annual period.
+	DefiInflation(context.Context, *QueryDefiInflationRequest) (*QueryDefiInflationResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2385,6 +2631,9 @@ func (*UnimplementedQueryServer) DefiDelegatorDelegations(ctx context.Context, r
 func (*UnimplementedQueryServer) DefiDelegatorUnbondingDelegations(ctx context.Context, req *QueryDelegatorUnbondingDelegationsRequest) (*QueryDelegatorUnbondingDelegationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DefiDelegatorUnbondingDelegations not implemented")
 }
+func (*UnimplementedQueryServer) DefiRedelegations(ctx context.Context, req *QueryRedelegationsRequest) (*QueryRedelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DefiRedelegations not implemented")
+}
 func (*UnimplementedQueryServer) DelegatorDefis(ctx context.Context, req *QueryDelegatorDefisRequest) (*QueryDelegatorDefisResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorDefis not implemented")
 }
@@ -2415,6 +2664,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) DefiCommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DefiCommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) DefiInflation(ctx context.Context, req *QueryDefiInflationRequest) (*QueryDefiInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DefiInflation not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2600,6 +2852,24 @@ func _Query_DefiDelegatorUnbondingDelegations_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DefiRedelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DefiRedelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gauss.defi.Query/DefiRedelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DefiRedelegations(ctx, req.(*QueryRedelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DelegatorDefis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDelegatorDefisRequest)
 	if err := dec(in); err != nil {
@@ -2780,6 +3050,24 @@ func _Query_DefiCommunityPool_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DefiInflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDefiInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DefiInflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gauss.defi.Query/DefiInflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DefiInflation(ctx, req.(*QueryDefiInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gauss.defi.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2824,6 +3112,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DefiDelegatorUnbondingDelegations",
 			Handler:    _Query_DefiDelegatorUnbondingDelegations_Handler,
 		},
+		{
+			MethodName: "DefiRedelegations",
+			Handler:    _Query_DefiRedelegations_Handler,
+		},
 		{
 			MethodName: "DelegatorDefis",
 			Handler:    _Query_DelegatorDefis_Handler,
@@ -2864,6 +3156,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DefiCommunityPool",
 			Handler:    _Query_DefiCommunityPool_Handler,
 		},
+		{
+			MethodName: "DefiInflation",
+			Handler:    _Query_DefiInflation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gauss/defi/query.proto",
@@ -3655,7 +3951,7 @@ func (m *QueryDelegatorUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorDefisRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryRedelegationsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3665,12 +3961,12 @@ func (m *QueryDelegatorDefisRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorDefisRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRedelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorDefisRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRedelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3685,6 +3981,20 @@ func (m *QueryDelegatorDefisRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DstDefiAddress) > 0 {
+		i -= len(m.DstDefiAddress)
+		copy(dAtA[i:], m.DstDefiAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DstDefiAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SrcDefiAddress) > 0 {
+		i -= len(m.SrcDefiAddress)
+		copy(dAtA[i:], m.SrcDefiAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SrcDefiAddress)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.DelegatorAddress) > 0 {
@@ -3697,7 +4007,7 @@ func (m *QueryDelegatorDefisRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorDefisResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryRedelegationsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3707,12 +4017,12 @@ func (m *QueryDelegatorDefisResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorDefisResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRedelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorDefisResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRedelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3729,10 +4039,10 @@ func (m *QueryDelegatorDefisResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Defis) > 0 {
-		for iNdEx := len(m.Defis) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RedelegationResponses) > 0 {
+		for iNdEx := len(m.RedelegationResponses) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Defis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RedelegationResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3746,7 +4056,7 @@ func (m *QueryDelegatorDefisResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorDefiRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegatorDefisRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3756,20 +4066,25 @@ func (m *QueryDelegatorDefiRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorDefiRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegatorDefisRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorDefiRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegatorDefisRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DefiAddress) > 0 {
-		i -= len(m.DefiAddress)
-		copy(dAtA[i:], m.DefiAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DefiAddress)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3783,7 +4098,7 @@ func (m *QueryDelegatorDefiRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorDefiResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegatorDefisResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3793,33 +4108,119 @@ func (m *QueryDelegatorDefiResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorDefiResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegatorDefisResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorDefiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegatorDefisResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Defi.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if len(m.Defis) > 0 {
+		for iNdEx := len(m.Defis) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Defis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorDefiRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorDefiRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorDefiRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DefiAddress) > 0 {
+		i -= len(m.DefiAddress)
+		copy(dAtA[i:], m.DefiAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DefiAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorDefiResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorDefiResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorDefiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Defi.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -4328,6 +4729,92 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDefiInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDefiInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDefiInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDefiInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDefiInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDefiInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Minter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BondedRatio.Size()
+		i -= size
+		if _, err := m.BondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Provision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -4649,6 +5136,50 @@ func (m *QueryDelegatorUnbondingDelegationsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRedelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SrcDefiAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DstDefiAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RedelegationResponses) > 0 {
+		for _, e := range m.RedelegationResponses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryDelegatorDefisRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4922,6 +5453,32 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDefiInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDefiInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Provision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BondedRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Minter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7010,7 +7567,7 @@ func (m *QueryDelegatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryDelegatorDefisRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRedelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7033,10 +7590,10 @@ func (m *QueryDelegatorDefisRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorDefisRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorDefisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7073,9 +7630,9 @@ func (m *QueryDelegatorDefisRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcDefiAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7085,44 +7642,346 @@ func (m *QueryDelegatorDefisRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SrcDefiAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstDefiAddress", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstDefiAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedelegationResponses = append(m.RedelegationResponses, RedelegationResponse{})
+			if err := m.RedelegationResponses[len(m.RedelegationResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorDefisRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorDefisRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorDefisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -8726,6 +9585,240 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDefiInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDefiInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDefiInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDefiInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDefiInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDefiInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
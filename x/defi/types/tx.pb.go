@@ -43,6 +43,7 @@ type MsgCreateDefi struct {
 	DelegatorAddress  string                                 `protobuf:"bytes,3,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
 	DefiAddress       string                                 `protobuf:"bytes,4,opt,name=defi_address,json=defiAddress,proto3" json:"defi_address,omitempty" yaml:"defi_address"`
 	Value             types.Coin                             `protobuf:"bytes,5,opt,name=value,proto3" json:"value"`
+	Commission        CommissionRates                        `protobuf:"bytes,6,opt,name=commission,proto3" json:"commission"`
 }
 
 func (m *MsgCreateDefi) Reset()         { *m = MsgCreateDefi{} }
@@ -120,6 +121,10 @@ type MsgEditDefi struct {
 	Description       Description                             `protobuf:"bytes,1,opt,name=description,proto3" json:"description"`
 	DefiAddress       string                                  `protobuf:"bytes,2,opt,name=defi_address,json=defiAddress,proto3" json:"defi_address,omitempty" yaml:"address"`
 	MinSelfDelegation *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation,omitempty" yaml:"min_self_delegation"`
+	// We pass a reference to the new commission rate as it's not mandatory to
+	// update. If not updated, the deserialized rate will be zero with no way to
+	// distinguish if an update was intended.
+	CommissionRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate,omitempty" yaml:"commission_rate"`
 }
 
 func (m *MsgEditDefi) Reset()         { *m = MsgEditDefi{} }
@@ -270,6 +275,93 @@ func (m *MsgDefiDelegateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDefiDelegateResponse proto.InternalMessageInfo
 
+// MsgDefiBeginRedelegate defines a SDK message for performing a redelegation
+// of coins from a delegator and source defi to a destination defi.
+type MsgDefiBeginRedelegate struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	DefiSrcAddress   string     `protobuf:"bytes,2,opt,name=defi_src_address,json=defiSrcAddress,proto3" json:"defi_src_address,omitempty" yaml:"defi_src_address"`
+	DefiDstAddress   string     `protobuf:"bytes,3,opt,name=defi_dst_address,json=defiDstAddress,proto3" json:"defi_dst_address,omitempty" yaml:"defi_dst_address"`
+	Amount           types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgDefiBeginRedelegate) Reset()         { *m = MsgDefiBeginRedelegate{} }
+func (m *MsgDefiBeginRedelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgDefiBeginRedelegate) ProtoMessage()    {}
+func (*MsgDefiBeginRedelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e72bf8f25f9c8490, []int{6}
+}
+func (m *MsgDefiBeginRedelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDefiBeginRedelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDefiBeginRedelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDefiBeginRedelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDefiBeginRedelegate.Merge(m, src)
+}
+func (m *MsgDefiBeginRedelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDefiBeginRedelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDefiBeginRedelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDefiBeginRedelegate proto.InternalMessageInfo
+
+// MsgDefiBeginRedelegateResponse defines the Msg/BeginRedelegate response type.
+type MsgDefiBeginRedelegateResponse struct {
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *MsgDefiBeginRedelegateResponse) Reset()         { *m = MsgDefiBeginRedelegateResponse{} }
+func (m *MsgDefiBeginRedelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDefiBeginRedelegateResponse) ProtoMessage()    {}
+func (*MsgDefiBeginRedelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e72bf8f25f9c8490, []int{7}
+}
+func (m *MsgDefiBeginRedelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDefiBeginRedelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDefiBeginRedelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDefiBeginRedelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDefiBeginRedelegateResponse.Merge(m, src)
+}
+func (m *MsgDefiBeginRedelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDefiBeginRedelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDefiBeginRedelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDefiBeginRedelegateResponse proto.InternalMessageInfo
+
+func (m *MsgDefiBeginRedelegateResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
 // MsgDefiUndelegate defines a SDK message for performing an undelegation from a
 // delegate and a defi.
 type MsgDefiUndelegate struct {
@@ -282,7 +374,7 @@ func (m *MsgDefiUndelegate) Reset()         { *m = MsgDefiUndelegate{} }
 func (m *MsgDefiUndelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgDefiUndelegate) ProtoMessage()    {}
 func (*MsgDefiUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e72bf8f25f9c8490, []int{6}
+	return fileDescriptor_e72bf8f25f9c8490, []int{8}
 }
 func (m *MsgDefiUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -320,7 +412,7 @@ func (m *MsgDefiUndelegateResponse) Reset()         { *m = MsgDefiUndelegateResp
 func (m *MsgDefiUndelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDefiUndelegateResponse) ProtoMessage()    {}
 func (*MsgDefiUndelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e72bf8f25f9c8490, []int{7}
+	return fileDescriptor_e72bf8f25f9c8490, []int{9}
 }
 func (m *MsgDefiUndelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -367,7 +459,7 @@ func (m *MsgSetDefiWithdrawAddress) Reset()         { *m = MsgSetDefiWithdrawAdd
 func (m *MsgSetDefiWithdrawAddress) String() string { return proto.CompactTextString(m) }
 func (*MsgSetDefiWithdrawAddress) ProtoMessage()    {}
 func (*MsgSetDefiWithdrawAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e72bf8f25f9c8490, []int{8}
+	return fileDescriptor_e72bf8f25f9c8490, []int{10}
 }
 func (m *MsgSetDefiWithdrawAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -404,7 +496,7 @@ func (m *MsgSetDefiWithdrawAddressResponse) Reset()         { *m = MsgSetDefiWit
 func (m *MsgSetDefiWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetDefiWithdrawAddressResponse) ProtoMessage()    {}
 func (*MsgSetDefiWithdrawAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e72bf8f25f9c8490, []int{9}
+	return fileDescriptor_e72bf8f25f9c8490, []int{11}
 }
 func (m *MsgSetDefiWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,7 +536,7 @@ func (m *MsgWithdrawDefiDelegatorReward) Reset()         { *m = MsgWithdrawDefiD
 func (m *MsgWithdrawDefiDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawDefiDelegatorReward) ProtoMessage()    {}
 func (*MsgWithdrawDefiDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e72bf8f25f9c8490, []int{10}
+	return fileDescriptor_e72bf8f25f9c8490, []int{12}
 }
 func (m *MsgWithdrawDefiDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -483,7 +575,7 @@ func (m *MsgWithdrawDefiDelegatorRewardResponse) Reset() {
 func (m *MsgWithdrawDefiDelegatorRewardResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawDefiDelegatorRewardResponse) ProtoMessage()    {}
 func (*MsgWithdrawDefiDelegatorRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e72bf8f25f9c8490, []int{11}
+	return fileDescriptor_e72bf8f25f9c8490, []int{13}
 }
 func (m *MsgWithdrawDefiDelegatorRewardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -522,7 +614,7 @@ func (m *MsgWithdrawDefiCommission) Reset()         { *m = MsgWithdrawDefiCommis
 func (m *MsgWithdrawDefiCommission) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawDefiCommission) ProtoMessage()    {}
 func (*MsgWithdrawDefiCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e72bf8f25f9c8490, []int{12}
+	return fileDescriptor_e72bf8f25f9c8490, []int{14}
 }
 func (m *MsgWithdrawDefiCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -559,7 +651,7 @@ func (m *MsgWithdrawDefiCommissionResponse) Reset()         { *m = MsgWithdrawDe
 func (m *MsgWithdrawDefiCommissionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawDefiCommissionResponse) ProtoMessage()    {}
 func (*MsgWithdrawDefiCommissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e72bf8f25f9c8490, []int{13}
+	return fileDescriptor_e72bf8f25f9c8490, []int{15}
 }
 func (m *MsgWithdrawDefiCommissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +691,7 @@ func (m *MsgFundDefiCommunityPool) Reset()         { *m = MsgFundDefiCommunityPo
 func (m *MsgFundDefiCommunityPool) String() string { return proto.CompactTextString(m) }
 func (*MsgFundDefiCommunityPool) ProtoMessage()    {}
 func (*MsgFundDefiCommunityPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e72bf8f25f9c8490, []int{14}
+	return fileDescriptor_e72bf8f25f9c8490, []int{16}
 }
 func (m *MsgFundDefiCommunityPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,7 +728,7 @@ func (m *MsgFundDefiCommunityPoolResponse) Reset()         { *m = MsgFundDefiCom
 func (m *MsgFundDefiCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFundDefiCommunityPoolResponse) ProtoMessage()    {}
 func (*MsgFundDefiCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e72bf8f25f9c8490, []int{15}
+	return fileDescriptor_e72bf8f25f9c8490, []int{17}
 }
 func (m *MsgFundDefiCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -665,6 +757,162 @@ func (m *MsgFundDefiCommunityPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFundDefiCommunityPoolResponse proto.InternalMessageInfo
 
+// MsgSlashDefi slashes a fraction of the tokens a defi held at the infraction
+// height, and jails the defi when jail is true.
+type MsgSlashDefi struct {
+	// authority is the registered evidence handler reporting the infraction.
+	Authority        string                                 `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	DefiAddress      string                                 `protobuf:"bytes,2,opt,name=defi_address,json=defiAddress,proto3" json:"defi_address,omitempty" yaml:"defi_address"`
+	InfractionHeight int64                                  `protobuf:"varint,3,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty" yaml:"infraction_height"`
+	SlashFraction    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
+	Jail             bool                                   `protobuf:"varint,5,opt,name=jail,proto3" json:"jail,omitempty"`
+}
+
+func (m *MsgSlashDefi) Reset()         { *m = MsgSlashDefi{} }
+func (m *MsgSlashDefi) String() string { return proto.CompactTextString(m) }
+func (*MsgSlashDefi) ProtoMessage()    {}
+func (*MsgSlashDefi) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e72bf8f25f9c8490, []int{18}
+}
+func (m *MsgSlashDefi) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSlashDefi) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSlashDefi.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSlashDefi) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSlashDefi.Merge(m, src)
+}
+func (m *MsgSlashDefi) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSlashDefi) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSlashDefi.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSlashDefi proto.InternalMessageInfo
+
+// MsgSlashDefiResponse defines the Msg/SlashDefi response type.
+type MsgSlashDefiResponse struct {
+}
+
+func (m *MsgSlashDefiResponse) Reset()         { *m = MsgSlashDefiResponse{} }
+func (m *MsgSlashDefiResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSlashDefiResponse) ProtoMessage()    {}
+func (*MsgSlashDefiResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e72bf8f25f9c8490, []int{19}
+}
+func (m *MsgSlashDefiResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSlashDefiResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSlashDefiResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSlashDefiResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSlashDefiResponse.Merge(m, src)
+}
+func (m *MsgSlashDefiResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSlashDefiResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSlashDefiResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSlashDefiResponse proto.InternalMessageInfo
+
+// MsgUnjailDefi unjails a defi once its jail duration has elapsed.
+type MsgUnjailDefi struct {
+	DefiAddress string `protobuf:"bytes,1,opt,name=defi_address,json=defiAddress,proto3" json:"defi_address,omitempty" yaml:"defi_address"`
+}
+
+func (m *MsgUnjailDefi) Reset()         { *m = MsgUnjailDefi{} }
+func (m *MsgUnjailDefi) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailDefi) ProtoMessage()    {}
+func (*MsgUnjailDefi) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e72bf8f25f9c8490, []int{20}
+}
+func (m *MsgUnjailDefi) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailDefi) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailDefi.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailDefi) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailDefi.Merge(m, src)
+}
+func (m *MsgUnjailDefi) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailDefi) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailDefi.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailDefi proto.InternalMessageInfo
+
+// MsgUnjailDefiResponse defines the Msg/UnjailDefi response type.
+type MsgUnjailDefiResponse struct {
+}
+
+func (m *MsgUnjailDefiResponse) Reset()         { *m = MsgUnjailDefiResponse{} }
+func (m *MsgUnjailDefiResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailDefiResponse) ProtoMessage()    {}
+func (*MsgUnjailDefiResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e72bf8f25f9c8490, []int{21}
+}
+func (m *MsgUnjailDefiResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailDefiResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailDefiResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailDefiResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailDefiResponse.Merge(m, src)
+}
+func (m *MsgUnjailDefiResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailDefiResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailDefiResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailDefiResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateDefi)(nil), "gauss.defi.MsgCreateDefi")
 	proto.RegisterType((*MsgCreateDefiResponse)(nil), "gauss.defi.MsgCreateDefiResponse")
@@ -672,6 +920,8 @@ func init() {
 	proto.RegisterType((*MsgEditDefiResponse)(nil), "gauss.defi.MsgEditDefiResponse")
 	proto.RegisterType((*MsgDefiDelegate)(nil), "gauss.defi.MsgDefiDelegate")
 	proto.RegisterType((*MsgDefiDelegateResponse)(nil), "gauss.defi.MsgDefiDelegateResponse")
+	proto.RegisterType((*MsgDefiBeginRedelegate)(nil), "gauss.defi.MsgDefiBeginRedelegate")
+	proto.RegisterType((*MsgDefiBeginRedelegateResponse)(nil), "gauss.defi.MsgDefiBeginRedelegateResponse")
 	proto.RegisterType((*MsgDefiUndelegate)(nil), "gauss.defi.MsgDefiUndelegate")
 	proto.RegisterType((*MsgDefiUndelegateResponse)(nil), "gauss.defi.MsgDefiUndelegateResponse")
 	proto.RegisterType((*MsgSetDefiWithdrawAddress)(nil), "gauss.defi.MsgSetDefiWithdrawAddress")
@@ -682,71 +932,92 @@ func init() {
 	proto.RegisterType((*MsgWithdrawDefiCommissionResponse)(nil), "gauss.defi.MsgWithdrawDefiCommissionResponse")
 	proto.RegisterType((*MsgFundDefiCommunityPool)(nil), "gauss.defi.MsgFundDefiCommunityPool")
 	proto.RegisterType((*MsgFundDefiCommunityPoolResponse)(nil), "gauss.defi.MsgFundDefiCommunityPoolResponse")
+	proto.RegisterType((*MsgSlashDefi)(nil), "gauss.defi.MsgSlashDefi")
+	proto.RegisterType((*MsgSlashDefiResponse)(nil), "gauss.defi.MsgSlashDefiResponse")
+	proto.RegisterType((*MsgUnjailDefi)(nil), "gauss.defi.MsgUnjailDefi")
+	proto.RegisterType((*MsgUnjailDefiResponse)(nil), "gauss.defi.MsgUnjailDefiResponse")
 }
 
 func init() { proto.RegisterFile("gauss/defi/tx.proto", fileDescriptor_e72bf8f25f9c8490) }
 
 var fileDescriptor_e72bf8f25f9c8490 = []byte{
-	// 933 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x5e, 0x27, 0x4d, 0x95, 0xbe, 0x40, 0xd3, 0x38, 0x84, 0xdd, 0x75, 0x8b, 0x9d, 0xba, 0xb4,
-	0x8a, 0x10, 0xb5, 0x69, 0xa0, 0x42, 0xca, 0x05, 0x48, 0x42, 0x45, 0x51, 0x57, 0x42, 0x0e, 0x08,
-	0x89, 0xcb, 0xca, 0xbb, 0x9e, 0x75, 0xa7, 0xd8, 0x9e, 0x95, 0x67, 0x36, 0xc9, 0x4a, 0xfc, 0x01,
-	0x70, 0xeb, 0x89, 0x73, 0x6f, 0x48, 0xdc, 0xb8, 0xf1, 0x27, 0xf4, 0xd8, 0x23, 0x02, 0xb1, 0x41,
-	0xc9, 0x85, 0xf3, 0xde, 0xb8, 0xa1, 0xf1, 0xd8, 0x63, 0xaf, 0xe3, 0x5d, 0x2d, 0x28, 0x48, 0xa8,
-	0x97, 0xcd, 0xee, 0xfb, 0xbe, 0x79, 0xf3, 0xbe, 0xf7, 0xcb, 0x0e, 0xac, 0xfb, 0xee, 0x80, 0x52,
-	0xdb, 0x43, 0x3d, 0x6c, 0xb3, 0x63, 0xab, 0x1f, 0x13, 0x46, 0x54, 0x48, 0x8c, 0x16, 0x37, 0x6a,
-	0x4d, 0x9f, 0x10, 0x3f, 0x40, 0x76, 0x82, 0x74, 0x06, 0x3d, 0xdb, 0x8d, 0x86, 0x82, 0xa6, 0x19,
-	0x65, 0x88, 0xe1, 0x10, 0x51, 0xe6, 0x86, 0xfd, 0x94, 0xf0, 0x9a, 0x4f, 0x7c, 0x92, 0x7c, 0xb5,
-	0xf9, 0xb7, 0xd4, 0xda, 0xec, 0x12, 0x1a, 0x12, 0xda, 0x16, 0x80, 0xf8, 0x91, 0x42, 0xba, 0xf8,
-	0x65, 0x77, 0x5c, 0x8a, 0xec, 0xc3, 0x7b, 0x1d, 0xc4, 0xdc, 0x7b, 0x76, 0x97, 0xe0, 0x28, 0xc5,
-	0x37, 0x0a, 0xd1, 0xf2, 0x0f, 0x61, 0x36, 0xbf, 0x5f, 0x84, 0x57, 0x5b, 0xd4, 0xdf, 0x8b, 0x91,
-	0xcb, 0xd0, 0x3e, 0xea, 0x61, 0xf5, 0x03, 0x58, 0xf1, 0x10, 0xed, 0xc6, 0xb8, 0xcf, 0x30, 0x89,
-	0x1a, 0xca, 0xa6, 0xb2, 0xb5, 0xb2, 0x5d, 0xb7, 0x72, 0x5d, 0xd6, 0x7e, 0x0e, 0xef, 0x5e, 0x7a,
-	0x3e, 0x32, 0x6a, 0x4e, 0xf1, 0x84, 0xfa, 0x0d, 0xac, 0x87, 0x38, 0x6a, 0x53, 0x14, 0xf4, 0xda,
-	0x1e, 0x0a, 0x90, 0xef, 0x26, 0x8e, 0x16, 0x36, 0x95, 0xad, 0x2b, 0xbb, 0x8f, 0x38, 0xff, 0xd7,
-	0x91, 0x71, 0xc7, 0xc7, 0xec, 0xf1, 0xa0, 0x63, 0x75, 0x49, 0x98, 0xea, 0x48, 0xff, 0xdc, 0xa5,
-	0xde, 0xd7, 0x36, 0x1b, 0xf6, 0x11, 0xb5, 0x1e, 0x46, 0x6c, 0x3c, 0x32, 0xb4, 0xa1, 0x1b, 0x06,
-	0x3b, 0x66, 0x85, 0x4b, 0xd3, 0x59, 0x0b, 0x71, 0x74, 0x80, 0x82, 0xde, 0xbe, 0xb4, 0xa9, 0x0f,
-	0x61, 0x2d, 0x65, 0x90, 0xb8, 0xed, 0x7a, 0x5e, 0x8c, 0x28, 0x6d, 0x2c, 0x26, 0x77, 0xdf, 0x18,
-	0x8f, 0x8c, 0x86, 0xf0, 0x76, 0x8e, 0x62, 0x3a, 0xd7, 0xa4, 0xed, 0x23, 0x61, 0x52, 0x77, 0xe0,
-	0x15, 0xae, 0x57, 0x7a, 0xb9, 0x94, 0x78, 0xa9, 0x8f, 0x47, 0xc6, 0x7a, 0xe6, 0x25, 0x47, 0x4d,
-	0x9e, 0x84, 0x1e, 0xce, 0xce, 0xde, 0x87, 0xa5, 0x43, 0x37, 0x18, 0xa0, 0xc6, 0x52, 0x92, 0xbf,
-	0xa6, 0x95, 0x16, 0x8b, 0x97, 0xc7, 0x4a, 0xcb, 0x63, 0xed, 0x11, 0x9c, 0x65, 0x50, 0xb0, 0x77,
-	0x96, 0xbf, 0x7d, 0x66, 0xd4, 0xfe, 0x7c, 0x66, 0xd4, 0xcc, 0x3a, 0x6c, 0x4c, 0xd4, 0xc5, 0x41,
-	0xb4, 0x4f, 0x22, 0x8a, 0xcc, 0xef, 0x16, 0x60, 0xa5, 0x45, 0xfd, 0x8f, 0x3d, 0xcc, 0x2e, 0xa6,
-	0x5e, 0xf7, 0x4b, 0x32, 0x45, 0xa1, 0xd4, 0xf1, 0xc8, 0xb8, 0x2a, 0x64, 0x56, 0x2b, 0x3c, 0xae,
-	0x2e, 0xb3, 0x48, 0xf5, 0x27, 0xff, 0x61, 0x89, 0x0b, 0x49, 0xda, 0x80, 0xf5, 0x42, 0x2a, 0x64,
-	0x8a, 0x7e, 0x53, 0x60, 0xb5, 0x45, 0x7d, 0x6e, 0x4b, 0x8f, 0xa1, 0xea, 0xbe, 0x50, 0x2e, 0xa4,
-	0x2f, 0x16, 0xfe, 0x41, 0x5f, 0xbc, 0x0f, 0x97, 0xdd, 0x90, 0x0c, 0x22, 0x96, 0x24, 0x6a, 0x8e,
-	0xc6, 0x48, 0xe9, 0x05, 0xd1, 0x4d, 0xa8, 0x97, 0xc4, 0x49, 0xe1, 0xbf, 0x2b, 0xb0, 0x96, 0x62,
-	0x5f, 0x44, 0xde, 0xcb, 0x27, 0xfd, 0x09, 0x34, 0xcf, 0xc9, 0xcb, 0xc4, 0xab, 0x2d, 0x58, 0xed,
-	0x92, 0xb0, 0x1f, 0x20, 0xde, 0x24, 0x6d, 0xbe, 0x50, 0xd3, 0x61, 0xd0, 0x2c, 0xb1, 0x6d, 0xad,
-	0x6c, 0xdb, 0x5a, 0x9f, 0x67, 0xdb, 0x76, 0x77, 0x99, 0xdf, 0xf4, 0xf4, 0xc4, 0x50, 0x9c, 0xab,
-	0xf9, 0x61, 0x0e, 0x9b, 0x3f, 0x2b, 0xc9, 0x65, 0x07, 0x28, 0xe9, 0xad, 0x2f, 0x31, 0x7b, 0xec,
-	0xc5, 0xee, 0x51, 0x26, 0xe6, 0x02, 0x73, 0xfa, 0x00, 0xae, 0x1d, 0xa5, 0xde, 0x4b, 0x79, 0xbd,
-	0x3e, 0x1e, 0x19, 0x75, 0xe1, 0xa9, 0xcc, 0x30, 0x9d, 0xd5, 0xa3, 0xc9, 0x90, 0x0a, 0x69, 0xba,
-	0x05, 0x37, 0xa7, 0x46, 0x2e, 0x7b, 0xe5, 0x27, 0x05, 0xf4, 0x16, 0xf5, 0x33, 0xb8, 0xd0, 0x4f,
-	0x24, 0x76, 0xd0, 0x91, 0x1b, 0x7b, 0xff, 0x93, 0xc6, 0x29, 0x08, 0xdb, 0x82, 0x3b, 0xb3, 0x43,
-	0x96, 0xea, 0xdc, 0xa4, 0x78, 0x45, 0xe6, 0x1e, 0x09, 0x43, 0x4c, 0x29, 0xdf, 0x78, 0xe5, 0x60,
-	0x94, 0x7f, 0x15, 0x8c, 0xc8, 0x72, 0xf5, 0x15, 0x32, 0x8e, 0x1f, 0x14, 0x68, 0xb4, 0xa8, 0xff,
-	0x60, 0x10, 0x79, 0x19, 0x63, 0x10, 0x61, 0x36, 0xfc, 0x8c, 0x90, 0x40, 0xed, 0xca, 0x89, 0x50,
-	0x36, 0x17, 0x67, 0x4f, 0xc4, 0x3b, 0xbc, 0x4f, 0x7f, 0x3c, 0x31, 0xb6, 0xe6, 0x58, 0xaa, 0xfc,
-	0x00, 0xcd, 0xa6, 0x47, 0xbd, 0x01, 0x57, 0x3c, 0xd4, 0x27, 0x14, 0x33, 0x12, 0x8b, 0xb4, 0x3b,
-	0xb9, 0xa1, 0x20, 0xc7, 0x84, 0xcd, 0x69, 0x81, 0x66, 0x6a, 0xb6, 0xff, 0x5a, 0x82, 0xc5, 0x16,
-	0xf5, 0xd5, 0x4f, 0x01, 0x0a, 0x6f, 0x0c, 0xcd, 0xe2, 0xc3, 0x66, 0xe2, 0xa1, 0xa5, 0xdd, 0x9c,
-	0x0a, 0xc9, 0xb1, 0xdd, 0x87, 0x65, 0xf9, 0x2c, 0xab, 0x97, 0xe8, 0x19, 0xa0, 0x19, 0x53, 0x00,
-	0xe9, 0xe5, 0x11, 0x2c, 0xcb, 0x55, 0x7f, 0xbd, 0x44, 0x2e, 0xae, 0x4a, 0xed, 0xd6, 0x0c, 0x50,
-	0x7a, 0x73, 0x00, 0x0a, 0xfb, 0xf3, 0x8d, 0x8a, 0x23, 0x39, 0xac, 0xdd, 0x9e, 0x09, 0x4b, 0x9f,
-	0x4f, 0x40, 0x3d, 0x40, 0xac, 0xbc, 0x47, 0xca, 0x87, 0xab, 0x87, 0x56, 0xbb, 0x3b, 0x17, 0x4d,
-	0xde, 0x35, 0x84, 0x7a, 0xde, 0x97, 0x93, 0x33, 0xfd, 0x56, 0xc9, 0xd3, 0x8c, 0x61, 0xd2, 0xb6,
-	0xe7, 0xe7, 0xca, 0xab, 0x23, 0x78, 0x7d, 0xca, 0xd4, 0xdd, 0x9e, 0xe1, 0x2d, 0xa7, 0x9d, 0x93,
-	0x3a, 0x7b, 0xc0, 0x54, 0x1f, 0xd6, 0x78, 0xcf, 0x4e, 0x0e, 0xd6, 0x9b, 0x25, 0x1f, 0x95, 0x5d,
-	0xad, 0xbd, 0x3d, 0x0f, 0x2b, 0xbb, 0x68, 0xf7, 0xc3, 0xe7, 0xa7, 0xba, 0xf2, 0xe2, 0x54, 0x57,
-	0xfe, 0x38, 0xd5, 0x95, 0xa7, 0x67, 0x7a, 0xed, 0xc5, 0x99, 0x5e, 0xfb, 0xe5, 0x4c, 0xaf, 0x7d,
-	0x55, 0x7c, 0xd1, 0x11, 0x6f, 0xd9, 0xe2, 0xf3, 0xf0, 0x3d, 0xfb, 0x38, 0xfd, 0xf7, 0x80, 0xcf,
-	0x65, 0xe7, 0x72, 0xf2, 0xfc, 0x79, 0xf7, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd7, 0xa3, 0xfd,
-	0x32, 0x39, 0x0c, 0x00, 0x00,
+	// 1214 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0x16, 0x2d, 0xc7, 0xb5, 0xc7, 0x89, 0x1f, 0x74, 0x6c, 0x49, 0x74, 0x2a, 0xda, 0x4c, 0x13,
+	0x18, 0x45, 0x4d, 0x35, 0x6e, 0x83, 0x02, 0xbe, 0xb4, 0xb1, 0x15, 0x27, 0x0e, 0x22, 0xa0, 0xa0,
+	0x6b, 0x14, 0xe8, 0x45, 0xa0, 0xc5, 0x15, 0x45, 0x57, 0xe2, 0x0a, 0x5c, 0xca, 0xb6, 0x80, 0xfe,
+	0x80, 0x1e, 0xf3, 0x13, 0x72, 0x2b, 0xd0, 0x5b, 0x81, 0x1e, 0x7a, 0xed, 0x2d, 0xc7, 0x1c, 0xfb,
+	0x40, 0x95, 0xc2, 0xbe, 0xf4, 0x5a, 0x9d, 0x7a, 0x2c, 0x96, 0x4b, 0x2e, 0x57, 0xd4, 0xa3, 0x4a,
+	0x6a, 0x03, 0x45, 0x2f, 0x32, 0x39, 0xf3, 0xed, 0xcc, 0xce, 0x37, 0xb3, 0xb3, 0x63, 0xc2, 0x92,
+	0x6d, 0xb6, 0x08, 0x29, 0x58, 0xa8, 0xea, 0x14, 0xfc, 0x33, 0xbd, 0xe9, 0x61, 0x1f, 0xcb, 0x10,
+	0x08, 0x75, 0x2a, 0x54, 0x72, 0x36, 0xc6, 0x76, 0x1d, 0x15, 0x02, 0xcd, 0x51, 0xab, 0x5a, 0x30,
+	0xdd, 0x36, 0x83, 0x29, 0x6a, 0x52, 0xe5, 0x3b, 0x0d, 0x44, 0x7c, 0xb3, 0xd1, 0x0c, 0x01, 0x37,
+	0x6d, 0x6c, 0xe3, 0xe0, 0xb1, 0x40, 0x9f, 0x42, 0x69, 0xae, 0x82, 0x49, 0x03, 0x93, 0x32, 0x53,
+	0xb0, 0x97, 0x50, 0x95, 0x67, 0x6f, 0x85, 0x23, 0x93, 0xa0, 0xc2, 0xc9, 0xbd, 0x23, 0xe4, 0x9b,
+	0xf7, 0x0a, 0x15, 0xec, 0xb8, 0xa1, 0x7e, 0x59, 0xd8, 0x2d, 0xfd, 0x61, 0x62, 0xed, 0xe7, 0x34,
+	0xdc, 0x28, 0x11, 0x7b, 0xd7, 0x43, 0xa6, 0x8f, 0x8a, 0xa8, 0xea, 0xc8, 0x1f, 0xc3, 0xac, 0x85,
+	0x48, 0xc5, 0x73, 0x9a, 0xbe, 0x83, 0xdd, 0xac, 0xb4, 0x26, 0x6d, 0xcc, 0x6e, 0x65, 0xf4, 0x38,
+	0x2e, 0xbd, 0x18, 0xab, 0x77, 0x26, 0x5f, 0x74, 0xd4, 0x94, 0x21, 0xae, 0x90, 0xbf, 0x82, 0xa5,
+	0x86, 0xe3, 0x96, 0x09, 0xaa, 0x57, 0xcb, 0x16, 0xaa, 0x23, 0xdb, 0x0c, 0x0c, 0x4d, 0xac, 0x49,
+	0x1b, 0x33, 0x3b, 0x4f, 0x29, 0xfe, 0x97, 0x8e, 0x7a, 0xd7, 0x76, 0xfc, 0x5a, 0xeb, 0x48, 0xaf,
+	0xe0, 0x46, 0x18, 0x47, 0xf8, 0x67, 0x93, 0x58, 0x5f, 0x16, 0xfc, 0x76, 0x13, 0x11, 0x7d, 0xdf,
+	0xf5, 0xbb, 0x1d, 0x55, 0x69, 0x9b, 0x8d, 0xfa, 0xb6, 0x36, 0xc0, 0xa4, 0x66, 0x2c, 0x36, 0x1c,
+	0xf7, 0x00, 0xd5, 0xab, 0x45, 0x2e, 0x93, 0xf7, 0x61, 0x31, 0x44, 0x60, 0xaf, 0x6c, 0x5a, 0x96,
+	0x87, 0x08, 0xc9, 0xa6, 0x03, 0xdf, 0xb7, 0xba, 0x1d, 0x35, 0xcb, 0xac, 0xf5, 0x41, 0x34, 0x63,
+	0x81, 0xcb, 0x1e, 0x30, 0x91, 0xbc, 0x0d, 0xd7, 0x69, 0xbc, 0xdc, 0xca, 0x64, 0x60, 0x25, 0xd3,
+	0xed, 0xa8, 0x4b, 0x91, 0x95, 0x58, 0xab, 0x51, 0x12, 0xaa, 0x4e, 0xb4, 0xf6, 0x3e, 0x5c, 0x3b,
+	0x31, 0xeb, 0x2d, 0x94, 0xbd, 0x16, 0xf0, 0x97, 0xd3, 0xc3, 0x64, 0xd1, 0xf4, 0xe8, 0x61, 0x7a,
+	0xf4, 0x5d, 0xec, 0x44, 0x0c, 0x32, 0xb4, 0xfc, 0x00, 0xa0, 0x82, 0x1b, 0x0d, 0x87, 0x10, 0x4a,
+	0xd9, 0x54, 0xb0, 0x76, 0x55, 0xe4, 0x7e, 0x97, 0x6b, 0x0d, 0xd3, 0x47, 0x24, 0x5c, 0x2d, 0x2c,
+	0xda, 0x9e, 0xfe, 0xfa, 0xb9, 0x9a, 0xfa, 0xe3, 0xb9, 0x9a, 0xd2, 0x32, 0xb0, 0xdc, 0x93, 0x5a,
+	0x03, 0x91, 0x26, 0x76, 0x09, 0xd2, 0xfe, 0x9a, 0x80, 0xd9, 0x12, 0xb1, 0x1f, 0x5a, 0x8e, 0x7f,
+	0x39, 0x29, 0xbf, 0x9f, 0x60, 0x8a, 0xe5, 0x5a, 0xee, 0x76, 0xd4, 0x39, 0xc6, 0xd4, 0x60, 0x92,
+	0xce, 0x06, 0x57, 0x0a, 0xcb, 0xd6, 0xe3, 0xab, 0xac, 0x92, 0x06, 0xcc, 0xc7, 0x94, 0x95, 0x3d,
+	0xd3, 0x47, 0x61, 0x76, 0x8b, 0x63, 0x7a, 0x2d, 0xa2, 0x4a, 0xb7, 0xa3, 0xae, 0x30, 0xaf, 0x09,
+	0x53, 0x9a, 0x31, 0x57, 0xe9, 0x49, 0x93, 0x90, 0x93, 0x65, 0x58, 0x12, 0x98, 0xe7, 0x19, 0xf9,
+	0x55, 0x82, 0xf9, 0x12, 0xb1, 0xa9, 0x2c, 0xdc, 0x25, 0x1a, 0x5c, 0xc9, 0xd2, 0xa5, 0x54, 0xf2,
+	0xc4, 0x6b, 0x54, 0xf2, 0x47, 0x30, 0x65, 0x36, 0x70, 0xcb, 0xf5, 0xb3, 0xe9, 0xf1, 0x4a, 0x39,
+	0x84, 0x0b, 0x41, 0xe7, 0x20, 0x93, 0x08, 0x8e, 0x07, 0xfe, 0xfd, 0x04, 0xac, 0x84, 0xba, 0x1d,
+	0x64, 0x3b, 0xae, 0x81, 0xac, 0x2b, 0x88, 0xff, 0x21, 0x2c, 0x04, 0x11, 0x12, 0xaf, 0x92, 0xe0,
+	0x60, 0xb5, 0xdb, 0x51, 0x33, 0x02, 0x07, 0x02, 0x42, 0x33, 0xe6, 0xa8, 0xe8, 0xc0, 0xab, 0x24,
+	0xcd, 0x58, 0xc4, 0x4f, 0xb4, 0x96, 0xa4, 0x19, 0x01, 0x11, 0x9a, 0x29, 0x12, 0xbf, 0x9f, 0xd1,
+	0xc9, 0x37, 0x65, 0x14, 0x43, 0x7e, 0x30, 0x6b, 0x11, 0xb1, 0x72, 0x29, 0xa8, 0xf0, 0x66, 0x1d,
+	0xd1, 0x7a, 0x2f, 0xd3, 0xeb, 0x25, 0x3c, 0xd7, 0x8a, 0xce, 0xee, 0x1e, 0x3d, 0xba, 0x7b, 0xf4,
+	0xcf, 0xa2, 0xbb, 0x67, 0x67, 0x9a, 0xba, 0x7b, 0xf6, 0x4a, 0x95, 0x8c, 0xb9, 0x78, 0x31, 0x55,
+	0x6b, 0xbf, 0x49, 0xb0, 0x18, 0x7a, 0x3c, 0x74, 0xad, 0xff, 0x5f, 0x89, 0x1e, 0x43, 0xae, 0x2f,
+	0xbc, 0xab, 0xe2, 0xf2, 0x07, 0x29, 0x70, 0x76, 0x80, 0x82, 0x1e, 0xf0, 0xb9, 0xe3, 0xd7, 0x2c,
+	0xcf, 0x3c, 0x8d, 0x82, 0xb9, 0x44, 0x4e, 0xf7, 0x60, 0xe1, 0x34, 0xb4, 0x3e, 0xbc, 0xec, 0x93,
+	0x08, 0xcd, 0x98, 0x3f, 0xed, 0xdd, 0x92, 0x40, 0xd3, 0x6d, 0x58, 0x1f, 0xba, 0x73, 0x7e, 0xa6,
+	0xbf, 0x93, 0x82, 0xea, 0x8c, 0xd4, 0xc2, 0xb9, 0xc7, 0x9e, 0x81, 0x4e, 0x4d, 0xcf, 0xfa, 0x8f,
+	0x14, 0x8e, 0x10, 0xd8, 0x06, 0xdc, 0x1d, 0xbd, 0x65, 0x1e, 0x9d, 0x09, 0xb9, 0x04, 0x32, 0xbe,
+	0x93, 0xfb, 0x36, 0x23, 0xbd, 0xd1, 0x66, 0x18, 0xcb, 0x83, 0x5d, 0xf0, 0x7d, 0x7c, 0x23, 0x41,
+	0xb6, 0x44, 0xec, 0xbd, 0x96, 0x6b, 0x45, 0x88, 0x96, 0xeb, 0xf8, 0xed, 0x4f, 0x31, 0xae, 0xcb,
+	0x15, 0x7e, 0x22, 0xa4, 0xb5, 0xf4, 0xe8, 0x13, 0xf1, 0x3e, 0xad, 0xd3, 0x6f, 0x5f, 0xa9, 0x1b,
+	0x63, 0xdc, 0x7a, 0x74, 0x01, 0x89, 0x4e, 0x8f, 0x7c, 0x0b, 0x66, 0x2c, 0xd4, 0xc4, 0xc4, 0xf1,
+	0xb1, 0xc7, 0x68, 0x37, 0x62, 0x81, 0x10, 0x8e, 0x06, 0x6b, 0xc3, 0x36, 0xca, 0xa3, 0xf9, 0x71,
+	0x02, 0xae, 0xd3, 0xca, 0xaa, 0x9b, 0xa4, 0x46, 0x51, 0xd4, 0xb8, 0xd9, 0xf2, 0x6b, 0xd8, 0x73,
+	0xfc, 0x36, 0xa3, 0xd1, 0x88, 0x05, 0xff, 0xaa, 0x5b, 0xec, 0xc3, 0xa2, 0xe3, 0x56, 0x3d, 0xb3,
+	0x12, 0x9c, 0xe6, 0x1a, 0x72, 0xec, 0x1a, 0x6b, 0x1c, 0x69, 0xb1, 0xf6, 0xfa, 0x20, 0x9a, 0xb1,
+	0x10, 0xcb, 0x1e, 0x07, 0x22, 0xd9, 0x85, 0x39, 0x42, 0x77, 0x5c, 0x8e, 0xe4, 0xe1, 0x14, 0xf1,
+	0xe8, 0x35, 0xa6, 0x5c, 0x36, 0x49, 0x2c, 0x33, 0xaf, 0xbd, 0xd6, 0x34, 0xe3, 0x46, 0x20, 0xd8,
+	0x0b, 0xdf, 0x65, 0x19, 0x26, 0x8f, 0x4d, 0xa7, 0x1e, 0x0c, 0x95, 0xd3, 0x46, 0xf0, 0x2c, 0xf0,
+	0xbc, 0x02, 0x37, 0x45, 0x0a, 0x39, 0xb7, 0x87, 0xc1, 0x88, 0x7f, 0xe8, 0x52, 0x78, 0xc0, 0xed,
+	0xe5, 0x54, 0x29, 0x1b, 0x2f, 0x63, 0xb3, 0x91, 0xbf, 0xad, 0x3f, 0xdf, 0x82, 0x74, 0x89, 0xd8,
+	0xf2, 0x13, 0x00, 0xe1, 0xff, 0x8a, 0x9c, 0x38, 0x4f, 0xf6, 0xcc, 0xa5, 0xca, 0xfa, 0x50, 0x15,
+	0x6f, 0xc1, 0x45, 0x98, 0xe6, 0xe3, 0x6a, 0x26, 0x01, 0x8f, 0x14, 0x8a, 0x3a, 0x44, 0xc1, 0xad,
+	0x3c, 0x85, 0x69, 0x3e, 0x5e, 0xad, 0x26, 0xc0, 0xe2, 0x78, 0xa2, 0xdc, 0x1e, 0xa1, 0xe4, 0xd6,
+	0x4c, 0x98, 0x4f, 0xce, 0x2c, 0xda, 0x80, 0x75, 0x09, 0x8c, 0xf2, 0xee, 0x3f, 0x63, 0xb8, 0x0b,
+	0x03, 0x40, 0xb8, 0x6e, 0xdf, 0x1e, 0xb0, 0x32, 0x56, 0x2b, 0x77, 0x46, 0xaa, 0xb9, 0xcd, 0x63,
+	0x90, 0x0f, 0x90, 0x9f, 0xbc, 0x76, 0x92, 0x8b, 0x07, 0xf7, 0x78, 0x65, 0x73, 0x2c, 0x18, 0xf7,
+	0xd5, 0x86, 0x4c, 0xdc, 0xc6, 0x7a, 0xaf, 0x80, 0x24, 0x0d, 0x23, 0x7a, 0xaf, 0xb2, 0x35, 0x3e,
+	0x96, 0xbb, 0x76, 0x61, 0x65, 0x48, 0x93, 0xbe, 0x33, 0xc2, 0x5a, 0x0c, 0x53, 0x36, 0xc7, 0x82,
+	0x71, 0x7f, 0x36, 0x2c, 0xd2, 0x16, 0xd7, 0xdb, 0x87, 0xdf, 0x49, 0xd8, 0x18, 0xd8, 0x04, 0x95,
+	0xf7, 0xc6, 0x41, 0x71, 0x47, 0x8f, 0x60, 0x26, 0x6e, 0x93, 0xd9, 0x64, 0x3e, 0x22, 0x8d, 0xb2,
+	0x36, 0x4c, 0xc3, 0x0d, 0x3d, 0x01, 0x88, 0x4f, 0x6f, 0xdf, 0xf9, 0x8c, 0x55, 0xca, 0xfa, 0x50,
+	0x55, 0x64, 0x6b, 0xe7, 0x93, 0x17, 0xe7, 0x79, 0xe9, 0xe5, 0x79, 0x5e, 0xfa, 0xfd, 0x3c, 0x2f,
+	0x3d, 0xbb, 0xc8, 0xa7, 0x5e, 0x5e, 0xe4, 0x53, 0x3f, 0x5d, 0xe4, 0x53, 0x5f, 0x88, 0x3d, 0x90,
+	0x7d, 0x83, 0x60, 0xbf, 0x27, 0x1f, 0x16, 0xce, 0xc2, 0x8f, 0x27, 0xb4, 0x0f, 0x1e, 0x4d, 0x05,
+	0x33, 0xd4, 0x07, 0x7f, 0x0f, 0x00, 0x54, 0x38, 0xe6, 0x44, 0x57, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -767,6 +1038,9 @@ type MsgClient interface {
 	EditDefi(ctx context.Context, in *MsgEditDefi, opts ...grpc.CallOption) (*MsgEditDefiResponse, error)
 	// from a delegator to a defi.
 	Delegate(ctx context.Context, in *MsgDefiDelegate, opts ...grpc.CallOption) (*MsgDefiDelegateResponse, error)
+	// BeginRedelegate defines a method for performing a redelegation
+	// of coins from a delegator and source defi to a destination defi.
+	BeginRedelegate(ctx context.Context, in *MsgDefiBeginRedelegate, opts ...grpc.CallOption) (*MsgDefiBeginRedelegateResponse, error)
 	// Undelegate defines a method for performing an undelegation from a
 	// delegate and a defi.
 	Undelegate(ctx context.Context, in *MsgDefiUndelegate, opts ...grpc.CallOption) (*MsgDefiUndelegateResponse, error)
@@ -782,6 +1056,12 @@ type MsgClient interface {
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	FundCommunityPool(ctx context.Context, in *MsgFundDefiCommunityPool, opts ...grpc.CallOption) (*MsgFundDefiCommunityPoolResponse, error)
+	// SlashDefi defines a method for a registered evidence handler to slash,
+	// and optionally jail, a misbehaving defi.
+	SlashDefi(ctx context.Context, in *MsgSlashDefi, opts ...grpc.CallOption) (*MsgSlashDefiResponse, error)
+	// UnjailDefi defines a method for a defi operator to bring a jailed defi
+	// back into the candidates of the bonded set.
+	UnjailDefi(ctx context.Context, in *MsgUnjailDefi, opts ...grpc.CallOption) (*MsgUnjailDefiResponse, error)
 }
 
 type msgClient struct {
@@ -819,6 +1099,15 @@ func (c *msgClient) Delegate(ctx context.Context, in *MsgDefiDelegate, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) BeginRedelegate(ctx context.Context, in *MsgDefiBeginRedelegate, opts ...grpc.CallOption) (*MsgDefiBeginRedelegateResponse, error) {
+	out := new(MsgDefiBeginRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/gauss.defi.Msg/BeginRedelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Undelegate(ctx context.Context, in *MsgDefiUndelegate, opts ...grpc.CallOption) (*MsgDefiUndelegateResponse, error) {
 	out := new(MsgDefiUndelegateResponse)
 	err := c.cc.Invoke(ctx, "/gauss.defi.Msg/Undelegate", in, out, opts...)
@@ -864,6 +1153,24 @@ func (c *msgClient) FundCommunityPool(ctx context.Context, in *MsgFundDefiCommun
 	return out, nil
 }
 
+func (c *msgClient) SlashDefi(ctx context.Context, in *MsgSlashDefi, opts ...grpc.CallOption) (*MsgSlashDefiResponse, error) {
+	out := new(MsgSlashDefiResponse)
+	err := c.cc.Invoke(ctx, "/gauss.defi.Msg/SlashDefi", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnjailDefi(ctx context.Context, in *MsgUnjailDefi, opts ...grpc.CallOption) (*MsgUnjailDefiResponse, error) {
+	out := new(MsgUnjailDefiResponse)
+	err := c.cc.Invoke(ctx, "/gauss.defi.Msg/UnjailDefi", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateDefi defines a method for creating a new defi.
@@ -872,6 +1179,9 @@ type MsgServer interface {
 	EditDefi(context.Context, *MsgEditDefi) (*MsgEditDefiResponse, error)
 	// from a delegator to a defi.
 	Delegate(context.Context, *MsgDefiDelegate) (*MsgDefiDelegateResponse, error)
+	// BeginRedelegate defines a method for performing a redelegation
+	// of coins from a delegator and source defi to a destination defi.
+	BeginRedelegate(context.Context, *MsgDefiBeginRedelegate) (*MsgDefiBeginRedelegateResponse, error)
 	// Undelegate defines a method for performing an undelegation from a
 	// delegate and a defi.
 	Undelegate(context.Context, *MsgDefiUndelegate) (*MsgDefiUndelegateResponse, error)
@@ -887,6 +1197,12 @@ type MsgServer interface {
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	FundCommunityPool(context.Context, *MsgFundDefiCommunityPool) (*MsgFundDefiCommunityPoolResponse, error)
+	// SlashDefi defines a method for a registered evidence handler to slash,
+	// and optionally jail, a misbehaving defi.
+	SlashDefi(context.Context, *MsgSlashDefi) (*MsgSlashDefiResponse, error)
+	// UnjailDefi defines a method for a defi operator to bring a jailed defi
+	// back into the candidates of the bonded set.
+	UnjailDefi(context.Context, *MsgUnjailDefi) (*MsgUnjailDefiResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -902,6 +1218,9 @@ func (*UnimplementedMsgServer) EditDefi(ctx context.Context, req *MsgEditDefi) (
 func (*UnimplementedMsgServer) Delegate(ctx context.Context, req *MsgDefiDelegate) (*MsgDefiDelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delegate not implemented")
 }
+func (*UnimplementedMsgServer) BeginRedelegate(ctx context.Context, req *MsgDefiBeginRedelegate) (*MsgDefiBeginRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginRedelegate not implemented")
+}
 func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgDefiUndelegate) (*MsgDefiUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
 }
@@ -917,6 +1236,12 @@ func (*UnimplementedMsgServer) WithdrawDefiCommission(ctx context.Context, req *
 func (*UnimplementedMsgServer) FundCommunityPool(ctx context.Context, req *MsgFundDefiCommunityPool) (*MsgFundDefiCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundCommunityPool not implemented")
 }
+func (*UnimplementedMsgServer) SlashDefi(ctx context.Context, req *MsgSlashDefi) (*MsgSlashDefiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashDefi not implemented")
+}
+func (*UnimplementedMsgServer) UnjailDefi(ctx context.Context, req *MsgUnjailDefi) (*MsgUnjailDefiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailDefi not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -976,6 +1301,24 @@ func _Msg_Delegate_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BeginRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDefiBeginRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BeginRedelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gauss.defi.Msg/BeginRedelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BeginRedelegate(ctx, req.(*MsgDefiBeginRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDefiUndelegate)
 	if err := dec(in); err != nil {
@@ -1066,6 +1409,42 @@ func _Msg_FundCommunityPool_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SlashDefi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSlashDefi)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SlashDefi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gauss.defi.Msg/SlashDefi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SlashDefi(ctx, req.(*MsgSlashDefi))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnjailDefi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailDefi)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailDefi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gauss.defi.Msg/UnjailDefi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailDefi(ctx, req.(*MsgUnjailDefi))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gauss.defi.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1082,6 +1461,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delegate",
 			Handler:    _Msg_Delegate_Handler,
 		},
+		{
+			MethodName: "BeginRedelegate",
+			Handler:    _Msg_BeginRedelegate_Handler,
+		},
 		{
 			MethodName: "Undelegate",
 			Handler:    _Msg_Undelegate_Handler,
@@ -1102,6 +1485,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FundCommunityPool",
 			Handler:    _Msg_FundCommunityPool_Handler,
 		},
+		{
+			MethodName: "SlashDefi",
+			Handler:    _Msg_SlashDefi_Handler,
+		},
+		{
+			MethodName: "UnjailDefi",
+			Handler:    _Msg_UnjailDefi_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gauss/defi/tx.proto",
@@ -1127,6 +1518,16 @@ func (m *MsgCreateDefi) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1217,6 +1618,18 @@ func (m *MsgEditDefi) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CommissionRate != nil {
+		{
+			size := m.CommissionRate.Size()
+			i -= size
+			if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.MinSelfDelegation != nil {
 		{
 			size := m.MinSelfDelegation.Size()
@@ -1342,7 +1755,7 @@ func (m *MsgDefiDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDefiUndelegate) Marshal() (dAtA []byte, err error) {
+func (m *MsgDefiBeginRedelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1352,12 +1765,12 @@ func (m *MsgDefiUndelegate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDefiUndelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDefiBeginRedelegate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDefiUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDefiBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1371,11 +1784,18 @@ func (m *MsgDefiUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.DefiAddress) > 0 {
-		i -= len(m.DefiAddress)
-		copy(dAtA[i:], m.DefiAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DefiAddress)))
+	dAtA[i] = 0x22
+	if len(m.DefiDstAddress) > 0 {
+		i -= len(m.DefiDstAddress)
+		copy(dAtA[i:], m.DefiDstAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DefiDstAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DefiSrcAddress) > 0 {
+		i -= len(m.DefiSrcAddress)
+		copy(dAtA[i:], m.DefiSrcAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DefiSrcAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1389,7 +1809,7 @@ func (m *MsgDefiUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDefiUndelegateResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDefiBeginRedelegateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1399,28 +1819,28 @@ func (m *MsgDefiUndelegateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDefiUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDefiBeginRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDefiUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDefiBeginRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err6 != nil {
-		return 0, err6
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n6
-	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetDefiWithdrawAddress) Marshal() (dAtA []byte, err error) {
+func (m *MsgDefiUndelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1430,7 +1850,85 @@ func (m *MsgSetDefiWithdrawAddress) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetDefiWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDefiUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDefiUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DefiAddress) > 0 {
+		i -= len(m.DefiAddress)
+		copy(dAtA[i:], m.DefiAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DefiAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDefiUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDefiUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDefiUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDefiWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDefiWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -1660,6 +2158,144 @@ func (m *MsgFundDefiCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSlashDefi) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashDefi) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashDefi) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Jail {
+		i--
+		if m.Jail {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.InfractionHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DefiAddress) > 0 {
+		i -= len(m.DefiAddress)
+		copy(dAtA[i:], m.DefiAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DefiAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSlashDefiResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashDefiResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashDefiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailDefi) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailDefi) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailDefi) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DefiAddress) > 0 {
+		i -= len(m.DefiAddress)
+		copy(dAtA[i:], m.DefiAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DefiAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailDefiResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailDefiResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailDefiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1691,6 +2327,8 @@ func (m *MsgCreateDefi) Size() (n int) {
 	}
 	l = m.Value.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1719,6 +2357,10 @@ func (m *MsgEditDefi) Size() (n int) {
 		l = m.MinSelfDelegation.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.CommissionRate != nil {
+		l = m.CommissionRate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1759,6 +2401,40 @@ func (m *MsgDefiDelegateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgDefiBeginRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DefiSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DefiDstAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDefiBeginRedelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func (m *MsgDefiUndelegate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1891,25 +2567,81 @@ func (m *MsgFundDefiCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgSlashDefi) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DefiAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.InfractionHeight != 0 {
+		n += 1 + sovTx(uint64(m.InfractionHeight))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Jail {
+		n += 2
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgSlashDefiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgCreateDefi) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
+
+func (m *MsgUnjailDefi) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DefiAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailDefiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateDefi) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
@@ -2090,6 +2822,39 @@ func (m *MsgCreateDefi) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2219,13 +2984,478 @@ func (m *MsgEditDefi) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefiAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinSelfDelegation = &v
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.CommissionRate = &v
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditDefiResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditDefiResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditDefiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDefiDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDefiDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDefiDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefiAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDefiDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDefiDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDefiDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDefiBeginRedelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDefiBeginRedelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDefiBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefiSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiDstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2253,13 +3483,13 @@ func (m *MsgEditDefi) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefiAddress = string(dAtA[iNdEx:postIndex])
+			m.DefiDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2269,25 +3499,22 @@ func (m *MsgEditDefi) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.MinSelfDelegation = &v
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2312,7 +3539,7 @@ func (m *MsgEditDefi) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditDefiResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDefiBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2335,12 +3562,45 @@ func (m *MsgEditDefiResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditDefiResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDefiBeginRedelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditDefiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDefiBeginRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2362,7 +3622,7 @@ func (m *MsgEditDefiResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDefiDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgDefiUndelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2385,10 +3645,10 @@ func (m *MsgDefiDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDefiDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDefiUndelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDefiDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDefiUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2509,7 +3769,7 @@ func (m *MsgDefiDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDefiDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDefiUndelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2532,12 +3792,45 @@ func (m *MsgDefiDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDefiDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDefiUndelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDefiDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDefiUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2559,7 +3852,7 @@ func (m *MsgDefiDelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDefiUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDefiWithdrawAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2582,10 +3875,10 @@ func (m *MsgDefiUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDefiUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDefiWithdrawAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDefiUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDefiWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2622,7 +3915,7 @@ func (m *MsgDefiUndelegate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2650,41 +3943,58 @@ func (m *MsgDefiUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefiAddress = string(dAtA[iNdEx:postIndex])
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDefiWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDefiWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDefiWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2706,7 +4016,7 @@ func (m *MsgDefiUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDefiUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawDefiDelegatorReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2729,17 +4039,17 @@ func (m *MsgDefiUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDefiUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawDefiDelegatorReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDefiUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawDefiDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2749,25 +4059,106 @@ func (m *MsgDefiUndelegateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefiAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawDefiDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDefiDelegatorRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDefiDelegatorRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2789,7 +4180,7 @@ func (m *MsgDefiUndelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDefiWithdrawAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawDefiCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2812,47 +4203,15 @@ func (m *MsgSetDefiWithdrawAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDefiWithdrawAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawDefiCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDefiWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawDefiCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2880,7 +4239,7 @@ func (m *MsgSetDefiWithdrawAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			m.DefiAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2903,7 +4262,7 @@ func (m *MsgSetDefiWithdrawAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDefiWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawDefiCommissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2926,10 +4285,10 @@ func (m *MsgSetDefiWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDefiWithdrawAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawDefiCommissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDefiWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawDefiCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2953,7 +4312,7 @@ func (m *MsgSetDefiWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawDefiDelegatorReward) Unmarshal(dAtA []byte) error {
+func (m *MsgFundDefiCommunityPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2976,17 +4335,17 @@ func (m *MsgWithdrawDefiDelegatorReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawDefiDelegatorReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundDefiCommunityPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawDefiDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundDefiCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2996,27 +4355,29 @@ func (m *MsgWithdrawDefiDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3044,7 +4405,7 @@ func (m *MsgWithdrawDefiDelegatorReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefiAddress = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3067,7 +4428,7 @@ func (m *MsgWithdrawDefiDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawDefiDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundDefiCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3090,10 +4451,10 @@ func (m *MsgWithdrawDefiDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawDefiDelegatorRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundDefiCommunityPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawDefiDelegatorRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundDefiCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3117,7 +4478,7 @@ func (m *MsgWithdrawDefiDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawDefiCommission) Unmarshal(dAtA []byte) error {
+func (m *MsgSlashDefi) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3140,13 +4501,45 @@ func (m *MsgWithdrawDefiCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawDefiCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSlashDefi: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawDefiCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSlashDefi: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
 			}
@@ -3178,6 +4571,79 @@ func (m *MsgWithdrawDefiCommission) Unmarshal(dAtA []byte) error {
 			}
 			m.DefiAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+			}
+			m.InfractionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jail", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jail = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3199,7 +4665,7 @@ func (m *MsgWithdrawDefiCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawDefiCommissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSlashDefiResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3222,10 +4688,10 @@ func (m *MsgWithdrawDefiCommissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawDefiCommissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSlashDefiResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawDefiCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSlashDefiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3249,7 +4715,7 @@ func (m *MsgWithdrawDefiCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundDefiCommunityPool) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailDefi) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3272,49 +4738,15 @@ func (m *MsgFundDefiCommunityPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundDefiCommunityPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailDefi: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundDefiCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailDefi: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3342,7 +4774,7 @@ func (m *MsgFundDefiCommunityPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.DefiAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3365,7 +4797,7 @@ func (m *MsgFundDefiCommunityPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundDefiCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailDefiResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3388,10 +4820,10 @@ func (m *MsgFundDefiCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundDefiCommunityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailDefiResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundDefiCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailDefiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
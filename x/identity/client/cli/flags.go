@@ -0,0 +1,22 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagVerificationMethod = "verification-method"
+	FlagService            = "service"
+	FlagExpirationDate     = "expiration-date"
+)
+
+var (
+	fsCreateDid  = flag.NewFlagSet("", flag.ContinueOnError)
+	fsCredential = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	fsCreateDid.StringArray(FlagVerificationMethod, nil, "verification method of the DID as [fragment]:[type]:[public-key-hex], repeatable")
+	fsCreateDid.StringArray(FlagService, nil, "service of the DID as [fragment]:[type]:[endpoint], repeatable")
+	fsCredential.String(FlagExpirationDate, "", "time (RFC3339) from which the credential is expired (default never)")
+}
@@ -0,0 +1,214 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/gauss/gauss/v4/x/identity/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	identityQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the identity module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	identityQueryCmd.AddCommand(
+		GetCmdQueryDidDocuments(),
+		GetCmdQueryCredential(),
+		GetCmdQuerySubjectCredentials(),
+		GetCmdQueryParams(),
+	)
+
+	return identityQueryCmd
+}
+
+// GetCmdQueryDidDocuments implements the DID documents query command.
+func GetCmdQueryDidDocuments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dids [did]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the DID documents",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the DID documents. optionally restrict to a single DID, or to the DID of an address
+
+Example:
+$ %s query %s dids
+$ %s query %s dids did:gauss:gauss1...
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 1 {
+				res, err := queryClient.DidDocument(context.Background(), &types.QueryDidDocumentRequest{Did: toDid(args[0])})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DidDocuments(context.Background(), &types.QueryDidDocumentsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "dids")
+
+	return cmd
+}
+
+// GetCmdQueryCredential implements the credential query command.
+func GetCmdQueryCredential() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "credential [hash]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a credential by its hash",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query an anchored credential by the hex of its sha256 hash, and whether it is
+neither revoked nor expired.
+
+Example:
+$ %s query %s credential 9f86d0...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Credential(context.Background(), &types.QueryCredentialRequest{Hash: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySubjectCredentials implements the subject credentials query command.
+func GetCmdQuerySubjectCredentials() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "credentials [subject-did]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the credentials issued to a subject",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the credentials issued to the DID of a subject, or to the DID of an address.
+
+Example:
+$ %s query %s credentials did:gauss:gauss1...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SubjectCredentials(context.Background(), &types.QuerySubjectCredentialsRequest{
+				Subject:    toDid(args[0]),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "credentials")
+
+	return cmd
+}
+
+// GetCmdQueryParams implements the params query command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current identity parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current identity parameters.
+
+Example:
+$ %s query %s params
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// toDid returns the DID of an address, unless it is already a DID
+func toDid(arg string) string {
+	if addr, err := sdk.AccAddressFromBech32(arg); err == nil {
+		return types.NewDid(addr)
+	}
+	return arg
+}
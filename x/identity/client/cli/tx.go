@@ -0,0 +1,380 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/gauss/gauss/v4/x/identity/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/identity transaction commands.
+func NewTxCmd() *cobra.Command {
+	identityTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Identity transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	identityTxCmd.AddCommand(
+		NewCreateDidCmd(),
+		NewAddVerificationMethodCmd(),
+		NewRotateVerificationMethodCmd(),
+		NewRevokeVerificationMethodCmd(),
+		NewAddServiceCmd(),
+		NewRemoveServiceCmd(),
+		NewAnchorCredentialCmd(),
+		NewRevokeCredentialCmd(),
+	)
+
+	return identityTxCmd
+}
+
+func NewCreateDidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-did",
+		Args:  cobra.NoArgs,
+		Short: "create the DID document of the account.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`create the DID document did:gauss:<address> of the --from account, optionally with
+its verification methods and services.
+
+Example:
+$ %s tx %s create-did --from mykey
+$ %s tx %s create-did --verification-method key-1:Ed25519VerificationKey2018:<hex> --service kyc:KYCService:https://kyc.example.com --from mykey
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			did := types.NewDid(clientCtx.GetFromAddress())
+
+			methodStrs, err := cmd.Flags().GetStringArray(FlagVerificationMethod)
+			if err != nil {
+				return err
+			}
+			methods := make([]types.VerificationMethod, 0, len(methodStrs))
+			for _, methodStr := range methodStrs {
+				parts := strings.SplitN(methodStr, ":", 3)
+				if len(parts) != 3 {
+					return fmt.Errorf("verification method %s is not [fragment]:[type]:[public-key-hex]", methodStr)
+				}
+				methods = append(methods, types.VerificationMethod{
+					Id:           didURL(did, parts[0]),
+					Type:         parts[1],
+					PublicKeyHex: parts[2],
+				})
+			}
+
+			serviceStrs, err := cmd.Flags().GetStringArray(FlagService)
+			if err != nil {
+				return err
+			}
+			services := make([]types.Service, 0, len(serviceStrs))
+			for _, serviceStr := range serviceStrs {
+				parts := strings.SplitN(serviceStr, ":", 3)
+				if len(parts) != 3 {
+					return fmt.Errorf("service %s is not [fragment]:[type]:[endpoint]", serviceStr)
+				}
+				services = append(services, types.NewService(didURL(did, parts[0]), parts[1], parts[2]))
+			}
+
+			msg := types.NewMsgCreateDid(clientCtx.GetFromAddress(), methods, services)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsCreateDid)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewAddVerificationMethodCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-verification-method [fragment] [type] [public-key-hex]",
+		Args:  cobra.ExactArgs(3),
+		Short: "add a public key to the DID document of the account.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`add a secp256k1 (Secp256k1VerificationKey2018, compressed) or an ed25519
+(Ed25519VerificationKey2018) public key to the DID document of the --from account.
+
+Example:
+$ %s tx %s add-verification-method key-2 Secp256k1VerificationKey2018 02a1... --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			did := types.NewDid(clientCtx.GetFromAddress())
+			method := types.VerificationMethod{
+				Id:           didURL(did, args[0]),
+				Type:         args[1],
+				PublicKeyHex: args[2],
+			}
+
+			msg := types.NewMsgAddVerificationMethod(clientCtx.GetFromAddress(), method)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRotateVerificationMethodCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-verification-method [fragment] [public-key-hex]",
+		Args:  cobra.ExactArgs(2),
+		Short: "replace the public key of a verification method of the account.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`replace the public key of a verification method of the DID document of the
+--from account with a new key of the same type.
+
+Example:
+$ %s tx %s rotate-verification-method key-1 03b2... --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			did := types.NewDid(clientCtx.GetFromAddress())
+			msg := types.NewMsgRotateVerificationMethod(clientCtx.GetFromAddress(), didURL(did, args[0]), args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRevokeVerificationMethodCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-verification-method [fragment]",
+		Args:  cobra.ExactArgs(1),
+		Short: "remove a verification method from the DID document of the account.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`remove a verification method from the DID document of the --from account.
+
+Example:
+$ %s tx %s revoke-verification-method key-1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			did := types.NewDid(clientCtx.GetFromAddress())
+			msg := types.NewMsgRevokeVerificationMethod(clientCtx.GetFromAddress(), didURL(did, args[0]))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewAddServiceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-service [fragment] [type] [endpoint]",
+		Args:  cobra.ExactArgs(3),
+		Short: "add a service endpoint to the DID document of the account.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`add a service endpoint to the DID document of the --from account.
+
+Example:
+$ %s tx %s add-service kyc KYCService https://kyc.example.com --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			did := types.NewDid(clientCtx.GetFromAddress())
+			service := types.NewService(didURL(did, args[0]), args[1], args[2])
+
+			msg := types.NewMsgAddService(clientCtx.GetFromAddress(), service)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRemoveServiceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-service [fragment]",
+		Args:  cobra.ExactArgs(1),
+		Short: "remove a service endpoint from the DID document of the account.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`remove a service endpoint from the DID document of the --from account.
+
+Example:
+$ %s tx %s remove-service kyc --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			did := types.NewDid(clientCtx.GetFromAddress())
+			msg := types.NewMsgRemoveService(clientCtx.GetFromAddress(), didURL(did, args[0]))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewAnchorCredentialCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "anchor-credential [subject-did] [hash]",
+		Args:  cobra.ExactArgs(2),
+		Short: "anchor the hash of a credential issued to a subject.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`anchor the hex of the sha256 hash of a credential issued by the --from account,
+which must be a trusted issuer with a DID document, to the DID of a subject.
+
+Example:
+$ %s tx %s anchor-credential did:gauss:gauss1... 9f86d0... --expiration-date 2022-01-01T00:00:00Z --from issuerkey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var expirationDate time.Time
+			if dateStr, _ := cmd.Flags().GetString(FlagExpirationDate); dateStr != "" {
+				expirationDate, err = time.Parse(time.RFC3339, dateStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgAnchorCredential(clientCtx.GetFromAddress(), args[0], args[1], expirationDate)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsCredential)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRevokeCredentialCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-credential [hash]",
+		Args:  cobra.ExactArgs(1),
+		Short: "revoke a credential issued by the account.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke a credential issued by the --from account. The credential stays anchored.
+
+Example:
+$ %s tx %s revoke-credential 9f86d0... --from issuerkey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeCredential(clientCtx.GetFromAddress(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// didURL returns the DID URL of a fragment of a DID, unless it is already a DID URL
+func didURL(did, fragment string) string {
+	if strings.HasPrefix(fragment, types.DidPrefix) {
+		return fragment
+	}
+	return did + "#" + strings.TrimPrefix(fragment, "#")
+}
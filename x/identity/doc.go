@@ -1,6 +1,6 @@
 /*
-Package identity implements a gauss module, that provides an implementation
-of the identity algorithm.
+Package identity implements a gauss module, that provides an on-chain registry
+of DID documents and of the anchors of verifiable credentials issued to them.
 Please refer to the specification under /spec for further information.
 */
 package identity
@@ -0,0 +1,50 @@
+package identity
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/identity/keeper"
+	"github.com/gauss/gauss/v4/x/identity/types"
+)
+
+// InitGenesis sets the DID documents, the credentials and parameters for the provided keeper.
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) (res []abci.ValidatorUpdate) {
+	if err := ValidateGenesis(data); err != nil {
+		panic(err.Error())
+	}
+
+	keeper.SetParams(ctx, data.Params)
+
+	for _, document := range data.DidDocuments {
+		keeper.SetDidDocument(ctx, document)
+	}
+
+	for _, credential := range data.Credentials {
+		keeper.SetCredential(ctx, credential)
+	}
+
+	return res
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	documents := []types.DidDocument{}
+	keeper.IterateDidDocuments(ctx, func(_ int64, document types.DidDocument) bool {
+		documents = append(documents, document)
+		return false
+	})
+
+	credentials := []types.Credential{}
+	keeper.IterateCredentials(ctx, func(_ int64, credential types.Credential) bool {
+		credentials = append(credentials, credential)
+		return false
+	})
+
+	return types.NewGenesisState(keeper.GetParams(ctx), documents, credentials)
+}
+
+// ValidateGenesis validates the provided identity genesis state
+func ValidateGenesis(data *types.GenesisState) error {
+	return data.Validate()
+}
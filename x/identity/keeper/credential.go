@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gauss/gauss/v4/x/identity/types"
+)
+
+// GetCredential returns a credential by its hash
+func (k Keeper) GetCredential(ctx sdk.Context, hash []byte) (credential types.Credential, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetCredentialKey(hash))
+	if value == nil {
+		return credential, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &credential)
+	return credential, true
+}
+
+// SetCredential sets a credential and indexes it by its subject
+func (k Keeper) SetCredential(ctx sdk.Context, credential types.Credential) {
+	hash, err := types.ParseCredentialHash(credential.Hash)
+	if err != nil {
+		panic(err)
+	}
+	subject, err := types.ParseDid(credential.Subject)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&credential)
+	store.Set(types.GetCredentialKey(hash), bz)
+	store.Set(types.GetSubjectCredentialKey(subject, hash), []byte{})
+}
+
+// IterateCredentials iterates through all of the credentials
+func (k Keeper) IterateCredentials(ctx sdk.Context, fn func(index int64, credential types.Credential) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.CredentialKey)
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		var credential types.Credential
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &credential)
+
+		if stop := fn(i, credential); stop {
+			break
+		}
+		i++
+	}
+}
+
+// GetAllCredentials returns all of the credentials
+func (k Keeper) GetAllCredentials(ctx sdk.Context) (credentials []types.Credential) {
+	k.IterateCredentials(ctx, func(_ int64, credential types.Credential) bool {
+		credentials = append(credentials, credential)
+		return false
+	})
+
+	return credentials
+}
+
+// IterateSubjectCredentials iterates through the credentials issued to a subject
+func (k Keeper) IterateSubjectCredentials(
+	ctx sdk.Context, subject sdk.AccAddress, fn func(index int64, credential types.Credential) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetSubjectCredentialsKey(subject)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		credential, found := k.GetCredential(ctx, iterator.Key()[len(prefix):])
+		if !found {
+			panic("indexed credential not found")
+		}
+
+		if stop := fn(i, credential); stop {
+			break
+		}
+		i++
+	}
+}
+
+// AnchorCredential anchors the hash of a credential issued by a trusted issuer
+// to the DID of a subject
+func (k Keeper) AnchorCredential(
+	ctx sdk.Context, issuer sdk.AccAddress, subject, hash string, expirationDate time.Time,
+) (types.Credential, error) {
+	if !k.GetParams(ctx).IsTrustedIssuer(issuer) {
+		return types.Credential{}, sdkerrors.Wrap(types.ErrUntrustedIssuer, issuer.String())
+	}
+	issuerDocument, err := k.getDidDocument(ctx, issuer)
+	if err != nil {
+		return types.Credential{}, err
+	}
+	if _, found := k.GetDidDocumentByDid(ctx, subject); !found {
+		return types.Credential{}, sdkerrors.Wrap(types.ErrDidNotFound, subject)
+	}
+
+	hashBz, err := types.ParseCredentialHash(hash)
+	if err != nil {
+		return types.Credential{}, err
+	}
+	if _, found := k.GetCredential(ctx, hashBz); found {
+		return types.Credential{}, sdkerrors.Wrap(types.ErrCredentialExists, hash)
+	}
+
+	credential := types.NewCredential(hash, issuerDocument.Id, subject, ctx.BlockTime(), expirationDate)
+	if err := credential.Validate(); err != nil {
+		return types.Credential{}, err
+	}
+
+	k.SetCredential(ctx, credential)
+	return credential, nil
+}
+
+// RevokeCredential revokes a credential of an issuer, which stays anchored
+func (k Keeper) RevokeCredential(ctx sdk.Context, issuer sdk.AccAddress, hash string) (types.Credential, error) {
+	hashBz, err := types.ParseCredentialHash(hash)
+	if err != nil {
+		return types.Credential{}, err
+	}
+
+	credential, found := k.GetCredential(ctx, hashBz)
+	if !found {
+		return types.Credential{}, sdkerrors.Wrap(types.ErrCredentialNotFound, hash)
+	}
+	if credential.Issuer != types.NewDid(issuer) {
+		return types.Credential{}, sdkerrors.Wrapf(types.ErrNotIssuer, "%s is issued by %s", hash, credential.Issuer)
+	}
+	if credential.Revoked {
+		return types.Credential{}, sdkerrors.Wrap(types.ErrCredentialRevoked, hash)
+	}
+
+	credential.Revoked = true
+	k.SetCredential(ctx, credential)
+	return credential, nil
+}
+
+// HasValidCredential returns whether a subject holds a credential that is
+// neither revoked nor expired, issued by one of the issuers or by any trusted
+// issuer if none is given
+func (k Keeper) HasValidCredential(ctx sdk.Context, subject sdk.AccAddress, issuers ...sdk.AccAddress) bool {
+	params := k.GetParams(ctx)
+
+	var found bool
+	k.IterateSubjectCredentials(ctx, subject, func(_ int64, credential types.Credential) bool {
+		if !credential.IsValid(ctx.BlockTime()) {
+			return false
+		}
+
+		issuer, err := types.ParseDid(credential.Issuer)
+		if err != nil {
+			panic(err)
+		}
+
+		if len(issuers) == 0 {
+			found = params.IsTrustedIssuer(issuer)
+		}
+		for _, addr := range issuers {
+			if addr.Equals(issuer) {
+				found = true
+			}
+		}
+
+		return found
+	})
+
+	return found
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/gauss/gauss/v4/x/identity/keeper"
+	"github.com/gauss/gauss/v4/x/identity/types"
+)
+
+func TestAnchorCredential(t *testing.T) {
+	app, ctx, addrs := setupIdentityTest(t, 3)
+	msgServer := keeper.NewMsgServerImpl(app.IdentityKeeper)
+
+	issuer, subject, untrusted := addrs[0], addrs[1], addrs[2]
+	for _, addr := range addrs {
+		_, err := app.IdentityKeeper.CreateDid(ctx, addr, nil, nil)
+		require.NoError(t, err)
+	}
+	params := types.DefaultParams()
+	params.TrustedIssuers = []string{issuer.String()}
+	app.IdentityKeeper.SetParams(ctx, params)
+
+	anchor := func(issuer sdk.AccAddress, subject, hash string, expirationDate time.Time) error {
+		_, err := msgServer.AnchorCredential(sdk.WrapSDKContext(ctx),
+			types.NewMsgAnchorCredential(issuer, subject, hash, expirationDate))
+		return err
+	}
+
+	hash1, hash2 := credentialHash("kyc-1"), credentialHash("kyc-2")
+	require.ErrorIs(t, anchor(untrusted, types.NewDid(subject), hash1, time.Time{}), types.ErrUntrustedIssuer)
+	require.ErrorIs(t, anchor(issuer, types.NewDid(sdk.AccAddress("nobody")), hash1, time.Time{}), types.ErrDidNotFound)
+	require.ErrorIs(t, anchor(issuer, types.NewDid(subject), hash1, ctx.BlockTime()), types.ErrInvalidCredential)
+	require.NoError(t, anchor(issuer, types.NewDid(subject), hash1, time.Time{}))
+	require.ErrorIs(t, anchor(issuer, types.NewDid(untrusted), hash1, time.Time{}), types.ErrCredentialExists)
+	require.NoError(t, anchor(issuer, types.NewDid(subject), hash2, ctx.BlockTime().Add(time.Hour)))
+
+	require.True(t, app.IdentityKeeper.HasValidCredential(ctx, subject))
+	require.True(t, app.IdentityKeeper.HasValidCredential(ctx, subject, issuer))
+	require.False(t, app.IdentityKeeper.HasValidCredential(ctx, subject, untrusted))
+	require.False(t, app.IdentityKeeper.HasValidCredential(ctx, untrusted))
+
+	querier := keeper.Querier{Keeper: app.IdentityKeeper}
+	res, err := querier.SubjectCredentials(sdk.WrapSDKContext(ctx), &types.QuerySubjectCredentialsRequest{
+		Subject:    types.NewDid(subject),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Credentials, 1)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+
+	// only the issuer revokes a credential, which stays anchored
+	revoke := func(issuer sdk.AccAddress, hash string) error {
+		_, err := msgServer.RevokeCredential(sdk.WrapSDKContext(ctx), types.NewMsgRevokeCredential(issuer, hash))
+		return err
+	}
+	require.ErrorIs(t, revoke(untrusted, hash1), types.ErrNotIssuer)
+	require.NoError(t, revoke(issuer, hash1))
+	require.ErrorIs(t, revoke(issuer, hash1), types.ErrCredentialRevoked)
+
+	credentialRes, err := querier.Credential(sdk.WrapSDKContext(ctx), &types.QueryCredentialRequest{Hash: hash1})
+	require.NoError(t, err)
+	require.True(t, credentialRes.Credential.Revoked)
+	require.False(t, credentialRes.Valid)
+	require.True(t, app.IdentityKeeper.HasValidCredential(ctx, subject))
+
+	// the other credential expires
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.False(t, app.IdentityKeeper.HasValidCredential(ctx, subject))
+
+	// an issuer no longer trusted keeps its credentials but they no longer
+	// satisfy the trusted issuers
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(-time.Minute))
+	app.IdentityKeeper.SetParams(ctx, types.DefaultParams())
+	require.False(t, app.IdentityKeeper.HasValidCredential(ctx, subject))
+	require.True(t, app.IdentityKeeper.HasValidCredential(ctx, subject, issuer))
+}
+
+func credentialHash(credential string) string {
+	hash := sha256.Sum256([]byte(credential))
+	return hex.EncodeToString(hash[:])
+}
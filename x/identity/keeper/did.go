@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gauss/gauss/v4/x/identity/types"
+)
+
+// GetDidDocument returns the DID document of an account
+func (k Keeper) GetDidDocument(ctx sdk.Context, controller sdk.AccAddress) (document types.DidDocument, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetDidDocumentKey(controller))
+	if value == nil {
+		return document, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &document)
+	return document, true
+}
+
+// GetDidDocumentByDid returns the DID document of a DID
+func (k Keeper) GetDidDocumentByDid(ctx sdk.Context, did string) (document types.DidDocument, found bool) {
+	controller, err := types.ParseDid(did)
+	if err != nil {
+		return document, false
+	}
+
+	return k.GetDidDocument(ctx, controller)
+}
+
+// SetDidDocument sets the DID document of its controller
+func (k Keeper) SetDidDocument(ctx sdk.Context, document types.DidDocument) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&document)
+	store.Set(types.GetDidDocumentKey(document.GetControllerAddr()), bz)
+}
+
+// IterateDidDocuments iterates through all of the DID documents
+func (k Keeper) IterateDidDocuments(ctx sdk.Context, fn func(index int64, document types.DidDocument) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.DidDocumentKey)
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		var document types.DidDocument
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &document)
+
+		if stop := fn(i, document); stop {
+			break
+		}
+		i++
+	}
+}
+
+// GetAllDidDocuments returns all of the DID documents
+func (k Keeper) GetAllDidDocuments(ctx sdk.Context) (documents []types.DidDocument) {
+	k.IterateDidDocuments(ctx, func(_ int64, document types.DidDocument) bool {
+		documents = append(documents, document)
+		return false
+	})
+
+	return documents
+}
+
+// CreateDid creates the DID document of an account with its initial
+// verification methods and services
+func (k Keeper) CreateDid(
+	ctx sdk.Context, controller sdk.AccAddress, methods []types.VerificationMethod, services []types.Service,
+) (types.DidDocument, error) {
+	if _, found := k.GetDidDocument(ctx, controller); found {
+		return types.DidDocument{}, sdkerrors.Wrap(types.ErrDidExists, types.NewDid(controller))
+	}
+
+	document := types.NewDidDocument(controller, methods, services, ctx.BlockTime())
+	if err := document.Validate(); err != nil {
+		return types.DidDocument{}, err
+	}
+	if err := k.validateDocumentSize(ctx, document); err != nil {
+		return types.DidDocument{}, err
+	}
+
+	k.SetDidDocument(ctx, document)
+	return document, nil
+}
+
+// AddVerificationMethod adds a public key to the DID document of an account
+func (k Keeper) AddVerificationMethod(ctx sdk.Context, controller sdk.AccAddress, method types.VerificationMethod) error {
+	document, err := k.getDidDocument(ctx, controller)
+	if err != nil {
+		return err
+	}
+
+	if err := method.Validate(document.Id); err != nil {
+		return err
+	}
+	if document.FindVerificationMethod(method.Id) >= 0 || document.FindService(method.Id) >= 0 {
+		return sdkerrors.Wrap(types.ErrVerificationMethodExists, method.Id)
+	}
+
+	document.VerificationMethods = append(document.VerificationMethods, method)
+	if err := k.validateDocumentSize(ctx, document); err != nil {
+		return err
+	}
+
+	k.updateDidDocument(ctx, document)
+	return nil
+}
+
+// RotateVerificationMethod replaces the public key of a verification method
+// with a new key of the same type
+func (k Keeper) RotateVerificationMethod(
+	ctx sdk.Context, controller sdk.AccAddress, methodID, publicKeyHex string,
+) error {
+	document, err := k.getDidDocument(ctx, controller)
+	if err != nil {
+		return err
+	}
+
+	i := document.FindVerificationMethod(methodID)
+	if i < 0 {
+		return sdkerrors.Wrap(types.ErrVerificationMethodNotFound, methodID)
+	}
+
+	method := document.VerificationMethods[i]
+	if err := types.ValidatePublicKey(method.Type, publicKeyHex); err != nil {
+		return err
+	}
+	if method.PublicKeyHex == publicKeyHex {
+		return sdkerrors.Wrap(types.ErrInvalidVerificationMethod, "public key is not rotated")
+	}
+
+	document.VerificationMethods[i].PublicKeyHex = publicKeyHex
+	k.updateDidDocument(ctx, document)
+	return nil
+}
+
+// RevokeVerificationMethod removes a verification method from the DID document
+// of an account
+func (k Keeper) RevokeVerificationMethod(ctx sdk.Context, controller sdk.AccAddress, methodID string) error {
+	document, err := k.getDidDocument(ctx, controller)
+	if err != nil {
+		return err
+	}
+
+	i := document.FindVerificationMethod(methodID)
+	if i < 0 {
+		return sdkerrors.Wrap(types.ErrVerificationMethodNotFound, methodID)
+	}
+
+	document.VerificationMethods = append(document.VerificationMethods[:i], document.VerificationMethods[i+1:]...)
+	k.updateDidDocument(ctx, document)
+	return nil
+}
+
+// AddService adds a service endpoint to the DID document of an account
+func (k Keeper) AddService(ctx sdk.Context, controller sdk.AccAddress, service types.Service) error {
+	document, err := k.getDidDocument(ctx, controller)
+	if err != nil {
+		return err
+	}
+
+	if err := service.Validate(document.Id); err != nil {
+		return err
+	}
+	if document.FindService(service.Id) >= 0 || document.FindVerificationMethod(service.Id) >= 0 {
+		return sdkerrors.Wrap(types.ErrServiceExists, service.Id)
+	}
+
+	document.Services = append(document.Services, service)
+	if err := k.validateDocumentSize(ctx, document); err != nil {
+		return err
+	}
+
+	k.updateDidDocument(ctx, document)
+	return nil
+}
+
+// RemoveService removes a service endpoint from the DID document of an account
+func (k Keeper) RemoveService(ctx sdk.Context, controller sdk.AccAddress, serviceID string) error {
+	document, err := k.getDidDocument(ctx, controller)
+	if err != nil {
+		return err
+	}
+
+	i := document.FindService(serviceID)
+	if i < 0 {
+		return sdkerrors.Wrap(types.ErrServiceNotFound, serviceID)
+	}
+
+	document.Services = append(document.Services[:i], document.Services[i+1:]...)
+	k.updateDidDocument(ctx, document)
+	return nil
+}
+
+func (k Keeper) getDidDocument(ctx sdk.Context, controller sdk.AccAddress) (types.DidDocument, error) {
+	document, found := k.GetDidDocument(ctx, controller)
+	if !found {
+		return document, sdkerrors.Wrap(types.ErrDidNotFound, types.NewDid(controller))
+	}
+
+	return document, nil
+}
+
+func (k Keeper) updateDidDocument(ctx sdk.Context, document types.DidDocument) {
+	document.Updated = ctx.BlockTime()
+	k.SetDidDocument(ctx, document)
+}
+
+func (k Keeper) validateDocumentSize(ctx sdk.Context, document types.DidDocument) error {
+	if max := k.MaxVerificationMethods(ctx); uint32(len(document.VerificationMethods)) > max {
+		return sdkerrors.Wrapf(types.ErrTooManyEntries, "more than %d verification methods", max)
+	}
+	if max := k.MaxServices(ctx); uint32(len(document.Services)) > max {
+		return sdkerrors.Wrapf(types.ErrTooManyEntries, "more than %d services", max)
+	}
+
+	return nil
+}
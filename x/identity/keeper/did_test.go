@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/identity/keeper"
+	"github.com/gauss/gauss/v4/x/identity/types"
+)
+
+func TestCreateDid(t *testing.T) {
+	app, ctx, addrs := setupIdentityTest(t, 1)
+	msgServer := keeper.NewMsgServerImpl(app.IdentityKeeper)
+
+	did := types.NewDid(addrs[0])
+	method := ed25519Method(did + "#key-1")
+	service := types.NewService(did+"#kyc", "KYCService", "https://kyc.example.com")
+
+	res, err := msgServer.CreateDid(sdk.WrapSDKContext(ctx),
+		types.NewMsgCreateDid(addrs[0], []types.VerificationMethod{method}, []types.Service{service}))
+	require.NoError(t, err)
+	require.Equal(t, did, res.Did)
+
+	document, found := app.IdentityKeeper.GetDidDocumentByDid(ctx, did)
+	require.True(t, found)
+	require.Equal(t, addrs[0].String(), document.Controller)
+	require.Equal(t, []types.VerificationMethod{method}, document.VerificationMethods)
+	require.Equal(t, []types.Service{service}, document.Services)
+
+	// a DID document is created once
+	_, err = msgServer.CreateDid(sdk.WrapSDKContext(ctx), types.NewMsgCreateDid(addrs[0], nil, nil))
+	require.ErrorIs(t, err, types.ErrDidExists)
+}
+
+func TestVerificationMethods(t *testing.T) {
+	app, ctx, addrs := setupIdentityTest(t, 1)
+	msgServer := keeper.NewMsgServerImpl(app.IdentityKeeper)
+
+	controller := addrs[0]
+	did := types.NewDid(controller)
+
+	add := func(method types.VerificationMethod) error {
+		_, err := msgServer.AddVerificationMethod(sdk.WrapSDKContext(ctx), types.NewMsgAddVerificationMethod(controller, method))
+		return err
+	}
+	rotate := func(id, publicKeyHex string) error {
+		_, err := msgServer.RotateVerificationMethod(sdk.WrapSDKContext(ctx),
+			types.NewMsgRotateVerificationMethod(controller, id, publicKeyHex))
+		return err
+	}
+
+	// the methods are added to an existing document
+	require.ErrorIs(t, add(ed25519Method(did+"#key-1")), types.ErrDidNotFound)
+	_, err := app.IdentityKeeper.CreateDid(ctx, controller, nil, nil)
+	require.NoError(t, err)
+
+	secpKey := secp256k1.GenPrivKey().PubKey().Bytes()
+	require.NoError(t, add(ed25519Method(did+"#key-1")))
+	require.NoError(t, add(types.NewVerificationMethod(did+"#key-2", types.Secp256k1VerificationKey2018, secpKey)))
+	require.ErrorIs(t, add(ed25519Method(did+"#key-1")), types.ErrVerificationMethodExists)
+	require.ErrorIs(t, add(ed25519Method(types.NewDid(addrs[0])+"x#key-3")), types.ErrInvalidVerificationMethod)
+
+	// the number of methods is bounded
+	params := types.DefaultParams()
+	params.MaxVerificationMethods = 2
+	app.IdentityKeeper.SetParams(ctx, params)
+	require.ErrorIs(t, add(ed25519Method(did+"#key-3")), types.ErrTooManyEntries)
+
+	// a key is rotated to a new key of the same type
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	newKey := hex.EncodeToString(secp256k1.GenPrivKey().PubKey().Bytes())
+	require.ErrorIs(t, rotate(did+"#key-2", hex.EncodeToString(secpKey)), types.ErrInvalidVerificationMethod)
+	require.ErrorIs(t, rotate(did+"#key-2", hex.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes())),
+		types.ErrInvalidVerificationMethod)
+	require.ErrorIs(t, rotate(did+"#key-3", newKey), types.ErrVerificationMethodNotFound)
+	require.NoError(t, rotate(did+"#key-2", newKey))
+
+	document, _ := app.IdentityKeeper.GetDidDocument(ctx, controller)
+	require.Equal(t, newKey, document.VerificationMethods[1].PublicKeyHex)
+	require.Equal(t, ctx.BlockTime(), document.Updated)
+
+	// a revoked method is removed from the document
+	_, err = msgServer.RevokeVerificationMethod(sdk.WrapSDKContext(ctx),
+		types.NewMsgRevokeVerificationMethod(controller, did+"#key-1"))
+	require.NoError(t, err)
+	document, _ = app.IdentityKeeper.GetDidDocument(ctx, controller)
+	require.Len(t, document.VerificationMethods, 1)
+	require.Equal(t, did+"#key-2", document.VerificationMethods[0].Id)
+
+	_, err = msgServer.RevokeVerificationMethod(sdk.WrapSDKContext(ctx),
+		types.NewMsgRevokeVerificationMethod(controller, did+"#key-1"))
+	require.ErrorIs(t, err, types.ErrVerificationMethodNotFound)
+}
+
+func TestServices(t *testing.T) {
+	app, ctx, addrs := setupIdentityTest(t, 1)
+	msgServer := keeper.NewMsgServerImpl(app.IdentityKeeper)
+
+	controller := addrs[0]
+	did := types.NewDid(controller)
+	_, err := app.IdentityKeeper.CreateDid(ctx, controller, []types.VerificationMethod{ed25519Method(did + "#key-1")}, nil)
+	require.NoError(t, err)
+
+	add := func(service types.Service) error {
+		_, err := msgServer.AddService(sdk.WrapSDKContext(ctx), types.NewMsgAddService(controller, service))
+		return err
+	}
+
+	require.NoError(t, add(types.NewService(did+"#kyc", "KYCService", "https://kyc.example.com")))
+	require.ErrorIs(t, add(types.NewService(did+"#kyc", "KYCService", "https://kyc.example.org")), types.ErrServiceExists)
+	require.ErrorIs(t, add(types.NewService(did+"#key-1", "KYCService", "https://kyc.example.org")), types.ErrServiceExists)
+	require.ErrorIs(t, add(types.NewService(did+"#hub", "", "https://hub.example.com")), types.ErrInvalidService)
+
+	_, err = msgServer.RemoveService(sdk.WrapSDKContext(ctx), types.NewMsgRemoveService(controller, did+"#kyc"))
+	require.NoError(t, err)
+	_, err = msgServer.RemoveService(sdk.WrapSDKContext(ctx), types.NewMsgRemoveService(controller, did+"#kyc"))
+	require.ErrorIs(t, err, types.ErrServiceNotFound)
+
+	document, _ := app.IdentityKeeper.GetDidDocument(ctx, controller)
+	require.Empty(t, document.Services)
+}
+
+func setupIdentityTest(t *testing.T, n int) (*simapp.SimApp, sdk.Context, []sdk.AccAddress) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)})
+	app.IdentityKeeper.SetParams(ctx, types.DefaultParams())
+
+	return app, ctx, simapp.AddTestAddrs(app, ctx, n, sdk.ZeroInt())
+}
+
+func ed25519Method(id string) types.VerificationMethod {
+	return types.NewVerificationMethod(id, types.Ed25519VerificationKey2018, ed25519.GenPrivKey().PubKey().Bytes())
+}
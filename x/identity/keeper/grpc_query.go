@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gauss/gauss/v4/x/identity/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+// Params queries the identity parameters
+func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// DidDocuments queries all the DID documents
+func (k Querier) DidDocuments(
+	c context.Context, req *types.QueryDidDocumentsRequest,
+) (*types.QueryDidDocumentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var documents []types.DidDocument
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	documentStore := prefix.NewStore(store, types.DidDocumentKey)
+
+	pageRes, err := query.Paginate(documentStore, req.Pagination, func(key []byte, value []byte) error {
+		var document types.DidDocument
+		if err := k.cdc.UnmarshalBinaryBare(value, &document); err != nil {
+			return err
+		}
+
+		documents = append(documents, document)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDidDocumentsResponse{DidDocuments: documents, Pagination: pageRes}, nil
+}
+
+// DidDocument queries a DID document by its DID
+func (k Querier) DidDocument(c context.Context, req *types.QueryDidDocumentRequest) (*types.QueryDidDocumentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	controller, err := types.ParseDid(req.Did)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	document, found := k.GetDidDocument(sdk.UnwrapSDKContext(c), controller)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "DID document %s not found", req.Did)
+	}
+
+	return &types.QueryDidDocumentResponse{DidDocument: document}, nil
+}
+
+// Credential queries a credential by its hash
+func (k Querier) Credential(c context.Context, req *types.QueryCredentialRequest) (*types.QueryCredentialResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := types.ParseCredentialHash(req.Hash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	credential, found := k.GetCredential(ctx, hash)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "credential %s not found", req.Hash)
+	}
+
+	return &types.QueryCredentialResponse{Credential: credential, Valid: credential.IsValid(ctx.BlockTime())}, nil
+}
+
+// SubjectCredentials queries the credentials issued to a subject
+func (k Querier) SubjectCredentials(
+	c context.Context, req *types.QuerySubjectCredentialsRequest,
+) (*types.QuerySubjectCredentialsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	subject, err := types.ParseDid(req.Subject)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var credentials []types.Credential
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	subjectStore := prefix.NewStore(store, types.GetSubjectCredentialsKey(subject))
+
+	pageRes, err := query.Paginate(subjectStore, req.Pagination, func(key []byte, _ []byte) error {
+		credential, found := k.GetCredential(ctx, key)
+		if !found {
+			return status.Errorf(codes.Internal, "credential %X not found", key)
+		}
+
+		credentials = append(credentials, credential)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySubjectCredentialsResponse{Credentials: credentials, Pagination: pageRes}, nil
+}
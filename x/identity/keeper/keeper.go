@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/gauss/gauss/v4/x/identity/types"
+)
+
+// keeper of the identity store
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryMarshaler
+	paramstore paramtypes.Subspace
+}
+
+// NewKeeper creates a new identity Keeper instance
+func NewKeeper(cdc codec.BinaryMarshaler, key sdk.StoreKey, ps paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:   key,
+		cdc:        cdc,
+		paramstore: ps,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
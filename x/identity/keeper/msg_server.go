@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/identity/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the identity MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) CreateDid(goCtx context.Context, msg *types.MsgCreateDid) (*types.MsgCreateDidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	controller, err := sdk.AccAddressFromBech32(msg.Controller)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := k.Keeper.CreateDid(ctx, controller, msg.VerificationMethods, msg.Services)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateDid,
+			sdk.NewAttribute(types.AttributeKeyDid, document.Id),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Controller),
+		),
+	})
+
+	return &types.MsgCreateDidResponse{Did: document.Id}, nil
+}
+
+func (k msgServer) AddVerificationMethod(
+	goCtx context.Context, msg *types.MsgAddVerificationMethod,
+) (*types.MsgAddVerificationMethodResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	controller, err := sdk.AccAddressFromBech32(msg.Controller)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.AddVerificationMethod(ctx, controller, msg.VerificationMethod); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddVerificationMethod,
+			sdk.NewAttribute(types.AttributeKeyDid, types.NewDid(controller)),
+			sdk.NewAttribute(types.AttributeKeyMethodID, msg.VerificationMethod.Id),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Controller),
+		),
+	})
+
+	return &types.MsgAddVerificationMethodResponse{}, nil
+}
+
+func (k msgServer) RotateVerificationMethod(
+	goCtx context.Context, msg *types.MsgRotateVerificationMethod,
+) (*types.MsgRotateVerificationMethodResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	controller, err := sdk.AccAddressFromBech32(msg.Controller)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RotateVerificationMethod(ctx, controller, msg.MethodId, msg.PublicKeyHex); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRotateVerificationMethod,
+			sdk.NewAttribute(types.AttributeKeyDid, types.NewDid(controller)),
+			sdk.NewAttribute(types.AttributeKeyMethodID, msg.MethodId),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Controller),
+		),
+	})
+
+	return &types.MsgRotateVerificationMethodResponse{}, nil
+}
+
+func (k msgServer) RevokeVerificationMethod(
+	goCtx context.Context, msg *types.MsgRevokeVerificationMethod,
+) (*types.MsgRevokeVerificationMethodResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	controller, err := sdk.AccAddressFromBech32(msg.Controller)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RevokeVerificationMethod(ctx, controller, msg.MethodId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeVerificationMethod,
+			sdk.NewAttribute(types.AttributeKeyDid, types.NewDid(controller)),
+			sdk.NewAttribute(types.AttributeKeyMethodID, msg.MethodId),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Controller),
+		),
+	})
+
+	return &types.MsgRevokeVerificationMethodResponse{}, nil
+}
+
+func (k msgServer) AddService(goCtx context.Context, msg *types.MsgAddService) (*types.MsgAddServiceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	controller, err := sdk.AccAddressFromBech32(msg.Controller)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.AddService(ctx, controller, msg.Service); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddService,
+			sdk.NewAttribute(types.AttributeKeyDid, types.NewDid(controller)),
+			sdk.NewAttribute(types.AttributeKeyServiceID, msg.Service.Id),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Controller),
+		),
+	})
+
+	return &types.MsgAddServiceResponse{}, nil
+}
+
+func (k msgServer) RemoveService(goCtx context.Context, msg *types.MsgRemoveService) (*types.MsgRemoveServiceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	controller, err := sdk.AccAddressFromBech32(msg.Controller)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RemoveService(ctx, controller, msg.ServiceId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveService,
+			sdk.NewAttribute(types.AttributeKeyDid, types.NewDid(controller)),
+			sdk.NewAttribute(types.AttributeKeyServiceID, msg.ServiceId),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Controller),
+		),
+	})
+
+	return &types.MsgRemoveServiceResponse{}, nil
+}
+
+func (k msgServer) AnchorCredential(
+	goCtx context.Context, msg *types.MsgAnchorCredential,
+) (*types.MsgAnchorCredentialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := k.Keeper.AnchorCredential(ctx, issuer, msg.Subject, msg.Hash, msg.ExpirationDate)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAnchorCredential,
+			sdk.NewAttribute(types.AttributeKeyHash, credential.Hash),
+			sdk.NewAttribute(types.AttributeKeyIssuer, credential.Issuer),
+			sdk.NewAttribute(types.AttributeKeySubject, credential.Subject),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Issuer),
+		),
+	})
+
+	return &types.MsgAnchorCredentialResponse{}, nil
+}
+
+func (k msgServer) RevokeCredential(
+	goCtx context.Context, msg *types.MsgRevokeCredential,
+) (*types.MsgRevokeCredentialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := k.Keeper.RevokeCredential(ctx, issuer, msg.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeCredential,
+			sdk.NewAttribute(types.AttributeKeyHash, credential.Hash),
+			sdk.NewAttribute(types.AttributeKeyIssuer, credential.Issuer),
+			sdk.NewAttribute(types.AttributeKeySubject, credential.Subject),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Issuer),
+		),
+	})
+
+	return &types.MsgRevokeCredentialResponse{}, nil
+}
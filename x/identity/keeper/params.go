@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/identity/types"
+)
+
+// TrustedIssuers - Addresses of the accounts trusted to anchor credentials
+func (k Keeper) TrustedIssuers(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyTrustedIssuers, &res)
+	return
+}
+
+// MaxVerificationMethods - Maximum number of verification methods of a DID document
+func (k Keeper) MaxVerificationMethods(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyMaxVerificationMethods, &res)
+	return
+}
+
+// MaxServices - Maximum number of services of a DID document
+func (k Keeper) MaxServices(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyMaxServices, &res)
+	return
+}
+
+// Get all parameteras as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
+}
+
+// set the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,37 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/gauss/gauss/v4/x/identity/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding identity type.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.DidDocumentKey):
+			var documentA, documentB types.DidDocument
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &documentA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &documentB)
+
+			return fmt.Sprintf("%v\n%v", documentA, documentB)
+		case bytes.Equal(kvA.Key[:1], types.CredentialKey):
+			var credentialA, credentialB types.Credential
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &credentialA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &credentialB)
+
+			return fmt.Sprintf("%v\n%v", credentialA, credentialB)
+		case bytes.Equal(kvA.Key[:1], types.SubjectCredentialKey):
+			return fmt.Sprintf("%X\n%X", kvA.Key[1:], kvB.Key[1:])
+		default:
+			panic(fmt.Sprintf("invalid identity key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package simulation_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/identity/simulation"
+	"github.com/gauss/gauss/v4/x/identity/types"
+)
+
+var (
+	pk1   = ed25519.GenPrivKey().PubKey()
+	addr1 = sdk.AccAddress(pk1.Address())
+	addr2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc, _ := simapp.MakeCodecs()
+	dec := simulation.NewDecodeStore(cdc)
+
+	now := time.Now().UTC()
+	did := types.NewDid(addr1)
+	method := types.NewVerificationMethod(did+"#key-1", types.Ed25519VerificationKey2018, pk1.Bytes())
+	document := types.NewDidDocument(addr1, []types.VerificationMethod{method}, []types.Service{}, now)
+
+	hash := sha256.Sum256([]byte("credential"))
+	credential := types.NewCredential(hex.EncodeToString(hash[:]), did, types.NewDid(addr2), now, time.Time{})
+	subjectKey := types.GetSubjectCredentialKey(addr2, hash[:])
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetDidDocumentKey(addr1), Value: cdc.MustMarshalBinaryBare(&document)},
+			{Key: types.GetCredentialKey(hash[:]), Value: cdc.MustMarshalBinaryBare(&credential)},
+			{Key: subjectKey, Value: []byte{}},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"didDocument", fmt.Sprintf("%v\n%v", document, document)},
+		{"credential", fmt.Sprintf("%v\n%v", credential, credential)},
+		{"subjectCredential", fmt.Sprintf("%X\n%X", subjectKey[1:], subjectKey[1:])},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/gauss/gauss/v4/x/identity/types"
+)
+
+// Simulation parameter constants
+const (
+	trustedIssuers         = "trusted_issuers"
+	maxVerificationMethods = "max_verification_methods"
+	maxServices            = "max_services"
+)
+
+// GenTrustedIssuers randomized trustedIssuers, up to 5 of the simulated accounts
+func GenTrustedIssuers(r *rand.Rand, accs []simtypes.Account) []string {
+	n := r.Intn(6)
+	if n > len(accs) {
+		n = len(accs)
+	}
+
+	issuers := []string{}
+	for _, i := range r.Perm(len(accs))[:n] {
+		issuers = append(issuers, accs[i].Address.String())
+	}
+	return issuers
+}
+
+// GenMaxVerificationMethods randomized maxVerificationMethods
+func GenMaxVerificationMethods(r *rand.Rand) uint32 {
+	return uint32(1 + r.Intn(10))
+}
+
+// GenMaxServices randomized maxServices
+func GenMaxServices(r *rand.Rand) uint32 {
+	return uint32(r.Intn(11))
+}
+
+// RandomizedGenState generates a random GenesisState for identity
+func RandomizedGenState(simState *module.SimulationState) {
+	// params
+	var (
+		trustedIssuersL         []string
+		maxVerificationMethodsL uint32
+		maxServicesL            uint32
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, trustedIssuers, &trustedIssuersL, simState.Rand,
+		func(r *rand.Rand) { trustedIssuersL = GenTrustedIssuers(r, simState.Accounts) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxVerificationMethods, &maxVerificationMethodsL, simState.Rand,
+		func(r *rand.Rand) { maxVerificationMethodsL = GenMaxVerificationMethods(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxServices, &maxServicesL, simState.Rand,
+		func(r *rand.Rand) { maxServicesL = GenMaxServices(r) },
+	)
+
+	params := types.NewParams(trustedIssuersL, maxVerificationMethodsL, maxServicesL)
+
+	identityGenesis := types.NewGenesisState(params, []types.DidDocument{}, []types.Credential{})
+
+	bz, err := json.MarshalIndent(&identityGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated identity parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(identityGenesis)
+}
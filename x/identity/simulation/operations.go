@@ -0,0 +1,418 @@
+package simulation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	gaussimappparams "github.com/gauss/gauss/v4/simapp/params"
+	"github.com/gauss/gauss/v4/x/identity/keeper"
+	"github.com/gauss/gauss/v4/x/identity/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateDid                = "op_weight_msg_create_did"
+	OpWeightMsgAddVerificationMethod    = "op_weight_msg_add_verification_method"
+	OpWeightMsgRotateVerificationMethod = "op_weight_msg_rotate_verification_method"
+	OpWeightMsgRevokeVerificationMethod = "op_weight_msg_revoke_verification_method"
+	OpWeightMsgAddService               = "op_weight_msg_add_service"
+	OpWeightMsgRemoveService            = "op_weight_msg_remove_service"
+	OpWeightMsgAnchorCredential         = "op_weight_msg_anchor_credential"
+	OpWeightMsgRevokeCredential         = "op_weight_msg_revoke_credential"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler, ak types.AccountKeeper,
+	bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateDid                int
+		weightMsgAddVerificationMethod    int
+		weightMsgRotateVerificationMethod int
+		weightMsgRevokeVerificationMethod int
+		weightMsgAddService               int
+		weightMsgRemoveService            int
+		weightMsgAnchorCredential         int
+		weightMsgRevokeCredential         int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateDid, &weightMsgCreateDid, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateDid = gaussimappparams.DefaultWeightMsgCreateDid
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAddVerificationMethod, &weightMsgAddVerificationMethod, nil,
+		func(_ *rand.Rand) {
+			weightMsgAddVerificationMethod = gaussimappparams.DefaultWeightMsgAddVerificationMethod
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRotateVerificationMethod, &weightMsgRotateVerificationMethod, nil,
+		func(_ *rand.Rand) {
+			weightMsgRotateVerificationMethod = gaussimappparams.DefaultWeightMsgRotateVerificationMethod
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokeVerificationMethod, &weightMsgRevokeVerificationMethod, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeVerificationMethod = gaussimappparams.DefaultWeightMsgRevokeVerificationMethod
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAddService, &weightMsgAddService, nil,
+		func(_ *rand.Rand) {
+			weightMsgAddService = gaussimappparams.DefaultWeightMsgAddService
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRemoveService, &weightMsgRemoveService, nil,
+		func(_ *rand.Rand) {
+			weightMsgRemoveService = gaussimappparams.DefaultWeightMsgRemoveService
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAnchorCredential, &weightMsgAnchorCredential, nil,
+		func(_ *rand.Rand) {
+			weightMsgAnchorCredential = gaussimappparams.DefaultWeightMsgAnchorCredential
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokeCredential, &weightMsgRevokeCredential, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeCredential = gaussimappparams.DefaultWeightMsgRevokeCredential
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateDid,
+			SimulateMsgCreateDid(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAddVerificationMethod,
+			SimulateMsgAddVerificationMethod(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRotateVerificationMethod,
+			SimulateMsgRotateVerificationMethod(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRevokeVerificationMethod,
+			SimulateMsgRevokeVerificationMethod(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAddService,
+			SimulateMsgAddService(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRemoveService,
+			SimulateMsgRemoveService(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAnchorCredential,
+			SimulateMsgAnchorCredential(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRevokeCredential,
+			SimulateMsgRevokeCredential(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateDid generates a MsgCreateDid for an account without a DID
+// document, with a random verification method. The trusted issuers create
+// theirs first so that they can anchor credentials.
+func SimulateMsgCreateDid(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		for _, address := range k.TrustedIssuers(ctx) {
+			issuer, found := simtypes.FindAccount(accs, mustAccAddress(address))
+			if _, hasDid := k.GetDidDocument(ctx, issuer.Address); found && !hasDid {
+				simAccount = issuer
+				break
+			}
+		}
+
+		if _, found := k.GetDidDocument(ctx, simAccount.Address); found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateDid, "DID document already exists"), nil, nil
+		}
+
+		did := types.NewDid(simAccount.Address)
+		methods := []types.VerificationMethod{randomVerificationMethod(r, did)}
+
+		msg := types.NewMsgCreateDid(simAccount.Address, methods, []types.Service{})
+
+		return deliverMsg(r, app, ctx, ak, bk, simAccount, msg, chainID)
+	}
+}
+
+// SimulateMsgAddVerificationMethod generates a MsgAddVerificationMethod with a
+// random public key
+func SimulateMsgAddVerificationMethod(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, document, found := randomDidDocument(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddVerificationMethod, "no DID document"), nil, nil
+		}
+		if uint32(len(document.VerificationMethods)) >= k.MaxVerificationMethods(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddVerificationMethod, "too many verification methods"), nil, nil
+		}
+
+		method := randomVerificationMethod(r, document.Id)
+		if document.FindVerificationMethod(method.Id) >= 0 || document.FindService(method.Id) >= 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddVerificationMethod, "verification method already exists"), nil, nil
+		}
+
+		msg := types.NewMsgAddVerificationMethod(simAccount.Address, method)
+
+		return deliverMsg(r, app, ctx, ak, bk, simAccount, msg, chainID)
+	}
+}
+
+// SimulateMsgRotateVerificationMethod generates a MsgRotateVerificationMethod
+// with a random public key of the type of the method
+func SimulateMsgRotateVerificationMethod(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, document, found := randomDidDocument(r, ctx, k, accs)
+		if !found || len(document.VerificationMethods) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRotateVerificationMethod, "no verification method"), nil, nil
+		}
+
+		method := document.VerificationMethods[r.Intn(len(document.VerificationMethods))]
+		publicKeyHex := hex.EncodeToString(randomPublicKey(r, method.Type))
+
+		msg := types.NewMsgRotateVerificationMethod(simAccount.Address, method.Id, publicKeyHex)
+
+		return deliverMsg(r, app, ctx, ak, bk, simAccount, msg, chainID)
+	}
+}
+
+// SimulateMsgRevokeVerificationMethod generates a MsgRevokeVerificationMethod
+func SimulateMsgRevokeVerificationMethod(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, document, found := randomDidDocument(r, ctx, k, accs)
+		if !found || len(document.VerificationMethods) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeVerificationMethod, "no verification method"), nil, nil
+		}
+
+		method := document.VerificationMethods[r.Intn(len(document.VerificationMethods))]
+		msg := types.NewMsgRevokeVerificationMethod(simAccount.Address, method.Id)
+
+		return deliverMsg(r, app, ctx, ak, bk, simAccount, msg, chainID)
+	}
+}
+
+// SimulateMsgAddService generates a MsgAddService with a random endpoint
+func SimulateMsgAddService(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, document, found := randomDidDocument(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddService, "no DID document"), nil, nil
+		}
+		if uint32(len(document.Services)) >= k.MaxServices(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddService, "too many services"), nil, nil
+		}
+
+		id := document.Id + "#" + simtypes.RandStringOfLength(r, 1+r.Intn(types.MaxFragmentLength))
+		if document.FindService(id) >= 0 || document.FindVerificationMethod(id) >= 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddService, "service already exists"), nil, nil
+		}
+
+		endpoint := "https://" + simtypes.RandStringOfLength(r, 1+r.Intn(32)) + ".com"
+		service := types.NewService(id, simtypes.RandStringOfLength(r, 1+r.Intn(types.MaxServiceTypeLength)), endpoint)
+
+		msg := types.NewMsgAddService(simAccount.Address, service)
+
+		return deliverMsg(r, app, ctx, ak, bk, simAccount, msg, chainID)
+	}
+}
+
+// SimulateMsgRemoveService generates a MsgRemoveService
+func SimulateMsgRemoveService(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, document, found := randomDidDocument(r, ctx, k, accs)
+		if !found || len(document.Services) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveService, "no service"), nil, nil
+		}
+
+		service := document.Services[r.Intn(len(document.Services))]
+		msg := types.NewMsgRemoveService(simAccount.Address, service.Id)
+
+		return deliverMsg(r, app, ctx, ak, bk, simAccount, msg, chainID)
+	}
+}
+
+// SimulateMsgAnchorCredential generates a MsgAnchorCredential of a random
+// credential issued by a trusted issuer to an account with a DID document
+func SimulateMsgAnchorCredential(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var issuers []simtypes.Account
+		for _, address := range k.TrustedIssuers(ctx) {
+			issuer, found := simtypes.FindAccount(accs, mustAccAddress(address))
+			if _, hasDid := k.GetDidDocument(ctx, issuer.Address); found && hasDid {
+				issuers = append(issuers, issuer)
+			}
+		}
+		if len(issuers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAnchorCredential, "no trusted issuer with a DID document"), nil, nil
+		}
+		issuer := issuers[r.Intn(len(issuers))]
+
+		_, subject, found := randomDidDocument(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAnchorCredential, "no DID document"), nil, nil
+		}
+
+		hash := sha256.Sum256([]byte(simtypes.RandStringOfLength(r, 32)))
+		var expirationDate time.Time
+		if r.Intn(2) == 0 {
+			expirationDate = ctx.BlockTime().Add(time.Duration(1+r.Intn(3600)) * time.Second)
+		}
+
+		msg := types.NewMsgAnchorCredential(issuer.Address, subject.Id, hex.EncodeToString(hash[:]), expirationDate)
+
+		return deliverMsg(r, app, ctx, ak, bk, issuer, msg, chainID)
+	}
+}
+
+// SimulateMsgRevokeCredential generates a MsgRevokeCredential of a random
+// credential that is not revoked
+func SimulateMsgRevokeCredential(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var credentials []types.Credential
+		k.IterateCredentials(ctx, func(_ int64, credential types.Credential) bool {
+			if !credential.Revoked {
+				credentials = append(credentials, credential)
+			}
+			return false
+		})
+		if len(credentials) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeCredential, "no credential to revoke"), nil, nil
+		}
+
+		credential := credentials[r.Intn(len(credentials))]
+		issuerAddr, err := types.ParseDid(credential.Issuer)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeCredential, "invalid issuer"), nil, err
+		}
+
+		issuer, found := simtypes.FindAccount(accs, issuerAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeCredential, "issuer not found"), nil, nil
+		}
+
+		msg := types.NewMsgRevokeCredential(issuer.Address, credential.Hash)
+
+		return deliverMsg(r, app, ctx, ak, bk, issuer, msg, chainID)
+	}
+}
+
+// randomDidDocument returns a random simulated account with a DID document
+func randomDidDocument(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account,
+) (simtypes.Account, types.DidDocument, bool) {
+	for _, i := range r.Perm(len(accs)) {
+		if document, found := k.GetDidDocument(ctx, accs[i].Address); found {
+			return accs[i], document, true
+		}
+	}
+
+	return simtypes.Account{}, types.DidDocument{}, false
+}
+
+// randomVerificationMethod returns a verification method of a DID with a
+// random fragment and public key
+func randomVerificationMethod(r *rand.Rand, did string) types.VerificationMethod {
+	keyType := types.Ed25519VerificationKey2018
+	if r.Intn(2) == 0 {
+		keyType = types.Secp256k1VerificationKey2018
+	}
+
+	id := did + "#" + simtypes.RandStringOfLength(r, 1+r.Intn(types.MaxFragmentLength))
+	return types.NewVerificationMethod(id, keyType, randomPublicKey(r, keyType))
+}
+
+// randomPublicKey returns a random public key of a verification method type
+func randomPublicKey(r *rand.Rand, keyType string) []byte {
+	secret := []byte(simtypes.RandStringOfLength(r, 32))
+	if keyType == types.Secp256k1VerificationKey2018 {
+		return secp256k1.GenPrivKeyFromSecret(secret).PubKey().Bytes()
+	}
+	return ed25519.GenPrivKeyFromSecret(secret).PubKey().Bytes()
+}
+
+func mustAccAddress(address string) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func deliverMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	simAccount simtypes.Account, msg sdk.Msg, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := ak.GetAccount(ctx, simAccount.Address)
+	if account == nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "account not found"), nil, nil
+	}
+
+	spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+	fees, err := simtypes.RandomFees(r, ctx, spendable)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
@@ -0,0 +1,30 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/gauss/gauss/v4/x/identity/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxVerificationMethods),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxVerificationMethods(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxServices),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxServices(r))
+			},
+		),
+	}
+}
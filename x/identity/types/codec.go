@@ -0,0 +1,55 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/identity interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateDid{}, "gauss/identity/MsgCreateDid", nil)
+	cdc.RegisterConcrete(&MsgAddVerificationMethod{}, "gauss/identity/MsgAddVerificationMethod", nil)
+	cdc.RegisterConcrete(&MsgRotateVerificationMethod{}, "gauss/identity/MsgRotateVerificationMethod", nil)
+	cdc.RegisterConcrete(&MsgRevokeVerificationMethod{}, "gauss/identity/MsgRevokeVerificationMethod", nil)
+	cdc.RegisterConcrete(&MsgAddService{}, "gauss/identity/MsgAddService", nil)
+	cdc.RegisterConcrete(&MsgRemoveService{}, "gauss/identity/MsgRemoveService", nil)
+	cdc.RegisterConcrete(&MsgAnchorCredential{}, "gauss/identity/MsgAnchorCredential", nil)
+	cdc.RegisterConcrete(&MsgRevokeCredential{}, "gauss/identity/MsgRevokeCredential", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateDid{},
+		&MsgAddVerificationMethod{},
+		&MsgRotateVerificationMethod{},
+		&MsgRevokeVerificationMethod{},
+		&MsgAddService{},
+		&MsgRemoveService{},
+		&MsgAnchorCredential{},
+		&MsgRevokeCredential{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/identity module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/identity and
+	// defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
@@ -0,0 +1,267 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DidPrefix is the prefix of the DIDs of the gauss method
+	DidPrefix = "did:gauss:"
+
+	// Secp256k1VerificationKey2018 is the type of a compressed secp256k1 public key
+	Secp256k1VerificationKey2018 = "Secp256k1VerificationKey2018"
+	// Ed25519VerificationKey2018 is the type of an ed25519 public key
+	Ed25519VerificationKey2018 = "Ed25519VerificationKey2018"
+
+	// MaxFragmentLength is the maximum length of the fragment of a DID URL
+	MaxFragmentLength = 64
+	// MaxServiceTypeLength is the maximum length of the type of a service
+	MaxServiceTypeLength = 64
+	// MaxServiceEndpointLength is the maximum length of the endpoint of a service
+	MaxServiceEndpointLength = 256
+)
+
+// NewDid returns the DID of an account
+func NewDid(controller sdk.AccAddress) string {
+	return DidPrefix + controller.String()
+}
+
+// ParseDid returns the account of a DID
+func ParseDid(did string) (sdk.AccAddress, error) {
+	if !strings.HasPrefix(did, DidPrefix) {
+		return nil, sdkerrors.Wrapf(ErrInvalidDid, "%s does not start with %s", did, DidPrefix)
+	}
+
+	controller, err := sdk.AccAddressFromBech32(strings.TrimPrefix(did, DidPrefix))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidDid, "%s: %s", did, err)
+	}
+
+	return controller, nil
+}
+
+// validateDidURL checks that an id is a DID URL of the DID with a fragment
+func validateDidURL(did, id string) error {
+	if !strings.HasPrefix(id, did+"#") {
+		return fmt.Errorf("%s is not a DID URL of %s", id, did)
+	}
+
+	fragment := strings.TrimPrefix(id, did+"#")
+	if len(fragment) == 0 || len(fragment) > MaxFragmentLength {
+		return fmt.Errorf("fragment length of %s should be between [1, %d]", id, MaxFragmentLength)
+	}
+
+	return nil
+}
+
+// NewDidDocument creates a new DID document of an account
+func NewDidDocument(
+	controller sdk.AccAddress, methods []VerificationMethod, services []Service, created time.Time,
+) DidDocument {
+	return DidDocument{
+		Id:                  NewDid(controller),
+		Controller:          controller.String(),
+		VerificationMethods: methods,
+		Services:            services,
+		Created:             created,
+		Updated:             created,
+	}
+}
+
+// GetControllerAddr returns the account controlling the document
+func (d DidDocument) GetControllerAddr() sdk.AccAddress {
+	return mustAccAddress(d.Controller)
+}
+
+// Validate performs basic validation of the document
+func (d DidDocument) Validate() error {
+	controller, err := ParseDid(d.Id)
+	if err != nil {
+		return err
+	}
+	if controller.String() != d.Controller {
+		return sdkerrors.Wrapf(ErrInvalidDid, "%s is not controlled by %s", d.Id, d.Controller)
+	}
+
+	return ValidateEntries(d.Id, d.VerificationMethods, d.Services)
+}
+
+// FindVerificationMethod returns the index of a verification method, or -1
+func (d DidDocument) FindVerificationMethod(id string) int {
+	for i, method := range d.VerificationMethods {
+		if method.Id == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindService returns the index of a service, or -1
+func (d DidDocument) FindService(id string) int {
+	for i, service := range d.Services {
+		if service.Id == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// String implements the Stringer interface.
+func (d DidDocument) String() string {
+	out, _ := yaml.Marshal(d)
+	return string(out)
+}
+
+// ValidateEntries checks the verification methods and services of a DID,
+// whose ids must be unique
+func ValidateEntries(did string, methods []VerificationMethod, services []Service) error {
+	ids := make(map[string]bool)
+	for _, method := range methods {
+		if err := method.Validate(did); err != nil {
+			return err
+		}
+		if ids[method.Id] {
+			return sdkerrors.Wrapf(ErrVerificationMethodExists, "duplicate id %s", method.Id)
+		}
+		ids[method.Id] = true
+	}
+
+	for _, service := range services {
+		if err := service.Validate(did); err != nil {
+			return err
+		}
+		if ids[service.Id] {
+			return sdkerrors.Wrapf(ErrServiceExists, "duplicate id %s", service.Id)
+		}
+		ids[service.Id] = true
+	}
+
+	return nil
+}
+
+// NewVerificationMethod creates a new verification method
+func NewVerificationMethod(id, keyType string, publicKey []byte) VerificationMethod {
+	return VerificationMethod{
+		Id:           id,
+		Type:         keyType,
+		PublicKeyHex: hex.EncodeToString(publicKey),
+	}
+}
+
+// Validate checks that the method belongs to a DID and holds a public key of its type
+func (m VerificationMethod) Validate(did string) error {
+	if err := validateDidURL(did, m.Id); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVerificationMethod, err.Error())
+	}
+
+	return ValidatePublicKey(m.Type, m.PublicKeyHex)
+}
+
+// ValidatePublicKey checks that the hex of a public key is a key of the type
+func ValidatePublicKey(keyType, publicKeyHex string) error {
+	bz, err := hex.DecodeString(publicKeyHex)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidVerificationMethod, "invalid public key hex (%s)", err)
+	}
+
+	switch keyType {
+	case Secp256k1VerificationKey2018:
+		if len(bz) != 33 || (bz[0] != 0x02 && bz[0] != 0x03) {
+			return sdkerrors.Wrap(ErrInvalidVerificationMethod, "secp256k1 public key should be 33 bytes compressed")
+		}
+	case Ed25519VerificationKey2018:
+		if len(bz) != 32 {
+			return sdkerrors.Wrap(ErrInvalidVerificationMethod, "ed25519 public key should be 32 bytes")
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidVerificationMethod, "unsupported key type %s", keyType)
+	}
+
+	return nil
+}
+
+// NewService creates a new service
+func NewService(id, serviceType, endpoint string) Service {
+	return Service{
+		Id:              id,
+		Type:            serviceType,
+		ServiceEndpoint: endpoint,
+	}
+}
+
+// Validate checks that the service belongs to a DID
+func (s Service) Validate(did string) error {
+	if err := validateDidURL(did, s.Id); err != nil {
+		return sdkerrors.Wrap(ErrInvalidService, err.Error())
+	}
+	if len(s.Type) == 0 || len(s.Type) > MaxServiceTypeLength {
+		return sdkerrors.Wrapf(ErrInvalidService, "type length should be between [1, %d]", MaxServiceTypeLength)
+	}
+	if len(s.ServiceEndpoint) == 0 || len(s.ServiceEndpoint) > MaxServiceEndpointLength {
+		return sdkerrors.Wrapf(ErrInvalidService, "endpoint length should be between [1, %d]", MaxServiceEndpointLength)
+	}
+
+	return nil
+}
+
+// NewCredential creates a new credential
+func NewCredential(hash, issuer, subject string, issuanceDate, expirationDate time.Time) Credential {
+	return Credential{
+		Hash:           hash,
+		Issuer:         issuer,
+		Subject:        subject,
+		IssuanceDate:   issuanceDate,
+		ExpirationDate: expirationDate,
+	}
+}
+
+// IsValid returns whether the credential is neither revoked nor expired at a time
+func (c Credential) IsValid(blockTime time.Time) bool {
+	return !c.Revoked && (c.ExpirationDate.IsZero() || blockTime.Before(c.ExpirationDate))
+}
+
+// Validate performs basic validation of the credential
+func (c Credential) Validate() error {
+	if _, err := ParseCredentialHash(c.Hash); err != nil {
+		return err
+	}
+	if _, err := ParseDid(c.Issuer); err != nil {
+		return err
+	}
+	if _, err := ParseDid(c.Subject); err != nil {
+		return err
+	}
+	if !c.ExpirationDate.IsZero() && !c.ExpirationDate.After(c.IssuanceDate) {
+		return sdkerrors.Wrap(ErrInvalidCredential, "expiration date should be after the issuance date")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (c Credential) String() string {
+	out, _ := yaml.Marshal(c)
+	return string(out)
+}
+
+// ParseCredentialHash returns the bytes of the hex of a sha256 hash
+func ParseCredentialHash(hash string) ([]byte, error) {
+	bz, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidCredential, "invalid hash hex (%s)", err)
+	}
+	if len(bz) != sha256.Size || hash != strings.ToLower(hash) {
+		return nil, sdkerrors.Wrapf(ErrInvalidCredential, "hash should be the lowercase hex of %d bytes", sha256.Size)
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/x/identity/types"
+)
+
+func TestParseDid(t *testing.T) {
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	parsed, err := types.ParseDid(types.NewDid(addr))
+	require.NoError(t, err)
+	require.Equal(t, addr, parsed)
+
+	_, err = types.ParseDid("did:example:" + addr.String())
+	require.ErrorIs(t, err, types.ErrInvalidDid)
+	_, err = types.ParseDid("did:gauss:abc")
+	require.ErrorIs(t, err, types.ErrInvalidDid)
+}
+
+func TestValidatePublicKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		keyType   string
+		publicKey string
+		valid     bool
+	}{
+		{"ed25519", types.Ed25519VerificationKey2018, "ab" + strings.Repeat("00", 31), true},
+		{"ed25519 short", types.Ed25519VerificationKey2018, strings.Repeat("00", 31), false},
+		{"secp256k1", types.Secp256k1VerificationKey2018, "02" + strings.Repeat("00", 32), true},
+		{"secp256k1 uncompressed", types.Secp256k1VerificationKey2018, "04" + strings.Repeat("00", 64), false},
+		{"secp256k1 prefix", types.Secp256k1VerificationKey2018, "05" + strings.Repeat("00", 32), false},
+		{"not hex", types.Ed25519VerificationKey2018, "zz", false},
+		{"unsupported", "RsaVerificationKey2018", strings.Repeat("00", 32), false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.ValidatePublicKey(tt.keyType, tt.publicKey)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidVerificationMethod)
+			}
+		})
+	}
+}
+
+func TestParseCredentialHash(t *testing.T) {
+	_, err := types.ParseCredentialHash(strings.Repeat("ab", 32))
+	require.NoError(t, err)
+	_, err = types.ParseCredentialHash(strings.Repeat("AB", 32))
+	require.ErrorIs(t, err, types.ErrInvalidCredential)
+	_, err = types.ParseCredentialHash(strings.Repeat("ab", 20))
+	require.ErrorIs(t, err, types.ErrInvalidCredential)
+}
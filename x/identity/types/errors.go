@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/identity module sentinel errors
+var (
+	ErrInvalidDid                 = sdkerrors.Register(ModuleName, 2, "invalid DID")
+	ErrDidExists                  = sdkerrors.Register(ModuleName, 3, "DID document already exists")
+	ErrDidNotFound                = sdkerrors.Register(ModuleName, 4, "DID document does not exist")
+	ErrInvalidVerificationMethod  = sdkerrors.Register(ModuleName, 5, "invalid verification method")
+	ErrVerificationMethodExists   = sdkerrors.Register(ModuleName, 6, "verification method already exists")
+	ErrVerificationMethodNotFound = sdkerrors.Register(ModuleName, 7, "verification method does not exist")
+	ErrInvalidService             = sdkerrors.Register(ModuleName, 8, "invalid service")
+	ErrServiceExists              = sdkerrors.Register(ModuleName, 9, "service already exists")
+	ErrServiceNotFound            = sdkerrors.Register(ModuleName, 10, "service does not exist")
+	ErrTooManyEntries             = sdkerrors.Register(ModuleName, 11, "too many entries in the DID document")
+	ErrInvalidCredential          = sdkerrors.Register(ModuleName, 12, "invalid credential")
+	ErrCredentialExists           = sdkerrors.Register(ModuleName, 13, "credential already anchored")
+	ErrCredentialNotFound         = sdkerrors.Register(ModuleName, 14, "credential does not exist")
+	ErrUntrustedIssuer            = sdkerrors.Register(ModuleName, 15, "issuer is not trusted")
+	ErrNotIssuer                  = sdkerrors.Register(ModuleName, 16, "not the issuer of the credential")
+	ErrCredentialRevoked          = sdkerrors.Register(ModuleName, 17, "credential already revoked")
+)
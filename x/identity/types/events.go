@@ -0,0 +1,21 @@
+package types
+
+const (
+	AttributeValueCategory = ModuleName
+
+	EventTypeCreateDid                = "create_did"
+	EventTypeAddVerificationMethod    = "add_verification_method"
+	EventTypeRotateVerificationMethod = "rotate_verification_method"
+	EventTypeRevokeVerificationMethod = "revoke_verification_method"
+	EventTypeAddService               = "add_service"
+	EventTypeRemoveService            = "remove_service"
+	EventTypeAnchorCredential         = "anchor_credential"
+	EventTypeRevokeCredential         = "revoke_credential"
+
+	AttributeKeyDid       = "did"
+	AttributeKeyMethodID  = "method_id"
+	AttributeKeyServiceID = "service_id"
+	AttributeKeyHash      = "hash"
+	AttributeKeyIssuer    = "issuer"
+	AttributeKeySubject   = "subject"
+)
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, didDocuments []DidDocument, credentials []Credential) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		DidDocuments: didDocuments,
+		Credentials:  credentials,
+	}
+}
+
+// DefaultGenesisState returns a default identity module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []DidDocument{}, []Credential{})
+}
+
+// Validate performs basic validation of the identity genesis state
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	dids := make(map[string]bool)
+	for _, document := range gs.DidDocuments {
+		if err := document.Validate(); err != nil {
+			return err
+		}
+		if dids[document.Id] {
+			return fmt.Errorf("duplicate DID document %s", document.Id)
+		}
+		dids[document.Id] = true
+	}
+
+	hashes := make(map[string]bool)
+	for _, credential := range gs.Credentials {
+		if err := credential.Validate(); err != nil {
+			return err
+		}
+		if hashes[credential.Hash] {
+			return fmt.Errorf("duplicate credential %s", credential.Hash)
+		}
+		hashes[credential.Hash] = true
+	}
+
+	return nil
+}
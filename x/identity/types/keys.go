@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "identity"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace default name for parameter store
+	DefaultParamspace = ModuleName
+)
+
+var (
+	DidDocumentKey       = []byte{0x11} // prefix for each key to the DID document of an account
+	CredentialKey        = []byte{0x21} // prefix for each key to a credential
+	SubjectCredentialKey = []byte{0x22} // prefix for each key to a credential of a subject
+)
+
+// GetDidDocumentKey returns the key of the DID document of an account
+// VALUE: identity/DidDocument
+func GetDidDocumentKey(controller sdk.AccAddress) []byte {
+	return append(DidDocumentKey, controller.Bytes()...)
+}
+
+// GetCredentialKey returns the key of a credential by its hash
+// VALUE: identity/Credential
+func GetCredentialKey(hash []byte) []byte {
+	return append(CredentialKey, hash...)
+}
+
+// GetSubjectCredentialsKey returns the prefix of the keys to the credentials of a subject
+func GetSubjectCredentialsKey(subject sdk.AccAddress) []byte {
+	return append(SubjectCredentialKey, lengthPrefixed(subject.Bytes())...)
+}
+
+// GetSubjectCredentialKey returns the key of a credential of a subject
+// VALUE: []byte{}
+func GetSubjectCredentialKey(subject sdk.AccAddress, hash []byte) []byte {
+	return append(GetSubjectCredentialsKey(subject), hash...)
+}
+
+func lengthPrefixed(bz []byte) []byte {
+	return append([]byte{byte(len(bz))}, bz...)
+}
@@ -0,0 +1,339 @@
+package types
+
+import (
+	"encoding/hex"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgCreateDid                = "create_did"
+	TypeMsgAddVerificationMethod    = "add_verification_method"
+	TypeMsgRotateVerificationMethod = "rotate_verification_method"
+	TypeMsgRevokeVerificationMethod = "revoke_verification_method"
+	TypeMsgAddService               = "add_service"
+	TypeMsgRemoveService            = "remove_service"
+	TypeMsgAnchorCredential         = "anchor_credential"
+	TypeMsgRevokeCredential         = "revoke_credential"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateDid{}
+	_ sdk.Msg = &MsgAddVerificationMethod{}
+	_ sdk.Msg = &MsgRotateVerificationMethod{}
+	_ sdk.Msg = &MsgRevokeVerificationMethod{}
+	_ sdk.Msg = &MsgAddService{}
+	_ sdk.Msg = &MsgRemoveService{}
+	_ sdk.Msg = &MsgAnchorCredential{}
+	_ sdk.Msg = &MsgRevokeCredential{}
+)
+
+// NewMsgCreateDid creates a new MsgCreateDid instance.
+func NewMsgCreateDid(controller sdk.AccAddress, methods []VerificationMethod, services []Service) *MsgCreateDid {
+	return &MsgCreateDid{
+		Controller:          controller.String(),
+		VerificationMethods: methods,
+		Services:            services,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateDid) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateDid) Type() string { return TypeMsgCreateDid }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreateDid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Controller)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCreateDid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateDid) ValidateBasic() error {
+	controller, err := sdk.AccAddressFromBech32(msg.Controller)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid controller address (%s)", err)
+	}
+
+	return ValidateEntries(NewDid(controller), msg.VerificationMethods, msg.Services)
+}
+
+// NewMsgAddVerificationMethod creates a new MsgAddVerificationMethod instance.
+func NewMsgAddVerificationMethod(controller sdk.AccAddress, method VerificationMethod) *MsgAddVerificationMethod {
+	return &MsgAddVerificationMethod{
+		Controller:         controller.String(),
+		VerificationMethod: method,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgAddVerificationMethod) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgAddVerificationMethod) Type() string { return TypeMsgAddVerificationMethod }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAddVerificationMethod) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Controller)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgAddVerificationMethod) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAddVerificationMethod) ValidateBasic() error {
+	controller, err := sdk.AccAddressFromBech32(msg.Controller)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid controller address (%s)", err)
+	}
+
+	return msg.VerificationMethod.Validate(NewDid(controller))
+}
+
+// NewMsgRotateVerificationMethod creates a new MsgRotateVerificationMethod instance.
+func NewMsgRotateVerificationMethod(controller sdk.AccAddress, methodID, publicKeyHex string) *MsgRotateVerificationMethod {
+	return &MsgRotateVerificationMethod{
+		Controller:   controller.String(),
+		MethodId:     methodID,
+		PublicKeyHex: publicKeyHex,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRotateVerificationMethod) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRotateVerificationMethod) Type() string { return TypeMsgRotateVerificationMethod }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRotateVerificationMethod) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Controller)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRotateVerificationMethod) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRotateVerificationMethod) ValidateBasic() error {
+	controller, err := sdk.AccAddressFromBech32(msg.Controller)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid controller address (%s)", err)
+	}
+
+	if err := validateDidURL(NewDid(controller), msg.MethodId); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVerificationMethod, err.Error())
+	}
+	if _, err := hex.DecodeString(msg.PublicKeyHex); err != nil || len(msg.PublicKeyHex) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVerificationMethod, "invalid public key hex")
+	}
+
+	return nil
+}
+
+// NewMsgRevokeVerificationMethod creates a new MsgRevokeVerificationMethod instance.
+func NewMsgRevokeVerificationMethod(controller sdk.AccAddress, methodID string) *MsgRevokeVerificationMethod {
+	return &MsgRevokeVerificationMethod{
+		Controller: controller.String(),
+		MethodId:   methodID,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRevokeVerificationMethod) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRevokeVerificationMethod) Type() string { return TypeMsgRevokeVerificationMethod }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRevokeVerificationMethod) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Controller)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRevokeVerificationMethod) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevokeVerificationMethod) ValidateBasic() error {
+	controller, err := sdk.AccAddressFromBech32(msg.Controller)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid controller address (%s)", err)
+	}
+
+	if err := validateDidURL(NewDid(controller), msg.MethodId); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVerificationMethod, err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgAddService creates a new MsgAddService instance.
+func NewMsgAddService(controller sdk.AccAddress, service Service) *MsgAddService {
+	return &MsgAddService{
+		Controller: controller.String(),
+		Service:    service,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgAddService) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgAddService) Type() string { return TypeMsgAddService }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAddService) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Controller)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgAddService) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAddService) ValidateBasic() error {
+	controller, err := sdk.AccAddressFromBech32(msg.Controller)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid controller address (%s)", err)
+	}
+
+	return msg.Service.Validate(NewDid(controller))
+}
+
+// NewMsgRemoveService creates a new MsgRemoveService instance.
+func NewMsgRemoveService(controller sdk.AccAddress, serviceID string) *MsgRemoveService {
+	return &MsgRemoveService{
+		Controller: controller.String(),
+		ServiceId:  serviceID,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRemoveService) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRemoveService) Type() string { return TypeMsgRemoveService }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRemoveService) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Controller)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRemoveService) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRemoveService) ValidateBasic() error {
+	controller, err := sdk.AccAddressFromBech32(msg.Controller)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid controller address (%s)", err)
+	}
+
+	if err := validateDidURL(NewDid(controller), msg.ServiceId); err != nil {
+		return sdkerrors.Wrap(ErrInvalidService, err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgAnchorCredential creates a new MsgAnchorCredential instance.
+func NewMsgAnchorCredential(issuer sdk.AccAddress, subject, hash string, expirationDate time.Time) *MsgAnchorCredential {
+	return &MsgAnchorCredential{
+		Issuer:         issuer.String(),
+		Subject:        subject,
+		Hash:           hash,
+		ExpirationDate: expirationDate,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgAnchorCredential) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgAnchorCredential) Type() string { return TypeMsgAnchorCredential }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAnchorCredential) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Issuer)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgAnchorCredential) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAnchorCredential) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+	if _, err := ParseDid(msg.Subject); err != nil {
+		return err
+	}
+	_, err := ParseCredentialHash(msg.Hash)
+	return err
+}
+
+// NewMsgRevokeCredential creates a new MsgRevokeCredential instance.
+func NewMsgRevokeCredential(issuer sdk.AccAddress, hash string) *MsgRevokeCredential {
+	return &MsgRevokeCredential{
+		Issuer: issuer.String(),
+		Hash:   hash,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRevokeCredential) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRevokeCredential) Type() string { return TypeMsgRevokeCredential }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRevokeCredential) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Issuer)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRevokeCredential) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevokeCredential) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+	_, err := ParseCredentialHash(msg.Hash)
+	return err
+}
+
+func mustAccAddress(address string) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
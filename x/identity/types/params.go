@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	// DefaultMaxVerificationMethods is the default maximum number of verification methods of a DID document
+	DefaultMaxVerificationMethods uint32 = 10
+	// DefaultMaxServices is the default maximum number of services of a DID document
+	DefaultMaxServices uint32 = 10
+)
+
+var (
+	KeyTrustedIssuers         = []byte("TrustedIssuers")
+	KeyMaxVerificationMethods = []byte("MaxVerificationMethods")
+	KeyMaxServices            = []byte("MaxServices")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable for identity module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the identity module
+func NewParams(trustedIssuers []string, maxVerificationMethods, maxServices uint32) Params {
+	return Params{
+		TrustedIssuers:         trustedIssuers,
+		MaxVerificationMethods: maxVerificationMethods,
+		MaxServices:            maxServices,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the identity module,
+// no issuer is trusted until governance adds some
+func DefaultParams() Params {
+	return NewParams([]string{}, DefaultMaxVerificationMethods, DefaultMaxServices)
+}
+
+// Validate all identity module parameters
+func (p Params) Validate() error {
+	if err := validateTrustedIssuers(p.TrustedIssuers); err != nil {
+		return err
+	}
+	if err := validateMaxVerificationMethods(p.MaxVerificationMethods); err != nil {
+		return err
+	}
+
+	return validateMaxServices(p.MaxServices)
+}
+
+// IsTrustedIssuer returns whether an account may anchor credentials
+func (p Params) IsTrustedIssuer(issuer sdk.AccAddress) bool {
+	for _, address := range p.TrustedIssuers {
+		if address == issuer.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyTrustedIssuers, &p.TrustedIssuers, validateTrustedIssuers),
+		paramtypes.NewParamSetPair(KeyMaxVerificationMethods, &p.MaxVerificationMethods, validateMaxVerificationMethods),
+		paramtypes.NewParamSetPair(KeyMaxServices, &p.MaxServices, validateMaxServices),
+	}
+}
+
+func validateTrustedIssuers(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	issuers := make(map[string]bool)
+	for _, issuer := range v {
+		if _, err := sdk.AccAddressFromBech32(issuer); err != nil {
+			return err
+		}
+		if issuers[issuer] {
+			return fmt.Errorf("duplicate trusted issuer %s", issuer)
+		}
+		issuers[issuer] = true
+	}
+
+	return nil
+}
+
+func validateMaxVerificationMethods(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max verification methods must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxServices(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package oracle
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/oracle/keeper"
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+// EndBlocker tallies the votes at the end of each voting period and slashes
+// the validators missing too many of them at the end of each slashing window
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	params := k.GetParams(ctx)
+	if types.IsPeriodLastBlock(ctx, params.VotePeriod) {
+		k.Tally(ctx)
+	}
+
+	if types.IsPeriodLastBlock(ctx, params.SlashWindow) {
+		k.SlashAndResetMissCounters(ctx)
+	}
+}
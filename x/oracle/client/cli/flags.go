@@ -0,0 +1,17 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagValidator = "validator"
+)
+
+var (
+	fsValidator = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	fsValidator.String(FlagValidator, "", "operator address of the validator voted for (default the validator of the --from account)")
+}
@@ -0,0 +1,266 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	oracleQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the oracle module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	oracleQueryCmd.AddCommand(
+		GetCmdQueryExchangeRates(),
+		GetCmdQueryFeederDelegation(),
+		GetCmdQueryMissCounter(),
+		GetCmdQueryAggregatePrevote(),
+		GetCmdQueryAggregateVote(),
+		GetCmdQueryParams(),
+	)
+
+	return oracleQueryCmd
+}
+
+// GetCmdQueryExchangeRates implements the exchange rates query command.
+func GetCmdQueryExchangeRates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rates [denom]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the latest exchange rates",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the latest exchange rates of the denoms in the bond denom. optionally restrict to a single denom
+
+Example:
+$ %s query %s exchange-rates
+$ %s query %s exchange-rates uusdg
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 1 {
+				res, err := queryClient.ExchangeRate(context.Background(), &types.QueryExchangeRateRequest{Denom: args[0]})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.ExchangeRates(context.Background(), &types.QueryExchangeRatesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFeederDelegation implements the feeder query command.
+func GetCmdQueryFeederDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feeder [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the feeder account voting for a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the feeder account voting for a validator.
+
+Example:
+$ %s query %s feeder gaussvaloper1...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeederDelegation(context.Background(),
+				&types.QueryFeederDelegationRequest{ValidatorAddr: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMissCounter implements the miss counter query command.
+func GetCmdQueryMissCounter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "miss [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the voting periods a validator missed in the current slashing window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of voting periods a validator missed in the current slashing window.
+
+Example:
+$ %s query %s miss gaussvaloper1...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MissCounter(context.Background(), &types.QueryMissCounterRequest{ValidatorAddr: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAggregatePrevote implements the aggregate prevote query command.
+func GetCmdQueryAggregatePrevote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-prevote [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the pending prevote of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending prevote of a validator.
+
+Example:
+$ %s query %s aggregate-prevote gaussvaloper1...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AggregatePrevote(context.Background(),
+				&types.QueryAggregatePrevoteRequest{ValidatorAddr: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAggregateVote implements the aggregate vote query command.
+func GetCmdQueryAggregateVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-vote [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the vote of a validator in the current voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the vote of a validator in the current voting period.
+
+Example:
+$ %s query %s aggregate-vote gaussvaloper1...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AggregateVote(context.Background(),
+				&types.QueryAggregateVoteRequest{ValidatorAddr: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryParams implements the params query command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current oracle parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current oracle parameters.
+
+Example:
+$ %s query %s params
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
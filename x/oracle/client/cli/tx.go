@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/oracle transaction commands.
+func NewTxCmd() *cobra.Command {
+	oracleTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Oracle transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	oracleTxCmd.AddCommand(
+		NewAggregatePrevoteCmd(),
+		NewAggregateVoteCmd(),
+		NewDelegateFeedConsentCmd(),
+	)
+
+	return oracleTxCmd
+}
+
+func NewAggregatePrevoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-prevote [salt] [exchange-rates]",
+		Args:  cobra.ExactArgs(2),
+		Short: "commit to the exchange rates of a validator for the next voting period.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`submit the hash of the salt and the exchange rates, in the bond denom, of a
+validator. The same salt and exchange rates must be revealed with aggregate-vote
+in the next voting period.
+
+Example:
+$ %s tx %s aggregate-prevote 1234 1.2uusdg,0.03ueth --from mykey
+$ %s tx %s aggregate-prevote 1234 1.2uusdg,0.03ueth --validator gaussvaloper1... --from feederkey
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			exchangeRates, err := sdk.ParseDecCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			validator, err := getValidator(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			hash := types.GetAggregateVoteHash(args[0], exchangeRates, validator)
+			msg := types.NewMsgAggregateExchangeRatePrevote(hash, clientCtx.GetFromAddress(), validator)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsValidator)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewAggregateVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-vote [salt] [exchange-rates]",
+		Args:  cobra.ExactArgs(2),
+		Short: "reveal the exchange rates of a validator committed to in the previous voting period.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`reveal the salt and the exchange rates, in the bond denom, of the prevote of a
+validator submitted in the previous voting period.
+
+Example:
+$ %s tx %s aggregate-vote 1234 1.2uusdg,0.03ueth --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			exchangeRates, err := sdk.ParseDecCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			validator, err := getValidator(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAggregateExchangeRateVote(args[0], exchangeRates, clientCtx.GetFromAddress(), validator)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsValidator)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewDelegateFeedConsentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-feeder [feeder]",
+		Args:  cobra.ExactArgs(1),
+		Short: "delegate the votes of a validator to a feeder account.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`delegate the votes of the validator of the --from account to a feeder account.
+
+Example:
+$ %s tx %s set-feeder gauss1... --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			feeder, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateFeedConsent(sdk.ValAddress(clientCtx.GetFromAddress()), feeder)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getValidator returns the validator of the --validator flag, or else the
+// validator of the --from account
+func getValidator(clientCtx client.Context, fs *flag.FlagSet) (sdk.ValAddress, error) {
+	validator, err := fs.GetString(FlagValidator)
+	if err != nil {
+		return nil, err
+	}
+
+	if validator == "" {
+		return sdk.ValAddress(clientCtx.GetFromAddress()), nil
+	}
+
+	return sdk.ValAddressFromBech32(validator)
+}
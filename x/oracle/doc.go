@@ -1,6 +1,14 @@
 /*
-Package oracle implements a gaussmodule, that provides an implementation
-of the oracle algorithm.
+Package oracle implements a gaussmodule, that provides on-chain exchange rates
+of the whitelisted denoms in the bond denom.
+
+The bonded validators, or the feeder accounts they delegated their votes to,
+commit to the hash of their exchange rates in a voting period and reveal them
+in the next one. At the end of each voting period the exchange rate of a denom
+is set to the weighted median of the votes by bonded power, provided enough of
+the bonded power voted on it. The validators missing too many voting periods
+of a slashing window are slashed and jailed.
+
 Please refer to the specification under /spec for further information.
 */
 package oracle
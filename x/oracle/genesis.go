@@ -0,0 +1,93 @@
+package oracle
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/oracle/keeper"
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+// InitGenesis sets the exchange rates, the votes and parameters for the provided keeper.
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) (res []abci.ValidatorUpdate) {
+	if err := ValidateGenesis(data); err != nil {
+		panic(err.Error())
+	}
+
+	keeper.SetParams(ctx, data.Params)
+
+	for _, delegation := range data.FeederDelegations {
+		operator, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		feeder, err := sdk.AccAddressFromBech32(delegation.FeederAddress)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetFeederDelegation(ctx, operator, feeder)
+	}
+
+	for _, rate := range data.ExchangeRates {
+		keeper.SetExchangeRate(ctx, rate.Denom, rate.Amount)
+	}
+
+	for _, counter := range data.MissCounters {
+		operator, err := sdk.ValAddressFromBech32(counter.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetMissCounter(ctx, operator, counter.MissCounter)
+	}
+
+	for _, prevote := range data.AggregateExchangeRatePrevotes {
+		keeper.SetAggregateExchangeRatePrevote(ctx, prevote)
+	}
+
+	for _, vote := range data.AggregateExchangeRateVotes {
+		keeper.SetAggregateExchangeRateVote(ctx, vote)
+	}
+
+	return res
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	feederDelegations := []types.FeederDelegation{}
+	keeper.IterateFeederDelegations(ctx, func(operator sdk.ValAddress, feeder sdk.AccAddress) bool {
+		feederDelegations = append(feederDelegations, types.FeederDelegation{
+			FeederAddress:    feeder.String(),
+			ValidatorAddress: operator.String(),
+		})
+		return false
+	})
+
+	missCounters := []types.MissCounter{}
+	keeper.IterateMissCounters(ctx, func(operator sdk.ValAddress, missCounter uint64) bool {
+		missCounters = append(missCounters, types.MissCounter{
+			ValidatorAddress: operator.String(),
+			MissCounter:      missCounter,
+		})
+		return false
+	})
+
+	prevotes := []types.AggregateExchangeRatePrevote{}
+	keeper.IterateAggregateExchangeRatePrevotes(ctx, func(prevote types.AggregateExchangeRatePrevote) bool {
+		prevotes = append(prevotes, prevote)
+		return false
+	})
+
+	votes := []types.AggregateExchangeRateVote{}
+	keeper.IterateAggregateExchangeRateVotes(ctx, func(vote types.AggregateExchangeRateVote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+
+	return types.NewGenesisState(keeper.GetParams(ctx), feederDelegations, keeper.GetAllExchangeRates(ctx),
+		missCounters, prevotes, votes)
+}
+
+// ValidateGenesis validates the provided oracle genesis state
+func ValidateGenesis(data *types.GenesisState) error {
+	return data.Validate()
+}
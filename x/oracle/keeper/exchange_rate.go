@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+// GetExchangeRate returns the latest exchange rate of a denom in the bond denom
+func (k Keeper) GetExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetExchangeRateKey(denom))
+	if value == nil {
+		return sdk.ZeroDec(), sdkerrors.Wrap(types.ErrNoExchangeRate, denom)
+	}
+
+	var rate sdk.DecProto
+	k.cdc.MustUnmarshalBinaryBare(value, &rate)
+	return rate.Dec, nil
+}
+
+// SetExchangeRate sets the exchange rate of a denom
+func (k Keeper) SetExchangeRate(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetExchangeRateKey(denom), k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: exchangeRate}))
+}
+
+// DeleteExchangeRate deletes the exchange rate of a denom
+func (k Keeper) DeleteExchangeRate(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetExchangeRateKey(denom))
+}
+
+// IterateExchangeRates iterates through the exchange rates by denom
+func (k Keeper) IterateExchangeRates(ctx sdk.Context, fn func(denom string, exchangeRate sdk.Dec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ExchangeRateKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key()[len(types.ExchangeRateKey):])
+
+		var rate sdk.DecProto
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &rate)
+
+		if fn(denom, rate.Dec) {
+			break
+		}
+	}
+}
+
+// GetAllExchangeRates returns the exchange rates of all the denoms
+func (k Keeper) GetAllExchangeRates(ctx sdk.Context) sdk.DecCoins {
+	rates := sdk.DecCoins{}
+	k.IterateExchangeRates(ctx, func(denom string, exchangeRate sdk.Dec) bool {
+		rates = append(rates, sdk.NewDecCoinFromDec(denom, exchangeRate))
+		return false
+	})
+
+	return rates
+}
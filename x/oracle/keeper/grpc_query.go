@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+// Params queries the oracle parameters
+func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// ExchangeRate queries the latest exchange rate of a denom
+func (k Querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateRequest) (*types.QueryExchangeRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom cannot be empty")
+	}
+
+	exchangeRate, err := k.GetExchangeRate(sdk.UnwrapSDKContext(c), req.Denom)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "exchange rate of %s not found", req.Denom)
+	}
+
+	return &types.QueryExchangeRateResponse{ExchangeRate: exchangeRate}, nil
+}
+
+// ExchangeRates queries the latest exchange rates of all the denoms
+func (k Querier) ExchangeRates(c context.Context, req *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryExchangeRatesResponse{ExchangeRates: k.GetAllExchangeRates(sdk.UnwrapSDKContext(c))}, nil
+}
+
+// FeederDelegation queries the feeder account a validator delegated its votes to
+func (k Querier) FeederDelegation(
+	c context.Context, req *types.QueryFeederDelegationRequest,
+) (*types.QueryFeederDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	operator, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	feeder := k.GetFeederDelegation(sdk.UnwrapSDKContext(c), operator)
+	return &types.QueryFeederDelegationResponse{FeederAddr: feeder.String()}, nil
+}
+
+// MissCounter queries the number of voting periods a validator missed in the
+// current slashing window
+func (k Querier) MissCounter(c context.Context, req *types.QueryMissCounterRequest) (*types.QueryMissCounterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	operator, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryMissCounterResponse{MissCounter: k.GetMissCounter(sdk.UnwrapSDKContext(c), operator)}, nil
+}
+
+// AggregatePrevote queries the pending prevote of a validator
+func (k Querier) AggregatePrevote(
+	c context.Context, req *types.QueryAggregatePrevoteRequest,
+) (*types.QueryAggregatePrevoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	operator, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	prevote, found := k.GetAggregateExchangeRatePrevote(sdk.UnwrapSDKContext(c), operator)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "prevote of %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryAggregatePrevoteResponse{AggregatePrevote: prevote}, nil
+}
+
+// AggregateVote queries the vote of a validator in the current voting period
+func (k Querier) AggregateVote(c context.Context, req *types.QueryAggregateVoteRequest) (*types.QueryAggregateVoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	operator, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	vote, found := k.GetAggregateExchangeRateVote(sdk.UnwrapSDKContext(c), operator)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "vote of %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryAggregateVoteResponse{AggregateVote: vote}, nil
+}
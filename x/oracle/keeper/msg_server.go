@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the oracle MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) AggregateExchangeRatePrevote(
+	goCtx context.Context, msg *types.MsgAggregateExchangeRatePrevote,
+) (*types.MsgAggregateExchangeRatePrevoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	feeder, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+	voter, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Prevote(ctx, feeder, voter, msg.Hash); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAggregatePrevote,
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.Validator),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Feeder),
+		),
+	})
+
+	return &types.MsgAggregateExchangeRatePrevoteResponse{}, nil
+}
+
+func (k msgServer) AggregateExchangeRateVote(
+	goCtx context.Context, msg *types.MsgAggregateExchangeRateVote,
+) (*types.MsgAggregateExchangeRateVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	feeder, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+	voter, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Vote(ctx, feeder, voter, msg.Salt, msg.ExchangeRates); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAggregateVote,
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.Validator),
+			sdk.NewAttribute(types.AttributeKeyExchangeRates, msg.ExchangeRates.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Feeder),
+		),
+	})
+
+	return &types.MsgAggregateExchangeRateVoteResponse{}, nil
+}
+
+func (k msgServer) DelegateFeedConsent(
+	goCtx context.Context, msg *types.MsgDelegateFeedConsent,
+) (*types.MsgDelegateFeedConsentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+	delegate, err := sdk.AccAddressFromBech32(msg.Delegate)
+	if err != nil {
+		return nil, err
+	}
+
+	// the feeder may be registered before the validator is bonded
+	if validator := k.stakingKeeper.Validator(ctx, operator); validator == nil {
+		return nil, types.ErrNotValidator
+	}
+
+	k.SetFeederDelegation(ctx, operator, delegate)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFeedDelegate,
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyFeeder, msg.Delegate),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sdk.AccAddress(operator).String()),
+		),
+	})
+
+	return &types.MsgDelegateFeedConsentResponse{}, nil
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+// VotePeriod - Number of blocks of a voting period
+func (k Keeper) VotePeriod(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyVotePeriod, &res)
+	return
+}
+
+// VoteThreshold - Rate of the bonded power needed to update an exchange rate
+func (k Keeper) VoteThreshold(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyVoteThreshold, &res)
+	return
+}
+
+// RewardBand - Band of the valid votes around the weighted median
+func (k Keeper) RewardBand(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyRewardBand, &res)
+	return
+}
+
+// Whitelist - Denoms whose exchange rates are voted on
+func (k Keeper) Whitelist(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyWhitelist, &res)
+	return
+}
+
+// SlashFraction - Fraction of the bonded tokens slashed for missing votes
+func (k Keeper) SlashFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeySlashFraction, &res)
+	return
+}
+
+// SlashWindow - Number of blocks of a slashing window
+func (k Keeper) SlashWindow(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeySlashWindow, &res)
+	return
+}
+
+// MinValidPerWindow - Rate of the voting periods of a window a validator must vote validly in
+func (k Keeper) MinValidPerWindow(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMinValidPerWindow, &res)
+	return
+}
+
+// Get all parameteras as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
+}
+
+// set the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
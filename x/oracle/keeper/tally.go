@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+// Tally updates the exchange rates of the whitelisted denoms to the weighted
+// median of the votes of the voting period, counts the voting periods missed by
+// the bonded validators and clears the votes and the stale prevotes
+func (k Keeper) Tally(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	// the bonded validators vote with their consensus power
+	var operators []string
+	var totalPower int64
+	claims := make(map[string]types.Claim)
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		operator := validator.GetOperator()
+		power := validator.GetConsensusPower()
+
+		operators = append(operators, operator.String())
+		claims[operator.String()] = types.Claim{Power: power, Voter: operator}
+		totalPower += power
+		return false
+	})
+
+	ballots := make(map[string]types.ExchangeRateBallot)
+	k.IterateAggregateExchangeRateVotes(ctx, func(vote types.AggregateExchangeRateVote) bool {
+		claim, ok := claims[vote.Voter]
+		if !ok {
+			return false
+		}
+
+		for _, rate := range vote.ExchangeRates {
+			if params.IsWhitelisted(rate.Denom) {
+				ballots[rate.Denom] = append(ballots[rate.Denom], types.VoteForTally{
+					Denom: rate.Denom, ExchangeRate: rate.Amount, Voter: claim.Voter, Power: claim.Power,
+				})
+			}
+		}
+		return false
+	})
+
+	// the exchange rates of the previous voting period are cleared, so a denom
+	// without enough votes in this one has no exchange rate
+	for _, rate := range k.GetAllExchangeRates(ctx) {
+		k.DeleteExchangeRate(ctx, rate.Denom)
+	}
+
+	threshold := params.VoteThreshold.MulInt64(totalPower)
+	for _, denom := range params.Whitelist {
+		ballot := ballots[denom]
+		if ballot.Power() == 0 || sdk.NewDec(ballot.Power()).LT(threshold) {
+			continue
+		}
+
+		exchangeRate := ballot.WeightedMedian()
+		k.SetExchangeRate(ctx, denom, exchangeRate)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExchangeRateUpdate,
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyExchangeRate, exchangeRate.String()),
+			),
+		)
+
+		// the votes within the reward band around the weighted median are valid
+		spread := exchangeRate.Mul(params.RewardBand).QuoInt64(2)
+		for _, vote := range ballot {
+			if vote.ExchangeRate.GTE(exchangeRate.Sub(spread)) && vote.ExchangeRate.LTE(exchangeRate.Add(spread)) {
+				claim := claims[vote.Voter.String()]
+				claim.WinCount++
+				claims[vote.Voter.String()] = claim
+			}
+		}
+	}
+
+	// a validator misses the voting period unless it voted validly on every
+	// whitelisted denom
+	for _, operator := range operators {
+		claim := claims[operator]
+		if claim.WinCount < int64(len(params.Whitelist)) {
+			k.SetMissCounter(ctx, claim.Voter, k.GetMissCounter(ctx, claim.Voter)+1)
+		}
+	}
+
+	k.clearVotes(ctx, params.VotePeriod)
+}
+
+// clearVotes deletes the votes of the voting period and the prevotes which can
+// no longer be revealed
+func (k Keeper) clearVotes(ctx sdk.Context, votePeriod uint64) {
+	var voters []sdk.ValAddress
+	k.IterateAggregateExchangeRateVotes(ctx, func(vote types.AggregateExchangeRateVote) bool {
+		voters = append(voters, vote.GetVoter())
+		return false
+	})
+	for _, voter := range voters {
+		k.DeleteAggregateExchangeRateVote(ctx, voter)
+	}
+
+	// a prevote is revealed in the voting period following its own
+	period := ctx.BlockHeight() / int64(votePeriod)
+	voters = nil
+	k.IterateAggregateExchangeRatePrevotes(ctx, func(prevote types.AggregateExchangeRatePrevote) bool {
+		if prevote.SubmitBlock/int64(votePeriod) < period {
+			voters = append(voters, prevote.GetVoter())
+		}
+		return false
+	})
+	for _, voter := range voters {
+		k.DeleteAggregateExchangeRatePrevote(ctx, voter)
+	}
+}
+
+// SlashAndResetMissCounters slashes and jails the bonded validators which voted
+// validly in less than MinValidPerWindow of the voting periods of the slashing
+// window, and resets the miss counters of all the validators
+func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	votePeriodsPerWindow := int64(params.SlashWindow / params.VotePeriod)
+	if votePeriodsPerWindow == 0 {
+		votePeriodsPerWindow = 1
+	}
+
+	// the infraction happened over the window, the slashed tokens are those
+	// bonded at the last height the validator set was updated
+	distributionHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+
+	var operators []sdk.ValAddress
+	k.IterateMissCounters(ctx, func(operator sdk.ValAddress, missCounter uint64) bool {
+		operators = append(operators, operator)
+
+		validRate := sdk.OneDec().Sub(sdk.NewDec(int64(missCounter)).QuoInt64(votePeriodsPerWindow))
+		if validRate.GTE(params.MinValidPerWindow) {
+			return false
+		}
+
+		validator := k.stakingKeeper.Validator(ctx, operator)
+		if validator == nil || !validator.IsBonded() || validator.IsJailed() {
+			return false
+		}
+
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			panic(err)
+		}
+
+		power := validator.GetConsensusPower()
+		k.stakingKeeper.Slash(ctx, consAddr, distributionHeight, power, params.SlashFraction)
+		k.stakingKeeper.Jail(ctx, consAddr)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSlash,
+				sdk.NewAttribute(types.AttributeKeyVoter, operator.String()),
+				sdk.NewAttribute(types.AttributeKeyMissCounter, strconv.FormatUint(missCounter, 10)),
+				sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(power, 10)),
+			),
+		)
+		return false
+	})
+
+	for _, operator := range operators {
+		k.DeleteMissCounter(ctx, operator)
+	}
+}
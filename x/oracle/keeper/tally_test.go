@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/x/oracle"
+	"github.com/gauss/gauss/v4/x/oracle/keeper"
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+func TestTally(t *testing.T) {
+	app, ctx, valAddrs := setupOracleTest(t, 10)
+	msgServer := keeper.NewMsgServerImpl(app.OracleKeeper)
+
+	rates := []sdk.DecCoins{
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomETH, sdk.NewDecWithPrec(11, 1)), sdk.NewDecCoinFromDec(denomUSDG, sdk.NewDec(2))),
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomETH, sdk.NewDecWithPrec(11, 1)), sdk.NewDecCoinFromDec(denomUSDG, sdk.NewDec(2))),
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomETH, sdk.NewDecWithPrec(12, 1))),
+	}
+
+	ctx = ctx.WithBlockHeight(3)
+	for i, valAddr := range valAddrs {
+		_, err := msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(ctx), types.NewMsgAggregateExchangeRatePrevote(
+			types.GetAggregateVoteHash(salt, rates[i], valAddr), sdk.AccAddress(valAddr), valAddr))
+		require.NoError(t, err)
+	}
+
+	ctx = ctx.WithBlockHeight(7)
+	for i, valAddr := range valAddrs {
+		_, err := msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(ctx),
+			types.NewMsgAggregateExchangeRateVote(salt, rates[i], sdk.AccAddress(valAddr), valAddr))
+		require.NoError(t, err)
+	}
+
+	// the votes are tallied at the end of the voting period only
+	ctx = ctx.WithBlockHeight(8)
+	oracle.EndBlocker(ctx, app.OracleKeeper)
+	_, err := app.OracleKeeper.GetExchangeRate(ctx, denomETH)
+	require.ErrorIs(t, err, types.ErrNoExchangeRate)
+
+	// the ueth rate is the weighted median of 1.1 by 10 + 20 and 1.2 by 30, the
+	// uusdg rate is voted by half of the bonded power, reaching the threshold
+	ctx = ctx.WithBlockHeight(9).WithEventManager(sdk.NewEventManager())
+	oracle.EndBlocker(ctx, app.OracleKeeper)
+
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, denomETH)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(11, 1), rate)
+	rate, err = app.OracleKeeper.GetExchangeRate(ctx, denomUSDG)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2), rate)
+	require.Equal(t, []string{denomETH, denomUSDG}, updateEvents(ctx))
+
+	// the third validator voted out of the reward band and not on uusdg
+	require.Equal(t, uint64(0), app.OracleKeeper.GetMissCounter(ctx, valAddrs[0]))
+	require.Equal(t, uint64(0), app.OracleKeeper.GetMissCounter(ctx, valAddrs[1]))
+	require.Equal(t, uint64(1), app.OracleKeeper.GetMissCounter(ctx, valAddrs[2]))
+
+	_, found := app.OracleKeeper.GetAggregateExchangeRateVote(ctx, valAddrs[0])
+	require.False(t, found)
+
+	querier := keeper.Querier{Keeper: app.OracleKeeper}
+	res, err := querier.ExchangeRate(sdk.WrapSDKContext(ctx), &types.QueryExchangeRateRequest{Denom: denomETH})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(11, 1), res.ExchangeRate)
+
+	ratesRes, err := querier.ExchangeRates(sdk.WrapSDKContext(ctx), &types.QueryExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(rates[0]...), ratesRes.ExchangeRates)
+
+	// without votes the exchange rates are cleared and every validator misses
+	ctx = ctx.WithBlockHeight(14)
+	oracle.EndBlocker(ctx, app.OracleKeeper)
+
+	_, err = querier.ExchangeRate(sdk.WrapSDKContext(ctx), &types.QueryExchangeRateRequest{Denom: denomETH})
+	require.Error(t, err)
+	require.Equal(t, uint64(1), app.OracleKeeper.GetMissCounter(ctx, valAddrs[0]))
+	require.Equal(t, uint64(2), app.OracleKeeper.GetMissCounter(ctx, valAddrs[2]))
+}
+
+func TestTallyBelowThreshold(t *testing.T) {
+	app, ctx, valAddrs := setupOracleTest(t, 10)
+
+	// the first validator alone holds a sixth of the bonded power
+	rates := sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomETH, sdk.OneDec()))
+	app.OracleKeeper.SetAggregateExchangeRateVote(ctx, types.NewAggregateExchangeRateVote(rates, valAddrs[0]))
+	app.OracleKeeper.SetExchangeRate(ctx, denomETH, sdk.NewDec(3))
+
+	ctx = ctx.WithBlockHeight(4)
+	oracle.EndBlocker(ctx, app.OracleKeeper)
+
+	_, err := app.OracleKeeper.GetExchangeRate(ctx, denomETH)
+	require.ErrorIs(t, err, types.ErrNoExchangeRate)
+	require.Equal(t, uint64(1), app.OracleKeeper.GetMissCounter(ctx, valAddrs[0]))
+}
+
+func TestSlashAndResetMissCounters(t *testing.T) {
+	app, ctx, valAddrs := setupOracleTest(t, 10)
+
+	params := app.OracleKeeper.GetParams(ctx)
+	params.SlashWindow = 10
+	params.MinValidPerWindow = sdk.NewDecWithPrec(5, 1)
+	params.SlashFraction = sdk.NewDecWithPrec(1, 1)
+	app.OracleKeeper.SetParams(ctx, params)
+
+	// the first validator voted validly in none of the two voting periods of
+	// the window, the second in half of them
+	app.OracleKeeper.SetMissCounter(ctx, valAddrs[0], 2)
+	app.OracleKeeper.SetMissCounter(ctx, valAddrs[1], 1)
+
+	ctx = ctx.WithBlockHeight(19)
+	app.OracleKeeper.SlashAndResetMissCounters(ctx)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.True(t, validator.IsJailed())
+	require.Equal(t, sdk.TokensFromConsensusPower(9), validator.GetTokens())
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddrs[1])
+	require.True(t, found)
+	require.False(t, validator.IsJailed())
+	require.Equal(t, sdk.TokensFromConsensusPower(20), validator.GetTokens())
+
+	for _, valAddr := range valAddrs {
+		require.Equal(t, uint64(0), app.OracleKeeper.GetMissCounter(ctx, valAddr))
+	}
+}
+
+// updateEvents returns the denoms of the exchange rates updated in the context
+func updateEvents(ctx sdk.Context) []string {
+	var denoms []string
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != types.EventTypeExchangeRateUpdate {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyDenom {
+				denoms = append(denoms, string(attr.Value))
+			}
+		}
+	}
+
+	return denoms
+}
@@ -0,0 +1,234 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+// GetFeederDelegation returns the account voting for a validator, the account
+// of its operator unless it delegated its votes
+func (k Keeper) GetFeederDelegation(ctx sdk.Context, operator sdk.ValAddress) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetFeederDelegationKey(operator))
+	if value == nil {
+		return sdk.AccAddress(operator)
+	}
+
+	return sdk.AccAddress(value)
+}
+
+// SetFeederDelegation sets the account voting for a validator
+func (k Keeper) SetFeederDelegation(ctx sdk.Context, operator sdk.ValAddress, feeder sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFeederDelegationKey(operator), feeder.Bytes())
+}
+
+// IterateFeederDelegations iterates through the feeder delegations by validator
+func (k Keeper) IterateFeederDelegations(ctx sdk.Context, fn func(operator sdk.ValAddress, feeder sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.FeederDelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		operator := sdk.ValAddress(iterator.Key()[len(types.FeederDelegationKey):])
+		if fn(operator, sdk.AccAddress(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// ValidateFeeder checks that a feeder may vote for a validator, which must be bonded
+func (k Keeper) ValidateFeeder(ctx sdk.Context, feeder sdk.AccAddress, operator sdk.ValAddress) error {
+	if validator := k.stakingKeeper.Validator(ctx, operator); validator == nil || !validator.IsBonded() {
+		return sdkerrors.Wrap(types.ErrNotValidator, operator.String())
+	}
+
+	if delegate := k.GetFeederDelegation(ctx, operator); !delegate.Equals(feeder) {
+		return sdkerrors.Wrapf(types.ErrNoVotingPermission, "%s is not the feeder of %s", feeder, operator)
+	}
+
+	return nil
+}
+
+// GetMissCounter returns the number of voting periods a validator missed in
+// the current slashing window
+func (k Keeper) GetMissCounter(ctx sdk.Context, operator sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetMissCounterKey(operator))
+	if value == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(value)
+}
+
+// SetMissCounter sets the miss counter of a validator
+func (k Keeper) SetMissCounter(ctx sdk.Context, operator sdk.ValAddress, missCounter uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMissCounterKey(operator), sdk.Uint64ToBigEndian(missCounter))
+}
+
+// DeleteMissCounter deletes the miss counter of a validator
+func (k Keeper) DeleteMissCounter(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetMissCounterKey(operator))
+}
+
+// IterateMissCounters iterates through the miss counters by validator
+func (k Keeper) IterateMissCounters(ctx sdk.Context, fn func(operator sdk.ValAddress, missCounter uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.MissCounterKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		operator := sdk.ValAddress(iterator.Key()[len(types.MissCounterKey):])
+		if fn(operator, sdk.BigEndianToUint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAggregateExchangeRatePrevote returns the pending prevote of a validator
+func (k Keeper) GetAggregateExchangeRatePrevote(
+	ctx sdk.Context, voter sdk.ValAddress,
+) (prevote types.AggregateExchangeRatePrevote, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetAggregatePrevoteKey(voter))
+	if value == nil {
+		return prevote, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &prevote)
+	return prevote, true
+}
+
+// SetAggregateExchangeRatePrevote sets the prevote of a validator
+func (k Keeper) SetAggregateExchangeRatePrevote(ctx sdk.Context, prevote types.AggregateExchangeRatePrevote) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAggregatePrevoteKey(prevote.GetVoter()), k.cdc.MustMarshalBinaryBare(&prevote))
+}
+
+// DeleteAggregateExchangeRatePrevote deletes the prevote of a validator
+func (k Keeper) DeleteAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAggregatePrevoteKey(voter))
+}
+
+// IterateAggregateExchangeRatePrevotes iterates through the prevotes by validator
+func (k Keeper) IterateAggregateExchangeRatePrevotes(
+	ctx sdk.Context, fn func(prevote types.AggregateExchangeRatePrevote) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.AggregatePrevoteKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var prevote types.AggregateExchangeRatePrevote
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &prevote)
+
+		if fn(prevote) {
+			break
+		}
+	}
+}
+
+// GetAggregateExchangeRateVote returns the vote of a validator in the current voting period
+func (k Keeper) GetAggregateExchangeRateVote(
+	ctx sdk.Context, voter sdk.ValAddress,
+) (vote types.AggregateExchangeRateVote, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetAggregateVoteKey(voter))
+	if value == nil {
+		return vote, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &vote)
+	return vote, true
+}
+
+// SetAggregateExchangeRateVote sets the vote of a validator
+func (k Keeper) SetAggregateExchangeRateVote(ctx sdk.Context, vote types.AggregateExchangeRateVote) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAggregateVoteKey(vote.GetVoter()), k.cdc.MustMarshalBinaryBare(&vote))
+}
+
+// DeleteAggregateExchangeRateVote deletes the vote of a validator
+func (k Keeper) DeleteAggregateExchangeRateVote(ctx sdk.Context, voter sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAggregateVoteKey(voter))
+}
+
+// IterateAggregateExchangeRateVotes iterates through the votes by validator
+func (k Keeper) IterateAggregateExchangeRateVotes(
+	ctx sdk.Context, fn func(vote types.AggregateExchangeRateVote) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.AggregateVoteKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.AggregateExchangeRateVote
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+
+		if fn(vote) {
+			break
+		}
+	}
+}
+
+// Prevote records the hash of the exchange rates a validator commits to,
+// replacing its pending prevote
+func (k Keeper) Prevote(ctx sdk.Context, feeder sdk.AccAddress, voter sdk.ValAddress, hash string) error {
+	if err := k.ValidateFeeder(ctx, feeder, voter); err != nil {
+		return err
+	}
+
+	k.SetAggregateExchangeRatePrevote(ctx, types.NewAggregateExchangeRatePrevote(hash, voter, ctx.BlockHeight()))
+	return nil
+}
+
+// Vote reveals the exchange rates a validator committed to in its prevote of
+// the previous voting period
+func (k Keeper) Vote(
+	ctx sdk.Context, feeder sdk.AccAddress, voter sdk.ValAddress, salt string, exchangeRates sdk.DecCoins,
+) error {
+	if err := k.ValidateFeeder(ctx, feeder, voter); err != nil {
+		return err
+	}
+
+	prevote, found := k.GetAggregateExchangeRatePrevote(ctx, voter)
+	if !found {
+		return sdkerrors.Wrap(types.ErrNoPrevote, voter.String())
+	}
+
+	// the rates must be revealed in the voting period following their prevote
+	votePeriod := int64(k.VotePeriod(ctx))
+	if prevote.SubmitBlock/votePeriod != ctx.BlockHeight()/votePeriod-1 {
+		return sdkerrors.Wrapf(types.ErrNoPrevote, "prevote of %s submitted at %d is not in the previous voting period",
+			voter, prevote.SubmitBlock)
+	}
+
+	params := k.GetParams(ctx)
+	for _, rate := range exchangeRates {
+		if !params.IsWhitelisted(rate.Denom) {
+			return sdkerrors.Wrap(types.ErrUnknownDenom, rate.Denom)
+		}
+	}
+
+	if hash := types.GetAggregateVoteHash(salt, exchangeRates, voter); hash != prevote.Hash {
+		return sdkerrors.Wrapf(types.ErrVerificationFailed, "expected %s, got %s", prevote.Hash, hash)
+	}
+
+	k.DeleteAggregateExchangeRatePrevote(ctx, voter)
+	k.SetAggregateExchangeRateVote(ctx, types.NewAggregateExchangeRateVote(exchangeRates, voter))
+	return nil
+}
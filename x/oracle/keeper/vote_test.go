@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/oracle/keeper"
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+const (
+	denomETH  = "ueth"
+	denomUSDG = "uusdg"
+	salt      = "1234"
+)
+
+func TestPrevoteVote(t *testing.T) {
+	app, ctx, valAddrs := setupOracleTest(t, 10)
+	msgServer := keeper.NewMsgServerImpl(app.OracleKeeper)
+	operator := sdk.AccAddress(valAddrs[0])
+
+	rates := sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomETH, sdk.NewDecWithPrec(15, 1)))
+	hash := types.GetAggregateVoteHash(salt, rates, valAddrs[0])
+
+	prevote := func(ctx sdk.Context, feeder sdk.AccAddress, validator sdk.ValAddress) error {
+		_, err := msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(ctx),
+			types.NewMsgAggregateExchangeRatePrevote(hash, feeder, validator))
+		return err
+	}
+	vote := func(ctx sdk.Context, salt string, rates sdk.DecCoins) error {
+		_, err := msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(ctx),
+			types.NewMsgAggregateExchangeRateVote(salt, rates, operator, valAddrs[0]))
+		return err
+	}
+
+	// only a bonded validator or its feeder may vote
+	ctx = ctx.WithBlockHeight(3)
+	require.ErrorIs(t, prevote(ctx, operator, sdk.ValAddress(simapp.CreateTestPubKeys(4)[3].Address())), types.ErrNotValidator)
+	require.ErrorIs(t, prevote(ctx, sdk.AccAddress(valAddrs[1]), valAddrs[0]), types.ErrNoVotingPermission)
+	require.NoError(t, prevote(ctx, operator, valAddrs[0]))
+
+	// the prevote is revealed in the next voting period
+	ctx = ctx.WithBlockHeight(4)
+	require.ErrorIs(t, vote(ctx, salt, rates), types.ErrNoPrevote)
+
+	ctx = ctx.WithBlockHeight(5)
+	require.ErrorIs(t, vote(ctx, "4321", rates), types.ErrVerificationFailed)
+	require.ErrorIs(t, vote(ctx, salt, sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.OneDec()))), types.ErrUnknownDenom)
+	require.NoError(t, vote(ctx, salt, rates))
+
+	_, found := app.OracleKeeper.GetAggregateExchangeRatePrevote(ctx, valAddrs[0])
+	require.False(t, found)
+	aggregateVote, found := app.OracleKeeper.GetAggregateExchangeRateVote(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, rates, aggregateVote.ExchangeRates)
+
+	// a prevote cannot be revealed twice
+	require.ErrorIs(t, vote(ctx, salt, rates), types.ErrNoPrevote)
+
+	// nor after the voting period following its own
+	ctx = ctx.WithBlockHeight(6)
+	require.NoError(t, prevote(ctx, operator, valAddrs[0]))
+	ctx = ctx.WithBlockHeight(15)
+	require.ErrorIs(t, vote(ctx, salt, rates), types.ErrNoPrevote)
+}
+
+func TestDelegateFeedConsent(t *testing.T) {
+	app, ctx, valAddrs := setupOracleTest(t, 10)
+	msgServer := keeper.NewMsgServerImpl(app.OracleKeeper)
+
+	feeder := sdk.AccAddress(simapp.CreateTestPubKeys(4)[3].Address())
+	require.Equal(t, sdk.AccAddress(valAddrs[0]), app.OracleKeeper.GetFeederDelegation(ctx, valAddrs[0]))
+
+	_, err := msgServer.DelegateFeedConsent(sdk.WrapSDKContext(ctx), types.NewMsgDelegateFeedConsent(valAddrs[0], feeder))
+	require.NoError(t, err)
+	require.Equal(t, feeder, app.OracleKeeper.GetFeederDelegation(ctx, valAddrs[0]))
+
+	// the feeder votes in place of the operator
+	require.NoError(t, app.OracleKeeper.ValidateFeeder(ctx, feeder, valAddrs[0]))
+	require.ErrorIs(t, app.OracleKeeper.ValidateFeeder(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0]), types.ErrNoVotingPermission)
+
+	querier := keeper.Querier{Keeper: app.OracleKeeper}
+	res, err := querier.FeederDelegation(sdk.WrapSDKContext(ctx),
+		&types.QueryFeederDelegationRequest{ValidatorAddr: valAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, feeder.String(), res.FeederAddr)
+}
+
+// setupOracleTest creates three bonded validators of the consensus powers
+// power, 2*power and 3*power, voting on the ueth and uusdg exchange rates
+func setupOracleTest(t *testing.T, power int64) (*simapp.SimApp, sdk.Context, []sdk.ValAddress) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := types.DefaultParams()
+	params.Whitelist = []string{denomETH, denomUSDG}
+	app.OracleKeeper.SetParams(ctx, params)
+
+	pubKeys := simapp.CreateTestPubKeys(3)
+	valAddrs := make([]sdk.ValAddress, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		addr := sdk.AccAddress(pubKey.Address())
+		valAddrs[i] = sdk.ValAddress(addr)
+
+		tokens := sdk.TokensFromConsensusPower(power * int64(i+1))
+		simapp.AddTestAddrsFromPubKeys(app, ctx, pubKeys[i:i+1], tokens)
+		require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, addr, stakingtypes.NotBondedPoolName,
+			sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), tokens))))
+
+		validator, err := stakingtypes.NewValidator(valAddrs[i], pubKey, stakingtypes.Description{})
+		require.NoError(t, err)
+		validator, _ = validator.AddTokensFromDel(tokens)
+
+		app.StakingKeeper.SetValidator(ctx, validator)
+		require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+		app.StakingKeeper.AfterValidatorCreated(ctx, valAddrs[i])
+		validator = stakingkeeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+		require.True(t, validator.IsBonded())
+	}
+
+	return app, ctx, valAddrs
+}
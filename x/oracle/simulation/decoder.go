@@ -0,0 +1,47 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding oracle type.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.ExchangeRateKey):
+			var rateA, rateB sdk.DecProto
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &rateA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &rateB)
+
+			return fmt.Sprintf("%v\n%v", rateA, rateB)
+		case bytes.Equal(kvA.Key[:1], types.FeederDelegationKey):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.MissCounterKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.AggregatePrevoteKey):
+			var prevoteA, prevoteB types.AggregateExchangeRatePrevote
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &prevoteA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &prevoteB)
+
+			return fmt.Sprintf("%v\n%v", prevoteA, prevoteB)
+		case bytes.Equal(kvA.Key[:1], types.AggregateVoteKey):
+			var voteA, voteB types.AggregateExchangeRateVote
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &voteA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &voteB)
+
+			return fmt.Sprintf("%v\n%v", voteA, voteB)
+		default:
+			panic(fmt.Sprintf("invalid oracle key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/oracle/simulation"
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+var (
+	valPk1   = ed25519.GenPrivKey().PubKey()
+	valAddr1 = sdk.ValAddress(valPk1.Address())
+	feeder1  = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc, _ := simapp.MakeCodecs()
+	dec := simulation.NewDecodeStore(cdc)
+
+	rate := sdk.DecProto{Dec: sdk.NewDecWithPrec(15, 1)}
+	rates := sdk.NewDecCoins(sdk.NewDecCoinFromDec("ueth", rate.Dec))
+	prevote := types.NewAggregateExchangeRatePrevote(types.GetAggregateVoteHash("1234", rates, valAddr1), valAddr1, 3)
+	vote := types.NewAggregateExchangeRateVote(rates, valAddr1)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetExchangeRateKey("ueth"), Value: cdc.MustMarshalBinaryBare(&rate)},
+			{Key: types.GetFeederDelegationKey(valAddr1), Value: feeder1.Bytes()},
+			{Key: types.GetMissCounterKey(valAddr1), Value: sdk.Uint64ToBigEndian(2)},
+			{Key: types.GetAggregatePrevoteKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&prevote)},
+			{Key: types.GetAggregateVoteKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&vote)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"exchangeRate", fmt.Sprintf("%v\n%v", rate, rate)},
+		{"feederDelegation", fmt.Sprintf("%v\n%v", feeder1, feeder1)},
+		{"missCounter", "2\n2"},
+		{"aggregatePrevote", fmt.Sprintf("%v\n%v", prevote, prevote)},
+		{"aggregateVote", fmt.Sprintf("%v\n%v", vote, vote)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
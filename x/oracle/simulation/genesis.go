@@ -0,0 +1,126 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+// Simulation parameter constants
+const (
+	votePeriod        = "vote_period"
+	voteThreshold     = "vote_threshold"
+	rewardBand        = "reward_band"
+	whitelist         = "whitelist"
+	slashFraction     = "slash_fraction"
+	slashWindow       = "slash_window"
+	minValidPerWindow = "min_valid_per_window"
+)
+
+// simDenoms are the denoms the whitelist is drawn from
+var simDenoms = []string{"ubtc", "ueth", "uusdg"}
+
+// GenVotePeriod randomized votePeriod
+func GenVotePeriod(r *rand.Rand) uint64 {
+	return uint64(1 + r.Intn(10))
+}
+
+// GenVoteThreshold randomized voteThreshold
+func GenVoteThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(34+int64(r.Intn(67)), 2)
+}
+
+// GenRewardBand randomized rewardBand, up to 10%
+func GenRewardBand(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(11)), 2)
+}
+
+// GenWhitelist randomized whitelist
+func GenWhitelist(r *rand.Rand) []string {
+	denoms := []string{}
+	for _, denom := range simDenoms {
+		if r.Intn(2) == 0 {
+			denoms = append(denoms, denom)
+		}
+	}
+	return denoms
+}
+
+// GenSlashFraction randomized slashFraction, up to 1%
+func GenSlashFraction(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 4)
+}
+
+// GenSlashWindow randomized slashWindow, a multiple of the vote period
+func GenSlashWindow(r *rand.Rand, votePeriod uint64) uint64 {
+	return votePeriod * uint64(10+r.Intn(91))
+}
+
+// RandomizedGenState generates a random GenesisState for oracle
+func RandomizedGenState(simState *module.SimulationState) {
+	// params
+	var (
+		votePeriodL        uint64
+		voteThresholdL     sdk.Dec
+		rewardBandL        sdk.Dec
+		whitelistL         []string
+		slashFractionL     sdk.Dec
+		slashWindowL       uint64
+		minValidPerWindowL sdk.Dec
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, votePeriod, &votePeriodL, simState.Rand,
+		func(r *rand.Rand) { votePeriodL = GenVotePeriod(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, voteThreshold, &voteThresholdL, simState.Rand,
+		func(r *rand.Rand) { voteThresholdL = GenVoteThreshold(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, rewardBand, &rewardBandL, simState.Rand,
+		func(r *rand.Rand) { rewardBandL = GenRewardBand(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, whitelist, &whitelistL, simState.Rand,
+		func(r *rand.Rand) { whitelistL = GenWhitelist(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, slashFraction, &slashFractionL, simState.Rand,
+		func(r *rand.Rand) { slashFractionL = GenSlashFraction(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, slashWindow, &slashWindowL, simState.Rand,
+		func(r *rand.Rand) { slashWindowL = GenSlashWindow(r, votePeriodL) },
+	)
+
+	// most of the simulated validators never vote, so none of them is slashed
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, minValidPerWindow, &minValidPerWindowL, simState.Rand,
+		func(r *rand.Rand) { minValidPerWindowL = sdk.ZeroDec() },
+	)
+
+	params := types.NewParams(votePeriodL, voteThresholdL, rewardBandL, whitelistL,
+		slashFractionL, slashWindowL, minValidPerWindowL)
+
+	oracleGenesis := types.NewGenesisState(params, []types.FeederDelegation{}, sdk.DecCoins{}, []types.MissCounter{},
+		[]types.AggregateExchangeRatePrevote{}, []types.AggregateExchangeRateVote{})
+
+	bz, err := json.MarshalIndent(&oracleGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated oracle parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(oracleGenesis)
+}
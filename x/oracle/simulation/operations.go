@@ -0,0 +1,237 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	gaussimappparams "github.com/gauss/gauss/v4/simapp/params"
+	"github.com/gauss/gauss/v4/x/oracle/keeper"
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgAggregateExchangeRatePrevote = "op_weight_msg_aggregate_exchange_rate_prevote"
+	OpWeightMsgAggregateExchangeRateVote    = "op_weight_msg_aggregate_exchange_rate_vote"
+	OpWeightMsgDelegateFeedConsent          = "op_weight_msg_delegate_feed_consent"
+)
+
+// simVote is the salt and the exchange rates a validator committed to in its
+// last simulated prevote
+type simVote struct {
+	salt          string
+	exchangeRates sdk.DecCoins
+}
+
+// simVotes holds the simulated prevotes by validator until they are revealed
+var simVotes = make(map[string]simVote)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler, ak types.AccountKeeper,
+	bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgAggregateExchangeRatePrevote int
+		weightMsgAggregateExchangeRateVote    int
+		weightMsgDelegateFeedConsent          int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAggregateExchangeRatePrevote, &weightMsgAggregateExchangeRatePrevote, nil,
+		func(_ *rand.Rand) {
+			weightMsgAggregateExchangeRatePrevote = gaussimappparams.DefaultWeightMsgAggregateExchangeRatePrevote
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAggregateExchangeRateVote, &weightMsgAggregateExchangeRateVote, nil,
+		func(_ *rand.Rand) {
+			weightMsgAggregateExchangeRateVote = gaussimappparams.DefaultWeightMsgAggregateExchangeRateVote
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgDelegateFeedConsent, &weightMsgDelegateFeedConsent, nil,
+		func(_ *rand.Rand) {
+			weightMsgDelegateFeedConsent = gaussimappparams.DefaultWeightMsgDelegateFeedConsent
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgAggregateExchangeRatePrevote,
+			SimulateMsgAggregateExchangeRatePrevote(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAggregateExchangeRateVote,
+			SimulateMsgAggregateExchangeRateVote(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDelegateFeedConsent,
+			SimulateMsgDelegateFeedConsent(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgAggregateExchangeRatePrevote generates a MsgAggregateExchangeRatePrevote
+// with random exchange rates
+func SimulateMsgAggregateExchangeRatePrevote(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		voter, feeder, found := randomVoter(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRatePrevote, "no bonded validator"), nil, nil
+		}
+
+		// the rates are spread around 1 so that some of them fall out of the reward band
+		exchangeRates := sdk.DecCoins{}
+		for _, denom := range k.Whitelist(ctx) {
+			rate := sdk.OneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(201)-100), 3))
+			exchangeRates = append(exchangeRates, sdk.NewDecCoinFromDec(denom, rate))
+		}
+		exchangeRates = exchangeRates.Sort()
+		if len(exchangeRates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRatePrevote, "no whitelisted denom"), nil, nil
+		}
+
+		salt := simtypes.RandStringOfLength(r, 1+r.Intn(types.MaxSaltLength))
+		hash := types.GetAggregateVoteHash(salt, exchangeRates, voter)
+
+		msg := types.NewMsgAggregateExchangeRatePrevote(hash, feeder.Address, voter)
+
+		opMsg, fops, err := deliverMsg(r, app, ctx, ak, bk, feeder, msg, chainID)
+		if err == nil {
+			simVotes[voter.String()] = simVote{salt: salt, exchangeRates: exchangeRates}
+		}
+		return opMsg, fops, err
+	}
+}
+
+// SimulateMsgAggregateExchangeRateVote generates a MsgAggregateExchangeRateVote
+// revealing a simulated prevote of the previous voting period
+func SimulateMsgAggregateExchangeRateVote(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// the prevotes of the previous voting period can be revealed
+		var prevotes []types.AggregateExchangeRatePrevote
+		votePeriod := int64(k.VotePeriod(ctx))
+		k.IterateAggregateExchangeRatePrevotes(ctx, func(prevote types.AggregateExchangeRatePrevote) bool {
+			if prevote.SubmitBlock/votePeriod == ctx.BlockHeight()/votePeriod-1 {
+				prevotes = append(prevotes, prevote)
+			}
+			return false
+		})
+		if len(prevotes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRateVote, "no prevote to reveal"), nil, nil
+		}
+
+		prevote := prevotes[r.Intn(len(prevotes))]
+		voter := prevote.GetVoter()
+
+		feeder, found := simtypes.FindAccount(accs, k.GetFeederDelegation(ctx, voter))
+		if !found || k.ValidateFeeder(ctx, feeder.Address, voter) != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRateVote, "no bonded validator"), nil, nil
+		}
+
+		vote, found := simVotes[voter.String()]
+		if !found || types.GetAggregateVoteHash(vote.salt, vote.exchangeRates, voter) != prevote.Hash {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRateVote, "prevote not simulated"), nil, nil
+		}
+
+		// the whitelist may have changed since the prevote
+		for _, rate := range vote.exchangeRates {
+			if !k.GetParams(ctx).IsWhitelisted(rate.Denom) {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAggregateExchangeRateVote, "denom not whitelisted"), nil, nil
+			}
+		}
+
+		msg := types.NewMsgAggregateExchangeRateVote(vote.salt, vote.exchangeRates, feeder.Address, voter)
+
+		return deliverMsg(r, app, ctx, ak, bk, feeder, msg, chainID)
+	}
+}
+
+// SimulateMsgDelegateFeedConsent generates a MsgDelegateFeedConsent with a random feeder
+func SimulateMsgDelegateFeedConsent(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// only the bonded validators are known not to have been removed
+		voter, _, found := randomVoter(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegateFeedConsent, "no bonded validator"), nil, nil
+		}
+
+		operator, found := simtypes.FindAccount(accs, sdk.AccAddress(voter))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegateFeedConsent, "operator not found"), nil, nil
+		}
+
+		delegate, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgDelegateFeedConsent(voter, delegate.Address)
+
+		return deliverMsg(r, app, ctx, ak, bk, operator, msg, chainID)
+	}
+}
+
+// randomVoter returns a random bonded validator among the simulated accounts
+// and the account of its feeder
+func randomVoter(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account,
+) (sdk.ValAddress, simtypes.Account, bool) {
+	for _, i := range r.Perm(len(accs)) {
+		voter := sdk.ValAddress(accs[i].Address)
+
+		feeder, found := simtypes.FindAccount(accs, k.GetFeederDelegation(ctx, voter))
+		if found && k.ValidateFeeder(ctx, feeder.Address, voter) == nil {
+			return voter, feeder, true
+		}
+	}
+
+	return nil, simtypes.Account{}, false
+}
+
+func deliverMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	simAccount simtypes.Account, msg sdk.Msg, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := ak.GetAccount(ctx, simAccount.Address)
+	if account == nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "account not found"), nil, nil
+	}
+
+	spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+	fees, err := simtypes.RandomFees(r, ctx, spendable)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
@@ -0,0 +1,30 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyVoteThreshold),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenVoteThreshold(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRewardBand),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenRewardBand(r))
+			},
+		),
+	}
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/oracle module sentinel errors
+var (
+	ErrInvalidExchangeRate = sdkerrors.Register(ModuleName, 2, "invalid exchange rate")
+	ErrUnknownDenom        = sdkerrors.Register(ModuleName, 3, "denom is not whitelisted")
+	ErrInvalidHash         = sdkerrors.Register(ModuleName, 4, "invalid vote hash")
+	ErrVerificationFailed  = sdkerrors.Register(ModuleName, 5, "vote does not match the prevote hash")
+	ErrNoPrevote           = sdkerrors.Register(ModuleName, 6, "no prevote in the previous voting period")
+	ErrNoVote              = sdkerrors.Register(ModuleName, 7, "no vote")
+	ErrNotValidator        = sdkerrors.Register(ModuleName, 8, "not a bonded validator")
+	ErrNoVotingPermission  = sdkerrors.Register(ModuleName, 9, "feeder has no voting permission for the validator")
+	ErrNoExchangeRate      = sdkerrors.Register(ModuleName, 10, "no exchange rate for the denom")
+)
@@ -0,0 +1,20 @@
+package types
+
+const (
+	AttributeValueCategory = ModuleName
+
+	EventTypeAggregatePrevote   = "aggregate_prevote"
+	EventTypeAggregateVote      = "aggregate_vote"
+	EventTypeFeedDelegate       = "feed_delegate"
+	EventTypeExchangeRateUpdate = "exchange_rate_update"
+	EventTypeSlash              = "oracle_slash"
+
+	AttributeKeyVoter         = "voter"
+	AttributeKeyFeeder        = "feeder"
+	AttributeKeyOperator      = "operator"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyExchangeRate  = "exchange_rate"
+	AttributeKeyExchangeRates = "exchange_rates"
+	AttributeKeyMissCounter   = "miss_counter"
+	AttributeKeyPower         = "power"
+)
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(
+	params Params, feederDelegations []FeederDelegation, exchangeRates sdk.DecCoins, missCounters []MissCounter,
+	prevotes []AggregateExchangeRatePrevote, votes []AggregateExchangeRateVote,
+) *GenesisState {
+	return &GenesisState{
+		Params:                        params,
+		FeederDelegations:             feederDelegations,
+		ExchangeRates:                 exchangeRates,
+		MissCounters:                  missCounters,
+		AggregateExchangeRatePrevotes: prevotes,
+		AggregateExchangeRateVotes:    votes,
+	}
+}
+
+// DefaultGenesisState returns a default oracle module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []FeederDelegation{}, sdk.DecCoins{}, []MissCounter{},
+		[]AggregateExchangeRatePrevote{}, []AggregateExchangeRateVote{})
+}
+
+// Validate performs basic validation of the oracle genesis state
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, delegation := range gs.FeederDelegations {
+		if _, err := sdk.AccAddressFromBech32(delegation.FeederAddress); err != nil {
+			return err
+		}
+		if _, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateExchangeRates(gs.ExchangeRates); err != nil {
+		return err
+	}
+
+	for _, counter := range gs.MissCounters {
+		if _, err := sdk.ValAddressFromBech32(counter.ValidatorAddress); err != nil {
+			return err
+		}
+	}
+
+	voters := make(map[string]bool)
+	for _, prevote := range gs.AggregateExchangeRatePrevotes {
+		if err := prevote.Validate(); err != nil {
+			return err
+		}
+		if voters[prevote.Voter] {
+			return fmt.Errorf("duplicate prevote of %s", prevote.Voter)
+		}
+		voters[prevote.Voter] = true
+	}
+
+	voters = make(map[string]bool)
+	for _, vote := range gs.AggregateExchangeRateVotes {
+		if err := vote.Validate(); err != nil {
+			return err
+		}
+		if voters[vote.Voter] {
+			return fmt.Errorf("duplicate vote of %s", vote.Voter)
+		}
+		voters[vote.Voter] = true
+	}
+
+	return nil
+}
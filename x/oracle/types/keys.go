@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "oracle"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace default name for parameter store
+	DefaultParamspace = ModuleName
+)
+
+var (
+	ExchangeRateKey     = []byte{0x11} // prefix for each key to the exchange rate of a denom
+	FeederDelegationKey = []byte{0x21} // prefix for each key to the feeder of a validator
+	MissCounterKey      = []byte{0x22} // prefix for each key to the miss counter of a validator
+	AggregatePrevoteKey = []byte{0x23} // prefix for each key to the prevote of a validator
+	AggregateVoteKey    = []byte{0x24} // prefix for each key to the vote of a validator
+)
+
+// GetExchangeRateKey returns the key of the exchange rate of a denom
+// VALUE: sdk.DecProto
+func GetExchangeRateKey(denom string) []byte {
+	return append(ExchangeRateKey, []byte(denom)...)
+}
+
+// GetFeederDelegationKey returns the key of the feeder of a validator
+// VALUE: sdk.AccAddress
+func GetFeederDelegationKey(valAddr sdk.ValAddress) []byte {
+	return append(FeederDelegationKey, valAddr.Bytes()...)
+}
+
+// GetMissCounterKey returns the key of the miss counter of a validator
+// VALUE: uint64 number of missed voting periods
+func GetMissCounterKey(valAddr sdk.ValAddress) []byte {
+	return append(MissCounterKey, valAddr.Bytes()...)
+}
+
+// GetAggregatePrevoteKey returns the key of the prevote of a validator
+// VALUE: oracle/AggregateExchangeRatePrevote
+func GetAggregatePrevoteKey(valAddr sdk.ValAddress) []byte {
+	return append(AggregatePrevoteKey, valAddr.Bytes()...)
+}
+
+// GetAggregateVoteKey returns the key of the vote of a validator
+// VALUE: oracle/AggregateExchangeRateVote
+func GetAggregateVoteKey(valAddr sdk.ValAddress) []byte {
+	return append(AggregateVoteKey, valAddr.Bytes()...)
+}
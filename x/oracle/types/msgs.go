@@ -0,0 +1,155 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgAggregateExchangeRatePrevote = "aggregate_exchange_rate_prevote"
+	TypeMsgAggregateExchangeRateVote    = "aggregate_exchange_rate_vote"
+	TypeMsgDelegateFeedConsent          = "delegate_feed_consent"
+
+	// MaxSaltLength is the maximum length of the salt of a vote
+	MaxSaltLength = 64
+)
+
+var (
+	_ sdk.Msg = &MsgAggregateExchangeRatePrevote{}
+	_ sdk.Msg = &MsgAggregateExchangeRateVote{}
+	_ sdk.Msg = &MsgDelegateFeedConsent{}
+)
+
+// NewMsgAggregateExchangeRatePrevote creates a new MsgAggregateExchangeRatePrevote instance.
+func NewMsgAggregateExchangeRatePrevote(hash string, feeder sdk.AccAddress, validator sdk.ValAddress) *MsgAggregateExchangeRatePrevote {
+	return &MsgAggregateExchangeRatePrevote{
+		Hash:      hash,
+		Feeder:    feeder.String(),
+		Validator: validator.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgAggregateExchangeRatePrevote) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgAggregateExchangeRatePrevote) Type() string { return TypeMsgAggregateExchangeRatePrevote }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAggregateExchangeRatePrevote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Feeder)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgAggregateExchangeRatePrevote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAggregateExchangeRatePrevote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Feeder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid feeder address (%s)", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.Validator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+
+	return ValidateHash(msg.Hash)
+}
+
+// NewMsgAggregateExchangeRateVote creates a new MsgAggregateExchangeRateVote instance.
+func NewMsgAggregateExchangeRateVote(
+	salt string, exchangeRates sdk.DecCoins, feeder sdk.AccAddress, validator sdk.ValAddress,
+) *MsgAggregateExchangeRateVote {
+	return &MsgAggregateExchangeRateVote{
+		Salt:          salt,
+		ExchangeRates: exchangeRates,
+		Feeder:        feeder.String(),
+		Validator:     validator.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgAggregateExchangeRateVote) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgAggregateExchangeRateVote) Type() string { return TypeMsgAggregateExchangeRateVote }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAggregateExchangeRateVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Feeder)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgAggregateExchangeRateVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAggregateExchangeRateVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Feeder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid feeder address (%s)", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.Validator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+	if len(msg.Salt) == 0 || len(msg.Salt) > MaxSaltLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "salt length should be between [1, %d]", MaxSaltLength)
+	}
+	if len(msg.ExchangeRates) == 0 {
+		return sdkerrors.Wrap(ErrInvalidExchangeRate, "no exchange rate")
+	}
+
+	return ValidateExchangeRates(msg.ExchangeRates)
+}
+
+// NewMsgDelegateFeedConsent creates a new MsgDelegateFeedConsent instance.
+func NewMsgDelegateFeedConsent(operator sdk.ValAddress, delegate sdk.AccAddress) *MsgDelegateFeedConsent {
+	return &MsgDelegateFeedConsent{
+		Operator: operator.String(),
+		Delegate: delegate.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgDelegateFeedConsent) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgDelegateFeedConsent) Type() string { return TypeMsgDelegateFeedConsent }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgDelegateFeedConsent) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(operator)}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgDelegateFeedConsent) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgDelegateFeedConsent) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Delegate); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegate address (%s)", err)
+	}
+
+	return nil
+}
+
+func mustAccAddress(address string) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
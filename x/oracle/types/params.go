@@ -0,0 +1,210 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	// DefaultVotePeriod is the default number of blocks of a voting period, about 30s
+	DefaultVotePeriod uint64 = 5
+	// DefaultVoteThreshold is the default rate of the bonded power needed to update an exchange rate
+	DefaultVoteThreshold = sdk.NewDecWithPrec(50, 2)
+	// DefaultRewardBand is the default band of the valid votes around the weighted median, 2%
+	DefaultRewardBand = sdk.NewDecWithPrec(2, 2)
+	// DefaultSlashFraction is the default fraction slashed for missing votes, 0.01%
+	DefaultSlashFraction = sdk.NewDecWithPrec(1, 4)
+	// DefaultSlashWindow is the default number of blocks of a slashing window, about a week
+	DefaultSlashWindow uint64 = 100800
+	// DefaultMinValidPerWindow is the default rate of valid votes a validator must keep per window
+	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2)
+)
+
+var (
+	KeyVotePeriod        = []byte("VotePeriod")
+	KeyVoteThreshold     = []byte("VoteThreshold")
+	KeyRewardBand        = []byte("RewardBand")
+	KeyWhitelist         = []byte("Whitelist")
+	KeySlashFraction     = []byte("SlashFraction")
+	KeySlashWindow       = []byte("SlashWindow")
+	KeyMinValidPerWindow = []byte("MinValidPerWindow")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable for oracle module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the oracle module
+func NewParams(
+	votePeriod uint64, voteThreshold, rewardBand sdk.Dec, whitelist []string,
+	slashFraction sdk.Dec, slashWindow uint64, minValidPerWindow sdk.Dec,
+) Params {
+	return Params{
+		VotePeriod:        votePeriod,
+		VoteThreshold:     voteThreshold,
+		RewardBand:        rewardBand,
+		Whitelist:         whitelist,
+		SlashFraction:     slashFraction,
+		SlashWindow:       slashWindow,
+		MinValidPerWindow: minValidPerWindow,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the oracle module,
+// no denom is whitelisted until governance adds some
+func DefaultParams() Params {
+	return NewParams(DefaultVotePeriod, DefaultVoteThreshold, DefaultRewardBand, []string{},
+		DefaultSlashFraction, DefaultSlashWindow, DefaultMinValidPerWindow)
+}
+
+// Validate all oracle module parameters
+func (p Params) Validate() error {
+	if err := validateVotePeriod(p.VotePeriod); err != nil {
+		return err
+	}
+	if err := validateVoteThreshold(p.VoteThreshold); err != nil {
+		return err
+	}
+	if err := validateRewardBand(p.RewardBand); err != nil {
+		return err
+	}
+	if err := validateWhitelist(p.Whitelist); err != nil {
+		return err
+	}
+	if err := validateSlashFraction(p.SlashFraction); err != nil {
+		return err
+	}
+	if err := validateSlashWindow(p.SlashWindow); err != nil {
+		return err
+	}
+	if err := validateMinValidPerWindow(p.MinValidPerWindow); err != nil {
+		return err
+	}
+
+	if p.SlashWindow%p.VotePeriod != 0 {
+		return fmt.Errorf("slash window %d should be a multiple of the vote period %d", p.SlashWindow, p.VotePeriod)
+	}
+
+	return nil
+}
+
+// IsWhitelisted returns whether the exchange rate of a denom is voted on
+func (p Params) IsWhitelisted(denom string) bool {
+	for _, d := range p.Whitelist {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyVotePeriod, &p.VotePeriod, validateVotePeriod),
+		paramtypes.NewParamSetPair(KeyVoteThreshold, &p.VoteThreshold, validateVoteThreshold),
+		paramtypes.NewParamSetPair(KeyRewardBand, &p.RewardBand, validateRewardBand),
+		paramtypes.NewParamSetPair(KeyWhitelist, &p.Whitelist, validateWhitelist),
+		paramtypes.NewParamSetPair(KeySlashFraction, &p.SlashFraction, validateSlashFraction),
+		paramtypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
+		paramtypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
+	}
+}
+
+func validateVotePeriod(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("vote period must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateVoteThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// less than a third of the bonded power could set prices on its own
+	if v.IsNil() || v.LTE(sdk.NewDecWithPrec(33, 2)) || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("vote threshold should be between (0.33, 1]: %s", v)
+	}
+
+	return nil
+}
+
+func validateRewardBand(i interface{}) error {
+	return validateRate("reward band", i)
+}
+
+func validateWhitelist(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := make(map[string]bool)
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if denoms[denom] {
+			return fmt.Errorf("duplicate whitelisted denom %s", denom)
+		}
+		denoms[denom] = true
+	}
+
+	return nil
+}
+
+func validateSlashFraction(i interface{}) error {
+	return validateRate("slash fraction", i)
+}
+
+func validateSlashWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("slash window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMinValidPerWindow(i interface{}) error {
+	return validateRate("min valid per window", i)
+}
+
+func validateRate(name string, i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("%s should be between [0, 1]: %s", name, v)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HashLen is the length in bytes of a prevote hash
+const HashLen = 20
+
+// GetAggregateVoteHash returns the hash a validator commits to in its prevote,
+// the hex of the truncated sha256 of "{salt}:{exchange rates}:{validator}"
+func GetAggregateVoteHash(salt string, exchangeRates sdk.DecCoins, voter sdk.ValAddress) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s:%s:%s", salt, exchangeRates, voter)))
+	return hex.EncodeToString(sum[:HashLen])
+}
+
+// ValidateHash checks that a prevote hash is the hex of HashLen bytes
+func ValidateHash(hash string) error {
+	bz, err := hex.DecodeString(hash)
+	if err != nil || len(bz) != HashLen {
+		return sdkerrors.Wrapf(ErrInvalidHash, "%s is not the hex of %d bytes", hash, HashLen)
+	}
+	return nil
+}
+
+// ValidateExchangeRates checks that the exchange rates are sorted, positive
+// and of distinct denoms
+func ValidateExchangeRates(exchangeRates sdk.DecCoins) error {
+	if err := exchangeRates.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidExchangeRate, err.Error())
+	}
+	return nil
+}
+
+// NewAggregateExchangeRatePrevote creates a new prevote
+func NewAggregateExchangeRatePrevote(hash string, voter sdk.ValAddress, submitBlock int64) AggregateExchangeRatePrevote {
+	return AggregateExchangeRatePrevote{
+		Hash:        hash,
+		Voter:       voter.String(),
+		SubmitBlock: submitBlock,
+	}
+}
+
+// GetVoter returns the validator of the prevote
+func (p AggregateExchangeRatePrevote) GetVoter() sdk.ValAddress {
+	voter, err := sdk.ValAddressFromBech32(p.Voter)
+	if err != nil {
+		panic(err)
+	}
+	return voter
+}
+
+// Validate performs a basic validation of the prevote
+func (p AggregateExchangeRatePrevote) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(p.Voter); err != nil {
+		return err
+	}
+	if p.SubmitBlock < 0 {
+		return fmt.Errorf("negative submit block %d of the prevote of %s", p.SubmitBlock, p.Voter)
+	}
+	return ValidateHash(p.Hash)
+}
+
+// String implements the Stringer interface.
+func (p AggregateExchangeRatePrevote) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// NewAggregateExchangeRateVote creates a new vote
+func NewAggregateExchangeRateVote(exchangeRates sdk.DecCoins, voter sdk.ValAddress) AggregateExchangeRateVote {
+	return AggregateExchangeRateVote{
+		ExchangeRates: exchangeRates,
+		Voter:         voter.String(),
+	}
+}
+
+// GetVoter returns the validator of the vote
+func (v AggregateExchangeRateVote) GetVoter() sdk.ValAddress {
+	voter, err := sdk.ValAddressFromBech32(v.Voter)
+	if err != nil {
+		panic(err)
+	}
+	return voter
+}
+
+// Validate performs a basic validation of the vote
+func (v AggregateExchangeRateVote) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(v.Voter); err != nil {
+		return err
+	}
+	return ValidateExchangeRates(v.ExchangeRates)
+}
+
+// String implements the Stringer interface.
+func (v AggregateExchangeRateVote) String() string {
+	out, _ := yaml.Marshal(v)
+	return string(out)
+}
+
+// VoteForTally is the exchange rate of a denom voted by a validator, weighted
+// by its bonded power
+type VoteForTally struct {
+	Denom        string
+	ExchangeRate sdk.Dec
+	Voter        sdk.ValAddress
+	Power        int64
+}
+
+// ExchangeRateBallot is the votes of the validators on the exchange rate of a denom
+type ExchangeRateBallot []VoteForTally
+
+// Power returns the total power of the ballot
+func (b ExchangeRateBallot) Power() int64 {
+	var power int64
+	for _, vote := range b {
+		power += vote.Power
+	}
+	return power
+}
+
+// WeightedMedian returns the exchange rate at which the cumulated power of
+// the ballot sorted by exchange rate reaches half of its total power
+func (b ExchangeRateBallot) WeightedMedian() sdk.Dec {
+	sorted := make(ExchangeRateBallot, len(b))
+	copy(sorted, b)
+	sort.Sort(sorted)
+
+	total := sorted.Power()
+	var cumulated int64
+	for _, vote := range sorted {
+		cumulated += vote.Power
+		if cumulated*2 >= total {
+			return vote.ExchangeRate
+		}
+	}
+
+	return sdk.ZeroDec()
+}
+
+func (b ExchangeRateBallot) Len() int           { return len(b) }
+func (b ExchangeRateBallot) Less(i, j int) bool { return b[i].ExchangeRate.LT(b[j].ExchangeRate) }
+func (b ExchangeRateBallot) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+
+// Claim is the tally of the votes of a bonded validator in a voting period
+type Claim struct {
+	Power    int64
+	WinCount int64
+	Voter    sdk.ValAddress
+}
+
+// IsPeriodLastBlock returns whether the block of the context is the last one
+// of a period of blocks
+func IsPeriodLastBlock(ctx sdk.Context, period uint64) bool {
+	return (uint64(ctx.BlockHeight())+1)%period == 0
+}
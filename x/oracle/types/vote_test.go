@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/x/oracle/types"
+)
+
+func TestWeightedMedian(t *testing.T) {
+	vote := func(rate int64, power int64) types.VoteForTally {
+		return types.VoteForTally{Denom: "ueth", ExchangeRate: sdk.NewDec(rate), Power: power}
+	}
+
+	tests := []struct {
+		name   string
+		ballot types.ExchangeRateBallot
+		median sdk.Dec
+	}{
+		{"empty", types.ExchangeRateBallot{}, sdk.ZeroDec()},
+		{"single", types.ExchangeRateBallot{vote(3, 1)}, sdk.NewDec(3)},
+		{"unsorted", types.ExchangeRateBallot{vote(5, 1), vote(1, 1), vote(3, 1)}, sdk.NewDec(3)},
+		{"weighted", types.ExchangeRateBallot{vote(1, 10), vote(2, 10), vote(3, 50)}, sdk.NewDec(3)},
+		{"half", types.ExchangeRateBallot{vote(2, 30), vote(1, 30)}, sdk.NewDec(1)},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.median, tt.ballot.WeightedMedian())
+		})
+	}
+}
+
+func TestParamsValidate(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.Whitelist = []string{"ueth", "ueth"}
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.SlashWindow = params.VotePeriod*10 + 1
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.VoteThreshold = sdk.NewDecWithPrec(33, 2)
+	require.Error(t, params.Validate())
+}
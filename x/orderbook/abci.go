@@ -0,0 +1,18 @@
+package orderbook
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/orderbook/keeper"
+	"github.com/gauss/gauss/v4/x/orderbook/types"
+)
+
+// EndBlocker cancels the GTH and GTT orders expiring in the block and refunds
+// their remaining asset
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.BlockExpiredOrders(ctx)
+}
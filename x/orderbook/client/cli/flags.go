@@ -8,15 +8,22 @@ const (
 	FlagDefiAddress    = "defi-address"
 	FlagDelegatorAddress    = "delegator-address"
 	FlagIsLeftOrder    = "is-left-order"
+	FlagTimeInForce    = "time-in-force"
+	FlagGoodTilHeight  = "good-til-height"
+	FlagGoodTilTime    = "good-til-time"
 )
 
 var (
 	fsPools		= flag.NewFlagSet("", flag.ContinueOnError)
 	fsOrders	= flag.NewFlagSet("", flag.ContinueOnError)
+	fsTimeInForce	= flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
 	fsPools.String(FlagDefiAddress, "", "belong to defi enviroment")
 	fsPools.String(FlagDelegatorAddress, "", "allow delegator to execute orders")
 	fsOrders.Bool(FlagIsLeftOrder, false, "left order is buy order, right order is sale order. (default sale order)")
+	fsTimeInForce.String(FlagTimeInForce, "GTC", "time in force of the order: GTC, IOC, FOK, GTH or GTT")
+	fsTimeInForce.Int64(FlagGoodTilHeight, 0, "block height at the end of which a GTH order expires")
+	fsTimeInForce.String(FlagGoodTilTime, "", "time (RFC3339) from which a GTT order expires")
 }
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -184,6 +186,10 @@ func NewPlaceOrderCmd() *cobra.Command {
 
 Example:
 $ %s tx %s place-order %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1000ugauss 5000uusdg 0.05uusdg 0001 --from mykey
+
+The order rests on the book until it is filled or revoked, unless another time in force is given:
+IOC cancels what is not filled right away, FOK fails unless the order is entirely filled right away,
+GTH expires at the end of --good-til-height and GTT from --good-til-time.
 `,
 				version.AppName, types.ModuleName, bech32PrefixAccAddr,
 			),
@@ -221,7 +227,13 @@ $ %s tx %s place-order %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1000ugauss 5000
 				return err
 			}
 
-			msg := types.NewMsgPlaceOrder(poolAddr, ownerAddr, my_asset, expect_asset, price, order_id)
+			timeInForce, goodTilHeight, goodTilTime, err := getTimeInForce(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceOrder(poolAddr, ownerAddr, my_asset, expect_asset, price, order_id,
+				timeInForce, goodTilHeight, goodTilTime)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -230,6 +242,7 @@ $ %s tx %s place-order %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1000ugauss 5000
 		},
 	}
 
+	cmd.Flags().AddFlagSet(fsTimeInForce)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -358,3 +371,25 @@ $ %s tx %s agree-order %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p gauss-usdg 1000
 
 	return cmd
 }
+
+// getTimeInForce returns the time in force of an order and its good-til height and time from the flags
+func getTimeInForce(fs *flag.FlagSet) (types.TimeInForce, int64, time.Time, error) {
+	var goodTilTime time.Time
+
+	tifStr, _ := fs.GetString(FlagTimeInForce)
+	timeInForce, err := types.TimeInForceFromString(tifStr)
+	if err != nil {
+		return timeInForce, 0, goodTilTime, err
+	}
+
+	goodTilHeight, _ := fs.GetInt64(FlagGoodTilHeight)
+
+	if timeStr, _ := fs.GetString(FlagGoodTilTime); timeStr != "" {
+		goodTilTime, err = time.Parse(time.RFC3339, timeStr)
+		if err != nil {
+			return timeInForce, 0, goodTilTime, err
+		}
+	}
+
+	return timeInForce, goodTilHeight, goodTilTime, nil
+}
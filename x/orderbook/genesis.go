@@ -1,6 +1,8 @@
 package orderbook
 
 import (
+	"fmt"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,14 +10,14 @@ import (
 	"github.com/gauss/gauss/v4/x/orderbook/types"
 )
 
-// InitGenesis sets the pool and parameters for the provided keeper. 
+// InitGenesis sets the pool and parameters for the provided keeper.
 func InitGenesis(
 	ctx sdk.Context, keeper keeper.Keeper, accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper, data *types.GenesisState,
 ) (res []abci.ValidatorUpdate) {
 	if err := ValidateGenesis(data); err != nil {
-                panic(err.Error())
-        }
+		panic(err.Error())
+	}
 
 	keeper.SetParams(ctx, data.Params)
 
@@ -38,22 +40,22 @@ func InitGenesis(
 		keeper.CreatePool(ctx, poolAddr, delAddr, defiAddr, pool.Pledge, pool.UpdateTime)
 	}
 
+	// placing the orders queues the GTH and GTT ones for expiry again
 	for _, order := range data.Orders {
-		ownerAddr, err := sdk.AccAddressFromBech32(order.OwnerAddress)
-		if err != nil {
-			panic(err)
-		}
-		poolAddr, err := sdk.AccAddressFromBech32(order.PoolAddress)
-		if err != nil {
+		if err := keeper.PlaceOrder(ctx, order); err != nil {
 			panic(err)
 		}
-		keeper.PlaceOrder(ctx, poolAddr, ownerAddr, order.MyAsset, order.ExpectAsset, order.Price, order.Nonce)
 	}
 
 	for _, txPairStats := range data.TxPairsStats {
 		keeper.SetTxPairStats(ctx, txPairStats)
 	}
 
+	// check if the module account exists, it escrows the pledges and the orders
+	if moduleAcc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
 	return res
 }
 
@@ -62,8 +64,9 @@ func InitGenesis(
 // the keeper.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	var orders []types.Order
-	
-	keeper.IterateAllOrders(ctx, func(_ int64, order types.Order) (stop bool) {
+
+	// the orders are exported by priority, so that placing them again keeps it
+	keeper.IterateAllOrdersByPriority(ctx, func(_ int64, order types.Order) (stop bool) {
 		orders = append(orders, order)
 		return false
 	})
@@ -75,11 +78,11 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Params:               keeper.GetParams(ctx),
-		Pools:                keeper.GetAllPools(ctx),
-		Orders:               orders,
-		TxPairsStats:         txPairsStats,
-		Exported:             true,
+		Params:       keeper.GetParams(ctx),
+		Pools:        keeper.GetAllPools(ctx),
+		Orders:       orders,
+		TxPairsStats: txPairsStats,
+		Exported:     true,
 	}
 }
 
@@ -89,7 +92,7 @@ func ValidateGenesis(data *types.GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
-	
+
 	for _, pool := range data.Pools {
 		if err := pool.Validate(); err != nil {
 			return err
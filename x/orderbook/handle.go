@@ -38,6 +38,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.AgreeOrderPair(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgAgreeOrderPairWithAmount:
+			res, err := msgServer.AgreeOrderPairWithAmount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gauss/gauss/v4/x/orderbook/types"
+)
+
+// AgreeOrderPair executes a left (buy) order against a right (sell) order of a
+// tx-pair at the given price. amount is the base amount to trade, a negative
+// amount trades the largest amount both orders can fill. It returns the quote
+// amount paid by the left order and the base amount paid by the right order.
+func (k Keeper) AgreeOrderPair(
+	ctx sdk.Context, pool types.Pool, txPair string, leftOrderID, rightOrderID uint64, price sdk.DecCoin, amount sdk.Int,
+) (leftAmount, rightAmount sdk.Int, err error) {
+	left, right, err := k.getOrderPair(ctx, pool, txPair, leftOrderID, rightOrderID)
+	if err != nil {
+		return leftAmount, rightAmount, err
+	}
+
+	if price.Denom != left.QuoteDenom() {
+		return leftAmount, rightAmount, sdkerrors.Wrapf(types.ErrInvalidPrice, "%s of tx-pair %s", price, txPair)
+	}
+	if err := checkPrice(left, right, price.Amount); err != nil {
+		return leftAmount, rightAmount, err
+	}
+
+	// the most the left order can still buy at this price
+	affordable := left.MyAsset.Amount.ToDec().Quo(price.Amount).TruncateInt()
+	maxAmount := sdk.MinInt(sdk.MinInt(left.ExpectAsset.Amount, right.MyAsset.Amount), affordable)
+
+	rightAmount = amount
+	if amount.IsNegative() {
+		rightAmount = maxAmount
+	}
+	if !rightAmount.IsPositive() || rightAmount.GT(maxAmount) {
+		return leftAmount, rightAmount, sdkerrors.Wrapf(types.ErrInsufficientOrderAsset,
+			"amount %s, orders can fill at most %s", rightAmount, maxAmount)
+	}
+
+	leftAmount = price.Amount.MulInt(rightAmount).TruncateInt()
+	if !leftAmount.IsPositive() {
+		return leftAmount, rightAmount, sdkerrors.Wrapf(types.ErrInvalidAmount,
+			"amount %s at price %s pays nothing", rightAmount, price)
+	}
+
+	if err := k.executeOrderPair(ctx, pool, left, right, leftAmount, rightAmount); err != nil {
+		return leftAmount, rightAmount, err
+	}
+
+	return leftAmount, rightAmount, nil
+}
+
+// AgreeOrderPairWithAmount executes a left (buy) order against a right (sell)
+// order of a tx-pair with explicit amounts: the left order pays leftAmount of
+// the quote denom and the right order pays rightAmount of the base denom.
+func (k Keeper) AgreeOrderPairWithAmount(
+	ctx sdk.Context, pool types.Pool, txPair string, leftOrderID, rightOrderID uint64, leftAmount, rightAmount sdk.Int,
+) error {
+	left, right, err := k.getOrderPair(ctx, pool, txPair, leftOrderID, rightOrderID)
+	if err != nil {
+		return err
+	}
+
+	if err := checkPrice(left, right, leftAmount.ToDec().Quo(rightAmount.ToDec())); err != nil {
+		return err
+	}
+
+	if leftAmount.GT(left.MyAsset.Amount) {
+		return sdkerrors.Wrapf(types.ErrInsufficientOrderAsset, "left order has %s, needs %s", left.MyAsset, leftAmount)
+	}
+	if rightAmount.GT(right.MyAsset.Amount) {
+		return sdkerrors.Wrapf(types.ErrInsufficientOrderAsset, "right order has %s, needs %s", right.MyAsset, rightAmount)
+	}
+	if rightAmount.GT(left.ExpectAsset.Amount) {
+		return sdkerrors.Wrapf(types.ErrInvalidAmount, "left order expects %s, got %s", left.ExpectAsset, rightAmount)
+	}
+
+	return k.executeOrderPair(ctx, pool, left, right, leftAmount, rightAmount)
+}
+
+func (k Keeper) getOrderPair(
+	ctx sdk.Context, pool types.Pool, txPair string, leftOrderID, rightOrderID uint64,
+) (left, right types.Order, err error) {
+	left, found := k.GetOrder(ctx, pool.GetPoolAddr(), txPair, true, leftOrderID)
+	if !found {
+		return left, right, sdkerrors.Wrapf(types.ErrNoOrderFound, "left order %d of tx-pair %s", leftOrderID, txPair)
+	}
+
+	right, found = k.GetOrder(ctx, pool.GetPoolAddr(), txPair, false, rightOrderID)
+	if !found {
+		return left, right, sdkerrors.Wrapf(types.ErrNoOrderFound, "right order %d of tx-pair %s", rightOrderID, txPair)
+	}
+
+	for _, order := range []types.Order{left, right} {
+		if err := k.validateOrderAsset(ctx, order); err != nil {
+			return left, right, sdkerrors.Wrapf(err, "order %d of tx-pair %s", order.Nonce, txPair)
+		}
+	}
+
+	return left, right, nil
+}
+
+// checkPrice ensures the price satisfies the limits of both orders
+func checkPrice(left, right types.Order, price sdk.Dec) error {
+	if price.GT(left.Price.Amount) || price.LT(right.Price.Amount) {
+		return sdkerrors.Wrapf(types.ErrPriceNotMatch, "price %s is out of [%s, %s]",
+			price, right.Price.Amount, left.Price.Amount)
+	}
+
+	return nil
+}
+
+// executeOrderPair swaps the escrowed assets of two orders, closes the filled
+// ones and updates the tx-pair stats.
+func (k Keeper) executeOrderPair(
+	ctx sdk.Context, pool types.Pool, left, right types.Order, leftAmount, rightAmount sdk.Int,
+) error {
+	quoteCoin := sdk.NewCoin(left.QuoteDenom(), leftAmount)
+	baseCoin := sdk.NewCoin(left.BaseDenom(), rightAmount)
+
+	if err := k.releaseCoins(ctx, left.GetOwnerAddr(), baseCoin); err != nil {
+		return err
+	}
+	if err := k.releaseCoins(ctx, right.GetOwnerAddr(), quoteCoin); err != nil {
+		return err
+	}
+
+	left.MyAsset = left.MyAsset.Sub(quoteCoin)
+	left.ExpectAsset.Amount = subFloorZero(left.ExpectAsset.Amount, rightAmount)
+	if err := k.updateOrder(ctx, left); err != nil {
+		return err
+	}
+
+	right.MyAsset = right.MyAsset.Sub(baseCoin)
+	right.ExpectAsset.Amount = subFloorZero(right.ExpectAsset.Amount, leftAmount)
+	if err := k.updateOrder(ctx, right); err != nil {
+		return err
+	}
+
+	stats, found := k.GetTxPairStats(ctx, pool.GetPoolAddr(), left.GetTxPair())
+	if !found {
+		stats = types.NewTxPairStats(pool.GetPoolAddr(), left.BaseDenom(), left.QuoteDenom())
+	}
+	stats.Count++
+	stats.LeftAsset = stats.LeftAsset.Add(baseCoin)
+	stats.RightAsset = stats.RightAsset.Add(quoteCoin)
+	k.SetTxPairStats(ctx, stats)
+
+	k.rewardPool(ctx, pool, baseCoin, quoteCoin)
+
+	return nil
+}
+
+// updateOrder stores a partially filled order, or closes it and refunds the
+// rest of its asset once it is filled.
+func (k Keeper) updateOrder(ctx sdk.Context, order types.Order) error {
+	if !order.MyAsset.IsZero() && order.ExpectAsset.IsPositive() {
+		k.SetOrder(ctx, order)
+		return nil
+	}
+
+	if err := k.releaseCoins(ctx, order.GetOwnerAddr(), order.MyAsset); err != nil {
+		return err
+	}
+
+	k.RemoveOrder(ctx, order)
+
+	return nil
+}
+
+// rewardPool mints defi rewards to the pool when the traded amount reaches
+// the rewards threshold
+func (k Keeper) rewardPool(ctx sdk.Context, pool types.Pool, traded ...sdk.Coin) {
+	defiAddr := pool.GetDefiAddr()
+	if defiAddr == nil || k.defiKeeper.Defi(ctx, defiAddr) == nil {
+		return
+	}
+
+	threshold := k.DefiRewardsThreshold(ctx)
+	for _, coin := range traded {
+		if coin.Denom == threshold.Denom && coin.Amount.GTE(threshold.Amount) {
+			k.defiKeeper.MintTokens(ctx, defiAddr, pool.Address, k.MarketRewardsRate(ctx), false)
+			return
+		}
+	}
+}
+
+func subFloorZero(a, b sdk.Int) sdk.Int {
+	if a.LTE(b) {
+		return sdk.ZeroInt()
+	}
+	return a.Sub(b)
+}
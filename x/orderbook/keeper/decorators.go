@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/gauss/gauss/v4/x/orderbook/types"
+)
+
+type ValidateOrderbookDecorator struct {
+	k  Keeper
+	bk types.BankKeeper
+	sk types.StakingKeeper
+}
+
+func NewValidateOrderbookDecorator(k Keeper, bk types.BankKeeper, sk types.StakingKeeper) ValidateOrderbookDecorator {
+	return ValidateOrderbookDecorator{
+		k:  k,
+		bk: bk,
+		sk: sk,
+	}
+}
+
+// AnteHandle returns an AnteHandler that checks if the balances of the senders
+// are sufficient for the pledges and the orders to be escrowed
+func (vod ValidateOrderbookDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	escrowsMap := make(map[string]sdk.Coins)
+
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case *types.MsgCreatePool:
+			if bondDenom := vod.sk.BondDenom(ctx); msg.Pledge.Denom != bondDenom {
+				return ctx, sdkerrors.Wrapf(types.ErrBadDenom, "pledge %s, expected %s", msg.Pledge.Denom, bondDenom)
+			}
+
+			escrowsMap[msg.OwnerAddress] = escrowsMap[msg.OwnerAddress].Add(msg.Pledge)
+		case *types.MsgAddPledge:
+			if bondDenom := vod.sk.BondDenom(ctx); msg.Pledge.Denom != bondDenom {
+				return ctx, sdkerrors.Wrapf(types.ErrBadDenom, "pledge %s, expected %s", msg.Pledge.Denom, bondDenom)
+			}
+
+			escrowsMap[msg.OwnerAddress] = escrowsMap[msg.OwnerAddress].Add(msg.Pledge)
+		case *types.MsgPlaceOrder:
+			escrowsMap[msg.OwnerAddress] = escrowsMap[msg.OwnerAddress].Add(msg.MyAsset)
+		}
+	}
+
+	for addr, escrows := range escrowsMap {
+		owner, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+
+		for _, escrow := range escrows {
+			balance := vod.bk.GetBalance(ctx, owner, escrow.Denom)
+			if balance.IsLT(escrow) {
+				return ctx, sdkerrors.Wrapf(
+					sdkerrors.ErrInsufficientFunds, "insufficient funds: balance[%s] < escrow[%s]", balance, escrow,
+				)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/orderbook/types"
+)
+
+// order expiry queue operations
+
+// GetOrderTimeQueueSlice returns the GTT orders expiring at a certain time
+func (k Keeper) GetOrderTimeQueueSlice(ctx sdk.Context, timestamp time.Time) []types.OrderID {
+	return k.getOrderQueueSlice(ctx, types.GetOrderTimeQueueKey(timestamp))
+}
+
+// SetOrderTimeQueueSlice sets the GTT orders expiring at a certain time
+func (k Keeper) SetOrderTimeQueueSlice(ctx sdk.Context, timestamp time.Time, ids []types.OrderID) {
+	k.setOrderQueueSlice(ctx, types.GetOrderTimeQueueKey(timestamp), ids)
+}
+
+// GetOrderHeightQueueSlice returns the GTH orders expiring at a certain height
+func (k Keeper) GetOrderHeightQueueSlice(ctx sdk.Context, height int64) []types.OrderID {
+	return k.getOrderQueueSlice(ctx, types.GetOrderHeightQueueKey(height))
+}
+
+// SetOrderHeightQueueSlice sets the GTH orders expiring at a certain height
+func (k Keeper) SetOrderHeightQueueSlice(ctx sdk.Context, height int64, ids []types.OrderID) {
+	k.setOrderQueueSlice(ctx, types.GetOrderHeightQueueKey(height), ids)
+}
+
+// InsertOrderQueue inserts a GTH or GTT order to the appropriate slice of the
+// expiry queue, other orders never expire
+func (k Keeper) InsertOrderQueue(ctx sdk.Context, order types.Order) {
+	switch order.TimeInForce {
+	case types.GoodTilHeight:
+		ids := k.GetOrderHeightQueueSlice(ctx, order.GoodTilHeight)
+		k.SetOrderHeightQueueSlice(ctx, order.GoodTilHeight, append(ids, order.GetOrderID()))
+	case types.GoodTilTime:
+		ids := k.GetOrderTimeQueueSlice(ctx, order.GoodTilTime)
+		k.SetOrderTimeQueueSlice(ctx, order.GoodTilTime, append(ids, order.GetOrderID()))
+	}
+}
+
+// DequeueAllExpiredOrders returns the orders of all the slices of the expiry
+// queue up to the current block time and height inclusively, and deletes the
+// slices from the queue. The orders may have been filled or revoked since.
+func (k Keeper) DequeueAllExpiredOrders(ctx sdk.Context) (expired []types.OrderID) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, iterator := range []sdk.Iterator{
+		store.Iterator(types.OrderHeightQueueKey,
+			sdk.InclusiveEndBytes(types.GetOrderHeightQueueKey(ctx.BlockHeight()))),
+		store.Iterator(types.OrderTimeQueueKey,
+			sdk.InclusiveEndBytes(types.GetOrderTimeQueueKey(ctx.BlockHeader().Time))),
+	} {
+		for ; iterator.Valid(); iterator.Next() {
+			var ids types.OrderIDs
+			k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &ids)
+
+			expired = append(expired, ids.IDs...)
+
+			store.Delete(iterator.Key())
+		}
+		iterator.Close()
+	}
+
+	return expired
+}
+
+// BlockExpiredOrders cancels all the orders expiring in the current block and
+// refunds their remaining asset to their owners. Called in each EndBlock.
+func (k Keeper) BlockExpiredOrders(ctx sdk.Context) {
+	for _, id := range k.DequeueAllExpiredOrders(ctx) {
+		order, found := k.GetOrder(ctx, id.GetPoolAddr(), id.TxPair, id.IsLeftOrder, id.Nonce)
+		// skip the orders which were closed, or replaced by an order of the same nonce
+		if !found || !order.IsExpired(ctx.BlockHeight(), ctx.BlockHeader().Time) {
+			continue
+		}
+
+		if err := k.CancelOrder(ctx, order); err != nil {
+			panic(err)
+		}
+
+		ctx.EventManager().EmitEvent(NewExpireOrderEvent(order))
+	}
+}
+
+// CancelOrder removes an order from the book and refunds its remaining asset
+// to its owner
+func (k Keeper) CancelOrder(ctx sdk.Context, order types.Order) error {
+	if err := k.releaseCoins(ctx, order.GetOwnerAddr(), order.MyAsset); err != nil {
+		return err
+	}
+
+	k.RemoveOrder(ctx, order)
+
+	return nil
+}
+
+// NewExpireOrderEvent returns the event of an order cancelled because of its
+// time in force
+func NewExpireOrderEvent(order types.Order) sdk.Event {
+	return sdk.NewEvent(
+		types.EventTypeExpireOrder,
+		sdk.NewAttribute(types.AttributeKeyPool, order.PoolAddress),
+		sdk.NewAttribute(types.AttributeKeyOwner, order.OwnerAddress),
+		sdk.NewAttribute(types.AttributeKeyTxPair, order.GetTxPair()),
+		sdk.NewAttribute(types.AttributeKeyOrderID, strconv.FormatUint(order.Nonce, 10)),
+		sdk.NewAttribute(types.AttributeKeyIsLeftOrder, strconv.FormatBool(order.IsLeftOrder())),
+		sdk.NewAttribute(types.AttributeKeyTimeInForce, order.TimeInForce.String()),
+		sdk.NewAttribute(types.AttributeKeyRefund, order.MyAsset.String()),
+	)
+}
+
+func (k Keeper) getOrderQueueSlice(ctx sdk.Context, key []byte) []types.OrderID {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(key)
+	if bz == nil {
+		return []types.OrderID{}
+	}
+
+	var ids types.OrderIDs
+	k.cdc.MustUnmarshalBinaryBare(bz, &ids)
+
+	return ids.IDs
+}
+
+func (k Keeper) setOrderQueueSlice(ctx sdk.Context, key []byte, ids []types.OrderID) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&types.OrderIDs{IDs: ids})
+	store.Set(key, bz)
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/x/orderbook"
+	"github.com/gauss/gauss/v4/x/orderbook/keeper"
+	"github.com/gauss/gauss/v4/x/orderbook/types"
+)
+
+func TestExpireOrders(t *testing.T) {
+	app, ctx, pool, addrs := setupMatchTest(t, 1)
+	msgServer := keeper.NewMsgServerImpl(app.OrderbookKeeper)
+
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(now)
+
+	owner := addrs[0]
+	place := func(nonce uint64, timeInForce types.TimeInForce, goodTilHeight int64, goodTilTime time.Time) error {
+		return placeOrderWithTimeInForce(msgServer, ctx, pool, owner, sdk.NewInt64Coin(quoteDenom, 100),
+			sdk.NewInt64Coin(baseDenom, 50), sdk.NewDec(2), nonce, timeInForce, goodTilHeight, goodTilTime)
+	}
+
+	// an order must outlive the block it is placed in
+	require.ErrorIs(t, place(1, types.GoodTilHeight, 10, time.Time{}), types.ErrOrderExpired)
+	require.ErrorIs(t, place(1, types.GoodTilTime, 0, now), types.ErrOrderExpired)
+
+	require.NoError(t, place(1, types.GoodTilHeight, 12, time.Time{}))
+	require.NoError(t, place(2, types.GoodTilTime, 0, now.Add(time.Hour)))
+	require.NoError(t, place(3, types.GoodTilCancelled, 0, time.Time{}))
+	require.Equal(t, sdk.NewInt(1000-300), app.BankKeeper.GetBalance(ctx, owner, quoteDenom).Amount)
+
+	// an order revoked and placed again with the same nonce is not expired
+	require.NoError(t, place(4, types.GoodTilHeight, 12, time.Time{}))
+	_, err := app.OrderbookKeeper.RevokeOrder(ctx, pool, txPair, true, 4, owner)
+	require.NoError(t, err)
+	require.NoError(t, place(4, types.GoodTilCancelled, 0, time.Time{}))
+
+	hasOrder := func(ctx sdk.Context, nonce uint64) bool {
+		_, found := app.OrderbookKeeper.GetOrder(ctx, pool.GetPoolAddr(), txPair, true, nonce)
+		return found
+	}
+
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(now.Add(time.Minute))
+	orderbook.EndBlocker(ctx, app.OrderbookKeeper)
+	require.True(t, hasOrder(ctx, 1))
+	require.True(t, hasOrder(ctx, 2))
+
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(now.Add(2 * time.Minute)).WithEventManager(sdk.NewEventManager())
+	orderbook.EndBlocker(ctx, app.OrderbookKeeper)
+	require.False(t, hasOrder(ctx, 1))
+	require.True(t, hasOrder(ctx, 2))
+	require.True(t, hasOrder(ctx, 4))
+	require.Equal(t, []string{"1"}, expireEvents(ctx))
+	require.Empty(t, app.OrderbookKeeper.GetOrderHeightQueueSlice(ctx, 12))
+
+	ctx = ctx.WithBlockHeight(13).WithBlockTime(now.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	orderbook.EndBlocker(ctx, app.OrderbookKeeper)
+	require.False(t, hasOrder(ctx, 2))
+	require.Equal(t, []string{"2"}, expireEvents(ctx))
+
+	// the expired orders are refunded
+	require.True(t, hasOrder(ctx, 3))
+	require.Equal(t, sdk.NewInt(1000-200), app.BankKeeper.GetBalance(ctx, owner, quoteDenom).Amount)
+
+	tp, found := app.OrderbookKeeper.GetTxPair(ctx, pool.GetPoolAddr(), txPair)
+	require.True(t, found)
+	require.Equal(t, uint64(2), tp.LeftOrdersTotal)
+}
+
+func TestImmediateOrCancel(t *testing.T) {
+	app, ctx, pool, addrs := setupMatchTest(t, 2)
+	msgServer := keeper.NewMsgServerImpl(app.OrderbookKeeper)
+
+	placeOrder(t, msgServer, ctx, pool, addrs[1], sdk.NewInt64Coin(baseDenom, 50),
+		sdk.NewInt64Coin(quoteDenom, 100), sdk.NewDec(2), 1)
+
+	// the order is filled against the resting order and the rest is cancelled
+	buyer := addrs[0]
+	require.NoError(t, placeOrderWithTimeInForce(msgServer, ctx, pool, buyer, sdk.NewInt64Coin(quoteDenom, 200),
+		sdk.NewInt64Coin(baseDenom, 100), sdk.NewDec(2), 1, types.ImmediateOrCancel, 0, time.Time{}))
+
+	require.Len(t, matchEvents(ctx), 1)
+	require.Equal(t, []string{"1"}, expireEvents(ctx))
+	require.Equal(t, sdk.NewInt(1000+50), app.BankKeeper.GetBalance(ctx, buyer, baseDenom).Amount)
+	require.Equal(t, sdk.NewInt(1000-100), app.BankKeeper.GetBalance(ctx, buyer, quoteDenom).Amount)
+
+	_, found := app.OrderbookKeeper.GetTxPair(ctx, pool.GetPoolAddr(), txPair)
+	require.False(t, found)
+}
+
+func TestFillOrKill(t *testing.T) {
+	app, ctx, pool, addrs := setupMatchTest(t, 2)
+	msgServer := keeper.NewMsgServerImpl(app.OrderbookKeeper)
+
+	placeOrder(t, msgServer, ctx, pool, addrs[1], sdk.NewInt64Coin(baseDenom, 50),
+		sdk.NewInt64Coin(quoteDenom, 100), sdk.NewDec(2), 1)
+
+	buy := func(ctx sdk.Context, amount int64) error {
+		return placeOrderWithTimeInForce(msgServer, ctx, pool, addrs[0], sdk.NewInt64Coin(quoteDenom, 2*amount),
+			sdk.NewInt64Coin(baseDenom, amount), sdk.NewDec(2), 1, types.FillOrKill, 0, time.Time{})
+	}
+
+	// the resting order cannot fill the whole order, which fails the tx
+	cacheCtx, _ := ctx.CacheContext()
+	require.ErrorIs(t, buy(cacheCtx, 100), types.ErrOrderNotFilled)
+
+	require.NoError(t, buy(ctx, 50))
+	require.Equal(t, sdk.NewInt(1000+50), app.BankKeeper.GetBalance(ctx, addrs[0], baseDenom).Amount)
+
+	_, found := app.OrderbookKeeper.GetTxPair(ctx, pool.GetPoolAddr(), txPair)
+	require.False(t, found)
+}
+
+// expireEvents returns the ids of the orders expired in the context
+func expireEvents(ctx sdk.Context) []string {
+	var ids []string
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != types.EventTypeExpireOrder {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyOrderID {
+				ids = append(ids, string(attr.Value))
+			}
+		}
+	}
+
+	return ids
+}
@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gauss/gauss/v4/x/orderbook/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+// Params queries the orderbook parameters
+func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Pools queries all the pools
+func (k Querier) Pools(c context.Context, req *types.QueryPoolsRequest) (*types.QueryPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pools := k.GetAllPools(ctx)
+	if pools == nil {
+		pools = []types.Pool{}
+	}
+
+	return &types.QueryPoolsResponse{Pools: pools}, nil
+}
+
+// GetPool queries pool info for given pool addr
+func (k Querier) GetPool(c context.Context, req *types.QueryPoolRequest) (*types.QueryPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	poolAddr, err := parsePoolAddress(req.PoolAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pool, found := k.Keeper.GetPool(ctx, poolAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pool %s not found", req.PoolAddress)
+	}
+
+	return &types.QueryPoolResponse{Pool: pool}, nil
+}
+
+// TxPairs queries the tx-pairs with open orders of a pool
+func (k Querier) TxPairs(c context.Context, req *types.QueryPoolTxPairsRequest) (*types.QueryPoolTxPairsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	poolAddr, err := parsePoolAddress(req.PoolAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	var txPairs []types.TxPair
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	txPairStore := prefix.NewStore(store, types.GetTxPairsKey(poolAddr))
+
+	pageRes, err := query.Paginate(txPairStore, req.Pagination, func(key []byte, value []byte) error {
+		var tp types.TxPair
+		if err := k.cdc.UnmarshalBinaryBare(value, &tp); err != nil {
+			return err
+		}
+
+		txPairs = append(txPairs, tp)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolTxPairsResponse{TxPairs: txPairs, Pagination: pageRes}, nil
+}
+
+// TxPair queries the order totals of a tx-pair of a pool
+func (k Querier) TxPair(c context.Context, req *types.QueryPoolTxPairRequest) (*types.QueryPoolTxPairResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	poolAddr, err := parsePoolAddress(req.PoolAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.TxPair == "" {
+		return nil, status.Error(codes.InvalidArgument, "tx-pair cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	tp, found := k.GetTxPair(ctx, poolAddr, req.TxPair)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "tx-pair %s not found in pool %s", req.TxPair, req.PoolAddress)
+	}
+
+	return &types.QueryPoolTxPairResponse{TxPair: tp}, nil
+}
+
+// Orders queries the left or right orders of a tx-pair of a pool
+func (k Querier) Orders(c context.Context, req *types.QueryPoolTxPairOrdersRequest) (*types.QueryPoolTxPairOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	poolAddr, err := parsePoolAddress(req.PoolAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.TxPair == "" {
+		return nil, status.Error(codes.InvalidArgument, "tx-pair cannot be empty")
+	}
+
+	var orders []types.Order
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	orderStore := prefix.NewStore(store, types.GetTxPairOrdersKey(poolAddr, req.TxPair, req.IsLeftOrder))
+
+	pageRes, err := query.Paginate(orderStore, req.Pagination, func(key []byte, value []byte) error {
+		var order types.Order
+		if err := k.cdc.UnmarshalBinaryBare(value, &order); err != nil {
+			return err
+		}
+
+		orders = append(orders, order)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolTxPairOrdersResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
+// Order queries a left or right order of a tx-pair of a pool
+func (k Querier) Order(c context.Context, req *types.QueryPoolTxPairOrderRequest) (*types.QueryPoolTxPairOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	poolAddr, err := parsePoolAddress(req.PoolAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.TxPair == "" {
+		return nil, status.Error(codes.InvalidArgument, "tx-pair cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	order, found := k.GetOrder(ctx, poolAddr, req.TxPair, req.IsLeftOrder, req.OrderId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "order %d of tx-pair %s not found", req.OrderId, req.TxPair)
+	}
+
+	return &types.QueryPoolTxPairOrderResponse{Order: order}, nil
+}
+
+// TxPairsStats queries the trading stats of the tx-pairs of a pool
+func (k Querier) TxPairsStats(c context.Context, req *types.QueryTxPairsStatsRequest) (*types.QueryTxPairsStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	poolAddr, err := parsePoolAddress(req.PoolAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats []types.TxPairStats
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	statsStore := prefix.NewStore(store, types.GetPoolTxPairsStatsKey(poolAddr))
+
+	pageRes, err := query.Paginate(statsStore, req.Pagination, func(key []byte, value []byte) error {
+		var s types.TxPairStats
+		if err := k.cdc.UnmarshalBinaryBare(value, &s); err != nil {
+			return err
+		}
+
+		stats = append(stats, s)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTxPairsStatsResponse{TxPairsStats: stats, Pagination: pageRes}, nil
+}
+
+func parsePoolAddress(poolAddress string) (sdk.AccAddress, error) {
+	if poolAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "pool address cannot be empty")
+	}
+
+	poolAddr, err := sdk.AccAddressFromBech32(poolAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return poolAddr, nil
+}
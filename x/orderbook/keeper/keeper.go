@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/gauss/gauss/v4/x/orderbook/types"
+)
+
+// keeper of the orderbook store
+type Keeper struct {
+	storeKey      sdk.StoreKey
+	cdc           codec.BinaryMarshaler
+	authKeeper    types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
+	defiKeeper    types.DefiKeeper
+	tokenKeeper   types.TokenKeeper
+	paramstore    paramtypes.Subspace
+
+	blockedAddrs map[string]bool
+}
+
+// NewKeeper creates a new orderbook Keeper instance
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey, ps paramtypes.Subspace, ak types.AccountKeeper, bk types.BankKeeper,
+	sk types.StakingKeeper, dk types.DefiKeeper, tk types.TokenKeeper, blockedAddrs map[string]bool,
+) Keeper {
+	// ensure orderbook module account is set, it escrows the pledges and the orders
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		authKeeper:    ak,
+		bankKeeper:    bk,
+		stakingKeeper: sk,
+		defiKeeper:    dk,
+		tokenKeeper:   tk,
+		paramstore:    ps,
+		blockedAddrs:  blockedAddrs,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// escrowCoins moves coins from an account into the orderbook module account
+func (k Keeper) escrowCoins(ctx sdk.Context, from sdk.AccAddress, coin sdk.Coin) error {
+	if coin.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, sdk.NewCoins(coin))
+}
+
+// releaseCoins moves coins from the orderbook module account back to an account
+func (k Keeper) releaseCoins(ctx sdk.Context, to sdk.AccAddress, coin sdk.Coin) error {
+	if coin.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, sdk.NewCoins(coin))
+}
+
+// validateOrderAsset checks that the owner of an order may still send its
+// escrowed asset, the owner may have been frozen or the token paused or
+// locked since the order was placed
+func (k Keeper) validateOrderAsset(ctx sdk.Context, order types.Order) error {
+	return k.tokenKeeper.ValidateTransfer(ctx, order.GetOwnerAddr(), sdk.NewCoins(order.MyAsset))
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gauss/gauss/v4/x/orderbook/types"
+)
+
+// MatchOrder fills a newly placed order against the resting orders on the
+// opposite side of its tx-pair with price-time priority: the best priced
+// orders first, and the first placed first among orders of the same price.
+// Each fill is executed at the price of the resting order. Whatever is not
+// filled stays on the book. A resting order whose owner may no longer send its
+// asset is cancelled and refunded instead of being filled. The asset of the
+// order must already be escrowed.
+func (k Keeper) MatchOrder(ctx sdk.Context, pool types.Pool, order types.Order) ([]types.OrderFill, error) {
+	isLeftOrder := order.IsLeftOrder()
+	txPair := order.GetTxPair()
+
+	var fills []types.OrderFill
+	var after []byte
+	for {
+		// refresh the order, it is removed from the book once filled
+		taker, found := k.GetOrder(ctx, pool.GetPoolAddr(), txPair, isLeftOrder, order.Nonce)
+		if !found {
+			break
+		}
+
+		resting, key, found := k.nextMatchingOrder(ctx, taker, after)
+		if !found {
+			break
+		}
+		after = key
+
+		if err := k.validateOrderAsset(ctx, resting); err != nil {
+			if err := k.CancelOrder(ctx, resting); err != nil {
+				return fills, err
+			}
+			ctx.EventManager().EmitEvent(NewCancelOrderEvent(resting, err))
+			continue
+		}
+
+		left, right := taker, resting
+		if !isLeftOrder {
+			left, right = resting, taker
+		}
+
+		leftAmount, rightAmount := fillAmounts(left, right, resting.Price.Amount)
+		if !leftAmount.IsPositive() || !rightAmount.IsPositive() {
+			continue
+		}
+
+		if err := k.executeOrderPair(ctx, pool, left, right, leftAmount, rightAmount); err != nil {
+			return fills, sdkerrors.Wrapf(err, "left order %d, right order %d of tx-pair %s",
+				left.Nonce, right.Nonce, txPair)
+		}
+
+		fills = append(fills, types.NewOrderFill(left.Nonce, right.Nonce, leftAmount, rightAmount))
+	}
+
+	return fills, nil
+}
+
+// NewCancelOrderEvent returns the event of a resting order cancelled during the
+// matching because its asset can no longer be sent by its owner
+func NewCancelOrderEvent(order types.Order, reason error) sdk.Event {
+	return sdk.NewEvent(
+		types.EventTypeCancelOrder,
+		sdk.NewAttribute(types.AttributeKeyPool, order.PoolAddress),
+		sdk.NewAttribute(types.AttributeKeyOwner, order.OwnerAddress),
+		sdk.NewAttribute(types.AttributeKeyTxPair, order.GetTxPair()),
+		sdk.NewAttribute(types.AttributeKeyOrderID, strconv.FormatUint(order.Nonce, 10)),
+		sdk.NewAttribute(types.AttributeKeyIsLeftOrder, strconv.FormatBool(order.IsLeftOrder())),
+		sdk.NewAttribute(types.AttributeKeyRefund, order.MyAsset.String()),
+		sdk.NewAttribute(types.AttributeKeyReason, reason.Error()),
+	)
+}
+
+// nextMatchingOrder returns the first order after the given priority key on
+// the opposite side of the tx-pair of the order, along with its priority key.
+// It returns false once the resting orders no longer cross the price of the
+// order, the orders being iterated in the order they must be filled. The
+// iterator is closed before the order is filled, which changes the store.
+func (k Keeper) nextMatchingOrder(ctx sdk.Context, order types.Order, after []byte) (types.Order, []byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	isLeftOrder := order.IsLeftOrder()
+
+	prefix := types.GetTxPairPriorityKey(order.GetPoolAddr(), order.GetTxPair(), !isLeftOrder)
+	start := prefix
+	if after != nil {
+		start = sdk.InclusiveEndBytes(after)
+	}
+
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(prefix))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.Order{}, nil, false
+	}
+
+	var resting types.Order
+	k.cdc.MustUnmarshalBinaryBare(store.Get(
+		types.GetOrderKeyFromPriorityKey(iterator.Key(), sdk.BigEndianToUint64(iterator.Value())),
+	), &resting)
+
+	left, right := order, resting
+	if !isLeftOrder {
+		left, right = resting, order
+	}
+	if left.Price.Amount.LT(right.Price.Amount) {
+		return types.Order{}, nil, false
+	}
+
+	return resting, sdk.CopyBytes(iterator.Key()), true
+}
+
+// fillAmounts returns the largest quote amount paid by the left order and base
+// amount paid by the right order that both orders can fill at the given price
+func fillAmounts(left, right types.Order, price sdk.Dec) (leftAmount, rightAmount sdk.Int) {
+	affordable := left.MyAsset.Amount.ToDec().Quo(price).TruncateInt()
+	rightAmount = sdk.MinInt(sdk.MinInt(left.ExpectAsset.Amount, right.MyAsset.Amount), affordable)
+	leftAmount = price.MulInt(rightAmount).TruncateInt()
+
+	return leftAmount, rightAmount
+}
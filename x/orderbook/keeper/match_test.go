@@ -0,0 +1,272 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/orderbook/keeper"
+	"github.com/gauss/gauss/v4/x/orderbook/types"
+	tokentypes "github.com/gauss/gauss/v4/x/token/types"
+)
+
+const (
+	baseDenom  = "base"
+	quoteDenom = "quote"
+)
+
+var txPair = types.GetTxPair(baseDenom, quoteDenom)
+
+func TestMatchBuyOrder(t *testing.T) {
+	app, ctx, pool, addrs := setupMatchTest(t, 5)
+	msgServer := keeper.NewMsgServerImpl(app.OrderbookKeeper)
+
+	sell := func(seller sdk.AccAddress, amount int64, price sdk.Dec, nonce uint64) {
+		placeOrder(t, msgServer, ctx, pool, seller, sdk.NewInt64Coin(baseDenom, amount),
+			sdk.NewCoin(quoteDenom, price.MulInt64(amount).TruncateInt()), price, nonce)
+	}
+	sell(addrs[1], 100, sdk.NewDec(2), 1)
+	sell(addrs[2], 100, sdk.NewDecWithPrec(15, 1), 2)
+	sell(addrs[3], 50, sdk.NewDecWithPrec(15, 1), 3)
+	sell(addrs[4], 100, sdk.NewDec(3), 4)
+
+	tp, found := app.OrderbookKeeper.GetTxPair(ctx, pool.GetPoolAddr(), txPair)
+	require.True(t, found)
+	require.Equal(t, uint64(4), tp.RightOrdersTotal)
+
+	// the buyer takes the sell orders at 1.5 by nonce, then the one at 2,
+	// and the order at 3 is out of its price
+	buyer := addrs[0]
+	placeOrder(t, msgServer, ctx, pool, buyer, sdk.NewInt64Coin(quoteDenom, 400),
+		sdk.NewInt64Coin(baseDenom, 200), sdk.NewDec(2), 1)
+
+	fills := matchEvents(ctx)
+	require.Len(t, fills, 3)
+	require.Equal(t, []string{"1", "2", "150", "100"}, fills[0])
+	require.Equal(t, []string{"1", "3", "75", "50"}, fills[1])
+	require.Equal(t, []string{"1", "1", "100", "50"}, fills[2])
+
+	// the buy order is filled and the rest of its asset refunded
+	_, found = app.OrderbookKeeper.GetOrder(ctx, pool.GetPoolAddr(), txPair, true, 1)
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt(1000+200), app.BankKeeper.GetBalance(ctx, buyer, baseDenom).Amount)
+	require.Equal(t, sdk.NewInt(1000-325), app.BankKeeper.GetBalance(ctx, buyer, quoteDenom).Amount)
+
+	// the partially filled sell order stays on the book
+	order, found := app.OrderbookKeeper.GetOrder(ctx, pool.GetPoolAddr(), txPair, false, 1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin(baseDenom, 50), order.MyAsset)
+	require.Equal(t, sdk.NewInt64Coin(quoteDenom, 100), order.ExpectAsset)
+
+	tp, found = app.OrderbookKeeper.GetTxPair(ctx, pool.GetPoolAddr(), txPair)
+	require.True(t, found)
+	require.Equal(t, uint64(2), tp.OrdersTotal)
+	require.Equal(t, uint64(0), tp.LeftOrdersTotal)
+	require.Equal(t, uint64(2), tp.RightOrdersTotal)
+
+	stats, found := app.OrderbookKeeper.GetTxPairStats(ctx, pool.GetPoolAddr(), txPair)
+	require.True(t, found)
+	require.Equal(t, uint64(3), stats.Count)
+	require.Equal(t, sdk.NewInt64Coin(baseDenom, 200), stats.LeftAsset)
+	require.Equal(t, sdk.NewInt64Coin(quoteDenom, 325), stats.RightAsset)
+}
+
+func TestMatchSellOrder(t *testing.T) {
+	app, ctx, pool, addrs := setupMatchTest(t, 3)
+	msgServer := keeper.NewMsgServerImpl(app.OrderbookKeeper)
+
+	placeOrder(t, msgServer, ctx, pool, addrs[1], sdk.NewInt64Coin(quoteDenom, 20),
+		sdk.NewInt64Coin(baseDenom, 10), sdk.NewDec(2), 1)
+	placeOrder(t, msgServer, ctx, pool, addrs[2], sdk.NewInt64Coin(quoteDenom, 30),
+		sdk.NewInt64Coin(baseDenom, 10), sdk.NewDec(3), 2)
+
+	// the seller takes the most expensive buy order first
+	seller := addrs[0]
+	placeOrder(t, msgServer, ctx, pool, seller, sdk.NewInt64Coin(baseDenom, 15),
+		sdk.NewInt64Coin(quoteDenom, 40), sdk.NewDec(2), 1)
+
+	fills := matchEvents(ctx)
+	require.Len(t, fills, 2)
+	require.Equal(t, []string{"2", "1", "30", "10"}, fills[0])
+	require.Equal(t, []string{"1", "1", "10", "5"}, fills[1])
+
+	_, found := app.OrderbookKeeper.GetOrder(ctx, pool.GetPoolAddr(), txPair, false, 1)
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt(1000+40), app.BankKeeper.GetBalance(ctx, seller, quoteDenom).Amount)
+
+	order, found := app.OrderbookKeeper.GetOrder(ctx, pool.GetPoolAddr(), txPair, true, 1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin(quoteDenom, 10), order.MyAsset)
+	require.Equal(t, sdk.NewInt64Coin(baseDenom, 5), order.ExpectAsset)
+}
+
+func TestMatchOrderRests(t *testing.T) {
+	app, ctx, pool, addrs := setupMatchTest(t, 2)
+	msgServer := keeper.NewMsgServerImpl(app.OrderbookKeeper)
+
+	placeOrder(t, msgServer, ctx, pool, addrs[0], sdk.NewInt64Coin(baseDenom, 10),
+		sdk.NewInt64Coin(quoteDenom, 30), sdk.NewDec(3), 1)
+	placeOrder(t, msgServer, ctx, pool, addrs[1], sdk.NewInt64Coin(quoteDenom, 20),
+		sdk.NewInt64Coin(baseDenom, 10), sdk.NewDec(2), 1)
+	require.Empty(t, matchEvents(ctx))
+
+	tp, found := app.OrderbookKeeper.GetTxPair(ctx, pool.GetPoolAddr(), txPair)
+	require.True(t, found)
+	require.Equal(t, uint64(1), tp.LeftOrdersTotal)
+	require.Equal(t, uint64(1), tp.RightOrdersTotal)
+
+	_, found = app.OrderbookKeeper.GetTxPairStats(ctx, pool.GetPoolAddr(), txPair)
+	require.False(t, found)
+}
+
+func TestMatchOrderTimePriority(t *testing.T) {
+	app, ctx, pool, addrs := setupMatchTest(t, 3)
+	msgServer := keeper.NewMsgServerImpl(app.OrderbookKeeper)
+
+	// the nonce chosen by the owner does not rank the orders of the same price,
+	// the order they are placed in does
+	placeOrder(t, msgServer, ctx, pool, addrs[1], sdk.NewInt64Coin(baseDenom, 10),
+		sdk.NewInt64Coin(quoteDenom, 20), sdk.NewDec(2), 7)
+	placeOrder(t, msgServer, ctx, pool, addrs[2], sdk.NewInt64Coin(baseDenom, 10),
+		sdk.NewInt64Coin(quoteDenom, 20), sdk.NewDec(2), 1)
+	placeOrder(t, msgServer, ctx, pool, addrs[2], sdk.NewInt64Coin(baseDenom, 10),
+		sdk.NewInt64Coin(quoteDenom, 10), sdk.NewDec(1), 9)
+
+	placeOrder(t, msgServer, ctx, pool, addrs[0], sdk.NewInt64Coin(quoteDenom, 30),
+		sdk.NewInt64Coin(baseDenom, 20), sdk.NewDec(2), 1)
+
+	fills := matchEvents(ctx)
+	require.Len(t, fills, 2)
+	require.Equal(t, []string{"1", "9", "10", "10"}, fills[0])
+	require.Equal(t, []string{"1", "7", "20", "10"}, fills[1])
+
+	_, found := app.OrderbookKeeper.GetOrder(ctx, pool.GetPoolAddr(), txPair, false, 7)
+	require.False(t, found)
+
+	// the filled orders leave the priority index, the genesis export iterates
+	var nonces []uint64
+	app.OrderbookKeeper.IterateAllOrdersByPriority(ctx, func(_ int64, order types.Order) bool {
+		nonces = append(nonces, order.Nonce)
+		return false
+	})
+	require.Equal(t, []uint64{1}, nonces)
+}
+
+func TestMatchFrozenOrder(t *testing.T) {
+	app, ctx, pool, addrs := setupMatchTest(t, 3)
+	msgServer := keeper.NewMsgServerImpl(app.OrderbookKeeper)
+
+	buyer, seller, issuer := addrs[0], addrs[1], addrs[2]
+	err := app.TokenKeeper.IssueToken(ctx, "USD Coin", "usdc", "uusdc", 6, 1000, 2000, true, true, false, true, issuer)
+	require.NoError(t, err)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, issuer, seller, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100))))
+
+	placeOrder(t, msgServer, ctx, pool, seller, sdk.NewInt64Coin("uusdc", 100),
+		sdk.NewInt64Coin(quoteDenom, 200), sdk.NewDec(2), 1)
+	require.Equal(t, sdk.ZeroInt(), app.BankKeeper.GetBalance(ctx, seller, "uusdc").Amount)
+
+	// the seller is frozen after its order rests on the book
+	require.NoError(t, app.TokenKeeper.FreezeAccount(ctx, "usdc", seller, issuer))
+
+	// the frozen order is cancelled and refunded instead of being filled
+	placeOrder(t, msgServer, ctx, pool, buyer, sdk.NewInt64Coin(quoteDenom, 200),
+		sdk.NewInt64Coin("uusdc", 100), sdk.NewDec(2), 1)
+	require.Empty(t, matchEvents(ctx))
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, seller, "uusdc").Amount)
+	require.Equal(t, sdk.ZeroInt(), app.BankKeeper.GetBalance(ctx, buyer, "uusdc").Amount)
+
+	var cancelled bool
+	for _, event := range ctx.EventManager().Events() {
+		cancelled = cancelled || event.Type == types.EventTypeCancelOrder
+	}
+	require.True(t, cancelled)
+
+	frozenPair := types.GetTxPair("uusdc", quoteDenom)
+	_, found := app.OrderbookKeeper.GetOrder(ctx, pool.GetPoolAddr(), frozenPair, false, 1)
+	require.False(t, found)
+	_, found = app.OrderbookKeeper.GetOrder(ctx, pool.GetPoolAddr(), frozenPair, true, 1)
+	require.True(t, found)
+
+	// the buy order rests until an order of an account not frozen fills it
+	placeOrder(t, msgServer, ctx, pool, issuer, sdk.NewInt64Coin("uusdc", 100),
+		sdk.NewInt64Coin(quoteDenom, 200), sdk.NewDec(2), 2)
+	_, found = app.OrderbookKeeper.GetOrder(ctx, pool.GetPoolAddr(), frozenPair, true, 1)
+	require.False(t, found)
+
+	// nor can the pool agree the order of a frozen account
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, issuer, seller, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100))))
+	require.NoError(t, app.TokenKeeper.UnfreezeAccount(ctx, "usdc", seller, issuer))
+	placeOrder(t, msgServer, ctx, pool, seller, sdk.NewInt64Coin("uusdc", 100),
+		sdk.NewInt64Coin(quoteDenom, 200), sdk.NewDec(2), 3)
+	placeOrder(t, msgServer, ctx, pool, buyer, sdk.NewInt64Coin(quoteDenom, 20),
+		sdk.NewInt64Coin("uusdc", 100), sdk.NewDec(1), 2)
+	require.NoError(t, app.TokenKeeper.FreezeAccount(ctx, "usdc", seller, issuer))
+	_, _, err = app.OrderbookKeeper.AgreeOrderPair(ctx, pool, frozenPair, 2, 3,
+		sdk.NewDecCoin(quoteDenom, sdk.NewInt(1)), sdk.NewInt(-1))
+	require.ErrorIs(t, err, tokentypes.ErrAccountFrozen)
+}
+
+func setupMatchTest(t *testing.T, n int) (*simapp.SimApp, sdk.Context, types.Pool, []sdk.AccAddress) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.OrderbookKeeper.SetParams(ctx, types.DefaultParams())
+
+	pledge := sdk.NewCoin(sdk.DefaultBondDenom, app.OrderbookKeeper.PoolMinPledgeAmount(ctx))
+	coins := sdk.NewCoins(pledge, sdk.NewInt64Coin(baseDenom, 1000), sdk.NewInt64Coin(quoteDenom, 1000))
+	addrs := simapp.AddTestAddrs(app, ctx, n+1, sdk.ZeroInt())
+	for _, addr := range addrs {
+		require.NoError(t, app.BankKeeper.SetBalances(ctx, addr, coins))
+	}
+
+	owner := addrs[n]
+	require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(pledge)))
+	pool := app.OrderbookKeeper.CreatePool(ctx, owner, owner, nil, pledge, ctx.BlockTime())
+
+	return app, ctx, pool, addrs[:n]
+}
+
+func placeOrder(
+	t *testing.T, msgServer types.MsgServer, ctx sdk.Context, pool types.Pool, owner sdk.AccAddress,
+	myAsset, expectAsset sdk.Coin, price sdk.Dec, nonce uint64,
+) {
+	require.NoError(t, placeOrderWithTimeInForce(msgServer, ctx, pool, owner, myAsset, expectAsset, price, nonce,
+		types.GoodTilCancelled, 0, time.Time{}))
+}
+
+func placeOrderWithTimeInForce(
+	msgServer types.MsgServer, ctx sdk.Context, pool types.Pool, owner sdk.AccAddress,
+	myAsset, expectAsset sdk.Coin, price sdk.Dec, nonce uint64,
+	timeInForce types.TimeInForce, goodTilHeight int64, goodTilTime time.Time,
+) error {
+	msg := types.NewMsgPlaceOrder(pool.GetPoolAddr(), owner, myAsset, expectAsset,
+		sdk.NewDecCoinFromDec(quoteDenom, price), nonce, timeInForce, goodTilHeight, goodTilTime)
+	_, err := msgServer.PlaceOrder(sdk.WrapSDKContext(ctx), msg)
+	return err
+}
+
+// matchEvents returns the order ids and amounts of the fills of the last placed order
+func matchEvents(ctx sdk.Context) [][]string {
+	var fills [][]string
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		switch event.Type {
+		case types.EventTypePlaceOrder:
+			fills = nil
+		case types.EventTypeMatchOrder:
+			attrs := make(map[string]string)
+			for _, attr := range event.Attributes {
+				attrs[string(attr.Key)] = string(attr.Value)
+			}
+			fills = append(fills, []string{
+				attrs[types.AttributeKeyLeftOrderID], attrs[types.AttributeKeyRightOrderID],
+				attrs[types.AttributeKeyLeftAmount], attrs[types.AttributeKeyRightAmount],
+			})
+		}
+	}
+
+	return fills
+}
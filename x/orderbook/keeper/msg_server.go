@@ -0,0 +1,400 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gauss/gauss/v4/x/orderbook/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the orderbook MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (*types.MsgCreatePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	var defiAddr sdk.ValAddress
+	if msg.DefiAddress != "" {
+		defiAddr, err = sdk.ValAddressFromBech32(msg.DefiAddress)
+		if err != nil {
+			return nil, err
+		}
+		if k.defiKeeper.Defi(ctx, defiAddr) == nil {
+			return nil, types.ErrNoDefiFound
+		}
+	}
+
+	if _, found := k.GetPool(ctx, ownerAddr); found {
+		return nil, types.ErrPoolExists
+	}
+
+	if k.GetPoolCount(ctx) >= k.PoolMaxCount(ctx) {
+		return nil, types.ErrMaxPoolsReached
+	}
+
+	if pledgeDenom := k.PledgeDenom(ctx); msg.Pledge.Denom != pledgeDenom {
+		return nil, sdkerrors.Wrapf(types.ErrBadDenom, "got %s, expected %s", msg.Pledge.Denom, pledgeDenom)
+	}
+
+	if minPledge := k.PoolMinPledgeAmount(ctx); msg.Pledge.Amount.LT(minPledge) {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientPledge, "%s < %s", msg.Pledge.Amount, minPledge)
+	}
+
+	if err := k.escrowCoins(ctx, ownerAddr, msg.Pledge); err != nil {
+		return nil, err
+	}
+
+	k.Keeper.CreatePool(ctx, ownerAddr, delAddr, defiAddr, msg.Pledge, ctx.BlockHeader().Time)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreatePool,
+			sdk.NewAttribute(types.AttributeKeyPool, msg.OwnerAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDefi, msg.DefiAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Pledge.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress),
+		),
+	})
+
+	return &types.MsgCreatePoolResponse{}, nil
+}
+
+func (k msgServer) AddPledge(goCtx context.Context, msg *types.MsgAddPledge) (*types.MsgAddPledgeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, found := k.GetPool(ctx, ownerAddr)
+	if !found {
+		return nil, types.ErrNoPoolFound
+	}
+
+	if msg.Pledge.Denom != pool.Pledge.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrBadDenom, "got %s, expected %s", msg.Pledge.Denom, pool.Pledge.Denom)
+	}
+
+	if err := k.escrowCoins(ctx, ownerAddr, msg.Pledge); err != nil {
+		return nil, err
+	}
+
+	pool.Pledge = pool.Pledge.Add(msg.Pledge)
+	pool.UpdateTime = ctx.BlockHeader().Time
+	k.SetPool(ctx, pool)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddPledge,
+			sdk.NewAttribute(types.AttributeKeyPool, msg.OwnerAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Pledge.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress),
+		),
+	})
+
+	return &types.MsgAddPledgeResponse{}, nil
+}
+
+func (k msgServer) RedeemPledge(goCtx context.Context, msg *types.MsgRedeemPledge) (*types.MsgRedeemPledgeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, found := k.GetPool(ctx, ownerAddr)
+	if !found {
+		return nil, types.ErrNoPoolFound
+	}
+
+	if msg.Pledge.Denom != pool.Pledge.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrBadDenom, "got %s, expected %s", msg.Pledge.Denom, pool.Pledge.Denom)
+	}
+
+	if pool.Pledge.IsLT(msg.Pledge) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAmount, "redeem %s, pledged %s", msg.Pledge, pool.Pledge)
+	}
+
+	// the pool is removed once all the pledge is redeemed
+	remaining := pool.Pledge.Sub(msg.Pledge)
+	if remaining.IsZero() {
+		if k.HasPoolOrders(ctx, ownerAddr) {
+			return nil, types.ErrPoolHasOrders
+		}
+	} else if minPledge := k.PoolMinPledgeAmount(ctx); remaining.Amount.LT(minPledge) {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientPledge, "remaining %s < %s", remaining.Amount, minPledge)
+	}
+
+	if err := k.releaseCoins(ctx, ownerAddr, msg.Pledge); err != nil {
+		return nil, err
+	}
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemPledge,
+			sdk.NewAttribute(types.AttributeKeyPool, msg.OwnerAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Pledge.String()),
+		),
+	}
+
+	if remaining.IsZero() {
+		k.RemovePool(ctx, ownerAddr)
+		events = append(events, sdk.NewEvent(
+			types.EventTypeRemovePool,
+			sdk.NewAttribute(types.AttributeKeyPool, msg.OwnerAddress),
+		))
+	} else {
+		pool.Pledge = remaining
+		pool.UpdateTime = ctx.BlockHeader().Time
+		k.SetPool(ctx, pool)
+	}
+
+	ctx.EventManager().EmitEvents(append(events,
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress),
+		),
+	))
+
+	return &types.MsgRedeemPledgeResponse{}, nil
+}
+
+func (k msgServer) PlaceOrder(goCtx context.Context, msg *types.MsgPlaceOrder) (*types.MsgPlaceOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	poolAddr, err := sdk.AccAddressFromBech32(msg.PoolAddress)
+	if err != nil {
+		return nil, err
+	}
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	order := types.NewOrder(poolAddr, ownerAddr, msg.MyAsset, msg.ExpectAsset, msg.Price, msg.OrderId,
+		msg.TimeInForce, msg.GoodTilHeight, msg.GoodTilTime)
+
+	// an order must outlive the block it is placed in, IOC is meant for the others
+	if order.IsExpired(ctx.BlockHeight(), ctx.BlockHeader().Time) {
+		return nil, sdkerrors.Wrapf(types.ErrOrderExpired, "%s order at height %d, time %s",
+			order.TimeInForce, ctx.BlockHeight(), ctx.BlockHeader().Time)
+	}
+
+	if err := k.Keeper.PlaceOrder(ctx, order); err != nil {
+		return nil, err
+	}
+
+	if err := k.escrowCoins(ctx, ownerAddr, msg.MyAsset); err != nil {
+		return nil, err
+	}
+
+	pool, _ := k.GetPool(ctx, poolAddr)
+	fills, err := k.MatchOrder(ctx, pool, order)
+	if err != nil {
+		return nil, err
+	}
+
+	// the remainder of the order is on the book unless it was entirely filled
+	remainder, open := k.GetOrder(ctx, poolAddr, order.GetTxPair(), order.IsLeftOrder(), order.Nonce)
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePlaceOrder,
+			sdk.NewAttribute(types.AttributeKeyPool, msg.PoolAddress),
+			sdk.NewAttribute(types.AttributeKeyTxPair, order.GetTxPair()),
+			sdk.NewAttribute(types.AttributeKeyOrderID, strconv.FormatUint(msg.OrderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyIsLeftOrder, strconv.FormatBool(order.IsLeftOrder())),
+			sdk.NewAttribute(types.AttributeKeyPrice, msg.Price.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.MyAsset.String()),
+			sdk.NewAttribute(types.AttributeKeyTimeInForce, msg.TimeInForce.String()),
+		),
+	}
+	for _, fill := range fills {
+		events = append(events, sdk.NewEvent(
+			types.EventTypeMatchOrder,
+			sdk.NewAttribute(types.AttributeKeyPool, msg.PoolAddress),
+			sdk.NewAttribute(types.AttributeKeyTxPair, order.GetTxPair()),
+			sdk.NewAttribute(types.AttributeKeyLeftOrderID, strconv.FormatUint(fill.LeftOrderID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRightOrderID, strconv.FormatUint(fill.RightOrderID, 10)),
+			sdk.NewAttribute(types.AttributeKeyLeftAmount, fill.LeftAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyRightAmount, fill.RightAmount.String()),
+		))
+	}
+
+	if open {
+		switch order.TimeInForce {
+		case types.FillOrKill:
+			return nil, sdkerrors.Wrapf(types.ErrOrderNotFilled, "%s remaining", remainder.MyAsset)
+		case types.ImmediateOrCancel:
+			if err := k.CancelOrder(ctx, remainder); err != nil {
+				return nil, err
+			}
+			events = append(events, NewExpireOrderEvent(remainder))
+		}
+	}
+
+	ctx.EventManager().EmitEvents(append(events,
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress),
+		),
+	))
+
+	return &types.MsgPlaceOrderResponse{}, nil
+}
+
+func (k msgServer) RevokeOrder(goCtx context.Context, msg *types.MsgRevokeOrder) (*types.MsgRevokeOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	poolAddr, err := sdk.AccAddressFromBech32(msg.PoolAddress)
+	if err != nil {
+		return nil, err
+	}
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, found := k.GetPool(ctx, poolAddr)
+	if !found {
+		return nil, types.ErrNoPoolFound
+	}
+
+	order, err := k.Keeper.RevokeOrder(ctx, pool, msg.TxPair, msg.IsLeftOrder, msg.OrderId, delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeOrder,
+			sdk.NewAttribute(types.AttributeKeyPool, msg.PoolAddress),
+			sdk.NewAttribute(types.AttributeKeyTxPair, msg.TxPair),
+			sdk.NewAttribute(types.AttributeKeyOrderID, strconv.FormatUint(msg.OrderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyIsLeftOrder, strconv.FormatBool(msg.IsLeftOrder)),
+			sdk.NewAttribute(types.AttributeKeyRefund, order.MyAsset.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgRevokeOrderResponse{}, nil
+}
+
+func (k msgServer) AgreeOrderPair(goCtx context.Context, msg *types.MsgAgreeOrderPair) (*types.MsgAgreeOrderPairResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, err := k.getDelegatedPool(ctx, msg.PoolAddress, msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	leftAmount, rightAmount, err := k.Keeper.AgreeOrderPair(
+		ctx, pool, msg.TxPair, msg.LeftOrderId, msg.RightOrderId, msg.Price, msg.Amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	k.emitAgreeOrderPairEvents(ctx, msg.PoolAddress, msg.DelegatorAddress, msg.TxPair,
+		msg.LeftOrderId, msg.RightOrderId, leftAmount, rightAmount)
+
+	return &types.MsgAgreeOrderPairResponse{}, nil
+}
+
+func (k msgServer) AgreeOrderPairWithAmount(goCtx context.Context, msg *types.MsgAgreeOrderPairWithAmount) (*types.MsgAgreeOrderPairWithAmountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, err := k.getDelegatedPool(ctx, msg.PoolAddress, msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.AgreeOrderPairWithAmount(
+		ctx, pool, msg.TxPair, msg.LeftOrderId, msg.RightOrderId, msg.LeftAmount, msg.RightAmount,
+	); err != nil {
+		return nil, err
+	}
+
+	k.emitAgreeOrderPairEvents(ctx, msg.PoolAddress, msg.DelegatorAddress, msg.TxPair,
+		msg.LeftOrderId, msg.RightOrderId, msg.LeftAmount, msg.RightAmount)
+
+	return &types.MsgAgreeOrderPairWithAmountResponse{}, nil
+}
+
+// getDelegatedPool returns the pool if the sender is its delegator
+func (k msgServer) getDelegatedPool(ctx sdk.Context, poolAddress, delegatorAddress string) (types.Pool, error) {
+	poolAddr, err := sdk.AccAddressFromBech32(poolAddress)
+	if err != nil {
+		return types.Pool{}, err
+	}
+
+	pool, found := k.GetPool(ctx, poolAddr)
+	if !found {
+		return pool, types.ErrNoPoolFound
+	}
+
+	if pool.DelegatorAddress != delegatorAddress {
+		return pool, types.ErrNotPoolDelegator
+	}
+
+	return pool, nil
+}
+
+func (k msgServer) emitAgreeOrderPairEvents(
+	ctx sdk.Context, poolAddress, delegatorAddress, txPair string,
+	leftOrderID, rightOrderID uint64, leftAmount, rightAmount sdk.Int,
+) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAgreeOrderPair,
+			sdk.NewAttribute(types.AttributeKeyPool, poolAddress),
+			sdk.NewAttribute(types.AttributeKeyTxPair, txPair),
+			sdk.NewAttribute(types.AttributeKeyLeftOrderID, strconv.FormatUint(leftOrderID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRightOrderID, strconv.FormatUint(rightOrderID, 10)),
+			sdk.NewAttribute(types.AttributeKeyLeftAmount, leftAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyRightAmount, rightAmount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, delegatorAddress),
+		),
+	})
+}
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gauss/gauss/v4/x/orderbook/types"
+)
+
+// GetOrder returns a left or right order of a tx-pair
+func (k Keeper) GetOrder(
+	ctx sdk.Context, poolAddr sdk.AccAddress, txPair string, isLeftOrder bool, orderID uint64,
+) (order types.Order, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetOrderKey(poolAddr, txPair, isLeftOrder, orderID))
+	if value == nil {
+		return order, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &order)
+	return order, true
+}
+
+// SetOrder sets an order record
+func (k Keeper) SetOrder(ctx sdk.Context, order types.Order) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&order)
+	store.Set(types.GetOrderKey(order.GetPoolAddr(), order.GetTxPair(), order.IsLeftOrder(), order.Nonce), bz)
+}
+
+// RemoveOrder deletes an order record with its priority and updates the
+// totals of its tx-pair
+func (k Keeper) RemoveOrder(ctx sdk.Context, order types.Order) {
+	store := ctx.KVStore(k.storeKey)
+	poolAddr, txPair, isLeftOrder := order.GetPoolAddr(), order.GetTxPair(), order.IsLeftOrder()
+	store.Delete(types.GetOrderKey(poolAddr, txPair, isLeftOrder, order.Nonce))
+
+	seqKey := types.GetOrderSeqKey(poolAddr, txPair, isLeftOrder, order.Nonce)
+	if bz := store.Get(seqKey); bz != nil {
+		store.Delete(types.GetOrderPriorityKey(poolAddr, txPair, isLeftOrder, order.Price.Amount,
+			sdk.BigEndianToUint64(bz)))
+		store.Delete(seqKey)
+	}
+
+	k.decrementTxPairOrders(ctx, order)
+}
+
+// setOrderPriority assigns the next sequence to a newly placed order and
+// inserts it in the price-time priority index of its tx-pair. The sequence
+// rather than the nonce chosen by the owner ranks the orders of the same price.
+func (k Keeper) setOrderPriority(ctx sdk.Context, order types.Order) {
+	store := ctx.KVStore(k.storeKey)
+
+	seq := uint64(1)
+	if bz := store.Get(types.OrderSeqKey); bz != nil {
+		seq = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.OrderSeqKey, sdk.Uint64ToBigEndian(seq+1))
+
+	poolAddr, txPair, isLeftOrder := order.GetPoolAddr(), order.GetTxPair(), order.IsLeftOrder()
+	store.Set(types.GetOrderSeqKey(poolAddr, txPair, isLeftOrder, order.Nonce), sdk.Uint64ToBigEndian(seq))
+	store.Set(types.GetOrderPriorityKey(poolAddr, txPair, isLeftOrder, order.Price.Amount, seq),
+		sdk.Uint64ToBigEndian(order.Nonce))
+}
+
+// PlaceOrder stores a new order in a pool and queues it for expiry if it is a
+// GTH or GTT order. The asset of the order must already be escrowed in the
+// module account.
+func (k Keeper) PlaceOrder(ctx sdk.Context, order types.Order) error {
+	if err := order.Validate(); err != nil {
+		return err
+	}
+
+	poolAddr := order.GetPoolAddr()
+	if _, found := k.GetPool(ctx, poolAddr); !found {
+		return types.ErrNoPoolFound
+	}
+
+	if _, found := k.GetOrder(ctx, poolAddr, order.GetTxPair(), order.IsLeftOrder(), order.Nonce); found {
+		return sdkerrors.Wrapf(types.ErrOrderExists, "order %d of tx-pair %s", order.Nonce, order.GetTxPair())
+	}
+
+	k.SetOrder(ctx, order)
+	k.setOrderPriority(ctx, order)
+	k.incrementTxPairOrders(ctx, order)
+	k.InsertOrderQueue(ctx, order)
+
+	return nil
+}
+
+// RevokeOrder cancels an order and refunds its remaining asset to the owner.
+// Either the owner of the order or the delegator of the pool may revoke it.
+func (k Keeper) RevokeOrder(
+	ctx sdk.Context, pool types.Pool, txPair string, isLeftOrder bool, orderID uint64, sender sdk.AccAddress,
+) (types.Order, error) {
+	order, found := k.GetOrder(ctx, pool.GetPoolAddr(), txPair, isLeftOrder, orderID)
+	if !found {
+		return order, sdkerrors.Wrapf(types.ErrNoOrderFound, "order %d of tx-pair %s", orderID, txPair)
+	}
+
+	if !sender.Equals(order.GetOwnerAddr()) && !sender.Equals(pool.GetDelegatorAddr()) {
+		return order, types.ErrNotOrderOwner
+	}
+
+	if err := k.CancelOrder(ctx, order); err != nil {
+		return order, err
+	}
+
+	return order, nil
+}
+
+// IterateAllOrders iterates through all of the orders of all the pools
+func (k Keeper) IterateAllOrders(ctx sdk.Context, fn func(index int64, order types.Order) (stop bool)) {
+	k.iterateOrders(ctx, types.OrderbookKey, fn)
+}
+
+// IterateTxPairOrders iterates through the left or right orders of a tx-pair
+func (k Keeper) IterateTxPairOrders(
+	ctx sdk.Context, poolAddr sdk.AccAddress, txPair string, isLeftOrder bool,
+	fn func(index int64, order types.Order) (stop bool),
+) {
+	k.iterateOrders(ctx, types.GetTxPairOrdersKey(poolAddr, txPair, isLeftOrder), fn)
+}
+
+// IterateAllOrdersByPriority iterates through all of the orders of all the
+// pools, the orders of each side of a tx-pair in the order they are filled
+func (k Keeper) IterateAllOrdersByPriority(ctx sdk.Context, fn func(index int64, order types.Order) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.OrderPriceKey)
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		orderKey := types.GetOrderKeyFromPriorityKey(iterator.Key(), sdk.BigEndianToUint64(iterator.Value()))
+
+		var order types.Order
+		k.cdc.MustUnmarshalBinaryBare(store.Get(orderKey), &order)
+
+		if stop := fn(i, order); stop {
+			break
+		}
+		i++
+	}
+}
+
+func (k Keeper) iterateOrders(ctx sdk.Context, prefix []byte, fn func(index int64, order types.Order) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		var order types.Order
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &order)
+
+		if stop := fn(i, order); stop {
+			break
+		}
+		i++
+	}
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/orderbook/types"
+)
+
+// PoolMaxCount - Maximum number of pools
+func (k Keeper) PoolMaxCount(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyPoolMaxCount, &res)
+	return
+}
+
+// PoolMinPledgeAmount - Minimum pledge of a pool
+func (k Keeper) PoolMinPledgeAmount(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyPoolMinPledgeAmount, &res)
+	return
+}
+
+// DefiRewardsThreshold - Minimum traded amount of an agreement to mint defi rewards
+func (k Keeper) DefiRewardsThreshold(ctx sdk.Context) (res sdk.Coin) {
+	k.paramstore.Get(ctx, types.KeyDefiRewardsThreshold, &res)
+	return
+}
+
+// MarketRewardsRate - Rate of the defi rewards paid to the pool
+func (k Keeper) MarketRewardsRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMarketRewardsRate, &res)
+	return
+}
+
+// PledgeDenom - Denomination of the pledges, the bond denom of staking
+func (k Keeper) PledgeDenom(ctx sdk.Context) string {
+	return k.stakingKeeper.BondDenom(ctx)
+}
+
+// Get all parameteras as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
+}
+
+// set the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
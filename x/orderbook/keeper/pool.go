@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/orderbook/types"
+)
+
+// GetPool returns the pool owned by the given address
+func (k Keeper) GetPool(ctx sdk.Context, poolAddr sdk.AccAddress) (pool types.Pool, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetPoolKey(poolAddr))
+	if value == nil {
+		return pool, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &pool)
+	return pool, true
+}
+
+// SetPool sets the main record holding pool details
+func (k Keeper) SetPool(ctx sdk.Context, pool types.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&pool)
+	store.Set(types.GetPoolKey(pool.GetPoolAddr()), bz)
+}
+
+// RemovePool deletes the pool record
+func (k Keeper) RemovePool(ctx sdk.Context, poolAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPoolKey(poolAddr))
+}
+
+// CreatePool stores a new pool. The pledge must already be escrowed in the
+// module account.
+func (k Keeper) CreatePool(
+	ctx sdk.Context, poolAddr, delAddr sdk.AccAddress, defiAddr sdk.ValAddress, pledge sdk.Coin, updateTime time.Time,
+) types.Pool {
+	pool := types.NewPool(poolAddr, delAddr, defiAddr, pledge, updateTime)
+	k.SetPool(ctx, pool)
+
+	return pool
+}
+
+// IteratePools iterates through all of the pools
+func (k Keeper) IteratePools(ctx sdk.Context, fn func(index int64, pool types.Pool) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PoolKey)
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		var pool types.Pool
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &pool)
+
+		if stop := fn(i, pool); stop {
+			break
+		}
+		i++
+	}
+}
+
+// GetAllPools returns all the pools, used during genesis dump
+func (k Keeper) GetAllPools(ctx sdk.Context) (pools []types.Pool) {
+	k.IteratePools(ctx, func(_ int64, pool types.Pool) bool {
+		pools = append(pools, pool)
+		return false
+	})
+
+	return pools
+}
+
+// GetPoolCount returns the number of pools
+func (k Keeper) GetPoolCount(ctx sdk.Context) (count uint32) {
+	k.IteratePools(ctx, func(_ int64, _ types.Pool) bool {
+		count++
+		return false
+	})
+
+	return count
+}
+
+// HasPoolOrders returns true if any order is still open in the pool
+func (k Keeper) HasPoolOrders(ctx sdk.Context, poolAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPoolOrdersKey(poolAddr))
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
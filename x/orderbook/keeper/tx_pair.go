@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gauss/gauss/v4/x/orderbook/types"
+)
+
+// GetTxPair returns the order totals of a tx-pair of a pool
+func (k Keeper) GetTxPair(ctx sdk.Context, poolAddr sdk.AccAddress, txPair string) (tp types.TxPair, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetTxPairKey(poolAddr, txPair))
+	if value == nil {
+		return tp, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &tp)
+	return tp, true
+}
+
+// SetTxPair sets the order totals of a tx-pair of a pool
+func (k Keeper) SetTxPair(ctx sdk.Context, poolAddr sdk.AccAddress, tp types.TxPair) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&tp)
+	store.Set(types.GetTxPairKey(poolAddr, tp.TxPair), bz)
+}
+
+// RemoveTxPair deletes the order totals of a tx-pair of a pool
+func (k Keeper) RemoveTxPair(ctx sdk.Context, poolAddr sdk.AccAddress, txPair string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTxPairKey(poolAddr, txPair))
+}
+
+func (k Keeper) incrementTxPairOrders(ctx sdk.Context, order types.Order) {
+	poolAddr := order.GetPoolAddr()
+
+	tp, found := k.GetTxPair(ctx, poolAddr, order.GetTxPair())
+	if !found {
+		tp = types.NewTxPair(order.GetTxPair())
+	}
+
+	tp.OrdersTotal++
+	if order.IsLeftOrder() {
+		tp.LeftOrdersTotal++
+	} else {
+		tp.RightOrdersTotal++
+	}
+
+	k.SetTxPair(ctx, poolAddr, tp)
+}
+
+func (k Keeper) decrementTxPairOrders(ctx sdk.Context, order types.Order) {
+	poolAddr := order.GetPoolAddr()
+
+	tp, found := k.GetTxPair(ctx, poolAddr, order.GetTxPair())
+	if !found {
+		panic("tx-pair of an open order not found")
+	}
+
+	tp.OrdersTotal--
+	if order.IsLeftOrder() {
+		tp.LeftOrdersTotal--
+	} else {
+		tp.RightOrdersTotal--
+	}
+
+	// the tx-pair only exists while it has open orders
+	if tp.OrdersTotal == 0 {
+		k.RemoveTxPair(ctx, poolAddr, tp.TxPair)
+		return
+	}
+
+	k.SetTxPair(ctx, poolAddr, tp)
+}
+
+// GetTxPairStats returns the stats of a tx-pair of a pool
+func (k Keeper) GetTxPairStats(ctx sdk.Context, poolAddr sdk.AccAddress, txPair string) (stats types.TxPairStats, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetTxPairStatsKey(poolAddr, txPair))
+	if value == nil {
+		return stats, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &stats)
+	return stats, true
+}
+
+// SetTxPairStats sets the stats of a tx-pair of a pool
+func (k Keeper) SetTxPairStats(ctx sdk.Context, stats types.TxPairStats) {
+	poolAddr, err := sdk.AccAddressFromBech32(stats.PoolAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&stats)
+	store.Set(types.GetTxPairStatsKey(poolAddr, stats.TxPair), bz)
+}
+
+// IterateTxPairsStats iterates through the stats of all the tx-pairs of all the pools
+func (k Keeper) IterateTxPairsStats(ctx sdk.Context, fn func(index int64, stats types.TxPairStats) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TxPairStatsKey)
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		var stats types.TxPairStats
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &stats)
+
+		if stop := fn(i, stats); stop {
+			break
+		}
+		i++
+	}
+}
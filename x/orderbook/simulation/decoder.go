@@ -21,6 +21,13 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &poolB)
 
 			return fmt.Sprintf("%v\n%v", poolA, poolB)
+		case bytes.Equal(kvA.Key[:1], types.TxPairKey):
+			var txPairA, txPairB types.TxPair
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &txPairA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &txPairB)
+
+			return fmt.Sprintf("%v\n%v", txPairA, txPairB)
 		case bytes.Equal(kvA.Key[:1], types.TxPairStatsKey):
 			var txPairStatsA, txPairStatsB types.TxPairStats
 
@@ -35,6 +42,14 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &orderB)
 
 			return fmt.Sprintf("%v\n%v", orderA, orderB)
+		case bytes.Equal(kvA.Key[:1], types.OrderTimeQueueKey),
+			bytes.Equal(kvA.Key[:1], types.OrderHeightQueueKey):
+			var idsA, idsB types.OrderIDs
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &idsA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &idsB)
+
+			return fmt.Sprintf("%v\n%v", idsA, idsB)
 		default:
 			panic(fmt.Sprintf("invalid orderbook key prefix %X", kvA.Key[:1]))
 		}
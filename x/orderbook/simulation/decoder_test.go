@@ -10,9 +10,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
-	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/gauss/gauss/v4/simapp"
 	"github.com/gauss/gauss/v4/x/orderbook/simulation"
 	"github.com/gauss/gauss/v4/x/orderbook/types"
 )
@@ -36,15 +36,21 @@ func TestDecodeStore(t *testing.T) {
 
 	bondTime := time.Now().UTC()
 
-	pool := types.NewPool()
-	order := types.NewOrder()
-	txPairStats := types.NewTxPairStats()
+	pool := types.NewPool(ownerAddr1, ownerAddr1, nil, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), bondTime)
+	order := types.NewOrder(ownerAddr1, ownerAddr1, sdk.NewInt64Coin("quote", 100), sdk.NewInt64Coin("base", 10),
+		sdk.NewInt64DecCoin("quote", 10), 1, types.GoodTilTime, 0, bondTime)
+	orderIDs := types.OrderIDs{IDs: []types.OrderID{order.GetOrderID()}}
+	txPair := types.NewTxPair(order.GetTxPair())
+	txPairStats := types.NewTxPairStats(ownerAddr1, "base", "quote")
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.PoolKey, Value: cdc.MustMarshalBinaryBare(&pool)},
-			{Key: types.TxPairStatsKey, Value: cdc.MustMarshalBinaryBare(&txPairStats)},
 			{Key: types.OrderbookKey, Value: cdc.MustMarshalBinaryBare(&order)},
+			{Key: types.TxPairKey, Value: cdc.MustMarshalBinaryBare(&txPair)},
+			{Key: types.TxPairStatsKey, Value: cdc.MustMarshalBinaryBare(&txPairStats)},
+			{Key: types.GetOrderTimeQueueKey(bondTime), Value: cdc.MustMarshalBinaryBare(&orderIDs)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
 
@@ -54,7 +60,9 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"pool", fmt.Sprintf("%v\n%v", pool, pool)},
 		{"order", fmt.Sprintf("%v\n%v", order, order)},
+		{"txPair", fmt.Sprintf("%v\n%v", txPair, txPair)},
 		{"tps", fmt.Sprintf("%v\n%v", txPairStats, txPairStats)},
+		{"orderIDs", fmt.Sprintf("%v\n%v", orderIDs, orderIDs)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
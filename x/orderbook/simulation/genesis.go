@@ -7,32 +7,33 @@ import (
 	"fmt"
 	"math/rand"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/gauss/gauss/v4/x/orderbook/types"
 )
 
 // Simulation parameter constants
 const (
-	maxPools     = "max_pools"
-	minPledge    = "min_pledge"
+	maxPools  = "max_pools"
+	minPledge = "min_pledge"
 )
 
-//  randomized maxPools
+// GenMaxPools randomized maxPools
 func GenMaxPools(r *rand.Rand) (maxPools uint32) {
 	return uint32(r.Intn(250) + 1)
 }
 
-// randomized minPledge
-func GenMinPledge(r *rand.Rand) uint32 {
-	return uint32(r.Intn(types.DefaultPoolMinPledgeAmount.Sign()) + 1)
+// GenMinPledge randomized minPledge
+func GenMinPledge(r *rand.Rand) sdk.Int {
+	return sdk.NewInt(r.Int63n(types.DefaultPoolMinPledgeAmount.Int64()) + 1)
 }
 
 // RandomizedGenState generates a random GenesisState for staking
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
 	var (
-		maxPoolsL     uint32
-		minPledgeL    uint32
+		maxPoolsL  uint32
+		minPledgeL sdk.Int
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -46,6 +47,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 	)
 
 	params := types.DefaultParams()
+	params.PoolMaxCount = maxPoolsL
+	params.PoolMinPledgeAmount = minPledgeL
+
 	orderbookGenesis := types.NewGenesisState(params, []types.Pool{}, []types.Order{}, []types.TxPairStats{})
 
 	bz, err := json.MarshalIndent(&orderbookGenesis.Params, "", " ")
@@ -41,8 +41,8 @@ func TestRandomizedGenState(t *testing.T) {
 	var orderbookGenesis types.GenesisState
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &orderbookGenesis)
 
-	require.Equal(t, uint32(207), orderbookGenesis.Params.PoolMaxCount)
-	require.Equal(t, uint32(7), orderbookGenesis.Params.PoolMinPledgeAmount)
+	require.Equal(t, uint32(41), orderbookGenesis.Params.PoolMaxCount)
+	require.Equal(t, "9183117217", orderbookGenesis.Params.PoolMinPledgeAmount.String())
 }
 
 // TestRandomizedGenState tests abnormal scenarios of applying RandomizedGenState.
@@ -65,17 +65,6 @@ func TestRandomizedGenState1(t *testing.T) {
 				Cdc:       cdc,
 				Rand:      r,
 			}, "invalid memory address or nil pointer dereference"},
-		{
-			// panic => reason: numBonded != len(Accnounts)
-			module.SimulationState{
-				AppParams:    make(simtypes.AppParams),
-				Cdc:          cdc,
-				Rand:         r,
-				NumBonded:    4,
-				Accounts:     simtypes.RandomAccounts(r, 3),
-				InitialStake: 1000,
-				GenState:     make(map[string]json.RawMessage),
-			}, "invalid memory address or nil pointer dereference"},
 	}
 
 	for _, tt := range tests {
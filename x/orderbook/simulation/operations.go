@@ -2,9 +2,13 @@ package simulation
 
 import (
 	"math/rand"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
@@ -15,26 +19,30 @@ import (
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgCreatePool      = "op_weight_msg_create_pool"
-	OpWeightMsgAddPledge       = "op_weight_msg_add_pledge"
-	OpWeightMsgRedeemPledge    = "op_weight_msg_redeem_pledge"
-	OpWeightMsgPlaceOrder      = "op_weight_msg_place_order"
-	OpWeightMsgRevokeOrder     = "op_weight_msg_revoke_order"
-	OpWeightMsgAgreeOrderPair  = "op_weight_msg_agree_order_pair"
+	OpWeightMsgCreatePool     = "op_weight_msg_create_pool"
+	OpWeightMsgAddPledge      = "op_weight_msg_add_pledge"
+	OpWeightMsgRedeemPledge   = "op_weight_msg_redeem_pledge"
+	OpWeightMsgPlaceOrder     = "op_weight_msg_place_order"
+	OpWeightMsgRevokeOrder    = "op_weight_msg_revoke_order"
+	OpWeightMsgAgreeOrderPair = "op_weight_msg_agree_order_pair"
 )
 
+// simQuoteDenom is the denom expected by simulated orders when the owner holds
+// a single denom
+const simQuoteDenom = "simquote"
+
 // WeightedOperations returns all the operations from the module with their respective weights
 func WeightedOperations(
 	appParams simtypes.AppParams, cdc codec.JSONMarshaler, ak types.AccountKeeper,
 	bk types.BankKeeper, k keeper.Keeper,
 ) simulation.WeightedOperations {
 	var (
-		weightMsgCreatePool      int
-		weightMsgAddPledge       int
-		weightMsgRedeemPledge    int
-		weightMsgPlaceOrder      int
-		weightMsgRevokeOrder     int
-		weightMsgAgreeOrderPair  int
+		weightMsgCreatePool     int
+		weightMsgAddPledge      int
+		weightMsgRedeemPledge   int
+		weightMsgPlaceOrder     int
+		weightMsgRevokeOrder    int
+		weightMsgAgreeOrderPair int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreatePool, &weightMsgCreatePool, nil,
@@ -102,79 +110,340 @@ func WeightedOperations(
 }
 
 // SimulateMsgCreatePool generates a MsgCreatePool with random values
-// nolint: interfacer
 func SimulateMsgCreatePool(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		// ensure the pool doesn't exist already
+		if _, found := k.GetPool(ctx, simAccount.Address); found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePool, "pool already exists"), nil, nil
+		}
+
+		if k.GetPoolCount(ctx) >= k.PoolMaxCount(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePool, "max pools reached"), nil, nil
+		}
+
+		denom := k.PledgeDenom(ctx)
+		minPledge := k.PoolMinPledgeAmount(ctx)
+
+		balance := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(denom)
+		if balance.LT(minPledge) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePool, "insufficient balance"), nil, nil
+		}
+
+		amount := minPledge
+		if extra := balance.Sub(minPledge); extra.IsPositive() {
+			randExtra, err := simtypes.RandPositiveInt(r, extra)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePool, "unable to generate positive amount"), nil, err
+			}
+			amount = amount.Add(randExtra)
+		}
+
+		delegator, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgCreatePool(simAccount.Address, delegator.Address, nil, sdk.NewCoin(denom, amount))
 
-		// msg := types.NewMsgCreatePool()
-		msg := &types.MsgCreatePool{}
-		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+		return deliverMsg(r, app, ctx, ak, bk, simAccount, msg, msg.Pledge, chainID)
 	}
 }
 
 // SimulateMsgAddPledge generates a MsgAddPledge with random values
-// nolint: interfacer
 func SimulateMsgAddPledge(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		pool, simAccount, ok := randomPoolOwner(r, k, ctx, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddPledge, "unable to pick a pool"), nil, nil
+		}
 
-		// msg := types.NewMsgAddPledge()
-		msg := &types.MsgAddPledge{}
-		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+		balance := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(pool.Pledge.Denom)
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddPledge, "balance is negative"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddPledge, "unable to generate positive amount"), nil, err
+		}
+
+		msg := types.NewMsgAddPledge(simAccount.Address, sdk.NewCoin(pool.Pledge.Denom, amount))
+
+		return deliverMsg(r, app, ctx, ak, bk, simAccount, msg, msg.Pledge, chainID)
 	}
 }
 
 // SimulateMsgRedeemPledge generates a MsgRedeemPledge with random values
-// nolint: interfacer
 func SimulateMsgRedeemPledge(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		pool, simAccount, ok := randomPoolOwner(r, k, ctx, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemPledge, "unable to pick a pool"), nil, nil
+		}
+
+		// redeem everything from an idle pool, otherwise keep the minimum pledge
+		amount := pool.Pledge.Amount
+		if k.HasPoolOrders(ctx, simAccount.Address) || r.Intn(2) == 0 {
+			redeemable := pool.Pledge.Amount.Sub(k.PoolMinPledgeAmount(ctx))
+			if !redeemable.IsPositive() {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemPledge, "nothing to redeem"), nil, nil
+			}
 
-		// msg := types.NewMsgRedeemPledge()
-		msg := &types.MsgRedeemPledge{}
-		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+			var err error
+			amount, err = simtypes.RandPositiveInt(r, redeemable)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemPledge, "unable to generate positive amount"), nil, err
+			}
+		}
+
+		msg := types.NewMsgRedeemPledge(simAccount.Address, sdk.NewCoin(pool.Pledge.Denom, amount))
+
+		return deliverMsg(r, app, ctx, ak, bk, simAccount, msg, sdk.Coin{}, chainID)
 	}
 }
 
 // SimulateMsgPlaceOrder generates a MsgPlaceOrder with random values
-// nolint: interfacer
 func SimulateMsgPlaceOrder(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		pools := k.GetAllPools(ctx)
+		if len(pools) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlaceOrder, "number of pools equal zero"), nil, nil
+		}
+		pool := pools[r.Intn(len(pools))]
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
 
-		// msg := types.NewMsgPlaceOrder()
-		msg := &types.MsgPlaceOrder{}
-		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+		// denoms holding the tx-pair separator, as the shares of the amm pools,
+		// cannot be traded in a tx-pair
+		var spendable sdk.Coins
+		for _, coin := range bk.SpendableCoins(ctx, simAccount.Address) {
+			if !strings.Contains(coin.Denom, types.TxPairSeparator) {
+				spendable = append(spendable, coin)
+			}
+		}
+		if spendable.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlaceOrder, "no spendable coins"), nil, nil
+		}
+
+		myCoin := spendable[r.Intn(len(spendable))]
+		myAmount, err := simtypes.RandPositiveInt(r, myCoin.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlaceOrder, "unable to generate positive amount"), nil, err
+		}
+
+		expectDenom := simQuoteDenom
+		for _, coin := range spendable {
+			if coin.Denom != myCoin.Denom {
+				expectDenom = coin.Denom
+				break
+			}
+		}
+		if expectDenom == myCoin.Denom {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlaceOrder, "no denom to trade for"), nil, nil
+		}
+
+		// the price is quoted in the denom of either side, which makes the order a
+		// left (buy) or a right (sell) one
+		price := sdk.NewDecCoinFromDec(expectDenom, simtypes.RandomDecAmount(r, sdk.NewDec(10)))
+		if r.Intn(2) == 0 {
+			price.Denom = myCoin.Denom
+		}
+		if !price.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlaceOrder, "price is zero"), nil, nil
+		}
+
+		expectAmount, err := simtypes.RandPositiveInt(r, myAmount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlaceOrder, "unable to generate positive amount"), nil, err
+		}
+
+		myAsset := sdk.NewCoin(myCoin.Denom, myAmount)
+		timeInForce, goodTilHeight, goodTilTime := randTimeInForce(r, ctx)
+		msg := types.NewMsgPlaceOrder(
+			pool.GetPoolAddr(), simAccount.Address, myAsset, sdk.NewCoin(expectDenom, expectAmount), price, r.Uint64(),
+			timeInForce, goodTilHeight, goodTilTime,
+		)
+
+		return deliverMsg(r, app, ctx, ak, bk, simAccount, msg, myAsset, chainID)
 	}
 }
 
 // SimulateMsgRevokeOrder generates a MsgRevokeOrder with random values
-// nolint: interfacer
 func SimulateMsgRevokeOrder(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var orders []types.Order
+		k.IterateAllOrders(ctx, func(_ int64, order types.Order) bool {
+			orders = append(orders, order)
+			return false
+		})
+		if len(orders) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeOrder, "number of orders equal zero"), nil, nil
+		}
+		order := orders[r.Intn(len(orders))]
+
+		simAccount, found := simtypes.FindAccount(accs, order.GetOwnerAddr())
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeOrder, "order owner not found"), nil, nil
+		}
 
-		// msg := types.NewMsgRevokeOrder()
-		msg := &types.MsgRevokeOrder{}
-		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+		msg := types.NewMsgRevokeOrder(
+			order.GetPoolAddr(), simAccount.Address, order.GetTxPair(), order.IsLeftOrder(), order.Nonce,
+		)
+
+		return deliverMsg(r, app, ctx, ak, bk, simAccount, msg, sdk.Coin{}, chainID)
 	}
 }
 
-// SimulateMsgAgreeOrderPair generates a MsgAgreeOrderPair with random values
-// nolint: interfacer
+// SimulateMsgAgreeOrderPair generates a MsgAgreeOrderPair matching the first
+// crossing orders of a random pool
 func SimulateMsgAgreeOrderPair(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		pools := k.GetAllPools(ctx)
+		if len(pools) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAgreeOrderPair, "number of pools equal zero"), nil, nil
+		}
+		pool := pools[r.Intn(len(pools))]
+
+		simAccount, found := simtypes.FindAccount(accs, pool.GetDelegatorAddr())
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAgreeOrderPair, "pool delegator not found"), nil, nil
+		}
+
+		left, right, found := findCrossingOrders(ctx, k, pool)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAgreeOrderPair, "no crossing orders"), nil, nil
+		}
+
+		// trade at the price of the right order, as much as both orders can fill
+		maxAmount := sdk.MinInt(left.ExpectAsset.Amount, right.MyAsset.Amount)
+		maxAmount = sdk.MinInt(maxAmount, left.MyAsset.Amount.ToDec().Quo(right.Price.Amount).TruncateInt())
+		if !right.Price.Amount.MulInt(maxAmount).TruncateInt().IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAgreeOrderPair, "orders can't fill"), nil, nil
+		}
 
-		// msg := types.NewMsgAgreeOrderPair()
-		msg := &types.MsgAgreeOrderPair{}
-		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+		msg := types.NewMsgAgreeOrderPair(
+			simAccount.Address, pool.GetPoolAddr(), left.GetTxPair(), left.Nonce, right.Nonce, right.Price, sdk.NewInt(-1),
+		)
+
+		return deliverMsg(r, app, ctx, ak, bk, simAccount, msg, sdk.Coin{}, chainID)
 	}
 }
+
+// randomPoolOwner picks a random pool owned by one of the simulation accounts
+func randomPoolOwner(
+	r *rand.Rand, k keeper.Keeper, ctx sdk.Context, accs []simtypes.Account,
+) (types.Pool, simtypes.Account, bool) {
+	pools := k.GetAllPools(ctx)
+	if len(pools) == 0 {
+		return types.Pool{}, simtypes.Account{}, false
+	}
+
+	pool := pools[r.Intn(len(pools))]
+	simAccount, found := simtypes.FindAccount(accs, pool.GetPoolAddr())
+
+	return pool, simAccount, found
+}
+
+// findCrossingOrders returns the first left and right orders of a tx-pair of
+// the pool whose prices cross
+func findCrossingOrders(ctx sdk.Context, k keeper.Keeper, pool types.Pool) (left, right types.Order, found bool) {
+	var leftOrders []types.Order
+	k.IterateAllOrders(ctx, func(_ int64, order types.Order) bool {
+		if order.PoolAddress == pool.Address && order.IsLeftOrder() {
+			leftOrders = append(leftOrders, order)
+		}
+		return false
+	})
+
+	for _, left = range leftOrders {
+		k.IterateTxPairOrders(ctx, pool.GetPoolAddr(), left.GetTxPair(), false, func(_ int64, order types.Order) bool {
+			if order.Price.Amount.LTE(left.Price.Amount) {
+				right, found = order, true
+			}
+			return found
+		})
+
+		if found {
+			return left, right, true
+		}
+	}
+
+	return left, right, false
+}
+
+// deliverMsg signs the message with random fees on top of the spent coin and
+// delivers it
+// randTimeInForce returns a random time in force which never fails the order,
+// FOK orders are left out since they fail unless they are entirely filled
+func randTimeInForce(r *rand.Rand, ctx sdk.Context) (types.TimeInForce, int64, time.Time) {
+	switch r.Intn(4) {
+	case 1:
+		return types.ImmediateOrCancel, 0, time.Time{}
+	case 2:
+		return types.GoodTilHeight, ctx.BlockHeight() + int64(simtypes.RandIntBetween(r, 1, 10)), time.Time{}
+	case 3:
+		return types.GoodTilTime, 0, ctx.BlockHeader().Time.Add(time.Duration(simtypes.RandIntBetween(r, 1, 60)) * time.Minute)
+	default:
+		return types.GoodTilCancelled, 0, time.Time{}
+	}
+}
+
+func deliverMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	simAccount simtypes.Account, msg sdk.Msg, spent sdk.Coin, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := ak.GetAccount(ctx, simAccount.Address)
+	spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+	var (
+		fees sdk.Coins
+		err  error
+	)
+
+	coins := spendable
+	if spent.Denom != "" {
+		var hasNeg bool
+		if coins, hasNeg = spendable.SafeSub(sdk.Coins{spent}); hasNeg {
+			coins = nil
+		}
+	}
+
+	if !coins.Empty() {
+		fees, err = simtypes.RandomFees(r, ctx, coins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
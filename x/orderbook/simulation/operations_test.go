@@ -9,19 +9,16 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
-	"github.com/cosmos/cosmos-sdk/simapp"
-	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
-	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
-	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/gauss/gauss/v4/simapp"
+	simappparams "github.com/gauss/gauss/v4/simapp/params"
 	"github.com/gauss/gauss/v4/x/orderbook/simulation"
 	"github.com/gauss/gauss/v4/x/orderbook/types"
 )
 
 // TestWeightedOperations tests the weights of the operations.
 func TestWeightedOperations(t *testing.T) {
-
 	app, ctx := createTestApp(false)
 
 	ctx.WithChainID("test-chain")
@@ -30,7 +27,7 @@ func TestWeightedOperations(t *testing.T) {
 	appParams := make(simtypes.AppParams)
 
 	weightesOps := simulation.WeightedOperations(appParams, cdc, app.AccountKeeper,
-		app.BankKeeper, app.StakingKeeper,
+		app.BankKeeper, app.OrderbookKeeper,
 	)
 
 	s := rand.NewSource(1)
@@ -44,9 +41,9 @@ func TestWeightedOperations(t *testing.T) {
 	}{{simappparams.DefaultWeightMsgCreatePool, types.ModuleName, types.TypeMsgCreatePool},
 		{simappparams.DefaultWeightMsgAddPledge, types.ModuleName, types.TypeMsgAddPledge},
 		{simappparams.DefaultWeightMsgRedeemPledge, types.ModuleName, types.TypeMsgRedeemPledge},
-		{simappparams.DefaultWeightMsgPalceOrder, types.ModuleName, types.TypeMsgPlaceOrder},
+		{simappparams.DefaultWeightMsgPlaceOrder, types.ModuleName, types.TypeMsgPlaceOrder},
 		{simappparams.DefaultWeightMsgRevokeOrder, types.ModuleName, types.TypeMsgRevokeOrder},
-		{simappparams.DefaultWeightMsgAgreeOrder, types.ModuleName, types.TypeMsgAgreeOrder},
+		{simappparams.DefaultWeightMsgAgreeOrderPair, types.ModuleName, types.TypeMsgAgreeOrderPair},
 	}
 
 	for i, w := range weightesOps {
@@ -68,6 +65,24 @@ func TestSimulateMsgCreatePool(t *testing.T) {
 	// setup 3 accounts
 	s := rand.NewSource(1)
 	r := rand.New(s)
+	accounts := getTestingAccounts(t, r, app, ctx, 3)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgCreatePool(app.AccountKeeper, app.BankKeeper, app.OrderbookKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgCreatePool
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgCreatePool, msg.Type())
+	require.Equal(t, sdk.DefaultBondDenom, msg.Pledge.Denom)
+	require.True(t, msg.Pledge.Amount.GTE(app.OrderbookKeeper.PoolMinPledgeAmount(ctx)))
+	require.Len(t, futureOperations, 0)
 }
 
 // TestSimulateMsgAddPledge tests the normal scenario of a valid message of type TypeMsgAddPledge.
@@ -80,6 +95,27 @@ func TestSimulateMsgAddPledge(t *testing.T) {
 	// setup 3 accounts
 	s := rand.NewSource(1)
 	r := rand.New(s)
+	accounts := getTestingAccounts(t, r, app, ctx, 3)
+
+	// setup accounts[0] as pool
+	pool := createTestPool(t, app, ctx, accounts[0], accounts[1])
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgAddPledge(app.AccountKeeper, app.BankKeeper, app.OrderbookKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgAddPledge
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgAddPledge, msg.Type())
+	require.Equal(t, pool.Address, msg.OwnerAddress)
+	require.Equal(t, sdk.DefaultBondDenom, msg.Pledge.Denom)
+	require.Len(t, futureOperations, 0)
 }
 
 // TestSimulateMsgRedeemPledge tests the normal scenario of a valid message of type TypeMsgRedeemPledge.
@@ -92,6 +128,27 @@ func TestSimulateMsgRedeemPledge(t *testing.T) {
 	// setup 3 accounts
 	s := rand.NewSource(1)
 	r := rand.New(s)
+	accounts := getTestingAccounts(t, r, app, ctx, 3)
+
+	// setup accounts[0] as pool
+	pool := createTestPool(t, app, ctx, accounts[0], accounts[1])
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgRedeemPledge(app.AccountKeeper, app.BankKeeper, app.OrderbookKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgRedeemPledge
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgRedeemPledge, msg.Type())
+	require.Equal(t, pool.Address, msg.OwnerAddress)
+	require.True(t, msg.Pledge.Amount.LTE(pool.Pledge.Amount))
+	require.Len(t, futureOperations, 0)
 }
 
 // TestSimulateMsgPlaceOrder tests the normal scenario of a valid message of type TypeMsgPlaceOrder.
@@ -104,6 +161,27 @@ func TestSimulateMsgPlaceOrder(t *testing.T) {
 	// setup 3 accounts
 	s := rand.NewSource(1)
 	r := rand.New(s)
+	accounts := getTestingAccounts(t, r, app, ctx, 3)
+
+	// setup accounts[0] as pool
+	pool := createTestPool(t, app, ctx, accounts[0], accounts[1])
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgPlaceOrder(app.AccountKeeper, app.BankKeeper, app.OrderbookKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgPlaceOrder
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgPlaceOrder, msg.Type())
+	require.Equal(t, pool.Address, msg.PoolAddress)
+	require.Equal(t, sdk.DefaultBondDenom, msg.MyAsset.Denom)
+	require.Len(t, futureOperations, 0)
 }
 
 // TestSimulateMsgRevokeOrder tests the normal scenario of a valid message of type TypeMsgRevokeOrder.
@@ -116,12 +194,37 @@ func TestSimulateMsgRevokeOrder(t *testing.T) {
 	// setup 3 accounts
 	s := rand.NewSource(5)
 	r := rand.New(s)
-
+	accounts := getTestingAccounts(t, r, app, ctx, 3)
+
+	// setup accounts[0] as pool and an order of accounts[2]
+	pool := createTestPool(t, app, ctx, accounts[0], accounts[1])
+	order := placeTestOrder(t, app, ctx, pool, accounts[2],
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("quote", 200), sdk.NewInt64DecCoin("quote", 2), 1)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgRevokeOrder(app.AccountKeeper, app.BankKeeper, app.OrderbookKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgRevokeOrder
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgRevokeOrder, msg.Type())
+	require.Equal(t, pool.Address, msg.PoolAddress)
+	require.Equal(t, order.OwnerAddress, msg.DelegatorAddress)
+	require.Equal(t, order.GetTxPair(), msg.TxPair)
+	require.False(t, msg.IsLeftOrder)
+	require.Equal(t, uint64(1), msg.OrderId)
+	require.Len(t, futureOperations, 0)
 }
 
-// TestSimulateMsgAgreeOrder tests the normal scenario of a valid message of type TypeMsgAgreeOrder.
+// TestSimulateMsgAgreeOrderPair tests the normal scenario of a valid message of type TypeMsgAgreeOrderPair.
 // Abonormal scenarios, where the message is created by an errors, are not tested here.
-func TestSimulateMsgAgreeOrder(t *testing.T) {
+func TestSimulateMsgAgreeOrderPair(t *testing.T) {
 	app, ctx := createTestApp(false)
 	blockTime := time.Now().UTC()
 	ctx = ctx.WithBlockTime(blockTime)
@@ -129,17 +232,42 @@ func TestSimulateMsgAgreeOrder(t *testing.T) {
 	// setup 3 accounts
 	s := rand.NewSource(5)
 	r := rand.New(s)
-
+	accounts := getTestingAccounts(t, r, app, ctx, 3)
+
+	// setup accounts[0] as pool delegated to accounts[1], and crossing orders
+	pool := createTestPool(t, app, ctx, accounts[0], accounts[1])
+	fundTestAccount(t, app, ctx, accounts[2], sdk.NewInt64Coin("quote", 1000))
+	placeTestOrder(t, app, ctx, pool, accounts[2],
+		sdk.NewInt64Coin("quote", 300), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64DecCoin("quote", 3), 1)
+	placeTestOrder(t, app, ctx, pool, accounts[0],
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), sdk.NewInt64Coin("quote", 100), sdk.NewInt64DecCoin("quote", 2), 2)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgAgreeOrderPair(app.AccountKeeper, app.BankKeeper, app.OrderbookKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgAgreeOrderPair
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgAgreeOrderPair, msg.Type())
+	require.Equal(t, accounts[1].Address.String(), msg.DelegatorAddress)
+	require.Equal(t, uint64(1), msg.LeftOrderId)
+	require.Equal(t, uint64(2), msg.RightOrderId)
+	require.Equal(t, sdk.NewInt64DecCoin("quote", 2), msg.Price)
+	require.Len(t, futureOperations, 0)
 }
 
 // returns context and an app with updated mint keeper
 func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
-	// sdk.PowerReduction = sdk.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
 	app := simapp.Setup(isCheckTx)
 
 	ctx := app.BaseApp.NewContext(isCheckTx, tmproto.Header{})
-	app.MintKeeper.SetParams(ctx, minttypes.DefaultParams())
-	app.MintKeeper.SetMinter(ctx, minttypes.DefaultInitialMinter())
+	app.OrderbookKeeper.SetParams(ctx, types.DefaultParams())
 
 	return app, ctx
 }
@@ -160,3 +288,29 @@ func getTestingAccounts(t *testing.T, r *rand.Rand, app *simapp.SimApp, ctx sdk.
 
 	return accounts
 }
+
+func fundTestAccount(t *testing.T, app *simapp.SimApp, ctx sdk.Context, account simtypes.Account, coin sdk.Coin) {
+	balances := app.BankKeeper.GetAllBalances(ctx, account.Address)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, account.Address, balances.Add(coin)))
+}
+
+func createTestPool(t *testing.T, app *simapp.SimApp, ctx sdk.Context, owner, delegator simtypes.Account) types.Pool {
+	pledge := sdk.NewCoin(sdk.DefaultBondDenom, app.OrderbookKeeper.PoolMinPledgeAmount(ctx).MulRaw(2))
+	require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, owner.Address, types.ModuleName, sdk.NewCoins(pledge)))
+
+	return app.OrderbookKeeper.CreatePool(ctx, owner.Address, delegator.Address, nil, pledge, ctx.BlockTime())
+}
+
+func placeTestOrder(
+	t *testing.T, app *simapp.SimApp, ctx sdk.Context, pool types.Pool, owner simtypes.Account,
+	myAsset, expectAsset sdk.Coin, price sdk.DecCoin, nonce uint64,
+) types.Order {
+	require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, owner.Address, types.ModuleName, sdk.NewCoins(myAsset)))
+
+	order := types.NewOrder(
+		pool.GetPoolAddr(), owner.Address, myAsset, expectAsset, price, nonce, types.GoodTilCancelled, 0, time.Time{},
+	)
+	require.NoError(t, app.OrderbookKeeper.PlaceOrder(ctx, order))
+
+	return order
+}
@@ -1,4 +1,4 @@
-package simulation 
+package simulation
 
 // DONTCOVER
 
@@ -23,7 +23,7 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 		),
 		simulation.NewSimParamChange(types.ModuleName, string(types.KeyPoolMinPledgeAmount),
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("%d", GenMinPledge(r))
+				return fmt.Sprintf("\"%s\"", GenMinPledge(r))
 			},
 		),
 	}
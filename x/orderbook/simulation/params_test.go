@@ -20,7 +20,7 @@ func TestParamChanges(t *testing.T) {
 		subspace    string
 	}{
 		{"orderbook/MaxPools", "MaxPools", "82", "orderbook"},
-		{"orderbook/MinPledge", "MinPledge", "9149", "orderbook"},
+		{"orderbook/MinPledge", "MinPledge", "\"3082153552\"", "orderbook"},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/orderbook interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreatePool{}, "gauss/orderbook/MsgCreatePool", nil)
+	cdc.RegisterConcrete(&MsgAddPledge{}, "gauss/orderbook/MsgAddPledge", nil)
+	cdc.RegisterConcrete(&MsgRedeemPledge{}, "gauss/orderbook/MsgRedeemPledge", nil)
+	cdc.RegisterConcrete(&MsgPlaceOrder{}, "gauss/orderbook/MsgPlaceOrder", nil)
+	cdc.RegisterConcrete(&MsgRevokeOrder{}, "gauss/orderbook/MsgRevokeOrder", nil)
+	cdc.RegisterConcrete(&MsgAgreeOrderPair{}, "gauss/orderbook/MsgAgreeOrderPair", nil)
+	cdc.RegisterConcrete(&MsgAgreeOrderPairWithAmount{}, "gauss/orderbook/MsgAgreeOrderPairWithAmount", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreatePool{},
+		&MsgAddPledge{},
+		&MsgRedeemPledge{},
+		&MsgPlaceOrder{},
+		&MsgRevokeOrder{},
+		&MsgAgreeOrderPair{},
+		&MsgAgreeOrderPairWithAmount{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/orderbook module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/orderbook and
+	// defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/orderbook module sentinel errors
+var (
+	ErrEmptyPoolAddr          = sdkerrors.Register(ModuleName, 2, "empty pool address")
+	ErrPoolExists             = sdkerrors.Register(ModuleName, 3, "pool already exists")
+	ErrNoPoolFound            = sdkerrors.Register(ModuleName, 4, "pool does not exist")
+	ErrMaxPoolsReached        = sdkerrors.Register(ModuleName, 5, "maximum number of pools reached")
+	ErrInsufficientPledge     = sdkerrors.Register(ModuleName, 6, "pledge is below the minimum pledge amount")
+	ErrBadDenom               = sdkerrors.Register(ModuleName, 7, "invalid coin denomination")
+	ErrInvalidAmount          = sdkerrors.Register(ModuleName, 8, "invalid amount")
+	ErrPoolHasOrders          = sdkerrors.Register(ModuleName, 9, "pool still has open orders")
+	ErrInvalidTxPair          = sdkerrors.Register(ModuleName, 10, "invalid tx-pair")
+	ErrInvalidPrice           = sdkerrors.Register(ModuleName, 11, "invalid price")
+	ErrOrderExists            = sdkerrors.Register(ModuleName, 12, "order already exists")
+	ErrNoOrderFound           = sdkerrors.Register(ModuleName, 13, "order does not exist")
+	ErrNotPoolDelegator       = sdkerrors.Register(ModuleName, 14, "not the delegator of the pool")
+	ErrNotOrderOwner          = sdkerrors.Register(ModuleName, 15, "neither the owner of the order nor the delegator of the pool")
+	ErrPriceNotMatch          = sdkerrors.Register(ModuleName, 16, "price does not match the orders")
+	ErrInsufficientOrderAsset = sdkerrors.Register(ModuleName, 17, "order has insufficient asset")
+	ErrNoDefiFound            = sdkerrors.Register(ModuleName, 18, "defi does not exist")
+	ErrInvalidTimeInForce     = sdkerrors.Register(ModuleName, 19, "invalid time in force")
+	ErrOrderExpired           = sdkerrors.Register(ModuleName, 20, "order is already expired")
+	ErrOrderNotFilled         = sdkerrors.Register(ModuleName, 21, "fill or kill order cannot be entirely filled")
+)
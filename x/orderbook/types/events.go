@@ -0,0 +1,32 @@
+package types
+
+const (
+	AttributeValueCategory = ModuleName
+
+	EventTypeCreatePool     = "create_pool"
+	EventTypeAddPledge      = "add_pledge"
+	EventTypeRedeemPledge   = "redeem_pledge"
+	EventTypeRemovePool     = "remove_pool"
+	EventTypePlaceOrder     = "place_order"
+	EventTypeRevokeOrder    = "revoke_order"
+	EventTypeAgreeOrderPair = "agree_order_pair"
+	EventTypeMatchOrder     = "match_order"
+	EventTypeExpireOrder    = "expire_order"
+	EventTypeCancelOrder    = "cancel_order"
+
+	AttributeKeyPool         = "pool"
+	AttributeKeyOwner        = "owner"
+	AttributeKeyDelegator    = "delegator"
+	AttributeKeyDefi         = "defi"
+	AttributeKeyTxPair       = "tx_pair"
+	AttributeKeyOrderID      = "order_id"
+	AttributeKeyLeftOrderID  = "left_order_id"
+	AttributeKeyRightOrderID = "right_order_id"
+	AttributeKeyIsLeftOrder  = "is_left_order"
+	AttributeKeyPrice        = "price"
+	AttributeKeyLeftAmount   = "left_amount"
+	AttributeKeyRightAmount  = "right_amount"
+	AttributeKeyRefund       = "refund"
+	AttributeKeyTimeInForce  = "time_in_force"
+	AttributeKeyReason       = "reason"
+)
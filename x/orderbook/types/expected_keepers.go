@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	defitypes "github.com/gauss/gauss/v4/x/defi/types"
+)
+
+// AccountKeeper defines the expected account keeper (noalias)
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI // only used for simulation
+
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
+}
+
+// BankKeeper defines the expected interface needed to escrow pledges and orders.
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
+
+// StakingKeeper defines the expected staking keeper used by the ante decorator
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+}
+
+// TokenKeeper defines the expected token keeper used to check the assets of the
+// resting orders before they are filled
+type TokenKeeper interface {
+	ValidateTransfer(ctx sdk.Context, sender sdk.AccAddress, coins sdk.Coins) error
+}
+
+// DefiKeeper defines the expected defi keeper used to reward the market makers
+type DefiKeeper interface {
+	Defi(ctx sdk.Context, address sdk.ValAddress) defitypes.DefiI
+	MintTokens(ctx sdk.Context, defiAddr sdk.ValAddress, marketAddr string, marketRate sdk.Dec, toModule bool)
+}
@@ -0,0 +1,16 @@
+package types
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, pools []Pool, orders []Order, txPairsStats []TxPairStats) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		Pools:        pools,
+		Orders:       orders,
+		TxPairsStats: txPairsStats,
+	}
+}
+
+// DefaultGenesisState returns a default orderbook module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []Pool{}, []Order{}, []TxPairStats{})
+}
@@ -0,0 +1,146 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "orderbook"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouteKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace default name for parameter store
+	DefaultParamspace = ModuleName
+
+	// TxPairSeparator separates the base and quote denoms of a tx-pair
+	TxPairSeparator = "/"
+
+	// priceKeyLen is the length of the prices in the order priority keys, a
+	// decimal holds at most 255 bits
+	priceKeyLen = 32
+)
+
+var (
+	PoolKey        = []byte{0x21} // prefix for each key to a pool
+	TxPairKey      = []byte{0x22} // prefix for each key to a tx-pair of a pool
+	OrderbookKey   = []byte{0x31} // prefix for each key to an order
+	OrderSeqKey    = []byte{0x32} // key for the sequence of the next placed order
+	OrderSeqsKey   = []byte{0x33} // prefix for each key to the sequence of an order
+	OrderPriceKey  = []byte{0x34} // prefix for each key to an order in the price-time priority index
+	TxPairStatsKey = []byte{0x41} // prefix for each key to the stats of a tx-pair
+
+	OrderTimeQueueKey   = []byte{0x51} // prefix for the timestamps in the order expiry queue
+	OrderHeightQueueKey = []byte{0x52} // prefix for the heights in the order expiry queue
+)
+
+// GetPoolKey returns the key of the pool owned by the given address
+// VALUE: orderbook/Pool
+func GetPoolKey(poolAddr sdk.AccAddress) []byte {
+	return append(PoolKey, poolAddr.Bytes()...)
+}
+
+// GetTxPairsKey returns the prefix of all the tx-pairs of a pool
+func GetTxPairsKey(poolAddr sdk.AccAddress) []byte {
+	return append(TxPairKey, lengthPrefixed(poolAddr.Bytes())...)
+}
+
+// GetTxPairKey returns the key of a tx-pair of a pool
+// VALUE: orderbook/TxPair
+func GetTxPairKey(poolAddr sdk.AccAddress, txPair string) []byte {
+	return append(GetTxPairsKey(poolAddr), []byte(txPair)...)
+}
+
+// GetPoolOrdersKey returns the prefix of all the orders of a pool
+func GetPoolOrdersKey(poolAddr sdk.AccAddress) []byte {
+	return append(OrderbookKey, lengthPrefixed(poolAddr.Bytes())...)
+}
+
+// GetTxPairOrdersKey returns the prefix of the left or right orders of a tx-pair
+func GetTxPairOrdersKey(poolAddr sdk.AccAddress, txPair string, isLeftOrder bool) []byte {
+	side := byte(0x00)
+	if isLeftOrder {
+		side = 0x01
+	}
+
+	key := append(GetPoolOrdersKey(poolAddr), lengthPrefixed([]byte(txPair))...)
+	return append(key, side)
+}
+
+// GetOrderKey returns the key of a left or right order of a tx-pair
+// VALUE: orderbook/Order
+func GetOrderKey(poolAddr sdk.AccAddress, txPair string, isLeftOrder bool, orderID uint64) []byte {
+	return append(GetTxPairOrdersKey(poolAddr, txPair, isLeftOrder), sdk.Uint64ToBigEndian(orderID)...)
+}
+
+// GetOrderSeqKey returns the key of the sequence an order was placed at
+// VALUE: BigEndian(Sequence)
+func GetOrderSeqKey(poolAddr sdk.AccAddress, txPair string, isLeftOrder bool, orderID uint64) []byte {
+	return append(OrderSeqsKey, GetOrderKey(poolAddr, txPair, isLeftOrder, orderID)[len(OrderbookKey):]...)
+}
+
+// GetTxPairPriorityKey returns the prefix of the left or right orders of a
+// tx-pair in the order they are filled
+func GetTxPairPriorityKey(poolAddr sdk.AccAddress, txPair string, isLeftOrder bool) []byte {
+	return append(OrderPriceKey, GetTxPairOrdersKey(poolAddr, txPair, isLeftOrder)[len(OrderbookKey):]...)
+}
+
+// GetOrderPriorityKey returns the key of an order in the price-time priority
+// index of its tx-pair: the left orders by descending and the right orders by
+// ascending price, then by the sequence they were placed at
+// VALUE: BigEndian(OrderID)
+func GetOrderPriorityKey(poolAddr sdk.AccAddress, txPair string, isLeftOrder bool, price sdk.Dec, seq uint64) []byte {
+	priceKey := price.BigInt().FillBytes(make([]byte, priceKeyLen))
+	if isLeftOrder {
+		for i := range priceKey {
+			priceKey[i] = ^priceKey[i]
+		}
+	}
+
+	key := append(GetTxPairPriorityKey(poolAddr, txPair, isLeftOrder), priceKey...)
+	return append(key, sdk.Uint64ToBigEndian(seq)...)
+}
+
+// GetOrderKeyFromPriorityKey returns the key of the order of a priority key,
+// both keys share the pool, the tx-pair and the side of the order
+func GetOrderKeyFromPriorityKey(priorityKey []byte, orderID uint64) []byte {
+	txPairOrdersKey := priorityKey[len(OrderPriceKey) : len(priorityKey)-priceKeyLen-8]
+	key := append(append([]byte{}, OrderbookKey...), txPairOrdersKey...)
+	return append(key, sdk.Uint64ToBigEndian(orderID)...)
+}
+
+// GetPoolTxPairsStatsKey returns the prefix of all the tx-pair stats of a pool
+func GetPoolTxPairsStatsKey(poolAddr sdk.AccAddress) []byte {
+	return append(TxPairStatsKey, lengthPrefixed(poolAddr.Bytes())...)
+}
+
+// GetTxPairStatsKey returns the key of the stats of a tx-pair of a pool
+// VALUE: orderbook/TxPairStats
+func GetTxPairStatsKey(poolAddr sdk.AccAddress, txPair string) []byte {
+	return append(GetPoolTxPairsStatsKey(poolAddr), []byte(txPair)...)
+}
+
+// GetOrderTimeQueueKey returns the key of the GTT orders expiring at a time
+// VALUE: orderbook/OrderIDs
+func GetOrderTimeQueueKey(timestamp time.Time) []byte {
+	return append(OrderTimeQueueKey, sdk.FormatTimeBytes(timestamp)...)
+}
+
+// GetOrderHeightQueueKey returns the key of the GTH orders expiring at a height
+// VALUE: orderbook/OrderIDs
+func GetOrderHeightQueueKey(height int64) []byte {
+	return append(OrderHeightQueueKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func lengthPrefixed(bz []byte) []byte {
+	return append([]byte{byte(len(bz))}, bz...)
+}
@@ -0,0 +1,382 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgCreatePool               = "create_pool"
+	TypeMsgAddPledge                = "add_pledge"
+	TypeMsgRedeemPledge             = "redeem_pledge"
+	TypeMsgPlaceOrder               = "place_order"
+	TypeMsgRevokeOrder              = "revoke_order"
+	TypeMsgAgreeOrderPair           = "agree_order_pair"
+	TypeMsgAgreeOrderPairWithAmount = "agree_order_pair_with_amount"
+)
+
+var (
+	_ sdk.Msg = &MsgCreatePool{}
+	_ sdk.Msg = &MsgAddPledge{}
+	_ sdk.Msg = &MsgRedeemPledge{}
+	_ sdk.Msg = &MsgPlaceOrder{}
+	_ sdk.Msg = &MsgRevokeOrder{}
+	_ sdk.Msg = &MsgAgreeOrderPair{}
+	_ sdk.Msg = &MsgAgreeOrderPairWithAmount{}
+)
+
+// NewMsgCreatePool creates a new MsgCreatePool instance.
+func NewMsgCreatePool(ownerAddr, delAddr sdk.AccAddress, defiAddr sdk.ValAddress, pledge sdk.Coin) *MsgCreatePool {
+	msg := &MsgCreatePool{
+		OwnerAddress:     ownerAddr.String(),
+		DelegatorAddress: delAddr.String(),
+		Pledge:           pledge,
+	}
+	if defiAddr != nil {
+		msg.DefiAddress = defiAddr.String()
+	}
+
+	return msg
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreatePool) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreatePool) Type() string { return TypeMsgCreatePool }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreatePool) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCreatePool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreatePool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address (%s)", err)
+	}
+	if msg.DefiAddress != "" {
+		if _, err := sdk.ValAddressFromBech32(msg.DefiAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid defi address (%s)", err)
+		}
+	}
+
+	return validatePositiveCoin(msg.Pledge, "pledge")
+}
+
+// NewMsgAddPledge creates a new MsgAddPledge instance.
+func NewMsgAddPledge(ownerAddr sdk.AccAddress, pledge sdk.Coin) *MsgAddPledge {
+	return &MsgAddPledge{
+		OwnerAddress: ownerAddr.String(),
+		Pledge:       pledge,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgAddPledge) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgAddPledge) Type() string { return TypeMsgAddPledge }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAddPledge) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgAddPledge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAddPledge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	return validatePositiveCoin(msg.Pledge, "pledge")
+}
+
+// NewMsgRedeemPledge creates a new MsgRedeemPledge instance.
+func NewMsgRedeemPledge(ownerAddr sdk.AccAddress, pledge sdk.Coin) *MsgRedeemPledge {
+	return &MsgRedeemPledge{
+		OwnerAddress: ownerAddr.String(),
+		Pledge:       pledge,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRedeemPledge) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRedeemPledge) Type() string { return TypeMsgRedeemPledge }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRedeemPledge) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRedeemPledge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRedeemPledge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	return validatePositiveCoin(msg.Pledge, "pledge")
+}
+
+// NewMsgPlaceOrder creates a new MsgPlaceOrder instance.
+func NewMsgPlaceOrder(poolAddr, ownerAddr sdk.AccAddress, myAsset, expectAsset sdk.Coin,
+	price sdk.DecCoin, orderID uint64, timeInForce TimeInForce, goodTilHeight int64, goodTilTime time.Time) *MsgPlaceOrder {
+	return &MsgPlaceOrder{
+		PoolAddress:   poolAddr.String(),
+		OwnerAddress:  ownerAddr.String(),
+		MyAsset:       myAsset,
+		Price:         price,
+		ExpectAsset:   expectAsset,
+		OrderId:       orderID,
+		TimeInForce:   timeInForce,
+		GoodTilHeight: goodTilHeight,
+		GoodTilTime:   goodTilTime,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgPlaceOrder) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgPlaceOrder) Type() string { return TypeMsgPlaceOrder }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgPlaceOrder) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgPlaceOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgPlaceOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.PoolAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pool address (%s)", err)
+	}
+
+	order := Order{
+		PoolAddress:   msg.PoolAddress,
+		OwnerAddress:  msg.OwnerAddress,
+		MyAsset:       msg.MyAsset,
+		Price:         msg.Price,
+		ExpectAsset:   msg.ExpectAsset,
+		Nonce:         msg.OrderId,
+		TimeInForce:   msg.TimeInForce,
+		GoodTilHeight: msg.GoodTilHeight,
+		GoodTilTime:   msg.GoodTilTime,
+	}
+
+	return order.Validate()
+}
+
+// NewMsgRevokeOrder creates a new MsgRevokeOrder instance.
+func NewMsgRevokeOrder(poolAddr, delAddr sdk.AccAddress, txPair string, isLeftOrder bool, orderID uint64) *MsgRevokeOrder {
+	return &MsgRevokeOrder{
+		PoolAddress:      poolAddr.String(),
+		DelegatorAddress: delAddr.String(),
+		TxPair:           txPair,
+		OrderId:          orderID,
+		IsLeftOrder:      isLeftOrder,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRevokeOrder) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRevokeOrder) Type() string { return TypeMsgRevokeOrder }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRevokeOrder) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRevokeOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevokeOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.PoolAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pool address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address (%s)", err)
+	}
+
+	_, _, err := ParseTxPair(msg.TxPair)
+	return err
+}
+
+// NewMsgAgreeOrderPair creates a new MsgAgreeOrderPair instance. A negative
+// amount agrees the largest amount both orders can fill.
+func NewMsgAgreeOrderPair(delAddr, poolAddr sdk.AccAddress, txPair string, leftOrderID, rightOrderID uint64,
+	price sdk.DecCoin, amount sdk.Int) *MsgAgreeOrderPair {
+	return &MsgAgreeOrderPair{
+		PoolAddress:      poolAddr.String(),
+		DelegatorAddress: delAddr.String(),
+		TxPair:           txPair,
+		LeftOrderId:      leftOrderID,
+		RightOrderId:     rightOrderID,
+		Amount:           amount,
+		Price:            price,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgAgreeOrderPair) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgAgreeOrderPair) Type() string { return TypeMsgAgreeOrderPair }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAgreeOrderPair) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgAgreeOrderPair) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAgreeOrderPair) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.PoolAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pool address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address (%s)", err)
+	}
+
+	_, quoteDenom, err := ParseTxPair(msg.TxPair)
+	if err != nil {
+		return err
+	}
+
+	if !msg.Price.IsValid() || msg.Price.IsZero() || msg.Price.Denom != quoteDenom {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "%s of tx-pair %s", msg.Price, msg.TxPair)
+	}
+	if msg.Amount.IsNil() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "amount must be positive, or negative to agree the maximum")
+	}
+
+	return nil
+}
+
+// NewMsgAgreeOrderPairWithAmount creates a new MsgAgreeOrderPairWithAmount instance.
+func NewMsgAgreeOrderPairWithAmount(delAddr, poolAddr sdk.AccAddress, txPair string, leftOrderID, rightOrderID uint64,
+	leftAmount, rightAmount sdk.Int) *MsgAgreeOrderPairWithAmount {
+	return &MsgAgreeOrderPairWithAmount{
+		PoolAddress:      poolAddr.String(),
+		DelegatorAddress: delAddr.String(),
+		TxPair:           txPair,
+		LeftOrderId:      leftOrderID,
+		RightOrderId:     rightOrderID,
+		LeftAmount:       leftAmount,
+		RightAmount:      rightAmount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgAgreeOrderPairWithAmount) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgAgreeOrderPairWithAmount) Type() string { return TypeMsgAgreeOrderPairWithAmount }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAgreeOrderPairWithAmount) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgAgreeOrderPairWithAmount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAgreeOrderPairWithAmount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.PoolAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pool address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address (%s)", err)
+	}
+	if _, _, err := ParseTxPair(msg.TxPair); err != nil {
+		return err
+	}
+	if msg.LeftAmount.IsNil() || !msg.LeftAmount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "left amount must be positive")
+	}
+	if msg.RightAmount.IsNil() || !msg.RightAmount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "right amount must be positive")
+	}
+
+	return nil
+}
+
+func validatePositiveCoin(coin sdk.Coin, name string) error {
+	if !coin.IsValid() || coin.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid %s %s", name, coin)
+	}
+	return nil
+}
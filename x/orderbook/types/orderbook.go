@@ -0,0 +1,332 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetTxPair returns the tx-pair name of the base and quote denoms, e.g. ugauss/uusdg
+func GetTxPair(baseDenom, quoteDenom string) string {
+	return baseDenom + TxPairSeparator + quoteDenom
+}
+
+// ParseTxPair returns the base and quote denoms of a tx-pair
+func ParseTxPair(txPair string) (baseDenom, quoteDenom string, err error) {
+	denoms := strings.Split(txPair, TxPairSeparator)
+	if len(denoms) != 2 {
+		return "", "", sdkerrors.Wrapf(ErrInvalidTxPair, "%s, expected format <base>%s<quote>", txPair, TxPairSeparator)
+	}
+
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return "", "", sdkerrors.Wrapf(ErrInvalidTxPair, "%s: %s", txPair, err)
+		}
+	}
+
+	if denoms[0] == denoms[1] {
+		return "", "", sdkerrors.Wrapf(ErrInvalidTxPair, "%s: base and quote denoms are the same", txPair)
+	}
+
+	return denoms[0], denoms[1], nil
+}
+
+// NewPool creates a new pool object
+func NewPool(poolAddr, delAddr sdk.AccAddress, defiAddr sdk.ValAddress, pledge sdk.Coin, updateTime time.Time) Pool {
+	pool := Pool{
+		Address:          poolAddr.String(),
+		DelegatorAddress: delAddr.String(),
+		Pledge:           pledge,
+		UpdateTime:       updateTime,
+	}
+	if defiAddr != nil {
+		pool.DefiAddress = defiAddr.String()
+	}
+
+	return pool
+}
+
+// GetPoolAddr returns the address of the pool
+func (p Pool) GetPoolAddr() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// GetDelegatorAddr returns the address allowed to execute the orders of the pool
+func (p Pool) GetDelegatorAddr() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(p.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// GetDefiAddr returns the defi the pool belongs to, nil if there is none
+func (p Pool) GetDefiAddr() sdk.ValAddress {
+	if p.DefiAddress == "" {
+		return nil
+	}
+
+	addr, err := sdk.ValAddressFromBech32(p.DefiAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate performs a stateless validation of the pool
+func (p Pool) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pool address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(p.DelegatorAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address (%s)", err)
+	}
+	if p.DefiAddress != "" {
+		if _, err := sdk.ValAddressFromBech32(p.DefiAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid defi address (%s)", err)
+		}
+	}
+	if !p.Pledge.IsValid() || p.Pledge.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid pledge %s", p.Pledge)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Pool) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// NewTxPair creates a new tx-pair object without any order
+func NewTxPair(txPair string) TxPair {
+	return TxPair{
+		TxPair: txPair,
+	}
+}
+
+// String implements the Stringer interface.
+func (tp TxPair) String() string {
+	out, _ := yaml.Marshal(tp)
+	return string(out)
+}
+
+// NewOrder creates a new order object
+func NewOrder(
+	poolAddr, ownerAddr sdk.AccAddress, myAsset, expectAsset sdk.Coin, price sdk.DecCoin, nonce uint64,
+	timeInForce TimeInForce, goodTilHeight int64, goodTilTime time.Time,
+) Order {
+	return Order{
+		PoolAddress:   poolAddr.String(),
+		OwnerAddress:  ownerAddr.String(),
+		MyAsset:       myAsset,
+		Price:         price,
+		ExpectAsset:   expectAsset,
+		Nonce:         nonce,
+		TimeInForce:   timeInForce,
+		GoodTilHeight: goodTilHeight,
+		GoodTilTime:   goodTilTime,
+	}
+}
+
+// GetOrderID returns the pointer to the order
+func (o Order) GetOrderID() OrderID {
+	return OrderID{
+		PoolAddress: o.PoolAddress,
+		TxPair:      o.GetTxPair(),
+		IsLeftOrder: o.IsLeftOrder(),
+		Nonce:       o.Nonce,
+	}
+}
+
+// IsExpired returns true if a GTH or GTT order expires at the end of the block
+// of the given height and time
+func (o Order) IsExpired(height int64, blockTime time.Time) bool {
+	switch o.TimeInForce {
+	case GoodTilHeight:
+		return height >= o.GoodTilHeight
+	case GoodTilTime:
+		return !blockTime.Before(o.GoodTilTime)
+	default:
+		return false
+	}
+}
+
+// IsLeftOrder returns true for a buy order, which pays the quote denom of its price
+func (o Order) IsLeftOrder() bool {
+	return o.MyAsset.Denom == o.Price.Denom
+}
+
+// BaseDenom returns the denom being bought or sold by the order
+func (o Order) BaseDenom() string {
+	if o.IsLeftOrder() {
+		return o.ExpectAsset.Denom
+	}
+	return o.MyAsset.Denom
+}
+
+// QuoteDenom returns the denom the price of the order is expressed in
+func (o Order) QuoteDenom() string {
+	return o.Price.Denom
+}
+
+// GetTxPair returns the tx-pair the order belongs to
+func (o Order) GetTxPair() string {
+	return GetTxPair(o.BaseDenom(), o.QuoteDenom())
+}
+
+// GetPoolAddr returns the address of the pool the order is placed in
+func (o Order) GetPoolAddr() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(o.PoolAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// GetOwnerAddr returns the address of the owner of the order
+func (o Order) GetOwnerAddr() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(o.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate performs a stateless validation of the order
+func (o Order) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(o.PoolAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pool address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(o.OwnerAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	if !o.MyAsset.IsValid() || o.MyAsset.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid asset %s", o.MyAsset)
+	}
+	if !o.ExpectAsset.IsValid() || o.ExpectAsset.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid expected asset %s", o.ExpectAsset)
+	}
+	if !o.Price.IsValid() || o.Price.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "%s", o.Price)
+	}
+	if o.MyAsset.Denom == o.ExpectAsset.Denom {
+		return sdkerrors.Wrapf(ErrInvalidTxPair, "asset and expected asset have the same denom %s", o.MyAsset.Denom)
+	}
+	if o.Price.Denom != o.MyAsset.Denom && o.Price.Denom != o.ExpectAsset.Denom {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "price denom %s must be %s or %s",
+			o.Price.Denom, o.MyAsset.Denom, o.ExpectAsset.Denom)
+	}
+
+	return ValidateTimeInForce(o.TimeInForce, o.GoodTilHeight, o.GoodTilTime)
+}
+
+// ValidateTimeInForce checks that only GTH orders have a good-til height and
+// only GTT orders have a good-til time
+func ValidateTimeInForce(timeInForce TimeInForce, goodTilHeight int64, goodTilTime time.Time) error {
+	if _, ok := TimeInForce_name[int32(timeInForce)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidTimeInForce, "unknown time in force %d", timeInForce)
+	}
+
+	switch {
+	case timeInForce == GoodTilHeight && goodTilHeight <= 0:
+		return sdkerrors.Wrapf(ErrInvalidTimeInForce, "invalid good-til height %d", goodTilHeight)
+	case timeInForce != GoodTilHeight && goodTilHeight != 0:
+		return sdkerrors.Wrapf(ErrInvalidTimeInForce, "good-til height of a %s order", timeInForce)
+	case timeInForce == GoodTilTime && goodTilTime.IsZero():
+		return sdkerrors.Wrap(ErrInvalidTimeInForce, "empty good-til time")
+	case timeInForce != GoodTilTime && !goodTilTime.IsZero():
+		return sdkerrors.Wrapf(ErrInvalidTimeInForce, "good-til time of a %s order", timeInForce)
+	}
+
+	return nil
+}
+
+// TimeInForceFromString returns the time in force of its short name, e.g. GTC
+func TimeInForceFromString(str string) (TimeInForce, error) {
+	timeInForce, ok := TimeInForce_value["TIME_IN_FORCE_"+strings.ToUpper(str)]
+	if !ok {
+		return GoodTilCancelled, sdkerrors.Wrapf(ErrInvalidTimeInForce, "%s, expected one of GTC, IOC, FOK, GTH or GTT", str)
+	}
+
+	return TimeInForce(timeInForce), nil
+}
+
+// String implements the Stringer interface.
+func (o Order) String() string {
+	out, _ := yaml.Marshal(o)
+	return string(out)
+}
+
+// GetPoolAddr returns the address of the pool the order is placed in
+func (id OrderID) GetPoolAddr() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(id.PoolAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewTxPairStats creates empty stats of a tx-pair of a pool
+func NewTxPairStats(poolAddr sdk.AccAddress, baseDenom, quoteDenom string) TxPairStats {
+	return TxPairStats{
+		PoolAddress: poolAddr.String(),
+		TxPair:      GetTxPair(baseDenom, quoteDenom),
+		LeftAsset:   sdk.NewCoin(baseDenom, sdk.ZeroInt()),
+		RightAsset:  sdk.NewCoin(quoteDenom, sdk.ZeroInt()),
+	}
+}
+
+// Validate performs a stateless validation of the stats
+func (tps TxPairStats) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(tps.PoolAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pool address (%s)", err)
+	}
+
+	baseDenom, quoteDenom, err := ParseTxPair(tps.TxPair)
+	if err != nil {
+		return err
+	}
+
+	if !tps.LeftAsset.IsValid() || tps.LeftAsset.Denom != baseDenom {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid left asset %s of tx-pair %s", tps.LeftAsset, tps.TxPair)
+	}
+	if !tps.RightAsset.IsValid() || tps.RightAsset.Denom != quoteDenom {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid right asset %s of tx-pair %s", tps.RightAsset, tps.TxPair)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (tps TxPairStats) String() string {
+	out, _ := yaml.Marshal(tps)
+	return string(out)
+}
+
+// OrderFill is a trade executed between a left and a right order of a tx-pair
+type OrderFill struct {
+	LeftOrderID  uint64
+	RightOrderID uint64
+	LeftAmount   sdk.Int // quote amount paid by the left order
+	RightAmount  sdk.Int // base amount paid by the right order
+}
+
+// NewOrderFill creates a new order fill
+func NewOrderFill(leftOrderID, rightOrderID uint64, leftAmount, rightAmount sdk.Int) OrderFill {
+	return OrderFill{
+		LeftOrderID:  leftOrderID,
+		RightOrderID: rightOrderID,
+		LeftAmount:   leftAmount,
+		RightAmount:  rightAmount,
+	}
+}
@@ -0,0 +1,2936 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gauss/orderbook/orderbook.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	_ "github.com/regen-network/cosmos-proto"
+	_ "github.com/tendermint/tendermint/proto/tendermint/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TimeInForce defines how long an order stays on the book.
+type TimeInForce int32
+
+const (
+	// GTC defines an order which rests on the book until it is filled or revoked.
+	GoodTilCancelled TimeInForce = 0
+	// IOC defines an order whose unfilled remainder is cancelled right after matching.
+	ImmediateOrCancel TimeInForce = 1
+	// FOK defines an order which fails unless it is entirely filled by matching.
+	FillOrKill TimeInForce = 2
+	// GTH defines an order which expires at the end of its good_til_height block.
+	GoodTilHeight TimeInForce = 3
+	// GTT defines an order which expires at the first block at or after its good_til_time.
+	GoodTilTime TimeInForce = 4
+)
+
+var TimeInForce_name = map[int32]string{
+	0: "TIME_IN_FORCE_GTC",
+	1: "TIME_IN_FORCE_IOC",
+	2: "TIME_IN_FORCE_FOK",
+	3: "TIME_IN_FORCE_GTH",
+	4: "TIME_IN_FORCE_GTT",
+}
+
+var TimeInForce_value = map[string]int32{
+	"TIME_IN_FORCE_GTC": 0,
+	"TIME_IN_FORCE_IOC": 1,
+	"TIME_IN_FORCE_FOK": 2,
+	"TIME_IN_FORCE_GTH": 3,
+	"TIME_IN_FORCE_GTT": 4,
+}
+
+func (x TimeInForce) String() string {
+	return proto.EnumName(TimeInForce_name, int32(x))
+}
+
+func (TimeInForce) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_505849af8743e517, []int{0}
+}
+
+// Pool
+type Pool struct {
+	Address          string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DelegatorAddress string     `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	DefiAddress      string     `protobuf:"bytes,3,opt,name=defi_address,json=defiAddress,proto3" json:"defi_address,omitempty"`
+	Pledge           types.Coin `protobuf:"bytes,4,opt,name=pledge,proto3" json:"pledge" yaml:"pledge"`
+	UpdateTime       time.Time  `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3,stdtime" json:"update_time" yaml:"update_time"`
+}
+
+func (m *Pool) Reset()      { *m = Pool{} }
+func (*Pool) ProtoMessage() {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505849af8743e517, []int{0}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func (m *Pool) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Pool) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *Pool) GetDefiAddress() string {
+	if m != nil {
+		return m.DefiAddress
+	}
+	return ""
+}
+
+func (m *Pool) GetPledge() types.Coin {
+	if m != nil {
+		return m.Pledge
+	}
+	return types.Coin{}
+}
+
+func (m *Pool) GetUpdateTime() time.Time {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return time.Time{}
+}
+
+// TxPair
+type TxPair struct {
+	TxPair           string `protobuf:"bytes,1,opt,name=tx_pair,json=txPair,proto3" json:"tx_pair,omitempty"`
+	OrdersTotal      uint64 `protobuf:"varint,2,opt,name=orders_total,json=ordersTotal,proto3" json:"orders_total,omitempty"`
+	LeftOrdersTotal  uint64 `protobuf:"varint,3,opt,name=left_orders_total,json=leftOrdersTotal,proto3" json:"left_orders_total,omitempty"`
+	RightOrdersTotal uint64 `protobuf:"varint,4,opt,name=right_orders_total,json=rightOrdersTotal,proto3" json:"right_orders_total,omitempty"`
+}
+
+func (m *TxPair) Reset()      { *m = TxPair{} }
+func (*TxPair) ProtoMessage() {}
+func (*TxPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505849af8743e517, []int{1}
+}
+func (m *TxPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxPair.Merge(m, src)
+}
+func (m *TxPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxPair proto.InternalMessageInfo
+
+func (m *TxPair) GetTxPair() string {
+	if m != nil {
+		return m.TxPair
+	}
+	return ""
+}
+
+func (m *TxPair) GetOrdersTotal() uint64 {
+	if m != nil {
+		return m.OrdersTotal
+	}
+	return 0
+}
+
+func (m *TxPair) GetLeftOrdersTotal() uint64 {
+	if m != nil {
+		return m.LeftOrdersTotal
+	}
+	return 0
+}
+
+func (m *TxPair) GetRightOrdersTotal() uint64 {
+	if m != nil {
+		return m.RightOrdersTotal
+	}
+	return 0
+}
+
+// Order
+type Order struct {
+	PoolAddress   string        `protobuf:"bytes,1,opt,name=pool_address,json=poolAddress,proto3" json:"pool_address,omitempty"`
+	OwnerAddress  string        `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	MyAsset       types.Coin    `protobuf:"bytes,3,opt,name=my_asset,json=myAsset,proto3" json:"my_asset" yaml:"my_asset"`
+	Price         types.DecCoin `protobuf:"bytes,4,opt,name=price,proto3" json:"price" yaml:"price"`
+	ExpectAsset   types.Coin    `protobuf:"bytes,5,opt,name=expect_asset,json=expectAsset,proto3" json:"expect_asset" yaml:"expect_asset"`
+	Nonce         uint64        `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty" yaml:"nonce"`
+	TimeInForce   TimeInForce   `protobuf:"varint,7,opt,name=time_in_force,json=timeInForce,proto3,enum=gauss.orderbook.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
+	GoodTilHeight int64         `protobuf:"varint,8,opt,name=good_til_height,json=goodTilHeight,proto3" json:"good_til_height,omitempty" yaml:"good_til_height"`
+	GoodTilTime   time.Time     `protobuf:"bytes,9,opt,name=good_til_time,json=goodTilTime,proto3,stdtime" json:"good_til_time" yaml:"good_til_time"`
+}
+
+func (m *Order) Reset()      { *m = Order{} }
+func (*Order) ProtoMessage() {}
+func (*Order) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505849af8743e517, []int{2}
+}
+func (m *Order) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Order) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Order.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Order) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order.Merge(m, src)
+}
+func (m *Order) XXX_Size() int {
+	return m.Size()
+}
+func (m *Order) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Order proto.InternalMessageInfo
+
+func (m *Order) GetPoolAddress() string {
+	if m != nil {
+		return m.PoolAddress
+	}
+	return ""
+}
+
+func (m *Order) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *Order) GetMyAsset() types.Coin {
+	if m != nil {
+		return m.MyAsset
+	}
+	return types.Coin{}
+}
+
+func (m *Order) GetPrice() types.DecCoin {
+	if m != nil {
+		return m.Price
+	}
+	return types.DecCoin{}
+}
+
+func (m *Order) GetExpectAsset() types.Coin {
+	if m != nil {
+		return m.ExpectAsset
+	}
+	return types.Coin{}
+}
+
+func (m *Order) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Order) GetTimeInForce() TimeInForce {
+	if m != nil {
+		return m.TimeInForce
+	}
+	return GoodTilCancelled
+}
+
+func (m *Order) GetGoodTilHeight() int64 {
+	if m != nil {
+		return m.GoodTilHeight
+	}
+	return 0
+}
+
+func (m *Order) GetGoodTilTime() time.Time {
+	if m != nil {
+		return m.GoodTilTime
+	}
+	return time.Time{}
+}
+
+// OrderID points to an order of a tx-pair of a pool.
+type OrderID struct {
+	PoolAddress string `protobuf:"bytes,1,opt,name=pool_address,json=poolAddress,proto3" json:"pool_address,omitempty" yaml:"pool_address"`
+	TxPair      string `protobuf:"bytes,2,opt,name=tx_pair,json=txPair,proto3" json:"tx_pair,omitempty" yaml:"tx_pair"`
+	IsLeftOrder bool   `protobuf:"varint,3,opt,name=is_left_order,json=isLeftOrder,proto3" json:"is_left_order,omitempty" yaml:"is_left_order"`
+	Nonce       uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty" yaml:"nonce"`
+}
+
+func (m *OrderID) Reset()         { *m = OrderID{} }
+func (m *OrderID) String() string { return proto.CompactTextString(m) }
+func (*OrderID) ProtoMessage()    {}
+func (*OrderID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505849af8743e517, []int{3}
+}
+func (m *OrderID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderID.Merge(m, src)
+}
+func (m *OrderID) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderID) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderID proto.InternalMessageInfo
+
+// OrderIDs defines an array of OrderID objects.
+type OrderIDs struct {
+	IDs []OrderID `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids"`
+}
+
+func (m *OrderIDs) Reset()         { *m = OrderIDs{} }
+func (m *OrderIDs) String() string { return proto.CompactTextString(m) }
+func (*OrderIDs) ProtoMessage()    {}
+func (*OrderIDs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505849af8743e517, []int{4}
+}
+func (m *OrderIDs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderIDs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderIDs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderIDs.Merge(m, src)
+}
+func (m *OrderIDs) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderIDs) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderIDs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderIDs proto.InternalMessageInfo
+
+func (m *OrderIDs) GetIDs() []OrderID {
+	if m != nil {
+		return m.IDs
+	}
+	return nil
+}
+
+// stats
+type TxPairStats struct {
+	Count       uint64     `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty" yaml:"count"`
+	LeftAsset   types.Coin `protobuf:"bytes,2,opt,name=left_asset,json=leftAsset,proto3" json:"left_asset" yaml:"left_asset"`
+	RightAsset  types.Coin `protobuf:"bytes,3,opt,name=right_asset,json=rightAsset,proto3" json:"right_asset" yaml:"right_asset"`
+	PoolAddress string     `protobuf:"bytes,4,opt,name=pool_address,json=poolAddress,proto3" json:"pool_address,omitempty" yaml:"pool_address"`
+	TxPair      string     `protobuf:"bytes,5,opt,name=tx_pair,json=txPair,proto3" json:"tx_pair,omitempty" yaml:"tx_pair"`
+}
+
+func (m *TxPairStats) Reset()      { *m = TxPairStats{} }
+func (*TxPairStats) ProtoMessage() {}
+func (*TxPairStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505849af8743e517, []int{5}
+}
+func (m *TxPairStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxPairStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxPairStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxPairStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxPairStats.Merge(m, src)
+}
+func (m *TxPairStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxPairStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxPairStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxPairStats proto.InternalMessageInfo
+
+func (m *TxPairStats) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *TxPairStats) GetLeftAsset() types.Coin {
+	if m != nil {
+		return m.LeftAsset
+	}
+	return types.Coin{}
+}
+
+func (m *TxPairStats) GetRightAsset() types.Coin {
+	if m != nil {
+		return m.RightAsset
+	}
+	return types.Coin{}
+}
+
+func (m *TxPairStats) GetPoolAddress() string {
+	if m != nil {
+		return m.PoolAddress
+	}
+	return ""
+}
+
+func (m *TxPairStats) GetTxPair() string {
+	if m != nil {
+		return m.TxPair
+	}
+	return ""
+}
+
+// Params defines the parameters for the orderbook module.
+type Params struct {
+	PoolMaxCount          uint32                                 `protobuf:"varint,1,opt,name=pool_max_count,json=poolMaxCount,proto3" json:"pool_max_count,omitempty" yaml:"pool_max_count"`
+	PoolMinPledgeAmount   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=pool_min_pledge_amount,json=poolMinPledgeAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pool_min_pledge_amount" yaml:"pool_min_pledge_amount"`
+	PoolMaxCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=pool_max_commission_rate,json=poolMaxCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pool_max_commission_rate"`
+	DefiRewardsThreshold  types.Coin                             `protobuf:"bytes,4,opt,name=defi_rewards_threshold,json=defiRewardsThreshold,proto3" json:"defi_rewards_threshold" yaml:"defi_rewards_threshold"`
+	MarketRewardsRate     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=market_rewards_rate,json=marketRewardsRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"market_rewards_rate"`
+	LeftRewardsRate       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=left_rewards_rate,json=leftRewardsRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"left_rewards_rate"`
+	RightRewardsRate      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=right_rewards_rate,json=rightRewardsRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"right_rewards_rate"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505849af8743e517, []int{6}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetPoolMaxCount() uint32 {
+	if m != nil {
+		return m.PoolMaxCount
+	}
+	return 0
+}
+
+func (m *Params) GetDefiRewardsThreshold() types.Coin {
+	if m != nil {
+		return m.DefiRewardsThreshold
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterEnum("gauss.orderbook.TimeInForce", TimeInForce_name, TimeInForce_value)
+	proto.RegisterType((*Pool)(nil), "gauss.orderbook.Pool")
+	proto.RegisterType((*TxPair)(nil), "gauss.orderbook.TxPair")
+	proto.RegisterType((*Order)(nil), "gauss.orderbook.Order")
+	proto.RegisterType((*OrderID)(nil), "gauss.orderbook.OrderID")
+	proto.RegisterType((*OrderIDs)(nil), "gauss.orderbook.OrderIDs")
+	proto.RegisterType((*TxPairStats)(nil), "gauss.orderbook.TxPairStats")
+	proto.RegisterType((*Params)(nil), "gauss.orderbook.Params")
+}
+
+func init() { proto.RegisterFile("gauss/orderbook/orderbook.proto", fileDescriptor_505849af8743e517) }
+
+var fileDescriptor_505849af8743e517 = []byte{
+	// 1263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xbf, 0x6f, 0xdb, 0xc6,
+	0x17, 0x17, 0xad, 0x1f, 0x76, 0x8e, 0x96, 0x2d, 0x5f, 0xec, 0x44, 0xd6, 0x37, 0x5f, 0x51, 0x61,
+	0x91, 0xc0, 0x48, 0x52, 0x09, 0x49, 0x0b, 0x14, 0x30, 0x0a, 0x14, 0xa1, 0x1c, 0xdb, 0x42, 0x9a,
+	0xca, 0x60, 0x84, 0x02, 0x4d, 0x8b, 0xb2, 0x67, 0xf1, 0x2c, 0x1f, 0x42, 0xf2, 0x04, 0xf2, 0x9c,
+	0xd8, 0x7b, 0x87, 0xc0, 0x53, 0xc6, 0x2e, 0x06, 0x52, 0x74, 0xe9, 0x9f, 0x92, 0x31, 0x4b, 0x80,
+	0xa2, 0x83, 0x5a, 0x38, 0x43, 0x3b, 0x7b, 0xe9, 0x5a, 0xdc, 0x0f, 0x8a, 0x14, 0x63, 0x54, 0xad,
+	0x17, 0x89, 0xf7, 0xde, 0xe7, 0x7d, 0xee, 0xfd, 0xe4, 0x23, 0x30, 0x06, 0xe8, 0x20, 0x8a, 0x5a,
+	0x34, 0x74, 0x71, 0xb8, 0x4b, 0xe9, 0xd3, 0xe4, 0xa9, 0x39, 0x0c, 0x29, 0xa3, 0x70, 0x51, 0x00,
+	0x9a, 0x63, 0x71, 0x6d, 0x79, 0x40, 0x07, 0x54, 0xe8, 0x5a, 0xfc, 0x49, 0xc2, 0x6a, 0xab, 0x03,
+	0x4a, 0x07, 0x1e, 0x6e, 0x89, 0xd3, 0xee, 0xc1, 0x5e, 0x0b, 0x05, 0x47, 0x4a, 0x55, 0xcf, 0xaa,
+	0xdc, 0x83, 0x10, 0x31, 0x42, 0x03, 0xa5, 0x37, 0xb2, 0x7a, 0x46, 0x7c, 0x1c, 0x31, 0xe4, 0x0f,
+	0x63, 0xee, 0x3e, 0x8d, 0x7c, 0x1a, 0x39, 0xf2, 0x52, 0x79, 0x88, 0xb9, 0xe5, 0xa9, 0xb5, 0x8b,
+	0x22, 0xdc, 0x7a, 0x76, 0x77, 0x17, 0x33, 0x74, 0xb7, 0xd5, 0xa7, 0x24, 0xe6, 0xbe, 0xc6, 0x70,
+	0xe0, 0xe2, 0xd0, 0x27, 0x01, 0x6b, 0xb1, 0xa3, 0x21, 0x8e, 0xe4, 0xaf, 0xd4, 0x9a, 0x3f, 0xce,
+	0x80, 0xc2, 0x0e, 0xa5, 0x1e, 0xac, 0x82, 0x59, 0xe4, 0xba, 0x21, 0x8e, 0xa2, 0xaa, 0xd6, 0xd0,
+	0xd6, 0x2e, 0xd9, 0xf1, 0x11, 0xde, 0x06, 0x4b, 0x2e, 0xf6, 0xf0, 0x00, 0x31, 0x1a, 0x3a, 0x31,
+	0x66, 0x46, 0x60, 0x2a, 0x63, 0xc5, 0x7d, 0x05, 0xbe, 0x0e, 0xe6, 0x5d, 0xbc, 0x47, 0xc6, 0xb8,
+	0xbc, 0xc0, 0xe9, 0x5c, 0x16, 0x43, 0xb6, 0x41, 0x69, 0xe8, 0x61, 0x77, 0x80, 0xab, 0x85, 0x86,
+	0xb6, 0xa6, 0xdf, 0x5b, 0x6d, 0xaa, 0x78, 0x78, 0x04, 0x4d, 0x15, 0x41, 0xb3, 0x4d, 0x49, 0x60,
+	0xad, 0xbc, 0x1e, 0x19, 0xb9, 0xb3, 0x91, 0x51, 0x3e, 0x42, 0xbe, 0xb7, 0x6e, 0x4a, 0x33, 0xd3,
+	0x56, 0xf6, 0xf0, 0x6b, 0xa0, 0x1f, 0x0c, 0x5d, 0xc4, 0xb0, 0xc3, 0xf3, 0x55, 0x2d, 0x0a, 0xba,
+	0x5a, 0x53, 0x26, 0xb3, 0x19, 0x27, 0xb3, 0xd9, 0x8b, 0x93, 0x69, 0xd5, 0x15, 0x1f, 0x94, 0x7c,
+	0x29, 0x63, 0xf3, 0xe5, 0x6f, 0x86, 0x66, 0x03, 0x29, 0xe1, 0x06, 0xeb, 0x73, 0x3f, 0xbc, 0x32,
+	0x72, 0x7f, 0xbe, 0x32, 0x34, 0xf3, 0x67, 0x0d, 0x94, 0x7a, 0x87, 0x3b, 0x88, 0x84, 0xf0, 0x2a,
+	0x98, 0x65, 0x87, 0xce, 0x10, 0x91, 0x50, 0x65, 0xa9, 0xc4, 0xa4, 0xe2, 0x3a, 0x98, 0x17, 0xfd,
+	0x11, 0x39, 0x8c, 0x32, 0xe4, 0x89, 0xfc, 0x14, 0x6c, 0x5d, 0xca, 0x7a, 0x5c, 0x04, 0x6f, 0x81,
+	0x25, 0x0f, 0xef, 0x31, 0x67, 0x02, 0x97, 0x17, 0xb8, 0x45, 0xae, 0xe8, 0xa6, 0xb0, 0x77, 0x00,
+	0x0c, 0xc9, 0x60, 0x3f, 0x03, 0x2e, 0x08, 0x70, 0x45, 0x68, 0x52, 0xe8, 0x94, 0xab, 0x7f, 0x15,
+	0x40, 0x51, 0x68, 0xb8, 0x43, 0x43, 0x4a, 0x3d, 0x67, 0xb2, 0xa8, 0x3a, 0x97, 0xc5, 0x85, 0xf8,
+	0x00, 0x94, 0xe9, 0xf3, 0x00, 0x67, 0x8b, 0x3a, 0x2f, 0x84, 0x31, 0xe8, 0x11, 0x98, 0xf3, 0x8f,
+	0x1c, 0x14, 0x45, 0x98, 0x55, 0xf3, 0xd3, 0xea, 0x75, 0x55, 0xe5, 0x77, 0x51, 0xe6, 0x37, 0x36,
+	0x34, 0xed, 0x59, 0xff, 0xe8, 0x3e, 0x7f, 0x82, 0xdb, 0xa0, 0x38, 0x0c, 0x49, 0x3f, 0xae, 0xfd,
+	0xb5, 0x73, 0xb9, 0x36, 0x70, 0x5f, 0xd0, 0x2d, 0x2b, 0xba, 0x79, 0x55, 0x7e, 0x6e, 0x68, 0xda,
+	0x92, 0x00, 0x7e, 0x05, 0xe6, 0xf1, 0xe1, 0x10, 0xf7, 0x99, 0x72, 0xae, 0x38, 0xcd, 0xb9, 0xff,
+	0x29, 0xb6, 0xcb, 0x92, 0x2d, 0x6d, 0x6c, 0xda, 0xba, 0x3c, 0x4a, 0x27, 0x6f, 0x82, 0x62, 0x40,
+	0x83, 0x3e, 0xae, 0x96, 0x78, 0xc2, 0xad, 0x4a, 0xe2, 0x82, 0x10, 0x9b, 0xb6, 0x54, 0xc3, 0x27,
+	0xa0, 0xcc, 0x7b, 0xc7, 0x21, 0x81, 0xb3, 0x47, 0xc3, 0x3e, 0xae, 0xce, 0x36, 0xb4, 0xb5, 0x85,
+	0x7b, 0xd7, 0x9a, 0x99, 0x17, 0x86, 0xe8, 0xc0, 0x4e, 0xb0, 0xc9, 0x31, 0x56, 0xf5, 0x6c, 0x64,
+	0x2c, 0x4b, 0xb6, 0x09, 0x63, 0xd3, 0xd6, 0x59, 0x02, 0x83, 0x16, 0x58, 0x1c, 0x50, 0xea, 0x3a,
+	0x8c, 0x78, 0xce, 0x3e, 0xe6, 0x15, 0xaf, 0xce, 0x35, 0xb4, 0xb5, 0xbc, 0x55, 0x3b, 0x1b, 0x19,
+	0x57, 0xa4, 0x7d, 0x06, 0x60, 0xda, 0x65, 0x2e, 0xe9, 0x11, 0x6f, 0x5b, 0x9c, 0xe1, 0x77, 0xa0,
+	0x3c, 0x86, 0x88, 0x09, 0xb9, 0x34, 0x75, 0x42, 0x1a, 0x2a, 0x49, 0xcb, 0x99, 0x1b, 0x92, 0x19,
+	0xd1, 0xd5, 0x1d, 0x99, 0x21, 0x39, 0xd5, 0xc0, 0xac, 0xe8, 0xbc, 0xce, 0x06, 0x5c, 0x3f, 0xaf,
+	0xf7, 0xac, 0xab, 0x49, 0xee, 0xd3, 0x5a, 0x73, 0xb2, 0x29, 0x6f, 0x27, 0x13, 0x26, 0xda, 0xd1,
+	0x82, 0x67, 0x23, 0x63, 0x41, 0xe5, 0x4b, 0x2a, 0xcc, 0xf1, 0xd4, 0x7d, 0x0a, 0xca, 0x24, 0x72,
+	0x92, 0xa9, 0x12, 0x1d, 0x3a, 0x97, 0x4e, 0xf1, 0x84, 0xda, 0xb4, 0x75, 0x12, 0x7d, 0x1e, 0x8f,
+	0x5a, 0x52, 0xe6, 0xc2, 0x3f, 0x96, 0x79, 0x7d, 0xee, 0x45, 0x1c, 0x64, 0x1b, 0xcc, 0xa9, 0x18,
+	0x23, 0xf8, 0x09, 0xc8, 0x13, 0x97, 0xc7, 0x96, 0x5f, 0xd3, 0xef, 0x55, 0xdf, 0x2b, 0xb9, 0xc2,
+	0x59, 0x3a, 0x4f, 0xe8, 0xe9, 0xc8, 0xc8, 0x77, 0x36, 0x22, 0x9b, 0x5b, 0x98, 0x6f, 0x67, 0x80,
+	0x2e, 0x5f, 0x27, 0x8f, 0x19, 0x62, 0x11, 0x77, 0xa3, 0x4f, 0x0f, 0x02, 0x56, 0xd5, 0xb2, 0x6e,
+	0x08, 0xb1, 0x69, 0x4b, 0x35, 0x7c, 0x0c, 0x80, 0x08, 0x45, 0xb6, 0xfb, 0xcc, 0xb4, 0x76, 0x5f,
+	0x55, 0x95, 0x5c, 0x92, 0x5c, 0x89, 0xa9, 0x69, 0x5f, 0xe2, 0x07, 0xd9, 0xea, 0x5f, 0x02, 0x5d,
+	0xbe, 0x68, 0xfe, 0xe5, 0x84, 0xd7, 0x26, 0xdf, 0xa0, 0x29, 0x5b, 0xd3, 0x06, 0xe2, 0x24, 0x79,
+	0xb3, 0x2d, 0x50, 0xb8, 0x58, 0x0b, 0x14, 0xa7, 0xb5, 0x40, 0xaa, 0x03, 0xdf, 0x16, 0x41, 0x69,
+	0x07, 0x85, 0xc8, 0x8f, 0xe0, 0x67, 0x60, 0x41, 0xf0, 0xfb, 0xe8, 0xd0, 0x49, 0x72, 0x5b, 0xb6,
+	0x56, 0xcf, 0x46, 0xc6, 0x4a, 0xea, 0xfe, 0xb1, 0xde, 0xb4, 0x85, 0xbb, 0x8f, 0xd0, 0x61, 0x5b,
+	0xe4, 0xfa, 0x7b, 0x0d, 0x5c, 0x91, 0x08, 0x12, 0x38, 0x72, 0xdb, 0x38, 0xc8, 0x17, 0x4c, 0xb2,
+	0x2b, 0xbb, 0x3c, 0x0f, 0xbf, 0x8e, 0x8c, 0x9b, 0x03, 0xc2, 0xf6, 0x0f, 0x76, 0x9b, 0x7d, 0xea,
+	0xab, 0xb5, 0xac, 0xfe, 0x3e, 0x8c, 0xdc, 0xa7, 0x6a, 0xd3, 0x76, 0x02, 0x76, 0x36, 0x32, 0xfe,
+	0x9f, 0xbe, 0x37, 0xcb, 0x6a, 0xda, 0x97, 0xc5, 0xfd, 0x24, 0xd8, 0x11, 0xe2, 0xfb, 0x42, 0x0a,
+	0x07, 0xa0, 0x9a, 0xf2, 0xd3, 0xf7, 0x49, 0x14, 0x11, 0x1a, 0x38, 0x21, 0x62, 0x58, 0x6e, 0x56,
+	0xab, 0xf9, 0x1f, 0xfc, 0xd8, 0xc0, 0x7d, 0x7b, 0x65, 0x1c, 0x66, 0xcc, 0x66, 0x23, 0x86, 0xe1,
+	0x33, 0x70, 0x45, 0xac, 0xed, 0x10, 0x3f, 0x47, 0xa1, 0x1b, 0x39, 0x6c, 0x3f, 0xc4, 0xd1, 0x3e,
+	0xf5, 0xdc, 0xe9, 0x3b, 0xfa, 0x86, 0xea, 0x08, 0x15, 0xdf, 0xf9, 0x34, 0xa6, 0xbd, 0xcc, 0x15,
+	0xb6, 0x94, 0xf7, 0x62, 0x31, 0xfc, 0x16, 0x5c, 0xf6, 0x51, 0xf8, 0x14, 0xb3, 0xb1, 0x89, 0x88,
+	0xad, 0x78, 0xa1, 0xd8, 0x96, 0x24, 0x95, 0xba, 0x44, 0xc4, 0xf5, 0x44, 0xed, 0xdc, 0x09, 0xf6,
+	0xd2, 0x85, 0xd8, 0xc5, 0x8e, 0x4e, 0x73, 0x7f, 0x13, 0xef, 0xe8, 0x09, 0xf2, 0xd9, 0x0b, 0x91,
+	0xcb, 0x9d, 0x9e, 0x62, 0x4f, 0xfa, 0xfa, 0xd6, 0x1f, 0x1a, 0xd0, 0x53, 0x0b, 0x84, 0x7f, 0x8f,
+	0xf5, 0x3a, 0x8f, 0x1e, 0x38, 0x9d, 0x2f, 0x9c, 0xcd, 0xae, 0xdd, 0x7e, 0xe0, 0x6c, 0xf5, 0xda,
+	0x95, 0x5c, 0x6d, 0xf9, 0xf8, 0xa4, 0x51, 0xd9, 0x92, 0xef, 0xe6, 0x36, 0x0a, 0xfa, 0xd8, 0xf3,
+	0xb0, 0x0b, 0xef, 0x64, 0xc1, 0x9d, 0x6e, 0xbb, 0xa2, 0xd5, 0x56, 0x8e, 0x4f, 0x1a, 0x4b, 0x1d,
+	0xdf, 0xc7, 0x2e, 0x41, 0x0c, 0x77, 0x43, 0x69, 0x00, 0x6f, 0x64, 0xd1, 0x9b, 0xdd, 0x87, 0x95,
+	0x99, 0xda, 0xc2, 0xf1, 0x49, 0x03, 0x6c, 0x12, 0xcf, 0xeb, 0x86, 0x0f, 0x89, 0xe7, 0xc1, 0xb5,
+	0xf7, 0x3d, 0xd8, 0xae, 0xe4, 0x6b, 0x4b, 0xc7, 0x27, 0x8d, 0xf2, 0xd6, 0xc4, 0x06, 0xba, 0xf9,
+	0x3e, 0xb2, 0x57, 0x29, 0xd4, 0x16, 0x8f, 0x4f, 0x1a, 0xfa, 0x56, 0xb2, 0x47, 0x6a, 0x85, 0x17,
+	0x3f, 0xd5, 0x73, 0xd6, 0x83, 0xd7, 0xa7, 0x75, 0xed, 0xcd, 0x69, 0x5d, 0xfb, 0xfd, 0xb4, 0xae,
+	0xbd, 0x7c, 0x57, 0xcf, 0xbd, 0x79, 0x57, 0xcf, 0xfd, 0xf2, 0xae, 0x9e, 0x7b, 0x72, 0x3b, 0x95,
+	0x47, 0xf9, 0xb9, 0x2e, 0x7f, 0x9f, 0x7d, 0xdc, 0x3a, 0x4c, 0x7d, 0xb9, 0x8b, 0x84, 0xee, 0x96,
+	0xc4, 0x5e, 0xfb, 0xe8, 0xef, 0x01, 0x00, 0x52, 0x93, 0x72, 0xc6, 0xd9, 0x0b, 0x00, 0x00,
+}
+
+func (this *Pool) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Pool)
+	if !ok {
+		that2, ok := that.(Pool)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
+	}
+	if this.DefiAddress != that1.DefiAddress {
+		return false
+	}
+	if !this.Pledge.Equal(&that1.Pledge) {
+		return false
+	}
+	if !this.UpdateTime.Equal(that1.UpdateTime) {
+		return false
+	}
+	return true
+}
+func (this *TxPair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxPair)
+	if !ok {
+		that2, ok := that.(TxPair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxPair != that1.TxPair {
+		return false
+	}
+	if this.OrdersTotal != that1.OrdersTotal {
+		return false
+	}
+	if this.LeftOrdersTotal != that1.LeftOrdersTotal {
+		return false
+	}
+	if this.RightOrdersTotal != that1.RightOrdersTotal {
+		return false
+	}
+	return true
+}
+func (this *Order) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Order)
+	if !ok {
+		that2, ok := that.(Order)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolAddress != that1.PoolAddress {
+		return false
+	}
+	if this.OwnerAddress != that1.OwnerAddress {
+		return false
+	}
+	if !this.MyAsset.Equal(&that1.MyAsset) {
+		return false
+	}
+	if !this.Price.Equal(&that1.Price) {
+		return false
+	}
+	if !this.ExpectAsset.Equal(&that1.ExpectAsset) {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.TimeInForce != that1.TimeInForce {
+		return false
+	}
+	if this.GoodTilHeight != that1.GoodTilHeight {
+		return false
+	}
+	if !this.GoodTilTime.Equal(that1.GoodTilTime) {
+		return false
+	}
+	return true
+}
+func (this *OrderID) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OrderID)
+	if !ok {
+		that2, ok := that.(OrderID)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolAddress != that1.PoolAddress {
+		return false
+	}
+	if this.TxPair != that1.TxPair {
+		return false
+	}
+	if this.IsLeftOrder != that1.IsLeftOrder {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	return true
+}
+func (this *TxPairStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxPairStats)
+	if !ok {
+		that2, ok := that.(TxPairStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if !this.LeftAsset.Equal(&that1.LeftAsset) {
+		return false
+	}
+	if !this.RightAsset.Equal(&that1.RightAsset) {
+		return false
+	}
+	if this.PoolAddress != that1.PoolAddress {
+		return false
+	}
+	if this.TxPair != that1.TxPair {
+		return false
+	}
+	return true
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolMaxCount != that1.PoolMaxCount {
+		return false
+	}
+	if !this.PoolMinPledgeAmount.Equal(that1.PoolMinPledgeAmount) {
+		return false
+	}
+	if !this.PoolMaxCommissionRate.Equal(that1.PoolMaxCommissionRate) {
+		return false
+	}
+	if !this.DefiRewardsThreshold.Equal(&that1.DefiRewardsThreshold) {
+		return false
+	}
+	if !this.MarketRewardsRate.Equal(that1.MarketRewardsRate) {
+		return false
+	}
+	if !this.LeftRewardsRate.Equal(that1.LeftRewardsRate) {
+		return false
+	}
+	if !this.RightRewardsRate.Equal(that1.RightRewardsRate) {
+		return false
+	}
+	return true
+}
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintOrderbook(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Pledge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DefiAddress) > 0 {
+		i -= len(m.DefiAddress)
+		copy(dAtA[i:], m.DefiAddress)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.DefiAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RightOrdersTotal != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.RightOrdersTotal))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LeftOrdersTotal != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.LeftOrdersTotal))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OrdersTotal != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.OrdersTotal))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxPair) > 0 {
+		i -= len(m.TxPair)
+		copy(dAtA[i:], m.TxPair)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.TxPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.GoodTilTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.GoodTilTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintOrderbook(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x4a
+	if m.GoodTilHeight != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.GoodTilHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.ExpectAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MyAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolAddress) > 0 {
+		i -= len(m.PoolAddress)
+		copy(dAtA[i:], m.PoolAddress)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.PoolAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsLeftOrder {
+		i--
+		if m.IsLeftOrder {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxPair) > 0 {
+		i -= len(m.TxPair)
+		copy(dAtA[i:], m.TxPair)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.TxPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolAddress) > 0 {
+		i -= len(m.PoolAddress)
+		copy(dAtA[i:], m.PoolAddress)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.PoolAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderIDs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderIDs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderIDs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IDs) > 0 {
+		for iNdEx := len(m.IDs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IDs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrderbook(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxPairStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxPairStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxPairStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxPair) > 0 {
+		i -= len(m.TxPair)
+		copy(dAtA[i:], m.TxPair)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.TxPair)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PoolAddress) > 0 {
+		i -= len(m.PoolAddress)
+		copy(dAtA[i:], m.PoolAddress)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.PoolAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.RightAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.LeftAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Count != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RightRewardsRate.Size()
+		i -= size
+		if _, err := m.RightRewardsRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.LeftRewardsRate.Size()
+		i -= size
+		if _, err := m.LeftRewardsRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MarketRewardsRate.Size()
+		i -= size
+		if _, err := m.MarketRewardsRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.DefiRewardsThreshold.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PoolMaxCommissionRate.Size()
+		i -= size
+		if _, err := m.PoolMaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PoolMinPledgeAmount.Size()
+		i -= size
+		if _, err := m.PoolMinPledgeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolMaxCount != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.PoolMaxCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrderbook(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrderbook(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	l = len(m.DefiAddress)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	l = m.Pledge.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovOrderbook(uint64(l))
+	return n
+}
+
+func (m *TxPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxPair)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	if m.OrdersTotal != 0 {
+		n += 1 + sovOrderbook(uint64(m.OrdersTotal))
+	}
+	if m.LeftOrdersTotal != 0 {
+		n += 1 + sovOrderbook(uint64(m.LeftOrdersTotal))
+	}
+	if m.RightOrdersTotal != 0 {
+		n += 1 + sovOrderbook(uint64(m.RightOrdersTotal))
+	}
+	return n
+}
+
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolAddress)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	l = m.MyAsset.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = m.ExpectAsset.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovOrderbook(uint64(m.Nonce))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovOrderbook(uint64(m.TimeInForce))
+	}
+	if m.GoodTilHeight != 0 {
+		n += 1 + sovOrderbook(uint64(m.GoodTilHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.GoodTilTime)
+	n += 1 + l + sovOrderbook(uint64(l))
+	return n
+}
+
+func (m *OrderID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolAddress)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	l = len(m.TxPair)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	if m.IsLeftOrder {
+		n += 2
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovOrderbook(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *OrderIDs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IDs) > 0 {
+		for _, e := range m.IDs {
+			l = e.Size()
+			n += 1 + l + sovOrderbook(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxPairStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovOrderbook(uint64(m.Count))
+	}
+	l = m.LeftAsset.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = m.RightAsset.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = len(m.PoolAddress)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	l = len(m.TxPair)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolMaxCount != 0 {
+		n += 1 + sovOrderbook(uint64(m.PoolMaxCount))
+	}
+	l = m.PoolMinPledgeAmount.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = m.PoolMaxCommissionRate.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = m.DefiRewardsThreshold.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = m.MarketRewardsRate.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = m.LeftRewardsRate.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = m.RightRewardsRate.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	return n
+}
+
+func sovOrderbook(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrderbook(x uint64) (n int) {
+	return sovOrderbook(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefiAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pledge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pledge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersTotal", wireType)
+			}
+			m.OrdersTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrdersTotal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftOrdersTotal", wireType)
+			}
+			m.LeftOrdersTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeftOrdersTotal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RightOrdersTotal", wireType)
+			}
+			m.RightOrdersTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RightOrdersTotal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MyAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MyAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpectAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilHeight", wireType)
+			}
+			m.GoodTilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GoodTilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.GoodTilTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLeftOrder", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLeftOrder = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderIDs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderIDs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderIDs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IDs = append(m.IDs, OrderID{})
+			if err := m.IDs[len(m.IDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxPairStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxPairStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxPairStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LeftAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RightAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RightAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolMaxCount", wireType)
+			}
+			m.PoolMaxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolMaxCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolMinPledgeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolMinPledgeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolMaxCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolMaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiRewardsThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefiRewardsThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketRewardsRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarketRewardsRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftRewardsRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LeftRewardsRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RightRewardsRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RightRewardsRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOrderbook(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOrderbook
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOrderbook
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOrderbook
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOrderbook        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOrderbook          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOrderbook = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,166 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	// DefaultPoolMaxCount is the default maximum number of pools
+	DefaultPoolMaxCount uint32 = 100
+)
+
+var (
+	// DefaultPoolMinPledgeAmount is the default minimum pledge of a pool
+	DefaultPoolMinPledgeAmount = sdk.NewInt(10000000000)
+)
+
+var (
+	KeyPoolMaxCount          = []byte("MaxPools")
+	KeyPoolMinPledgeAmount   = []byte("MinPledge")
+	KeyPoolMaxCommissionRate = []byte("MaxCommissionRate")
+	KeyDefiRewardsThreshold  = []byte("DefiRewardsThreshold")
+	KeyMarketRewardsRate     = []byte("MarketRewardsRate")
+	KeyLeftRewardsRate       = []byte("LeftRewardsRate")
+	KeyRightRewardsRate      = []byte("RightRewardsRate")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable for orderbook module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the orderbook module
+func NewParams(poolMaxCount uint32, poolMinPledgeAmount sdk.Int, poolMaxCommissionRate sdk.Dec,
+	defiRewardsThreshold sdk.Coin, marketRewardsRate, leftRewardsRate, rightRewardsRate sdk.Dec) Params {
+	return Params{
+		PoolMaxCount:          poolMaxCount,
+		PoolMinPledgeAmount:   poolMinPledgeAmount,
+		PoolMaxCommissionRate: poolMaxCommissionRate,
+		DefiRewardsThreshold:  defiRewardsThreshold,
+		MarketRewardsRate:     marketRewardsRate,
+		LeftRewardsRate:       leftRewardsRate,
+		RightRewardsRate:      rightRewardsRate,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the orderbook module
+func DefaultParams() Params {
+	return NewParams(
+		DefaultPoolMaxCount,
+		DefaultPoolMinPledgeAmount,
+		sdk.NewDecWithPrec(10, 2), // 10%
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000)),
+		sdk.NewDecWithPrec(5, 2),  // 5%
+		sdk.NewDecWithPrec(50, 2), // 50%
+		sdk.NewDecWithPrec(50, 2), // 50%
+	)
+}
+
+// Validate all orderbook module parameters
+func (p Params) Validate() error {
+	if err := validatePoolMaxCount(p.PoolMaxCount); err != nil {
+		return err
+	}
+	if err := validatePoolMinPledgeAmount(p.PoolMinPledgeAmount); err != nil {
+		return err
+	}
+	if err := validateRate(p.PoolMaxCommissionRate); err != nil {
+		return err
+	}
+	if err := validateDefiRewardsThreshold(p.DefiRewardsThreshold); err != nil {
+		return err
+	}
+	if err := validateRate(p.MarketRewardsRate); err != nil {
+		return err
+	}
+	if err := validateRate(p.LeftRewardsRate); err != nil {
+		return err
+	}
+	if err := validateRate(p.RightRewardsRate); err != nil {
+		return err
+	}
+	if p.LeftRewardsRate.Add(p.RightRewardsRate).GT(sdk.OneDec()) {
+		return fmt.Errorf("sum of left and right rewards rates cannot be greater than one: %s + %s",
+			p.LeftRewardsRate, p.RightRewardsRate)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyPoolMaxCount, &p.PoolMaxCount, validatePoolMaxCount),
+		paramtypes.NewParamSetPair(KeyPoolMinPledgeAmount, &p.PoolMinPledgeAmount, validatePoolMinPledgeAmount),
+		paramtypes.NewParamSetPair(KeyPoolMaxCommissionRate, &p.PoolMaxCommissionRate, validateRate),
+		paramtypes.NewParamSetPair(KeyDefiRewardsThreshold, &p.DefiRewardsThreshold, validateDefiRewardsThreshold),
+		paramtypes.NewParamSetPair(KeyMarketRewardsRate, &p.MarketRewardsRate, validateRate),
+		paramtypes.NewParamSetPair(KeyLeftRewardsRate, &p.LeftRewardsRate, validateRate),
+		paramtypes.NewParamSetPair(KeyRightRewardsRate, &p.RightRewardsRate, validateRate),
+	}
+}
+
+func validatePoolMaxCount(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max pools must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validatePoolMinPledgeAmount(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min pledge amount cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDefiRewardsThreshold(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid defi rewards threshold: %s", err)
+	}
+
+	return nil
+}
+
+func validateRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("rate should be between [0, 1]: %s", v)
+	}
+
+	return nil
+}
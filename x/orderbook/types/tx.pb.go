@@ -0,0 +1,3660 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gauss/orderbook/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	_ "github.com/regen-network/cosmos-proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MsgCreatePool struct {
+	OwnerAddress     string     `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty" yaml:"owner_address"`
+	DelegatorAddress string     `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	DefiAddress      string     `protobuf:"bytes,3,opt,name=defi_address,json=defiAddress,proto3" json:"defi_address,omitempty" yaml:"defi_address"`
+	Pledge           types.Coin `protobuf:"bytes,4,opt,name=pledge,proto3" json:"pledge"`
+}
+
+func (m *MsgCreatePool) Reset()         { *m = MsgCreatePool{} }
+func (m *MsgCreatePool) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePool) ProtoMessage()    {}
+func (*MsgCreatePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61b3910303867e96, []int{0}
+}
+func (m *MsgCreatePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePool.Merge(m, src)
+}
+func (m *MsgCreatePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePool proto.InternalMessageInfo
+
+type MsgCreatePoolResponse struct {
+}
+
+func (m *MsgCreatePoolResponse) Reset()         { *m = MsgCreatePoolResponse{} }
+func (m *MsgCreatePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePoolResponse) ProtoMessage()    {}
+func (*MsgCreatePoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61b3910303867e96, []int{1}
+}
+func (m *MsgCreatePoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePoolResponse.Merge(m, src)
+}
+func (m *MsgCreatePoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePoolResponse proto.InternalMessageInfo
+
+type MsgAddPledge struct {
+	OwnerAddress string     `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty" yaml:"owner_address"`
+	Pledge       types.Coin `protobuf:"bytes,2,opt,name=pledge,proto3" json:"pledge"`
+}
+
+func (m *MsgAddPledge) Reset()         { *m = MsgAddPledge{} }
+func (m *MsgAddPledge) String() string { return proto.CompactTextString(m) }
+func (*MsgAddPledge) ProtoMessage()    {}
+func (*MsgAddPledge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61b3910303867e96, []int{2}
+}
+func (m *MsgAddPledge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddPledge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddPledge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddPledge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddPledge.Merge(m, src)
+}
+func (m *MsgAddPledge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddPledge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddPledge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddPledge proto.InternalMessageInfo
+
+type MsgAddPledgeResponse struct {
+}
+
+func (m *MsgAddPledgeResponse) Reset()         { *m = MsgAddPledgeResponse{} }
+func (m *MsgAddPledgeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddPledgeResponse) ProtoMessage()    {}
+func (*MsgAddPledgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61b3910303867e96, []int{3}
+}
+func (m *MsgAddPledgeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddPledgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddPledgeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddPledgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddPledgeResponse.Merge(m, src)
+}
+func (m *MsgAddPledgeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddPledgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddPledgeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddPledgeResponse proto.InternalMessageInfo
+
+type MsgRedeemPledge struct {
+	OwnerAddress string     `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty" yaml:"owner_address"`
+	Pledge       types.Coin `protobuf:"bytes,2,opt,name=pledge,proto3" json:"pledge"`
+}
+
+func (m *MsgRedeemPledge) Reset()         { *m = MsgRedeemPledge{} }
+func (m *MsgRedeemPledge) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemPledge) ProtoMessage()    {}
+func (*MsgRedeemPledge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61b3910303867e96, []int{4}
+}
+func (m *MsgRedeemPledge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemPledge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemPledge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemPledge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemPledge.Merge(m, src)
+}
+func (m *MsgRedeemPledge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemPledge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemPledge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemPledge proto.InternalMessageInfo
+
+type MsgRedeemPledgeResponse struct {
+}
+
+func (m *MsgRedeemPledgeResponse) Reset()         { *m = MsgRedeemPledgeResponse{} }
+func (m *MsgRedeemPledgeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemPledgeResponse) ProtoMessage()    {}
+func (*MsgRedeemPledgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61b3910303867e96, []int{5}
+}
+func (m *MsgRedeemPledgeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemPledgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemPledgeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemPledgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemPledgeResponse.Merge(m, src)
+}
+func (m *MsgRedeemPledgeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemPledgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemPledgeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemPledgeResponse proto.InternalMessageInfo
+
+type MsgPlaceOrder struct {
+	PoolAddress  string        `protobuf:"bytes,1,opt,name=pool_address,json=poolAddress,proto3" json:"pool_address,omitempty" yaml:"pool_address"`
+	OwnerAddress string        `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty" yaml:"owner_address"`
+	MyAsset      types.Coin    `protobuf:"bytes,3,opt,name=my_asset,json=myAsset,proto3" json:"my_asset"`
+	Price        types.DecCoin `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
+	// string expect_asset = 5 [(gogoproto.moretags) = "yaml:\"expect_asset\""];
+	ExpectAsset types.Coin  `protobuf:"bytes,5,opt,name=expect_asset,json=expectAsset,proto3" json:"expect_asset"`
+	OrderId     uint64      `protobuf:"varint,6,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	TimeInForce TimeInForce `protobuf:"varint,7,opt,name=time_in_force,json=timeInForce,proto3,enum=gauss.orderbook.TimeInForce" json:"time_in_force,omitempty"`
+	// block height at the end of which a GTH order expires
+	GoodTilHeight int64 `protobuf:"varint,8,opt,name=good_til_height,json=goodTilHeight,proto3" json:"good_til_height,omitempty"`
+	// time from which a GTT order expires
+	GoodTilTime time.Time `protobuf:"bytes,9,opt,name=good_til_time,json=goodTilTime,proto3,stdtime" json:"good_til_time"`
+}
+
+func (m *MsgPlaceOrder) Reset()         { *m = MsgPlaceOrder{} }
+func (m *MsgPlaceOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceOrder) ProtoMessage()    {}
+func (*MsgPlaceOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61b3910303867e96, []int{6}
+}
+func (m *MsgPlaceOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceOrder.Merge(m, src)
+}
+func (m *MsgPlaceOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceOrder proto.InternalMessageInfo
+
+type MsgPlaceOrderResponse struct {
+}
+
+func (m *MsgPlaceOrderResponse) Reset()         { *m = MsgPlaceOrderResponse{} }
+func (m *MsgPlaceOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceOrderResponse) ProtoMessage()    {}
+func (*MsgPlaceOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61b3910303867e96, []int{7}
+}
+func (m *MsgPlaceOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceOrderResponse.Merge(m, src)
+}
+func (m *MsgPlaceOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceOrderResponse proto.InternalMessageInfo
+
+type MsgRevokeOrder struct {
+	PoolAddress      string `protobuf:"bytes,1,opt,name=pool_address,json=poolAddress,proto3" json:"pool_address,omitempty" yaml:"pool_address"`
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	TxPair           string `protobuf:"bytes,3,opt,name=tx_pair,json=txPair,proto3" json:"tx_pair,omitempty"`
+	OrderId          uint64 `protobuf:"varint,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	IsLeftOrder      bool   `protobuf:"varint,5,opt,name=is_left_order,json=isLeftOrder,proto3" json:"is_left_order,omitempty"`
+}
+
+func (m *MsgRevokeOrder) Reset()         { *m = MsgRevokeOrder{} }
+func (m *MsgRevokeOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeOrder) ProtoMessage()    {}
+func (*MsgRevokeOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61b3910303867e96, []int{8}
+}
+func (m *MsgRevokeOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeOrder.Merge(m, src)
+}
+func (m *MsgRevokeOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeOrder proto.InternalMessageInfo
+
+type MsgRevokeOrderResponse struct {
+}
+
+func (m *MsgRevokeOrderResponse) Reset()         { *m = MsgRevokeOrderResponse{} }
+func (m *MsgRevokeOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeOrderResponse) ProtoMessage()    {}
+func (*MsgRevokeOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61b3910303867e96, []int{9}
+}
+func (m *MsgRevokeOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeOrderResponse.Merge(m, src)
+}
+func (m *MsgRevokeOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeOrderResponse proto.InternalMessageInfo
+
+type MsgAgreeOrderPair struct {
+	PoolAddress      string                                 `protobuf:"bytes,1,opt,name=pool_address,json=poolAddress,proto3" json:"pool_address,omitempty" yaml:"pool_address"`
+	DelegatorAddress string                                 `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	TxPair           string                                 `protobuf:"bytes,3,opt,name=tx_pair,json=txPair,proto3" json:"tx_pair,omitempty"`
+	LeftOrderId      uint64                                 `protobuf:"varint,4,opt,name=left_order_id,json=leftOrderId,proto3" json:"left_order_id,omitempty"`
+	RightOrderId     uint64                                 `protobuf:"varint,5,opt,name=right_order_id,json=rightOrderId,proto3" json:"right_order_id,omitempty"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Price            types.DecCoin                          `protobuf:"bytes,7,opt,name=price,proto3" json:"price"`
+}
+
+func (m *MsgAgreeOrderPair) Reset()         { *m = MsgAgreeOrderPair{} }
+func (m *MsgAgreeOrderPair) String() string { return proto.CompactTextString(m) }
+func (*MsgAgreeOrderPair) ProtoMessage()    {}
+func (*MsgAgreeOrderPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61b3910303867e96, []int{10}
+}
+func (m *MsgAgreeOrderPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAgreeOrderPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAgreeOrderPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAgreeOrderPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAgreeOrderPair.Merge(m, src)
+}
+func (m *MsgAgreeOrderPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAgreeOrderPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAgreeOrderPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAgreeOrderPair proto.InternalMessageInfo
+
+type MsgAgreeOrderPairResponse struct {
+}
+
+func (m *MsgAgreeOrderPairResponse) Reset()         { *m = MsgAgreeOrderPairResponse{} }
+func (m *MsgAgreeOrderPairResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAgreeOrderPairResponse) ProtoMessage()    {}
+func (*MsgAgreeOrderPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61b3910303867e96, []int{11}
+}
+func (m *MsgAgreeOrderPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAgreeOrderPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAgreeOrderPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAgreeOrderPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAgreeOrderPairResponse.Merge(m, src)
+}
+func (m *MsgAgreeOrderPairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAgreeOrderPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAgreeOrderPairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAgreeOrderPairResponse proto.InternalMessageInfo
+
+type MsgAgreeOrderPairWithAmount struct {
+	PoolAddress      string                                 `protobuf:"bytes,1,opt,name=pool_address,json=poolAddress,proto3" json:"pool_address,omitempty" yaml:"pool_address"`
+	DelegatorAddress string                                 `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	TxPair           string                                 `protobuf:"bytes,3,opt,name=tx_pair,json=txPair,proto3" json:"tx_pair,omitempty"`
+	LeftOrderId      uint64                                 `protobuf:"varint,4,opt,name=left_order_id,json=leftOrderId,proto3" json:"left_order_id,omitempty"`
+	RightOrderId     uint64                                 `protobuf:"varint,5,opt,name=right_order_id,json=rightOrderId,proto3" json:"right_order_id,omitempty"`
+	LeftAmount       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=left_amount,json=leftAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"left_amount"`
+	RightAmount      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=right_amount,json=rightAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"right_amount"`
+}
+
+func (m *MsgAgreeOrderPairWithAmount) Reset()         { *m = MsgAgreeOrderPairWithAmount{} }
+func (m *MsgAgreeOrderPairWithAmount) String() string { return proto.CompactTextString(m) }
+func (*MsgAgreeOrderPairWithAmount) ProtoMessage()    {}
+func (*MsgAgreeOrderPairWithAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61b3910303867e96, []int{12}
+}
+func (m *MsgAgreeOrderPairWithAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAgreeOrderPairWithAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAgreeOrderPairWithAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAgreeOrderPairWithAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAgreeOrderPairWithAmount.Merge(m, src)
+}
+func (m *MsgAgreeOrderPairWithAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAgreeOrderPairWithAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAgreeOrderPairWithAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAgreeOrderPairWithAmount proto.InternalMessageInfo
+
+type MsgAgreeOrderPairWithAmountResponse struct {
+}
+
+func (m *MsgAgreeOrderPairWithAmountResponse) Reset()         { *m = MsgAgreeOrderPairWithAmountResponse{} }
+func (m *MsgAgreeOrderPairWithAmountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAgreeOrderPairWithAmountResponse) ProtoMessage()    {}
+func (*MsgAgreeOrderPairWithAmountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61b3910303867e96, []int{13}
+}
+func (m *MsgAgreeOrderPairWithAmountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAgreeOrderPairWithAmountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAgreeOrderPairWithAmountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAgreeOrderPairWithAmountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAgreeOrderPairWithAmountResponse.Merge(m, src)
+}
+func (m *MsgAgreeOrderPairWithAmountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAgreeOrderPairWithAmountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAgreeOrderPairWithAmountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAgreeOrderPairWithAmountResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreatePool)(nil), "gauss.orderbook.MsgCreatePool")
+	proto.RegisterType((*MsgCreatePoolResponse)(nil), "gauss.orderbook.MsgCreatePoolResponse")
+	proto.RegisterType((*MsgAddPledge)(nil), "gauss.orderbook.MsgAddPledge")
+	proto.RegisterType((*MsgAddPledgeResponse)(nil), "gauss.orderbook.MsgAddPledgeResponse")
+	proto.RegisterType((*MsgRedeemPledge)(nil), "gauss.orderbook.MsgRedeemPledge")
+	proto.RegisterType((*MsgRedeemPledgeResponse)(nil), "gauss.orderbook.MsgRedeemPledgeResponse")
+	proto.RegisterType((*MsgPlaceOrder)(nil), "gauss.orderbook.MsgPlaceOrder")
+	proto.RegisterType((*MsgPlaceOrderResponse)(nil), "gauss.orderbook.MsgPlaceOrderResponse")
+	proto.RegisterType((*MsgRevokeOrder)(nil), "gauss.orderbook.MsgRevokeOrder")
+	proto.RegisterType((*MsgRevokeOrderResponse)(nil), "gauss.orderbook.MsgRevokeOrderResponse")
+	proto.RegisterType((*MsgAgreeOrderPair)(nil), "gauss.orderbook.MsgAgreeOrderPair")
+	proto.RegisterType((*MsgAgreeOrderPairResponse)(nil), "gauss.orderbook.MsgAgreeOrderPairResponse")
+	proto.RegisterType((*MsgAgreeOrderPairWithAmount)(nil), "gauss.orderbook.MsgAgreeOrderPairWithAmount")
+	proto.RegisterType((*MsgAgreeOrderPairWithAmountResponse)(nil), "gauss.orderbook.MsgAgreeOrderPairWithAmountResponse")
+}
+
+func init() { proto.RegisterFile("gauss/orderbook/tx.proto", fileDescriptor_61b3910303867e96) }
+
+var fileDescriptor_61b3910303867e96 = []byte{
+	// 1010 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0x8e, 0x9b, 0x36, 0x49, 0x5f, 0x27, 0x2d, 0x6b, 0xca, 0xd6, 0xf1, 0x96, 0x38, 0x32, 0x6c,
+	0x89, 0xf8, 0xb0, 0xb5, 0x65, 0x25, 0x50, 0x25, 0x24, 0x9a, 0x85, 0xd5, 0x46, 0xa2, 0xda, 0xae,
+	0x15, 0x69, 0xa5, 0xbd, 0x18, 0x27, 0x9e, 0xb8, 0x56, 0xed, 0x8c, 0xe5, 0x99, 0x96, 0xe4, 0xc2,
+	0x19, 0x6e, 0x95, 0xb8, 0x20, 0x21, 0xa4, 0xfd, 0x13, 0xfc, 0x87, 0x3d, 0xee, 0x11, 0x71, 0x08,
+	0xa8, 0xbd, 0x70, 0xee, 0x8d, 0x1b, 0xf2, 0xf8, 0x33, 0x1f, 0xb4, 0xdb, 0xdd, 0x3d, 0xac, 0xc4,
+	0xa5, 0xf5, 0xcc, 0xf3, 0xbc, 0xcf, 0xbc, 0x5f, 0xf3, 0xda, 0x01, 0xd1, 0x36, 0x8f, 0x09, 0xd1,
+	0x70, 0x60, 0xa1, 0xa0, 0x87, 0xf1, 0x91, 0x46, 0x47, 0xaa, 0x1f, 0x60, 0x8a, 0x85, 0x75, 0x86,
+	0xa8, 0x29, 0x22, 0xd5, 0x6d, 0x8c, 0x6d, 0x17, 0x69, 0x0c, 0xee, 0x1d, 0x0f, 0x34, 0x73, 0x38,
+	0x8e, 0xb8, 0x92, 0x3c, 0x0b, 0x51, 0xc7, 0x43, 0x84, 0x9a, 0x9e, 0x1f, 0x13, 0x36, 0x6c, 0x6c,
+	0x63, 0xf6, 0xa8, 0x85, 0x4f, 0xf1, 0x6e, 0xbd, 0x8f, 0x89, 0x87, 0x89, 0x11, 0x01, 0xd1, 0x22,
+	0x86, 0x1a, 0xd1, 0x4a, 0xeb, 0x99, 0x04, 0x69, 0x27, 0x77, 0x7a, 0x88, 0x9a, 0x77, 0xb4, 0x3e,
+	0x76, 0x86, 0xe9, 0x89, 0x33, 0x7e, 0xa7, 0x4f, 0x11, 0x41, 0xf9, 0x79, 0x09, 0x6a, 0xfb, 0xc4,
+	0xbe, 0x17, 0x20, 0x93, 0xa2, 0x03, 0x8c, 0x5d, 0xe1, 0x0b, 0xa8, 0xe1, 0xef, 0x86, 0x28, 0x30,
+	0x4c, 0xcb, 0x0a, 0x10, 0x21, 0x22, 0xd7, 0xe4, 0x5a, 0xab, 0x6d, 0xf1, 0x62, 0x22, 0x6f, 0x8c,
+	0x4d, 0xcf, 0xdd, 0x55, 0xa6, 0x60, 0x45, 0xaf, 0xb2, 0xf5, 0x5e, 0xb4, 0x14, 0x3a, 0x70, 0xc3,
+	0x42, 0x2e, 0xb2, 0x4d, 0x8a, 0x33, 0x89, 0x25, 0x26, 0xb1, 0x75, 0x31, 0x91, 0xc5, 0x48, 0x62,
+	0x8e, 0xa2, 0xe8, 0x6f, 0xa5, 0x7b, 0x89, 0xd4, 0x2e, 0x54, 0x2d, 0x34, 0x70, 0x52, 0x95, 0x22,
+	0x53, 0xd9, 0xbc, 0x98, 0xc8, 0x6f, 0x27, 0x2a, 0x19, 0xaa, 0xe8, 0x7c, 0xb8, 0x4c, 0x6c, 0x3f,
+	0x83, 0x92, 0xef, 0x22, 0xcb, 0x46, 0xe2, 0x72, 0x93, 0x6b, 0xf1, 0x3b, 0x75, 0x35, 0xce, 0x5b,
+	0x98, 0x29, 0x35, 0xce, 0x94, 0x7a, 0x0f, 0x3b, 0xc3, 0xf6, 0xf2, 0xb3, 0x89, 0x5c, 0xd0, 0x63,
+	0xfa, 0x6e, 0xe5, 0x87, 0xa7, 0x72, 0xe1, 0xef, 0xa7, 0x72, 0x41, 0xd9, 0x84, 0x77, 0xa6, 0x32,
+	0xa3, 0x23, 0xe2, 0xe3, 0x21, 0x41, 0xca, 0x29, 0x07, 0xd5, 0x7d, 0x62, 0xef, 0x59, 0xd6, 0x01,
+	0xb3, 0x79, 0xd5, 0x94, 0x65, 0xbe, 0x2e, 0xbd, 0xac, 0xaf, 0x37, 0x61, 0x23, 0xef, 0x51, 0xea,
+	0xea, 0x4f, 0x1c, 0xac, 0xef, 0x13, 0x5b, 0x47, 0x16, 0x42, 0xde, 0x1b, 0xe3, 0x6d, 0x1d, 0x36,
+	0x67, 0x9c, 0x4a, 0x1d, 0xfe, 0x75, 0x99, 0xf5, 0xe3, 0x81, 0x6b, 0xf6, 0xd1, 0xc3, 0xc0, 0x42,
+	0x41, 0xd8, 0x05, 0x3e, 0xc6, 0xee, 0x8c, 0xb7, 0xb9, 0x2e, 0xc8, 0xa3, 0x8a, 0xce, 0x87, 0xcb,
+	0xc4, 0xd7, 0xb9, 0x50, 0x97, 0xae, 0x15, 0xea, 0x2e, 0x54, 0xbc, 0xb1, 0x61, 0x12, 0x82, 0xa8,
+	0x58, 0x7c, 0xb1, 0x60, 0xcb, 0xde, 0x78, 0x2f, 0xe4, 0x0b, 0x9f, 0xc3, 0x8a, 0x1f, 0x38, 0xfd,
+	0xa4, 0xff, 0xb6, 0x16, 0x1a, 0x7e, 0x85, 0xfa, 0x39, 0xdb, 0xc8, 0x40, 0x68, 0x43, 0x15, 0x8d,
+	0x7c, 0xd4, 0xa7, 0xf1, 0xc9, 0x2b, 0x2f, 0x76, 0x32, 0x1f, 0x19, 0x45, 0xa7, 0xd7, 0xa1, 0xc2,
+	0x6e, 0xba, 0xe1, 0x58, 0x62, 0xa9, 0xc9, 0xb5, 0x96, 0xf5, 0x32, 0x5b, 0x77, 0x2c, 0xe1, 0x4b,
+	0xa8, 0x85, 0x63, 0xc7, 0x70, 0x86, 0xc6, 0x00, 0x07, 0x7d, 0x24, 0x96, 0x9b, 0x5c, 0x6b, 0x6d,
+	0x67, 0x4b, 0x9d, 0x19, 0x64, 0x6a, 0xd7, 0xf1, 0x50, 0x67, 0x78, 0x3f, 0xe4, 0xe8, 0x3c, 0xcd,
+	0x16, 0xc2, 0x36, 0xac, 0xdb, 0x18, 0x5b, 0x06, 0x75, 0x5c, 0xe3, 0x10, 0x39, 0xf6, 0x21, 0x15,
+	0x2b, 0x4d, 0xae, 0x55, 0xd4, 0x6b, 0xe1, 0x76, 0xd7, 0x71, 0x1f, 0xb0, 0x4d, 0xe1, 0x01, 0xd4,
+	0x52, 0x5e, 0x68, 0x2f, 0xae, 0xb2, 0x48, 0x24, 0x35, 0x1a, 0x83, 0x6a, 0x32, 0x06, 0xd5, 0x6e,
+	0x32, 0x06, 0xdb, 0x95, 0x30, 0x94, 0xd3, 0x3f, 0x65, 0x4e, 0xe7, 0x63, 0xad, 0x10, 0x9b, 0xbb,
+	0x94, 0x59, 0x7b, 0xa4, 0x8d, 0xf3, 0x0f, 0x07, 0x6b, 0xac, 0xa9, 0x4e, 0xf0, 0xd1, 0x6b, 0xe8,
+	0x9c, 0xd7, 0x38, 0xc6, 0x36, 0xa1, 0x4c, 0x47, 0x86, 0x6f, 0x3a, 0x41, 0x34, 0xc1, 0xf4, 0x12,
+	0x1d, 0x1d, 0x98, 0x4e, 0x30, 0x55, 0xa4, 0xe5, 0xe9, 0x22, 0x29, 0x50, 0x73, 0x88, 0xe1, 0xa2,
+	0x01, 0x35, 0xd8, 0x16, 0x6b, 0x82, 0x8a, 0xce, 0x3b, 0xe4, 0x1b, 0x34, 0xa0, 0x2c, 0xbc, 0x5c,
+	0x52, 0x44, 0xb8, 0x39, 0x1d, 0x7a, 0x9a, 0x95, 0x1f, 0x8b, 0x70, 0x23, 0x1c, 0x0c, 0x76, 0x80,
+	0x22, 0x84, 0x1d, 0xfc, 0xa6, 0x27, 0x46, 0x81, 0x5a, 0x16, 0x7a, 0x96, 0x1d, 0xde, 0x4d, 0x62,
+	0xef, 0x58, 0xc2, 0xfb, 0xb0, 0x16, 0x84, 0x5d, 0x96, 0x91, 0x56, 0x18, 0xa9, 0xca, 0x76, 0x13,
+	0xd6, 0x7d, 0x28, 0x99, 0x1e, 0x3e, 0x1e, 0x52, 0x76, 0x0b, 0x56, 0xdb, 0x6a, 0xd8, 0x5f, 0x7f,
+	0x4c, 0xe4, 0x6d, 0xdb, 0xa1, 0x87, 0xc7, 0x3d, 0xb5, 0x8f, 0xbd, 0xf8, 0x85, 0x1a, 0xff, 0xfb,
+	0x84, 0x58, 0x47, 0x1a, 0x1d, 0xfb, 0x88, 0xa8, 0x9d, 0x21, 0xd5, 0x63, 0xeb, 0xec, 0x36, 0x97,
+	0xaf, 0x79, 0x9b, 0x73, 0x55, 0xba, 0x05, 0xf5, 0xb9, 0x52, 0xa4, 0x85, 0xfa, 0xad, 0x08, 0xb7,
+	0xe6, 0xd0, 0xc7, 0x0e, 0x3d, 0xdc, 0x8b, 0x1c, 0xf8, 0xff, 0x94, 0xec, 0x21, 0x30, 0x23, 0xe3,
+	0x95, 0xea, 0x06, 0xa1, 0x44, 0x9c, 0xba, 0x47, 0x10, 0x1d, 0x90, 0x28, 0x96, 0x5f, 0x4a, 0x91,
+	0x67, 0x1a, 0x91, 0x64, 0xae, 0xa8, 0xb7, 0xe1, 0xbd, 0x4b, 0xca, 0x96, 0x94, 0x77, 0xe7, 0x97,
+	0x15, 0x28, 0xee, 0x13, 0x5b, 0xe8, 0x02, 0xe4, 0x3e, 0xb5, 0x1a, 0x73, 0x33, 0x77, 0xea, 0x83,
+	0x43, 0xda, 0xbe, 0x1c, 0x4f, 0xd4, 0x85, 0x47, 0xb0, 0x9a, 0x7d, 0x8c, 0xbc, 0xbb, 0xc8, 0x28,
+	0x85, 0xa5, 0xdb, 0x97, 0xc2, 0xa9, 0xe4, 0x13, 0xa8, 0x4e, 0x7d, 0x34, 0x34, 0x17, 0x99, 0xe5,
+	0x19, 0x52, 0xeb, 0x2a, 0x46, 0xaa, 0xdd, 0x05, 0xc8, 0xbd, 0xdf, 0x17, 0x26, 0x21, 0xc3, 0xa5,
+	0xed, 0xcb, 0xf1, 0x54, 0xf5, 0x31, 0xf0, 0xf9, 0xe1, 0x2f, 0x2f, 0x76, 0x27, 0x25, 0x48, 0x1f,
+	0x5c, 0x41, 0x48, 0x85, 0xbf, 0x85, 0xb5, 0x99, 0xf9, 0xa9, 0x2c, 0xcc, 0xe1, 0x14, 0x47, 0xfa,
+	0xf0, 0x6a, 0x4e, 0x7a, 0xc2, 0xf7, 0x20, 0xfe, 0xe7, 0xc5, 0xff, 0xf8, 0x6a, 0x9d, 0x8c, 0x2d,
+	0xdd, 0xbd, 0x0e, 0x3b, 0x39, 0xbf, 0xfd, 0xf5, 0xb3, 0xb3, 0x06, 0xf7, 0xfc, 0xac, 0xc1, 0xfd,
+	0x75, 0xd6, 0xe0, 0x4e, 0xcf, 0x1b, 0x85, 0xe7, 0xe7, 0x8d, 0xc2, 0xef, 0xe7, 0x8d, 0xc2, 0x93,
+	0x8f, 0x72, 0xb7, 0x23, 0xfa, 0x29, 0x11, 0xfd, 0x3d, 0xb9, 0xab, 0x8d, 0xf2, 0xbf, 0x86, 0xc2,
+	0x6b, 0xd2, 0x2b, 0xb1, 0x17, 0xfa, 0xa7, 0xff, 0x0e, 0x00, 0xdf, 0x0c, 0x85, 0xbe, 0x2d, 0x0d,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreatePool defines a method for creating a new transaction pool.
+	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
+	// AddPledge defines a method for adding some pledge to a pool.
+	AddPledge(ctx context.Context, in *MsgAddPledge, opts ...grpc.CallOption) (*MsgAddPledgeResponse, error)
+	// RedeemPledge defines a method for redeaming pledge.
+	RedeemPledge(ctx context.Context, in *MsgRedeemPledge, opts ...grpc.CallOption) (*MsgRedeemPledgeResponse, error)
+	// PlaceOrder defines a method for placing a order.
+	PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error)
+	// RevokeOrder defines a method for revoking a order.
+	RevokeOrder(ctx context.Context, in *MsgRevokeOrder, opts ...grpc.CallOption) (*MsgRevokeOrderResponse, error)
+	// AgreeOrderPair defines a method for agreeing a pair orders.
+	AgreeOrderPair(ctx context.Context, in *MsgAgreeOrderPair, opts ...grpc.CallOption) (*MsgAgreeOrderPairResponse, error)
+	// AgreeOrderPairWithAmount defines a method for agreeing a pair orders.
+	AgreeOrderPairWithAmount(ctx context.Context, in *MsgAgreeOrderPairWithAmount, opts ...grpc.CallOption) (*MsgAgreeOrderPairWithAmountResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error) {
+	out := new(MsgCreatePoolResponse)
+	err := c.cc.Invoke(ctx, "/gauss.orderbook.Msg/CreatePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddPledge(ctx context.Context, in *MsgAddPledge, opts ...grpc.CallOption) (*MsgAddPledgeResponse, error) {
+	out := new(MsgAddPledgeResponse)
+	err := c.cc.Invoke(ctx, "/gauss.orderbook.Msg/AddPledge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedeemPledge(ctx context.Context, in *MsgRedeemPledge, opts ...grpc.CallOption) (*MsgRedeemPledgeResponse, error) {
+	out := new(MsgRedeemPledgeResponse)
+	err := c.cc.Invoke(ctx, "/gauss.orderbook.Msg/RedeemPledge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error) {
+	out := new(MsgPlaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/gauss.orderbook.Msg/PlaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeOrder(ctx context.Context, in *MsgRevokeOrder, opts ...grpc.CallOption) (*MsgRevokeOrderResponse, error) {
+	out := new(MsgRevokeOrderResponse)
+	err := c.cc.Invoke(ctx, "/gauss.orderbook.Msg/RevokeOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AgreeOrderPair(ctx context.Context, in *MsgAgreeOrderPair, opts ...grpc.CallOption) (*MsgAgreeOrderPairResponse, error) {
+	out := new(MsgAgreeOrderPairResponse)
+	err := c.cc.Invoke(ctx, "/gauss.orderbook.Msg/AgreeOrderPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AgreeOrderPairWithAmount(ctx context.Context, in *MsgAgreeOrderPairWithAmount, opts ...grpc.CallOption) (*MsgAgreeOrderPairWithAmountResponse, error) {
+	out := new(MsgAgreeOrderPairWithAmountResponse)
+	err := c.cc.Invoke(ctx, "/gauss.orderbook.Msg/AgreeOrderPairWithAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreatePool defines a method for creating a new transaction pool.
+	CreatePool(context.Context, *MsgCreatePool) (*MsgCreatePoolResponse, error)
+	// AddPledge defines a method for adding some pledge to a pool.
+	AddPledge(context.Context, *MsgAddPledge) (*MsgAddPledgeResponse, error)
+	// RedeemPledge defines a method for redeaming pledge.
+	RedeemPledge(context.Context, *MsgRedeemPledge) (*MsgRedeemPledgeResponse, error)
+	// PlaceOrder defines a method for placing a order.
+	PlaceOrder(context.Context, *MsgPlaceOrder) (*MsgPlaceOrderResponse, error)
+	// RevokeOrder defines a method for revoking a order.
+	RevokeOrder(context.Context, *MsgRevokeOrder) (*MsgRevokeOrderResponse, error)
+	// AgreeOrderPair defines a method for agreeing a pair orders.
+	AgreeOrderPair(context.Context, *MsgAgreeOrderPair) (*MsgAgreeOrderPairResponse, error)
+	// AgreeOrderPairWithAmount defines a method for agreeing a pair orders.
+	AgreeOrderPairWithAmount(context.Context, *MsgAgreeOrderPairWithAmount) (*MsgAgreeOrderPairWithAmountResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreatePool(ctx context.Context, req *MsgCreatePool) (*MsgCreatePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePool not implemented")
+}
+func (*UnimplementedMsgServer) AddPledge(ctx context.Context, req *MsgAddPledge) (*MsgAddPledgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPledge not implemented")
+}
+func (*UnimplementedMsgServer) RedeemPledge(ctx context.Context, req *MsgRedeemPledge) (*MsgRedeemPledgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemPledge not implemented")
+}
+func (*UnimplementedMsgServer) PlaceOrder(ctx context.Context, req *MsgPlaceOrder) (*MsgPlaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+}
+func (*UnimplementedMsgServer) RevokeOrder(ctx context.Context, req *MsgRevokeOrder) (*MsgRevokeOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOrder not implemented")
+}
+func (*UnimplementedMsgServer) AgreeOrderPair(ctx context.Context, req *MsgAgreeOrderPair) (*MsgAgreeOrderPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgreeOrderPair not implemented")
+}
+func (*UnimplementedMsgServer) AgreeOrderPairWithAmount(ctx context.Context, req *MsgAgreeOrderPairWithAmount) (*MsgAgreeOrderPairWithAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgreeOrderPairWithAmount not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gauss.orderbook.Msg/CreatePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePool(ctx, req.(*MsgCreatePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddPledge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddPledge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddPledge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gauss.orderbook.Msg/AddPledge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddPledge(ctx, req.(*MsgAddPledge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemPledge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemPledge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemPledge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gauss.orderbook.Msg/RedeemPledge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemPledge(ctx, req.(*MsgRedeemPledge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gauss.orderbook.Msg/PlaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceOrder(ctx, req.(*MsgPlaceOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gauss.orderbook.Msg/RevokeOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeOrder(ctx, req.(*MsgRevokeOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AgreeOrderPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAgreeOrderPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AgreeOrderPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gauss.orderbook.Msg/AgreeOrderPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AgreeOrderPair(ctx, req.(*MsgAgreeOrderPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AgreeOrderPairWithAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAgreeOrderPairWithAmount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AgreeOrderPairWithAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gauss.orderbook.Msg/AgreeOrderPairWithAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AgreeOrderPairWithAmount(ctx, req.(*MsgAgreeOrderPairWithAmount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gauss.orderbook.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePool",
+			Handler:    _Msg_CreatePool_Handler,
+		},
+		{
+			MethodName: "AddPledge",
+			Handler:    _Msg_AddPledge_Handler,
+		},
+		{
+			MethodName: "RedeemPledge",
+			Handler:    _Msg_RedeemPledge_Handler,
+		},
+		{
+			MethodName: "PlaceOrder",
+			Handler:    _Msg_PlaceOrder_Handler,
+		},
+		{
+			MethodName: "RevokeOrder",
+			Handler:    _Msg_RevokeOrder_Handler,
+		},
+		{
+			MethodName: "AgreeOrderPair",
+			Handler:    _Msg_AgreeOrderPair_Handler,
+		},
+		{
+			MethodName: "AgreeOrderPairWithAmount",
+			Handler:    _Msg_AgreeOrderPairWithAmount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gauss/orderbook/tx.proto",
+}
+
+func (m *MsgCreatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pledge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DefiAddress) > 0 {
+		i -= len(m.DefiAddress)
+		copy(dAtA[i:], m.DefiAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DefiAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddPledge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddPledge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddPledge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pledge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddPledgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddPledgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddPledgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemPledge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemPledge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemPledge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pledge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemPledgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemPledgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemPledgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.GoodTilTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.GoodTilTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x4a
+	if m.GoodTilHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GoodTilHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.ExpectAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MyAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolAddress) > 0 {
+		i -= len(m.PoolAddress)
+		copy(dAtA[i:], m.PoolAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PoolAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsLeftOrder {
+		i--
+		if m.IsLeftOrder {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TxPair) > 0 {
+		i -= len(m.TxPair)
+		copy(dAtA[i:], m.TxPair)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxPair)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolAddress) > 0 {
+		i -= len(m.PoolAddress)
+		copy(dAtA[i:], m.PoolAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PoolAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAgreeOrderPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAgreeOrderPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAgreeOrderPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.RightOrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RightOrderId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LeftOrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LeftOrderId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TxPair) > 0 {
+		i -= len(m.TxPair)
+		copy(dAtA[i:], m.TxPair)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxPair)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolAddress) > 0 {
+		i -= len(m.PoolAddress)
+		copy(dAtA[i:], m.PoolAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PoolAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAgreeOrderPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAgreeOrderPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAgreeOrderPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAgreeOrderPairWithAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAgreeOrderPairWithAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAgreeOrderPairWithAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RightAmount.Size()
+		i -= size
+		if _, err := m.RightAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.LeftAmount.Size()
+		i -= size
+		if _, err := m.LeftAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.RightOrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RightOrderId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LeftOrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LeftOrderId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TxPair) > 0 {
+		i -= len(m.TxPair)
+		copy(dAtA[i:], m.TxPair)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxPair)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolAddress) > 0 {
+		i -= len(m.PoolAddress)
+		copy(dAtA[i:], m.PoolAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PoolAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAgreeOrderPairWithAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAgreeOrderPairWithAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAgreeOrderPairWithAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DefiAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pledge.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreatePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddPledge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pledge.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddPledgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedeemPledge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pledge.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemPledgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MyAsset.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExpectAsset.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovTx(uint64(m.TimeInForce))
+	}
+	if m.GoodTilHeight != 0 {
+		n += 1 + sovTx(uint64(m.GoodTilHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.GoodTilTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxPair)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	if m.IsLeftOrder {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRevokeOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAgreeOrderPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxPair)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LeftOrderId != 0 {
+		n += 1 + sovTx(uint64(m.LeftOrderId))
+	}
+	if m.RightOrderId != 0 {
+		n += 1 + sovTx(uint64(m.RightOrderId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAgreeOrderPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAgreeOrderPairWithAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxPair)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LeftOrderId != 0 {
+		n += 1 + sovTx(uint64(m.LeftOrderId))
+	}
+	if m.RightOrderId != 0 {
+		n += 1 + sovTx(uint64(m.RightOrderId))
+	}
+	l = m.LeftAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RightAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAgreeOrderPairWithAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefiAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefiAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pledge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pledge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddPledge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddPledge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddPledge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pledge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pledge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddPledgeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddPledgeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddPledgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemPledge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemPledge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemPledge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pledge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pledge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemPledgeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemPledgeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemPledgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MyAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MyAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpectAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilHeight", wireType)
+			}
+			m.GoodTilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GoodTilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.GoodTilTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLeftOrder", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLeftOrder = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAgreeOrderPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAgreeOrderPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAgreeOrderPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftOrderId", wireType)
+			}
+			m.LeftOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeftOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RightOrderId", wireType)
+			}
+			m.RightOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RightOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAgreeOrderPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAgreeOrderPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAgreeOrderPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAgreeOrderPairWithAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAgreeOrderPairWithAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAgreeOrderPairWithAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftOrderId", wireType)
+			}
+			m.LeftOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeftOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RightOrderId", wireType)
+			}
+			m.RightOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RightOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LeftAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RightAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RightAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAgreeOrderPairWithAmountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAgreeOrderPairWithAmountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAgreeOrderPairWithAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
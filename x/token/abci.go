@@ -0,0 +1,20 @@
+package token
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/x/token/keeper"
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+// BeginBlocker releases the amounts of the token vestings vested since the last block
+// and claws back the unclaimed amounts of the ended token distributions
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.ReleaseVestedTokens(ctx)
+	k.ClawbackEndedDistributions(ctx)
+}
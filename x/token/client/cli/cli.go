@@ -25,6 +25,17 @@ func NewTxCmd() *cobra.Command {
 		GetCmdBurnToken(),
 		GetCmdUnlockToken(),
 		GetCmdTransferTokenOwner(),
+		GetCmdGrantTokenRole(),
+		GetCmdRevokeTokenRole(),
+		GetCmdPauseToken(),
+		GetCmdUnpauseToken(),
+		GetCmdFreezeAccount(),
+		GetCmdUnfreezeAccount(),
+		GetCmdCreateTokenVesting(),
+		GetCmdTakeTokenSnapshot(),
+		GetCmdDistributeToken(),
+		GetCmdClaimDistribution(),
+		GetCmdDistributionTree(),
 	)
 
 	return txCmd
@@ -47,6 +58,17 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryToken(),
 		GetCmdQueryTokenFees(),
 		GetCmdQueryBurntoken(),
+		GetCmdQueryRoles(),
+		GetCmdQueryFrozenAccounts(),
+		GetCmdQueryVestings(),
+		GetCmdQuerySymbolAvailability(),
+		GetCmdQueryHolders(),
+		GetCmdQuerySnapshots(),
+		GetCmdQuerySnapshot(),
+		GetCmdExportHolders(),
+		GetCmdQueryDistributions(),
+		GetCmdQueryDistribution(),
+		GetCmdQueryClaimStatus(),
 	)
 
 	return queryCmd
@@ -7,16 +7,28 @@ import (
 )
 
 const (
-	FlagName 	  = "name"
-	FlagSymbol        = "symbol"
-	FlagDecimals      = "decimals"
-	FlagSmallestUnit  = "smallest-unit"
-	FlagInitialSupply = "initial-supply"
-	FlagTotalSupply   = "total-supply"
-	FlagMintable      = "mintable"
-	FlagUnlocked      = "unlocked"
-	FlagTo            = "to"
-	FlagAmount        = "amount"
+	FlagName           = "name"
+	FlagSymbol         = "symbol"
+	FlagDecimals       = "decimals"
+	FlagSmallestUnit   = "smallest-unit"
+	FlagInitialSupply  = "initial-supply"
+	FlagTotalSupply    = "total-supply"
+	FlagMintable       = "mintable"
+	FlagUnlocked       = "unlocked"
+	FlagTo             = "to"
+	FlagAmount         = "amount"
+	FlagURI            = "uri"
+	FlagURIHash        = "uri-hash"
+	FlagDescription    = "description"
+	FlagAttributes     = "attributes"
+	FlagHolderBurnable = "holder-burnable"
+	FlagFreezable      = "freezable"
+	FlagRemove         = "remove"
+	FlagOwner          = "owner"
+	FlagSnapshot       = "snapshot"
+	FlagFormat         = "format"
+	FlagFile           = "file"
+	FlagMint           = "mint"
 )
 
 var (
@@ -35,8 +47,14 @@ func init() {
 	FsIssueToken.Uint64(FlagTotalSupply, types.MaximumAmount, "The maximum supply of the token(default 1.8*10^19). The unit is smallest-unit")
 	FsIssueToken.Bool(FlagMintable, false, "Whether the token can be minted (default false)")
 	FsIssueToken.Bool(FlagUnlocked, true, "Whether the token can be transfer")
+	FsIssueToken.Bool(FlagHolderBurnable, false, "Whether any holder can burn its own tokens (default false)")
+	FsIssueToken.Bool(FlagFreezable, false, "Whether the owner can freeze the transfers of the token from an account, fixed at issuance (default false)")
 
 	FsEditToken.Bool(FlagMintable, false, "Whether the token can be minted, default to false")
+	FsEditToken.String(FlagURI, types.DoNotModify, "The uri of the token metadata")
+	FsEditToken.String(FlagURIHash, types.DoNotModify, "The hex encoded hash of the content behind the uri")
+	FsEditToken.String(FlagDescription, types.DoNotModify, "The token description")
+	FsEditToken.StringToString(FlagAttributes, nil, "The attributes to set as key1=value1,key2=value2, an empty value removes the key")
 
 	FsMintToken.String(FlagTo, "", "Address to which the token is to be minted")
 	FsMintToken.Uint64(FlagAmount, 0, "Amount of the token to be minted")
@@ -2,7 +2,11 @@ package cli
 
 import (
 	"context"
+	"encoding/csv"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -10,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/gauss/gauss/v4/x/token/types"
@@ -246,4 +251,610 @@ $ %s query %s burned <symbol>`,
 	return cmd
 }
 
+// GetCmdQueryRoles implements the query token roles command.
+func GetCmdQueryRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "roles [address] [symbol]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query the token roles granted to an address.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the token roles granted to an address, optionally of a single token
+
+Example:
+$ %s query %s roles <address> [symbol]`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			req := &types.QueryRolesRequest{Address: args[0]}
+			if len(args) > 1 {
+				if err := types.ValidateSymbol(args[1]); err != nil {
+					return err
+				}
+				req.Symbol = args[1]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Roles(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFrozenAccounts implements the query frozen accounts command.
+func GetCmdQueryFrozenAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen [symbol]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the accounts frozen for a token.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the accounts whose outgoing transfers of a token are frozen
+
+Example:
+$ %s query %s frozen <symbol>`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateSymbol(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FrozenAccounts(
+				context.Background(),
+				&types.QueryFrozenAccountsRequest{
+					Symbol:     args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen accounts")
+
+	return cmd
+}
+
+// GetCmdQueryVestings implements the query token vestings command
+func GetCmdQueryVestings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vestings [beneficiary]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the token vestings of a beneficiary.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the token vestings of a beneficiary with their vested and unvested amounts
+
+Example:
+$ %s query %s vestings <beneficiary>`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Vestings(
+				context.Background(),
+				&types.QueryVestingsRequest{
+					Beneficiary: args[0],
+					Pagination:  pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token vestings")
+
+	return cmd
+}
+
+// GetCmdQuerySymbolAvailability implements the query symbol availability command
+func GetCmdQuerySymbolAvailability() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "symbol [symbol]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether a symbol can be issued and its exact issue fee.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether a symbol is issued or reserved and the exact fee to issue it,
+a reserved symbol being available to the address it is reserved for given by --owner
+
+Example:
+$ %s query %s symbol <symbol> --owner=<address>`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateSymbol(args[0]); err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SymbolAvailability(
+				context.Background(),
+				&types.QuerySymbolAvailabilityRequest{
+					Symbol: args[0],
+					Owner:  owner,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(FlagOwner, "", "the address issuing the symbol")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryHolders implements the query token holders command
+func GetCmdQueryHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holders [symbol]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the holders of a token with their balances.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the holders of a token with their balances, at a past height with --height
+
+Example:
+$ %s query %s holders <symbol> --height=<height>`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateSymbol(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Holders(
+				context.Background(),
+				&types.QueryHoldersRequest{
+					Symbol:     args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token holders")
+
+	return cmd
+}
+
+// GetCmdQuerySnapshots implements the query token snapshots command
+func GetCmdQuerySnapshots() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots [symbol]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the snapshots of a token.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the snapshots of the holders of a token
+
+Example:
+$ %s query %s snapshots <symbol>`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateSymbol(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Snapshots(
+				context.Background(),
+				&types.QuerySnapshotsRequest{
+					Symbol:     args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token snapshots")
+
+	return cmd
+}
+
+// GetCmdQuerySnapshot implements the query token snapshot command
+func GetCmdQuerySnapshot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a token snapshot by id.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a snapshot of the holders of a token by id
+
+Example:
+$ %s query %s snapshot <id>`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid snapshot id %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Snapshot(context.Background(), &types.QuerySnapshotRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Snapshot)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdExportHolders implements the export token holders command
+func GetCmdExportHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-holders [symbol]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Export all the holders of a token as CSV or JSON.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export all the holders of a token with their balances as CSV or JSON, at the height
+of a snapshot with --snapshot, at a past height with --height or else at the latest height.
+All the pages are queried at the same height.
+
+Example:
+$ %s query %s export-holders <symbol> --snapshot=<id> --format=csv --file=holders.csv`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateSymbol(args[0]); err != nil {
+				return err
+			}
+
+			format, err := cmd.Flags().GetString(FlagFormat)
+			if err != nil {
+				return err
+			}
+			if format != "csv" && format != "json" {
+				return fmt.Errorf("invalid format %s, expected csv or json", format)
+			}
+
+			snapshotID, err := cmd.Flags().GetUint64(FlagSnapshot)
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetUint64(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if snapshotID > 0 {
+				res, err := queryClient.Snapshot(context.Background(), &types.QuerySnapshotRequest{Id: snapshotID})
+				if err != nil {
+					return err
+				}
+				if res.Snapshot.Symbol != args[0] {
+					return fmt.Errorf("snapshot %d is a snapshot of %s", snapshotID, res.Snapshot.Symbol)
+				}
+				clientCtx = clientCtx.WithHeight(res.Snapshot.Height)
+			}
+
+			// pin the height of the first page so that the holders are consistent
+			all := &types.QueryHoldersResponse{}
+			pageReq := &query.PageRequest{Limit: limit}
+			for {
+				res, err := types.NewQueryClient(clientCtx).Holders(
+					context.Background(),
+					&types.QueryHoldersRequest{Symbol: args[0], Pagination: pageReq},
+				)
+				if err != nil {
+					return err
+				}
+
+				all.Holders = append(all.Holders, res.Holders...)
+				all.Height = res.Height
+				clientCtx = clientCtx.WithHeight(res.Height)
+
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: limit}
+			}
+
+			out := cmd.OutOrStdout()
+			file, err := cmd.Flags().GetString(FlagFile)
+			if err != nil {
+				return err
+			}
+			if len(file) > 0 {
+				f, err := os.Create(file)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+
+			if format == "json" {
+				bz, err := clientCtx.JSONMarshaler.MarshalJSON(all)
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintln(out, string(bz))
+				return err
+			}
+
+			return writeHoldersCSV(out, all.Holders)
+		},
+	}
+	cmd.Flags().Uint64(FlagSnapshot, 0, "the id of the snapshot to export the holders at")
+	cmd.Flags().String(FlagFormat, "csv", "the export format, csv or json")
+	cmd.Flags().String(FlagFile, "", "the file to export the holders to instead of the standard output")
+	cmd.Flags().Uint64(flags.FlagLimit, query.DefaultLimit, "the number of holders queried per page")
+	flags.AddQueryFlagsToCmd(cmd)
 
+	return cmd
+}
+
+// GetCmdQueryDistributions implements the query token distributions command
+func GetCmdQueryDistributions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distributions [symbol]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the pending distributions of a token.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the distributions of a token whose unclaimed amount is not clawed back yet
+
+Example:
+$ %s query %s distributions <symbol>`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateSymbol(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Distributions(
+				context.Background(),
+				&types.QueryDistributionsRequest{
+					Symbol:     args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token distributions")
+
+	return cmd
+}
+
+// GetCmdQueryDistribution implements the query token distribution command
+func GetCmdQueryDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a pending token distribution by id.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a pending token distribution by id with its claimed amount
+
+Example:
+$ %s query %s distribution <id>`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid distribution id %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Distribution(context.Background(), &types.QueryDistributionRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Distribution)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryClaimStatus implements the query distribution claim status command
+func GetCmdQueryClaimStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-status [id] [address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query whether an address claimed its amount of a token distribution.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether an address claimed its amount of a pending token distribution
+and whether the distribution ended
+
+Example:
+$ %s query %s claim-status <id> <address>`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid distribution id %s: %w", args[0], err)
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[1]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClaimStatus(context.Background(), &types.QueryClaimStatusRequest{Id: id, Address: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// writeHoldersCSV writes the holders as CSV records of address, amount and denom
+func writeHoldersCSV(out io.Writer, holders []types.TokenHolder) error {
+	w := csv.NewWriter(out)
+	if err := w.Write([]string{"address", "amount", "denom"}); err != nil {
+		return err
+	}
+	for _, holder := range holders {
+		if err := w.Write([]string{holder.Address, holder.Balance.Amount.String(), holder.Balance.Denom}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -1,8 +1,13 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -11,6 +16,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/gauss/gauss/v4/x/token/types"
 )
@@ -25,7 +32,7 @@ func GetCmdIssueToken() *cobra.Command {
 			fmt.Sprintf(`Issue a new token 
 
 Example:
-$ %s tx %s issue gauss --name=\"gauss network\" --smallest-unit=ugauss --decimals=6 --initial-supply=1000000000 --total-supply=10000000000 --mintable=true --from=<key-name>
+$ %s tx %s issue gauss --name=\"gauss network\" --smallest-unit=ugauss --decimals=6 --initial-supply=1000000000 --total-supply=10000000000 --mintable=true --holder-burnable=true --freezable=true --from=<key-name>
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -69,10 +76,18 @@ $ %s tx %s issue gauss --name=\"gauss network\" --smallest-unit=ugauss --decimal
 			if err != nil {
 				return err
 			}
+			holderBurnable, err := cmd.Flags().GetBool(FlagHolderBurnable)
+			if err != nil {
+				return err
+			}
+			freezable, err := cmd.Flags().GetBool(FlagFreezable)
+			if err != nil {
+				return err
+			}
 			owner := clientCtx.GetFromAddress()
 
 			msg := types.NewMsgIssueToken(name, symbol, smallestUnit, decimals, initialSupply, 
-				totalSupply, mintable, unlocked, owner.String())
+				totalSupply, mintable, unlocked, holderBurnable, freezable, owner.String())
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -117,7 +132,7 @@ func GetCmdEditToken() *cobra.Command {
 			fmt.Sprintf(`Edit an existing token
 
 Example:
-$ %s tx %s edit gauss --mintable=true --from=<key-name>
+$ %s tx %s edit gauss --mintable=true --uri=<uri> --uri-hash=<hash> --description=<description> --attributes=website=gauss.io,logo= --from=<key-name>
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -132,9 +147,30 @@ $ %s tx %s edit gauss --mintable=true --from=<key-name>
 			if err != nil {
 				return err
 			}
+			uri, err := cmd.Flags().GetString(FlagURI)
+			if err != nil {
+				return err
+			}
+			uriHash, err := cmd.Flags().GetString(FlagURIHash)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(FlagDescription)
+			if err != nil {
+				return err
+			}
+			attrs, err := cmd.Flags().GetStringToString(FlagAttributes)
+			if err != nil {
+				return err
+			}
+			attributes := make([]types.Attribute, 0, len(attrs))
+			for key, value := range attrs {
+				attributes = append(attributes, types.Attribute{Key: key, Value: value})
+			}
+			sort.Slice(attributes, func(i, j int) bool { return attributes[i].Key < attributes[j].Key })
 			owner := clientCtx.GetFromAddress()
 
-			msg := types.NewMsgEditToken(args[0], mintable, owner.String())
+			msg := types.NewMsgEditToken(args[0], uri, uriHash, description, attributes, mintable, owner.String())
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -304,6 +340,80 @@ $ %s tx %s unlock gauss --from=my_key
 	return cmd
 }
 
+// GetCmdPauseToken implements the pause token command
+func GetCmdPauseToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause [symbol]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Pause all the transfers of a token, by its owner or a pauser.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Pause all the transfers of a token, by its owner or a pauser.
+
+Example:
+$ %s tx %s pause gauss --from=my_key
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgPauseToken(args[0], sender.String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdUnpauseToken implements the unpause token command
+func GetCmdUnpauseToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause [symbol]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Resume the transfers of a paused token, by its owner or a pauser.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Resume the transfers of a paused token, by its owner or a pauser.
+
+Example:
+$ %s tx %s unpause gauss --from=my_key
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgUnpauseToken(args[0], sender.String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdTransferTokenOwner implements transfer the token owner command
 func GetCmdTransferTokenOwner() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -351,3 +461,601 @@ $ %s tx %s transfer gauss --to=%s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p  --from
 
 	return cmd
 }
+
+// GetCmdGrantTokenRole implements grant a token role command
+func GetCmdGrantTokenRole() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:  "grant-role [symbol] [role] [address]",
+		Args: cobra.ExactArgs(3),
+		Short: "Grant a role of a token to an address.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant a role of a token to an address, the role is one of
+minter, burner, pauser or metadata-admin
+
+Example:
+$ %s tx %s grant-role gauss minter %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from=my_key
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			role, err := types.TokenRoleFromString(args[1])
+			if err != nil {
+				return err
+			}
+			owner := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgGrantTokenRole(args[0], role, args[2], owner.String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRevokeTokenRole implements revoke a token role command
+func GetCmdRevokeTokenRole() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:  "revoke-role [symbol] [role] [address]",
+		Args: cobra.ExactArgs(3),
+		Short: "Revoke a role of a token from an address.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke a role of a token from an address, the role is one of
+minter, burner, pauser or metadata-admin
+
+Example:
+$ %s tx %s revoke-role gauss minter %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from=my_key
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			role, err := types.TokenRoleFromString(args[1])
+			if err != nil {
+				return err
+			}
+			owner := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgRevokeTokenRole(args[0], role, args[2], owner.String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFreezeAccount implements the freeze account command
+func GetCmdFreezeAccount() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "freeze [symbol] [address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Freeze the outgoing transfers of a freezable token from an address.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze the outgoing transfers of a freezable token from an address.
+
+Example:
+$ %s tx %s freeze gauss %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from=my_key
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgFreezeAccount(args[0], args[1], owner.String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdUnfreezeAccount implements the unfreeze account command
+func GetCmdUnfreezeAccount() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "unfreeze [symbol] [address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Unfreeze an address frozen for a token.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unfreeze an address frozen for a token.
+
+Example:
+$ %s tx %s unfreeze gauss %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from=my_key
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgUnfreezeAccount(args[0], args[1], owner.String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCreateTokenVesting implements the create token vesting command
+func GetCmdCreateTokenVesting() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "create-vesting [beneficiary] [amount] [start-time] [cliff-time] [end-time]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Escrow tokens released to a beneficiary on a schedule.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Escrow an amount of a token in the token module account, released to the
+beneficiary linearly from the start time (RFC3339) until the end time, nothing being
+released before the cliff time.
+
+Example:
+$ %s tx %s create-vesting %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1000000ugauss 2022-01-01T00:00:00Z 2022-07-01T00:00:00Z 2023-01-01T00:00:00Z --from=my_key
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress()
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			var times [3]time.Time
+			for i, timeStr := range args[2:] {
+				if times[i], err = time.Parse(time.RFC3339, timeStr); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgCreateTokenVesting(sender.String(), args[0], amount, times[0], times[1], times[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdTakeTokenSnapshot implements the take token snapshot command
+func GetCmdTakeTokenSnapshot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot [symbol]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Record a snapshot of the token holders at the current height.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Record a snapshot of the token holders at the height the tx is included in,
+the holders being exported with the export-holders query command
+
+Example:
+$ %s tx %s snapshot <symbol> --from=my_key
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgTakeTokenSnapshot(args[0], owner.String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDistributeToken implements the distribute token command
+func GetCmdDistributeToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribute [symbol] [merkle-root] [amount] [end-time]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Distribute tokens to the recipients of a merkle root.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Escrow an amount of a token, in its smallest unit, claimable by the (address, amount)
+pairs of the hex encoded merkle root until the end time (RFC3339), the unclaimed amount
+being sent back to the owner afterwards. The amount is taken from the balance of the
+owner, or minted when --mint is set, the mint fee being charged. The merkle root and the
+proofs of a CSV file of addresses and amounts are built by the distribution-tree command.
+
+Example:
+$ %s tx %s distribute <symbol> <merkle-root> 1000000 2023-01-01T00:00:00Z --mint --from=my_key
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			amount, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid amount %s: %w", args[2], err)
+			}
+
+			endTime, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return err
+			}
+
+			mint, err := cmd.Flags().GetBool(FlagMint)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDistributeToken(args[0], owner.String(), args[1], amount, endTime, mint)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if mint {
+				var prompt = "The minted token distribution will consume extra fee"
+				generateOnly, err := cmd.Flags().GetBool(flags.FlagGenerateOnly)
+				if err != nil {
+					return err
+				}
+				if !generateOnly {
+					fees, errL := queryTokenFees(clientCtx, args[0])
+					if errL != nil {
+						return fmt.Errorf("failed to query token minting fees: %s", errL.Error())
+					}
+					prompt += fmt.Sprintf(": %s", sdk.Coins{fees.MintFee}.String())
+				}
+
+				fmt.Println(prompt)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagMint, false, "mint the distributed amount instead of taking it from the owner balance")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdClaimDistribution implements the claim token distribution command
+func GetCmdClaimDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-distribution [id] [amount] [proof]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Claim the amount of a token distribution allotted to the sender.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the amount of a token distribution allotted to the sender, proven by the
+comma separated hex encoded nodes of its merkle proof, as built by the distribution-tree
+command. The proof of a distribution to a single recipient is empty.
+
+Example:
+$ %s tx %s claim-distribution 1 1000 <node1>,<node2> --from=my_key
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient := clientCtx.GetFromAddress()
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid distribution id %s: %w", args[0], err)
+			}
+
+			amount, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid amount %s: %w", args[1], err)
+			}
+
+			var proof []string
+			if len(args) == 3 && len(args[2]) > 0 {
+				proof = strings.Split(args[2], ",")
+			}
+
+			msg := types.NewMsgClaimDistribution(id, recipient.String(), amount, proof)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDistributionTree implements the offline command building the merkle tree of a token distribution
+func GetCmdDistributionTree() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution-tree [file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Build the merkle root and the proofs of a token distribution offline.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Build the merkle root of the addresses and amounts of a CSV file, one "address,amount"
+record per line, and print it with the total amount and the proof of every recipient. The
+files written by the export-holders query command can be used, their header and denom
+column being ignored.
+
+Example:
+$ %s tx %s distribution-tree recipients.csv
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			tree, err := buildDistributionTree(f)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(tree, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(string(bz) + "\n")
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitTokenFeeOverrideProposal implements the command to submit a token fee override proposal
+func GetCmdSubmitTokenFeeOverrideProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-fee-override [symbol] [issue-fee] [mint-fee]",
+		Args:  cobra.RangeArgs(1, 3),
+		Short: "Submit a proposal overriding or waiving the issue and mint fees of a symbol",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal setting the issue and mint fees of a symbol in place of the
+fee curve along with an initial deposit, zero fees waiving them. With --remove, the
+fees are omitted and the override of the symbol is removed.
+
+Example:
+$ %s tx gov submit-proposal token-fee-override btc 0ugauss 0ugauss --title="Waive BTC fees" --description="Bridged token" --deposit=1000ugauss --from=my_key
+$ %s tx gov submit-proposal token-fee-override btc --remove --title="Restore BTC fees" --description="..." --deposit=1000ugauss --from=my_key
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			remove, err := cmd.Flags().GetBool(FlagRemove)
+			if err != nil {
+				return err
+			}
+
+			var issueFee, mintFee sdk.Coin
+			switch {
+			case remove && len(args) != 1:
+				return fmt.Errorf("the fees must be omitted with --%s", FlagRemove)
+			case !remove && len(args) != 3:
+				return fmt.Errorf("both the issue fee and the mint fee are required")
+			case !remove:
+				if issueFee, err = sdk.ParseCoinNormalized(args[1]); err != nil {
+					return err
+				}
+				if mintFee, err = sdk.ParseCoinNormalized(args[2]); err != nil {
+					return err
+				}
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewTokenFeeOverrideProposal(title, description, args[0], issueFee, mintFee, remove)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(FlagRemove, false, "remove the fee override of the symbol")
+
+	return cmd
+}
+
+// GetCmdSubmitSymbolReservationProposal implements the command to submit a symbol reservation proposal
+func GetCmdSubmitSymbolReservationProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "symbol-reservation [symbol] [address]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Submit a proposal reserving a symbol for an address or releasing its reservation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal reserving a symbol not issued yet along with an initial deposit,
+only the reserved address being allowed to issue it. With --remove, the address is
+omitted and the reservation of the symbol is released.
+
+Example:
+$ %s tx gov submit-proposal symbol-reservation btc gauss1... --title="Reserve BTC" --description="Exchange ticker" --deposit=1000ugauss --from=my_key
+$ %s tx gov submit-proposal symbol-reservation btc --remove --title="Release BTC" --description="..." --deposit=1000ugauss --from=my_key
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			remove, err := cmd.Flags().GetBool(FlagRemove)
+			if err != nil {
+				return err
+			}
+
+			var address string
+			switch {
+			case remove && len(args) != 1:
+				return fmt.Errorf("the address must be omitted with --%s", FlagRemove)
+			case !remove && len(args) != 2:
+				return fmt.Errorf("the reserved address is required")
+			case !remove:
+				address = args[1]
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSymbolReservationProposal(title, description, args[0], address, remove)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(FlagRemove, false, "release the reservation of the symbol")
+
+	return cmd
+}
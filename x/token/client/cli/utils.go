@@ -2,8 +2,15 @@ package cli
 
 import (
 	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/gauss/gauss/v4/x/token/types"
 )
@@ -19,3 +26,80 @@ func queryTokenFees(cliCtx client.Context, symbol string) (types.QueryFeesRespon
 
 	return *resp, err
 }
+
+// distributionTree defines the merkle root of a token distribution with the proofs of its recipients
+type distributionTree struct {
+	MerkleRoot string              `json:"merkle_root"`
+	Amount     uint64              `json:"amount"`
+	Claims     []distributionClaim `json:"claims"`
+}
+
+// distributionClaim defines the amount allotted to a recipient of a token distribution with its proof
+type distributionClaim struct {
+	Address string   `json:"address"`
+	Amount  uint64   `json:"amount"`
+	Proof   []string `json:"proof"`
+}
+
+// buildDistributionTree reads the "address,amount" CSV records, skipping a header
+// and any extra column, and builds the merkle tree of the token distribution
+func buildDistributionTree(in io.Reader) (distributionTree, error) {
+	r := csv.NewReader(in)
+	r.FieldsPerRecord = -1
+
+	var (
+		tree   distributionTree
+		leaves [][]byte
+		seen   = make(map[string]bool)
+	)
+	for line := 1; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return tree, err
+		}
+		if len(record) < 2 {
+			return tree, fmt.Errorf("line %d: expected address and amount", line)
+		}
+		if line == 1 && record[0] == "address" {
+			continue
+		}
+
+		addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(record[0]))
+		if err != nil {
+			return tree, fmt.Errorf("line %d: invalid address: %w", line, err)
+		}
+		if seen[addr.String()] {
+			return tree, fmt.Errorf("line %d: duplicate address %s", line, addr)
+		}
+		seen[addr.String()] = true
+
+		amount, err := strconv.ParseUint(strings.TrimSpace(record[1]), 10, 64)
+		if err != nil || amount == 0 {
+			return tree, fmt.Errorf("line %d: invalid amount %s", line, record[1])
+		}
+		if tree.Amount+amount < tree.Amount {
+			return tree, fmt.Errorf("line %d: total amount overflows", line)
+		}
+		tree.Amount += amount
+
+		tree.Claims = append(tree.Claims, distributionClaim{Address: addr.String(), Amount: amount})
+		leaves = append(leaves, types.DistributionLeaf(addr, amount))
+	}
+	if len(leaves) == 0 {
+		return tree, fmt.Errorf("no recipient found")
+	}
+
+	tree.MerkleRoot = hex.EncodeToString(types.DistributionMerkleRoot(leaves))
+	for i := range tree.Claims {
+		proof := types.DistributionMerkleProof(leaves, i)
+		tree.Claims[i].Proof = make([]string, len(proof))
+		for j, node := range proof {
+			tree.Claims[i].Proof[j] = hex.EncodeToString(node)
+		}
+	}
+
+	return tree, nil
+}
@@ -0,0 +1,16 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/gauss/gauss/v4/x/token/client/cli"
+	"github.com/gauss/gauss/v4/x/token/client/rest"
+)
+
+// ProposalHandler is the token fee override proposal handler
+var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitTokenFeeOverrideProposal, rest.ProposalRESTHandler)
+
+// SymbolReservationProposalHandler is the symbol reservation proposal handler
+var SymbolReservationProposalHandler = govclient.NewProposalHandler(
+	cli.GetCmdSubmitSymbolReservationProposal, rest.SymbolReservationProposalRESTHandler,
+)
@@ -0,0 +1,109 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+// TokenFeeOverrideProposalReq defines a token fee override proposal request body
+type TokenFeeOverrideProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Symbol      string         `json:"symbol" yaml:"symbol"`
+	IssueFee    sdk.Coin       `json:"issue_fee" yaml:"issue_fee"`
+	MintFee     sdk.Coin       `json:"mint_fee" yaml:"mint_fee"`
+	Remove      bool           `json:"remove" yaml:"remove"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// SymbolReservationProposalReq defines a symbol reservation proposal request body
+type SymbolReservationProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Symbol      string         `json:"symbol" yaml:"symbol"`
+	Address     string         `json:"address" yaml:"address"`
+	Remove      bool           `json:"remove" yaml:"remove"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the token fee override REST handler
+func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "token_fee_override",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req TokenFeeOverrideProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewTokenFeeOverrideProposal(req.Title, req.Description, req.Symbol, req.IssueFee, req.MintFee, req.Remove)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// SymbolReservationProposalRESTHandler returns a ProposalRESTHandler that exposes the symbol reservation REST handler
+func SymbolReservationProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "symbol_reservation",
+		Handler:  postSymbolReservationProposalHandlerFn(clientCtx),
+	}
+}
+
+func postSymbolReservationProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SymbolReservationProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewSymbolReservationProposal(req.Title, req.Description, req.Symbol, req.Address, req.Remove)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
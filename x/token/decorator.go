@@ -3,28 +3,41 @@ package token
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
 
+	ammswaptypes "github.com/gauss/gauss/v4/x/ammswap/types"
+	defitypes "github.com/gauss/gauss/v4/x/defi/types"
+	orderbooktypes "github.com/gauss/gauss/v4/x/orderbook/types"
 	"github.com/gauss/gauss/v4/x/token/keeper"
 	"github.com/gauss/gauss/v4/x/token/types"
 )
 
-
-// ValidateTokenDecorator is responsible for restricting the token participation of the swap prefix
+// ValidateTokenDecorator is responsible for restricting the token participation of the swap prefix,
+// the burns to the accounts allowed to burn the token and the transfers of the locked tokens. A locked token can only leave the account of its owner,
+// whether it is sent to another account, over IBC or escrowed in a module account, so that no
+// module can pay it out to other accounts either. A paused token can neither be sent by anyone
+// nor be bought from the order book or the swap pools until it is unpaused, while the open
+// orders can still be revoked by their owners. The order book and the swap pools check the
+// resting orders and the liquidity withdrawals against the token keeper themselves. The
+// transfers are enforced by the RestrictedBankKeeper whichever message sends them, the
+// decorator only rejects the known messages early, before they reach the mempool.
 type ValidateTokenDecorator struct {
 	keeper keeper.Keeper
 }
 
 // NewValidateTokenDecorator returns an instance of ValidateTokenDecorator
 func NewValidateTokenDecorator(tk keeper.Keeper) ValidateTokenDecorator {
-	return ValidateTokenDecorator {
+	return ValidateTokenDecorator{
 		keeper: tk,
 	}
 }
 
 // AnteHandle checks the transaction
-func (vtd ValidateTokenDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, 
+func (vtd ValidateTokenDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx,
 	simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 
 	for _, msg := range tx.GetMsgs() {
@@ -34,9 +47,96 @@ func (vtd ValidateTokenDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx,
 				return ctx, sdkerrors.Wrap(
 					sdkerrors.ErrInvalidRequest, "burn failed")
 			}
+			sender, err := sdk.AccAddressFromBech32(msg.Sender)
+			if err != nil {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+			if err := vtd.keeper.ValidateBurn(ctx, msg.Symbol, sender); err != nil {
+				return ctx, err
+			}
+		case *types.MsgCreateTokenVesting:
+			if err := vtd.validateTransfer(ctx, msg.Sender, sdk.NewCoins(msg.Amount)); err != nil {
+				return ctx, err
+			}
+		case *types.MsgDistributeToken:
+			// the minted distributions do not leave the balance of the owner
+			if token, err := vtd.keeper.GetToken(ctx, msg.Symbol); err == nil && !msg.Mint {
+				coins := sdk.NewCoins(sdk.NewCoin(token.GetSmallestUnit(), sdk.NewIntFromUint64(msg.Amount)))
+				if err := vtd.validateTransfer(ctx, msg.Owner, coins); err != nil {
+					return ctx, err
+				}
+			}
+		case *banktypes.MsgSend:
+			if err := vtd.validateTransfer(ctx, msg.FromAddress, msg.Amount); err != nil {
+				return ctx, err
+			}
+		case *banktypes.MsgMultiSend:
+			for _, input := range msg.Inputs {
+				if err := vtd.validateTransfer(ctx, input.Address, input.Coins); err != nil {
+					return ctx, err
+				}
+			}
+		case *vestingtypes.MsgCreateVestingAccount:
+			if err := vtd.validateTransfer(ctx, msg.FromAddress, msg.Amount); err != nil {
+				return ctx, err
+			}
 		case *ibctransfertypes.MsgTransfer:
+			if err := vtd.validateTransfer(ctx, msg.Sender, sdk.NewCoins(msg.Token)); err != nil {
+				return ctx, err
+			}
 		case *govtypes.MsgSubmitProposal:
+			if err := vtd.validateTransfer(ctx, msg.Proposer, msg.InitialDeposit); err != nil {
+				return ctx, err
+			}
 		case *govtypes.MsgDeposit:
+			if err := vtd.validateTransfer(ctx, msg.Depositor, msg.Amount); err != nil {
+				return ctx, err
+			}
+		case *distrtypes.MsgFundCommunityPool:
+			if err := vtd.validateTransfer(ctx, msg.Depositor, msg.Amount); err != nil {
+				return ctx, err
+			}
+		case *defitypes.MsgFundDefiCommunityPool:
+			if err := vtd.validateTransfer(ctx, msg.Depositor, msg.Amount); err != nil {
+				return ctx, err
+			}
+		case *orderbooktypes.MsgPlaceOrder:
+			if err := vtd.validateTransfer(ctx, msg.OwnerAddress, sdk.NewCoins(msg.MyAsset)); err != nil {
+				return ctx, err
+			}
+			if err := vtd.keeper.ValidateNotPaused(ctx, msg.ExpectAsset.Denom); err != nil {
+				return ctx, err
+			}
+		case *orderbooktypes.MsgAgreeOrderPair:
+			if err := vtd.validateTxPair(ctx, msg.TxPair); err != nil {
+				return ctx, err
+			}
+		case *orderbooktypes.MsgAgreeOrderPairWithAmount:
+			if err := vtd.validateTxPair(ctx, msg.TxPair); err != nil {
+				return ctx, err
+			}
+		case *ammswaptypes.MsgCreatePool:
+			if err := vtd.validateTransfer(ctx, msg.Creator, sdk.NewCoins(msg.TokenA, msg.TokenB)); err != nil {
+				return ctx, err
+			}
+		case *ammswaptypes.MsgAddLiquidity:
+			if err := vtd.validateTransfer(ctx, msg.Sender, msg.MaxTokens); err != nil {
+				return ctx, err
+			}
+		case *ammswaptypes.MsgSwapExactIn:
+			if err := vtd.validateTransfer(ctx, msg.Sender, sdk.NewCoins(msg.TokenIn)); err != nil {
+				return ctx, err
+			}
+			if err := vtd.keeper.ValidateNotPaused(ctx, msg.MinTokenOut.Denom); err != nil {
+				return ctx, err
+			}
+		case *ammswaptypes.MsgSwapExactOut:
+			if err := vtd.validateTransfer(ctx, msg.Sender, sdk.NewCoins(msg.MaxTokenIn)); err != nil {
+				return ctx, err
+			}
+			if err := vtd.keeper.ValidateNotPaused(ctx, msg.TokenOut.Denom); err != nil {
+				return ctx, err
+			}
 		default:
 			break
 		}
@@ -44,3 +144,23 @@ func (vtd ValidateTokenDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx,
 
 	return next(ctx, tx, simulate)
 }
+
+// validateTransfer checks the coins sent by the given sender against the locked tokens
+func (vtd ValidateTokenDecorator) validateTransfer(ctx sdk.Context, sender string, coins sdk.Coins) error {
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	return vtd.keeper.ValidateTransfer(ctx, senderAddr, coins)
+}
+
+// validateTxPair checks that neither denom of the order book tx-pair is paused
+func (vtd ValidateTokenDecorator) validateTxPair(ctx sdk.Context, txPair string) error {
+	baseDenom, quoteDenom, err := orderbooktypes.ParseTxPair(txPair)
+	if err != nil {
+		return err
+	}
+
+	return vtd.keeper.ValidateNotPaused(ctx, baseDenom, quoteDenom)
+}
@@ -39,6 +39,46 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgTransferTokenOwner:
 			res, err := msgServer.TransferTokenOwner(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgGrantTokenRole:
+			res, err := msgServer.GrantTokenRole(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRevokeTokenRole:
+			res, err := msgServer.RevokeTokenRole(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgPauseToken:
+			res, err := msgServer.PauseToken(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgUnpauseToken:
+			res, err := msgServer.UnpauseToken(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgFreezeAccount:
+			res, err := msgServer.FreezeAccount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgUnfreezeAccount:
+			res, err := msgServer.UnfreezeAccount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCreateTokenVesting:
+			res, err := msgServer.CreateTokenVesting(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgTakeTokenSnapshot:
+			res, err := msgServer.TakeTokenSnapshot(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgDistributeToken:
+			res, err := msgServer.DistributeToken(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgClaimDistribution:
+			res, err := msgServer.ClaimDistribution(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		}
 
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized token message type: %T", msg)
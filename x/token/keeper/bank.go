@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ bankkeeper.Keeper = RestrictedBankKeeper{}
+
+// RestrictedBankKeeper wraps the bank keeper to check the coins sent out of an
+// account against the locked and paused tokens and the frozen accounts, so that
+// they are enforced whichever message or module moves the coins. The coins sent
+// out of the module accounts are not checked, so that the escrowed coins can
+// still be refunded. The token keeper itself is given the unwrapped bank keeper.
+type RestrictedBankKeeper struct {
+	bankkeeper.Keeper
+
+	tokenKeeper Keeper
+}
+
+// NewRestrictedBankKeeper returns a bank keeper restricting the transfers of the tokens
+func NewRestrictedBankKeeper(bk bankkeeper.Keeper, tk Keeper) RestrictedBankKeeper {
+	return RestrictedBankKeeper{
+		Keeper:      bk,
+		tokenKeeper: tk,
+	}
+}
+
+// SendCoins checks the coins sent by the sender before transferring them
+func (k RestrictedBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.tokenKeeper.ValidateTransfer(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+
+	return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins checks the coins of each input before transferring them
+func (k RestrictedBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	for _, input := range inputs {
+		fromAddr, err := sdk.AccAddressFromBech32(input.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.tokenKeeper.ValidateTransfer(ctx, fromAddr, input.Coins); err != nil {
+			return err
+		}
+	}
+
+	return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+// SendCoinsFromAccountToModule checks the coins sent by the sender before
+// escrowing them in the module account
+func (k RestrictedBankKeeper) SendCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+	if err := k.tokenKeeper.ValidateTransfer(ctx, senderAddr, amt); err != nil {
+		return err
+	}
+
+	return k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+// DelegateCoinsFromAccountToModule checks the coins delegated by the sender
+// before delegating them to the module account
+func (k RestrictedBankKeeper) DelegateCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+	if err := k.tokenKeeper.ValidateTransfer(ctx, senderAddr, amt); err != nil {
+		return err
+	}
+
+	return k.Keeper.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+// DelegateCoins checks the coins delegated by the delegator before delegating
+// them to the module account
+func (k RestrictedBankKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.tokenKeeper.ValidateTransfer(ctx, delegatorAddr, amt); err != nil {
+		return err
+	}
+
+	return k.Keeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt)
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+func TestRestrictedBankKeeper(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	owner := sdk.AccAddress(tmhash.SumTruncated([]byte("addrOne")))
+	holder := sdk.AccAddress(tmhash.SumTruncated([]byte("addrTwo")))
+
+	err := app.TokenKeeper.IssueToken(ctx, "Bitcoin Network", "btc", "satoshi", 8, 1000, 2000, true, false, false, false, owner)
+	require.NoError(t, err)
+	err = app.TokenKeeper.IssueToken(ctx, "USD Coin", "usdc", "uusdc", 6, 1000, 2000, true, true, false, true, owner)
+	require.NoError(t, err)
+
+	satoshi := sdk.NewCoins(sdk.NewInt64Coin("satoshi", 10))
+	uusdc := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 10))
+
+	// the owner sends the locked token, its holders cannot whichever way they send it
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, owner, holder, satoshi))
+	err = app.BankKeeper.SendCoins(ctx, holder, owner, satoshi)
+	require.ErrorIs(t, err, types.ErrTokenLocked)
+	err = app.BankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{banktypes.NewInput(holder, satoshi)}, []banktypes.Output{banktypes.NewOutput(owner, satoshi)})
+	require.ErrorIs(t, err, types.ErrTokenLocked)
+	err = app.BankKeeper.SendCoinsFromAccountToModule(ctx, holder, authtypes.FeeCollectorName, satoshi)
+	require.ErrorIs(t, err, types.ErrTokenLocked)
+
+	// a frozen account cannot send the token, while it is refunded from a module account
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, owner, holder, uusdc.Add(uusdc...)))
+	require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, holder, authtypes.FeeCollectorName, uusdc))
+	require.NoError(t, app.TokenKeeper.FreezeAccount(ctx, "usdc", holder, owner))
+	err = app.BankKeeper.SendCoins(ctx, holder, owner, uusdc)
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+	err = app.BankKeeper.DelegateCoinsFromAccountToModule(ctx, holder, authtypes.FeeCollectorName, uusdc)
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, holder, uusdc))
+
+	// a paused token cannot be sent by anyone
+	require.NoError(t, app.TokenKeeper.UnfreezeAccount(ctx, "usdc", holder, owner))
+	require.NoError(t, app.TokenKeeper.PauseToken(ctx, "usdc", owner))
+	err = app.BankKeeper.SendCoins(ctx, owner, holder, uusdc)
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+	require.NoError(t, app.TokenKeeper.UnpauseToken(ctx, "usdc", owner))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, holder, owner, uusdc))
+	require.Equal(t, sdk.NewInt(10), app.BankKeeper.GetBalance(ctx, holder, "uusdc").Amount)
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+func TestHolderBurn(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	owner := sdk.AccAddress(tmhash.SumTruncated([]byte("addrOne")))
+	holder := sdk.AccAddress(tmhash.SumTruncated([]byte("addrTwo")))
+
+	require.NoError(t, app.TokenKeeper.IssueToken(ctx, "Bitcoin Network", "btc", "satoshi", 8, 1000, 2000, true, true, false, false, owner))
+	require.NoError(t, app.TokenKeeper.IssueToken(ctx, "Ether", "eth", "wei", 18, 1000, 2000, true, true, true, false, owner))
+	require.NoError(t, app.TokenKeeper.MintToken(ctx, "btc", 100, holder, owner))
+	require.NoError(t, app.TokenKeeper.MintToken(ctx, "eth", 100, holder, owner))
+
+	// only the owner burns a token which is not holder burnable
+	err := app.TokenKeeper.BurnToken(ctx, "btc", 10, holder)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, app.TokenKeeper.ValidateBurn(ctx, "btc", holder), sdkerrors.ErrUnauthorized)
+	require.NoError(t, app.TokenKeeper.BurnToken(ctx, "btc", 10, owner))
+
+	require.NoError(t, app.TokenKeeper.ValidateBurn(ctx, "eth", holder))
+	require.NoError(t, app.TokenKeeper.BurnToken(ctx, "eth", 30, holder))
+	require.NoError(t, app.TokenKeeper.BurnToken(ctx, "eth", 20, owner))
+	require.Equal(t, int64(70), app.BankKeeper.GetBalance(ctx, holder, "wei").Amount.Int64())
+
+	// the burns of the holders are tracked apart from the owner's ones
+	res, err := app.TokenKeeper.Burntoken(sdk.WrapSDKContext(ctx), &types.QueryBurntokenRequest{Symbol: "eth"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("wei", 50), res.BurnedCoin)
+	require.Equal(t, sdk.NewInt64Coin("wei", 20), res.OwnerBurnedCoin)
+	require.Equal(t, sdk.NewInt64Coin("wei", 30), res.HolderBurnedCoin)
+
+	res, err = app.TokenKeeper.Burntoken(sdk.WrapSDKContext(ctx), &types.QueryBurntokenRequest{Symbol: "btc"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("satoshi", 10), res.OwnerBurnedCoin)
+	require.Equal(t, sdk.NewInt64Coin("satoshi", 0), res.HolderBurnedCoin)
+
+	// the burnt coins still count against the total supply
+	err = app.TokenKeeper.MintToken(ctx, "eth", 901, holder, owner)
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+	require.NoError(t, app.TokenKeeper.MintToken(ctx, "eth", 900, holder, owner))
+
+	gs := app.TokenKeeper.ExportGenesis(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("wei", 30)), sdk.NewCoins(gs.HolderBurnedCoins...))
+	require.NoError(t, gs.Validate())
+}
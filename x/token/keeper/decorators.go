@@ -22,7 +22,7 @@ func NewValidateTokenDecorator(k Keeper, bk types.BankKeeper) ValidateTokenDecor
 // AnteHandle returns an AnteHandler that checks if the balance of
 // the fee payer is sufficient for token related fee
 func (dtf ValidateTokenDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	feesMap := make(map[string]sdk.Coin)
+	feesMap := make(map[string]sdk.Coins)
 
 	for _, msg := range tx.GetMsgs() {
 		switch msg := msg.(type) {
@@ -32,32 +32,27 @@ func (dtf ValidateTokenDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 				return ctx, sdkerrors.Wrap(types.ErrInvalidIssueFee, err.Error())
 			}
 			
-			if fees, ok := feesMap[msg.Owner]; ok {
-				feesMap[msg.Owner] = fees.Add(issueFee)
-			} else {
-				feesMap[msg.Owner] = issueFee
-			}
+			// the fees overridden by governance may be of another denom
+			feesMap[msg.Owner] = feesMap[msg.Owner].Add(issueFee)
 		case *types.MsgMintToken:
 			mintFee, err := dtf.k.GetMintTokenFee(ctx, msg.Symbol)
 			if err != nil {
 				return ctx, sdkerrors.Wrap(types.ErrInvalidIssueFee, err.Error())
 			}
 			
-			if fees, ok := feesMap[msg.Owner]; ok {
-				feesMap[msg.Owner] = fees.Add(mintFee)
-			} else {
-				feesMap[msg.Owner] = mintFee
-			}
+			feesMap[msg.Owner] = feesMap[msg.Owner].Add(mintFee)
 		}
 	}
 
 	for addr, fees := range feesMap {
 		owner, _ := sdk.AccAddressFromBech32(addr)
-		balance := dtf.bk.GetBalance(ctx, owner, fees.Denom)
-		if balance.IsLT(fees) {
-			return ctx, sdkerrors.Wrapf(
-				sdkerrors.ErrInsufficientFunds, "insufficient fees: balance[%s] < fees[%s]", balance, fees,
-			)
+		for _, fee := range fees {
+			balance := dtf.bk.GetBalance(ctx, owner, fee.Denom)
+			if balance.IsLT(fee) {
+				return ctx, sdkerrors.Wrapf(
+					sdkerrors.ErrInsufficientFunds, "insufficient fees: balance[%s] < fees[%s]", balance, fee,
+				)
+			}
 		}
 	}
 
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/token/keeper"
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+func TestTokenDistribution(t *testing.T) {
+	app := simapp.Setup(false)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: start})
+
+	owner := sdk.AccAddress(tmhash.SumTruncated([]byte("addrOne")))
+	alice := sdk.AccAddress(tmhash.SumTruncated([]byte("addrTwo")))
+	bob := sdk.AccAddress(tmhash.SumTruncated([]byte("addrThree")))
+	carol := sdk.AccAddress(tmhash.SumTruncated([]byte("addrFour")))
+
+	err := app.TokenKeeper.IssueToken(ctx, "Bitcoin Network", "btc", "satoshi", 8, 1000, 2000, true, true, false, false, owner)
+	require.NoError(t, err)
+
+	recipients := []sdk.AccAddress{alice, bob, carol}
+	amounts := []uint64{100, 200, 300}
+	leaves := make([][]byte, len(recipients))
+	for i, recipient := range recipients {
+		leaves[i] = types.DistributionLeaf(recipient, amounts[i])
+	}
+	root := hex.EncodeToString(types.DistributionMerkleRoot(leaves))
+	proof := func(i int) []string {
+		var nodes []string
+		for _, node := range types.DistributionMerkleProof(leaves, i) {
+			nodes = append(nodes, hex.EncodeToString(node))
+		}
+		return nodes
+	}
+	end := start.Add(time.Hour)
+
+	// only the owner can distribute the amounts it holds
+	_, err = app.TokenKeeper.DistributeToken(ctx, "btc", alice, root, 600, end, false)
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+	_, err = app.TokenKeeper.DistributeToken(ctx, "btc", owner, root, 2000, end, false)
+	require.Error(t, err)
+	_, err = app.TokenKeeper.DistributeToken(ctx, "btc", owner, root, 600, start, false)
+	require.ErrorIs(t, err, types.ErrInvalidDistribution)
+
+	id, err := app.TokenKeeper.DistributeToken(ctx, "btc", owner, root, 600, end, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id)
+	require.Equal(t, sdk.NewInt(400), app.BankKeeper.GetBalance(ctx, owner, "satoshi").Amount)
+
+	// the minted distributions are limited by the mintable headroom
+	_, err = app.TokenKeeper.DistributeToken(ctx, "btc", owner, root, 1001, end, true)
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+	minted, err := app.TokenKeeper.DistributeToken(ctx, "btc", owner, root, 600, end.Add(time.Hour), true)
+	require.NoError(t, err)
+
+	invariant := keeper.ModuleAccountInvariant(app.TokenKeeper, app.BankKeeper)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// the recipients claim the amounts proven by the merkle root once
+	_, err = app.TokenKeeper.ClaimDistribution(ctx, id, alice, 200, proof(0))
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof)
+	_, err = app.TokenKeeper.ClaimDistribution(ctx, id, bob, 100, proof(0))
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof)
+
+	claimed, err := app.TokenKeeper.ClaimDistribution(ctx, id, alice, 100, proof(0))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("satoshi", 100), claimed)
+	_, err = app.TokenKeeper.ClaimDistribution(ctx, id, alice, 100, proof(0))
+	require.ErrorIs(t, err, types.ErrAlreadyClaimed)
+
+	_, err = app.TokenKeeper.ClaimDistribution(ctx, minted, carol, 300, proof(2))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(300), app.BankKeeper.GetBalance(ctx, carol, "satoshi").Amount)
+
+	// the claims are paused with the token
+	require.NoError(t, app.TokenKeeper.PauseToken(ctx, "btc", owner))
+	_, err = app.TokenKeeper.ClaimDistribution(ctx, id, bob, 200, proof(1))
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+	require.NoError(t, app.TokenKeeper.UnpauseToken(ctx, "btc", owner))
+
+	res, err := app.TokenKeeper.ClaimStatus(sdk.WrapSDKContext(ctx), &types.QueryClaimStatusRequest{Id: id, Address: alice.String()})
+	require.NoError(t, err)
+	require.True(t, res.Claimed)
+	require.Equal(t, sdk.NewInt64Coin("satoshi", 100), res.Amount)
+	require.False(t, res.Expired)
+
+	res, err = app.TokenKeeper.ClaimStatus(sdk.WrapSDKContext(ctx), &types.QueryClaimStatusRequest{Id: id, Address: bob.String()})
+	require.NoError(t, err)
+	require.False(t, res.Claimed)
+
+	distributions, err := app.TokenKeeper.Distributions(sdk.WrapSDKContext(ctx), &types.QueryDistributionsRequest{Symbol: "btc"})
+	require.NoError(t, err)
+	require.Len(t, distributions.Distributions, 2)
+
+	gs := app.TokenKeeper.ExportGenesis(ctx)
+	require.Len(t, gs.TokenDistributions, 2)
+	require.Len(t, gs.DistributionClaims, 2)
+	require.Equal(t, uint64(3), gs.NextDistributionId)
+	require.NoError(t, gs.Validate())
+
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// the unclaimed amount is clawed back to the owner once the distribution ended
+	ctx = ctx.WithBlockTime(end)
+	_, err = app.TokenKeeper.ClaimDistribution(ctx, id, bob, 200, proof(1))
+	require.ErrorIs(t, err, types.ErrDistributionEnded)
+
+	app.TokenKeeper.ClawbackEndedDistributions(ctx)
+	require.Equal(t, sdk.NewInt(900), app.BankKeeper.GetBalance(ctx, owner, "satoshi").Amount)
+	_, found := app.TokenKeeper.GetTokenDistribution(ctx, id)
+	require.False(t, found)
+	_, found = app.TokenKeeper.GetDistributionClaim(ctx, id, alice)
+	require.False(t, found)
+	_, found = app.TokenKeeper.GetTokenDistribution(ctx, minted)
+	require.True(t, found)
+
+	// only the ended distributions are dequeued
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	require.False(t, store.Has(types.GetDistributionQueueKey(end, id)))
+	require.True(t, store.Has(types.GetDistributionQueueKey(end.Add(time.Hour), minted)))
+
+	ctx = ctx.WithBlockTime(end.Add(time.Hour))
+	app.TokenKeeper.ClawbackEndedDistributions(ctx)
+	_, found = app.TokenKeeper.GetTokenDistribution(ctx, minted)
+	require.False(t, found)
+	iter := sdk.KVStorePrefixIterator(store, types.DistributionQueuePrefix)
+	require.False(t, iter.Valid())
+	iter.Close()
+
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
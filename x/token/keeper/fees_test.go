@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+func TestTokenFeeCurve(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := types.DefaultParams()
+	params.IssueFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	params.PremiumSymbolLen = 0
+	app.TokenKeeper.SetParams(ctx, params)
+
+	// the factor of a symbol as long as the base is 1
+	fee, err := app.TokenKeeper.GetIssueTokenFee(ctx, "btc")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), fee)
+
+	// the longer the symbol, the lower the fee
+	longFee, err := app.TokenKeeper.GetIssueTokenFee(ctx, "bitcoin")
+	require.NoError(t, err)
+	require.True(t, longFee.IsLT(fee))
+
+	// a flat curve charges the issue fee whatever the symbol
+	params.FactorExp = 0
+	app.TokenKeeper.SetParams(ctx, params)
+	longFee, err = app.TokenKeeper.GetIssueTokenFee(ctx, "bitcoin")
+	require.NoError(t, err)
+	require.Equal(t, fee, longFee)
+
+	// the fee given by the curve is never below the minimum
+	params.FactorExp = types.DefaultFactorExp
+	params.MinIssueFee = sdk.NewInt(500)
+	app.TokenKeeper.SetParams(ctx, params)
+	longFee, err = app.TokenKeeper.GetIssueTokenFee(ctx, "bitcoin")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 500), longFee)
+
+	// the short symbols are charged the premium fee, not their mint fee
+	mintFee, err := app.TokenKeeper.GetMintTokenFee(ctx, "btc")
+	require.NoError(t, err)
+	params.PremiumSymbolLen = 3
+	params.PremiumFeeMultiplier = sdk.NewDecWithPrec(25, 1)
+	app.TokenKeeper.SetParams(ctx, params)
+	premiumFee, err := app.TokenKeeper.GetIssueTokenFee(ctx, "btc")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 2500), premiumFee)
+	premiumFee, err = app.TokenKeeper.GetMintTokenFee(ctx, "btc")
+	require.NoError(t, err)
+	require.Equal(t, mintFee, premiumFee)
+	longFee, err = app.TokenKeeper.GetIssueTokenFee(ctx, "bitcoin")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 500), longFee)
+
+	params.PremiumFeeMultiplier = sdk.NewDecWithPrec(5, 1)
+	require.Error(t, params.Validate())
+
+	params.FactorBase = 1
+	require.Error(t, params.Validate())
+
+	// a base and exponent rounding the factor of the shortest symbols to
+	// zero are rejected, and fail the fee instead of dividing by zero
+	params = types.DefaultParams()
+	params.FactorBase = 100
+	require.Error(t, params.Validate())
+	params.FactorBase = 10
+	params.FactorExp = types.MaximumFactorExp
+	require.Error(t, params.Validate())
+	app.TokenKeeper.SetParams(ctx, params)
+	_, err = app.TokenKeeper.GetIssueTokenFee(ctx, "btc")
+	require.ErrorIs(t, err, types.ErrInvalidFeeFactor)
+	_, err = app.TokenKeeper.GetMintTokenFee(ctx, "btc")
+	require.ErrorIs(t, err, types.ErrInvalidFeeFactor)
+}
+
+func TestTokenFeeOverrideProposal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	owner := sdk.AccAddress(tmhash.SumTruncated([]byte("addrOne")))
+	zero := sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
+
+	// the fees of a symbol not issued yet can be waived
+	proposal := types.NewTokenFeeOverrideProposal("title", "description", "btc", zero, zero, false)
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, app.TokenKeeper.HandleTokenFeeOverrideProposal(ctx, proposal))
+
+	res, err := app.TokenKeeper.Fees(sdk.WrapSDKContext(ctx), &types.QueryFeesRequest{Symbol: "btc"})
+	require.NoError(t, err)
+	require.True(t, res.Overridden)
+	require.True(t, res.IssueFee.IsZero())
+	require.True(t, res.MintFee.IsZero())
+
+	// the waived fees are not charged
+	require.NoError(t, app.TokenKeeper.DeductIssueTokenFee(ctx, owner, "btc"))
+	require.NoError(t, app.TokenKeeper.DeductMintTokenFee(ctx, owner, "btc"))
+
+	// the fees can be overridden in another denom
+	issueFee, mintFee := sdk.NewInt64Coin("satoshi", 10), sdk.NewInt64Coin("satoshi", 2)
+	proposal = types.NewTokenFeeOverrideProposal("title", "description", "btc", issueFee, mintFee, false)
+	require.NoError(t, app.TokenKeeper.HandleTokenFeeOverrideProposal(ctx, proposal))
+	fee, err := app.TokenKeeper.GetMintTokenFee(ctx, "btc")
+	require.NoError(t, err)
+	require.Equal(t, mintFee, fee)
+
+	gs := app.TokenKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.TokenFeeOverride{{Symbol: "btc", IssueFee: issueFee, MintFee: mintFee}}, gs.FeeOverrides)
+	require.NoError(t, gs.Validate())
+
+	// removing the override restores the fee curve
+	proposal = types.NewTokenFeeOverrideProposal("title", "description", "btc", sdk.Coin{}, sdk.Coin{}, true)
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, app.TokenKeeper.HandleTokenFeeOverrideProposal(ctx, proposal))
+	err = app.TokenKeeper.HandleTokenFeeOverrideProposal(ctx, proposal)
+	require.ErrorIs(t, err, types.ErrFeeOverrideNotFound)
+
+	res, err = app.TokenKeeper.Fees(sdk.WrapSDKContext(ctx), &types.QueryFeesRequest{Symbol: "btc"})
+	require.NoError(t, err)
+	require.False(t, res.Overridden)
+	params := types.DefaultParams()
+	require.Equal(t, params.IssueFee.Amount.ToDec().Mul(params.PremiumFeeMultiplier).TruncateInt(), res.IssueFee.Amount)
+
+	// the fees must be valid unless the override is removed
+	proposal = types.NewTokenFeeOverrideProposal("title", "description", "btc", sdk.Coin{}, zero, false)
+	require.Error(t, proposal.ValidateBasic())
+}
@@ -24,6 +24,57 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) {
 	for _, coin := range gs.BurnedCoins {
 		k.AddBurnedCoin(ctx, coin)
 	}
+
+	for _, unit := range gs.LockedTokens {
+		k.unlockToken(ctx, unit, false)
+	}
+
+	for _, coin := range gs.HolderBurnedCoins {
+		k.storeHolderBurntCoin(ctx, coin)
+	}
+
+	for _, roles := range gs.TokenRoles {
+		k.storeTokenRoles(ctx, roles)
+	}
+
+	for _, unit := range gs.PausedTokens {
+		k.pauseToken(ctx, unit, true)
+	}
+
+	for _, account := range gs.FrozenAccounts {
+		addr, err := sdk.AccAddressFromBech32(account.Address)
+		if err != nil {
+			panic(err.Error())
+		}
+		k.freezeAccount(ctx, account.Denom, addr, true)
+	}
+
+	for _, vesting := range gs.TokenVestings {
+		k.storeTokenVesting(ctx, vesting)
+		k.insertVestingQueue(ctx, vesting.Id, vesting.NextReleaseTime(ctx.BlockTime()))
+	}
+	k.setNextVestingID(ctx, gs.NextVestingId)
+
+	for _, override := range gs.FeeOverrides {
+		k.storeTokenFeeOverride(ctx, override)
+	}
+
+	for _, reservation := range gs.SymbolReservations {
+		k.storeSymbolReservation(ctx, reservation)
+	}
+
+	for _, snapshot := range gs.TokenSnapshots {
+		k.storeTokenSnapshot(ctx, snapshot)
+	}
+	k.setNextSnapshotID(ctx, gs.NextSnapshotId)
+
+	for _, distribution := range gs.TokenDistributions {
+		k.storeTokenDistribution(ctx, distribution)
+	}
+	for _, claim := range gs.DistributionClaims {
+		k.storeDistributionClaim(ctx, claim)
+	}
+	k.setNextDistributionID(ctx, gs.NextDistributionId)
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -34,9 +85,71 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		tokens = append(tokens, *t)
 	}
 
+	var tokenRoles []types.TokenRoles
+	k.IterateTokenRoles(ctx, func(roles types.TokenRoles) bool {
+		tokenRoles = append(tokenRoles, roles)
+		return false
+	})
+
+	var frozenAccounts []types.FrozenAccount
+	k.IterateFrozenAccounts(ctx, func(denom string, addr sdk.AccAddress) bool {
+		frozenAccounts = append(frozenAccounts, types.FrozenAccount{Denom: denom, Address: addr.String()})
+		return false
+	})
+
+	var tokenVestings []types.TokenVesting
+	k.IterateTokenVestings(ctx, func(vesting types.TokenVesting) bool {
+		tokenVestings = append(tokenVestings, vesting)
+		return false
+	})
+
+	var feeOverrides []types.TokenFeeOverride
+	k.IterateTokenFeeOverrides(ctx, func(override types.TokenFeeOverride) bool {
+		feeOverrides = append(feeOverrides, override)
+		return false
+	})
+
+	var symbolReservations []types.SymbolReservation
+	k.IterateSymbolReservations(ctx, func(reservation types.SymbolReservation) bool {
+		symbolReservations = append(symbolReservations, reservation)
+		return false
+	})
+
+	var tokenSnapshots []types.TokenSnapshot
+	k.IterateTokenSnapshots(ctx, func(snapshot types.TokenSnapshot) bool {
+		tokenSnapshots = append(tokenSnapshots, snapshot)
+		return false
+	})
+
+	var tokenDistributions []types.TokenDistribution
+	k.IterateTokenDistributions(ctx, func(distribution types.TokenDistribution) bool {
+		tokenDistributions = append(tokenDistributions, distribution)
+		return false
+	})
+
+	var distributionClaims []types.DistributionClaim
+	k.IterateDistributionClaims(ctx, func(claim types.DistributionClaim) bool {
+		distributionClaims = append(distributionClaims, claim)
+		return false
+	})
+
 	return types.NewGenesisState(
 		k.GetParams(ctx),
 		tokens,
 		k.GetAllBurntCoins(ctx),
+		k.GetLockedTokens(ctx),
+		tokenRoles,
+		k.GetAllHolderBurntCoins(ctx),
+		k.GetPausedTokens(ctx),
+		frozenAccounts,
+		tokenVestings,
+		k.GetNextVestingID(ctx),
+		feeOverrides,
+		symbolReservations,
+		tokenSnapshots,
+		k.GetNextSnapshotID(ctx),
+		tokenDistributions,
+		distributionClaims,
+		k.GetNextDistributionID(ctx),
 	)
 }
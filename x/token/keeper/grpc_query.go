@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -12,7 +13,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-//	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/gauss/gauss/v4/x/token/types"
@@ -56,6 +57,7 @@ func (k BaseKeeper) Token(c context.Context, req *types.QueryTokenRequest) (*typ
 	return &types.QueryTokenResponse{
 		Token: any,
 		Unlocked: k.IsUnlocked(ctx, token.GetSmallestUnit()),
+		Paused: k.IsPaused(ctx, token.GetSmallestUnit()),
 		}, nil
 }
 
@@ -145,13 +147,23 @@ func (k BaseKeeper) Burntoken(c context.Context, req *types.QueryBurntokenReques
 	if !found {
 		return &types.QueryBurntokenResponse{
 			BurnedCoin: sdk.Coin{Denom:token.GetSmallestUnit(), Amount:sdk.ZeroInt()},
+			OwnerBurnedCoin: sdk.Coin{Denom:token.GetSmallestUnit(), Amount:sdk.ZeroInt()},
+			HolderBurnedCoin: sdk.Coin{Denom:token.GetSmallestUnit(), Amount:sdk.ZeroInt()},
 		}, nil
 		// return nil, sdkerrors.Wrapf(err, "failed to get burned coin of the token[%s].", req.Symbol)
 	}
 
+	// the owner part is what has not been burnt by the holders
+	holderBurntCoin, found := k.GetHolderBurntCoin(ctx, token.GetSmallestUnit())
+	if !found {
+		holderBurntCoin = sdk.NewCoin(token.GetSmallestUnit(), sdk.ZeroInt())
+	}
+
 	return &types.QueryBurntokenResponse{
 		Exist:      k.HasToken(ctx, req.Symbol),
 		BurnedCoin: burntCoin,
+		OwnerBurnedCoin: burntCoin.Sub(holderBurntCoin),
+		HolderBurnedCoin: holderBurntCoin,
 	}, nil
 }
 
@@ -176,9 +188,327 @@ func (k BaseKeeper) Fees(c context.Context, req *types.QueryFeesRequest) (*types
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	_, overridden := k.GetTokenFeeOverride(ctx, req.Symbol)
+
 	return &types.QueryFeesResponse{
-		Exist:    k.HasToken(ctx, req.Symbol),
-		IssueFee: issueFee,
-		MintFee:  mintFee,
+		Exist:      k.HasToken(ctx, req.Symbol),
+		IssueFee:   issueFee,
+		MintFee:    mintFee,
+		Overridden: overridden,
+	}, nil
+}
+
+func (k BaseKeeper) Roles(c context.Context, req *types.QueryRolesRequest) (*types.QueryRolesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s", req.Address)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if len(req.Symbol) > 0 {
+		if err := types.ValidateSymbol(req.Symbol); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+
+		roles := []types.TokenRoles{}
+		if tokenRoles, found := k.getTokenRoles(ctx, req.Symbol, addr); found {
+			roles = append(roles, tokenRoles)
+		}
+		return &types.QueryRolesResponse{Roles: roles}, nil
+	}
+
+	return &types.QueryRolesResponse{Roles: k.GetTokenRoles(ctx, addr)}, nil
+}
+
+func (k BaseKeeper) FrozenAccounts(c context.Context, req *types.QueryFrozenAccountsRequest) (*types.QueryFrozenAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateSymbol(req.Symbol); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	token, err := k.GetToken(ctx, req.Symbol)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Token %s do not found", req.Symbol)
+	}
+
+	var addresses []string
+	store := ctx.KVStore(k.storeKey)
+	frozenStore := prefix.NewStore(store, types.GetFrozenAccountsKey(token.GetSmallestUnit()))
+	pageRes, err := query.Paginate(frozenStore, req.Pagination, func(key []byte, _ []byte) error {
+		addresses = append(addresses, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryFrozenAccountsResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+func (k BaseKeeper) Vestings(c context.Context, req *types.QueryVestingsRequest) (*types.QueryVestingsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	beneficiary, err := sdk.AccAddressFromBech32(req.Beneficiary)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid beneficiary address (%s)", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var balances []types.VestingBalance
+	store := ctx.KVStore(k.storeKey)
+	vestingStore := prefix.NewStore(store, types.GetBeneficiaryVestingsKey(beneficiary))
+	pageRes, err := query.Paginate(vestingStore, req.Pagination, func(key []byte, _ []byte) error {
+		vesting, found := k.GetTokenVesting(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return sdkerrors.Wrapf(types.ErrVestingNotFound, "vesting %d", sdk.BigEndianToUint64(key))
+		}
+		balances = append(balances, types.VestingBalance{
+			Vesting:  vesting,
+			Vested:   vesting.VestedAmount(ctx.BlockTime()),
+			Unvested: vesting.UnvestedAmount(ctx.BlockTime()),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryVestingsResponse{Balances: balances, Pagination: pageRes}, nil
+}
+
+func (k BaseKeeper) SymbolAvailability(c context.Context, req *types.QuerySymbolAvailabilityRequest) (*types.QuerySymbolAvailabilityResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateSymbol(req.Symbol); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var owner sdk.AccAddress
+	if len(req.Owner) > 0 {
+		var err error
+		if owner, err = sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid owner address (%s)", err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	issueFee, err := k.GetIssueTokenFee(ctx, req.Symbol)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	issued := k.HasToken(ctx, req.Symbol)
+	reservation, _ := k.GetSymbolReservation(ctx, req.Symbol)
+	_, overridden := k.GetTokenFeeOverride(ctx, req.Symbol)
+
+	return &types.QuerySymbolAvailabilityResponse{
+		Available:   !issued && k.ValidateSymbolReservation(ctx, req.Symbol, owner) == nil,
+		Issued:      issued,
+		ReservedFor: reservation.Address,
+		Premium:     !overridden && k.GetParams(ctx).IsPremiumSymbol(req.Symbol),
+		Overridden:  overridden,
+		IssueFee:    issueFee,
 	}, nil
 }
+
+func (k BaseKeeper) Holders(c context.Context, req *types.QueryHoldersRequest) (*types.QueryHoldersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	token, err := k.getTokenBySymbol(ctx, req.Symbol)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Token %s do not found", req.Symbol)
+	}
+
+	holders, pageRes, err := k.paginateHolders(ctx, token.GetSmallestUnit(), req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryHoldersResponse{Holders: holders, Height: ctx.BlockHeight(), Pagination: pageRes}, nil
+}
+
+// paginateHolders paginates the accounts holding a positive balance of the denom by address
+// through the balances of the bank keeper, the balances of the other denoms being skipped as
+// the balances are not indexed by denom. The page key is the address of the next holder, and
+// the iteration stops at the end of the page unless the total is counted.
+func (k BaseKeeper) paginateHolders(
+	ctx sdk.Context, denom string, pageReq *query.PageRequest,
+) ([]types.TokenHolder, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	countTotal := pageReq.CountTotal && len(pageReq.Key) == 0
+
+	var (
+		holders []types.TokenHolder
+		nextKey []byte
+		count   uint64
+	)
+	k.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		if coin.Denom != denom || !coin.IsPositive() || bytes.Compare(addr, pageReq.Key) < 0 {
+			return false
+		}
+
+		count++
+		switch {
+		case count <= pageReq.Offset:
+		case uint64(len(holders)) < limit:
+			holders = append(holders, types.TokenHolder{Address: addr.String(), Balance: coin})
+		case nextKey == nil:
+			nextKey = addr
+			return !countTotal
+		}
+		return false
+	})
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+	return holders, pageRes, nil
+}
+
+func (k BaseKeeper) Snapshots(c context.Context, req *types.QuerySnapshotsRequest) (*types.QuerySnapshotsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateSymbol(req.Symbol); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var snapshots []types.TokenSnapshot
+	store := ctx.KVStore(k.storeKey)
+	snapshotStore := prefix.NewStore(store, types.GetSymbolSnapshotsKey(req.Symbol))
+	pageRes, err := query.Paginate(snapshotStore, req.Pagination, func(key []byte, _ []byte) error {
+		snapshot, found := k.GetTokenSnapshot(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return sdkerrors.Wrapf(types.ErrSnapshotNotFound, "snapshot %d", sdk.BigEndianToUint64(key))
+		}
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QuerySnapshotsResponse{Snapshots: snapshots, Pagination: pageRes}, nil
+}
+
+func (k BaseKeeper) Snapshot(c context.Context, req *types.QuerySnapshotRequest) (*types.QuerySnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	snapshot, found := k.GetTokenSnapshot(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "snapshot %d not found", req.Id)
+	}
+
+	return &types.QuerySnapshotResponse{Snapshot: snapshot}, nil
+}
+
+func (k BaseKeeper) Distributions(c context.Context, req *types.QueryDistributionsRequest) (*types.QueryDistributionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateSymbol(req.Symbol); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var distributions []types.TokenDistribution
+	store := ctx.KVStore(k.storeKey)
+	distributionStore := prefix.NewStore(store, types.GetSymbolDistributionsKey(req.Symbol))
+	pageRes, err := query.Paginate(distributionStore, req.Pagination, func(key []byte, _ []byte) error {
+		distribution, found := k.GetTokenDistribution(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return sdkerrors.Wrapf(types.ErrDistributionNotFound, "distribution %d", sdk.BigEndianToUint64(key))
+		}
+		distributions = append(distributions, distribution)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryDistributionsResponse{Distributions: distributions, Pagination: pageRes}, nil
+}
+
+func (k BaseKeeper) Distribution(c context.Context, req *types.QueryDistributionRequest) (*types.QueryDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	distribution, found := k.GetTokenDistribution(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "distribution %d not found", req.Id)
+	}
+
+	return &types.QueryDistributionResponse{Distribution: distribution}, nil
+}
+
+func (k BaseKeeper) ClaimStatus(c context.Context, req *types.QueryClaimStatusRequest) (*types.QueryClaimStatusResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address (%s)", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	distribution, found := k.GetTokenDistribution(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "distribution %d not found", req.Id)
+	}
+
+	res := &types.QueryClaimStatusResponse{
+		Amount:  sdk.NewCoin(distribution.Total.Denom, sdk.ZeroInt()),
+		Expired: distribution.IsEnded(ctx.BlockTime()),
+	}
+	if claim, found := k.GetDistributionClaim(ctx, req.Id, addr); found {
+		res.Claimed = true
+		res.Amount = claim.Amount
+	}
+
+	return res, nil
+}
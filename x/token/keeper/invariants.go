@@ -4,39 +4,170 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/gauss/gauss/v4/x/token/types"
 )
 
-// RegisterInvariants registers the bank module invariants
-func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
-	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding", NonnegativeBalanceInvariant(k))
-	ir.RegisterRoute(types.ModuleName, "total-supply", TotalSupply(k))
+// RegisterInvariants registers all token invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper, bk types.BankKeeper) {
+	ir.RegisterRoute(types.ModuleName, "total-supply",
+		TotalSupplyInvariant(k, bk))
+	ir.RegisterRoute(types.ModuleName, "unit-index",
+		UnitIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "owner-index",
+		OwnerIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-account",
+		ModuleAccountInvariant(k, bk))
 }
 
-// AllInvariants runs all invariants of the X/token module
-func AllInvariants(k Keeper) sdk.Invariant {
+// AllInvariants runs all invariants of the token module
+func AllInvariants(k Keeper, bk types.BankKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return "Not implement", true
+		res, stop := TotalSupplyInvariant(k, bk)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = UnitIndexInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = OwnerIndexInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return ModuleAccountInvariant(k, bk)(ctx)
 	}
 }
 
-// NonnegativeBalanceInvariant checks that all accounts in the application have non-negative balances
-func NonnegativeBalanceInvariant(k ViewKeeper) sdk.Invariant {
+// TotalSupplyInvariant checks that the circulating supply plus the burnt amount
+// of every token capped by its total supply never exceeds it
+func TotalSupplyInvariant(k Keeper, bk types.BankKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return sdk.FormatInvariant(
-			types.ModuleName, "nonnegative-outstanding",
-			fmt.Sprintf("amount of negative balances found %d\n%s", 100, "ok"),
-		), true
+		var (
+			msg   string
+			count int
+		)
+
+		supply := bk.GetSupply(ctx).GetTotal()
+		for _, token := range k.GetTokens(ctx, nil) {
+			if !token.IsSupplyCapped() {
+				continue
+			}
+			unit := token.GetSmallestUnit()
+
+			burnt := sdk.ZeroInt()
+			if burntCoin, found := k.GetBurntCoin(ctx, unit); found {
+				burnt = burntCoin.Amount
+			}
+
+			issued := supply.AmountOf(unit).Add(burnt)
+			if total := sdk.NewIntFromUint64(token.GetTotalSupply()); issued.GT(total) {
+				count++
+				msg += fmt.Sprintf("\ttoken %s: supply %s + burnt %s exceeds total supply %s\n",
+					token.GetSymbol(), supply.AmountOf(unit), burnt, total)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "total-supply",
+			fmt.Sprintf("%d tokens exceeding their total supply found\n%s", count, msg)), broken
+	}
+}
+
+// UnitIndexInvariant checks that every smallest-unit entry points to an existing
+// token with the same smallest unit
+func UnitIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		k.IterateTokenUnits(ctx, func(unit, symbol string) bool {
+			token, err := k.GetToken(ctx, symbol)
+			switch {
+			case err != nil:
+				count++
+				msg += fmt.Sprintf("\tunit %s points to the missing token %s\n", unit, symbol)
+			case token.GetSmallestUnit() != unit:
+				count++
+				msg += fmt.Sprintf("\tunit %s points to the token %s with the unit %s\n",
+					unit, symbol, token.GetSmallestUnit())
+			}
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "unit-index",
+			fmt.Sprintf("%d invalid unit entries found\n%s", count, msg)), broken
 	}
 }
 
-// TotalSupply checks that the total supply reflects all the coins held in accounts
-func TotalSupply(k Keeper) sdk.Invariant {
+// OwnerIndexInvariant checks that the owner index matches the owners of the tokens
+func OwnerIndexInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return sdk.FormatInvariant(types.ModuleName, "total supply",
-			fmt.Sprintf("\tsum of accounts coins: %v\n"+
-				"\tsupply.Total:          %v\n",
-				100, 100)), true
+		var (
+			msg   string
+			count int
+		)
+
+		indexed := make(map[string]bool)
+		k.IterateTokenOwners(ctx, func(owner sdk.AccAddress, symbol string) bool {
+			indexed[symbol] = true
+
+			token, err := k.GetToken(ctx, symbol)
+			switch {
+			case err != nil:
+				count++
+				msg += fmt.Sprintf("\towner %s indexes the missing token %s\n", owner, symbol)
+			case !token.GetOwner().Equals(owner):
+				count++
+				msg += fmt.Sprintf("\towner %s indexes the token %s owned by %s\n",
+					owner, symbol, token.GetOwner())
+			}
+			return false
+		})
+
+		for _, token := range k.GetTokens(ctx, nil) {
+			if !token.GetOwner().Empty() && !indexed[token.GetSymbol()] {
+				count++
+				msg += fmt.Sprintf("\ttoken %s is not indexed by its owner %s\n",
+					token.GetSymbol(), token.GetOwner())
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "owner-index",
+			fmt.Sprintf("%d invalid owner entries found\n%s", count, msg)), broken
+	}
+}
+
+// ModuleAccountInvariant checks that the token module account holds exactly the
+// amounts escrowed by the token vestings and distributions, the coins it mints or
+// burns only transit through it
+func ModuleAccountInvariant(k Keeper, bk types.BankKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		k.IterateTokenVestings(ctx, func(vesting types.TokenVesting) bool {
+			expected = expected.Add(vesting.Escrowed())
+			return false
+		})
+		k.IterateTokenDistributions(ctx, func(distribution types.TokenDistribution) bool {
+			expected = expected.Add(distribution.Unclaimed())
+			return false
+		})
+
+		balances := bk.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+
+		// IsEqual panics on coins of the same length with different denoms
+		broken := !balances.IsAllGTE(expected) || !expected.IsAllGTE(balances)
+
+		return sdk.FormatInvariant(types.ModuleName, "module-account",
+			fmt.Sprintf("\tmodule account balance: %v\n\tescrowed by the token vestings and distributions: %v\n", balances, expected)), broken
 	}
 }
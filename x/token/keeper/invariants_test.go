@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/token/keeper"
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+func TestTotalSupplyInvariant(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := sdk.AccAddress(tmhash.SumTruncated([]byte("addrOne")))
+	err := app.TokenKeeper.IssueToken(ctx, "Bitcoin Network", "btc", "satoshi", 8, 1000, 2000, true, true, false, false, addr)
+	require.NoError(t, err)
+
+	invariant := keeper.TotalSupplyInvariant(app.TokenKeeper, app.BankKeeper)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// burnt coins count against the total supply
+	app.TokenKeeper.AddBurnedCoin(ctx, sdk.NewInt64Coin("satoshi", 1000))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	app.TokenKeeper.AddBurnedCoin(ctx, sdk.NewInt64Coin("satoshi", 1))
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+}
+
+func TestIndexInvariants(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := sdk.AccAddress(tmhash.SumTruncated([]byte("addrOne")))
+	btc := types.NewToken("Bitcoin Network", "btc", "satoshi", 8, 1000, 2000, true, addr)
+	require.NoError(t, app.TokenKeeper.AddToken(ctx, btc))
+
+	_, broken := keeper.UnitIndexInvariant(app.TokenKeeper)(ctx)
+	require.False(t, broken)
+	_, broken = keeper.OwnerIndexInvariant(app.TokenKeeper)(ctx)
+	require.False(t, broken)
+
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	// a unit entry pointing to a missing token breaks the unit index
+	store.Set(types.GetUnitKey("gwei"), app.AppCodec().MustMarshalBinaryBare(&gogotypes.StringValue{Value: "eth"}))
+	_, broken = keeper.UnitIndexInvariant(app.TokenKeeper)(ctx)
+	require.True(t, broken)
+
+	// an owner entry left behind by a transfer breaks the owner index
+	newOwner := sdk.AccAddress(tmhash.SumTruncated([]byte("addrTwo")))
+	btc.Owner = newOwner.String()
+	store.Set(types.GetSymbolKey("btc"), app.AppCodec().MustMarshalBinaryBare(&btc))
+	_, broken = keeper.OwnerIndexInvariant(app.TokenKeeper)(ctx)
+	require.True(t, broken)
+
+	require.NoError(t, app.TokenKeeper.TransferTokenOwner(ctx, "btc", newOwner, addr))
+	_, broken = keeper.OwnerIndexInvariant(app.TokenKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestModuleAccountInvariant(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	invariant := keeper.ModuleAccountInvariant(app.TokenKeeper, app.BankKeeper)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("satoshi", 10))
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, authtypes.NewModuleAddress(types.ModuleName), coins))
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	// an escrow next to a stray balance of another denom breaks it without panicking
+	owner := sdk.AccAddress(tmhash.SumTruncated([]byte("addrOne")))
+	err := app.TokenKeeper.IssueToken(ctx, "Ether Network", "eth", "wei", 8, 1000, 2000, true, true, false, false, owner)
+	require.NoError(t, err)
+	start := ctx.BlockTime()
+	_, err = app.TokenKeeper.CreateTokenVesting(ctx, owner, owner, sdk.NewInt64Coin("wei", 100), start, start.Add(time.Hour), start.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, authtypes.NewModuleAddress(types.ModuleName),
+		sdk.NewCoins(sdk.NewInt64Coin("satoshi", 100))))
+	require.NotPanics(t, func() { _, broken = invariant(ctx) })
+	require.True(t, broken)
+}
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -23,13 +26,34 @@ type Keeper interface {
 
 	// msgServer
 	IssueToken(ctx sdk.Context, name string, symbol string, smallestUnit string, decimals uint32,initialSupply uint64,
-		totalSupply uint64, mintable bool, unlocked bool, owner sdk.AccAddress) error 
-	EditToken(ctx sdk.Context, symbol string, mintable bool, owner sdk.AccAddress) error 
+		totalSupply uint64, mintable bool, unlocked bool, holderBurnable bool, freezable bool, owner sdk.AccAddress) error
+	EditToken(ctx sdk.Context, symbol, uri, uriHash, description string, attributes []types.Attribute,
+		mintable bool, owner sdk.AccAddress) error
 	MintToken(ctx sdk.Context, symbol string, amount uint64, recipient sdk.AccAddress, owner sdk.AccAddress) error 
 	MintTokenWithUnit(ctx sdk.Context, unit string, amount uint64, recipient string) error 
-	BurnToken(ctx sdk.Context, symbol string, amount uint64, owner sdk.AccAddress) error 
+	BurnToken(ctx sdk.Context, symbol string, amount uint64, owner sdk.AccAddress) error
+	ValidateBurn(ctx sdk.Context, symbol string, sender sdk.AccAddress) error
 	UnlockToken(ctx sdk.Context, symbol string, owner sdk.AccAddress) error
 	TransferTokenOwner(ctx sdk.Context, symbol string, oldOwner sdk.AccAddress, newOwner sdk.AccAddress) error
+	GrantTokenRole(ctx sdk.Context, symbol string, role types.TokenRole, addr sdk.AccAddress, owner sdk.AccAddress) error
+	RevokeTokenRole(ctx sdk.Context, symbol string, role types.TokenRole, addr sdk.AccAddress, owner sdk.AccAddress) error
+	PauseToken(ctx sdk.Context, symbol string, sender sdk.AccAddress) error
+	UnpauseToken(ctx sdk.Context, symbol string, sender sdk.AccAddress) error
+	FreezeAccount(ctx sdk.Context, symbol string, addr sdk.AccAddress, owner sdk.AccAddress) error
+	UnfreezeAccount(ctx sdk.Context, symbol string, addr sdk.AccAddress, owner sdk.AccAddress) error
+	CreateTokenVesting(
+		ctx sdk.Context, sender, beneficiary sdk.AccAddress, amount sdk.Coin, startTime, cliffTime, endTime time.Time,
+	) (uint64, error)
+	ReleaseVestedTokens(ctx sdk.Context)
+	TakeTokenSnapshot(ctx sdk.Context, symbol string, owner sdk.AccAddress) (uint64, error)
+	DistributeToken(
+		ctx sdk.Context, symbol string, owner sdk.AccAddress, merkleRoot string, amount uint64, endTime time.Time, mint bool,
+	) (uint64, error)
+	ClaimDistribution(ctx sdk.Context, id uint64, recipient sdk.AccAddress, amount uint64, proof []string) (sdk.Coin, error)
+	ClawbackEndedDistributions(ctx sdk.Context)
+	HandleTokenFeeOverrideProposal(ctx sdk.Context, p *types.TokenFeeOverrideProposal) error
+	HandleSymbolReservationProposal(ctx sdk.Context, p *types.SymbolReservationProposal) error
+	MigrateStore(ctx sdk.Context)
 
 	DeductIssueTokenFee(ctx sdk.Context, owner sdk.AccAddress, symbol string) error
 	DeductMintTokenFee(ctx sdk.Context, owner sdk.AccAddress, symbol string) error
@@ -86,16 +110,26 @@ func (k BaseKeeper) IssueToken(
 	totalSupply uint64,
 	mintable bool,
 	unlocked bool,
+	holderBurnable bool,
+	freezable bool,
 	owner sdk.AccAddress,
 ) error {
 
+	// a reserved symbol is only issued by the reserved address
+	if err := k.ValidateSymbolReservation(ctx, symbol, owner); err != nil {
+		return err
+	}
+
 	// pools
 	token := types.NewToken(
 		name, symbol, smallestUnit, decimals, initialSupply,
 		totalSupply, mintable, owner)
+	token.HolderBurnable = holderBurnable
+	token.Freezable = freezable
 	if err := k.AddToken(ctx, token); err != nil {
 		return err
 	}
+	ctx.KVStore(k.storeKey).Delete(types.GetSymbolReservationKey(symbol))
 
 	// status
 	if unlocked != k.IsUnlocked(ctx, smallestUnit) {
@@ -111,10 +145,16 @@ func (k BaseKeeper) IssueToken(
 	return k.mintCoinsToAccount(ctx, owner, mintCoins)
 }
 
-// EditToken edits the specified token
+// EditToken edits the specified token, the metadata fields set to
+// types.DoNotModify are left unchanged and the attributes are upserted.
+// NOTE: owner is the signer, either the token owner or a metadata admin
 func (k BaseKeeper) EditToken(
 	ctx sdk.Context,
 	symbol string,
+	uri string,
+	uriHash string,
+	description string,
+	attributes []types.Attribute,
 	mintable bool,
 	owner sdk.AccAddress,
 ) error {
@@ -123,19 +163,43 @@ func (k BaseKeeper) EditToken(
 		return err
 	}
 
+	// the metadata admins edit the metadata, only the owner changes the mintability
 	if owner.String() != token.GetOwnerString() {
-		return sdkerrors.Wrapf(types.ErrInvalidOwner, 
-			"The address %s is not the owner of the token %s", owner, symbol)
+		if mintable != token.Mintable {
+			return sdkerrors.Wrapf(types.ErrInvalidOwner,
+				"The address %s is not the owner of the token %s", owner, symbol)
+		}
+		if !k.HasTokenRole(ctx, symbol, types.RoleMetadataAdmin, owner) {
+			return sdkerrors.Wrapf(types.ErrInvalidOwner,
+				"The address %s is neither the owner nor a metadata admin of the token %s", owner, symbol)
+		}
 	}
-	
+
 	token.Mintable = mintable
+	if uri != types.DoNotModify {
+		token.URI = uri
+	}
+	if uriHash != types.DoNotModify {
+		token.URIHash = uriHash
+	}
+	if description != types.DoNotModify {
+		token.Description = description
+	}
+	token.SetAttributes(attributes)
+
+	if err := token.Validate(); err != nil {
+		return err
+	}
+
 	k.storeToken(ctx, token)
+	k.setDenomMetaData(ctx, token)
 
 	return nil
 }
 
 // MintToken mints the specified amount of token to the specified recipient
-// NOTE: empty owner means that the external caller is responsible to manage the token authority
+// NOTE: empty owner means that the external caller is responsible to manage the token authority,
+// otherwise owner is the signer, either the token owner or a minter
 func (k BaseKeeper) MintToken(
 	ctx sdk.Context,
 	symbol string,
@@ -153,9 +217,9 @@ func (k BaseKeeper) MintToken(
 	}
 
 	if owner != nil {
-		if owner.String() != token.GetOwnerString() {
-			return sdkerrors.Wrapf(types.ErrInvalidOwner, 
-				"the address %s is not the owner of the token %s", owner, symbol)
+		if !k.hasTokenAuthority(ctx, token, types.RoleMinter, owner) {
+			return sdkerrors.Wrapf(types.ErrInvalidOwner,
+				"the address %s is neither the owner nor a minter of the token %s", owner, symbol)
 		}
 	
 		if recipient.Empty() {
@@ -227,7 +291,8 @@ func (k BaseKeeper) getMintCoins(
 }
 
 
-// BurnToken burns the specified amount of token
+// BurnToken burns the specified amount of token held by the sender, either the token
+// owner, a burner or, when the token is holder burnable, any holder
 func (k BaseKeeper) BurnToken(
 	ctx sdk.Context,
 	symbol string,
@@ -238,8 +303,9 @@ func (k BaseKeeper) BurnToken(
 	if err != nil {
 		return err
 	}
-	if token.GetOwnerString() != owner.String() {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "account %s does not have permissions to burn tokens", token.GetOwnerString())
+	holderBurn, err := k.checkBurnAuthority(ctx, token, owner)
+	if err != nil {
+		return err
 	}
 
 	burnedCoin := sdk.NewCoin(token.GetSmallestUnit(), sdk.NewIntFromUint64(amount))
@@ -249,9 +315,35 @@ func (k BaseKeeper) BurnToken(
 	}
 	
 	k.AddBurnedCoin(ctx, burnedCoin)
+	if holderBurn {
+		k.addHolderBurnedCoin(ctx, burnedCoin)
+	}
 	return nil
 }
 
+// ValidateBurn checks the sender is allowed to burn the specified token
+func (k BaseKeeper) ValidateBurn(ctx sdk.Context, symbol string, sender sdk.AccAddress) error {
+	token, err := k.getTokenBySymbol(ctx, symbol)
+	if err != nil {
+		return err
+	}
+
+	_, err = k.checkBurnAuthority(ctx, token, sender)
+	return err
+}
+
+// checkBurnAuthority returns whether the sender burns the token as a mere holder,
+// the burns of the owner and the burners are tracked apart from the holders' ones
+func (k BaseKeeper) checkBurnAuthority(ctx sdk.Context, token types.Token, sender sdk.AccAddress) (holderBurn bool, err error) {
+	if k.hasTokenAuthority(ctx, token, types.RoleBurner, sender) {
+		return false, nil
+	}
+	if !token.GetHolderBurnable() {
+		return false, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "account %s does not have permissions to burn tokens", sender)
+	}
+	return true, nil
+}
+
 // UnlockToken unlock the specialied token
 func (k BaseKeeper) UnlockToken(ctx sdk.Context, symbol string, owner sdk.AccAddress) error {
 	token, err := k.getTokenBySymbol(ctx, symbol)
@@ -298,6 +390,377 @@ func (k BaseKeeper) TransferTokenOwner(
 	return nil
 }
 
+// GrantTokenRole grants the role of the specified token to the address
+func (k BaseKeeper) GrantTokenRole(
+	ctx sdk.Context,
+	symbol string,
+	role types.TokenRole,
+	addr sdk.AccAddress,
+	owner sdk.AccAddress,
+) error {
+	token, err := k.getTokenBySymbol(ctx, symbol)
+	if err != nil {
+		return err
+	}
+
+	if owner.String() != token.GetOwnerString() {
+		return sdkerrors.Wrapf(types.ErrInvalidOwner, "%s is not the owner of the token[%s]",
+			owner, symbol)
+	}
+
+	roles, found := k.getTokenRoles(ctx, symbol, addr)
+	if !found {
+		roles = types.TokenRoles{Symbol: symbol, Address: addr.String()}
+	}
+	if roles.HasRole(role) {
+		return sdkerrors.Wrapf(types.ErrRoleAlreadyGranted, "%s of the token[%s] granted to %s",
+			role, symbol, addr)
+	}
+
+	roles.Roles = append(roles.Roles, role)
+	k.storeTokenRoles(ctx, roles)
+
+	return nil
+}
+
+// RevokeTokenRole revokes the role of the specified token from the address
+func (k BaseKeeper) RevokeTokenRole(
+	ctx sdk.Context,
+	symbol string,
+	role types.TokenRole,
+	addr sdk.AccAddress,
+	owner sdk.AccAddress,
+) error {
+	token, err := k.getTokenBySymbol(ctx, symbol)
+	if err != nil {
+		return err
+	}
+
+	if owner.String() != token.GetOwnerString() {
+		return sdkerrors.Wrapf(types.ErrInvalidOwner, "%s is not the owner of the token[%s]",
+			owner, symbol)
+	}
+
+	roles, found := k.getTokenRoles(ctx, symbol, addr)
+	if !found || !roles.HasRole(role) {
+		return sdkerrors.Wrapf(types.ErrRoleNotGranted, "%s of the token[%s] is not granted to %s",
+			role, symbol, addr)
+	}
+
+	granted := roles.Roles[:0]
+	for _, r := range roles.Roles {
+		if r != role {
+			granted = append(granted, r)
+		}
+	}
+	roles.Roles = granted
+	k.storeTokenRoles(ctx, roles)
+
+	return nil
+}
+
+// hasTokenAuthority asserts the address is the token owner or has been granted the role
+// PauseToken pauses all the transfers of the specified token, either by its owner or by a pauser
+func (k BaseKeeper) PauseToken(ctx sdk.Context, symbol string, sender sdk.AccAddress) error {
+	token, err := k.getTokenBySymbol(ctx, symbol)
+	if err != nil {
+		return err
+	}
+
+	if !k.hasTokenAuthority(ctx, token, types.RolePauser, sender) {
+		return sdkerrors.Wrapf(types.ErrInvalidOwner, "%s is neither the owner nor a pauser of the token[%s]",
+			sender, symbol)
+	}
+
+	if k.IsPaused(ctx, token.GetSmallestUnit()) {
+		return sdkerrors.Wrapf(types.ErrTokenPaused, "the token[%s] has been paused", symbol)
+	}
+
+	k.pauseToken(ctx, token.GetSmallestUnit(), true)
+	return nil
+}
+
+// UnpauseToken resumes the transfers of the specified paused token, either by its owner or by a pauser
+func (k BaseKeeper) UnpauseToken(ctx sdk.Context, symbol string, sender sdk.AccAddress) error {
+	token, err := k.getTokenBySymbol(ctx, symbol)
+	if err != nil {
+		return err
+	}
+
+	if !k.hasTokenAuthority(ctx, token, types.RolePauser, sender) {
+		return sdkerrors.Wrapf(types.ErrInvalidOwner, "%s is neither the owner nor a pauser of the token[%s]",
+			sender, symbol)
+	}
+
+	if !k.IsPaused(ctx, token.GetSmallestUnit()) {
+		return sdkerrors.Wrapf(types.ErrTokenNotPaused, "the token[%s] is not paused", symbol)
+	}
+
+	k.pauseToken(ctx, token.GetSmallestUnit(), false)
+	return nil
+}
+
+// FreezeAccount freezes the outgoing transfers of the specified freezable token from the account
+func (k BaseKeeper) FreezeAccount(ctx sdk.Context, symbol string, addr sdk.AccAddress, owner sdk.AccAddress) error {
+	token, err := k.getTokenBySymbol(ctx, symbol)
+	if err != nil {
+		return err
+	}
+
+	if owner.String() != token.GetOwnerString() {
+		return sdkerrors.Wrapf(types.ErrInvalidOwner, "%s is not the owner of the token[%s]", owner, symbol)
+	}
+
+	if !token.GetFreezable() {
+		return sdkerrors.Wrapf(types.ErrNotFreezable, "the token[%s] is not freezable", symbol)
+	}
+
+	if k.IsFrozen(ctx, token.GetSmallestUnit(), addr) {
+		return sdkerrors.Wrapf(types.ErrAccountFrozen, "%s has been frozen for the token[%s]", addr, symbol)
+	}
+
+	k.freezeAccount(ctx, token.GetSmallestUnit(), addr, true)
+	return nil
+}
+
+// UnfreezeAccount unfreezes the frozen account of the specified token
+func (k BaseKeeper) UnfreezeAccount(ctx sdk.Context, symbol string, addr sdk.AccAddress, owner sdk.AccAddress) error {
+	token, err := k.getTokenBySymbol(ctx, symbol)
+	if err != nil {
+		return err
+	}
+
+	if owner.String() != token.GetOwnerString() {
+		return sdkerrors.Wrapf(types.ErrInvalidOwner, "%s is not the owner of the token[%s]", owner, symbol)
+	}
+
+	if !k.IsFrozen(ctx, token.GetSmallestUnit(), addr) {
+		return sdkerrors.Wrapf(types.ErrAccountNotFrozen, "%s is not frozen for the token[%s]", addr, symbol)
+	}
+
+	k.freezeAccount(ctx, token.GetSmallestUnit(), addr, false)
+	return nil
+}
+
+// CreateTokenVesting escrows the amount of the sender in the token module account,
+// released to the beneficiary from the cliff time until the end time
+func (k BaseKeeper) CreateTokenVesting(
+	ctx sdk.Context, sender, beneficiary sdk.AccAddress, amount sdk.Coin, startTime, cliffTime, endTime time.Time,
+) (uint64, error) {
+	if !k.HasTokenWithUnit(ctx, amount.Denom) {
+		return 0, sdkerrors.Wrapf(types.ErrTokenNotExists, "token with unit %s does not exist", amount.Denom)
+	}
+
+	if !endTime.After(ctx.BlockTime()) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidVesting, "end time %s must be after the block time", endTime)
+	}
+
+	if minAmount := k.GetParams(ctx).MinVestingAmount; amount.Amount.LT(minAmount) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidVesting, "amount %s is below the minimum vesting amount %s", amount, minAmount)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return 0, err
+	}
+
+	id := k.GetNextVestingID(ctx)
+	vesting := types.NewTokenVesting(id, sender, beneficiary, amount, startTime, cliffTime, endTime)
+	k.storeTokenVesting(ctx, vesting)
+	k.insertVestingQueue(ctx, id, vesting.NextReleaseTime(ctx.BlockTime()))
+	k.setNextVestingID(ctx, id+1)
+
+	return id, nil
+}
+
+// TakeTokenSnapshot records a snapshot of the holders of the token at the current
+// height, the holders being queried at that height
+func (k BaseKeeper) TakeTokenSnapshot(ctx sdk.Context, symbol string, owner sdk.AccAddress) (uint64, error) {
+	token, err := k.getTokenBySymbol(ctx, symbol)
+	if err != nil {
+		return 0, err
+	}
+
+	if token.GetOwnerString() != owner.String() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidOwner, "%s is not the owner of the token[%s]", owner, symbol)
+	}
+
+	id := k.GetNextSnapshotID(ctx)
+	k.storeTokenSnapshot(ctx, types.NewTokenSnapshot(id, symbol, ctx.BlockHeight(), ctx.BlockTime(), owner))
+	k.setNextSnapshotID(ctx, id+1)
+
+	return id, nil
+}
+
+// DistributeToken escrows the amount of the token claimed by the (address, amount)
+// pairs of the merkle root until the end time, the amount being minted within the
+// mintable headroom of the token when mint is true or taken from the owner balance
+func (k BaseKeeper) DistributeToken(
+	ctx sdk.Context, symbol string, owner sdk.AccAddress, merkleRoot string, amount uint64, endTime time.Time, mint bool,
+) (uint64, error) {
+	token, err := k.getTokenBySymbol(ctx, symbol)
+	if err != nil {
+		return 0, err
+	}
+
+	if token.GetOwnerString() != owner.String() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidOwner, "%s is not the owner of the token[%s]", owner, symbol)
+	}
+
+	if !endTime.After(ctx.BlockTime()) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidDistribution, "end time %s must be after the block time", endTime)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(token.GetSmallestUnit(), sdk.NewIntFromUint64(amount)))
+	if mint {
+		if coins, err = k.getMintCoins(ctx, token, amount); err != nil {
+			return 0, err
+		}
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return 0, err
+		}
+	} else if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, coins); err != nil {
+		return 0, err
+	}
+
+	id := k.GetNextDistributionID(ctx)
+	k.storeTokenDistribution(ctx, types.NewTokenDistribution(id, symbol, owner, merkleRoot, coins[0], endTime))
+	k.setNextDistributionID(ctx, id+1)
+
+	return id, nil
+}
+
+// ClaimDistribution sends the amount of the token distribution allotted to the recipient,
+// the proof linking the (recipient, amount) pair to the merkle root of the distribution
+func (k BaseKeeper) ClaimDistribution(
+	ctx sdk.Context, id uint64, recipient sdk.AccAddress, amount uint64, proof []string,
+) (sdk.Coin, error) {
+	distribution, found := k.GetTokenDistribution(ctx, id)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrDistributionNotFound, "distribution %d", id)
+	}
+
+	if distribution.IsEnded(ctx.BlockTime()) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrDistributionEnded, "distribution %d ended at %s", id, distribution.EndTime)
+	}
+
+	if _, found := k.GetDistributionClaim(ctx, id, recipient); found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrAlreadyClaimed, "%s already claimed distribution %d", recipient, id)
+	}
+
+	root, err := types.ParseMerkleRoot(distribution.MerkleRoot)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	nodes, err := types.ParseMerkleProof(proof)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !types.VerifyDistributionProof(root, types.DistributionLeaf(recipient, amount), nodes) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidMerkleProof, "%d allotted to %s is not included in distribution %d", amount, recipient, id)
+	}
+
+	claim := sdk.NewCoin(distribution.Total.Denom, sdk.NewIntFromUint64(amount))
+	if distribution.Unclaimed().IsLT(claim) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidAmount,
+			"claimed amount %s exceeds the unclaimed amount %s of distribution %d", claim, distribution.Unclaimed(), id)
+	}
+
+	if err := k.ValidateNotPaused(ctx, claim.Denom); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(claim)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	distribution.Claimed = distribution.Claimed.Add(claim)
+	k.storeTokenDistribution(ctx, distribution)
+	k.storeDistributionClaim(ctx, types.DistributionClaim{DistributionId: id, Address: recipient.String(), Amount: claim})
+
+	return claim, nil
+}
+
+// ClawbackEndedDistributions sends the unclaimed amounts of the token distributions ended
+// in the distribution queue back to their creators and removes them, the distributions of
+// the paused tokens are clawed back once resumed
+func (k BaseKeeper) ClawbackEndedDistributions(ctx sdk.Context) {
+	for _, id := range k.endedDistributionIDs(ctx) {
+		distribution, found := k.GetTokenDistribution(ctx, id)
+		if !found || k.IsPaused(ctx, distribution.Total.Denom) {
+			continue
+		}
+
+		unclaimed := distribution.Unclaimed()
+		if unclaimed.IsPositive() {
+			creator, _ := sdk.AccAddressFromBech32(distribution.Creator)
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, sdk.NewCoins(unclaimed)); err != nil {
+				panic(err)
+			}
+		}
+
+		k.deleteTokenDistribution(ctx, distribution)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeClawbackDistribution,
+				sdk.NewAttribute(types.AttributeKeyDistributionID, strconv.FormatUint(distribution.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyCreator, distribution.Creator),
+				sdk.NewAttribute(types.AttributeKeyAmount, unclaimed.String()),
+			),
+		)
+	}
+}
+
+// ReleaseVestedTokens sends the newly vested amounts of the token vestings due in the
+// vesting queue to their beneficiaries, the vestings of the paused tokens are released
+// once resumed and the fully released vestings are removed
+func (k BaseKeeper) ReleaseVestedTokens(ctx sdk.Context) {
+	for _, id := range k.dequeueDueVestings(ctx) {
+		vesting, found := k.GetTokenVesting(ctx, id)
+		if !found {
+			continue
+		}
+
+		// a paused vesting is retried a release interval later, even past its end time
+		if k.IsPaused(ctx, vesting.Total.Denom) {
+			k.insertVestingQueue(ctx, id, ctx.BlockTime().Add(types.VestingReleaseInterval))
+			continue
+		}
+
+		release := vesting.VestedAmount(ctx.BlockTime()).Sub(vesting.Released)
+		if !release.IsPositive() {
+			k.insertVestingQueue(ctx, id, vesting.NextReleaseTime(ctx.BlockTime()))
+			continue
+		}
+
+		beneficiary, _ := sdk.AccAddressFromBech32(vesting.Beneficiary)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, beneficiary, sdk.NewCoins(release)); err != nil {
+			panic(err)
+		}
+
+		vesting.Released = vesting.Released.Add(release)
+		if vesting.Released.IsGTE(vesting.Total) {
+			k.deleteTokenVesting(ctx, vesting)
+		} else {
+			k.storeTokenVesting(ctx, vesting)
+			k.insertVestingQueue(ctx, id, vesting.NextReleaseTime(ctx.BlockTime()))
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReleaseVesting,
+				sdk.NewAttribute(types.AttributeKeyVestingID, strconv.FormatUint(vesting.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyBeneficiary, vesting.Beneficiary),
+				sdk.NewAttribute(types.AttributeKeyAmount, release.String()),
+			),
+		)
+	}
+}
+
+func (k BaseKeeper) hasTokenAuthority(ctx sdk.Context, token types.TokenI, role types.TokenRole, addr sdk.AccAddress) bool {
+	return token.GetOwnerString() == addr.String() || k.HasTokenRole(ctx, token.GetSymbol(), role, addr)
+}
+
 func (k BaseKeeper) GetBlockedAddress()(map[string]bool){
 	return k.blockedAddress;
 }
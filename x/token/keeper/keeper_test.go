@@ -88,7 +88,7 @@ func (suite *KeeperTestSuite) TestIssueUnlockedToken() {
 	err := suite.keeper.IssueToken(
 		suite.ctx, token.GetName(), token.GetSymbol(), token.GetSmallestUnit(),
 		token.GetDecimals(), token.GetInitialSupply(), token.GetTotalSupply(),
-		token.GetMintable(), true, token.GetOwner(),
+		token.GetMintable(), true, false, false, token.GetOwner(),
 	)
 	suite.NoError(err)
 
@@ -107,7 +107,7 @@ func (suite *KeeperTestSuite) TestIssueLockedToken() {
 	err := suite.keeper.IssueToken(
 		suite.ctx, token.GetName(), token.GetSymbol(), token.GetSmallestUnit(),
 		token.GetDecimals(), token.GetInitialSupply(), token.GetTotalSupply(),
-		token.GetMintable(), false, token.GetOwner(),
+		token.GetMintable(), false, false, false, token.GetOwner(),
 	)
 	suite.NoError(err)
 
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+// MigrateStore migrates the token store of a running chain to the current
+// version, it is run once by the software upgrade handler
+func (k BaseKeeper) MigrateStore(ctx sdk.Context) {
+	k.migrateParams(ctx)
+	k.migrateLockedTokens(ctx)
+}
+
+// migrateParams sets the params missing from the param subspace to their
+// default values
+func (k BaseKeeper) migrateParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, reflect.Indirect(reflect.ValueOf(pair.Value)).Interface())
+		}
+	}
+}
+
+// migrateLockedTokens moves the locks of the issued tokens from the send enabled
+// params of the bank module to the locked token index
+func (k BaseKeeper) migrateLockedTokens(ctx sdk.Context) {
+	bankParams := k.bankKeeper.GetParams(ctx)
+
+	var sendEnabled []*banktypes.SendEnabled
+	for _, param := range bankParams.SendEnabled {
+		if !param.Enabled && k.HasTokenWithUnit(ctx, param.Denom) {
+			k.unlockToken(ctx, param.Denom, false)
+			continue
+		}
+		sendEnabled = append(sendEnabled, param)
+	}
+
+	bankParams.SendEnabled = sendEnabled
+	k.bankKeeper.SetParams(ctx, bankParams)
+}
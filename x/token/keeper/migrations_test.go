@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := app.TokenKeeper.GetParams(ctx)
+	params.TokenTax = params.TokenTax.QuoInt64(2)
+	app.TokenKeeper.SetParams(ctx, params)
+
+	// the params added since the chain started are missing from the subspace
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	for _, key := range [][]byte{
+		types.KeyFactorBase, types.KeyFactorExp, types.KeyMinIssueFee,
+		types.KeyPremiumSymbolLen, types.KeyPremiumFeeMultiplier, types.KeyMinVestingAmount,
+	} {
+		store.Delete(key)
+	}
+	require.Panics(t, func() { app.TokenKeeper.GetParams(ctx) })
+
+	// the migration sets them to their defaults and keeps the others
+	app.TokenKeeper.MigrateStore(ctx)
+	expected := types.DefaultParams()
+	expected.TokenTax = params.TokenTax
+	require.Equal(t, expected, app.TokenKeeper.GetParams(ctx))
+}
@@ -39,8 +39,8 @@ func (m msgServer) IssueToken(goCtx context.Context, msg *types.MsgIssueToken) (
 	}
 
 	if err := m.Keeper.IssueToken(
-		ctx, msg.Name, msg.Symbol, msg.SmallestUnit, msg.Decimals, msg.InitialSupply, 
-		msg.TotalSupply, msg.Mintable, msg.Unlocked, owner); err != nil {
+		ctx, msg.Name, msg.Symbol, msg.SmallestUnit, msg.Decimals, msg.InitialSupply,
+		msg.TotalSupply, msg.Mintable, msg.Unlocked, msg.HolderBurnable, msg.Freezable, owner); err != nil {
 		return nil, err
 	}
 
@@ -69,7 +69,7 @@ func (m msgServer) EditToken(goCtx context.Context, msg *types.MsgEditToken) (*t
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	if err := m.Keeper.EditToken(
-		ctx, msg.Symbol, msg.Mintable, owner,
+		ctx, msg.Symbol, msg.URI, msg.URIHash, msg.Description, msg.Attributes, msg.Mintable, owner,
 	); err != nil {
 		return nil, err
 	}
@@ -224,3 +224,329 @@ func (m msgServer) TransferTokenOwner(goCtx context.Context, msg *types.MsgTrans
 
 	return &types.MsgTransferTokenOwnerResponse{}, nil
 }
+
+func (m msgServer) GrantTokenRole(goCtx context.Context, msg *types.MsgGrantTokenRole) (*types.MsgGrantTokenRoleResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.GrantTokenRole(ctx, msg.Symbol, msg.Role, addr, owner); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeGrantTokenRole,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role.String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	})
+
+	return &types.MsgGrantTokenRoleResponse{}, nil
+}
+
+func (m msgServer) RevokeTokenRole(goCtx context.Context, msg *types.MsgRevokeTokenRole) (*types.MsgRevokeTokenRoleResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.RevokeTokenRole(ctx, msg.Symbol, msg.Role, addr, owner); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeTokenRole,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role.String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	})
+
+	return &types.MsgRevokeTokenRoleResponse{}, nil
+}
+
+func (m msgServer) PauseToken(goCtx context.Context, msg *types.MsgPauseToken) (*types.MsgPauseTokenResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.PauseToken(ctx, msg.Symbol, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePauseToken,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgPauseTokenResponse{}, nil
+}
+
+func (m msgServer) UnpauseToken(goCtx context.Context, msg *types.MsgUnpauseToken) (*types.MsgUnpauseTokenResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.UnpauseToken(ctx, msg.Symbol, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnpauseToken,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgUnpauseTokenResponse{}, nil
+}
+
+func (m msgServer) FreezeAccount(goCtx context.Context, msg *types.MsgFreezeAccount) (*types.MsgFreezeAccountResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.FreezeAccount(ctx, msg.Symbol, addr, owner); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFreezeAccount,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	})
+
+	return &types.MsgFreezeAccountResponse{}, nil
+}
+
+func (m msgServer) UnfreezeAccount(goCtx context.Context, msg *types.MsgUnfreezeAccount) (*types.MsgUnfreezeAccountResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.UnfreezeAccount(ctx, msg.Symbol, addr, owner); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnfreezeAccount,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	})
+
+	return &types.MsgUnfreezeAccountResponse{}, nil
+}
+
+func (m msgServer) CreateTokenVesting(goCtx context.Context, msg *types.MsgCreateTokenVesting) (*types.MsgCreateTokenVestingResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	beneficiary, err := sdk.AccAddressFromBech32(msg.Beneficiary)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Keeper.GetBlockedAddress()[msg.Beneficiary] {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is a module account", msg.Beneficiary)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, err := m.Keeper.CreateTokenVesting(ctx, sender, beneficiary, msg.Amount, msg.StartTime, msg.CliffTime, msg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateTokenVesting,
+			sdk.NewAttribute(types.AttributeKeyVestingID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyBeneficiary, msg.Beneficiary),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgCreateTokenVestingResponse{Id: id}, nil
+}
+
+func (m msgServer) TakeTokenSnapshot(goCtx context.Context, msg *types.MsgTakeTokenSnapshot) (*types.MsgTakeTokenSnapshotResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, err := m.Keeper.TakeTokenSnapshot(ctx, msg.Symbol, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTakeTokenSnapshot,
+			sdk.NewAttribute(types.AttributeKeySnapshotID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	})
+
+	return &types.MsgTakeTokenSnapshotResponse{Id: id, Height: ctx.BlockHeight()}, nil
+}
+
+func (m msgServer) DistributeToken(goCtx context.Context, msg *types.MsgDistributeToken) (*types.MsgDistributeTokenResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Mint {
+		if err := m.Keeper.DeductMintTokenFee(ctx, owner, msg.Symbol); err != nil {
+			return nil, err
+		}
+	}
+
+	id, err := m.Keeper.DistributeToken(ctx, msg.Symbol, owner, msg.MerkleRoot, msg.Amount, msg.EndTime, msg.Mint)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDistributeToken,
+			sdk.NewAttribute(types.AttributeKeyDistributionID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyMerkleRoot, msg.MerkleRoot),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(msg.Amount, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndTime, msg.EndTime.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	})
+
+	return &types.MsgDistributeTokenResponse{Id: id}, nil
+}
+
+func (m msgServer) ClaimDistribution(goCtx context.Context, msg *types.MsgClaimDistribution) (*types.MsgClaimDistributionResponse, error) {
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	claim, err := m.Keeper.ClaimDistribution(ctx, msg.Id, recipient, msg.Amount, msg.Proof)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimDistribution,
+			sdk.NewAttribute(types.AttributeKeyDistributionID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, claim.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Recipient),
+		),
+	})
+
+	return &types.MsgClaimDistributionResponse{}, nil
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+// HandleTokenFeeOverrideProposal is a handler for executing a passed token fee override proposal
+func (k BaseKeeper) HandleTokenFeeOverrideProposal(ctx sdk.Context, p *types.TokenFeeOverrideProposal) error {
+	store := ctx.KVStore(k.storeKey)
+
+	if p.Remove {
+		if !store.Has(types.GetFeeOverrideKey(p.Symbol)) {
+			return sdkerrors.Wrapf(types.ErrFeeOverrideNotFound, "no fee override of symbol %s", p.Symbol)
+		}
+		store.Delete(types.GetFeeOverrideKey(p.Symbol))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRemoveFeeOverride,
+				sdk.NewAttribute(types.AttributeKeySymbol, p.Symbol),
+			),
+		)
+		return nil
+	}
+
+	k.storeTokenFeeOverride(ctx, types.TokenFeeOverride{
+		Symbol:   p.Symbol,
+		IssueFee: p.IssueFee,
+		MintFee:  p.MintFee,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOverrideTokenFee,
+			sdk.NewAttribute(types.AttributeKeySymbol, p.Symbol),
+			sdk.NewAttribute(types.AttributeKeyIssueFee, p.IssueFee.String()),
+			sdk.NewAttribute(types.AttributeKeyMintFee, p.MintFee.String()),
+		),
+	)
+	return nil
+}
+
+// HandleSymbolReservationProposal is a handler for executing a passed symbol reservation proposal
+func (k BaseKeeper) HandleSymbolReservationProposal(ctx sdk.Context, p *types.SymbolReservationProposal) error {
+	store := ctx.KVStore(k.storeKey)
+
+	if p.Remove {
+		if !store.Has(types.GetSymbolReservationKey(p.Symbol)) {
+			return sdkerrors.Wrapf(types.ErrReservationNotFound, "no reservation of symbol %s", p.Symbol)
+		}
+		store.Delete(types.GetSymbolReservationKey(p.Symbol))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReleaseSymbol,
+				sdk.NewAttribute(types.AttributeKeySymbol, p.Symbol),
+			),
+		)
+		return nil
+	}
+
+	if k.HasToken(ctx, p.Symbol) {
+		return sdkerrors.Wrapf(types.ErrSymbolAlreadyExists, "symbol %s is already issued", p.Symbol)
+	}
+
+	k.storeSymbolReservation(ctx, types.SymbolReservation{
+		Symbol:  p.Symbol,
+		Address: p.Address,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReserveSymbol,
+			sdk.NewAttribute(types.AttributeKeySymbol, p.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAddress, p.Address),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+func TestSymbolReservationProposal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	owner := sdk.AccAddress(tmhash.SumTruncated([]byte("addrOne")))
+	reserved := sdk.AccAddress(tmhash.SumTruncated([]byte("addrTwo")))
+
+	proposal := types.NewSymbolReservationProposal("title", "description", "btc", reserved.String(), false)
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, app.TokenKeeper.HandleSymbolReservationProposal(ctx, proposal))
+
+	// the reserved symbol is only available to the reserved address, at the premium fee
+	res, err := app.TokenKeeper.SymbolAvailability(sdk.WrapSDKContext(ctx), &types.QuerySymbolAvailabilityRequest{Symbol: "btc"})
+	require.NoError(t, err)
+	require.False(t, res.Available)
+	require.Equal(t, reserved.String(), res.ReservedFor)
+	require.True(t, res.Premium)
+	params := app.TokenKeeper.GetParams(ctx)
+	require.Equal(t, params.IssueFee.Amount.MulRaw(10), res.IssueFee.Amount)
+
+	res, err = app.TokenKeeper.SymbolAvailability(sdk.WrapSDKContext(ctx), &types.QuerySymbolAvailabilityRequest{Symbol: "btc", Owner: reserved.String()})
+	require.NoError(t, err)
+	require.True(t, res.Available)
+
+	err = app.TokenKeeper.IssueToken(ctx, "Bitcoin Network", "btc", "satoshi", 8, 1000, 2000, true, true, false, false, owner)
+	require.ErrorIs(t, err, types.ErrSymbolReserved)
+
+	gs := app.TokenKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.SymbolReservation{{Symbol: "btc", Address: reserved.String()}}, gs.SymbolReservations)
+	require.NoError(t, gs.Validate())
+
+	// issuing the reserved symbol consumes the reservation
+	err = app.TokenKeeper.IssueToken(ctx, "Bitcoin Network", "btc", "satoshi", 8, 1000, 2000, true, true, false, false, reserved)
+	require.NoError(t, err)
+	_, found := app.TokenKeeper.GetSymbolReservation(ctx, "btc")
+	require.False(t, found)
+
+	res, err = app.TokenKeeper.SymbolAvailability(sdk.WrapSDKContext(ctx), &types.QuerySymbolAvailabilityRequest{Symbol: "btc", Owner: reserved.String()})
+	require.NoError(t, err)
+	require.False(t, res.Available)
+	require.True(t, res.Issued)
+
+	// an issued symbol can not be reserved and a missing reservation can not be released
+	require.ErrorIs(t, app.TokenKeeper.HandleSymbolReservationProposal(ctx, proposal), types.ErrSymbolAlreadyExists)
+	proposal = types.NewSymbolReservationProposal("title", "description", "eth", "", true)
+	require.NoError(t, proposal.ValidateBasic())
+	require.ErrorIs(t, app.TokenKeeper.HandleSymbolReservationProposal(ctx, proposal), types.ErrReservationNotFound)
+
+	// the reserved address must be valid unless the reservation is released
+	proposal = types.NewSymbolReservationProposal("title", "description", "eth", "", false)
+	require.Error(t, proposal.ValidateBasic())
+}
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+func TestTokenRoles(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	owner := sdk.AccAddress(tmhash.SumTruncated([]byte("addrOne")))
+	minter := sdk.AccAddress(tmhash.SumTruncated([]byte("addrTwo")))
+
+	err := app.TokenKeeper.IssueToken(ctx, "Bitcoin Network", "btc", "satoshi", 8, 1000, 2000, true, true, false, false, owner)
+	require.NoError(t, err)
+
+	// only the owner mints until the role is granted
+	err = app.TokenKeeper.MintToken(ctx, "btc", 10, minter, minter)
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+	err = app.TokenKeeper.GrantTokenRole(ctx, "btc", types.RoleMinter, minter, minter)
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+
+	require.NoError(t, app.TokenKeeper.GrantTokenRole(ctx, "btc", types.RoleMinter, minter, owner))
+	err = app.TokenKeeper.GrantTokenRole(ctx, "btc", types.RoleMinter, minter, owner)
+	require.ErrorIs(t, err, types.ErrRoleAlreadyGranted)
+	require.True(t, app.TokenKeeper.HasTokenRole(ctx, "btc", types.RoleMinter, minter))
+	require.False(t, app.TokenKeeper.HasTokenRole(ctx, "btc", types.RoleBurner, minter))
+
+	require.NoError(t, app.TokenKeeper.MintToken(ctx, "btc", 10, minter, minter))
+	require.Equal(t, int64(10), app.BankKeeper.GetBalance(ctx, minter, "satoshi").Amount.Int64())
+
+	// a burner burns its own tokens
+	err = app.TokenKeeper.BurnToken(ctx, "btc", 5, minter)
+	require.Error(t, err)
+	require.NoError(t, app.TokenKeeper.GrantTokenRole(ctx, "btc", types.RoleBurner, minter, owner))
+	require.NoError(t, app.TokenKeeper.BurnToken(ctx, "btc", 5, minter))
+	require.Equal(t, int64(5), app.BankKeeper.GetBalance(ctx, minter, "satoshi").Amount.Int64())
+
+	// a metadata admin edits the metadata but not the mintability
+	require.NoError(t, app.TokenKeeper.GrantTokenRole(ctx, "btc", types.RoleMetadataAdmin, minter, owner))
+	err = app.TokenKeeper.EditToken(ctx, "btc", "https://bitcoin.org", types.DoNotModify, types.DoNotModify, nil, false, minter)
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+	err = app.TokenKeeper.EditToken(ctx, "btc", "https://bitcoin.org", types.DoNotModify, types.DoNotModify, nil, true, minter)
+	require.NoError(t, err)
+
+	roles := app.TokenKeeper.GetTokenRoles(ctx, minter)
+	require.Len(t, roles, 1)
+	require.Equal(t, []types.TokenRole{types.RoleMinter, types.RoleBurner, types.RoleMetadataAdmin}, roles[0].Roles)
+	require.Equal(t, roles, app.TokenKeeper.ExportGenesis(ctx).TokenRoles)
+
+	require.NoError(t, app.TokenKeeper.RevokeTokenRole(ctx, "btc", types.RoleMinter, minter, owner))
+	err = app.TokenKeeper.RevokeTokenRole(ctx, "btc", types.RoleMinter, minter, owner)
+	require.ErrorIs(t, err, types.ErrRoleNotGranted)
+	err = app.TokenKeeper.MintToken(ctx, "btc", 10, minter, minter)
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+
+	// the index entry is removed with the last role
+	require.NoError(t, app.TokenKeeper.RevokeTokenRole(ctx, "btc", types.RoleBurner, minter, owner))
+	require.NoError(t, app.TokenKeeper.RevokeTokenRole(ctx, "btc", types.RoleMetadataAdmin, minter, owner))
+	require.Empty(t, app.TokenKeeper.GetTokenRoles(ctx, minter))
+}
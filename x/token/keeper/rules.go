@@ -2,20 +2,12 @@
 package keeper
 
 import (
-	"math"
-	"strconv"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/gauss/gauss/v4/x/token/types"
 )
 
-// factor = (ln(len({data}))/ln{base})^{exp}
-const (
-	FactorBase = 3
-	FactorExp  = 4
-)
-
 // DoIssueTokenFee performs fee handling for issuing token
 func (k BaseKeeper) DeductIssueTokenFee(ctx sdk.Context, owner sdk.AccAddress, symbol string) error {
 	fees, err := k.GetIssueTokenFee(ctx, symbol)
@@ -34,61 +26,82 @@ func (k BaseKeeper) DeductMintTokenFee(ctx sdk.Context, owner sdk.AccAddress, sy
 	return handleFees(ctx, k, owner, fees)
 }
 
-// GetIssueTokenFee returns the token issuance fee
+// GetIssueTokenFee returns the token issuance fee, the fee overridden by
+// governance if any. The fee of a premium symbol is multiplied by the
+// premium fee multiplier
 func (k BaseKeeper) GetIssueTokenFee(ctx sdk.Context, symbol string) (sdk.Coin, error) {
+	if override, found := k.GetTokenFeeOverride(ctx, symbol); found {
+		return override.IssueFee, nil
+	}
+
 	params := k.GetParams(ctx)
-	return k.calcIssueTokenFee(params, symbol), nil
+	issueFee, err := k.calcIssueTokenFee(params, symbol)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if params.IsPremiumSymbol(symbol) {
+		issueFee.Amount = sdk.NewDecFromInt(issueFee.Amount).Mul(params.PremiumFeeMultiplier).TruncateInt()
+	}
+	return issueFee, nil
 }
 
-// GetMintTokenFee returns the token minting fee
+// GetMintTokenFee returns the token minting fee, the fee overridden by
+// governance if any
 func (k BaseKeeper) GetMintTokenFee(ctx sdk.Context, symbol string) (sdk.Coin, error) {
+	if override, found := k.GetTokenFeeOverride(ctx, symbol); found {
+		return override.MintFee, nil
+	}
+
 	params := k.GetParams(ctx)
-	issueFees := k.calcIssueTokenFee(params, symbol)
+	issueFees, err := k.calcIssueTokenFee(params, symbol)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
 
 	mintFees := sdk.NewDecFromInt(issueFees.Amount).Mul(params.MintFeeRatio).TruncateInt()
 	return sdk.NewCoin(params.IssueFee.Denom, mintFees), nil
 }
 
-func (k BaseKeeper) calcIssueTokenFee(params types.Params, symbol string) sdk.Coin {
+func (k BaseKeeper) calcIssueTokenFee(params types.Params, symbol string) (sdk.Coin, error) {
 	issueTokenFee := params.IssueFee
 
-	fees := calcDataCost(symbol, issueTokenFee.Amount)
-	if fees.GT(sdk.NewDec(1)) {
-		return sdk.NewCoin(issueTokenFee.Denom, fees.TruncateInt())
+	fees, err := calcDataCost(symbol, issueTokenFee.Amount, params.FactorBase, params.FactorExp)
+	if err != nil {
+		return sdk.Coin{}, err
 	}
-	return sdk.NewCoin(issueTokenFee.Denom, sdk.OneInt())
+	return sdk.NewCoin(issueTokenFee.Denom, sdk.MaxInt(fees.TruncateInt(), params.MinIssueFee)), nil
 }
 
 // calcDataCost computes the actual cost according to the data and principal
 // The larger the data, the lower the cost
-func calcDataCost(data string, principal sdk.Int) sdk.Dec {
-	factor := getFactor(data)
-	return sdk.NewDecFromInt(principal).Quo(factor)
+func calcDataCost(data string, principal sdk.Int, base, exp uint32) (sdk.Dec, error) {
+	factor := getFactor(data, base, exp)
+	if !factor.IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidFeeFactor,
+			"factor base %d and exponent %d give a zero factor to %s", base, exp, data)
+	}
+	return sdk.NewDecFromInt(principal).Quo(factor), nil
 }
 
 // getFactor computes the factor
 // Note: make sure that the data size is examined before invoking the function
 // factor = (ln(len({data}))/ln{base})^{exp}
-func getFactor(data string) sdk.Dec {
+func getFactor(data string, base, exp uint32) sdk.Dec {
 	dataLen := len(data)
 	if dataLen == 0 {
 		panic("the length of data must be greater than 0")
 	}
 
-	numerator := math.Log(float64(dataLen))
-	denominator := math.Log(FactorBase)
-	factor := math.Pow(numerator/denominator, FactorExp)
-
-	factorDec, err := sdk.NewDecFromStr(strconv.FormatFloat(factor, 'f', 2, 64))
-	if err != nil {
-		panic("invalid factor string")
-	}
-
-	return factorDec
+	return types.FeeFactor(dataLen, base, exp)
 }
 
 // handleFees handles the fees of token
 func handleFees(ctx sdk.Context, k BaseKeeper, from sdk.AccAddress, feesCoin sdk.Coin) error {
+	// waived fees
+	if feesCoin.IsZero() {
+		return nil
+	}
+
 	params := k.GetParams(ctx)
 	tokenTax := params.TokenTax
 
@@ -87,8 +87,21 @@ func (k BaseSendKeeper) addToken(ctx sdk.Context, token types.Token) error {
 		k.storeTokenWithOwner(ctx, token.GetOwner(), token.GetSymbol())
 	}
 
+	k.setDenomMetaData(ctx, token)
+
+	return nil
+}
+
+// setDenomMetaData mirrors the token into the bank denom metadata, the token
+// description is preferred to its name when set
+func (k BaseSendKeeper) setDenomMetaData(ctx sdk.Context, token types.Token) {
+	description := token.GetDescription()
+	if len(description) == 0 {
+		description = token.GetName()
+	}
+
 	denomMetaData := banktypes.Metadata{
-		Description: token.GetName(),
+		Description: description,
 		Base: token.GetSmallestUnit(),
 		Display: token.GetSymbol(),
 		DenomUnits: []*banktypes.DenomUnit{
@@ -97,8 +110,6 @@ func (k BaseSendKeeper) addToken(ctx sdk.Context, token types.Token) error {
 		},
 	}
 	k.bankKeeper.SetDenomMetaData(ctx, denomMetaData)
-
-	return nil
 }
 
 func (k BaseSendKeeper) hasToken(ctx sdk.Context, token types.Token) bool {
@@ -140,6 +151,16 @@ func (k BaseSendKeeper) AddBurnedCoin(ctx sdk.Context, coin sdk.Coin) {
 	k.storeBurntCoin(ctx, coinL)
 }
 
+// addHolderBurnedCoin adds the coin burnt by a holder to the holders' part of the burned coins
+func (k BaseSendKeeper) addHolderBurnedCoin(ctx sdk.Context, coin sdk.Coin) {
+	var coinL = coin
+	if hasCoin, found := k.GetHolderBurntCoin(ctx, coin.Denom); found {
+		coinL = coinL.Add(hasCoin)
+	}
+
+	k.storeHolderBurntCoin(ctx, coinL)
+}
+
 // mintCoinsToAccount
 func (k BaseSendKeeper) mintCoinsToAccount(ctx sdk.Context, to sdk.AccAddress, coins sdk.Coins) error {
 	// mint coins into module account
@@ -172,7 +193,37 @@ func (k BaseSendKeeper) burnCoins(ctx sdk.Context, from sdk.AccAddress, coins sd
 }
 
 // unlockToken unlock the specialied token
-func (k BaseSendKeeper) unlockToken(ctx sdk.Context, symbol string, unlocked bool){
-	bkParams := k.bankKeeper.GetParams(ctx);
-	k.bankKeeper.SetParams(ctx, bkParams.SetSendEnabledParam(symbol, unlocked))
+func (k BaseSendKeeper) unlockToken(ctx sdk.Context, unit string, unlocked bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	if unlocked {
+		store.Delete(types.GetLockedTokenKey(unit))
+		return
+	}
+
+	store.Set(types.GetLockedTokenKey(unit), []byte{})
+}
+
+// pauseToken pauses or resumes the transfers of the specified token
+func (k BaseSendKeeper) pauseToken(ctx sdk.Context, unit string, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	if !paused {
+		store.Delete(types.GetPausedTokenKey(unit))
+		return
+	}
+
+	store.Set(types.GetPausedTokenKey(unit), []byte{})
+}
+
+// freezeAccount freezes or unfreezes the outgoing transfers of the specified token from the account
+func (k BaseSendKeeper) freezeAccount(ctx sdk.Context, unit string, addr sdk.AccAddress, frozen bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	if !frozen {
+		store.Delete(types.GetFrozenAccountKey(unit, addr))
+		return
+	}
+
+	store.Set(types.GetFrozenAccountKey(unit, addr), []byte{})
 }
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+func TestTokenSnapshot(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	owner := sdk.AccAddress(tmhash.SumTruncated([]byte("addrOne")))
+	holder := sdk.AccAddress(tmhash.SumTruncated([]byte("addrTwo")))
+
+	err := app.TokenKeeper.IssueToken(ctx, "Bitcoin Network", "btc", "satoshi", 8, 1000, 2000, true, true, false, false, owner)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoins(ctx, owner, holder, sdk.NewCoins(sdk.NewInt64Coin("satoshi", 400)))
+	require.NoError(t, err)
+
+	// the holders are paginated by address
+	res, err := app.TokenKeeper.Holders(sdk.WrapSDKContext(ctx), &types.QueryHoldersRequest{
+		Symbol:     "btc",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Holders, 1)
+	require.Equal(t, int64(10), res.Height)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+	require.NotEmpty(t, res.Pagination.NextKey)
+
+	next, err := app.TokenKeeper.Holders(sdk.WrapSDKContext(ctx), &types.QueryHoldersRequest{
+		Symbol:     "btc",
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, next.Holders, 1)
+	require.Empty(t, next.Pagination.NextKey)
+
+	balances := map[string]sdk.Coin{
+		res.Holders[0].Address:  res.Holders[0].Balance,
+		next.Holders[0].Address: next.Holders[0].Balance,
+	}
+	require.Equal(t, map[string]sdk.Coin{
+		owner.String():  sdk.NewInt64Coin("satoshi", 600),
+		holder.String(): sdk.NewInt64Coin("satoshi", 400),
+	}, balances)
+
+	// only the owner takes a snapshot
+	_, err = app.TokenKeeper.TakeTokenSnapshot(ctx, "btc", holder)
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+	_, err = app.TokenKeeper.TakeTokenSnapshot(ctx, "eth", owner)
+	require.ErrorIs(t, err, types.ErrTokenNotExists)
+
+	id, err := app.TokenKeeper.TakeTokenSnapshot(ctx, "btc", owner)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id)
+
+	snapshot, err := app.TokenKeeper.Snapshot(sdk.WrapSDKContext(ctx), &types.QuerySnapshotRequest{Id: id})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), snapshot.Snapshot.Height)
+	require.Equal(t, owner.String(), snapshot.Snapshot.Creator)
+
+	snapshots, err := app.TokenKeeper.Snapshots(sdk.WrapSDKContext(ctx), &types.QuerySnapshotsRequest{Symbol: "btc"})
+	require.NoError(t, err)
+	require.Equal(t, []types.TokenSnapshot{snapshot.Snapshot}, snapshots.Snapshots)
+
+	gs := app.TokenKeeper.ExportGenesis(ctx)
+	require.Len(t, gs.TokenSnapshots, 1)
+	require.Equal(t, uint64(2), gs.NextSnapshotId)
+	require.NoError(t, gs.Validate())
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -41,6 +43,176 @@ func (k BaseSendKeeper) storeBurntCoin(ctx sdk.Context, coin sdk.Coin) {
 	store.Set(types.GetBurntCoinKey(coin.Denom), bz)
 }
 
+// storeTokenRoles sets the roles of the token granted to the address, the
+// entry is removed once no role is left
+func (k BaseSendKeeper) storeTokenRoles(ctx sdk.Context, roles types.TokenRoles) {
+	store := ctx.KVStore(k.storeKey)
+
+	addr, _ := sdk.AccAddressFromBech32(roles.Address)
+	if len(roles.Roles) == 0 {
+		store.Delete(types.GetTokenRoleKey(addr, roles.Symbol))
+		return
+	}
+
+	bz := k.cdc.MustMarshalBinaryBare(&roles)
+	store.Set(types.GetTokenRoleKey(addr, roles.Symbol), bz)
+}
+
+// storeHolderBurntCoin
+func (k BaseSendKeeper) storeHolderBurntCoin(ctx sdk.Context, coin sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryBare(&coin)
+	store.Set(types.GetHolderBurntCoinKey(coin.Denom), bz)
+}
+
+// storeTokenVesting sets the token vesting and indexes it by beneficiary
+func (k BaseSendKeeper) storeTokenVesting(ctx sdk.Context, vesting types.TokenVesting) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryBare(&vesting)
+	store.Set(types.GetTokenVestingKey(vesting.Id), bz)
+
+	beneficiary, _ := sdk.AccAddressFromBech32(vesting.Beneficiary)
+	store.Set(types.GetBeneficiaryVestingKey(beneficiary, vesting.Id), []byte{})
+}
+
+// deleteTokenVesting removes the token vesting and its beneficiary index
+func (k BaseSendKeeper) deleteTokenVesting(ctx sdk.Context, vesting types.TokenVesting) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetTokenVestingKey(vesting.Id))
+
+	beneficiary, _ := sdk.AccAddressFromBech32(vesting.Beneficiary)
+	store.Delete(types.GetBeneficiaryVestingKey(beneficiary, vesting.Id))
+}
+
+// insertVestingQueue schedules the next release of the token vesting
+func (k BaseSendKeeper) insertVestingQueue(ctx sdk.Context, id uint64, releaseTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetVestingQueueKey(releaseTime, id), []byte{})
+}
+
+// dequeueDueVestings removes the token vestings due at the block time from the
+// queue and returns their ids
+func (k BaseSendKeeper) dequeueDueVestings(ctx sdk.Context) (ids []uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	end := sdk.PrefixEndBytes(types.GetVestingQueueTimeKey(ctx.BlockTime()))
+	iter := store.Iterator(types.VestingQueuePrefix, end)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		ids = append(ids, sdk.BigEndianToUint64(key[len(key)-8:]))
+		keys = append(keys, key)
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return ids
+}
+
+// setNextVestingID sets the id of the next token vesting
+func (k BaseSendKeeper) setNextVestingID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextVestingIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// storeTokenSnapshot sets the token snapshot and indexes it by symbol
+func (k BaseSendKeeper) storeTokenSnapshot(ctx sdk.Context, snapshot types.TokenSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryBare(&snapshot)
+	store.Set(types.GetTokenSnapshotKey(snapshot.Id), bz)
+	store.Set(types.GetSymbolSnapshotKey(snapshot.Symbol, snapshot.Id), []byte{})
+}
+
+// setNextSnapshotID sets the id of the next token snapshot
+func (k BaseSendKeeper) setNextSnapshotID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextSnapshotIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// storeTokenDistribution sets the token distribution and indexes it by symbol and end time
+func (k BaseSendKeeper) storeTokenDistribution(ctx sdk.Context, distribution types.TokenDistribution) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryBare(&distribution)
+	store.Set(types.GetTokenDistributionKey(distribution.Id), bz)
+	store.Set(types.GetSymbolDistributionKey(distribution.Symbol, distribution.Id), []byte{})
+	store.Set(types.GetDistributionQueueKey(distribution.EndTime, distribution.Id), []byte{})
+}
+
+// deleteTokenDistribution removes the token distribution, its indexes and its claims
+func (k BaseSendKeeper) deleteTokenDistribution(ctx sdk.Context, distribution types.TokenDistribution) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetTokenDistributionKey(distribution.Id))
+	store.Delete(types.GetSymbolDistributionKey(distribution.Symbol, distribution.Id))
+	store.Delete(types.GetDistributionQueueKey(distribution.EndTime, distribution.Id))
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetDistributionClaimsKey(distribution.Id))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// endedDistributionIDs returns the ids of the token distributions in the queue
+// ended at the block time
+func (k BaseSendKeeper) endedDistributionIDs(ctx sdk.Context) (ids []uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	end := sdk.PrefixEndBytes(types.GetDistributionQueueTimeKey(ctx.BlockTime()))
+	iter := store.Iterator(types.DistributionQueuePrefix, end)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		ids = append(ids, sdk.BigEndianToUint64(key[len(key)-8:]))
+	}
+	return ids
+}
+
+// storeDistributionClaim sets the amount of the token distribution claimed by the address
+func (k BaseSendKeeper) storeDistributionClaim(ctx sdk.Context, claim types.DistributionClaim) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryBare(&claim)
+	addr, _ := sdk.AccAddressFromBech32(claim.Address)
+	store.Set(types.GetDistributionClaimKey(claim.DistributionId, addr), bz)
+}
+
+// setNextDistributionID sets the id of the next token distribution
+func (k BaseSendKeeper) setNextDistributionID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextDistributionIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// storeTokenFeeOverride sets the fees of the symbol overridden by governance
+func (k BaseSendKeeper) storeTokenFeeOverride(ctx sdk.Context, override types.TokenFeeOverride) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryBare(&override)
+	store.Set(types.GetFeeOverrideKey(override.Symbol), bz)
+}
+
+// storeSymbolReservation reserves the symbol for the address
+func (k BaseSendKeeper) storeSymbolReservation(ctx sdk.Context, reservation types.SymbolReservation) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryBare(&reservation)
+	store.Set(types.GetSymbolReservationKey(reservation.Symbol), bz)
+}
+
 // reset all indices by the new owner for token query
 func (k BaseSendKeeper) resetTokenOwner(ctx sdk.Context, symbol string, oldOwner, newOwner sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
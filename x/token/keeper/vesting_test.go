@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/token/keeper"
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+func TestTokenVesting(t *testing.T) {
+	app := simapp.Setup(false)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: start})
+
+	owner := sdk.AccAddress(tmhash.SumTruncated([]byte("addrOne")))
+	beneficiary := sdk.AccAddress(tmhash.SumTruncated([]byte("addrTwo")))
+
+	err := app.TokenKeeper.IssueToken(ctx, "Bitcoin Network", "btc", "satoshi", 8, 1000, 2000, true, true, false, false, owner)
+	require.NoError(t, err)
+
+	amount := sdk.NewInt64Coin("satoshi", 400)
+	cliff, end := start.Add(100*time.Hour), start.Add(400*time.Hour)
+
+	// only the tokens can be vested and the sender must hold the amount
+	_, err = app.TokenKeeper.CreateTokenVesting(ctx, owner, beneficiary, sdk.NewInt64Coin("stake", 400), start, cliff, end)
+	require.ErrorIs(t, err, types.ErrTokenNotExists)
+	_, err = app.TokenKeeper.CreateTokenVesting(ctx, beneficiary, owner, amount, start, cliff, end)
+	require.Error(t, err)
+	_, err = app.TokenKeeper.CreateTokenVesting(ctx, owner, beneficiary, sdk.NewInt64Coin("satoshi", types.DefaultMinVestingAmount-1), start, cliff, end)
+	require.ErrorIs(t, err, types.ErrInvalidVesting)
+
+	id, err := app.TokenKeeper.CreateTokenVesting(ctx, owner, beneficiary, amount, start, cliff, end)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id)
+	require.Equal(t, uint64(2), app.TokenKeeper.GetNextVestingID(ctx))
+	require.Equal(t, sdk.NewInt(600), app.BankKeeper.GetBalance(ctx, owner, "satoshi").Amount)
+
+	invariant := keeper.ModuleAccountInvariant(app.TokenKeeper, app.BankKeeper)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	balanceAt := func(blockTime time.Time) sdk.Int {
+		ctx = ctx.WithBlockTime(blockTime)
+		app.TokenKeeper.ReleaseVestedTokens(ctx)
+		return app.BankKeeper.GetBalance(ctx, beneficiary, "satoshi").Amount
+	}
+
+	// nothing is released before the cliff, then the amount vests linearly from the start
+	// and is released every release interval
+	require.True(t, balanceAt(cliff.Add(-time.Second)).IsZero())
+	require.Equal(t, sdk.NewInt(100), balanceAt(cliff))
+	require.Equal(t, sdk.NewInt(100), balanceAt(cliff.Add(types.VestingReleaseInterval/2)))
+	require.Equal(t, sdk.NewInt(200), balanceAt(start.Add(200*time.Hour)))
+
+	res, err := app.TokenKeeper.Vestings(sdk.WrapSDKContext(ctx), &types.QueryVestingsRequest{Beneficiary: beneficiary.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Balances, 1)
+	require.Equal(t, sdk.NewInt64Coin("satoshi", 200), res.Balances[0].Vested)
+	require.Equal(t, sdk.NewInt64Coin("satoshi", 200), res.Balances[0].Unvested)
+	require.Equal(t, sdk.NewInt64Coin("satoshi", 200), res.Balances[0].Vesting.Released)
+
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	gs := app.TokenKeeper.ExportGenesis(ctx)
+	require.Len(t, gs.TokenVestings, 1)
+	require.Equal(t, uint64(2), gs.NextVestingId)
+	require.NoError(t, gs.Validate())
+
+	// the vestings of a paused token are released at their next release once resumed
+	require.NoError(t, app.TokenKeeper.PauseToken(ctx, "btc", owner))
+	require.Equal(t, sdk.NewInt(200), balanceAt(start.Add(300*time.Hour)))
+	require.NoError(t, app.TokenKeeper.UnpauseToken(ctx, "btc", owner))
+	require.Equal(t, sdk.NewInt(200), balanceAt(start.Add(300*time.Hour)))
+	require.Equal(t, sdk.NewInt(301), balanceAt(start.Add(300*time.Hour).Add(types.VestingReleaseInterval)))
+
+	// a vesting paused past its end time is retried once per release interval, not every block
+	require.NoError(t, app.TokenKeeper.PauseToken(ctx, "btc", owner))
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	pausedAt := end.Add(time.Minute)
+	for block := 0; block < 5; block++ {
+		require.Equal(t, sdk.NewInt(301), balanceAt(pausedAt.Add(time.Duration(block)*time.Minute)))
+		require.True(t, store.Has(types.GetVestingQueueKey(pausedAt.Add(types.VestingReleaseInterval), id)))
+	}
+	require.NoError(t, app.TokenKeeper.UnpauseToken(ctx, "btc", owner))
+
+	// the fully released vesting is removed
+	require.Equal(t, sdk.NewInt(400), balanceAt(pausedAt.Add(types.VestingReleaseInterval)))
+	_, found := app.TokenKeeper.GetTokenVesting(ctx, id)
+	require.False(t, found)
+
+	res, err = app.TokenKeeper.Vestings(sdk.WrapSDKContext(ctx), &types.QueryVestingsRequest{Beneficiary: beneficiary.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Balances)
+
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
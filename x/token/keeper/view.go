@@ -19,11 +19,44 @@ import (
 type ViewKeeper interface {
 	GetTokens(ctx sdk.Context, owner sdk.AccAddress) (tokens []types.TokenI)
 	GetToken(ctx sdk.Context, denom string) (types.TokenI, error)
+	GetTokenWithUnit(ctx sdk.Context, unit string) (types.TokenI, error)
 	HasToken(ctx sdk.Context, denom string) bool
+	HasTokenWithUnit(ctx sdk.Context, unit string) bool
 	GetOwner(ctx sdk.Context, denom string) (sdk.AccAddress, error)
 	GetBurntCoin(ctx sdk.Context, denom string) (sdk.Coin, bool)
         GetAllBurntCoins(ctx sdk.Context) sdk.Coins
+	GetHolderBurntCoin(ctx sdk.Context, denom string) (sdk.Coin, bool)
+	GetAllHolderBurntCoins(ctx sdk.Context) sdk.Coins
 	IsUnlocked(ctx sdk.Context, denom string) bool
+	GetLockedTokens(ctx sdk.Context) []string
+	IsPaused(ctx sdk.Context, denom string) bool
+	GetPausedTokens(ctx sdk.Context) []string
+	ValidateNotPaused(ctx sdk.Context, denoms ...string) error
+	IsFrozen(ctx sdk.Context, denom string, addr sdk.AccAddress) bool
+	ValidateTransfer(ctx sdk.Context, sender sdk.AccAddress, coins sdk.Coins) error
+	HasTokenRole(ctx sdk.Context, symbol string, role types.TokenRole, addr sdk.AccAddress) bool
+	GetTokenRoles(ctx sdk.Context, addr sdk.AccAddress) []types.TokenRoles
+	GetTokenVesting(ctx sdk.Context, id uint64) (types.TokenVesting, bool)
+	GetNextVestingID(ctx sdk.Context) uint64
+	GetTokenFeeOverride(ctx sdk.Context, symbol string) (types.TokenFeeOverride, bool)
+	GetSymbolReservation(ctx sdk.Context, symbol string) (types.SymbolReservation, bool)
+	ValidateSymbolReservation(ctx sdk.Context, symbol string, owner sdk.AccAddress) error
+	GetTokenSnapshot(ctx sdk.Context, id uint64) (types.TokenSnapshot, bool)
+	GetNextSnapshotID(ctx sdk.Context) uint64
+	GetTokenDistribution(ctx sdk.Context, id uint64) (types.TokenDistribution, bool)
+	GetNextDistributionID(ctx sdk.Context) uint64
+	GetDistributionClaim(ctx sdk.Context, id uint64, addr sdk.AccAddress) (types.DistributionClaim, bool)
+
+	IterateTokenUnits(ctx sdk.Context, cb func(unit, symbol string) (stop bool))
+	IterateTokenOwners(ctx sdk.Context, cb func(owner sdk.AccAddress, symbol string) (stop bool))
+	IterateTokenRoles(ctx sdk.Context, cb func(roles types.TokenRoles) (stop bool))
+	IterateFrozenAccounts(ctx sdk.Context, cb func(denom string, addr sdk.AccAddress) (stop bool))
+	IterateTokenVestings(ctx sdk.Context, cb func(vesting types.TokenVesting) (stop bool))
+	IterateTokenFeeOverrides(ctx sdk.Context, cb func(override types.TokenFeeOverride) (stop bool))
+	IterateSymbolReservations(ctx sdk.Context, cb func(reservation types.SymbolReservation) (stop bool))
+	IterateTokenSnapshots(ctx sdk.Context, cb func(snapshot types.TokenSnapshot) (stop bool))
+	IterateTokenDistributions(ctx sdk.Context, cb func(distribution types.TokenDistribution) (stop bool))
+	IterateDistributionClaims(ctx sdk.Context, cb func(claim types.DistributionClaim) (stop bool))
 }
 
 var _ ViewKeeper = (*BaseViewKeeper)(nil)
@@ -91,6 +124,44 @@ func (k BaseViewKeeper) GetTokens(ctx sdk.Context, owner sdk.AccAddress) (tokens
 	return k.getAllTokenOfOwner(ctx, store, owner)
 }
 
+// IterateTokenUnits iterates over the smallest-unit index of the tokens
+func (k BaseViewKeeper) IterateTokenUnits(ctx sdk.Context, cb func(unit, symbol string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.UnitPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var symbol gogotypes.StringValue
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &symbol)
+
+		unit := string(iter.Key()[len(types.UnitPrefix):])
+		if cb(unit, symbol.Value) {
+			break
+		}
+	}
+}
+
+// IterateTokenOwners iterates over the owner index of the tokens
+func (k BaseViewKeeper) IterateTokenOwners(ctx sdk.Context, cb func(owner sdk.AccAddress, symbol string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.OwnerSymbolKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var symbol gogotypes.StringValue
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &symbol)
+
+		// the key is the prefix followed by the owner and the symbol
+		key := iter.Key()
+		owner := sdk.AccAddress(key[len(types.OwnerSymbolKey) : len(key)-len(symbol.Value)])
+		if cb(owner, symbol.Value) {
+			break
+		}
+	}
+}
+
 func (k BaseViewKeeper) GetOwner(ctx sdk.Context, symbol string) (sdk.AccAddress, error) {
 	token, err := k.GetToken(ctx, symbol)
 	if err != nil {
@@ -127,6 +198,35 @@ func (k BaseViewKeeper) GetAllBurntCoins(ctx sdk.Context) sdk.Coins {
 	return coins
 }
 
+// GetHolderBurntCoin returns the coin of the specified unit burnt by the token holders
+func (k BaseViewKeeper) GetHolderBurntCoin(ctx sdk.Context, denom string) (coin sdk.Coin, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetHolderBurntCoinKey(denom))
+	if len(bz) == 0 {
+		return coin, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &coin)
+	return coin, true
+}
+
+// GetAllHolderBurntCoins returns the coins burnt by the holders of all tokens
+func (k BaseViewKeeper) GetAllHolderBurntCoins(ctx sdk.Context) (coins sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.HolderBurntCoinPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var coin sdk.Coin
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &coin)
+		coins = append(coins, coin)
+	}
+
+	return
+}
+
 func (k BaseViewKeeper) getTokenBySymbol(ctx sdk.Context, symbol string) (token types.Token, err error) {
 	store := ctx.KVStore(k.storeKey)
 
@@ -190,7 +290,386 @@ func (k BaseViewKeeper) getAllTokenOfOwner(
 
 // use smallest-unit
 func (k BaseViewKeeper) IsUnlocked(ctx sdk.Context, denom string) bool {
-	return k.bankKeeper.SendEnabledCoin(ctx, sdk.Coin{Denom:denom, Amount: sdk.ZeroInt()})
+	store := ctx.KVStore(k.storeKey)
+	return !store.Has(types.GetLockedTokenKey(denom))
+}
+
+// GetLockedTokens returns the smallest units of all locked tokens
+func (k BaseViewKeeper) GetLockedTokens(ctx sdk.Context) (units []string) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.LockedTokenPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		units = append(units, string(iter.Key()[len(types.LockedTokenPrefix):]))
+	}
+
+	return
+}
+
+// IsPaused returns whether the transfers of the token with the specified smallest unit are paused
+func (k BaseViewKeeper) IsPaused(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetPausedTokenKey(denom))
+}
+
+// GetPausedTokens returns the smallest units of all paused tokens
+func (k BaseViewKeeper) GetPausedTokens(ctx sdk.Context) (units []string) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.PausedTokenPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		units = append(units, string(iter.Key()[len(types.PausedTokenPrefix):]))
+	}
+
+	return
+}
+
+// ValidateNotPaused checks that none of the given smallest units belongs to a paused token
+func (k BaseViewKeeper) ValidateNotPaused(ctx sdk.Context, denoms ...string) error {
+	for _, denom := range denoms {
+		if k.IsPaused(ctx, denom) {
+			return sdkerrors.Wrapf(types.ErrTokenPaused, "the transfers of %s are paused", denom)
+		}
+	}
+
+	return nil
+}
+
+// IsFrozen returns whether the account is frozen for the token with the specified smallest unit
+func (k BaseViewKeeper) IsFrozen(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetFrozenAccountKey(denom, addr))
+}
+
+// IterateFrozenAccounts iterates through all the frozen accounts
+func (k BaseViewKeeper) IterateFrozenAccounts(ctx sdk.Context, cb func(denom string, addr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.FrozenAccountPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(types.SplitFrozenAccountKey(iter.Key())) {
+			break
+		}
+	}
+}
+
+// ValidateTransfer checks that the coins sent by the sender contain no paused
+// token, no token for which the sender is frozen, nor any locked token unless
+// the sender is the owner of that token
+func (k BaseViewKeeper) ValidateTransfer(ctx sdk.Context, sender sdk.AccAddress, coins sdk.Coins) error {
+	for _, coin := range coins {
+		if err := k.ValidateNotPaused(ctx, coin.Denom); err != nil {
+			return err
+		}
+
+		if k.IsFrozen(ctx, coin.Denom, sender) {
+			return sdkerrors.Wrapf(types.ErrAccountFrozen, "%s is frozen for %s", sender, coin.Denom)
+		}
+
+		if k.IsUnlocked(ctx, coin.Denom) {
+			continue
+		}
+
+		token, err := k.GetTokenWithUnit(ctx, coin.Denom)
+		if err != nil {
+			return err
+		}
+
+		if !token.GetOwner().Equals(sender) {
+			return sdkerrors.Wrapf(types.ErrTokenLocked,
+				"the token[%s] can only be transferred by its owner", token.GetSymbol())
+		}
+	}
+
+	return nil
+}
+
+// getTokenRoles returns the roles of the token granted to the address
+func (k BaseViewKeeper) getTokenRoles(ctx sdk.Context, symbol string, addr sdk.AccAddress) (roles types.TokenRoles, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenRoleKey(addr, symbol))
+	if bz == nil {
+		return roles, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &roles)
+	return roles, true
+}
+
+// HasTokenRole asserts the role of the token has been granted to the address
+func (k BaseViewKeeper) HasTokenRole(ctx sdk.Context, symbol string, role types.TokenRole, addr sdk.AccAddress) bool {
+	roles, found := k.getTokenRoles(ctx, symbol, addr)
+	return found && roles.HasRole(role)
+}
+
+// GetTokenRoles returns the roles of all tokens granted to the address
+func (k BaseViewKeeper) GetTokenRoles(ctx sdk.Context, addr sdk.AccAddress) (tokenRoles []types.TokenRoles) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetTokenRoleKey(addr, ""))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var roles types.TokenRoles
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &roles)
+
+		// the prefix of a longer address may match the address
+		if roles.Address != addr.String() {
+			continue
+		}
+		tokenRoles = append(tokenRoles, roles)
+	}
+
+	return
+}
+
+// IterateTokenRoles iterates over the roles of all tokens
+func (k BaseViewKeeper) IterateTokenRoles(ctx sdk.Context, cb func(roles types.TokenRoles) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.TokenRoleKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var roles types.TokenRoles
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &roles)
+
+		if cb(roles) {
+			break
+		}
+	}
+}
+
+// GetTokenVesting returns the token vesting with the specified id
+func (k BaseViewKeeper) GetTokenVesting(ctx sdk.Context, id uint64) (vesting types.TokenVesting, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenVestingKey(id))
+	if bz == nil {
+		return vesting, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &vesting)
+	return vesting, true
+}
+
+// GetNextVestingID returns the id of the next token vesting
+func (k BaseViewKeeper) GetNextVestingID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.NextVestingIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IterateTokenVestings iterates over all the token vestings by id
+func (k BaseViewKeeper) IterateTokenVestings(ctx sdk.Context, cb func(vesting types.TokenVesting) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.TokenVestingPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var vesting types.TokenVesting
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &vesting)
+
+		if cb(vesting) {
+			break
+		}
+	}
+}
+
+// GetTokenFeeOverride returns the fees of the symbol overridden by governance
+func (k BaseViewKeeper) GetTokenFeeOverride(ctx sdk.Context, symbol string) (override types.TokenFeeOverride, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetFeeOverrideKey(symbol))
+	if bz == nil {
+		return override, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &override)
+	return override, true
+}
+
+// IterateTokenFeeOverrides iterates over all the fee overrides by symbol
+func (k BaseViewKeeper) IterateTokenFeeOverrides(ctx sdk.Context, cb func(override types.TokenFeeOverride) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.FeeOverridePrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var override types.TokenFeeOverride
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &override)
+
+		if cb(override) {
+			break
+		}
+	}
+}
+
+// GetSymbolReservation returns the address the symbol is reserved for by governance
+func (k BaseViewKeeper) GetSymbolReservation(ctx sdk.Context, symbol string) (reservation types.SymbolReservation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetSymbolReservationKey(symbol))
+	if bz == nil {
+		return reservation, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &reservation)
+	return reservation, true
+}
+
+// IterateSymbolReservations iterates over all the symbol reservations by symbol
+func (k BaseViewKeeper) IterateSymbolReservations(ctx sdk.Context, cb func(reservation types.SymbolReservation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.SymbolReservationPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var reservation types.SymbolReservation
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &reservation)
+
+		if cb(reservation) {
+			break
+		}
+	}
+}
+
+// ValidateSymbolReservation returns an error if the symbol is reserved for an address other than the owner
+func (k BaseViewKeeper) ValidateSymbolReservation(ctx sdk.Context, symbol string, owner sdk.AccAddress) error {
+	reservation, found := k.GetSymbolReservation(ctx, symbol)
+	if found && reservation.Address != owner.String() {
+		return sdkerrors.Wrapf(types.ErrSymbolReserved, "symbol %s is reserved for %s", symbol, reservation.Address)
+	}
+	return nil
+}
+
+// GetTokenSnapshot returns the token snapshot with the specified id
+func (k BaseViewKeeper) GetTokenSnapshot(ctx sdk.Context, id uint64) (snapshot types.TokenSnapshot, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenSnapshotKey(id))
+	if bz == nil {
+		return snapshot, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &snapshot)
+	return snapshot, true
+}
+
+// GetNextSnapshotID returns the id of the next token snapshot
+func (k BaseViewKeeper) GetNextSnapshotID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.NextSnapshotIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IterateTokenSnapshots iterates over all the token snapshots by id
+func (k BaseViewKeeper) IterateTokenSnapshots(ctx sdk.Context, cb func(snapshot types.TokenSnapshot) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.TokenSnapshotPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var snapshot types.TokenSnapshot
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &snapshot)
+
+		if cb(snapshot) {
+			break
+		}
+	}
+}
+
+// GetTokenDistribution returns the token distribution with the specified id
+func (k BaseViewKeeper) GetTokenDistribution(ctx sdk.Context, id uint64) (distribution types.TokenDistribution, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenDistributionKey(id))
+	if bz == nil {
+		return distribution, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &distribution)
+	return distribution, true
+}
+
+// GetNextDistributionID returns the id of the next token distribution
+func (k BaseViewKeeper) GetNextDistributionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.NextDistributionIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetDistributionClaim returns the amount of the token distribution claimed by the address
+func (k BaseViewKeeper) GetDistributionClaim(ctx sdk.Context, id uint64, addr sdk.AccAddress) (claim types.DistributionClaim, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetDistributionClaimKey(id, addr))
+	if bz == nil {
+		return claim, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &claim)
+	return claim, true
+}
+
+// IterateTokenDistributions iterates over all the token distributions by id
+func (k BaseViewKeeper) IterateTokenDistributions(ctx sdk.Context, cb func(distribution types.TokenDistribution) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.TokenDistributionPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var distribution types.TokenDistribution
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &distribution)
+
+		if cb(distribution) {
+			break
+		}
+	}
+}
+
+// IterateDistributionClaims iterates over all the distribution claims by distribution id
+func (k BaseViewKeeper) IterateDistributionClaims(ctx sdk.Context, cb func(claim types.DistributionClaim) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.DistributionClaimPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var claim types.DistributionClaim
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &claim)
+
+		if cb(claim) {
+			break
+		}
+	}
 }
 
 // getTokenSupply queries the token supply from the total supply
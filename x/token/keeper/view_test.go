@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/gauss/gauss/v4/simapp"
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+func TestValidateTransfer(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	owner := sdk.AccAddress(tmhash.SumTruncated([]byte("addrOne")))
+	holder := sdk.AccAddress(tmhash.SumTruncated([]byte("addrTwo")))
+
+	err := app.TokenKeeper.IssueToken(ctx, "Bitcoin Network", "btc", "satoshi", 8, 1000, 2000, true, false, false, false, owner)
+	require.NoError(t, err)
+	require.False(t, app.TokenKeeper.IsUnlocked(ctx, "satoshi"))
+	require.Equal(t, []string{"satoshi"}, app.TokenKeeper.GetLockedTokens(ctx))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("satoshi", 10), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	// only the owner can transfer a locked token
+	require.NoError(t, app.TokenKeeper.ValidateTransfer(ctx, owner, coins))
+	err = app.TokenKeeper.ValidateTransfer(ctx, holder, coins)
+	require.ErrorIs(t, err, types.ErrTokenLocked)
+
+	// the native denom is never locked
+	require.NoError(t, app.TokenKeeper.ValidateTransfer(ctx, holder, coins[1:]))
+
+	require.NoError(t, app.TokenKeeper.UnlockToken(ctx, "btc", owner))
+	require.True(t, app.TokenKeeper.IsUnlocked(ctx, "satoshi"))
+	require.Empty(t, app.TokenKeeper.GetLockedTokens(ctx))
+	require.NoError(t, app.TokenKeeper.ValidateTransfer(ctx, holder, coins))
+}
+
+func TestMigrateLockedTokens(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	owner := sdk.AccAddress(tmhash.SumTruncated([]byte("addrOne")))
+
+	err := app.TokenKeeper.IssueToken(ctx, "Bitcoin Network", "btc", "satoshi", 8, 1000, 2000, true, true, false, false, owner)
+	require.NoError(t, err)
+	err = app.TokenKeeper.IssueToken(ctx, "Ether Network", "eth", "wei", 8, 1000, 2000, true, true, false, false, owner)
+	require.NoError(t, err)
+
+	// the locks of the issued tokens were kept in the bank send enabled params
+	bankParams := app.BankKeeper.GetParams(ctx)
+	bankParams = bankParams.SetSendEnabledParam("satoshi", false)
+	bankParams = bankParams.SetSendEnabledParam("wei", true)
+	bankParams = bankParams.SetSendEnabledParam("other", false)
+	app.BankKeeper.SetParams(ctx, bankParams)
+
+	// the migration locks them in the token store and leaves the other denoms to the bank
+	app.TokenKeeper.MigrateStore(ctx)
+	require.False(t, app.TokenKeeper.IsUnlocked(ctx, "satoshi"))
+	require.True(t, app.TokenKeeper.IsUnlocked(ctx, "wei"))
+	require.Equal(t, []string{"satoshi"}, app.TokenKeeper.GetLockedTokens(ctx))
+	require.Equal(t, []*banktypes.SendEnabled{
+		banktypes.NewSendEnabled("wei", true), banktypes.NewSendEnabled("other", false),
+	}, app.BankKeeper.GetParams(ctx).SendEnabled)
+
+	// the owner can transfer the migrated locked token
+	require.True(t, app.BankKeeper.SendEnabledCoin(ctx, sdk.NewInt64Coin("satoshi", 10)))
+	require.NoError(t, app.TokenKeeper.ValidateTransfer(ctx, owner, sdk.NewCoins(sdk.NewInt64Coin("satoshi", 10))))
+
+	// only the upgrade migrates the locks, not the genesis import
+	gs := app.TokenKeeper.ExportGenesis(ctx)
+	gs.LockedTokens = []string{}
+	app = simapp.Setup(false)
+	ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	app.BankKeeper.SetParams(ctx, bankParams)
+	app.TokenKeeper.InitGenesis(ctx, gs)
+	require.Empty(t, app.TokenKeeper.GetLockedTokens(ctx))
+	require.Len(t, app.BankKeeper.GetParams(ctx).SendEnabled, 3)
+}
+
+func TestPauseToken(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	owner := sdk.AccAddress(tmhash.SumTruncated([]byte("addrOne")))
+	pauser := sdk.AccAddress(tmhash.SumTruncated([]byte("addrTwo")))
+
+	err := app.TokenKeeper.IssueToken(ctx, "Bitcoin Network", "btc", "satoshi", 8, 1000, 2000, true, true, false, false, owner)
+	require.NoError(t, err)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("satoshi", 10))
+
+	// only the owner or a pauser pauses the token
+	err = app.TokenKeeper.PauseToken(ctx, "btc", pauser)
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+	require.NoError(t, app.TokenKeeper.GrantTokenRole(ctx, "btc", types.RolePauser, pauser, owner))
+	require.NoError(t, app.TokenKeeper.PauseToken(ctx, "btc", pauser))
+	err = app.TokenKeeper.PauseToken(ctx, "btc", owner)
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+	require.True(t, app.TokenKeeper.IsPaused(ctx, "satoshi"))
+	require.Equal(t, []string{"satoshi"}, app.TokenKeeper.GetPausedTokens(ctx))
+
+	// not even the owner transfers a paused token
+	err = app.TokenKeeper.ValidateTransfer(ctx, owner, coins)
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+	err = app.TokenKeeper.ValidateNotPaused(ctx, sdk.DefaultBondDenom, "satoshi")
+	require.ErrorIs(t, err, types.ErrTokenPaused)
+
+	res, err := app.TokenKeeper.Token(sdk.WrapSDKContext(ctx), &types.QueryTokenRequest{Symbol: "btc"})
+	require.NoError(t, err)
+	require.True(t, res.Paused)
+
+	gs := app.TokenKeeper.ExportGenesis(ctx)
+	require.Equal(t, []string{"satoshi"}, gs.PausedTokens)
+	require.NoError(t, gs.Validate())
+
+	require.NoError(t, app.TokenKeeper.UnpauseToken(ctx, "btc", owner))
+	err = app.TokenKeeper.UnpauseToken(ctx, "btc", pauser)
+	require.ErrorIs(t, err, types.ErrTokenNotPaused)
+	require.False(t, app.TokenKeeper.IsPaused(ctx, "satoshi"))
+	require.Empty(t, app.TokenKeeper.GetPausedTokens(ctx))
+	require.NoError(t, app.TokenKeeper.ValidateTransfer(ctx, owner, coins))
+}
+
+func TestFreezeAccount(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	owner := sdk.AccAddress(tmhash.SumTruncated([]byte("addrOne")))
+	holder := sdk.AccAddress(tmhash.SumTruncated([]byte("addrTwo")))
+
+	err := app.TokenKeeper.IssueToken(ctx, "Bitcoin Network", "btc", "satoshi", 8, 1000, 2000, true, true, false, false, owner)
+	require.NoError(t, err)
+	err = app.TokenKeeper.IssueToken(ctx, "USD Coin", "usdc", "uusdc", 6, 1000, 2000, true, true, false, true, owner)
+	require.NoError(t, err)
+
+	// only the owner freezes an account of a freezable token
+	err = app.TokenKeeper.FreezeAccount(ctx, "btc", holder, owner)
+	require.ErrorIs(t, err, types.ErrNotFreezable)
+	err = app.TokenKeeper.FreezeAccount(ctx, "usdc", holder, holder)
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+	require.NoError(t, app.TokenKeeper.FreezeAccount(ctx, "usdc", holder, owner))
+	err = app.TokenKeeper.FreezeAccount(ctx, "usdc", holder, owner)
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+	require.True(t, app.TokenKeeper.IsFrozen(ctx, "uusdc", holder))
+
+	// the frozen account can neither send the token nor is any other account affected
+	err = app.TokenKeeper.ValidateTransfer(ctx, holder, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 10)))
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+	require.NoError(t, app.TokenKeeper.ValidateTransfer(ctx, holder, sdk.NewCoins(sdk.NewInt64Coin("satoshi", 10))))
+	require.NoError(t, app.TokenKeeper.ValidateTransfer(ctx, owner, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 10))))
+
+	res, err := app.TokenKeeper.FrozenAccounts(sdk.WrapSDKContext(ctx), &types.QueryFrozenAccountsRequest{Symbol: "usdc"})
+	require.NoError(t, err)
+	require.Equal(t, []string{holder.String()}, res.Addresses)
+	res, err = app.TokenKeeper.FrozenAccounts(sdk.WrapSDKContext(ctx), &types.QueryFrozenAccountsRequest{Symbol: "btc"})
+	require.NoError(t, err)
+	require.Empty(t, res.Addresses)
+
+	gs := app.TokenKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.FrozenAccount{{Denom: "uusdc", Address: holder.String()}}, gs.FrozenAccounts)
+	require.NoError(t, gs.Validate())
+
+	require.NoError(t, app.TokenKeeper.UnfreezeAccount(ctx, "usdc", holder, owner))
+	err = app.TokenKeeper.UnfreezeAccount(ctx, "usdc", holder, owner)
+	require.ErrorIs(t, err, types.ErrAccountNotFrozen)
+	require.NoError(t, app.TokenKeeper.ValidateTransfer(ctx, holder, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 10))))
+}
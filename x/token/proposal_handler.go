@@ -0,0 +1,26 @@
+package token
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/gauss/gauss/v4/x/token/keeper"
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+// NewTokenProposalHandler creates a new governance Handler for the token proposals
+func NewTokenProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.TokenFeeOverrideProposal:
+			return k.HandleTokenFeeOverrideProposal(ctx, c)
+
+		case *types.SymbolReservationProposal:
+			return k.HandleSymbolReservationProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized token proposal content type: %T", c)
+		}
+	}
+}
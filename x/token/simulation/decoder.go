@@ -28,6 +28,11 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &symbolA)
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &symbolB)
 			return fmt.Sprintf("%v\n%v", symbolA, symbolB)
+		case bytes.Equal(kvA.Key[:1], types.TokenRoleKey):
+			var rolesA, rolesB types.TokenRoles
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &rolesA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &rolesB)
+			return fmt.Sprintf("%v\n%v", rolesA, rolesB)
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
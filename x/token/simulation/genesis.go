@@ -9,15 +9,22 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
 	"github.com/gauss/gauss/v4/x/token/types"
 )
 
 // Simulation parameter constants
 const (
-	CommuniteTax      = "communite_tax"
-	IssueTokenFee     = "issue_token_fee"
-	MintTokenFeeRatio = "mint_token_fee_ratio"
+	CommuniteTax         = "communite_tax"
+	IssueTokenFee        = "issue_token_fee"
+	MintTokenFeeRatio    = "mint_token_fee_ratio"
+	FactorBase           = "factor_base"
+	FactorExp            = "factor_exp"
+	MinIssueFee          = "min_issue_fee"
+	PremiumSymbolLen     = "premium_symbol_len"
+	PremiumFeeMultiplier = "premium_fee_multiplier"
+	MinVestingAmount     = "min_vesting_amount"
 )
 
 // RandomDec randomized sdk.RandomDec
@@ -36,6 +43,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var communiteTax sdk.Dec
 	var issueTokenFee sdk.Int
 	var mintTokenFeeRatio sdk.Dec
+	var factorBase, factorExp uint32
+	var minIssueFee sdk.Int
+	var premiumSymbolLen uint32
+	var premiumFeeMultiplier sdk.Dec
+	var minVestingAmount sdk.Int
 	var tokens []types.Token
 
 	simState.AppParams.GetOrGenerate(
@@ -60,13 +72,78 @@ func RandomizedGenState(simState *module.SimulationState) {
 			mintTokenFeeRatio = sdk.NewDecWithPrec(int64(r.Intn(5)), 1)
 		},
 	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FactorBase, &factorBase, simState.Rand,
+		func(r *rand.Rand) {
+			factorBase = uint32(simtypes.RandIntBetween(r, 2, 6))
+		},
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FactorExp, &factorExp, simState.Rand,
+		func(r *rand.Rand) {
+			factorExp = uint32(r.Intn(6))
+		},
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinIssueFee, &minIssueFee, simState.Rand,
+		func(r *rand.Rand) {
+			minIssueFee = sdk.NewInt(int64(r.Intn(5)))
+		},
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PremiumSymbolLen, &premiumSymbolLen, simState.Rand,
+		func(r *rand.Rand) {
+			premiumSymbolLen = uint32(r.Intn(types.MinimumSymbolLen + 2))
+		},
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PremiumFeeMultiplier, &premiumFeeMultiplier, simState.Rand,
+		func(r *rand.Rand) {
+			premiumFeeMultiplier = sdk.NewDec(int64(simtypes.RandIntBetween(r, 1, 11)))
+		},
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinVestingAmount, &minVestingAmount, simState.Rand,
+		func(r *rand.Rand) {
+			minVestingAmount = sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1000)))
+		},
+	)
+
+	// delegate a random role of some tokens to another account
+	var tokenRoles []types.TokenRoles
+	for _, token := range tokens {
+		if simState.Rand.Intn(2) == 0 {
+			continue
+		}
+		simAccount, _ := simtypes.RandomAcc(simState.Rand, simState.Accounts)
+		tokenRoles = append(tokenRoles, types.TokenRoles{
+			Symbol:  token.Symbol,
+			Address: simAccount.Address.String(),
+			Roles:   []types.TokenRole{randTokenRole(simState.Rand)},
+		})
+	}
 
 	gs := types.NewGenesisState(
 		types.NewParams(communiteTax, sdk.NewCoin(sdk.DefaultBondDenom, issueTokenFee),
-			mintTokenFeeRatio,
+			mintTokenFeeRatio, factorBase, factorExp, minIssueFee, premiumSymbolLen, premiumFeeMultiplier,
+			minVestingAmount,
 		),
 		tokens,
 		sdk.Coins{},
+		[]string{},
+		tokenRoles,
+		sdk.Coins{},
+		[]string{},
+		[]types.FrozenAccount{},
+		[]types.TokenVesting{},
+		1,
+		[]types.TokenFeeOverride{},
+		[]types.SymbolReservation{},
+		[]types.TokenSnapshot{},
+		1,
+		[]types.TokenDistribution{},
+		[]types.DistributionClaim{},
+		1,
 	)
 
 	bz, err := json.MarshalIndent(&gs, "", " ")
@@ -1,9 +1,12 @@
 package simulation
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"math/rand"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -23,7 +26,15 @@ const (
 	OpWeightMsgIssueToken         = "op_weight_msg_issue_token"
 	OpWeightMsgEditToken          = "op_weight_msg_edit_token"
 	OpWeightMsgMintToken          = "op_weight_msg_mint_token"
+	OpWeightMsgBurnToken          = "op_weight_msg_burn_token"
 	OpWeightMsgTransferTokenOwner = "op_weight_msg_transfer_token_owner"
+	OpWeightMsgGrantTokenRole     = "op_weight_msg_grant_token_role"
+	OpWeightMsgRevokeTokenRole    = "op_weight_msg_revoke_token_role"
+	OpWeightMsgPauseToken         = "op_weight_msg_pause_token"
+	OpWeightMsgFreezeAccount      = "op_weight_msg_freeze_account"
+	OpWeightMsgCreateTokenVesting = "op_weight_msg_create_token_vesting"
+	OpWeightMsgTakeTokenSnapshot  = "op_weight_msg_take_token_snapshot"
+	OpWeightMsgDistributeToken    = "op_weight_msg_distribute_token"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -35,7 +46,7 @@ func WeightedOperations(
 	bk types.BankKeeper,
 ) simulation.WeightedOperations {
 
-	var weightIssue, weightEdit, weightMint, weightTransfer int
+	var weightIssue, weightEdit, weightMint, weightBurn, weightTransfer, weightGrant, weightRevoke, weightPause, weightFreeze, weightVesting, weightSnapshot, weightDistribute int
 	appParams.GetOrGenerate(
 		cdc, OpWeightMsgIssueToken, &weightIssue, nil,
 		func(_ *rand.Rand) {
@@ -57,6 +68,13 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgBurnToken, &weightBurn, nil,
+		func(_ *rand.Rand) {
+			weightBurn = 50
+		},
+	)
+
 	appParams.GetOrGenerate(
 		cdc, OpWeightMsgTransferTokenOwner, &weightTransfer, nil,
 		func(_ *rand.Rand) {
@@ -64,6 +82,55 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgGrantTokenRole, &weightGrant, nil,
+		func(_ *rand.Rand) {
+			weightGrant = 50
+		},
+	)
+
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgRevokeTokenRole, &weightRevoke, nil,
+		func(_ *rand.Rand) {
+			weightRevoke = 20
+		},
+	)
+
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgPauseToken, &weightPause, nil,
+		func(_ *rand.Rand) {
+			weightPause = 20
+		},
+	)
+
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgFreezeAccount, &weightFreeze, nil,
+		func(_ *rand.Rand) {
+			weightFreeze = 20
+		},
+	)
+
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgCreateTokenVesting, &weightVesting, nil,
+		func(_ *rand.Rand) {
+			weightVesting = 50
+		},
+	)
+
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgTakeTokenSnapshot, &weightSnapshot, nil,
+		func(_ *rand.Rand) {
+			weightSnapshot = 20
+		},
+	)
+
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgDistributeToken, &weightDistribute, nil,
+		func(_ *rand.Rand) {
+			weightDistribute = 30
+		},
+	)
+
 	return simulation.WeightedOperations{
 		//simtypes.NewWeightedOperation(
 		//	weightIssue,
@@ -77,10 +144,42 @@ func WeightedOperations(
 			weightMint,
 			SimulateMintToken(k, ak, bk),
 		),
+		simulation.NewWeightedOperation(
+			weightBurn,
+			SimulateBurnToken(k, ak, bk),
+		),
 		simulation.NewWeightedOperation(
 			weightTransfer,
 			SimulateTransferTokenOwner(k, ak, bk),
 		),
+		simulation.NewWeightedOperation(
+			weightGrant,
+			SimulateGrantTokenRole(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightRevoke,
+			SimulateRevokeTokenRole(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightPause,
+			SimulatePauseToken(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightFreeze,
+			SimulateFreezeAccount(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightVesting,
+			SimulateCreateTokenVesting(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightSnapshot,
+			SimulateTakeTokenSnapshot(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightDistribute,
+			SimulateDistributeToken(k, ak, bk),
+		),
 	}
 }
 
@@ -93,12 +192,13 @@ func SimulateIssueToken(k keeper.Keeper, ak authkeeper.AccountKeeper, bk types.B
 
 		token, maxFees := genToken(ctx, r, k, ak, bk, accs)
 
-		msg := types.NewMsgIssueToken(token.GetName(), token.GetSymbol(), token.GetSmallestUnit(), token.GetDecimals(), 
-			token.GetInitialSupply(), token.GetTotalSupply(), token.GetMintable(), true, token.GetOwnerString())
+		msg := types.NewMsgIssueToken(token.GetName(), token.GetSymbol(), token.GetSmallestUnit(), token.GetDecimals(),
+			token.GetInitialSupply(), token.GetTotalSupply(), token.GetMintable(), true, token.GetHolderBurnable(), token.GetFreezable(),
+			token.GetOwnerString())
 
 		simAccount, found := simtypes.FindAccount(accs, token.GetOwner())
 		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), fmt.Sprintf("account[%s] does not found", token.GetOwnerString())), 
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), fmt.Sprintf("account[%s] does not found", token.GetOwnerString())),
 				nil, fmt.Errorf("account[%s] does not found", token.GetOwnerString())
 		}
 
@@ -141,11 +241,14 @@ func SimulateEditToken(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKee
 
 		token, _ := selectToken(ctx, k, ak, bk, false)
 
-		msg := types.NewMsgEditToken(token.GetSymbol(), true, token.GetOwnerString())
+		uri, uriHash, description, attributes := randMetadata(r)
+		msg := types.NewMsgEditToken(
+			token.GetSymbol(), uri, uriHash, description, attributes, true, token.GetOwnerString(),
+		)
 
 		simAccount, found := simtypes.FindAccount(accs, token.GetOwner())
 		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), fmt.Sprintf("account[%s] does not found", token.GetOwnerString())), 
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), fmt.Sprintf("account[%s] does not found", token.GetOwnerString())),
 				nil, fmt.Errorf("account[%s] does not found", token.GetOwnerString())
 		}
 
@@ -195,7 +298,7 @@ func SimulateMintToken(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKee
 
 		ownerAccount, found := simtypes.FindAccount(accs, token.GetOwner())
 		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), fmt.Sprintf("account[%s] does not found", token.GetOwnerString())), 
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), fmt.Sprintf("account[%s] does not found", token.GetOwnerString())),
 				nil, fmt.Errorf("account[%s] does not found", token.GetOwnerString())
 		}
 
@@ -229,6 +332,47 @@ func SimulateMintToken(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKee
 	}
 }
 
+// SimulateBurnToken tests and runs a single msg burning some tokens held by a
+// random account allowed to burn them, as a holder, a burner or the owner
+func SimulateBurnToken(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// the tokens are held by a few accounts only
+		var simAccount simtypes.Account
+		var burnable []sdk.Coin
+		for _, i := range r.Perm(len(accs)) {
+			simAccount = accs[i]
+			for _, coin := range bk.SpendableCoins(ctx, simAccount.Address) {
+				token, err := k.GetTokenWithUnit(ctx, coin.Denom)
+				if err != nil || token.GetOwner().Empty() || !coin.Amount.IsUint64() {
+					continue
+				}
+				if k.ValidateBurn(ctx, token.GetSymbol(), simAccount.Address) == nil {
+					burnable = append(burnable, coin)
+				}
+			}
+			if len(burnable) > 0 {
+				break
+			}
+		}
+		if len(burnable) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurnToken, "no token burnable"), nil, nil
+		}
+
+		coin := burnable[r.Intn(len(burnable))]
+		token, _ := k.GetTokenWithUnit(ctx, coin.Denom)
+		amount := sdk.MaxInt(simtypes.RandomAmount(r, coin.Amount), sdk.OneInt())
+
+		msg := types.NewMsgBurnToken(token.GetSymbol(), simAccount.Address.String(), amount.Uint64())
+
+		spent := sdk.NewCoins(sdk.NewCoin(coin.Denom, amount))
+		return deliverMsg(r, app, ctx, ak, bk, chainID, simAccount, msg, spent, "simulate burn token")
+	}
+}
+
 // SimulateTransferTokenOwner tests and runs a single msg transfer to others
 func SimulateTransferTokenOwner(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
 	return func(
@@ -246,7 +390,7 @@ func SimulateTransferTokenOwner(k keeper.Keeper, ak types.AccountKeeper, bk type
 
 		simAccount, found := simtypes.FindAccount(accs, token.GetOwner())
 		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), fmt.Sprintf("account[%s] does not found", token.GetOwnerString())), 
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), fmt.Sprintf("account[%s] does not found", token.GetOwnerString())),
 				nil, fmt.Errorf("account[%s] does not found", token.GetOwnerString())
 		}
 
@@ -282,6 +426,425 @@ func SimulateTransferTokenOwner(k keeper.Keeper, ak types.AccountKeeper, bk type
 	}
 }
 
+// SimulateGrantTokenRole tests and runs a single msg granting a role of a random token
+func SimulateGrantTokenRole(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		var owned []types.TokenI
+		for _, t := range k.GetTokens(ctx, nil) {
+			if !t.GetOwner().Empty() {
+				owned = append(owned, t)
+			}
+		}
+		if len(owned) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantTokenRole, "no token available"), nil, nil
+		}
+
+		token := owned[r.Intn(len(owned))]
+		simToAccount, _ := simtypes.RandomAcc(r, accs)
+		role := randTokenRole(r)
+
+		msg := types.NewMsgGrantTokenRole(token.GetSymbol(), role, simToAccount.Address.String(), token.GetOwnerString())
+
+		if k.HasTokenRole(ctx, token.GetSymbol(), role, simToAccount.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "role already granted"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, token.GetOwner())
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), fmt.Sprintf("account[%s] does not found", token.GetOwnerString())),
+				nil, fmt.Errorf("account[%s] does not found", token.GetOwnerString())
+		}
+
+		return deliverMsg(r, app, ctx, ak, bk, chainID, simAccount, msg, nil, "simulate grant token role")
+	}
+}
+
+// SimulateRevokeTokenRole tests and runs a single msg revoking a granted token role
+func SimulateRevokeTokenRole(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		var granted []types.TokenRoles
+		k.IterateTokenRoles(ctx, func(roles types.TokenRoles) bool {
+			granted = append(granted, roles)
+			return false
+		})
+		if len(granted) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeTokenRole, "no role granted"), nil, nil
+		}
+
+		roles := granted[r.Intn(len(granted))]
+		token, err := k.GetToken(ctx, roles.Symbol)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeTokenRole, "token not found"), nil, err
+		}
+
+		msg := types.NewMsgRevokeTokenRole(
+			roles.Symbol, roles.Roles[r.Intn(len(roles.Roles))], roles.Address, token.GetOwnerString(),
+		)
+
+		simAccount, found := simtypes.FindAccount(accs, token.GetOwner())
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), fmt.Sprintf("account[%s] does not found", token.GetOwnerString())),
+				nil, fmt.Errorf("account[%s] does not found", token.GetOwnerString())
+		}
+
+		return deliverMsg(r, app, ctx, ak, bk, chainID, simAccount, msg, nil, "simulate revoke token role")
+	}
+}
+
+// SimulatePauseToken tests and runs the pause of a random token by its owner or a pauser. The token
+// is unpaused within the same tx, as a lingering pause would fail the random transfers of the other modules
+func SimulatePauseToken(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		var owned []types.TokenI
+		for _, t := range k.GetTokens(ctx, nil) {
+			if !t.GetOwner().Empty() && !k.IsPaused(ctx, t.GetSmallestUnit()) {
+				owned = append(owned, t)
+			}
+		}
+		if len(owned) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPauseToken, "no token available"), nil, nil
+		}
+
+		token := owned[r.Intn(len(owned))]
+		sender := token.GetOwner()
+		for _, acc := range accs {
+			if k.HasTokenRole(ctx, token.GetSymbol(), types.RolePauser, acc.Address) && r.Intn(2) == 0 {
+				sender = acc.Address
+				break
+			}
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, sender)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPauseToken, fmt.Sprintf("account[%s] does not found", sender)),
+				nil, fmt.Errorf("account[%s] does not found", sender)
+		}
+
+		msgs := []sdk.Msg{
+			types.NewMsgPauseToken(token.GetSymbol(), sender.String()),
+			types.NewMsgUnpauseToken(token.GetSymbol(), sender.String()),
+		}
+
+		return deliverMsgs(r, app, ctx, ak, bk, chainID, simAccount, msgs, nil, "simulate pause token")
+	}
+}
+
+// SimulateFreezeAccount tests and runs the freeze of a random account for a freezable token. The
+// account is unfrozen within the same tx, as a lingering freeze would fail the random transfers of the other modules
+func SimulateFreezeAccount(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		var freezable []types.TokenI
+		for _, t := range k.GetTokens(ctx, nil) {
+			if !t.GetOwner().Empty() && t.GetFreezable() {
+				freezable = append(freezable, t)
+			}
+		}
+		if len(freezable) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgFreezeAccount, "no freezable token available"), nil, nil
+		}
+
+		token := freezable[r.Intn(len(freezable))]
+		simToAccount, _ := simtypes.RandomAcc(r, accs)
+		if k.IsFrozen(ctx, token.GetSmallestUnit(), simToAccount.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgFreezeAccount, "account already frozen"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, token.GetOwner())
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgFreezeAccount, fmt.Sprintf("account[%s] does not found", token.GetOwnerString())),
+				nil, fmt.Errorf("account[%s] does not found", token.GetOwnerString())
+		}
+
+		msgs := []sdk.Msg{
+			types.NewMsgFreezeAccount(token.GetSymbol(), simToAccount.Address.String(), token.GetOwnerString()),
+			types.NewMsgUnfreezeAccount(token.GetSymbol(), simToAccount.Address.String(), token.GetOwnerString()),
+		}
+
+		return deliverMsgs(r, app, ctx, ak, bk, chainID, simAccount, msgs, nil, "simulate freeze account")
+	}
+}
+
+// SimulateCreateTokenVesting tests and runs a single msg escrowing tokens released
+// to a random beneficiary within a few hours
+func SimulateCreateTokenVesting(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		// the tokens are held by a few accounts only
+		minAmount := k.GetParams(ctx).MinVestingAmount
+		var simAccount simtypes.Account
+		var transferable []sdk.Coin
+		for _, i := range r.Perm(len(accs)) {
+			simAccount = accs[i]
+			for _, coin := range bk.SpendableCoins(ctx, simAccount.Address) {
+				if !k.HasTokenWithUnit(ctx, coin.Denom) {
+					continue
+				}
+				if coin.Amount.GTE(minAmount) && k.ValidateTransfer(ctx, simAccount.Address, sdk.NewCoins(coin)) == nil {
+					transferable = append(transferable, coin)
+				}
+			}
+			if len(transferable) > 0 {
+				break
+			}
+		}
+		if len(transferable) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateTokenVesting, "no token transferable"), nil, nil
+		}
+
+		coin := transferable[r.Intn(len(transferable))]
+		amount := sdk.NewCoin(coin.Denom, sdk.MaxInt(simtypes.RandomAmount(r, coin.Amount), minAmount))
+		beneficiary, _ := simtypes.RandomAcc(r, accs)
+
+		startTime := ctx.BlockTime()
+		cliffTime := startTime.Add(time.Duration(r.Intn(3600)) * time.Second)
+		endTime := cliffTime.Add(time.Duration(simtypes.RandIntBetween(r, 60, 7200)) * time.Second)
+
+		msg := types.NewMsgCreateTokenVesting(
+			simAccount.Address.String(), beneficiary.Address.String(), amount, startTime, cliffTime, endTime,
+		)
+
+		return deliverMsg(r, app, ctx, ak, bk, chainID, simAccount, msg, sdk.NewCoins(amount), "simulate create token vesting")
+	}
+}
+
+// SimulateTakeTokenSnapshot tests and runs a snapshot of the holders of a random token by its owner
+func SimulateTakeTokenSnapshot(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		var owned []types.TokenI
+		for _, t := range k.GetTokens(ctx, nil) {
+			if !t.GetOwner().Empty() {
+				owned = append(owned, t)
+			}
+		}
+		if len(owned) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTakeTokenSnapshot, "no token available"), nil, nil
+		}
+
+		token := owned[r.Intn(len(owned))]
+		simAccount, found := simtypes.FindAccount(accs, token.GetOwner())
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTakeTokenSnapshot, fmt.Sprintf("account[%s] does not found", token.GetOwner())),
+				nil, fmt.Errorf("account[%s] does not found", token.GetOwner())
+		}
+
+		msg := types.NewMsgTakeTokenSnapshot(token.GetSymbol(), simAccount.Address.String())
+
+		return deliverMsg(r, app, ctx, ak, bk, chainID, simAccount, msg, nil, "simulate take token snapshot")
+	}
+}
+
+// SimulateDistributeToken tests and runs a distribution of a random token by its owner to
+// a few random accounts, taken from the owner balance or minted, most of the recipients
+// claiming their amount in the next blocks
+func SimulateDistributeToken(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		var owned []types.TokenI
+		for _, t := range k.GetTokens(ctx, nil) {
+			if !t.GetOwner().Empty() {
+				owned = append(owned, t)
+			}
+		}
+		if len(owned) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDistributeToken, "no token available"), nil, nil
+		}
+
+		token := owned[r.Intn(len(owned))]
+		simAccount, found := simtypes.FindAccount(accs, token.GetOwner())
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDistributeToken, fmt.Sprintf("account[%s] does not found", token.GetOwner())),
+				nil, fmt.Errorf("account[%s] does not found", token.GetOwner())
+		}
+
+		// the distributed amount is limited by the owner balance or the mintable headroom
+		unit := token.GetSmallestUnit()
+		mint := r.Intn(2) == 0
+		var available sdk.Int
+		spent := sdk.NewCoins()
+		if mint {
+			if !token.GetMintable() {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDistributeToken, "token not mintable"), nil, nil
+			}
+			available = sdk.NewIntFromUint64(token.GetTotalSupply()).Sub(bk.GetSupply(ctx).GetTotal().AmountOf(unit))
+			if burnt, found := k.GetBurntCoin(ctx, unit); found {
+				available = available.Sub(burnt.Amount)
+			}
+			fee, err := k.GetMintTokenFee(ctx, token.GetSymbol())
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDistributeToken, "unable to get the mint fee"), nil, err
+			}
+			spent = sdk.NewCoins(fee)
+		} else {
+			available = bk.SpendableCoins(ctx, simAccount.Address).AmountOf(unit)
+			if available.IsPositive() && k.ValidateTransfer(ctx, simAccount.Address, sdk.NewCoins(sdk.NewCoin(unit, available))) != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDistributeToken, "token not transferable"), nil, nil
+			}
+		}
+
+		recipients := accs[:0:0]
+		for _, i := range r.Perm(len(accs))[:simtypes.RandIntBetween(r, 1, 6)] {
+			recipients = append(recipients, accs[i])
+		}
+		share := available.QuoRaw(int64(len(recipients)))
+		if !share.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDistributeToken, "insufficient amount"), nil, nil
+		}
+		if share.GT(sdk.NewInt(1000000)) {
+			share = sdk.NewInt(1000000)
+		}
+
+		var (
+			total   uint64
+			amounts []uint64
+			leaves  [][]byte
+		)
+		for _, recipient := range recipients {
+			amount := uint64(simtypes.RandIntBetween(r, 1, int(share.Int64())+1))
+			total += amount
+			amounts = append(amounts, amount)
+			leaves = append(leaves, types.DistributionLeaf(recipient.Address, amount))
+		}
+		if !mint {
+			spent = spent.Add(sdk.NewCoin(unit, sdk.NewIntFromUint64(total)))
+		}
+
+		root := hex.EncodeToString(types.DistributionMerkleRoot(leaves))
+		// the simulated blocks are hours apart, the distribution lasts a few blocks
+		endTime := ctx.BlockTime().Add(time.Duration(simtypes.RandIntBetween(r, 6, 24)) * time.Hour)
+		msg := types.NewMsgDistributeToken(token.GetSymbol(), simAccount.Address.String(), root, total, endTime, mint)
+
+		id := k.GetNextDistributionID(ctx)
+		opMsg, _, err := deliverMsg(r, app, ctx, ak, bk, chainID, simAccount, msg, spent, "simulate distribute token")
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		var futureOps []simtypes.FutureOperation
+		for i, recipient := range recipients {
+			if r.Intn(4) == 0 {
+				continue
+			}
+
+			var proof []string
+			for _, node := range types.DistributionMerkleProof(leaves, i) {
+				proof = append(proof, hex.EncodeToString(node))
+			}
+			futureOps = append(futureOps, simtypes.FutureOperation{
+				BlockHeight: int(ctx.BlockHeight()) + simtypes.RandIntBetween(r, 1, 4),
+				Op:          SimulateClaimDistribution(k, ak, bk, types.NewMsgClaimDistribution(id, recipient.Address.String(), amounts[i], proof)),
+			})
+		}
+
+		return opMsg, futureOps, nil
+	}
+}
+
+// SimulateClaimDistribution tests and runs the claim of a token distribution by a
+// recipient, unless the distribution ended or its token is paused
+func SimulateClaimDistribution(
+	k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper, msg *types.MsgClaimDistribution,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		recipient, _ := sdk.AccAddressFromBech32(msg.Recipient)
+		simAccount, found := simtypes.FindAccount(accs, recipient)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), fmt.Sprintf("account[%s] does not found", msg.Recipient)),
+				nil, fmt.Errorf("account[%s] does not found", msg.Recipient)
+		}
+
+		distribution, found := k.GetTokenDistribution(ctx, msg.Id)
+		if !found || distribution.IsEnded(ctx.BlockTime()) {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "distribution ended"), nil, nil
+		}
+		if k.IsPaused(ctx, distribution.Total.Denom) {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "token paused"), nil, nil
+		}
+
+		return deliverMsg(r, app, ctx, ak, bk, chainID, simAccount, msg, nil, "simulate claim distribution")
+	}
+}
+
+// deliverMsg signs the msg by the account, paying random fees out of the coins
+// left once the msg has spent its coins, and delivers it
+func deliverMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	ak types.AccountKeeper, bk types.BankKeeper, chainID string,
+	simAccount simtypes.Account, msg sdk.Msg, spent sdk.Coins, comment string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return deliverMsgs(r, app, ctx, ak, bk, chainID, simAccount, []sdk.Msg{msg}, spent, comment)
+}
+
+// deliverMsgs delivers the msgs in a single tx as deliverMsg does, the operation
+// being reported under the first msg
+func deliverMsgs(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	ak types.AccountKeeper, bk types.BankKeeper, chainID string,
+	simAccount simtypes.Account, msgs []sdk.Msg, spent sdk.Coins, comment string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msg := msgs[0]
+	account := ak.GetAccount(ctx, simAccount.Address)
+	spendable, hasNeg := bk.SpendableCoins(ctx, account.GetAddress()).SafeSub(spent)
+	if hasNeg {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "insufficient funds"), nil, nil
+	}
+
+	fees, err := simtypes.RandomFees(r, ctx, spendable)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		msgs,
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+
+	if _, _, err = app.Deliver(txGen.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, comment), nil, nil
+}
+
 func genToken(ctx sdk.Context,
 	r *rand.Rand,
 	k keeper.Keeper,
@@ -321,6 +884,10 @@ func selectToken(
 	}
 
 	for _, t := range tokens {
+		// the tokens without owner, as the bridge vouchers, are managed by their module
+		if t.GetOwner().Empty() {
+			continue
+		}
 		if !mint {
 			return t, nil
 		}
@@ -343,23 +910,54 @@ func selectToken(
 func randToken(r *rand.Rand, accs []simtypes.Account) types.Token {
 
 	name := randString(r, 1, types.MaximumNameLen)
-	symbol := randString(r, types.MinimumSymbolLen, types.MaximumSymbolLen)
+	// symbols and smallest units must begin with a letter, the smallest unit
+	// is the symbol prefixed with "u" so it has to leave room for one more char
+	symbol := "s" + strings.ToLower(randString(r, types.MinimumSymbolLen-1, types.MaximumSymbolLen-2))
 	decimals := simtypes.RandIntBetween(r, 1, int(types.MaximumDecimals))
 	initialSupply := r.Int63n(int64(100000000000))
 	totalSupply := 2 * initialSupply
 	simAccount, _ := simtypes.RandomAcc(r, accs)
 
 	return types.Token{
-		Name:          name,
-		Symbol:        strings.ToLower(symbol),
+		Name:           name,
+		Symbol:         symbol,
+		SmallestUnit:   "u" + symbol,
 		Decimals:       uint32(decimals),
-		InitialSupply: uint64(initialSupply),
-		TotalSupply:   uint64(totalSupply),
-		Mintable:      true,
-		Owner:         simAccount.Address.String(),
+		InitialSupply:  uint64(initialSupply),
+		TotalSupply:    uint64(totalSupply),
+		Mintable:       true,
+		Owner:          simAccount.Address.String(),
+		HolderBurnable: r.Intn(2) == 0,
+		Freezable:      r.Intn(2) == 0,
 	}
 }
 
+// randMetadata returns random metadata for an edit, each field being either
+// left unchanged or set to a random value
+func randMetadata(r *rand.Rand) (uri, uriHash, description string, attributes []types.Attribute) {
+	uri, uriHash, description = types.DoNotModify, types.DoNotModify, types.DoNotModify
+	if r.Intn(2) == 0 {
+		uri = "https://" + strings.ToLower(randString(r, 1, 64))
+		hash := sha256.Sum256([]byte(uri))
+		uriHash = hex.EncodeToString(hash[:])
+	}
+	if r.Intn(2) == 0 {
+		description = randString(r, 0, types.MaximumDescriptionLen)
+	}
+	// a few keys only so that edits also update and remove attributes
+	for _, key := range []string{"website", "logo", "whitepaper"} {
+		if r.Intn(3) == 0 {
+			attributes = append(attributes, types.Attribute{Key: key, Value: randString(r, 0, 32)})
+		}
+	}
+	return
+}
+
+func randTokenRole(r *rand.Rand) types.TokenRole {
+	roles := []types.TokenRole{types.RoleMinter, types.RoleBurner, types.RolePauser, types.RoleMetadataAdmin}
+	return roles[r.Intn(len(roles))]
+}
+
 func filterAccount(
 	ctx sdk.Context,
 	r *rand.Rand,
@@ -385,5 +983,3 @@ func randString(r *rand.Rand, min, max int) string {
 	randStr := simtypes.RandStringOfLength(r, strLen)
 	return randStr
 }
-
-
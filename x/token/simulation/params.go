@@ -1,37 +1,58 @@
 package simulation
 
 import (
+	"fmt"
 	"math/rand"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"github.com/gauss/gauss/v4/x/token/types"
 )
 
-const (
-	KeyCommunityTax      = "CommunityTax"
-	KeyIssueTokenFee     = "IssueTokenFee"
-	KeyMintTokenFeeRatio = "MintTokenFeeRatio"
-)
-
 // ParamChanges defines the parameters that can be modified by param change proposals
 // on the simulation
 func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 	return []simtypes.ParamChange{
-		simulation.NewSimParamChange(types.ModuleName, KeyCommunityTax,
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyTokenTax),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", sdk.NewDecWithPrec(int64(r.Intn(5)), 1))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyIssueTokenFee),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("{\"denom\":\"%s\",\"amount\":\"%d\"}", sdk.DefaultBondDenom, r.Intn(100))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMintTokenFeeRatio),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", sdk.NewDecWithPrec(int64(r.Intn(5)), 1))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyFactorBase),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", simtypes.RandIntBetween(r, 2, 6))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyFactorExp),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", r.Intn(6))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMinIssueFee),
 			func(r *rand.Rand) string {
-				return RandomDec(r).String()
+				return fmt.Sprintf("\"%d\"", r.Intn(5))
 			},
 		),
-		simulation.NewSimParamChange(types.ModuleName, KeyIssueTokenFee,
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyPremiumSymbolLen),
 			func(r *rand.Rand) string {
-				return RandomInt(r).String()
+				return fmt.Sprintf("%d", r.Intn(types.MinimumSymbolLen+2))
 			},
 		),
-		simulation.NewSimParamChange(types.ModuleName, KeyMintTokenFeeRatio,
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyPremiumFeeMultiplier),
 			func(r *rand.Rand) string {
-				return RandomDec(r).String()
+				return fmt.Sprintf("\"%s\"", sdk.NewDec(int64(simtypes.RandIntBetween(r, 1, 11))))
 			},
 		),
 	}
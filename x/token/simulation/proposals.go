@@ -0,0 +1,94 @@
+package simulation
+
+import (
+	"math/rand"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/gauss/gauss/v4/x/token/keeper"
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+// Simulation operation weights constants of the token proposals
+const (
+	OpWeightSubmitTokenFeeOverrideProposal  = "op_weight_submit_token_fee_override_proposal"
+	OpWeightSubmitSymbolReservationProposal = "op_weight_submit_symbol_reservation_proposal"
+
+	DefaultWeightTokenFeeOverrideProposal  = 5
+	DefaultWeightSymbolReservationProposal = 5
+)
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitTokenFeeOverrideProposal,
+			DefaultWeightTokenFeeOverrideProposal,
+			SimulateTokenFeeOverrideProposalContent(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitSymbolReservationProposal,
+			DefaultWeightSymbolReservationProposal,
+			SimulateSymbolReservationProposalContent(k),
+		),
+	}
+}
+
+// SimulateTokenFeeOverrideProposalContent generates random token fee override proposal content,
+// waiving the fees of an issued token, overriding them or removing an existing override
+func SimulateTokenFeeOverrideProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		tokens := k.GetTokens(ctx, nil)
+		if len(tokens) == 0 {
+			return nil
+		}
+
+		symbol := tokens[r.Intn(len(tokens))].GetSymbol()
+		title := simtypes.RandStringOfLength(r, 10)
+		description := simtypes.RandStringOfLength(r, 100)
+
+		if _, found := k.GetTokenFeeOverride(ctx, symbol); found && r.Intn(2) == 0 {
+			return types.NewTokenFeeOverrideProposal(title, description, symbol, sdk.Coin{}, sdk.Coin{}, true)
+		}
+
+		// waive the fees half of the time
+		denom := k.GetParams(ctx).IssueFee.Denom
+		issueFee, mintFee := sdk.NewCoin(denom, sdk.ZeroInt()), sdk.NewCoin(denom, sdk.ZeroInt())
+		if r.Intn(2) == 0 {
+			issueFee = sdk.NewCoin(denom, simtypes.RandomAmount(r, sdk.NewInt(100)))
+			mintFee = sdk.NewCoin(denom, simtypes.RandomAmount(r, sdk.NewInt(100)))
+		}
+
+		return types.NewTokenFeeOverrideProposal(title, description, symbol, issueFee, mintFee, false)
+	}
+}
+
+// SimulateSymbolReservationProposalContent generates random symbol reservation proposal content,
+// reserving a random symbol for a random account or releasing an existing reservation
+func SimulateSymbolReservationProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		title := simtypes.RandStringOfLength(r, 10)
+		description := simtypes.RandStringOfLength(r, 100)
+
+		var reservations []types.SymbolReservation
+		k.IterateSymbolReservations(ctx, func(reservation types.SymbolReservation) bool {
+			reservations = append(reservations, reservation)
+			return false
+		})
+		if len(reservations) > 0 && r.Intn(2) == 0 {
+			symbol := reservations[r.Intn(len(reservations))].Symbol
+			return types.NewSymbolReservationProposal(title, description, symbol, "", true)
+		}
+
+		symbol := "r" + strings.ToLower(randString(r, types.MinimumSymbolLen-1, 8))
+		if k.HasToken(ctx, symbol) {
+			return nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		return types.NewSymbolReservationProposal(title, description, symbol, simAccount.Address.String(), false)
+	}
+}
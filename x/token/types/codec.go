@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/token interfaces and concrete types
@@ -21,6 +22,19 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBurnToken{}, "gauss/token/MsgBurnToken", nil)
 	cdc.RegisterConcrete(&MsgUnlockToken{}, "gauss/token/MsgUnlockToken", nil)
 	cdc.RegisterConcrete(&MsgTransferTokenOwner{}, "gauss/token/MsgTransferTokenOwner", nil)
+	cdc.RegisterConcrete(&MsgGrantTokenRole{}, "gauss/token/MsgGrantTokenRole", nil)
+	cdc.RegisterConcrete(&MsgRevokeTokenRole{}, "gauss/token/MsgRevokeTokenRole", nil)
+	cdc.RegisterConcrete(&MsgPauseToken{}, "gauss/token/MsgPauseToken", nil)
+	cdc.RegisterConcrete(&MsgUnpauseToken{}, "gauss/token/MsgUnpauseToken", nil)
+	cdc.RegisterConcrete(&MsgFreezeAccount{}, "gauss/token/MsgFreezeAccount", nil)
+	cdc.RegisterConcrete(&MsgUnfreezeAccount{}, "gauss/token/MsgUnfreezeAccount", nil)
+	cdc.RegisterConcrete(&MsgCreateTokenVesting{}, "gauss/token/MsgCreateTokenVesting", nil)
+	cdc.RegisterConcrete(&MsgTakeTokenSnapshot{}, "gauss/token/MsgTakeTokenSnapshot", nil)
+	cdc.RegisterConcrete(&MsgDistributeToken{}, "gauss/token/MsgDistributeToken", nil)
+	cdc.RegisterConcrete(&MsgClaimDistribution{}, "gauss/token/MsgClaimDistribution", nil)
+
+	cdc.RegisterConcrete(&TokenFeeOverrideProposal{}, "gauss/TokenFeeOverrideProposal", nil)
+	cdc.RegisterConcrete(&SymbolReservationProposal{}, "gauss/SymbolReservationProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -31,6 +45,20 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBurnToken{},
 		&MsgUnlockToken{},
 		&MsgTransferTokenOwner{},
+		&MsgGrantTokenRole{},
+		&MsgRevokeTokenRole{},
+		&MsgPauseToken{},
+		&MsgUnpauseToken{},
+		&MsgFreezeAccount{},
+		&MsgUnfreezeAccount{},
+		&MsgCreateTokenVesting{},
+		&MsgTakeTokenSnapshot{},
+		&MsgDistributeToken{},
+		&MsgClaimDistribution{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&TokenFeeOverrideProposal{},
+		&SymbolReservationProposal{},
 	)
 	registry.RegisterInterface(
 		"gauss.token.TokenI",
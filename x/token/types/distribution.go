@@ -0,0 +1,70 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewTokenDistribution creates a new TokenDistribution with nothing claimed
+func NewTokenDistribution(
+	id uint64, symbol string, creator sdk.AccAddress, merkleRoot string, total sdk.Coin, endTime time.Time,
+) TokenDistribution {
+	return TokenDistribution{
+		Id:         id,
+		Symbol:     symbol,
+		Creator:    creator.String(),
+		MerkleRoot: merkleRoot,
+		Total:      total,
+		Claimed:    sdk.NewCoin(total.Denom, sdk.ZeroInt()),
+		EndTime:    endTime,
+	}
+}
+
+// Unclaimed returns the amount still escrowed in the token module account
+func (d TokenDistribution) Unclaimed() sdk.Coin {
+	return d.Total.Sub(d.Claimed)
+}
+
+// IsEnded returns whether the amounts of the distribution are no longer claimable at the given time
+func (d TokenDistribution) IsEnded(blockTime time.Time) bool {
+	return !blockTime.Before(d.EndTime)
+}
+
+// Validate checks the symbol, the creator, the merkle root and the amounts of the token distribution
+func (d TokenDistribution) Validate() error {
+	if d.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidDistribution, "distribution id must be positive")
+	}
+	if err := ValidateSymbol(d.Symbol); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := ParseMerkleRoot(d.MerkleRoot); err != nil {
+		return err
+	}
+	if !d.Total.IsValid() || !d.Total.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid distribution total %s", d.Total)
+	}
+	if !d.Claimed.IsValid() || d.Claimed.Denom != d.Total.Denom || d.Total.IsLT(d.Claimed) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid distribution claimed %s of total %s", d.Claimed, d.Total)
+	}
+	return nil
+}
+
+// Validate checks the address and the amount of the distribution claim
+func (c DistributionClaim) Validate() error {
+	if c.DistributionId == 0 {
+		return sdkerrors.Wrap(ErrInvalidDistribution, "distribution id must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid claim address (%s)", err)
+	}
+	if !c.Amount.IsValid() || !c.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid claimed amount %s", c.Amount)
+	}
+	return nil
+}
@@ -21,4 +21,30 @@ var (
         ErrInvalidIssueFee      = sdkerrors.Register(ModuleName, 13, "invalid issue token fee")
 	ErrUnlockedToken	= sdkerrors.Register(ModuleName, 14, "token has been unlocked")
 	ErrNotFoundToken	= sdkerrors.Register(ModuleName, 15, "token is not found")
+	ErrTokenLocked		= sdkerrors.Register(ModuleName, 16, "token is locked")
+	ErrInvalidURI           = sdkerrors.Register(ModuleName, 17, "invalid token uri")
+	ErrInvalidURIHash       = sdkerrors.Register(ModuleName, 18, "invalid token uri hash")
+	ErrInvalidDescription   = sdkerrors.Register(ModuleName, 19, "invalid token description")
+	ErrInvalidAttribute     = sdkerrors.Register(ModuleName, 20, "invalid token attribute")
+	ErrInvalidRole          = sdkerrors.Register(ModuleName, 21, "invalid token role")
+	ErrRoleAlreadyGranted   = sdkerrors.Register(ModuleName, 22, "token role already granted")
+	ErrRoleNotGranted       = sdkerrors.Register(ModuleName, 23, "token role not granted")
+	ErrTokenPaused          = sdkerrors.Register(ModuleName, 24, "token is paused")
+	ErrTokenNotPaused       = sdkerrors.Register(ModuleName, 25, "token is not paused")
+	ErrNotFreezable         = sdkerrors.Register(ModuleName, 26, "token is not freezable")
+	ErrAccountFrozen        = sdkerrors.Register(ModuleName, 27, "account is frozen")
+	ErrAccountNotFrozen     = sdkerrors.Register(ModuleName, 28, "account is not frozen")
+	ErrInvalidVesting       = sdkerrors.Register(ModuleName, 29, "invalid token vesting")
+	ErrVestingNotFound      = sdkerrors.Register(ModuleName, 30, "token vesting not found")
+	ErrFeeOverrideNotFound  = sdkerrors.Register(ModuleName, 31, "token fee override not found")
+	ErrSymbolReserved       = sdkerrors.Register(ModuleName, 32, "symbol is reserved")
+	ErrReservationNotFound  = sdkerrors.Register(ModuleName, 33, "symbol reservation not found")
+	ErrInvalidSnapshot      = sdkerrors.Register(ModuleName, 34, "invalid token snapshot")
+	ErrSnapshotNotFound     = sdkerrors.Register(ModuleName, 35, "token snapshot not found")
+	ErrInvalidDistribution  = sdkerrors.Register(ModuleName, 36, "invalid token distribution")
+	ErrDistributionNotFound = sdkerrors.Register(ModuleName, 37, "token distribution not found")
+	ErrDistributionEnded    = sdkerrors.Register(ModuleName, 38, "token distribution ended")
+	ErrInvalidMerkleProof   = sdkerrors.Register(ModuleName, 39, "invalid merkle proof")
+	ErrAlreadyClaimed       = sdkerrors.Register(ModuleName, 40, "token distribution already claimed")
+	ErrInvalidFeeFactor     = sdkerrors.Register(ModuleName, 41, "invalid fee factor")
 )
@@ -3,17 +3,44 @@ package types
 const (
 	AttributeValueCategory = ModuleName
 
-	EventTypeIssueToken         = "issue_token"
-	EventTypeEditToken          = "edit_token"
-	EventTypeMintToken          = "mint_token"
-	EventTypeBurnToken          = "burn_token"
-	EventTypeUnlockToken        = "unlock_token"
-	EventTypeTransferTokenOwner = "transfer_token_owner"
+	EventTypeIssueToken           = "issue_token"
+	EventTypeEditToken            = "edit_token"
+	EventTypeMintToken            = "mint_token"
+	EventTypeBurnToken            = "burn_token"
+	EventTypeUnlockToken          = "unlock_token"
+	EventTypeTransferTokenOwner   = "transfer_token_owner"
+	EventTypeGrantTokenRole       = "grant_token_role"
+	EventTypeRevokeTokenRole      = "revoke_token_role"
+	EventTypePauseToken           = "pause_token"
+	EventTypeUnpauseToken         = "unpause_token"
+	EventTypeFreezeAccount        = "freeze_account"
+	EventTypeUnfreezeAccount      = "unfreeze_account"
+	EventTypeCreateTokenVesting   = "create_token_vesting"
+	EventTypeReleaseVesting       = "release_token_vesting"
+	EventTypeOverrideTokenFee     = "override_token_fee"
+	EventTypeRemoveFeeOverride    = "remove_token_fee_override"
+	EventTypeReserveSymbol        = "reserve_symbol"
+	EventTypeReleaseSymbol        = "release_symbol"
+	EventTypeTakeTokenSnapshot    = "take_token_snapshot"
+	EventTypeDistributeToken      = "distribute_token"
+	EventTypeClaimDistribution    = "claim_distribution"
+	EventTypeClawbackDistribution = "clawback_distribution"
 
-	AttributeKeyCreator   = "creator"
-	AttributeKeySymbol    = "symbol"
-	AttributeKeyAmount    = "amount"
-	AttributeKeyOwner     = "owner"
-	AttributeKeyNewOwner  = "new_owner"
-	AttributeKeyRecipient = "recipient"
+	AttributeKeyCreator        = "creator"
+	AttributeKeySymbol         = "symbol"
+	AttributeKeyAmount         = "amount"
+	AttributeKeyOwner          = "owner"
+	AttributeKeyNewOwner       = "new_owner"
+	AttributeKeyRecipient      = "recipient"
+	AttributeKeyRole           = "role"
+	AttributeKeyAddress        = "address"
+	AttributeKeyVestingID      = "vesting_id"
+	AttributeKeyBeneficiary    = "beneficiary"
+	AttributeKeyIssueFee       = "issue_fee"
+	AttributeKeyMintFee        = "mint_fee"
+	AttributeKeySnapshotID     = "snapshot_id"
+	AttributeKeyHeight         = "height"
+	AttributeKeyDistributionID = "distribution_id"
+	AttributeKeyMerkleRoot     = "merkle_root"
+	AttributeKeyEndTime        = "end_time"
 )
@@ -14,6 +14,8 @@ type BankKeeper interface {
 
 	GetSupply(ctx sdk.Context) (supply bank.SupplyI)
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
 
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
@@ -26,9 +28,6 @@ type BankKeeper interface {
 
 	GetParams(ctx sdk.Context) banktypes.Params
 	SetParams(ctx sdk.Context, params banktypes.Params)
-
-	SendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
-	SendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 }
 
 // AccountKeeper defines the expected account keeper
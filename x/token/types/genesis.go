@@ -1,7 +1,10 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Validate performs basic validation of supply genesis data returning an
@@ -24,21 +27,248 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	burned := sdk.NewCoins(gs.BurnedCoins...)
+	for _, coin := range gs.HolderBurnedCoins {
+		if err := coin.Validate(); err != nil {
+			return err
+		}
+		if burned.AmountOf(coin.Denom).LT(coin.Amount) {
+			return sdkerrors.Wrapf(ErrInvalidAmount, "holder burned coin %s exceeds the burned coin of the token", coin)
+		}
+	}
+
+	// validate locked tokens
+	units := make(map[string]bool)
+	for _, token := range gs.Tokens {
+		units[token.SmallestUnit] = true
+	}
+	for _, unit := range gs.LockedTokens {
+		if !units[unit] {
+			return sdkerrors.Wrapf(ErrTokenNotExists, "locked token[%s] does not exist", unit)
+		}
+	}
+
+	// validate paused tokens
+	paused := make(map[string]bool)
+	for _, unit := range gs.PausedTokens {
+		if !units[unit] {
+			return sdkerrors.Wrapf(ErrTokenNotExists, "paused token[%s] does not exist", unit)
+		}
+		if paused[unit] {
+			return sdkerrors.Wrapf(ErrTokenPaused, "duplicate paused token[%s]", unit)
+		}
+		paused[unit] = true
+	}
+
+	// validate frozen accounts
+	freezable := make(map[string]bool)
+	for _, token := range gs.Tokens {
+		freezable[token.SmallestUnit] = token.Freezable
+	}
+	frozen := make(map[string]bool)
+	for _, account := range gs.FrozenAccounts {
+		if !units[account.Denom] {
+			return sdkerrors.Wrapf(ErrTokenNotExists, "token[%s] of frozen account does not exist", account.Denom)
+		}
+		if !freezable[account.Denom] {
+			return sdkerrors.Wrapf(ErrNotFreezable, "token[%s] of frozen account is not freezable", account.Denom)
+		}
+		if _, err := sdk.AccAddressFromBech32(account.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid frozen address (%s)", err)
+		}
+		if frozen[account.Denom+"/"+account.Address] {
+			return sdkerrors.Wrapf(ErrAccountFrozen, "duplicate frozen account %s of token[%s]", account.Address, account.Denom)
+		}
+		frozen[account.Denom+"/"+account.Address] = true
+	}
+
+	// validate token roles
+	symbols := make(map[string]bool)
+	for _, token := range gs.Tokens {
+		symbols[token.Symbol] = true
+	}
+	granted := make(map[string]bool)
+	for _, roles := range gs.TokenRoles {
+		if err := roles.Validate(); err != nil {
+			return err
+		}
+		if !symbols[roles.Symbol] {
+			return sdkerrors.Wrapf(ErrTokenNotExists, "token[%s] of roles does not exist", roles.Symbol)
+		}
+		if granted[roles.Address+"/"+roles.Symbol] {
+			return sdkerrors.Wrapf(ErrInvalidRole, "duplicate roles of token[%s] granted to %s", roles.Symbol, roles.Address)
+		}
+		granted[roles.Address+"/"+roles.Symbol] = true
+	}
+
+	// validate token vestings
+	vestings := make(map[uint64]bool)
+	for _, vesting := range gs.TokenVestings {
+		if err := vesting.Validate(); err != nil {
+			return err
+		}
+		if !units[vesting.Total.Denom] {
+			return sdkerrors.Wrapf(ErrTokenNotExists, "token[%s] of vesting %d does not exist", vesting.Total.Denom, vesting.Id)
+		}
+		if vesting.Id >= gs.NextVestingId {
+			return sdkerrors.Wrapf(ErrInvalidVesting, "vesting id %d must be less than the next vesting id %d", vesting.Id, gs.NextVestingId)
+		}
+		if vestings[vesting.Id] {
+			return sdkerrors.Wrapf(ErrInvalidVesting, "duplicate vesting id %d", vesting.Id)
+		}
+		vestings[vesting.Id] = true
+	}
+	if gs.NextVestingId == 0 {
+		return sdkerrors.Wrap(ErrInvalidVesting, "next vesting id must be positive")
+	}
+
+	// validate fee overrides, the symbols may not be issued yet
+	overridden := make(map[string]bool)
+	for _, override := range gs.FeeOverrides {
+		if err := override.Validate(); err != nil {
+			return err
+		}
+		if overridden[override.Symbol] {
+			return sdkerrors.Wrapf(ErrInvalidSymbol, "duplicate fee override of symbol %s", override.Symbol)
+		}
+		overridden[override.Symbol] = true
+	}
+
+	// validate symbol reservations, the reserved symbols must not be issued
+	issued := make(map[string]bool)
+	for _, token := range gs.Tokens {
+		issued[token.Symbol] = true
+	}
+	reserved := make(map[string]bool)
+	for _, reservation := range gs.SymbolReservations {
+		if err := reservation.Validate(); err != nil {
+			return err
+		}
+		if issued[reservation.Symbol] {
+			return sdkerrors.Wrapf(ErrSymbolAlreadyExists, "reserved symbol %s is already issued", reservation.Symbol)
+		}
+		if reserved[reservation.Symbol] {
+			return sdkerrors.Wrapf(ErrInvalidSymbol, "duplicate reservation of symbol %s", reservation.Symbol)
+		}
+		reserved[reservation.Symbol] = true
+	}
+
+	// validate token snapshots
+	snapshots := make(map[uint64]bool)
+	for _, snapshot := range gs.TokenSnapshots {
+		if err := snapshot.Validate(); err != nil {
+			return err
+		}
+		if !issued[snapshot.Symbol] {
+			return sdkerrors.Wrapf(ErrTokenNotExists, "token[%s] of snapshot %d does not exist", snapshot.Symbol, snapshot.Id)
+		}
+		if snapshot.Id >= gs.NextSnapshotId {
+			return sdkerrors.Wrapf(ErrInvalidSnapshot, "snapshot id %d must be less than the next snapshot id %d", snapshot.Id, gs.NextSnapshotId)
+		}
+		if snapshots[snapshot.Id] {
+			return sdkerrors.Wrapf(ErrInvalidSnapshot, "duplicate snapshot id %d", snapshot.Id)
+		}
+		snapshots[snapshot.Id] = true
+	}
+	if gs.NextSnapshotId == 0 {
+		return sdkerrors.Wrap(ErrInvalidSnapshot, "next snapshot id must be positive")
+	}
+
+	// validate token distributions and their claims
+	symbolUnits := make(map[string]string)
+	for _, token := range gs.Tokens {
+		symbolUnits[token.Symbol] = token.SmallestUnit
+	}
+	distributions := make(map[uint64]TokenDistribution)
+	for _, distribution := range gs.TokenDistributions {
+		if err := distribution.Validate(); err != nil {
+			return err
+		}
+		if unit, found := symbolUnits[distribution.Symbol]; !found || unit != distribution.Total.Denom {
+			return sdkerrors.Wrapf(ErrTokenNotExists, "token[%s] of distribution %d does not exist", distribution.Total.Denom, distribution.Id)
+		}
+		if distribution.Id >= gs.NextDistributionId {
+			return sdkerrors.Wrapf(ErrInvalidDistribution, "distribution id %d must be less than the next distribution id %d", distribution.Id, gs.NextDistributionId)
+		}
+		if _, found := distributions[distribution.Id]; found {
+			return sdkerrors.Wrapf(ErrInvalidDistribution, "duplicate distribution id %d", distribution.Id)
+		}
+		distributions[distribution.Id] = distribution
+	}
+	if gs.NextDistributionId == 0 {
+		return sdkerrors.Wrap(ErrInvalidDistribution, "next distribution id must be positive")
+	}
+
+	claimed := make(map[uint64]sdk.Int)
+	claims := make(map[string]bool)
+	for _, claim := range gs.DistributionClaims {
+		if err := claim.Validate(); err != nil {
+			return err
+		}
+		distribution, found := distributions[claim.DistributionId]
+		if !found {
+			return sdkerrors.Wrapf(ErrDistributionNotFound, "distribution %d of claim does not exist", claim.DistributionId)
+		}
+		if claim.Amount.Denom != distribution.Total.Denom {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "claimed amount %s of distribution %d", claim.Amount, claim.DistributionId)
+		}
+		key := fmt.Sprintf("%d/%s", claim.DistributionId, claim.Address)
+		if claims[key] {
+			return sdkerrors.Wrapf(ErrAlreadyClaimed, "duplicate claim of distribution %d by %s", claim.DistributionId, claim.Address)
+		}
+		claims[key] = true
+
+		if _, found := claimed[claim.DistributionId]; !found {
+			claimed[claim.DistributionId] = sdk.ZeroInt()
+		}
+		claimed[claim.DistributionId] = claimed[claim.DistributionId].Add(claim.Amount.Amount)
+	}
+	for _, distribution := range gs.TokenDistributions {
+		amount, found := claimed[distribution.Id]
+		if !found {
+			amount = sdk.ZeroInt()
+		}
+		if !amount.Equal(distribution.Claimed.Amount) {
+			return sdkerrors.Wrapf(ErrInvalidDistribution, "claims of distribution %d sum to %s, expected %s",
+				distribution.Id, amount, distribution.Claimed.Amount)
+		}
+	}
+
 	return nil
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, tokens []Token, burntCoins sdk.Coins) *GenesisState {
+func NewGenesisState(params Params, tokens []Token, burntCoins sdk.Coins, lockedTokens []string,
+	tokenRoles []TokenRoles, holderBurntCoins sdk.Coins, pausedTokens []string,
+	frozenAccounts []FrozenAccount, tokenVestings []TokenVesting, nextVestingID uint64,
+	feeOverrides []TokenFeeOverride, symbolReservations []SymbolReservation,
+	tokenSnapshots []TokenSnapshot, nextSnapshotID uint64,
+	tokenDistributions []TokenDistribution, distributionClaims []DistributionClaim,
+	nextDistributionID uint64) *GenesisState {
 	return &GenesisState{
 		Params:	params,
 		Tokens:	tokens,
 		BurnedCoins: burntCoins,
+		LockedTokens: lockedTokens,
+		TokenRoles: tokenRoles,
+		HolderBurnedCoins: holderBurntCoins,
+		PausedTokens: pausedTokens,
+		FrozenAccounts: frozenAccounts,
+		TokenVestings: tokenVestings,
+		NextVestingId: nextVestingID,
+		FeeOverrides: feeOverrides,
+		SymbolReservations: symbolReservations,
+		TokenSnapshots: tokenSnapshots,
+		NextSnapshotId: nextSnapshotID,
+		TokenDistributions: tokenDistributions,
+		DistributionClaims: distributionClaims,
+		NextDistributionId: nextDistributionID,
 	}
 }
 
 // DefaultGenesisState returns a default bank module genesis state.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []Token{}, sdk.Coins{})
+	return NewGenesisState(DefaultParams(), []Token{}, sdk.Coins{}, []string{}, []TokenRoles{}, sdk.Coins{}, []string{}, []FrozenAccount{}, []TokenVesting{}, 1, []TokenFeeOverride{}, []SymbolReservation{}, []TokenSnapshot{}, 1, []TokenDistribution{}, []DistributionClaim{}, 1)
 }
 
 
@@ -29,6 +29,31 @@ type GenesisState struct {
 	Params      Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	Tokens      []Token      `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens"`
 	BurnedCoins []types.Coin `protobuf:"bytes,3,rep,name=burned_coins,json=burnedCoins,proto3" json:"burned_coins"`
+	// smallest units of the tokens which can only be transferred by their owner
+	LockedTokens []string `protobuf:"bytes,4,rep,name=locked_tokens,json=lockedTokens,proto3" json:"locked_tokens,omitempty" yaml:"locked_tokens"`
+	// roles of the tokens granted by their owners
+	TokenRoles []TokenRoles `protobuf:"bytes,5,rep,name=token_roles,json=tokenRoles,proto3" json:"token_roles" yaml:"token_roles"`
+	// part of the burned coins burnt by the holders of the tokens
+	HolderBurnedCoins []types.Coin `protobuf:"bytes,6,rep,name=holder_burned_coins,json=holderBurnedCoins,proto3" json:"holder_burned_coins" yaml:"holder_burned_coins"`
+	// smallest units of the tokens whose transfers are paused
+	PausedTokens []string `protobuf:"bytes,7,rep,name=paused_tokens,json=pausedTokens,proto3" json:"paused_tokens,omitempty" yaml:"paused_tokens"`
+	// accounts whose outgoing transfers of the freezable tokens are frozen
+	FrozenAccounts []FrozenAccount `protobuf:"bytes,8,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts" yaml:"frozen_accounts"`
+	// token vestings not fully released yet
+	TokenVestings []TokenVesting `protobuf:"bytes,9,rep,name=token_vestings,json=tokenVestings,proto3" json:"token_vestings" yaml:"token_vestings"`
+	NextVestingId uint64         `protobuf:"varint,10,opt,name=next_vesting_id,json=nextVestingId,proto3" json:"next_vesting_id,omitempty" yaml:"next_vesting_id"`
+	// issue and mint fees of the symbols overridden by governance
+	FeeOverrides []TokenFeeOverride `protobuf:"bytes,11,rep,name=fee_overrides,json=feeOverrides,proto3" json:"fee_overrides" yaml:"fee_overrides"`
+	// symbols reserved by governance
+	SymbolReservations []SymbolReservation `protobuf:"bytes,12,rep,name=symbol_reservations,json=symbolReservations,proto3" json:"symbol_reservations" yaml:"symbol_reservations"`
+	// snapshots of the token holders
+	TokenSnapshots []TokenSnapshot `protobuf:"bytes,13,rep,name=token_snapshots,json=tokenSnapshots,proto3" json:"token_snapshots" yaml:"token_snapshots"`
+	NextSnapshotId uint64          `protobuf:"varint,14,opt,name=next_snapshot_id,json=nextSnapshotId,proto3" json:"next_snapshot_id,omitempty" yaml:"next_snapshot_id"`
+	// token distributions not ended yet
+	TokenDistributions []TokenDistribution `protobuf:"bytes,15,rep,name=token_distributions,json=tokenDistributions,proto3" json:"token_distributions" yaml:"token_distributions"`
+	// amounts claimed from the token distributions
+	DistributionClaims []DistributionClaim `protobuf:"bytes,16,rep,name=distribution_claims,json=distributionClaims,proto3" json:"distribution_claims" yaml:"distribution_claims"`
+	NextDistributionId uint64              `protobuf:"varint,17,opt,name=next_distribution_id,json=nextDistributionId,proto3" json:"next_distribution_id,omitempty" yaml:"next_distribution_id"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -85,6 +110,104 @@ func (m *GenesisState) GetBurnedCoins() []types.Coin {
 	return nil
 }
 
+func (m *GenesisState) GetLockedTokens() []string {
+	if m != nil {
+		return m.LockedTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenRoles() []TokenRoles {
+	if m != nil {
+		return m.TokenRoles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHolderBurnedCoins() []types.Coin {
+	if m != nil {
+		return m.HolderBurnedCoins
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedTokens() []string {
+	if m != nil {
+		return m.PausedTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenAccounts() []FrozenAccount {
+	if m != nil {
+		return m.FrozenAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenVestings() []TokenVesting {
+	if m != nil {
+		return m.TokenVestings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextVestingId() uint64 {
+	if m != nil {
+		return m.NextVestingId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetFeeOverrides() []TokenFeeOverride {
+	if m != nil {
+		return m.FeeOverrides
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSymbolReservations() []SymbolReservation {
+	if m != nil {
+		return m.SymbolReservations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenSnapshots() []TokenSnapshot {
+	if m != nil {
+		return m.TokenSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextSnapshotId() uint64 {
+	if m != nil {
+		return m.NextSnapshotId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTokenDistributions() []TokenDistribution {
+	if m != nil {
+		return m.TokenDistributions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionClaims() []DistributionClaim {
+	if m != nil {
+		return m.DistributionClaims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextDistributionId() uint64 {
+	if m != nil {
+		return m.NextDistributionId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "gauss.token.GenesisState")
 }
@@ -92,25 +215,52 @@ func init() {
 func init() { proto.RegisterFile("gauss/token/genesis.proto", fileDescriptor_5aa181acbd4bf1fe) }
 
 var fileDescriptor_5aa181acbd4bf1fe = []byte{
-	// 276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x63, 0x8a, 0x3a, 0x24, 0x9d, 0x02, 0x12, 0x69, 0x07, 0x53, 0xb1, 0xd0, 0xc9, 0x26,
-	0x85, 0x0b, 0x10, 0x06, 0x56, 0x04, 0x4c, 0x2c, 0xc8, 0x0e, 0x96, 0x89, 0x50, 0xf2, 0xa2, 0x3c,
-	0xa7, 0x82, 0x5b, 0x70, 0x1b, 0xae, 0xd0, 0xb1, 0x23, 0x13, 0x42, 0xc9, 0x45, 0x90, 0xed, 0x44,
-	0x2a, 0xcb, 0xaf, 0xe8, 0x7d, 0xff, 0x9f, 0xe7, 0xff, 0x85, 0x73, 0x2d, 0x5a, 0x44, 0x6e, 0xe0,
-	0x4d, 0x55, 0x5c, 0xab, 0x4a, 0x61, 0x81, 0xac, 0x6e, 0xc0, 0x40, 0x1c, 0x39, 0xc4, 0x1c, 0x5a,
-	0x1c, 0x6b, 0xd0, 0xe0, 0xe6, 0xdc, 0x7e, 0x79, 0xcb, 0x82, 0xe6, 0x80, 0x25, 0x20, 0x97, 0x02,
-	0x15, 0xdf, 0xa4, 0x52, 0x19, 0x91, 0xf2, 0x1c, 0x8a, 0x6a, 0xe0, 0x27, 0xfb, 0x7f, 0x77, 0xea,
-	0xc1, 0xd9, 0x17, 0x09, 0x67, 0xb7, 0x7e, 0xdb, 0x83, 0x11, 0x46, 0xc5, 0x69, 0x38, 0xad, 0x45,
-	0x23, 0x4a, 0x4c, 0xc8, 0x92, 0xac, 0xa2, 0xf5, 0x11, 0xdb, 0xdb, 0xce, 0xee, 0x1c, 0xca, 0x0e,
-	0xb7, 0x3f, 0xa7, 0xc1, 0xfd, 0x60, 0x8c, 0x2f, 0xc2, 0xa9, 0xa3, 0x98, 0x1c, 0x2c, 0x27, 0xab,
-	0x68, 0x1d, 0xff, 0x8b, 0x3c, 0x5a, 0x1d, 0x13, 0xde, 0x17, 0x67, 0xe1, 0x4c, 0xb6, 0x4d, 0xa5,
-	0x5e, 0x9e, 0xed, 0x1b, 0x31, 0x99, 0xb8, 0xdc, 0x9c, 0xf9, 0x16, 0xcc, 0xb6, 0x60, 0x43, 0x0b,
-	0x76, 0x03, 0xc5, 0x18, 0x8f, 0x7c, 0xc8, 0x4e, 0x30, 0xbb, 0xde, 0x76, 0x94, 0xec, 0x3a, 0x4a,
-	0x7e, 0x3b, 0x4a, 0x3e, 0x7b, 0x1a, 0xec, 0x7a, 0x1a, 0x7c, 0xf7, 0x34, 0x78, 0x3a, 0xd7, 0x85,
-	0x79, 0x6d, 0x25, 0xcb, 0xa1, 0xe4, 0xbe, 0xb7, 0xd7, 0xcd, 0x15, 0x7f, 0x1f, 0x4f, 0xf0, 0x51,
-	0x2b, 0x94, 0x53, 0x77, 0x83, 0xcb, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x96, 0xd7, 0xb7, 0x4f,
-	0x7c, 0x01, 0x00, 0x00,
+	// 720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x4d, 0x68, 0x09, 0x74, 0xf3, 0xd1, 0x76, 0x5b, 0xa8, 0x9b, 0x52, 0x27, 0xda, 0x0b, 0x39,
+	0xd9, 0xb4, 0x70, 0x42, 0xe2, 0x50, 0x17, 0x8a, 0x7a, 0x02, 0xb6, 0x15, 0x07, 0x2e, 0xc6, 0xb1,
+	0x37, 0xa9, 0xd5, 0xc4, 0x1b, 0x79, 0x36, 0x51, 0xcb, 0xaf, 0xe0, 0x47, 0x71, 0xe8, 0xb1, 0x47,
+	0x4e, 0x11, 0x6a, 0xff, 0x41, 0x7e, 0x01, 0xf2, 0xee, 0x86, 0xac, 0xdd, 0x48, 0x5c, 0xac, 0x64,
+	0xe6, 0xbd, 0x37, 0x6f, 0xde, 0x58, 0x32, 0xda, 0xed, 0x07, 0x63, 0x00, 0x57, 0xf0, 0x4b, 0x96,
+	0xb8, 0x7d, 0x96, 0x30, 0x88, 0xc1, 0x19, 0xa5, 0x5c, 0x70, 0x5c, 0x95, 0x2d, 0x47, 0xb6, 0x9a,
+	0xdb, 0x7d, 0xde, 0xe7, 0xb2, 0xee, 0x66, 0xbf, 0x14, 0xa4, 0x69, 0x87, 0x1c, 0x86, 0x1c, 0xdc,
+	0x6e, 0x00, 0xcc, 0x9d, 0x1c, 0x74, 0x99, 0x08, 0x0e, 0xdc, 0x90, 0xc7, 0x89, 0xee, 0xef, 0x98,
+	0xea, 0xf2, 0xa9, 0x1a, 0xe4, 0x57, 0x15, 0xd5, 0x3e, 0xaa, 0x69, 0x67, 0x22, 0x10, 0x0c, 0x1f,
+	0xa0, 0xca, 0x28, 0x48, 0x83, 0x21, 0x58, 0xe5, 0x76, 0xb9, 0x53, 0x3d, 0xdc, 0x72, 0x8c, 0xe9,
+	0xce, 0x67, 0xd9, 0xf2, 0x56, 0x6f, 0xa6, 0xad, 0x12, 0xd5, 0x40, 0xfc, 0x0a, 0x55, 0x64, 0x17,
+	0xac, 0x47, 0xed, 0x95, 0x4e, 0xf5, 0x10, 0xe7, 0x28, 0xe7, 0xd9, 0x73, 0xce, 0x50, 0x38, 0xec,
+	0xa1, 0x5a, 0x77, 0x9c, 0x26, 0x2c, 0xf2, 0x33, 0x8f, 0x60, 0xad, 0x48, 0xde, 0xae, 0xa3, 0xb6,
+	0x70, 0xb2, 0x2d, 0x1c, 0xbd, 0x85, 0x73, 0xcc, 0xe3, 0x39, 0xbd, 0xaa, 0x48, 0x59, 0x05, 0xf0,
+	0x3b, 0x54, 0x1f, 0xf0, 0xf0, 0x92, 0x45, 0xbe, 0x1e, 0xbe, 0xda, 0x5e, 0xe9, 0xac, 0x79, 0xd6,
+	0x6c, 0xda, 0xda, 0xbe, 0x0e, 0x86, 0x83, 0xb7, 0x24, 0xd7, 0x26, 0xb4, 0xa6, 0xfe, 0x9f, 0x2b,
+	0x0b, 0xe7, 0xa8, 0x2a, 0x1b, 0x7e, 0xca, 0x07, 0x0c, 0xac, 0xc7, 0xd2, 0xc1, 0xce, 0x43, 0xe7,
+	0x34, 0x6b, 0x7b, 0xcd, 0x6c, 0xfe, 0x6c, 0xda, 0xc2, 0x4a, 0xd9, 0x60, 0x12, 0x8a, 0xc4, 0x3f,
+	0x1c, 0x1e, 0xa2, 0xad, 0x0b, 0x3e, 0x88, 0x58, 0xea, 0xe7, 0xf6, 0xab, 0xfc, 0x6f, 0x3f, 0xa2,
+	0xf5, 0x9b, 0x4a, 0x7f, 0x89, 0x06, 0xa1, 0x9b, 0xaa, 0xea, 0xe5, 0x33, 0x18, 0x05, 0x63, 0x58,
+	0x64, 0xf0, 0xa4, 0x98, 0x41, 0xae, 0x4d, 0x68, 0x4d, 0xfd, 0xd7, 0x19, 0x84, 0x68, 0xbd, 0x97,
+	0xf2, 0x1f, 0x2c, 0xf1, 0x83, 0x30, 0xe4, 0xe3, 0x44, 0x80, 0xf5, 0x54, 0x3a, 0x6d, 0xe6, 0x72,
+	0x38, 0x91, 0x98, 0x23, 0x05, 0xf1, 0x6c, 0x6d, 0xf5, 0xb9, 0x1a, 0x50, 0x10, 0x20, 0xb4, 0xd1,
+	0x33, 0xe1, 0x80, 0x7d, 0xd4, 0x50, 0x71, 0x4d, 0x18, 0x88, 0x38, 0xe9, 0x83, 0xb5, 0xa6, 0xd3,
+	0x78, 0x90, 0xf5, 0x57, 0x85, 0xf0, 0xf6, 0xf5, 0x88, 0x67, 0x66, 0xda, 0x73, 0x3a, 0xa1, 0x75,
+	0x61, 0x80, 0xb3, 0x97, 0x69, 0x3d, 0x61, 0x57, 0x62, 0x0e, 0xf0, 0xe3, 0xc8, 0x42, 0xed, 0x72,
+	0x67, 0xd5, 0x6b, 0x2e, 0x5c, 0x16, 0x00, 0x84, 0xd6, 0xb3, 0x8a, 0x96, 0x38, 0x8d, 0xf0, 0x77,
+	0x54, 0xef, 0x31, 0xe6, 0xf3, 0x09, 0x4b, 0xd3, 0x38, 0x62, 0x60, 0x55, 0xa5, 0xc7, 0xfd, 0x87,
+	0x1e, 0x4f, 0x18, 0xfb, 0xa4, 0x51, 0xde, 0x0b, 0xed, 0x53, 0x67, 0x9d, 0x53, 0x20, 0xb4, 0xd6,
+	0x5b, 0x40, 0x01, 0x03, 0xda, 0x82, 0xeb, 0x61, 0x97, 0x0f, 0xfc, 0x94, 0x01, 0x4b, 0x27, 0x81,
+	0x88, 0x79, 0x02, 0x56, 0x4d, 0xce, 0xb1, 0x73, 0x73, 0xce, 0x24, 0x8e, 0x2e, 0x60, 0xc5, 0xd7,
+	0x63, 0x89, 0x10, 0xa1, 0x18, 0x8a, 0x34, 0x79, 0x60, 0x15, 0x1e, 0x24, 0xc1, 0x08, 0x2e, 0xb8,
+	0x00, 0xab, 0xbe, 0xe4, 0xc0, 0x72, 0xb1, 0x33, 0x0d, 0x29, 0x1e, 0xb8, 0x20, 0x40, 0x68, 0x43,
+	0x98, 0x70, 0xc0, 0x1f, 0xd0, 0x86, 0x8c, 0x77, 0x0e, 0xc9, 0x0e, 0xd0, 0x90, 0x07, 0xd8, 0x9b,
+	0x4d, 0x5b, 0x3b, 0xc6, 0x01, 0x0c, 0x04, 0xa1, 0x8d, 0xac, 0x34, 0x57, 0x39, 0x8d, 0xb2, 0x80,
+	0xd4, 0xa8, 0x28, 0x06, 0x91, 0xc6, 0xdd, 0xb1, 0x0a, 0x68, 0x7d, 0x49, 0x40, 0xd2, 0xef, 0x7b,
+	0x03, 0x56, 0x0c, 0x68, 0x89, 0x10, 0xa1, 0x58, 0x14, 0x69, 0xf2, 0x2a, 0x26, 0xca, 0x0f, 0x07,
+	0x41, 0x3c, 0x04, 0x6b, 0x63, 0xc9, 0x50, 0x93, 0x78, 0x9c, 0xc1, 0x8a, 0x43, 0x97, 0x08, 0x11,
+	0x8a, 0xa3, 0x22, 0x0d, 0xf0, 0x17, 0xb4, 0x2d, 0xe3, 0xc8, 0x11, 0xe2, 0xc8, 0xda, 0x94, 0xa1,
+	0xb5, 0x66, 0xd3, 0xd6, 0x9e, 0x11, 0x5a, 0x01, 0x45, 0x28, 0xce, 0xca, 0xa6, 0x9b, 0xd3, 0xc8,
+	0x3b, 0xba, 0xb9, 0xb3, 0xcb, 0xb7, 0x77, 0x76, 0xf9, 0xcf, 0x9d, 0x5d, 0xfe, 0x79, 0x6f, 0x97,
+	0x6e, 0xef, 0xed, 0xd2, 0xef, 0x7b, 0xbb, 0xf4, 0xed, 0x65, 0x3f, 0x16, 0x17, 0xe3, 0xae, 0x13,
+	0xf2, 0xa1, 0xab, 0x3e, 0x02, 0xea, 0x39, 0x79, 0xe3, 0x5e, 0xcd, 0xbf, 0x07, 0xd7, 0x23, 0x06,
+	0xdd, 0x8a, 0xfc, 0x20, 0xbc, 0xfe, 0x3b, 0x00, 0x63, 0x3a, 0x20, 0xbb, 0x89, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -133,6 +283,169 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextDistributionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextDistributionId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.DistributionClaims) > 0 {
+		for iNdEx := len(m.DistributionClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.TokenDistributions) > 0 {
+		for iNdEx := len(m.TokenDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.NextSnapshotId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextSnapshotId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.TokenSnapshots) > 0 {
+		for iNdEx := len(m.TokenSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.SymbolReservations) > 0 {
+		for iNdEx := len(m.SymbolReservations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SymbolReservations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.FeeOverrides) > 0 {
+		for iNdEx := len(m.FeeOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.NextVestingId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextVestingId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenVestings) > 0 {
+		for iNdEx := len(m.TokenVestings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenVestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for iNdEx := len(m.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PausedTokens) > 0 {
+		for iNdEx := len(m.PausedTokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedTokens[iNdEx])
+			copy(dAtA[i:], m.PausedTokens[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedTokens[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.HolderBurnedCoins) > 0 {
+		for iNdEx := len(m.HolderBurnedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HolderBurnedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TokenRoles) > 0 {
+		for iNdEx := len(m.TokenRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LockedTokens) > 0 {
+		for iNdEx := len(m.LockedTokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LockedTokens[iNdEx])
+			copy(dAtA[i:], m.LockedTokens[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.LockedTokens[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.BurnedCoins) > 0 {
 		for iNdEx := len(m.BurnedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -205,6 +518,81 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.LockedTokens) > 0 {
+		for _, s := range m.LockedTokens {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenRoles) > 0 {
+		for _, e := range m.TokenRoles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HolderBurnedCoins) > 0 {
+		for _, e := range m.HolderBurnedCoins {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedTokens) > 0 {
+		for _, s := range m.PausedTokens {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for _, e := range m.FrozenAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenVestings) > 0 {
+		for _, e := range m.TokenVestings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextVestingId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextVestingId))
+	}
+	if len(m.FeeOverrides) > 0 {
+		for _, e := range m.FeeOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SymbolReservations) > 0 {
+		for _, e := range m.SymbolReservations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenSnapshots) > 0 {
+		for _, e := range m.TokenSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextSnapshotId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextSnapshotId))
+	}
+	if len(m.TokenDistributions) > 0 {
+		for _, e := range m.TokenDistributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionClaims) > 0 {
+		for _, e := range m.DistributionClaims {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextDistributionId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextDistributionId))
+	}
 	return n
 }
 
@@ -344,6 +732,433 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedTokens = append(m.LockedTokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRoles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenRoles = append(m.TokenRoles, TokenRoles{})
+			if err := m.TokenRoles[len(m.TokenRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderBurnedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HolderBurnedCoins = append(m.HolderBurnedCoins, types.Coin{})
+			if err := m.HolderBurnedCoins[len(m.HolderBurnedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedTokens = append(m.PausedTokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccounts = append(m.FrozenAccounts, FrozenAccount{})
+			if err := m.FrozenAccounts[len(m.FrozenAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenVestings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenVestings = append(m.TokenVestings, TokenVesting{})
+			if err := m.TokenVestings[len(m.TokenVestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVestingId", wireType)
+			}
+			m.NextVestingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextVestingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeOverrides = append(m.FeeOverrides, TokenFeeOverride{})
+			if err := m.FeeOverrides[len(m.FeeOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolReservations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolReservations = append(m.SymbolReservations, SymbolReservation{})
+			if err := m.SymbolReservations[len(m.SymbolReservations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenSnapshots = append(m.TokenSnapshots, TokenSnapshot{})
+			if err := m.TokenSnapshots[len(m.TokenSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSnapshotId", wireType)
+			}
+			m.NextSnapshotId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSnapshotId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenDistributions = append(m.TokenDistributions, TokenDistribution{})
+			if err := m.TokenDistributions[len(m.TokenDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionClaims = append(m.DistributionClaims, DistributionClaim{})
+			if err := m.DistributionClaims[len(m.DistributionClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDistributionId", wireType)
+			}
+			m.NextDistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextDistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
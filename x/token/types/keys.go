@@ -1,54 +1,219 @@
-package types
-
-import (
-	sdk "github.com/cosmos/cosmos-sdk/types"
-)
-
-const (
-	// ModuleName defines the module name
-	ModuleName = "token"
-
-	// StoreKey defines the primary module store key
-	StoreKey = ModuleName
-
-	// RouteKey defines the module's message routing key
-	RouterKey = ModuleName
-
-	// QuerierRoute defines the module's query routing key
-	QuerierRoute = ModuleName
-
-	// DefaultParamspace default name for parameter store
-	DefaultParamspace = ModuleName
-)
-
-var (
-	// SymbolPrefix define a symbol prefix
-	SymbolPrefix    = []byte{0x21}
-	// UnitPrefix define a unit prefix
-	UnitPrefix      = []byte{0x22}
-	// OwnerSymbolsKey define a symbols prefix with owner
-	OwnerSymbolKey  = []byte{0x23}
-	// BurntCoinPrefix define a symbols prefix 
-	BurntCoinPrefix = []byte{0x24}
-)
-
-// GetSymbolKey returns the key with the specified symbol
-func GetSymbolKey(symbol string) []byte {
-	return append(SymbolPrefix, []byte(symbol)...)
-}
-
-// GetUnitKey returns the key with the specified symbol
-func GetUnitKey(unit string) []byte {
-	return append(UnitPrefix, []byte(unit)...)
-}
-
-// GetOwnerSymbolKey returns the key of the specified owner and symbol. Intended for querying all symbols of an owner
-func GetOwnerSymbolKey(owner sdk.AccAddress, symbol string) []byte {
-	return append(append(OwnerSymbolKey, owner.Bytes()...), []byte(symbol)...)
-}
-
-// GetBurntCoinKey
-func GetBurntCoinKey(symbol string) []byte {
-	return append(BurntCoinPrefix, []byte(symbol)...)
-}
-
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "token"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouteKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace default name for parameter store
+	DefaultParamspace = ModuleName
+)
+
+var (
+	// SymbolPrefix define a symbol prefix
+	SymbolPrefix    = []byte{0x21}
+	// UnitPrefix define a unit prefix
+	UnitPrefix      = []byte{0x22}
+	// OwnerSymbolsKey define a symbols prefix with owner
+	OwnerSymbolKey  = []byte{0x23}
+	// BurntCoinPrefix define a symbols prefix 
+	BurntCoinPrefix = []byte{0x24}
+	// LockedTokenPrefix define a prefix of the locked tokens with unit
+	LockedTokenPrefix = []byte{0x25}
+	// TokenRoleKey define a prefix of the token roles with address
+	TokenRoleKey = []byte{0x26}
+	// HolderBurntCoinPrefix define a prefix of the coins burnt by the token holders with unit
+	HolderBurntCoinPrefix = []byte{0x27}
+	// PausedTokenPrefix define a prefix of the paused tokens with unit
+	PausedTokenPrefix = []byte{0x28}
+	// FrozenAccountPrefix define a prefix of the frozen accounts with unit
+	FrozenAccountPrefix = []byte{0x29}
+	// NextVestingIDKey define the key of the next token vesting id
+	NextVestingIDKey = []byte{0x2A}
+	// TokenVestingPrefix define a prefix of the token vestings with id
+	TokenVestingPrefix = []byte{0x2B}
+	// BeneficiaryVestingPrefix define a prefix of the token vesting ids with beneficiary
+	BeneficiaryVestingPrefix = []byte{0x2C}
+	// FeeOverridePrefix define a prefix of the token fee overrides with symbol
+	FeeOverridePrefix = []byte{0x2D}
+	// SymbolReservationPrefix define a prefix of the reserved symbols with symbol
+	SymbolReservationPrefix = []byte{0x2E}
+	// NextSnapshotIDKey define the key of the next token snapshot id
+	NextSnapshotIDKey = []byte{0x2F}
+	// TokenSnapshotPrefix define a prefix of the token snapshots with id
+	TokenSnapshotPrefix = []byte{0x30}
+	// SymbolSnapshotPrefix define a prefix of the token snapshot ids with symbol
+	SymbolSnapshotPrefix = []byte{0x31}
+	// NextDistributionIDKey define the key of the next token distribution id
+	NextDistributionIDKey = []byte{0x32}
+	// TokenDistributionPrefix define a prefix of the token distributions with id
+	TokenDistributionPrefix = []byte{0x33}
+	// SymbolDistributionPrefix define a prefix of the token distribution ids with symbol
+	SymbolDistributionPrefix = []byte{0x34}
+	// DistributionClaimPrefix define a prefix of the distribution claims with id and address
+	DistributionClaimPrefix = []byte{0x35}
+	// VestingQueuePrefix define a prefix of the token vesting ids with their next release time
+	VestingQueuePrefix = []byte{0x36}
+	// DistributionQueuePrefix define a prefix of the token distribution ids with their end time
+	DistributionQueuePrefix = []byte{0x37}
+)
+
+// GetSymbolKey returns the key with the specified symbol
+func GetSymbolKey(symbol string) []byte {
+	return append(SymbolPrefix, []byte(symbol)...)
+}
+
+// GetUnitKey returns the key with the specified symbol
+func GetUnitKey(unit string) []byte {
+	return append(UnitPrefix, []byte(unit)...)
+}
+
+// GetOwnerSymbolKey returns the key of the specified owner and symbol. Intended for querying all symbols of an owner
+func GetOwnerSymbolKey(owner sdk.AccAddress, symbol string) []byte {
+	return append(append(OwnerSymbolKey, owner.Bytes()...), []byte(symbol)...)
+}
+
+// GetBurntCoinKey
+func GetBurntCoinKey(symbol string) []byte {
+	return append(BurntCoinPrefix, []byte(symbol)...)
+}
+
+// GetHolderBurntCoinKey returns the key of the coin burnt by the holders of the token with the specified unit
+func GetHolderBurntCoinKey(unit string) []byte {
+	return append(HolderBurntCoinPrefix, []byte(unit)...)
+}
+
+// GetLockedTokenKey returns the key of the locked token with the specified unit
+func GetLockedTokenKey(unit string) []byte {
+	return append(LockedTokenPrefix, []byte(unit)...)
+}
+
+// GetPausedTokenKey returns the key of the paused token with the specified unit
+func GetPausedTokenKey(unit string) []byte {
+	return append(PausedTokenPrefix, []byte(unit)...)
+}
+
+// GetFrozenAccountsKey returns the prefix of the frozen accounts of the token with the specified unit
+func GetFrozenAccountsKey(unit string) []byte {
+	return append(FrozenAccountPrefix, lengthPrefixed([]byte(unit))...)
+}
+
+// GetFrozenAccountKey returns the key of the account frozen for the token with the specified unit
+func GetFrozenAccountKey(unit string, addr sdk.AccAddress) []byte {
+	return append(GetFrozenAccountsKey(unit), addr.Bytes()...)
+}
+
+// SplitFrozenAccountKey returns the unit and the address of a frozen account key
+func SplitFrozenAccountKey(key []byte) (unit string, addr sdk.AccAddress) {
+	unitLen := int(key[len(FrozenAccountPrefix)])
+	unitStart := len(FrozenAccountPrefix) + 1
+	return string(key[unitStart : unitStart+unitLen]), key[unitStart+unitLen:]
+}
+
+// GetTokenVestingKey returns the key of the token vesting with the specified id
+func GetTokenVestingKey(id uint64) []byte {
+	return append(TokenVestingPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetBeneficiaryVestingsKey returns the prefix of the token vesting ids of the beneficiary
+func GetBeneficiaryVestingsKey(beneficiary sdk.AccAddress) []byte {
+	return append(BeneficiaryVestingPrefix, lengthPrefixed(beneficiary.Bytes())...)
+}
+
+// GetBeneficiaryVestingKey returns the key of the token vesting id of the beneficiary
+func GetBeneficiaryVestingKey(beneficiary sdk.AccAddress, id uint64) []byte {
+	return append(GetBeneficiaryVestingsKey(beneficiary), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetVestingQueueTimeKey returns the prefix of the token vesting ids due at the specified time
+func GetVestingQueueTimeKey(releaseTime time.Time) []byte {
+	return append(VestingQueuePrefix, sdk.FormatTimeBytes(releaseTime)...)
+}
+
+// GetVestingQueueKey returns the key of the token vesting id due at the specified time
+func GetVestingQueueKey(releaseTime time.Time, id uint64) []byte {
+	return append(GetVestingQueueTimeKey(releaseTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetFeeOverrideKey returns the key of the fee override of the specified symbol
+func GetFeeOverrideKey(symbol string) []byte {
+	return append(FeeOverridePrefix, []byte(symbol)...)
+}
+
+// GetSymbolReservationKey returns the key of the reservation of the specified symbol
+func GetSymbolReservationKey(symbol string) []byte {
+	return append(SymbolReservationPrefix, []byte(symbol)...)
+}
+
+// GetTokenSnapshotKey returns the key of the token snapshot with the specified id
+func GetTokenSnapshotKey(id uint64) []byte {
+	return append(TokenSnapshotPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSymbolSnapshotsKey returns the prefix of the snapshot ids of the specified symbol
+func GetSymbolSnapshotsKey(symbol string) []byte {
+	return append(SymbolSnapshotPrefix, lengthPrefixed([]byte(symbol))...)
+}
+
+// GetSymbolSnapshotKey returns the key of the snapshot id of the specified symbol
+func GetSymbolSnapshotKey(symbol string, id uint64) []byte {
+	return append(GetSymbolSnapshotsKey(symbol), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenDistributionKey returns the key of the token distribution with the specified id
+func GetTokenDistributionKey(id uint64) []byte {
+	return append(TokenDistributionPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSymbolDistributionsKey returns the prefix of the distribution ids of the specified symbol
+func GetSymbolDistributionsKey(symbol string) []byte {
+	return append(SymbolDistributionPrefix, lengthPrefixed([]byte(symbol))...)
+}
+
+// GetSymbolDistributionKey returns the key of the distribution id of the specified symbol
+func GetSymbolDistributionKey(symbol string, id uint64) []byte {
+	return append(GetSymbolDistributionsKey(symbol), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetDistributionQueueTimeKey returns the prefix of the token distribution ids ending at the specified time
+func GetDistributionQueueTimeKey(endTime time.Time) []byte {
+	return append(DistributionQueuePrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+// GetDistributionQueueKey returns the key of the token distribution id ending at the specified time
+func GetDistributionQueueKey(endTime time.Time, id uint64) []byte {
+	return append(GetDistributionQueueTimeKey(endTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetDistributionClaimsKey returns the prefix of the claims of the token distribution with the specified id
+func GetDistributionClaimsKey(id uint64) []byte {
+	return append(DistributionClaimPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetDistributionClaimKey returns the key of the claim of the address from the token distribution
+func GetDistributionClaimKey(id uint64, addr sdk.AccAddress) []byte {
+	return append(GetDistributionClaimsKey(id), addr.Bytes()...)
+}
+
+// GetTokenRoleKey returns the key of the roles of the specified symbol granted to the address. Intended for querying all token roles of an address
+func GetTokenRoleKey(addr sdk.AccAddress, symbol string) []byte {
+	return append(append(TokenRoleKey, addr.Bytes()...), []byte(symbol)...)
+}
+
+func lengthPrefixed(bz []byte) []byte {
+	return append([]byte{byte(len(bz))}, bz...)
+}
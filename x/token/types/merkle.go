@@ -0,0 +1,108 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Domain separators of the leaves and the inner nodes of the distribution merkle trees
+const (
+	merkleLeafPrefix byte = 0x00
+	merkleNodePrefix byte = 0x01
+)
+
+// DistributionLeaf returns the merkle leaf of the amount distributed to the address,
+// sha256(0x00 | address | big endian amount)
+func DistributionLeaf(addr sdk.AccAddress, amount uint64) []byte {
+	bz := append([]byte{merkleLeafPrefix}, addr.Bytes()...)
+	hash := sha256.Sum256(append(bz, sdk.Uint64ToBigEndian(amount)...))
+	return hash[:]
+}
+
+// hashDistributionNodes returns the parent of two nodes, sha256(0x01 | lower | greater),
+// sorting the pair so that the proofs do not need to record the side of the siblings
+func hashDistributionNodes(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+
+	bz := append([]byte{merkleNodePrefix}, a...)
+	hash := sha256.Sum256(append(bz, b...))
+	return hash[:]
+}
+
+// VerifyDistributionProof returns whether the proof links the leaf to the merkle root
+func VerifyDistributionProof(root, leaf []byte, proof [][]byte) bool {
+	node := leaf
+	for _, sibling := range proof {
+		node = hashDistributionNodes(node, sibling)
+	}
+	return bytes.Equal(node, root)
+}
+
+// DistributionMerkleRoot returns the merkle root of the leaves, the last node of a
+// level with an odd number of nodes being promoted to the next level
+func DistributionMerkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return nil
+	}
+
+	level := leaves
+	for len(level) > 1 {
+		level = nextDistributionLevel(level)
+	}
+	return level[0]
+}
+
+// DistributionMerkleProof returns the proof of the leaf at the index of the leaves
+func DistributionMerkleProof(leaves [][]byte, index int) [][]byte {
+	var proof [][]byte
+
+	level := leaves
+	for len(level) > 1 {
+		if sibling := index ^ 1; sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		level = nextDistributionLevel(level)
+		index /= 2
+	}
+	return proof
+}
+
+func nextDistributionLevel(level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, level[i])
+			continue
+		}
+		next = append(next, hashDistributionNodes(level[i], level[i+1]))
+	}
+	return next
+}
+
+// ParseMerkleRoot decodes a hex encoded merkle root
+func ParseMerkleRoot(root string) ([]byte, error) {
+	bz, err := hex.DecodeString(root)
+	if err != nil || len(bz) != sha256.Size {
+		return nil, sdkerrors.Wrapf(ErrInvalidDistribution, "invalid merkle root %s, expected %d hex encoded bytes", root, sha256.Size)
+	}
+	return bz, nil
+}
+
+// ParseMerkleProof decodes a hex encoded merkle proof
+func ParseMerkleProof(proof []string) ([][]byte, error) {
+	nodes := make([][]byte, len(proof))
+	for i, node := range proof {
+		bz, err := hex.DecodeString(node)
+		if err != nil || len(bz) != sha256.Size {
+			return nil, sdkerrors.Wrapf(ErrInvalidMerkleProof, "invalid proof node %s, expected %d hex encoded bytes", node, sha256.Size)
+		}
+		nodes[i] = bz
+	}
+	return nodes, nil
+}
@@ -0,0 +1,29 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+func TestDistributionMerkleProof(t *testing.T) {
+	var leaves [][]byte
+	for i := 0; i < 7; i++ {
+		addr := sdk.AccAddress(tmhash.SumTruncated([]byte{byte(i)}))
+		leaves = append(leaves, types.DistributionLeaf(addr, uint64(i+1)))
+	}
+
+	root := types.DistributionMerkleRoot(leaves)
+	for i := range leaves {
+		require.True(t, types.VerifyDistributionProof(root, leaves[i], types.DistributionMerkleProof(leaves, i)))
+	}
+
+	// a single recipient is proven by an empty proof
+	require.True(t, types.VerifyDistributionProof(leaves[0], leaves[0], nil))
+	require.False(t, types.VerifyDistributionProof(root, leaves[0], types.DistributionMerkleProof(leaves, 1)))
+}
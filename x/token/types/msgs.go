@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -15,6 +17,16 @@ const (
 	TypeMsgBurnToken          = "burn_token"
 	TypeMsgUnlockToken        = "unlock_token"
 	TypeMsgTransferTokenOwner = "transfer_token_owner"
+	TypeMsgGrantTokenRole     = "grant_token_role"
+	TypeMsgRevokeTokenRole    = "revoke_token_role"
+	TypeMsgPauseToken         = "pause_token"
+	TypeMsgUnpauseToken       = "unpause_token"
+	TypeMsgFreezeAccount      = "freeze_account"
+	TypeMsgUnfreezeAccount    = "unfreeze_account"
+	TypeMsgCreateTokenVesting = "create_token_vesting"
+	TypeMsgTakeTokenSnapshot  = "take_token_snapshot"
+	TypeMsgDistributeToken    = "distribute_token"
+	TypeMsgClaimDistribution  = "claim_distribution"
 
 	// DoNotModify used to indicate that some field should not be updated
 	DoNotModify = "[do-not-modify]"
@@ -27,24 +39,36 @@ var (
 	_ sdk.Msg = &MsgBurnToken{}
 	_ sdk.Msg = &MsgUnlockToken{}
 	_ sdk.Msg = &MsgTransferTokenOwner{}
+	_ sdk.Msg = &MsgGrantTokenRole{}
+	_ sdk.Msg = &MsgRevokeTokenRole{}
+	_ sdk.Msg = &MsgPauseToken{}
+	_ sdk.Msg = &MsgUnpauseToken{}
+	_ sdk.Msg = &MsgFreezeAccount{}
+	_ sdk.Msg = &MsgUnfreezeAccount{}
+	_ sdk.Msg = &MsgCreateTokenVesting{}
+	_ sdk.Msg = &MsgTakeTokenSnapshot{}
+	_ sdk.Msg = &MsgDistributeToken{}
+	_ sdk.Msg = &MsgClaimDistribution{}
 )
 
 // NewMsgIssueToken - construct token issue msg.
 func NewMsgIssueToken(
 	name string, symbol string, smallestUnit string, 
 	decimals uint32, initialSupply, totalSupply uint64,
-	mintable bool, unlocked bool, owner string,
+	mintable bool, unlocked bool, holderBurnable bool, freezable bool, owner string,
 ) *MsgIssueToken {
 	return &MsgIssueToken{
-		Name:          name,
-		Symbol:        symbol,
-		SmallestUnit:  smallestUnit,
-		Decimals:      decimals,
-		InitialSupply: initialSupply,
-		TotalSupply:   totalSupply,
-		Mintable:      mintable,
-		Unlocked:      unlocked,
-		Owner:         owner,
+		Name:           name,
+		Symbol:         symbol,
+		SmallestUnit:   smallestUnit,
+		Decimals:       decimals,
+		InitialSupply:  initialSupply,
+		TotalSupply:    totalSupply,
+		Mintable:       mintable,
+		Unlocked:       unlocked,
+		HolderBurnable: holderBurnable,
+		Freezable:      freezable,
+		Owner:          owner,
 	}
 }
 
@@ -90,12 +114,20 @@ func (msg MsgIssueToken) ValidateBasic() error {
 	).Validate()
 }
 
-// NewMsgEditToken creates a MsgEditToken
-func NewMsgEditToken(symbol string, mintable bool, owner string) *MsgEditToken {
+// NewMsgEditToken creates a MsgEditToken, the uri, uri hash and description
+// set to DoNotModify are left unchanged
+func NewMsgEditToken(
+	symbol, uri, uriHash, description string, attributes []Attribute,
+	mintable bool, owner string,
+) *MsgEditToken {
 	return &MsgEditToken{
-		Symbol:    symbol,
-		Mintable:  mintable,
-		Owner:     owner,
+		Symbol:      symbol,
+		Mintable:    mintable,
+		Owner:       owner,
+		URI:         uri,
+		URIHash:     uriHash,
+		Description: description,
+		Attributes:  attributes,
 	}
 }
 
@@ -130,6 +162,29 @@ func (msg MsgEditToken) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
 	}
 
+	if msg.URI != DoNotModify {
+		if err := ValidateURI(msg.URI); err != nil {
+			return err
+		}
+	}
+	if msg.URIHash != DoNotModify {
+		if err := ValidateURIHash(msg.URIHash); err != nil {
+			return err
+		}
+	}
+	if msg.Description != DoNotModify {
+		if err := ValidateDescription(msg.Description); err != nil {
+			return err
+		}
+	}
+	if len(msg.Attributes) > MaximumAttributes {
+		return sdkerrors.Wrapf(ErrInvalidAttribute, "too many attributes %d, only accepts [0, %d]",
+			len(msg.Attributes), MaximumAttributes)
+	}
+	if err := ValidateAttributes(msg.Attributes); err != nil {
+		return err
+	}
+
 	return ValidateSymbol(msg.Symbol)
 }
 
@@ -342,3 +397,482 @@ func (msg MsgTransferTokenOwner) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgGrantTokenRole creates a MsgGrantTokenRole
+func NewMsgGrantTokenRole(symbol string, role TokenRole, address, owner string) *MsgGrantTokenRole {
+	return &MsgGrantTokenRole{
+		Symbol:  symbol,
+		Role:    role,
+		Address: address,
+		Owner:   owner,
+	}
+}
+
+// Route implements Msg
+func (msg MsgGrantTokenRole) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgGrantTokenRole) Type() string { return TypeMsgGrantTokenRole }
+
+// GetSignBytes implements Msg
+func (msg MsgGrantTokenRole) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgGrantTokenRole) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgGrantTokenRole) ValidateBasic() error {
+	return validateTokenRoleMsg(msg.Symbol, msg.Role, msg.Address, msg.Owner)
+}
+
+// NewMsgRevokeTokenRole creates a MsgRevokeTokenRole
+func NewMsgRevokeTokenRole(symbol string, role TokenRole, address, owner string) *MsgRevokeTokenRole {
+	return &MsgRevokeTokenRole{
+		Symbol:  symbol,
+		Role:    role,
+		Address: address,
+		Owner:   owner,
+	}
+}
+
+// Route implements Msg
+func (msg MsgRevokeTokenRole) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgRevokeTokenRole) Type() string { return TypeMsgRevokeTokenRole }
+
+// GetSignBytes implements Msg
+func (msg MsgRevokeTokenRole) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgRevokeTokenRole) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRevokeTokenRole) ValidateBasic() error {
+	return validateTokenRoleMsg(msg.Symbol, msg.Role, msg.Address, msg.Owner)
+}
+
+// NewMsgPauseToken creates a MsgPauseToken
+func NewMsgPauseToken(symbol string, sender string) *MsgPauseToken {
+	return &MsgPauseToken{
+		Symbol: symbol,
+		Sender: sender,
+	}
+}
+
+// Route implements Msg
+func (msg MsgPauseToken) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgPauseToken) Type() string { return TypeMsgPauseToken }
+
+// GetSignBytes implements Msg
+func (msg MsgPauseToken) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgPauseToken) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgPauseToken) ValidateBasic() error {
+	return validatePauseMsg(msg.Symbol, msg.Sender)
+}
+
+// NewMsgUnpauseToken creates a MsgUnpauseToken
+func NewMsgUnpauseToken(symbol string, sender string) *MsgUnpauseToken {
+	return &MsgUnpauseToken{
+		Symbol: symbol,
+		Sender: sender,
+	}
+}
+
+// Route implements Msg
+func (msg MsgUnpauseToken) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgUnpauseToken) Type() string { return TypeMsgUnpauseToken }
+
+// GetSignBytes implements Msg
+func (msg MsgUnpauseToken) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgUnpauseToken) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgUnpauseToken) ValidateBasic() error {
+	return validatePauseMsg(msg.Symbol, msg.Sender)
+}
+
+// NewMsgFreezeAccount creates a MsgFreezeAccount
+func NewMsgFreezeAccount(symbol, address, owner string) *MsgFreezeAccount {
+	return &MsgFreezeAccount{
+		Symbol:  symbol,
+		Address: address,
+		Owner:   owner,
+	}
+}
+
+// Route implements Msg
+func (msg MsgFreezeAccount) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgFreezeAccount) Type() string { return TypeMsgFreezeAccount }
+
+// GetSignBytes implements Msg
+func (msg MsgFreezeAccount) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgFreezeAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgFreezeAccount) ValidateBasic() error {
+	return validateFreezeMsg(msg.Symbol, msg.Address, msg.Owner)
+}
+
+// NewMsgUnfreezeAccount creates a MsgUnfreezeAccount
+func NewMsgUnfreezeAccount(symbol, address, owner string) *MsgUnfreezeAccount {
+	return &MsgUnfreezeAccount{
+		Symbol:  symbol,
+		Address: address,
+		Owner:   owner,
+	}
+}
+
+// Route implements Msg
+func (msg MsgUnfreezeAccount) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgUnfreezeAccount) Type() string { return TypeMsgUnfreezeAccount }
+
+// GetSignBytes implements Msg
+func (msg MsgUnfreezeAccount) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgUnfreezeAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgUnfreezeAccount) ValidateBasic() error {
+	return validateFreezeMsg(msg.Symbol, msg.Address, msg.Owner)
+}
+
+// NewMsgCreateTokenVesting creates a MsgCreateTokenVesting
+func NewMsgCreateTokenVesting(
+	sender, beneficiary string, amount sdk.Coin, startTime, cliffTime, endTime time.Time,
+) *MsgCreateTokenVesting {
+	return &MsgCreateTokenVesting{
+		Sender:      sender,
+		Beneficiary: beneficiary,
+		Amount:      amount,
+		StartTime:   startTime,
+		CliffTime:   cliffTime,
+		EndTime:     endTime,
+	}
+}
+
+// Route implements Msg
+func (msg MsgCreateTokenVesting) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgCreateTokenVesting) Type() string { return TypeMsgCreateTokenVesting }
+
+// GetSignBytes implements Msg
+func (msg MsgCreateTokenVesting) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgCreateTokenVesting) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgCreateTokenVesting) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Beneficiary); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid beneficiary address (%s)", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid vesting amount %s", msg.Amount)
+	}
+
+	return ValidateVestingSchedule(msg.StartTime, msg.CliffTime, msg.EndTime)
+}
+
+// NewMsgTakeTokenSnapshot creates a MsgTakeTokenSnapshot
+func NewMsgTakeTokenSnapshot(symbol, owner string) *MsgTakeTokenSnapshot {
+	return &MsgTakeTokenSnapshot{
+		Symbol: symbol,
+		Owner:  owner,
+	}
+}
+
+// Route implements Msg
+func (msg MsgTakeTokenSnapshot) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgTakeTokenSnapshot) Type() string { return TypeMsgTakeTokenSnapshot }
+
+// GetSignBytes implements Msg
+func (msg MsgTakeTokenSnapshot) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgTakeTokenSnapshot) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgTakeTokenSnapshot) ValidateBasic() error {
+	if err := ValidateSymbol(msg.Symbol); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	return nil
+}
+
+// NewMsgDistributeToken creates a MsgDistributeToken
+func NewMsgDistributeToken(symbol, owner, merkleRoot string, amount uint64, endTime time.Time, mint bool) *MsgDistributeToken {
+	return &MsgDistributeToken{
+		Symbol:     symbol,
+		Owner:      owner,
+		MerkleRoot: merkleRoot,
+		Amount:     amount,
+		EndTime:    endTime,
+		Mint:       mint,
+	}
+}
+
+// Route implements Msg
+func (msg MsgDistributeToken) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgDistributeToken) Type() string { return TypeMsgDistributeToken }
+
+// GetSignBytes implements Msg
+func (msg MsgDistributeToken) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgDistributeToken) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgDistributeToken) ValidateBasic() error {
+	if err := ValidateSymbol(msg.Symbol); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if _, err := ParseMerkleRoot(msg.MerkleRoot); err != nil {
+		return err
+	}
+
+	if msg.Amount == 0 {
+		return sdkerrors.Wrap(ErrInvalidAmount, "distributed amount must be positive")
+	}
+
+	return nil
+}
+
+// NewMsgClaimDistribution creates a MsgClaimDistribution
+func NewMsgClaimDistribution(id uint64, recipient string, amount uint64, proof []string) *MsgClaimDistribution {
+	return &MsgClaimDistribution{
+		Id:        id,
+		Recipient: recipient,
+		Amount:    amount,
+		Proof:     proof,
+	}
+}
+
+// Route implements Msg
+func (msg MsgClaimDistribution) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgClaimDistribution) Type() string { return TypeMsgClaimDistribution }
+
+// GetSignBytes implements Msg
+func (msg MsgClaimDistribution) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgClaimDistribution) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgClaimDistribution) ValidateBasic() error {
+	if msg.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidDistribution, "distribution id must be positive")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+
+	if msg.Amount == 0 {
+		return sdkerrors.Wrap(ErrInvalidAmount, "claimed amount must be positive")
+	}
+
+	_, err := ParseMerkleProof(msg.Proof)
+	return err
+}
+
+func validateTokenRoleMsg(symbol string, role TokenRole, address, owner string) error {
+	if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid role address (%s)", err)
+	}
+	if err := ValidateTokenRole(role); err != nil {
+		return err
+	}
+
+	return ValidateSymbol(symbol)
+}
+
+func validatePauseMsg(symbol, sender string) error {
+	if err := ValidateSymbol(symbol); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func validateFreezeMsg(symbol, address, owner string) error {
+	if err := ValidateSymbol(symbol); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid frozen address (%s)", err)
+	}
+
+	return nil
+}
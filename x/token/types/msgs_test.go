@@ -26,20 +26,20 @@ func TestMsgIssueAsset(t *testing.T) {
 		*MsgIssueToken
 		expectPass bool
 	}{
-		{"token unlocked", NewMsgIssueToken("Gauss Network", "stake", "ustake", 6, 1, 1, true, true, addr), true},
-		{"token locked", NewMsgIssueToken("Gauss Network", "stake", "ustake", 6, 1, 1, true, false, addr), true},
-		{"symbol empty", NewMsgIssueToken("Gauss Network", "", "", 6, 1, 1, true, true, addr), false},
-		{"symbol error", NewMsgIssueToken("Gauss Network", "b&stake", "ub&stake", 6, 1, 1, true, true, addr), false},
-		{"symbol first letter is num", NewMsgIssueToken("Gauss Network", "4stake", "u4stake", 6, 1, 1, true, true, addr), false},
-		{"symbol too long", NewMsgIssueToken("Gauss Network", "stake123456789012345678901234567890123456789012345678901234567890", "ustake", 6, 1, 1, true, true, addr), false},
-		{"unit too long", NewMsgIssueToken("Gauss Network", "stake", "ustake123456789012345678901234567890123456789012345678901234567890", 6, 1, 1, true, true, addr), false},
-		{"symbol too short", NewMsgIssueToken("Gauss Network", "aa", "uaa", 6, 1, 1, true, true, addr), false},
-		{"name empty", NewMsgIssueToken("", "stake", "ustake", 6, 1, 1, true, true, addr), false},
-		{"name too long", NewMsgIssueToken("Gauss Network aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "stake", "ustake", 6, 1, 1, true, true, addr), false},
-		{"initial supply is zero", NewMsgIssueToken("Gauss Network", "stake", "ustake", 6, 0, 1, true, true, addr), true},
-		{"total supply is zero", NewMsgIssueToken("Gauss Network", "stake", "ustake", 6, 1, 0, true, true, addr), true},
-		{"initial supply bigger than total supply", NewMsgIssueToken("Gauss Network", "stake", "ustake", 6, 2, 1, true, true, addr), false},
-		{"decimals error", NewMsgIssueToken("Gauss Network", "stake", "ustake", 20, 1, 1, true, true, addr), false},
+		{"token unlocked", NewMsgIssueToken("Gauss Network", "stake", "ustake", 6, 1, 1, true, true, false, false, addr), true},
+		{"token locked", NewMsgIssueToken("Gauss Network", "stake", "ustake", 6, 1, 1, true, false, false, false, addr), true},
+		{"symbol empty", NewMsgIssueToken("Gauss Network", "", "", 6, 1, 1, true, true, false, false, addr), false},
+		{"symbol error", NewMsgIssueToken("Gauss Network", "b&stake", "ub&stake", 6, 1, 1, true, true, false, false, addr), false},
+		{"symbol first letter is num", NewMsgIssueToken("Gauss Network", "4stake", "u4stake", 6, 1, 1, true, true, false, false, addr), false},
+		{"symbol too long", NewMsgIssueToken("Gauss Network", "stake123456789012345678901234567890123456789012345678901234567890", "ustake", 6, 1, 1, true, true, false, false, addr), false},
+		{"unit too long", NewMsgIssueToken("Gauss Network", "stake", "ustake123456789012345678901234567890123456789012345678901234567890", 6, 1, 1, true, true, false, false, addr), false},
+		{"symbol too short", NewMsgIssueToken("Gauss Network", "aa", "uaa", 6, 1, 1, true, true, false, false, addr), false},
+		{"name empty", NewMsgIssueToken("", "stake", "ustake", 6, 1, 1, true, true, false, false, addr), false},
+		{"name too long", NewMsgIssueToken("Gauss Network aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "stake", "ustake", 6, 1, 1, true, true, false, false, addr), false},
+		{"initial supply is zero", NewMsgIssueToken("Gauss Network", "stake", "ustake", 6, 0, 1, true, true, false, false, addr), true},
+		{"total supply is zero", NewMsgIssueToken("Gauss Network", "stake", "ustake", 6, 1, 0, true, true, false, false, addr), true},
+		{"initial supply bigger than total supply", NewMsgIssueToken("Gauss Network", "stake", "ustake", 6, 2, 1, true, true, false, false, addr), false},
+		{"decimals error", NewMsgIssueToken("Gauss Network", "stake", "ustake", 20, 1, 1, true, true, false, false, addr), false},
 	}
 
 	for _, tc := range tests {
@@ -61,9 +61,12 @@ func TestMsgEditToken(t *testing.T) {
 		*MsgEditToken
 		expectPass bool
 	}{
-		{"basic good", NewMsgEditToken("ttk",  mintable, owner), true},
-		{"symbol error", NewMsgEditToken("tt", mintable, ""), false},
-		{"loss owner", NewMsgEditToken("ttk",  mintable, ""), false},
+		{"basic good", NewMsgEditToken("ttk", DoNotModify, DoNotModify, DoNotModify, nil, mintable, owner), true},
+		{"symbol error", NewMsgEditToken("tt", DoNotModify, DoNotModify, DoNotModify, nil, mintable, ""), false},
+		{"loss owner", NewMsgEditToken("ttk", DoNotModify, DoNotModify, DoNotModify, nil, mintable, ""), false},
+		{"uri hash error", NewMsgEditToken("ttk", "https://gauss.io", "0xzz", DoNotModify, nil, mintable, owner), false},
+		{"attribute key error", NewMsgEditToken("ttk", DoNotModify, DoNotModify, DoNotModify,
+			[]Attribute{{Key: "1logo", Value: "logo"}}, mintable, owner), false},
 	}
 
 	for _, tc := range tests {
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 
 	"gopkg.in/yaml.v2"
 
@@ -13,12 +15,28 @@ import (
 
 const (
 	DefaultParamsDenom  = sdk.DefaultBondDenom
+
+	DefaultFactorBase       = 3
+	DefaultFactorExp        = 4
+	DefaultPremiumSymbolLen = 3
+	DefaultMinVestingAmount = 100
+
+	// MaximumFactorExp bounds the exponent of the fee curve, the base and the
+	// exponent are also checked together so that the factor of the shortest
+	// symbol does not round to zero
+	MaximumFactorExp = 16
 )
 
 var (
-	KeyTokenTax           = []byte("TokenTax")
-	KeyIssueTokenFee      = []byte("IssueTokenFee")
-	KeyMintTokenFeeRatio  = []byte("MintTokenFeeRatio")
+	KeyTokenTax             = []byte("TokenTax")
+	KeyIssueTokenFee        = []byte("IssueTokenFee")
+	KeyMintTokenFeeRatio    = []byte("MintTokenFeeRatio")
+	KeyFactorBase           = []byte("FactorBase")
+	KeyFactorExp            = []byte("FactorExp")
+	KeyMinIssueFee          = []byte("MinIssueFee")
+	KeyPremiumSymbolLen     = []byte("PremiumSymbolLen")
+	KeyPremiumFeeMultiplier = []byte("PremiumFeeMultiplier")
+	KeyMinVestingAmount     = []byte("MinVestingAmount")
 )
 
 // ParamKeyTable for token module.
@@ -27,11 +45,19 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the bank module
-func NewParams(tokenTax sdk.Dec, issueFee sdk.Coin, mintFeeRatio sdk.Dec) Params {
+func NewParams(tokenTax sdk.Dec, issueFee sdk.Coin, mintFeeRatio sdk.Dec,
+	factorBase, factorExp uint32, minIssueFee sdk.Int, premiumSymbolLen uint32, premiumFeeMultiplier sdk.Dec,
+	minVestingAmount sdk.Int) Params {
 	return Params{
 		TokenTax: tokenTax,
 		IssueFee: issueFee,
 		MintFeeRatio: mintFeeRatio,
+		FactorBase: factorBase,
+		FactorExp: factorExp,
+		MinIssueFee: minIssueFee,
+		PremiumSymbolLen: premiumSymbolLen,
+		PremiumFeeMultiplier: premiumFeeMultiplier,
+		MinVestingAmount: minVestingAmount,
 	}
 }
 
@@ -41,6 +67,12 @@ func DefaultParams() Params {
 		sdk.NewDecWithPrec(4, 1), // 40%
 		sdk.NewCoin(DefaultParamsDenom, sdk.NewInt(100000000)),
 		sdk.NewDecWithPrec(3, 1), // 5
+		DefaultFactorBase,
+		DefaultFactorExp,
+		sdk.OneInt(),
+		DefaultPremiumSymbolLen,
+		sdk.NewDec(10),
+		sdk.NewInt(DefaultMinVestingAmount),
 	)
 }
 
@@ -55,10 +87,52 @@ func (p Params) Validate() error {
 	if err := validateIssueTokenFee(p.IssueFee); err != nil {
 		return err
 	}
+	if err := validateFactorBase(p.FactorBase); err != nil {
+		return err
+	}
+	if err := validateFactorExp(p.FactorExp); err != nil {
+		return err
+	}
+	if !FeeFactor(MinimumSymbolLen, p.FactorBase, p.FactorExp).IsPositive() {
+		return fmt.Errorf("factor base %d and exponent %d give a zero fee factor to the symbols of %d characters",
+			p.FactorBase, p.FactorExp, MinimumSymbolLen)
+	}
+	if err := validateMinIssueFee(p.MinIssueFee); err != nil {
+		return err
+	}
+	if err := validatePremiumSymbolLen(p.PremiumSymbolLen); err != nil {
+		return err
+	}
+	if err := validatePremiumFeeMultiplier(p.PremiumFeeMultiplier); err != nil {
+		return err
+	}
+	if err := validateMinVestingAmount(p.MinVestingAmount); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// FeeFactor computes the factor dividing the issue fee of a symbol
+// factor = (ln({dataLen})/ln{base})^{exp}, rounded to 2 decimals
+func FeeFactor(dataLen int, base, exp uint32) sdk.Dec {
+	numerator := math.Log(float64(dataLen))
+	denominator := math.Log(float64(base))
+	factor := math.Pow(numerator/denominator, float64(exp))
+
+	factorDec, err := sdk.NewDecFromStr(strconv.FormatFloat(factor, 'f', 2, 64))
+	if err != nil {
+		panic("invalid factor string")
+	}
+
+	return factorDec
+}
+
+// IsPremiumSymbol returns true if the symbol is charged the premium issue fee
+func (p Params) IsPremiumSymbol(symbol string) bool {
+	return len(symbol) <= int(p.PremiumSymbolLen)
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -71,6 +145,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTokenTax, &p.TokenTax, validateTokenTax),
 		paramtypes.NewParamSetPair(KeyIssueTokenFee, &p.IssueFee, validateIssueTokenFee),
 		paramtypes.NewParamSetPair(KeyMintTokenFeeRatio, &p.MintFeeRatio, validateMintFeeRatio),
+		paramtypes.NewParamSetPair(KeyFactorBase, &p.FactorBase, validateFactorBase),
+		paramtypes.NewParamSetPair(KeyFactorExp, &p.FactorExp, validateFactorExp),
+		paramtypes.NewParamSetPair(KeyMinIssueFee, &p.MinIssueFee, validateMinIssueFee),
+		paramtypes.NewParamSetPair(KeyPremiumSymbolLen, &p.PremiumSymbolLen, validatePremiumSymbolLen),
+		paramtypes.NewParamSetPair(KeyPremiumFeeMultiplier, &p.PremiumFeeMultiplier, validatePremiumFeeMultiplier),
+		paramtypes.NewParamSetPair(KeyMinVestingAmount, &p.MinVestingAmount, validateMinVestingAmount),
 	}
 }
 
@@ -110,3 +190,69 @@ func validateIssueTokenFee(i interface{}) error {
 	}
 	return nil
 }
+
+func validateFactorBase(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 2 {
+		return fmt.Errorf("factor base must be at least 2: %d", v)
+	}
+	return nil
+}
+
+func validateFactorExp(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > MaximumFactorExp {
+		return fmt.Errorf("factor exponent must not exceed %d: %d", MaximumFactorExp, v)
+	}
+	return nil
+}
+
+func validateMinIssueFee(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("minimum issue fee should not be negative: %s", v)
+	}
+	return nil
+}
+
+func validatePremiumSymbolLen(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > MaximumSymbolLen {
+		return fmt.Errorf("premium symbol length must not exceed %d: %d", MaximumSymbolLen, v)
+	}
+	return nil
+}
+
+func validatePremiumFeeMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("premium fee multiplier must be at least 1: %s", v)
+	}
+	return nil
+}
+
+func validateMinVestingAmount(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("minimum vesting amount must be positive: %s", v)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeTokenFeeOverride defines the type for a TokenFeeOverrideProposal
+	ProposalTypeTokenFeeOverride = "TokenFeeOverride"
+	// ProposalTypeSymbolReservation defines the type for a SymbolReservationProposal
+	ProposalTypeSymbolReservation = "SymbolReservation"
+)
+
+// Assert the token proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &TokenFeeOverrideProposal{}
+	_ govtypes.Content = &SymbolReservationProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeTokenFeeOverride)
+	govtypes.RegisterProposalTypeCodec(&TokenFeeOverrideProposal{}, "gauss/TokenFeeOverrideProposal")
+	govtypes.RegisterProposalType(ProposalTypeSymbolReservation)
+	govtypes.RegisterProposalTypeCodec(&SymbolReservationProposal{}, "gauss/SymbolReservationProposal")
+}
+
+// NewTokenFeeOverrideProposal creates a new token fee override proposal, the
+// fees are ignored when the override of the symbol is removed
+func NewTokenFeeOverrideProposal(
+	title, description, symbol string, issueFee, mintFee sdk.Coin, remove bool,
+) *TokenFeeOverrideProposal {
+	return &TokenFeeOverrideProposal{
+		Title:       title,
+		Description: description,
+		Symbol:      symbol,
+		IssueFee:    issueFee,
+		MintFee:     mintFee,
+		Remove:      remove,
+	}
+}
+
+// GetTitle returns the title of a token fee override proposal
+func (p *TokenFeeOverrideProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a token fee override proposal
+func (p *TokenFeeOverrideProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a token fee override proposal
+func (p *TokenFeeOverrideProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a token fee override proposal
+func (p *TokenFeeOverrideProposal) ProposalType() string { return ProposalTypeTokenFeeOverride }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *TokenFeeOverrideProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if err := ValidateSymbol(p.Symbol); err != nil {
+		return err
+	}
+
+	if p.Remove {
+		return nil
+	}
+
+	return TokenFeeOverride{Symbol: p.Symbol, IssueFee: p.IssueFee, MintFee: p.MintFee}.Validate()
+}
+
+// String implements the Stringer interface
+func (p TokenFeeOverrideProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Token Fee Override Proposal:
+  Title:       %s
+  Description: %s
+  Symbol:      %s
+`, p.Title, p.Description, p.Symbol))
+
+	if p.Remove {
+		b.WriteString("  Remove:      true\n")
+	} else {
+		b.WriteString(fmt.Sprintf(`  Issue Fee:   %s
+  Mint Fee:    %s
+`, p.IssueFee, p.MintFee))
+	}
+
+	return b.String()
+}
+
+// Validate checks the symbol and the fees of the token fee override
+func (o TokenFeeOverride) Validate() error {
+	if err := ValidateSymbol(o.Symbol); err != nil {
+		return err
+	}
+
+	if !o.IssueFee.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid issue fee %s", o.IssueFee)
+	}
+
+	if !o.MintFee.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid mint fee %s", o.MintFee)
+	}
+
+	return nil
+}
+
+// NewSymbolReservationProposal creates a new symbol reservation proposal, the
+// address is ignored when the reservation of the symbol is removed
+func NewSymbolReservationProposal(title, description, symbol, address string, remove bool) *SymbolReservationProposal {
+	return &SymbolReservationProposal{
+		Title:       title,
+		Description: description,
+		Symbol:      symbol,
+		Address:     address,
+		Remove:      remove,
+	}
+}
+
+// GetTitle returns the title of a symbol reservation proposal
+func (p *SymbolReservationProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a symbol reservation proposal
+func (p *SymbolReservationProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a symbol reservation proposal
+func (p *SymbolReservationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a symbol reservation proposal
+func (p *SymbolReservationProposal) ProposalType() string { return ProposalTypeSymbolReservation }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SymbolReservationProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if err := ValidateSymbol(p.Symbol); err != nil {
+		return err
+	}
+
+	if p.Remove {
+		return nil
+	}
+
+	return SymbolReservation{Symbol: p.Symbol, Address: p.Address}.Validate()
+}
+
+// String implements the Stringer interface
+func (p SymbolReservationProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Symbol Reservation Proposal:
+  Title:       %s
+  Description: %s
+  Symbol:      %s
+`, p.Title, p.Description, p.Symbol))
+
+	if p.Remove {
+		b.WriteString("  Remove:      true\n")
+	} else {
+		b.WriteString(fmt.Sprintf("  Address:     %s\n", p.Address))
+	}
+
+	return b.String()
+}
+
+// Validate checks the symbol and the address of the symbol reservation
+func (r SymbolReservation) Validate() error {
+	if err := ValidateSymbol(r.Symbol); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid reserved address (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TokenRoleNames maps the command line names to the token roles
+var TokenRoleNames = map[string]TokenRole{
+	"minter":         RoleMinter,
+	"burner":         RoleBurner,
+	"pauser":         RolePauser,
+	"metadata-admin": RoleMetadataAdmin,
+}
+
+// TokenRoleFromString parses a token role from its command line name or its enum name
+func TokenRoleFromString(str string) (TokenRole, error) {
+	if role, ok := TokenRoleNames[strings.ToLower(str)]; ok {
+		return role, nil
+	}
+	if role, ok := TokenRole_value[strings.ToUpper(str)]; ok && TokenRole(role) != RoleUnspecified {
+		return TokenRole(role), nil
+	}
+	return RoleUnspecified, sdkerrors.Wrapf(ErrInvalidRole, "%s, expected one of minter, burner, pauser or metadata-admin", str)
+}
+
+// ValidateTokenRole checks if the given role is a known role
+func ValidateTokenRole(role TokenRole) error {
+	if role == RoleUnspecified {
+		return sdkerrors.Wrap(ErrInvalidRole, "unspecified role")
+	}
+	if _, ok := TokenRole_name[int32(role)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidRole, "unknown role %d", role)
+	}
+	return nil
+}
+
+// HasRole returns true if the role is among the granted roles
+func (tr TokenRoles) HasRole(role TokenRole) bool {
+	for _, r := range tr.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the symbol, the address and the roles of the token roles
+func (tr TokenRoles) Validate() error {
+	if err := ValidateSymbol(tr.Symbol); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(tr.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid role address (%s)", err)
+	}
+	if len(tr.Roles) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidRole, "no role of token %s granted to %s", tr.Symbol, tr.Address)
+	}
+	seen := make(map[TokenRole]bool, len(tr.Roles))
+	for _, role := range tr.Roles {
+		if err := ValidateTokenRole(role); err != nil {
+			return err
+		}
+		if seen[role] {
+			return sdkerrors.Wrapf(ErrInvalidRole, "duplicate role %s of token %s granted to %s", role, tr.Symbol, tr.Address)
+		}
+		seen[role] = true
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewTokenSnapshot creates a new TokenSnapshot of the holders of the symbol at the height
+func NewTokenSnapshot(id uint64, symbol string, height int64, blockTime time.Time, creator sdk.AccAddress) TokenSnapshot {
+	return TokenSnapshot{
+		Id:      id,
+		Symbol:  symbol,
+		Height:  height,
+		Time:    blockTime,
+		Creator: creator.String(),
+	}
+}
+
+// Validate checks the id, the symbol, the height and the creator of the token snapshot
+func (s TokenSnapshot) Validate() error {
+	if s.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidSnapshot, "snapshot id must be positive")
+	}
+	if err := ValidateSymbol(s.Symbol); err != nil {
+		return err
+	}
+	if s.Height <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidSnapshot, "snapshot height must be positive: %d", s.Height)
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
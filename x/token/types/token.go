@@ -1,6 +1,8 @@
 package types
 
 import (
+	"sort"
+
 	"github.com/gogo/protobuf/proto"
 	"gopkg.in/yaml.v2"
 
@@ -22,6 +24,13 @@ type TokenI interface {
 	GetMintable() bool
 	GetOwner() sdk.AccAddress
 	GetOwnerString() string
+	GetURI() string
+	GetURIHash() string
+	GetDescription() string
+	GetAttributes() []Attribute
+	GetHolderBurnable() bool
+	GetFreezable() bool
+	IsSupplyCapped() bool
 }
 
 // NewToken constructs a new Token instance
@@ -77,6 +86,57 @@ func (t Token) GetOwnerString() string {
 	return t.Owner
 }
 
+// IsSupplyCapped returns true if the supply of the token is capped by its total
+// supply, the tokens without an owner being minted by the modules bridging them
+// against funds locked elsewhere
+func (t Token) IsSupplyCapped() bool {
+	return len(t.Owner) > 0
+}
+
+func (t Token) GetURI() string {
+	return t.URI
+}
+
+func (t Token) GetURIHash() string {
+	return t.URIHash
+}
+
+func (t Token) GetDescription() string {
+	return t.Description
+}
+
+func (t Token) GetAttributes() []Attribute {
+	return t.Attributes
+}
+
+func (t Token) GetHolderBurnable() bool {
+	return t.HolderBurnable
+}
+
+func (t Token) GetFreezable() bool {
+	return t.Freezable
+}
+
+// SetAttributes upserts the given attributes into the token, an attribute
+// with an empty value removes the key; the attributes are kept sorted by key
+func (t *Token) SetAttributes(attributes []Attribute) {
+	for _, attr := range attributes {
+		i := sort.Search(len(t.Attributes), func(i int) bool { return t.Attributes[i].Key >= attr.Key })
+		switch {
+		case i < len(t.Attributes) && t.Attributes[i].Key == attr.Key:
+			if len(attr.Value) == 0 {
+				t.Attributes = append(t.Attributes[:i], t.Attributes[i+1:]...)
+			} else {
+				t.Attributes[i].Value = attr.Value
+			}
+		case len(attr.Value) > 0:
+			t.Attributes = append(t.Attributes, Attribute{})
+			copy(t.Attributes[i+1:], t.Attributes[i:])
+			t.Attributes[i] = attr
+		}
+	}
+}
+
 func (t Token) String() string {
 	bz, _ := yaml.Marshal(t)
 	return string(bz)
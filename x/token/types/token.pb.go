@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,16 +29,66 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TokenRole defines a privilege of a token which its owner delegates to
+// other accounts
+type TokenRole int32
+
+const (
+	// UNSPECIFIED defines an invalid role.
+	RoleUnspecified TokenRole = 0
+	// MINTER defines a role which mints the token.
+	RoleMinter TokenRole = 1
+	// BURNER defines a role which burns the token it holds.
+	RoleBurner TokenRole = 2
+	// PAUSER defines a role which pauses and unpauses the token.
+	RolePauser TokenRole = 3
+	// METADATA_ADMIN defines a role which edits the token metadata.
+	RoleMetadataAdmin TokenRole = 4
+)
+
+var TokenRole_name = map[int32]string{
+	0: "TOKEN_ROLE_UNSPECIFIED",
+	1: "TOKEN_ROLE_MINTER",
+	2: "TOKEN_ROLE_BURNER",
+	3: "TOKEN_ROLE_PAUSER",
+	4: "TOKEN_ROLE_METADATA_ADMIN",
+}
+
+var TokenRole_value = map[string]int32{
+	"TOKEN_ROLE_UNSPECIFIED":    0,
+	"TOKEN_ROLE_MINTER":         1,
+	"TOKEN_ROLE_BURNER":         2,
+	"TOKEN_ROLE_PAUSER":         3,
+	"TOKEN_ROLE_METADATA_ADMIN": 4,
+}
+
+func (x TokenRole) String() string {
+	return proto.EnumName(TokenRole_name, int32(x))
+}
+
+func (TokenRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4817717eb3178fe7, []int{0}
+}
+
 // Token defines a standard for the fungible token
 type Token struct {
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Symbol        string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	SmallestUnit  string `protobuf:"bytes,3,opt,name=smallest_unit,json=smallestUnit,proto3" json:"smallest_unit,omitempty"`
-	Decimals      uint32 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
-	InitialSupply uint64 `protobuf:"varint,5,opt,name=initial_supply,json=initialSupply,proto3" json:"initial_supply,omitempty" yaml:"initial_supply"`
-	TotalSupply   uint64 `protobuf:"varint,6,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty" yaml:"total_supply"`
-	Mintable      bool   `protobuf:"varint,7,opt,name=mintable,proto3" json:"mintable,omitempty"`
-	Owner         string `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	Name          string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol        string      `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	SmallestUnit  string      `protobuf:"bytes,3,opt,name=smallest_unit,json=smallestUnit,proto3" json:"smallest_unit,omitempty"`
+	Decimals      uint32      `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	InitialSupply uint64      `protobuf:"varint,5,opt,name=initial_supply,json=initialSupply,proto3" json:"initial_supply,omitempty" yaml:"initial_supply"`
+	TotalSupply   uint64      `protobuf:"varint,6,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty" yaml:"total_supply"`
+	Mintable      bool        `protobuf:"varint,7,opt,name=mintable,proto3" json:"mintable,omitempty"`
+	Owner         string      `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	URI           string      `protobuf:"bytes,9,opt,name=uri,proto3" json:"uri,omitempty"`
+	URIHash       string      `protobuf:"bytes,10,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty" yaml:"uri_hash"`
+	Description   string      `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
+	Attributes    []Attribute `protobuf:"bytes,12,rep,name=attributes,proto3" json:"attributes"`
+	// holder_burnable allows any holder to burn its own balance of the token
+	HolderBurnable bool `protobuf:"varint,13,opt,name=holder_burnable,json=holderBurnable,proto3" json:"holder_burnable,omitempty" yaml:"holder_burnable"`
+	// freezable allows the owner to freeze the outgoing transfers of the token
+	// from specific accounts, it is fixed at issuance
+	Freezable bool `protobuf:"varint,14,opt,name=freezable,proto3" json:"freezable,omitempty"`
 }
 
 func (m *Token) Reset()      { *m = Token{} }
@@ -69,17 +123,107 @@ func (m *Token) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Token proto.InternalMessageInfo
 
+// Attribute defines an owner-editable key/value pair of the token metadata
+type Attribute struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Attribute) Reset()         { *m = Attribute{} }
+func (m *Attribute) String() string { return proto.CompactTextString(m) }
+func (*Attribute) ProtoMessage()    {}
+func (*Attribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4817717eb3178fe7, []int{1}
+}
+func (m *Attribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Attribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Attribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Attribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attribute.Merge(m, src)
+}
+func (m *Attribute) XXX_Size() int {
+	return m.Size()
+}
+func (m *Attribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attribute proto.InternalMessageInfo
+
+// TokenRoles defines the roles of a token granted to an address
+type TokenRoles struct {
+	Symbol  string      `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Address string      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Roles   []TokenRole `protobuf:"varint,3,rep,packed,name=roles,proto3,enum=gauss.token.TokenRole" json:"roles,omitempty"`
+}
+
+func (m *TokenRoles) Reset()         { *m = TokenRoles{} }
+func (m *TokenRoles) String() string { return proto.CompactTextString(m) }
+func (*TokenRoles) ProtoMessage()    {}
+func (*TokenRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4817717eb3178fe7, []int{2}
+}
+func (m *TokenRoles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenRoles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenRoles.Merge(m, src)
+}
+func (m *TokenRoles) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenRoles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenRoles proto.InternalMessageInfo
+
 // Params defines token module's parameters
 type Params struct {
 	TokenTax     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=token_tax,json=tokenTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"token_tax" yaml:"token_tax"`
 	IssueFee     types.Coin                             `protobuf:"bytes,2,opt,name=issue_fee,json=issueFee,proto3" json:"issue_fee" yaml:"issue_fee"`
 	MintFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=mint_fee_ratio,json=mintFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mint_fee_ratio" yaml:"mint_fee_ratio"`
+	// base and exponent of the issue fee curve, fee = issue_fee / (ln(len(symbol)) / ln(factor_base))^factor_exp
+	FactorBase uint32 `protobuf:"varint,4,opt,name=factor_base,json=factorBase,proto3" json:"factor_base,omitempty" yaml:"factor_base"`
+	FactorExp  uint32 `protobuf:"varint,5,opt,name=factor_exp,json=factorExp,proto3" json:"factor_exp,omitempty" yaml:"factor_exp"`
+	// minimum amount of the issue fee given by the fee curve
+	MinIssueFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=min_issue_fee,json=minIssueFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_issue_fee" yaml:"min_issue_fee"`
+	// symbols not longer than premium_symbol_len are charged the issue fee given by the
+	// fee curve multiplied by premium_fee_multiplier, 0 disabling the premium tier
+	PremiumSymbolLen     uint32                                 `protobuf:"varint,7,opt,name=premium_symbol_len,json=premiumSymbolLen,proto3" json:"premium_symbol_len,omitempty" yaml:"premium_symbol_len"`
+	PremiumFeeMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=premium_fee_multiplier,json=premiumFeeMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"premium_fee_multiplier" yaml:"premium_fee_multiplier"`
+	// minimum amount of a token vesting, in the smallest unit of the token
+	MinVestingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=min_vesting_amount,json=minVestingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_vesting_amount" yaml:"min_vesting_amount"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4817717eb3178fe7, []int{1}
+	return fileDescriptor_4817717eb3178fe7, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -108,46 +252,511 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// FrozenAccount defines an account whose outgoing transfers of a token are frozen
+type FrozenAccount struct {
+	// smallest unit of the token
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *FrozenAccount) Reset()         { *m = FrozenAccount{} }
+func (m *FrozenAccount) String() string { return proto.CompactTextString(m) }
+func (*FrozenAccount) ProtoMessage()    {}
+func (*FrozenAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4817717eb3178fe7, []int{4}
+}
+func (m *FrozenAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrozenAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrozenAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenAccount.Merge(m, src)
+}
+func (m *FrozenAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenAccount proto.InternalMessageInfo
+
+// TokenVesting defines tokens escrowed in the token module account and released
+// to the beneficiary linearly from the start to the end time, nothing being
+// released before the cliff time
+type TokenVesting struct {
+	Id          uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator     string     `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Beneficiary string     `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	Total       types.Coin `protobuf:"bytes,4,opt,name=total,proto3" json:"total"`
+	Released    types.Coin `protobuf:"bytes,5,opt,name=released,proto3" json:"released"`
+	StartTime   time.Time  `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	CliffTime   time.Time  `protobuf:"bytes,7,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time" yaml:"cliff_time"`
+	EndTime     time.Time  `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+}
+
+func (m *TokenVesting) Reset()         { *m = TokenVesting{} }
+func (m *TokenVesting) String() string { return proto.CompactTextString(m) }
+func (*TokenVesting) ProtoMessage()    {}
+func (*TokenVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4817717eb3178fe7, []int{5}
+}
+func (m *TokenVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenVesting.Merge(m, src)
+}
+func (m *TokenVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenVesting proto.InternalMessageInfo
+
+// TokenFeeOverride defines the issue and mint fees of a symbol set by governance
+// in place of the fee curve, zero fees waiving them
+type TokenFeeOverride struct {
+	Symbol   string     `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	IssueFee types.Coin `protobuf:"bytes,2,opt,name=issue_fee,json=issueFee,proto3" json:"issue_fee" yaml:"issue_fee"`
+	MintFee  types.Coin `protobuf:"bytes,3,opt,name=mint_fee,json=mintFee,proto3" json:"mint_fee" yaml:"mint_fee"`
+}
+
+func (m *TokenFeeOverride) Reset()         { *m = TokenFeeOverride{} }
+func (m *TokenFeeOverride) String() string { return proto.CompactTextString(m) }
+func (*TokenFeeOverride) ProtoMessage()    {}
+func (*TokenFeeOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4817717eb3178fe7, []int{6}
+}
+func (m *TokenFeeOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenFeeOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenFeeOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenFeeOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenFeeOverride.Merge(m, src)
+}
+func (m *TokenFeeOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenFeeOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenFeeOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenFeeOverride proto.InternalMessageInfo
+
+// TokenFeeOverrideProposal defines a governance proposal overriding or waiving the
+// issue and mint fees of a symbol, or removing its override when remove is true
+type TokenFeeOverrideProposal struct {
+	Title       string     `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Symbol      string     `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	IssueFee    types.Coin `protobuf:"bytes,4,opt,name=issue_fee,json=issueFee,proto3" json:"issue_fee" yaml:"issue_fee"`
+	MintFee     types.Coin `protobuf:"bytes,5,opt,name=mint_fee,json=mintFee,proto3" json:"mint_fee" yaml:"mint_fee"`
+	Remove      bool       `protobuf:"varint,6,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *TokenFeeOverrideProposal) Reset()      { *m = TokenFeeOverrideProposal{} }
+func (*TokenFeeOverrideProposal) ProtoMessage() {}
+func (*TokenFeeOverrideProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4817717eb3178fe7, []int{7}
+}
+func (m *TokenFeeOverrideProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenFeeOverrideProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenFeeOverrideProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenFeeOverrideProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenFeeOverrideProposal.Merge(m, src)
+}
+func (m *TokenFeeOverrideProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenFeeOverrideProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenFeeOverrideProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenFeeOverrideProposal proto.InternalMessageInfo
+
+// SymbolReservation defines a symbol reserved by governance which only the
+// reserved address can issue
+type SymbolReservation struct {
+	Symbol  string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *SymbolReservation) Reset()         { *m = SymbolReservation{} }
+func (m *SymbolReservation) String() string { return proto.CompactTextString(m) }
+func (*SymbolReservation) ProtoMessage()    {}
+func (*SymbolReservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4817717eb3178fe7, []int{8}
+}
+func (m *SymbolReservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbolReservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbolReservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SymbolReservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbolReservation.Merge(m, src)
+}
+func (m *SymbolReservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbolReservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbolReservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbolReservation proto.InternalMessageInfo
+
+// SymbolReservationProposal defines a governance proposal reserving a symbol for
+// an address, or releasing the reservation of the symbol when remove is true
+type SymbolReservationProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Symbol      string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Address     string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Remove      bool   `protobuf:"varint,5,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *SymbolReservationProposal) Reset()      { *m = SymbolReservationProposal{} }
+func (*SymbolReservationProposal) ProtoMessage() {}
+func (*SymbolReservationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4817717eb3178fe7, []int{9}
+}
+func (m *SymbolReservationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbolReservationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbolReservationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SymbolReservationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbolReservationProposal.Merge(m, src)
+}
+func (m *SymbolReservationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbolReservationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbolReservationProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbolReservationProposal proto.InternalMessageInfo
+
+// TokenSnapshot defines a snapshot of the holders of a token taken by its owner,
+// the holders being queried at the recorded height
+type TokenSnapshot struct {
+	Id      uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol  string    `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Height  int64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time    time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	Creator string    `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *TokenSnapshot) Reset()         { *m = TokenSnapshot{} }
+func (m *TokenSnapshot) String() string { return proto.CompactTextString(m) }
+func (*TokenSnapshot) ProtoMessage()    {}
+func (*TokenSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4817717eb3178fe7, []int{10}
+}
+func (m *TokenSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenSnapshot.Merge(m, src)
+}
+func (m *TokenSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenSnapshot proto.InternalMessageInfo
+
+// TokenDistribution defines tokens escrowed in the token module account and
+// claimed by the recipients proving the inclusion of their (address, amount)
+// pair in the merkle root, the unclaimed amount being clawed back to the
+// creator from the end time
+type TokenDistribution struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol  string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// hex encoded merkle root of the (address, amount) pairs
+	MerkleRoot string     `protobuf:"bytes,4,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty" yaml:"merkle_root"`
+	Total      types.Coin `protobuf:"bytes,5,opt,name=total,proto3" json:"total"`
+	Claimed    types.Coin `protobuf:"bytes,6,opt,name=claimed,proto3" json:"claimed"`
+	EndTime    time.Time  `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+}
+
+func (m *TokenDistribution) Reset()         { *m = TokenDistribution{} }
+func (m *TokenDistribution) String() string { return proto.CompactTextString(m) }
+func (*TokenDistribution) ProtoMessage()    {}
+func (*TokenDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4817717eb3178fe7, []int{11}
+}
+func (m *TokenDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenDistribution.Merge(m, src)
+}
+func (m *TokenDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenDistribution proto.InternalMessageInfo
+
+// DistributionClaim defines the amount of a token distribution claimed by an address
+type DistributionClaim struct {
+	DistributionId uint64     `protobuf:"varint,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty" yaml:"distribution_id"`
+	Address        string     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount         types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *DistributionClaim) Reset()         { *m = DistributionClaim{} }
+func (m *DistributionClaim) String() string { return proto.CompactTextString(m) }
+func (*DistributionClaim) ProtoMessage()    {}
+func (*DistributionClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4817717eb3178fe7, []int{12}
+}
+func (m *DistributionClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionClaim.Merge(m, src)
+}
+func (m *DistributionClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionClaim proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("gauss.token.TokenRole", TokenRole_name, TokenRole_value)
 	proto.RegisterType((*Token)(nil), "gauss.token.Token")
+	proto.RegisterType((*Attribute)(nil), "gauss.token.Attribute")
+	proto.RegisterType((*TokenRoles)(nil), "gauss.token.TokenRoles")
 	proto.RegisterType((*Params)(nil), "gauss.token.Params")
+	proto.RegisterType((*FrozenAccount)(nil), "gauss.token.FrozenAccount")
+	proto.RegisterType((*TokenVesting)(nil), "gauss.token.TokenVesting")
+	proto.RegisterType((*TokenFeeOverride)(nil), "gauss.token.TokenFeeOverride")
+	proto.RegisterType((*TokenFeeOverrideProposal)(nil), "gauss.token.TokenFeeOverrideProposal")
+	proto.RegisterType((*SymbolReservation)(nil), "gauss.token.SymbolReservation")
+	proto.RegisterType((*SymbolReservationProposal)(nil), "gauss.token.SymbolReservationProposal")
+	proto.RegisterType((*TokenSnapshot)(nil), "gauss.token.TokenSnapshot")
+	proto.RegisterType((*TokenDistribution)(nil), "gauss.token.TokenDistribution")
+	proto.RegisterType((*DistributionClaim)(nil), "gauss.token.DistributionClaim")
 }
 
 func init() { proto.RegisterFile("gauss/token/token.proto", fileDescriptor_4817717eb3178fe7) }
 
 var fileDescriptor_4817717eb3178fe7 = []byte{
-	// 494 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x31, 0x73, 0xd3, 0x30,
-	0x14, 0xb6, 0x43, 0x12, 0x1c, 0x25, 0xe9, 0x71, 0xa2, 0x50, 0x37, 0x83, 0x9d, 0x33, 0x77, 0x90,
-	0x05, 0xfb, 0x0a, 0x4c, 0x99, 0x38, 0x03, 0x65, 0xed, 0x89, 0xb2, 0xb0, 0xf8, 0x64, 0x57, 0x04,
-	0x5d, 0x2d, 0x2b, 0x17, 0xc9, 0x25, 0xf9, 0x17, 0x8c, 0x8c, 0x9d, 0x99, 0xf9, 0x11, 0x19, 0x3b,
-	0x72, 0x0c, 0x3e, 0x48, 0x16, 0xe6, 0xfc, 0x02, 0x4e, 0x92, 0x93, 0xb6, 0x63, 0x17, 0xf9, 0x7d,
-	0xdf, 0xf3, 0xf7, 0x9e, 0xf4, 0xbe, 0x07, 0x0e, 0x26, 0xb8, 0x14, 0x22, 0x92, 0xfc, 0x9c, 0x14,
-	0xe6, 0x0c, 0xa7, 0x33, 0x2e, 0x39, 0xec, 0xea, 0x44, 0xa8, 0xa9, 0x81, 0x97, 0x71, 0xc1, 0xb8,
-	0x88, 0x52, 0x2c, 0x48, 0x74, 0x71, 0x94, 0x12, 0x89, 0x8f, 0xa2, 0x8c, 0xd3, 0xfa, 0xe7, 0xc1,
-	0xfe, 0x84, 0x4f, 0xb8, 0x0e, 0x23, 0x15, 0x19, 0x36, 0xf8, 0xd1, 0x00, 0xad, 0x53, 0xa5, 0x87,
-	0x10, 0x34, 0x0b, 0xcc, 0x88, 0x6b, 0x0f, 0xed, 0x51, 0x07, 0xe9, 0x18, 0x3e, 0x06, 0x6d, 0xb1,
-	0x60, 0x29, 0xcf, 0xdd, 0x86, 0x66, 0x6b, 0x04, 0x9f, 0x80, 0xbe, 0x60, 0x38, 0xcf, 0x89, 0x90,
-	0x49, 0x59, 0x50, 0xe9, 0xde, 0xd3, 0xe9, 0xde, 0x96, 0xfc, 0x58, 0x50, 0x09, 0x07, 0xc0, 0x39,
-	0x23, 0x19, 0x65, 0x38, 0x17, 0x6e, 0x73, 0x68, 0x8f, 0xfa, 0x68, 0x87, 0xe1, 0x6b, 0xb0, 0x47,
-	0x0b, 0x2a, 0x29, 0xce, 0x13, 0x51, 0x4e, 0xa7, 0xf9, 0xc2, 0x6d, 0x0d, 0xed, 0x51, 0x33, 0x3e,
-	0xdc, 0x54, 0xfe, 0xa3, 0x05, 0x66, 0xf9, 0x38, 0xb8, 0x9d, 0x0f, 0x50, 0xbf, 0x26, 0x3e, 0x68,
-	0x0c, 0xc7, 0xa0, 0x27, 0xb9, 0xbc, 0xd6, 0xb7, 0xb5, 0xfe, 0x60, 0x53, 0xf9, 0x0f, 0x8d, 0xfe,
-	0x66, 0x36, 0x40, 0x5d, 0x0d, 0x6b, 0xed, 0x00, 0x38, 0x8c, 0x16, 0x12, 0xa7, 0x39, 0x71, 0xef,
-	0x0f, 0xed, 0x91, 0x83, 0x76, 0x18, 0xee, 0x83, 0x16, 0xff, 0x5a, 0x90, 0x99, 0xeb, 0xe8, 0x27,
-	0x19, 0x30, 0x6e, 0x7e, 0xbf, 0xf4, 0xad, 0xe0, 0x67, 0x03, 0xb4, 0x4f, 0xf0, 0x0c, 0x33, 0x01,
-	0x13, 0xd0, 0xd1, 0x63, 0x4f, 0x24, 0x9e, 0x9b, 0x91, 0xc5, 0xf1, 0xb2, 0xf2, 0xad, 0xdf, 0x95,
-	0xff, 0x74, 0x42, 0xe5, 0x97, 0x32, 0x0d, 0x33, 0xce, 0xa2, 0xda, 0x13, 0xf3, 0x79, 0x2e, 0xce,
-	0xce, 0x23, 0xb9, 0x98, 0x12, 0x11, 0xbe, 0x25, 0xd9, 0xa6, 0xf2, 0x1f, 0x6c, 0x6f, 0x5a, 0x17,
-	0x0a, 0x90, 0xa3, 0xe3, 0x53, 0x3c, 0x87, 0x27, 0xa0, 0x43, 0x85, 0x28, 0x49, 0xf2, 0x99, 0x10,
-	0x3d, 0xfd, 0xee, 0x8b, 0xc3, 0xd0, 0xd4, 0x09, 0x95, 0xc5, 0x61, 0x6d, 0x71, 0xf8, 0x86, 0xd3,
-	0x22, 0x76, 0x55, 0xef, 0xeb, 0x8a, 0x3b, 0x65, 0x80, 0x1c, 0x1d, 0x1f, 0x13, 0x02, 0x19, 0xd8,
-	0x53, 0xaf, 0x54, 0x74, 0x32, 0xc3, 0x92, 0x72, 0xe3, 0x5a, 0xfc, 0xfe, 0xce, 0xf7, 0xae, 0x1d,
-	0xba, 0x5d, 0x2d, 0x40, 0x3d, 0x45, 0x1c, 0x13, 0x82, 0x14, 0x1c, 0x3b, 0x6a, 0x64, 0xff, 0x2e,
-	0x7d, 0x3b, 0x7e, 0xb7, 0xfc, 0xeb, 0x59, 0xcb, 0x95, 0x67, 0x5f, 0xad, 0x3c, 0xfb, 0xcf, 0xca,
-	0xb3, 0xbf, 0xad, 0x3d, 0xeb, 0x6a, 0xed, 0x59, 0xbf, 0xd6, 0x9e, 0xf5, 0xe9, 0xd9, 0x8d, 0xb6,
-	0x66, 0xd1, 0xcd, 0x79, 0xf1, 0x2a, 0x9a, 0x6f, 0x77, 0x5e, 0xf5, 0x4e, 0xdb, 0x7a, 0x63, 0x5f,
-	0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xf0, 0xb9, 0xb1, 0x56, 0x0f, 0x03, 0x00, 0x00,
+	// 1589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x77, 0xc7, 0xdf, 0xe5, 0x38, 0xe3, 0xd4, 0xce, 0x64, 0x3a, 0x86, 0x71, 0x5b, 0x8d, 0x80,
+	0x08, 0x81, 0xad, 0x9d, 0x1d, 0x34, 0x6c, 0x40, 0x02, 0xf7, 0xc4, 0x01, 0x6b, 0x36, 0x99, 0xa8,
+	0x92, 0x20, 0xc4, 0xa5, 0x55, 0x76, 0x57, 0xe2, 0x62, 0xba, 0xbb, 0xac, 0xae, 0xea, 0x90, 0xec,
+	0x1d, 0x69, 0x35, 0xa7, 0x3d, 0x72, 0x19, 0x69, 0xa4, 0x3d, 0xee, 0x89, 0xbf, 0x81, 0xcb, 0x48,
+	0x5c, 0xf6, 0x80, 0x10, 0xe2, 0xe0, 0x85, 0xcc, 0x85, 0x0b, 0x17, 0xff, 0x05, 0xa8, 0xaa, 0xba,
+	0xed, 0x76, 0xa2, 0xd9, 0x49, 0xc4, 0x70, 0x49, 0xea, 0xfd, 0xde, 0x47, 0xd5, 0x7b, 0x7e, 0x5f,
+	0x0d, 0xee, 0x9f, 0xe2, 0x98, 0xf3, 0xae, 0x60, 0xcf, 0x49, 0xa8, 0xff, 0x76, 0x26, 0x11, 0x13,
+	0x0c, 0xd6, 0x14, 0xa3, 0xa3, 0xa0, 0xa6, 0x75, 0xca, 0xd8, 0xa9, 0x4f, 0xba, 0x8a, 0x35, 0x8c,
+	0x4f, 0xba, 0x82, 0x06, 0x84, 0x0b, 0x1c, 0x4c, 0xb4, 0x74, 0xb3, 0x35, 0x62, 0x3c, 0x60, 0xbc,
+	0x3b, 0xc4, 0x9c, 0x74, 0xcf, 0x3e, 0x1c, 0x12, 0x81, 0x3f, 0xec, 0x8e, 0x18, 0x4d, 0xac, 0x35,
+	0xef, 0x9e, 0xb2, 0x53, 0xa6, 0x8e, 0x5d, 0x79, 0xd2, 0xa8, 0xfd, 0xe7, 0x02, 0x28, 0x1e, 0xc9,
+	0x0b, 0x20, 0x04, 0x85, 0x10, 0x07, 0xc4, 0x34, 0xda, 0xc6, 0x56, 0x15, 0xa9, 0x33, 0xdc, 0x00,
+	0x25, 0x7e, 0x11, 0x0c, 0x99, 0x6f, 0xae, 0x28, 0x34, 0xa1, 0xe0, 0x77, 0x40, 0x9d, 0x07, 0xd8,
+	0xf7, 0x09, 0x17, 0x6e, 0x1c, 0x52, 0x61, 0xe6, 0x15, 0x7b, 0x35, 0x05, 0x8f, 0x43, 0x2a, 0x60,
+	0x13, 0x54, 0x3c, 0x32, 0xa2, 0x01, 0xf6, 0xb9, 0x59, 0x68, 0x1b, 0x5b, 0x75, 0x34, 0xa7, 0xe1,
+	0x2f, 0xc0, 0x1a, 0x0d, 0xa9, 0xa0, 0xd8, 0x77, 0x79, 0x3c, 0x99, 0xf8, 0x17, 0x66, 0xb1, 0x6d,
+	0x6c, 0x15, 0x9c, 0xcd, 0xd9, 0xd4, 0xba, 0x77, 0x81, 0x03, 0x7f, 0xdb, 0x5e, 0xe6, 0xdb, 0xa8,
+	0x9e, 0x00, 0x87, 0x8a, 0x86, 0xdb, 0x60, 0x55, 0x30, 0xb1, 0xd0, 0x2f, 0x29, 0xfd, 0xfb, 0xb3,
+	0xa9, 0xf5, 0x81, 0xd6, 0xcf, 0x72, 0x6d, 0x54, 0x53, 0x64, 0xa2, 0xdb, 0x04, 0x95, 0x80, 0x86,
+	0x02, 0x0f, 0x7d, 0x62, 0x96, 0xdb, 0xc6, 0x56, 0x05, 0xcd, 0x69, 0x78, 0x17, 0x14, 0xd9, 0xef,
+	0x43, 0x12, 0x99, 0x15, 0xe5, 0x92, 0x26, 0xe0, 0x26, 0xc8, 0xc7, 0x11, 0x35, 0xab, 0x12, 0x73,
+	0xca, 0x97, 0x53, 0x2b, 0x7f, 0x8c, 0x06, 0x48, 0x62, 0xf0, 0x63, 0x50, 0x89, 0x23, 0xea, 0x8e,
+	0x31, 0x1f, 0x9b, 0x40, 0xf1, 0x5b, 0x97, 0x53, 0xab, 0x7c, 0x8c, 0x06, 0xbf, 0xc2, 0x7c, 0x3c,
+	0x9b, 0x5a, 0x77, 0xf4, 0x7b, 0x52, 0x21, 0x1b, 0x95, 0xe3, 0x88, 0x4a, 0x1e, 0x6c, 0x83, 0x9a,
+	0x47, 0xf8, 0x28, 0xa2, 0x13, 0x41, 0x59, 0x68, 0xd6, 0xd4, 0x8d, 0x59, 0x08, 0xfe, 0x0c, 0x00,
+	0x2c, 0x44, 0x44, 0x87, 0xb1, 0x20, 0xdc, 0x5c, 0x6d, 0xe7, 0xb7, 0x6a, 0x0f, 0x37, 0x3a, 0x99,
+	0xbc, 0xe8, 0xf4, 0x52, 0xb6, 0x53, 0x78, 0x3d, 0xb5, 0x72, 0x28, 0x23, 0x0f, 0x9f, 0x80, 0x3b,
+	0x63, 0xe6, 0x7b, 0x24, 0x72, 0x87, 0x71, 0x14, 0x2a, 0x77, 0xeb, 0xd2, 0x5d, 0xa7, 0x39, 0x9b,
+	0x5a, 0x1b, 0xfa, 0x59, 0x57, 0x04, 0x6c, 0xb4, 0xa6, 0x11, 0x27, 0x01, 0xe0, 0xb7, 0x41, 0xf5,
+	0x24, 0x22, 0xe4, 0x53, 0xa5, 0xbe, 0xa6, 0xa2, 0xb5, 0x00, 0xb6, 0x0b, 0x7f, 0x7c, 0x65, 0xe5,
+	0xec, 0x8f, 0x40, 0x75, 0xfe, 0x0e, 0xd8, 0x00, 0xf9, 0xe7, 0xe4, 0x22, 0xc9, 0x23, 0x79, 0x94,
+	0x31, 0x3d, 0xc3, 0x7e, 0x4c, 0x92, 0x2c, 0xd2, 0x84, 0xed, 0x03, 0xa0, 0x32, 0x0f, 0x31, 0x9f,
+	0xf0, 0x4c, 0xaa, 0x19, 0x4b, 0xa9, 0x66, 0x82, 0x32, 0xf6, 0xbc, 0x88, 0x70, 0x9e, 0x68, 0xa7,
+	0x24, 0xfc, 0x21, 0x28, 0x46, 0x52, 0xd5, 0xcc, 0xb7, 0xf3, 0x5b, 0x6b, 0x57, 0xc2, 0x32, 0xb7,
+	0x8c, 0xb4, 0x90, 0xfd, 0x75, 0x09, 0x94, 0x0e, 0x70, 0x84, 0x03, 0x0e, 0x5d, 0x50, 0x55, 0x42,
+	0xae, 0xc0, 0xe7, 0xfa, 0x36, 0xc7, 0x91, 0xb1, 0xfb, 0xc7, 0xd4, 0xfa, 0xde, 0x29, 0x15, 0xe3,
+	0x78, 0xd8, 0x19, 0xb1, 0xa0, 0x9b, 0xd4, 0x93, 0xfe, 0xf7, 0x23, 0xee, 0x3d, 0xef, 0x8a, 0x8b,
+	0x09, 0xe1, 0x9d, 0x1d, 0x32, 0x9a, 0x4d, 0xad, 0x46, 0x9a, 0x65, 0x89, 0x21, 0x1b, 0x55, 0xd4,
+	0xf9, 0x08, 0x9f, 0xc3, 0x03, 0x50, 0xa5, 0x9c, 0xc7, 0xc4, 0x3d, 0x21, 0xda, 0xe7, 0xda, 0xc3,
+	0xcd, 0x8e, 0xb6, 0xd3, 0x91, 0xe5, 0xd9, 0x49, 0xca, 0xb3, 0xf3, 0x84, 0xd1, 0xd0, 0x31, 0xe5,
+	0xdd, 0x0b, 0x8b, 0x73, 0x4d, 0x1b, 0x55, 0xd4, 0x79, 0x97, 0x10, 0x18, 0x80, 0x35, 0x99, 0xa1,
+	0x12, 0x76, 0x23, 0x2c, 0x28, 0xd3, 0x15, 0xe7, 0xfc, 0xf2, 0xd6, 0xef, 0x4e, 0xaa, 0x6b, 0xd9,
+	0x9a, 0x8d, 0x56, 0x25, 0xb0, 0x4b, 0x08, 0x92, 0x24, 0x7c, 0x0c, 0x6a, 0x27, 0x78, 0x24, 0x58,
+	0xe4, 0xca, 0xe7, 0xea, 0xea, 0x75, 0x36, 0x66, 0x53, 0x0b, 0x6a, 0xed, 0x0c, 0xd3, 0x46, 0x40,
+	0x53, 0x0e, 0xe6, 0x04, 0x3e, 0x02, 0x09, 0xe5, 0x92, 0xf3, 0x89, 0xaa, 0xe9, 0xba, 0x73, 0x6f,
+	0x36, 0xb5, 0xd6, 0x97, 0xf4, 0xc8, 0xf9, 0xc4, 0x46, 0x55, 0x4d, 0xf4, 0xcf, 0x27, 0xf0, 0x77,
+	0xa0, 0x1e, 0xd0, 0xd0, 0x5d, 0xc4, 0xac, 0xa4, 0x9c, 0xdb, 0xbd, 0x85, 0x73, 0x83, 0x50, 0xcc,
+	0xa6, 0xd6, 0xdd, 0xb9, 0x73, 0x6e, 0x26, 0x8c, 0xb5, 0x80, 0x86, 0x83, 0x34, 0x92, 0x4f, 0x01,
+	0x9c, 0x44, 0x24, 0xa0, 0x71, 0xe0, 0xea, 0x0c, 0x73, 0x7d, 0x12, 0xaa, 0x2e, 0x50, 0x77, 0x1e,
+	0xcc, 0xa6, 0xd6, 0xa6, 0x36, 0x71, 0x5d, 0xc6, 0x46, 0x8d, 0x04, 0x3c, 0x54, 0xd8, 0x27, 0x24,
+	0x84, 0x7f, 0x30, 0xc0, 0x46, 0x2a, 0x29, 0x83, 0x19, 0xc4, 0xbe, 0xa0, 0x13, 0x9f, 0xa6, 0xed,
+	0xc3, 0x79, 0x76, 0xeb, 0xdf, 0xe7, 0xc1, 0xf2, 0xfd, 0xcb, 0x56, 0x6d, 0x74, 0x37, 0x61, 0xec,
+	0x12, 0xb2, 0x37, 0x87, 0xe1, 0x05, 0x80, 0xd2, 0xe7, 0x33, 0xc2, 0x05, 0x0d, 0x4f, 0x5d, 0x1c,
+	0xb0, 0x38, 0x14, 0x49, 0xb7, 0x7a, 0x7a, 0xeb, 0x28, 0x6e, 0x2e, 0xa2, 0xb8, 0x6c, 0xd1, 0x46,
+	0x8d, 0x80, 0x86, 0xbf, 0xd6, 0x58, 0x4f, 0x41, 0xdb, 0x15, 0xd9, 0x00, 0xfe, 0xfd, 0xca, 0x32,
+	0xec, 0x9f, 0x83, 0xfa, 0x6e, 0xc4, 0x3e, 0x25, 0x61, 0x6f, 0x34, 0x92, 0x2c, 0x59, 0xf6, 0x1e,
+	0x09, 0x59, 0x90, 0x54, 0xb4, 0x26, 0xde, 0x5e, 0xd0, 0xf6, 0xdf, 0xf2, 0x60, 0x55, 0xd5, 0x6d,
+	0x72, 0x03, 0x5c, 0x03, 0x2b, 0xd4, 0x53, 0xda, 0x05, 0xb4, 0x42, 0x3d, 0xa9, 0x3a, 0x8a, 0x08,
+	0x16, 0x2c, 0x4a, 0x55, 0x13, 0x52, 0x76, 0xd2, 0x21, 0x09, 0xc9, 0x09, 0x1d, 0x51, 0x1c, 0x5d,
+	0x24, 0xe3, 0x28, 0x0b, 0xc1, 0x1f, 0x83, 0xa2, 0x1a, 0x01, 0x66, 0xe1, 0x5d, 0xf5, 0xa8, 0xfb,
+	0xa8, 0x96, 0x86, 0x3f, 0x05, 0x95, 0x88, 0xf8, 0x04, 0x73, 0xe2, 0x99, 0xc5, 0x9b, 0x69, 0xce,
+	0x15, 0xe0, 0x6f, 0x00, 0xe0, 0x02, 0x47, 0xc2, 0x95, 0xb3, 0x5a, 0x25, 0x75, 0xed, 0x61, 0xb3,
+	0xa3, 0x07, 0x79, 0x27, 0x1d, 0xe4, 0x9d, 0xa3, 0x74, 0x90, 0x3b, 0x0f, 0x92, 0x4e, 0x90, 0x54,
+	0xcb, 0x42, 0xd7, 0xfe, 0xfc, 0x6b, 0xcb, 0x40, 0x55, 0x05, 0x48, 0x71, 0x69, 0x79, 0xe4, 0xd3,
+	0x93, 0x13, 0x6d, 0xb9, 0x7c, 0x5b, 0xcb, 0x0b, 0xdd, 0xc4, 0xb2, 0x02, 0x94, 0x65, 0x04, 0x2a,
+	0x24, 0xf4, 0xb4, 0xdd, 0xca, 0x3b, 0xed, 0x7e, 0x2b, 0xb1, 0x9b, 0xcc, 0xb8, 0x54, 0x53, 0x5b,
+	0x2d, 0x93, 0xd0, 0x93, 0xa2, 0xf6, 0x5f, 0x0c, 0xd0, 0x50, 0x3f, 0xec, 0x2e, 0x21, 0xcf, 0xce,
+	0x48, 0x14, 0x51, 0x8f, 0xbc, 0xb5, 0xe1, 0xbf, 0xff, 0xe6, 0xb9, 0xa7, 0xc7, 0xbd, 0x32, 0x98,
+	0x7f, 0x97, 0xc1, 0xfb, 0xcb, 0x1e, 0xa5, 0x8a, 0x36, 0x2a, 0x27, 0x1d, 0xd2, 0xfe, 0xd3, 0x0a,
+	0x30, 0xaf, 0x7a, 0x73, 0x10, 0xb1, 0x09, 0xe3, 0xd8, 0x97, 0x39, 0x2f, 0xa8, 0xf0, 0xd3, 0x35,
+	0x4a, 0x13, 0x57, 0x07, 0xfd, 0xca, 0xf5, 0x41, 0xbf, 0x88, 0x46, 0xfe, 0xed, 0xd1, 0x28, 0xbc,
+	0xef, 0x68, 0x14, 0xff, 0xe7, 0x68, 0xc8, 0x87, 0x47, 0x24, 0x60, 0x67, 0x3a, 0xbf, 0x2b, 0x28,
+	0xa1, 0xb6, 0x57, 0x3f, 0x7b, 0x65, 0xe5, 0x92, 0xde, 0x90, 0xb3, 0xfb, 0x60, 0x5d, 0x77, 0x4d,
+	0x44, 0x38, 0x89, 0xce, 0xf0, 0x15, 0x9f, 0x6f, 0x38, 0xf2, 0xed, 0x2f, 0x0d, 0xb0, 0x79, 0xcd,
+	0xce, 0xff, 0x2d, 0xf6, 0x99, 0x77, 0x14, 0x96, 0x57, 0x8f, 0x85, 0xd3, 0xc5, 0x6f, 0x70, 0xfa,
+	0x0b, 0x03, 0xd4, 0x55, 0xa2, 0x1c, 0x86, 0x78, 0xc2, 0xc7, 0x4c, 0x5c, 0x6b, 0x68, 0x6f, 0xdb,
+	0xaf, 0x37, 0x40, 0x69, 0x4c, 0xe8, 0xe9, 0x58, 0x2f, 0xd6, 0x79, 0x94, 0x50, 0xf0, 0x27, 0xa0,
+	0xa0, 0x0a, 0xb3, 0xf0, 0xce, 0xc2, 0xac, 0xc8, 0x1f, 0x4e, 0x55, 0xa1, 0xd2, 0xc8, 0xb6, 0xce,
+	0xe2, 0x52, 0xeb, 0xb4, 0xff, 0xba, 0x02, 0xd6, 0xd5, 0x2b, 0x77, 0x28, 0xd7, 0x2b, 0x9c, 0x8c,
+	0xc9, 0x4d, 0x5f, 0x9a, 0xb1, 0x9b, 0x5f, 0x6e, 0xc9, 0x8f, 0x41, 0x2d, 0x20, 0xd1, 0x73, 0x9f,
+	0xb8, 0x11, 0x63, 0x42, 0x47, 0x30, 0xbb, 0x43, 0x64, 0x98, 0x36, 0x02, 0x9a, 0x42, 0x8c, 0x89,
+	0x45, 0xa7, 0x2e, 0xde, 0xaa, 0x53, 0x7f, 0x0c, 0xca, 0x23, 0x1f, 0xd3, 0x80, 0x78, 0x66, 0xe9,
+	0x66, 0x8a, 0xa9, 0xfc, 0x52, 0xcf, 0x2b, 0xbf, 0xa7, 0x9e, 0xf7, 0xa5, 0x01, 0xd6, 0xb3, 0x11,
+	0x7d, 0x22, 0xef, 0x92, 0x1b, 0xb9, 0x97, 0x01, 0xdd, 0x34, 0xc6, 0xd9, 0x8d, 0xfc, 0x8a, 0x80,
+	0x8d, 0xd6, 0xb2, 0xc8, 0xc0, 0xfb, 0x86, 0x95, 0xf8, 0x31, 0x28, 0x25, 0xb3, 0x3f, 0x7f, 0xb3,
+	0x10, 0x24, 0xe2, 0x3f, 0xf8, 0x8f, 0x01, 0xaa, 0xf3, 0x95, 0x19, 0x76, 0xc1, 0xc6, 0xd1, 0xb3,
+	0xa7, 0xfd, 0x7d, 0x17, 0x3d, 0xfb, 0xa4, 0xef, 0x1e, 0xef, 0x1f, 0x1e, 0xf4, 0x9f, 0x0c, 0x76,
+	0x07, 0xfd, 0x9d, 0x46, 0xae, 0xf9, 0xc1, 0x8b, 0x97, 0xed, 0x3b, 0x52, 0xea, 0x38, 0xe4, 0x13,
+	0x32, 0xa2, 0x27, 0x94, 0x78, 0xf0, 0xbb, 0x60, 0x3d, 0xa3, 0xb0, 0x37, 0xd8, 0x3f, 0xea, 0xa3,
+	0x86, 0xd1, 0x5c, 0x7b, 0xf1, 0xb2, 0x0d, 0xa4, 0xec, 0x1e, 0x0d, 0x05, 0x89, 0xae, 0x88, 0x39,
+	0xc7, 0x68, 0xbf, 0x8f, 0x1a, 0x2b, 0x0b, 0x31, 0xf9, 0xcd, 0x71, 0x4d, 0xec, 0xa0, 0x77, 0x7c,
+	0xd8, 0x47, 0x8d, 0xfc, 0x42, 0xec, 0x00, 0xc7, 0x9c, 0x44, 0xf0, 0x11, 0xd8, 0xcc, 0x5e, 0xda,
+	0x3f, 0xea, 0xed, 0xf4, 0x8e, 0x7a, 0x6e, 0x6f, 0x67, 0x6f, 0xb0, 0xdf, 0x28, 0x34, 0xef, 0xbd,
+	0x78, 0xd9, 0x5e, 0x57, 0x97, 0x13, 0x81, 0x3d, 0x2c, 0x70, 0xcf, 0x0b, 0x68, 0xd8, 0x2c, 0x7c,
+	0xf6, 0x45, 0x2b, 0xe7, 0xf4, 0x5f, 0xff, 0xab, 0x95, 0x7b, 0x7d, 0xd9, 0x32, 0xbe, 0xba, 0x6c,
+	0x19, 0xff, 0xbc, 0x6c, 0x19, 0x9f, 0xbf, 0x69, 0xe5, 0xbe, 0x7a, 0xd3, 0xca, 0xfd, 0xfd, 0x4d,
+	0x2b, 0xf7, 0xdb, 0xef, 0x67, 0x56, 0x25, 0xfd, 0x71, 0xae, 0xff, 0x9e, 0x3d, 0xea, 0x9e, 0xa7,
+	0xdf, 0xe9, 0x72, 0x5f, 0x1a, 0x96, 0x54, 0x7a, 0x7c, 0xf4, 0xdf, 0x01, 0x00, 0x6d, 0xd0, 0xb1,
+	0xa8, 0xc3, 0x0f, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -178,17 +787,35 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MintFeeRatio.Equal(that1.MintFeeRatio) {
 		return false
 	}
-	return true
-}
-func (m *Token) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.FactorBase != that1.FactorBase {
+		return false
 	}
-	return dAtA[:n], nil
-}
+	if this.FactorExp != that1.FactorExp {
+		return false
+	}
+	if !this.MinIssueFee.Equal(that1.MinIssueFee) {
+		return false
+	}
+	if this.PremiumSymbolLen != that1.PremiumSymbolLen {
+		return false
+	}
+	if !this.PremiumFeeMultiplier.Equal(that1.PremiumFeeMultiplier) {
+		return false
+	}
+	if !this.MinVestingAmount.Equal(that1.MinVestingAmount) {
+		return false
+	}
+	return true
+}
+func (m *Token) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
 
 func (m *Token) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
@@ -200,6 +827,61 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Freezable {
+		i--
+		if m.Freezable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.HolderBurnable {
+		i--
+		if m.HolderBurnable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -256,6 +938,98 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Attribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenRoles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenRoles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		dAtA2 := make([]byte, len(m.Roles)*10)
+		var j1 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintToken(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -276,6 +1050,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinVestingAmount.Size()
+		i -= size
+		if _, err := m.MinVestingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.PremiumFeeMultiplier.Size()
+		i -= size
+		if _, err := m.PremiumFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.PremiumSymbolLen != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.PremiumSymbolLen))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MinIssueFee.Size()
+		i -= size
+		if _, err := m.MinIssueFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.FactorExp != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.FactorExp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FactorBase != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.FactorBase))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.MintFeeRatio.Size()
 		i -= size
@@ -302,83 +1121,3216 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if _, err := m.TokenTax.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintToken(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
-	offset -= sovToken(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Token) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
-	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
-	}
-	l = len(m.SmallestUnit)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
-	}
-	if m.Decimals != 0 {
-		n += 1 + sovToken(uint64(m.Decimals))
-	}
-	if m.InitialSupply != 0 {
-		n += 1 + sovToken(uint64(m.InitialSupply))
-	}
-	if m.TotalSupply != 0 {
-		n += 1 + sovToken(uint64(m.TotalSupply))
-	}
-	if m.Mintable {
-		n += 2
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FrozenAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrozenAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrozenAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintToken(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x42
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CliffTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CliffTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintToken(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintToken(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Released.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenFeeOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenFeeOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenFeeOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MintFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.IssueFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenFeeOverrideProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenFeeOverrideProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenFeeOverrideProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.MintFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.IssueFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbolReservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbolReservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbolReservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbolReservationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbolReservationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbolReservationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintToken(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintToken(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Claimed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DistributionId != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
+	offset -= sovToken(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.SmallestUnit)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovToken(uint64(m.Decimals))
+	}
+	if m.InitialSupply != 0 {
+		n += 1 + sovToken(uint64(m.InitialSupply))
+	}
+	if m.TotalSupply != 0 {
+		n += 1 + sovToken(uint64(m.TotalSupply))
+	}
+	if m.Mintable {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovToken(uint64(l))
+		}
+	}
+	if m.HolderBurnable {
+		n += 2
+	}
+	if m.Freezable {
+		n += 2
+	}
+	return n
+}
+
+func (m *Attribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenRoles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovToken(uint64(e))
+		}
+		n += 1 + sovToken(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenTax.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.IssueFee.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.MintFeeRatio.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if m.FactorBase != 0 {
+		n += 1 + sovToken(uint64(m.FactorBase))
+	}
+	if m.FactorExp != 0 {
+		n += 1 + sovToken(uint64(m.FactorExp))
+	}
+	l = m.MinIssueFee.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if m.PremiumSymbolLen != 0 {
+		n += 1 + sovToken(uint64(m.PremiumSymbolLen))
+	}
+	l = m.PremiumFeeMultiplier.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.MinVestingAmount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *FrozenAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovToken(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.Released.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovToken(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CliffTime)
+	n += 1 + l + sovToken(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *TokenFeeOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.IssueFee.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.MintFee.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *TokenFeeOverrideProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.IssueFee.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.MintFee.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func (m *SymbolReservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *SymbolReservationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func (m *TokenSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovToken(uint64(m.Id))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovToken(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovToken(uint64(l))
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovToken(uint64(m.Id))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *DistributionClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		n += 1 + sovToken(uint64(m.DistributionId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func sovToken(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozToken(x uint64) (n int) {
+	return sovToken(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Token) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Token: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Token: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmallestUnit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SmallestUnit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialSupply", wireType)
+			}
+			m.InitialSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			m.TotalSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mintable = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderBurnable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HolderBurnable = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freezable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Freezable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenRoles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenRoles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v TokenRole
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowToken
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= TokenRole(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowToken
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthToken
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthToken
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]TokenRole, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v TokenRole
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowToken
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= TokenRole(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssueFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IssueFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactorBase", wireType)
+			}
+			m.FactorBase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FactorBase |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactorExp", wireType)
+			}
+			m.FactorExp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FactorExp |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIssueFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinIssueFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumSymbolLen", wireType)
+			}
+			m.PremiumSymbolLen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PremiumSymbolLen |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PremiumFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVestingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinVestingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrozenAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrozenAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrozenAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Released.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenFeeOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenFeeOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenFeeOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssueFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IssueFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenFeeOverrideProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenFeeOverrideProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenFeeOverrideProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssueFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IssueFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbolReservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbolReservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbolReservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbolReservationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbolReservationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbolReservationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.TokenTax.Size()
-	n += 1 + l + sovToken(uint64(l))
-	l = m.IssueFee.Size()
-	n += 1 + l + sovToken(uint64(l))
-	l = m.MintFeeRatio.Size()
-	n += 1 + l + sovToken(uint64(l))
-	return n
-}
-
-func sovToken(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozToken(x uint64) (n int) {
-	return sovToken(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Token) Unmarshal(dAtA []byte) error {
+func (m *TokenDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -401,17 +4353,17 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Token: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenDistribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Token: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -421,24 +4373,11 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthToken
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthToken
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
@@ -473,7 +4412,7 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SmallestUnit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -501,13 +4440,13 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SmallestUnit = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
 			}
-			m.Decimals = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -517,16 +4456,29 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialSupply", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			m.InitialSupply = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -536,16 +4488,30 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitialSupply |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
 			}
-			m.TotalSupply = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -555,36 +4521,30 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalSupply |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthToken
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowToken
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
 			}
-			m.Mintable = bool(v != 0)
-		case 8:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -594,23 +4554,24 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthToken
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthToken
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -633,7 +4594,7 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DistributionClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -656,17 +4617,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DistributionClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DistributionClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenTax", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
 			}
-			var stringLen uint64
+			m.DistributionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -676,31 +4637,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DistributionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthToken
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthToken
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssueFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -710,30 +4656,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthToken
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthToken
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IssueFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -743,23 +4688,22 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthToken
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthToken
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
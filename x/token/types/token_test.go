@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gauss/gauss/v4/x/token/types"
+)
+
+func TestTokenSetAttributes(t *testing.T) {
+	token := types.Token{}
+
+	token.SetAttributes([]types.Attribute{{Key: "website", Value: "gauss.io"}, {Key: "logo", Value: "ipfs://logo"}})
+	require.Equal(t, []types.Attribute{{Key: "logo", Value: "ipfs://logo"}, {Key: "website", Value: "gauss.io"}}, token.Attributes)
+
+	// an existing key is updated and an empty value removes the key
+	token.SetAttributes([]types.Attribute{{Key: "website", Value: "gauss.network"}, {Key: "logo", Value: ""}, {Key: "docs", Value: ""}})
+	require.Equal(t, []types.Attribute{{Key: "website", Value: "gauss.network"}}, token.Attributes)
+}
+
+func TestTokenValidateMetadata(t *testing.T) {
+	owner := sdk.AccAddress(tmhash.SumTruncated([]byte("owner")))
+
+	tests := []struct {
+		testCase   string
+		edit       func(token *types.Token)
+		expectPass bool
+	}{
+		{"empty metadata", func(token *types.Token) {}, true},
+		{"metadata", func(token *types.Token) {
+			token.URI = "https://gauss.io/ttk.json"
+			token.URIHash = strings.Repeat("aB", 32)
+			token.Description = "Test Token"
+			token.Attributes = []types.Attribute{{Key: "website", Value: "gauss.io"}}
+		}, true},
+		{"uri too long", func(token *types.Token) { token.URI = strings.Repeat("u", types.MaximumURILen+1) }, false},
+		{"uri hash not hex", func(token *types.Token) { token.URIHash = "0xab" }, false},
+		{"description too long", func(token *types.Token) {
+			token.Description = strings.Repeat("d", types.MaximumDescriptionLen+1)
+		}, false},
+		{"duplicate attribute", func(token *types.Token) {
+			token.Attributes = []types.Attribute{{Key: "logo", Value: "a"}, {Key: "logo", Value: "b"}}
+		}, false},
+		{"empty attribute value", func(token *types.Token) {
+			token.Attributes = []types.Attribute{{Key: "logo", Value: ""}}
+		}, false},
+	}
+
+	for _, tc := range tests {
+		token := types.NewToken("Test Token", "ttk", "uttk", 6, 1000, 2000, true, owner)
+		tc.edit(&token)
+		if tc.expectPass {
+			require.NoError(t, token.Validate(), "test: %v", tc.testCase)
+		} else {
+			require.Error(t, token.Validate(), "test: %v", tc.testCase)
+		}
+	}
+}
+
+func TestTokenRolesValidate(t *testing.T) {
+	addr := sdk.AccAddress(tmhash.SumTruncated([]byte("minter"))).String()
+
+	role, err := types.TokenRoleFromString("metadata-admin")
+	require.NoError(t, err)
+	require.Equal(t, types.RoleMetadataAdmin, role)
+	role, err = types.TokenRoleFromString("TOKEN_ROLE_MINTER")
+	require.NoError(t, err)
+	require.Equal(t, types.RoleMinter, role)
+	_, err = types.TokenRoleFromString("TOKEN_ROLE_UNSPECIFIED")
+	require.ErrorIs(t, err, types.ErrInvalidRole)
+
+	roles := types.TokenRoles{Symbol: "ttk", Address: addr, Roles: []types.TokenRole{types.RoleMinter, types.RolePauser}}
+	require.NoError(t, roles.Validate())
+	require.True(t, roles.HasRole(types.RolePauser))
+	require.False(t, roles.HasRole(types.RoleBurner))
+
+	roles.Roles = append(roles.Roles, types.RoleMinter)
+	require.ErrorIs(t, roles.Validate(), types.ErrInvalidRole)
+	roles.Roles = []types.TokenRole{types.TokenRole(9)}
+	require.ErrorIs(t, roles.Validate(), types.ErrInvalidRole)
+	roles.Roles = nil
+	require.ErrorIs(t, roles.Validate(), types.ErrInvalidRole)
+}
@@ -21,6 +21,18 @@ const (
 	MaximumDecimals = uint32(18)
 	// MaximumAmount is the maximum limitation for the token supply
 	MaximumAmount = math.MaxUint64
+	// MaximumURILen is the maximum limitation for the length of the token's metadata uri
+	MaximumURILen = 256
+	// MaximumURIHashLen is the maximum limitation for the length of the token's metadata uri hash
+	MaximumURIHashLen = 128
+	// MaximumDescriptionLen is the maximum limitation for the length of the token's description
+	MaximumDescriptionLen = 1024
+	// MaximumAttributes is the maximum limitation for the number of the token's attributes
+	MaximumAttributes = 16
+	// MaximumAttributeKeyLen is the maximum limitation for the length of an attribute key
+	MaximumAttributeKeyLen = 32
+	// MaximumAttributeValueLen is the maximum limitation for the length of an attribute value
+	MaximumAttributeValueLen = 256
 )
 
 var (
@@ -33,6 +45,11 @@ var (
 
 	regexpSymbolFmt = fmt.Sprintf("^[a-z][a-z0-9]{%d,%d}$", MinimumSymbolLen-1, MaximumSymbolLen-1)
 	regexpSymbol    = regexp.MustCompile(regexpSymbolFmt).MatchString
+
+	regexpURIHash = regexp.MustCompile("^[a-fA-F0-9]*$").MatchString
+
+	regexpAttributeKeyFmt = fmt.Sprintf("^[a-zA-Z][a-zA-Z0-9_.-]{0,%d}$", MaximumAttributeKeyLen-1)
+	regexpAttributeKey    = regexp.MustCompile(regexpAttributeKeyFmt).MatchString
 )
 
 // ValidateToken checks if the given token is valid
@@ -58,6 +75,27 @@ func (token Token) Validate() error {
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
 		}
 	}
+	if err := ValidateURI(token.GetURI()); err != nil {
+		return err
+	}
+	if err := ValidateURIHash(token.GetURIHash()); err != nil {
+		return err
+	}
+	if err := ValidateDescription(token.GetDescription()); err != nil {
+		return err
+	}
+	if len(token.Attributes) > MaximumAttributes {
+		return sdkerrors.Wrapf(ErrInvalidAttribute, "too many attributes %d, only accepts [0, %d]",
+			len(token.Attributes), MaximumAttributes)
+	}
+	if err := ValidateAttributes(token.GetAttributes()); err != nil {
+		return err
+	}
+	for _, attr := range token.Attributes {
+		if len(attr.Value) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidAttribute, "empty value of attribute %s", attr.Key)
+		}
+	}
 	return nil
 }
 
@@ -118,3 +156,50 @@ func ValidateAmountGTZero(amount uint64) error {
 	return nil
 }
 
+// ValidateURI verifies whether the given metadata uri is legal
+func ValidateURI(uri string) error {
+	if len(uri) > MaximumURILen {
+		return sdkerrors.Wrapf(ErrInvalidURI, "invalid uri length %d, only accepts length [0, %d]",
+			len(uri), MaximumURILen)
+	}
+	return nil
+}
+
+// ValidateURIHash verifies whether the given metadata uri hash is a legal hex string
+func ValidateURIHash(uriHash string) error {
+	if len(uriHash) > MaximumURIHashLen || !regexpURIHash(uriHash) {
+		return sdkerrors.Wrapf(ErrInvalidURIHash, "invalid uri hash %s, only accepts hex string of length [0, %d]",
+			uriHash, MaximumURIHashLen)
+	}
+	return nil
+}
+
+// ValidateDescription verifies whether the given description is legal
+func ValidateDescription(description string) error {
+	if len(description) > MaximumDescriptionLen {
+		return sdkerrors.Wrapf(ErrInvalidDescription, "invalid description length %d, only accepts length [0, %d]",
+			len(description), MaximumDescriptionLen)
+	}
+	return nil
+}
+
+// ValidateAttributes verifies the keys and values of the given attributes,
+// an empty value is accepted as it removes the attribute on edit
+func ValidateAttributes(attributes []Attribute) error {
+	keys := make(map[string]bool, len(attributes))
+	for _, attr := range attributes {
+		if !regexpAttributeKey(attr.Key) {
+			return sdkerrors.Wrapf(ErrInvalidAttribute, "invalid attribute key %s, regexp: %s",
+				attr.Key, regexpAttributeKeyFmt)
+		}
+		if keys[attr.Key] {
+			return sdkerrors.Wrapf(ErrInvalidAttribute, "duplicate attribute key %s", attr.Key)
+		}
+		if len(attr.Value) > MaximumAttributeValueLen {
+			return sdkerrors.Wrapf(ErrInvalidAttribute, "invalid value length %d of attribute %s, only accepts length [0, %d]",
+				len(attr.Value), attr.Key, MaximumAttributeValueLen)
+		}
+		keys[attr.Key] = true
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// VestingReleaseInterval is the time between two releases of a token vesting
+// once its cliff time is reached
+const VestingReleaseInterval = time.Hour
+
+// NewTokenVesting creates a new TokenVesting with nothing released
+func NewTokenVesting(
+	id uint64, creator, beneficiary sdk.AccAddress, total sdk.Coin, startTime, cliffTime, endTime time.Time,
+) TokenVesting {
+	return TokenVesting{
+		Id:          id,
+		Creator:     creator.String(),
+		Beneficiary: beneficiary.String(),
+		Total:       total,
+		Released:    sdk.NewCoin(total.Denom, sdk.ZeroInt()),
+		StartTime:   startTime,
+		CliffTime:   cliffTime,
+		EndTime:     endTime,
+	}
+}
+
+// ValidateVestingSchedule checks that the cliff time is between the start and the end time
+// and that the end time is after the start time
+func ValidateVestingSchedule(startTime, cliffTime, endTime time.Time) error {
+	if !endTime.After(startTime) {
+		return sdkerrors.Wrapf(ErrInvalidVesting, "end time %s must be after start time %s", endTime, startTime)
+	}
+	if cliffTime.Before(startTime) || cliffTime.After(endTime) {
+		return sdkerrors.Wrapf(ErrInvalidVesting, "cliff time %s must be between start time %s and end time %s", cliffTime, startTime, endTime)
+	}
+	return nil
+}
+
+// VestedAmount returns the amount vested at the given time, including the released amount.
+// Nothing is vested before the cliff time, the total is vested from the end time and the
+// amount vests linearly from the start time in between
+func (v TokenVesting) VestedAmount(blockTime time.Time) sdk.Coin {
+	switch {
+	case blockTime.Before(v.CliffTime):
+		return sdk.NewCoin(v.Total.Denom, sdk.ZeroInt())
+	case !blockTime.Before(v.EndTime):
+		return v.Total
+	}
+
+	elapsed := sdk.NewInt(blockTime.Sub(v.StartTime).Nanoseconds())
+	duration := sdk.NewInt(v.EndTime.Sub(v.StartTime).Nanoseconds())
+	return sdk.NewCoin(v.Total.Denom, v.Total.Amount.Mul(elapsed).Quo(duration))
+}
+
+// NextReleaseTime returns the time of the next release of the token vesting
+// after the given time: its cliff time, or a release interval later capped at
+// the end time
+func (v TokenVesting) NextReleaseTime(blockTime time.Time) time.Time {
+	if blockTime.Before(v.CliffTime) {
+		return v.CliffTime
+	}
+	if next := blockTime.Add(VestingReleaseInterval); next.Before(v.EndTime) {
+		return next
+	}
+	return v.EndTime
+}
+
+// UnvestedAmount returns the amount not vested yet at the given time
+func (v TokenVesting) UnvestedAmount(blockTime time.Time) sdk.Coin {
+	return v.Total.Sub(v.VestedAmount(blockTime))
+}
+
+// Escrowed returns the amount still escrowed in the token module account
+func (v TokenVesting) Escrowed() sdk.Coin {
+	return v.Total.Sub(v.Released)
+}
+
+// Validate checks the addresses, the amounts and the schedule of the token vesting
+func (v TokenVesting) Validate() error {
+	if v.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidVesting, "vesting id must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(v.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(v.Beneficiary); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid beneficiary address (%s)", err)
+	}
+	if !v.Total.IsValid() || !v.Total.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid vesting total %s", v.Total)
+	}
+	if !v.Released.IsValid() || v.Released.Denom != v.Total.Denom || v.Released.IsGTE(v.Total) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid vesting released %s of total %s", v.Released, v.Total)
+	}
+	return ValidateVestingSchedule(v.StartTime, v.CliffTime, v.EndTime)
+}